@@ -285,6 +285,58 @@ func (AttestVersionType) EnumDescriptor() ([]byte, []int) {
 	return file_attest_attest_proto_rawDescGZIP(), []int{4}
 }
 
+type MeasurementChangeType int32
+
+const (
+	MeasurementChangeType_MEASUREMENT_CHANGE_TYPE_INVALID  MeasurementChangeType = 0
+	MeasurementChangeType_MEASUREMENT_CHANGE_TYPE_ADDED    MeasurementChangeType = 1 // Component measured only in the current boot
+	MeasurementChangeType_MEASUREMENT_CHANGE_TYPE_REMOVED  MeasurementChangeType = 2 // Component measured only in the previous boot
+	MeasurementChangeType_MEASUREMENT_CHANGE_TYPE_MODIFIED MeasurementChangeType = 3 // Component measured with a different digest
+)
+
+// Enum value maps for MeasurementChangeType.
+var (
+	MeasurementChangeType_name = map[int32]string{
+		0: "MEASUREMENT_CHANGE_TYPE_INVALID",
+		1: "MEASUREMENT_CHANGE_TYPE_ADDED",
+		2: "MEASUREMENT_CHANGE_TYPE_REMOVED",
+		3: "MEASUREMENT_CHANGE_TYPE_MODIFIED",
+	}
+	MeasurementChangeType_value = map[string]int32{
+		"MEASUREMENT_CHANGE_TYPE_INVALID":  0,
+		"MEASUREMENT_CHANGE_TYPE_ADDED":    1,
+		"MEASUREMENT_CHANGE_TYPE_REMOVED":  2,
+		"MEASUREMENT_CHANGE_TYPE_MODIFIED": 3,
+	}
+)
+
+func (x MeasurementChangeType) Enum() *MeasurementChangeType {
+	p := new(MeasurementChangeType)
+	*p = x
+	return p
+}
+
+func (x MeasurementChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeasurementChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_attest_attest_proto_enumTypes[5].Descriptor()
+}
+
+func (MeasurementChangeType) Type() protoreflect.EnumType {
+	return &file_attest_attest_proto_enumTypes[5]
+}
+
+func (x MeasurementChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeasurementChangeType.Descriptor instead.
+func (MeasurementChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_attest_attest_proto_rawDescGZIP(), []int{5}
+}
+
 type ZAttestResponseCode int32
 
 const (
@@ -324,11 +376,11 @@ func (x ZAttestResponseCode) String() string {
 }
 
 func (ZAttestResponseCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_attest_attest_proto_enumTypes[5].Descriptor()
+	return file_attest_attest_proto_enumTypes[6].Descriptor()
 }
 
 func (ZAttestResponseCode) Type() protoreflect.EnumType {
-	return &file_attest_attest_proto_enumTypes[5]
+	return &file_attest_attest_proto_enumTypes[6]
 }
 
 func (x ZAttestResponseCode) Number() protoreflect.EnumNumber {
@@ -337,7 +389,7 @@ func (x ZAttestResponseCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ZAttestResponseCode.Descriptor instead.
 func (ZAttestResponseCode) EnumDescriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{5}
+	return file_attest_attest_proto_rawDescGZIP(), []int{6}
 }
 
 type AttestVolumeKeyType int32
@@ -370,11 +422,11 @@ func (x AttestVolumeKeyType) String() string {
 }
 
 func (AttestVolumeKeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_attest_attest_proto_enumTypes[6].Descriptor()
+	return file_attest_attest_proto_enumTypes[7].Descriptor()
 }
 
 func (AttestVolumeKeyType) Type() protoreflect.EnumType {
-	return &file_attest_attest_proto_enumTypes[6]
+	return &file_attest_attest_proto_enumTypes[7]
 }
 
 func (x AttestVolumeKeyType) Number() protoreflect.EnumNumber {
@@ -383,7 +435,7 @@ func (x AttestVolumeKeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AttestVolumeKeyType.Descriptor instead.
 func (AttestVolumeKeyType) EnumDescriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{6}
+	return file_attest_attest_proto_rawDescGZIP(), []int{7}
 }
 
 type AttestStorageKeysResponseCode int32
@@ -419,11 +471,11 @@ func (x AttestStorageKeysResponseCode) String() string {
 }
 
 func (AttestStorageKeysResponseCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_attest_attest_proto_enumTypes[7].Descriptor()
+	return file_attest_attest_proto_enumTypes[8].Descriptor()
 }
 
 func (AttestStorageKeysResponseCode) Type() protoreflect.EnumType {
-	return &file_attest_attest_proto_enumTypes[7]
+	return &file_attest_attest_proto_enumTypes[8]
 }
 
 func (x AttestStorageKeysResponseCode) Number() protoreflect.EnumNumber {
@@ -432,7 +484,7 @@ func (x AttestStorageKeysResponseCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AttestStorageKeysResponseCode.Descriptor instead.
 func (AttestStorageKeysResponseCode) EnumDescriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{7}
+	return file_attest_attest_proto_rawDescGZIP(), []int{8}
 }
 
 //  This is the request payload for POST /api/v2/edgeDevice/id/<uuid>/attest
@@ -966,6 +1018,175 @@ func (x *TpmPCRValue) GetValue() []byte {
 	return nil
 }
 
+// Result of replaying TPM Event log for a PCR which has events in the log
+type TpmPCRReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                     //PCR index
+	ReplayedValue []byte `protobuf:"bytes,2,opt,name=replayed_value,json=replayedValue,proto3" json:"replayed_value,omitempty"` //SHA256 value obtained by replaying the event log
+	MatchesQuote  bool   `protobuf:"varint,3,opt,name=matches_quote,json=matchesQuote,proto3" json:"matches_quote,omitempty"`   //true if replayed_value equals the value in pcr_values
+}
+
+func (x *TpmPCRReplay) Reset() {
+	*x = TpmPCRReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attest_attest_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TpmPCRReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TpmPCRReplay) ProtoMessage() {}
+
+func (x *TpmPCRReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_attest_attest_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TpmPCRReplay.ProtoReflect.Descriptor instead.
+func (*TpmPCRReplay) Descriptor() ([]byte, []int) {
+	return file_attest_attest_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TpmPCRReplay) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TpmPCRReplay) GetReplayedValue() []byte {
+	if x != nil {
+		return x.ReplayedValue
+	}
+	return nil
+}
+
+func (x *TpmPCRReplay) GetMatchesQuote() bool {
+	if x != nil {
+		return x.MatchesQuote
+	}
+	return false
+}
+
+// Difference in measured boot components compared to the last boot
+// which was attested successfully
+type MeasurementChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeType     MeasurementChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=org.lfedge.eve.attest.MeasurementChangeType" json:"change_type,omitempty"`
+	Component      string                `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`                                 // firmware, secureboot, bootloader, grub-command, cmdline, hypervisor, kernel, initrd, rootfs or other
+	Name           string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Identifies measurement within the component, e.g. EFI variable name
+	PcrIndex       uint32                `protobuf:"varint,4,opt,name=pcr_index,json=pcrIndex,proto3" json:"pcr_index,omitempty"`                  // PCR the component is measured into
+	PreviousDigest []byte                `protobuf:"bytes,5,opt,name=previous_digest,json=previousDigest,proto3" json:"previous_digest,omitempty"` // SHA256 event digest in the previous boot
+	Digest         []byte                `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`                                       // SHA256 event digest in the current boot
+	PreviousDetail string                `protobuf:"bytes,7,opt,name=previous_detail,json=previousDetail,proto3" json:"previous_detail,omitempty"` // Measured text in the previous boot (e.g. kernel command line), may be truncated
+	Detail         string                `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`                                       // Measured text in the current boot, may be truncated
+}
+
+func (x *MeasurementChange) Reset() {
+	*x = MeasurementChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attest_attest_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasurementChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurementChange) ProtoMessage() {}
+
+func (x *MeasurementChange) ProtoReflect() protoreflect.Message {
+	mi := &file_attest_attest_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurementChange.ProtoReflect.Descriptor instead.
+func (*MeasurementChange) Descriptor() ([]byte, []int) {
+	return file_attest_attest_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MeasurementChange) GetChangeType() MeasurementChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return MeasurementChangeType_MEASUREMENT_CHANGE_TYPE_INVALID
+}
+
+func (x *MeasurementChange) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *MeasurementChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeasurementChange) GetPcrIndex() uint32 {
+	if x != nil {
+		return x.PcrIndex
+	}
+	return 0
+}
+
+func (x *MeasurementChange) GetPreviousDigest() []byte {
+	if x != nil {
+		return x.PreviousDigest
+	}
+	return nil
+}
+
+func (x *MeasurementChange) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *MeasurementChange) GetPreviousDetail() string {
+	if x != nil {
+		return x.PreviousDetail
+	}
+	return ""
+}
+
+func (x *MeasurementChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
 // attestData is taken from
 // TPMS_ATTEST Table 2:123) in https://trustedcomputinggroup.org/wp-content/uploads/TPM-Rev-2.0-Part-2-Structures-01.38.pdf
 type ZAttestQuote struct {
@@ -973,18 +1194,20 @@ type ZAttestQuote struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttestData []byte                `protobuf:"bytes,1,opt,name=attestData,proto3" json:"attestData,omitempty"`                // Nonce is included in attestData, see comment above
-	Signature  []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                  // Signature to verify attestData
-	PcrValues  []*TpmPCRValue        `protobuf:"bytes,3,rep,name=pcr_values,json=pcrValues,proto3" json:"pcr_values,omitempty"` // Current values of TPM PCRs 0-15
-	EventLog   []*TpmEventLogEntry   `protobuf:"bytes,4,rep,name=event_log,json=eventLog,proto3" json:"event_log,omitempty"`    // TPM Event log
-	Versions   []*AttestVersionInfo  `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions,omitempty"`                    // Versions of various software packages, as defined by VersionType
-	GpsInfo    *AttestGPSCoordinates `protobuf:"bytes,6,opt,name=gps_info,json=gpsInfo,proto3" json:"gps_info,omitempty"`       // Geo-Coordinates of the device
+	AttestData         []byte                `protobuf:"bytes,1,opt,name=attestData,proto3" json:"attestData,omitempty"`                                           // Nonce is included in attestData, see comment above
+	Signature          []byte                `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                                             // Signature to verify attestData
+	PcrValues          []*TpmPCRValue        `protobuf:"bytes,3,rep,name=pcr_values,json=pcrValues,proto3" json:"pcr_values,omitempty"`                            // Current values of TPM PCRs 0-15
+	EventLog           []*TpmEventLogEntry   `protobuf:"bytes,4,rep,name=event_log,json=eventLog,proto3" json:"event_log,omitempty"`                               // TPM Event log
+	Versions           []*AttestVersionInfo  `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions,omitempty"`                                               // Versions of various software packages, as defined by VersionType
+	GpsInfo            *AttestGPSCoordinates `protobuf:"bytes,6,opt,name=gps_info,json=gpsInfo,proto3" json:"gps_info,omitempty"`                                  // Geo-Coordinates of the device
+	PcrReplay          []*TpmPCRReplay       `protobuf:"bytes,7,rep,name=pcr_replay,json=pcrReplay,proto3" json:"pcr_replay,omitempty"`                            // Event log replay checked against pcr_values
+	MeasurementChanges []*MeasurementChange  `protobuf:"bytes,8,rep,name=measurement_changes,json=measurementChanges,proto3" json:"measurement_changes,omitempty"` // Changes since the last successful attestation
 }
 
 func (x *ZAttestQuote) Reset() {
 	*x = ZAttestQuote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[8]
+		mi := &file_attest_attest_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1220,7 @@ func (x *ZAttestQuote) String() string {
 func (*ZAttestQuote) ProtoMessage() {}
 
 func (x *ZAttestQuote) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[8]
+	mi := &file_attest_attest_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1233,7 @@ func (x *ZAttestQuote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZAttestQuote.ProtoReflect.Descriptor instead.
 func (*ZAttestQuote) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{8}
+	return file_attest_attest_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ZAttestQuote) GetAttestData() []byte {
@@ -1055,6 +1278,20 @@ func (x *ZAttestQuote) GetGpsInfo() *AttestGPSCoordinates {
 	return nil
 }
 
+func (x *ZAttestQuote) GetPcrReplay() []*TpmPCRReplay {
+	if x != nil {
+		return x.PcrReplay
+	}
+	return nil
+}
+
+func (x *ZAttestQuote) GetMeasurementChanges() []*MeasurementChange {
+	if x != nil {
+		return x.MeasurementChanges
+	}
+	return nil
+}
+
 type AttestVolumeKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1067,7 +1304,7 @@ type AttestVolumeKey struct {
 func (x *AttestVolumeKey) Reset() {
 	*x = AttestVolumeKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[9]
+		mi := &file_attest_attest_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1080,7 +1317,7 @@ func (x *AttestVolumeKey) String() string {
 func (*AttestVolumeKey) ProtoMessage() {}
 
 func (x *AttestVolumeKey) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[9]
+	mi := &file_attest_attest_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1330,7 @@ func (x *AttestVolumeKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestVolumeKey.ProtoReflect.Descriptor instead.
 func (*AttestVolumeKey) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{9}
+	return file_attest_attest_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AttestVolumeKey) GetKeyType() AttestVolumeKeyType {
@@ -1124,7 +1361,7 @@ type ZAttestQuoteResp struct {
 func (x *ZAttestQuoteResp) Reset() {
 	*x = ZAttestQuoteResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[10]
+		mi := &file_attest_attest_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1137,7 +1374,7 @@ func (x *ZAttestQuoteResp) String() string {
 func (*ZAttestQuoteResp) ProtoMessage() {}
 
 func (x *ZAttestQuoteResp) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[10]
+	mi := &file_attest_attest_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1387,7 @@ func (x *ZAttestQuoteResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZAttestQuoteResp.ProtoReflect.Descriptor instead.
 func (*ZAttestQuoteResp) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{10}
+	return file_attest_attest_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ZAttestQuoteResp) GetResponse() ZAttestResponseCode {
@@ -1187,7 +1424,7 @@ type AttestStorageKeys struct {
 func (x *AttestStorageKeys) Reset() {
 	*x = AttestStorageKeys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[11]
+		mi := &file_attest_attest_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1200,7 +1437,7 @@ func (x *AttestStorageKeys) String() string {
 func (*AttestStorageKeys) ProtoMessage() {}
 
 func (x *AttestStorageKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[11]
+	mi := &file_attest_attest_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1450,7 @@ func (x *AttestStorageKeys) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestStorageKeys.ProtoReflect.Descriptor instead.
 func (*AttestStorageKeys) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{11}
+	return file_attest_attest_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AttestStorageKeys) GetIntegrityToken() []byte {
@@ -1241,7 +1478,7 @@ type AttestStorageKeysResp struct {
 func (x *AttestStorageKeysResp) Reset() {
 	*x = AttestStorageKeysResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[12]
+		mi := &file_attest_attest_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1254,7 +1491,7 @@ func (x *AttestStorageKeysResp) String() string {
 func (*AttestStorageKeysResp) ProtoMessage() {}
 
 func (x *AttestStorageKeysResp) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[12]
+	mi := &file_attest_attest_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1504,7 @@ func (x *AttestStorageKeysResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestStorageKeysResp.ProtoReflect.Descriptor instead.
 func (*AttestStorageKeysResp) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{12}
+	return file_attest_attest_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AttestStorageKeysResp) GetResponse() AttestStorageKeysResponseCode {
@@ -1289,7 +1526,7 @@ type AttestVolumeKeyData struct {
 func (x *AttestVolumeKeyData) Reset() {
 	*x = AttestVolumeKeyData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attest_attest_proto_msgTypes[13]
+		mi := &file_attest_attest_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1302,7 +1539,7 @@ func (x *AttestVolumeKeyData) String() string {
 func (*AttestVolumeKeyData) ProtoMessage() {}
 
 func (x *AttestVolumeKeyData) ProtoReflect() protoreflect.Message {
-	mi := &file_attest_attest_proto_msgTypes[13]
+	mi := &file_attest_attest_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,7 +1552,7 @@ func (x *AttestVolumeKeyData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestVolumeKeyData.ProtoReflect.Descriptor instead.
 func (*AttestVolumeKeyData) Descriptor() ([]byte, []int) {
-	return file_attest_attest_proto_rawDescGZIP(), []int{13}
+	return file_attest_attest_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AttestVolumeKeyData) GetEncryptedKey() []byte {
@@ -1428,143 +1665,190 @@ var file_attest_attest_proto_rawDesc = []byte{
 	0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x70, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f,
 	0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0xe3, 0x02, 0x0a, 0x0c, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x70, 0x63, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x70, 0x6d, 0x50,
-	0x43, 0x52, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x70, 0x63, 0x72, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x70,
-	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x44, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x67,
-	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46,
-	0x0a, 0x08, 0x67, 0x70, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x47,
-	0x50, 0x53, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x67,
-	0x70, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x6a, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x08, 0x6b, 0x65, 0x79,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6f, 0x72,
+	0x22, 0x70, 0x0a, 0x0c, 0x54, 0x70, 0x6d, 0x50, 0x43, 0x52, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x11, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x63, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x63,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x82, 0x04, 0x0a, 0x0c, 0x5a, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x63, 0x72, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72,
 	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
-	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
-	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04,
-	0x6b, 0x65, 0x79, 0x73, 0x22, 0x78, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x69,
-	0x0a, 0x15, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
-	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x13, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f,
-	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x69,
-	0x67, 0x65, 0x73, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x5a,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a,
-	0x0f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51,
-	0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53,
-	0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a,
-	0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x51, 0x55, 0x4f, 0x54,
-	0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f,
-	0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4b,
-	0x45, 0x59, 0x53, 0x10, 0x04, 0x2a, 0x93, 0x01, 0x0a, 0x0f, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x54,
-	0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x14, 0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x43,
-	0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
-	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x51, 0x55, 0x4f, 0x54,
-	0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x5a, 0x5f, 0x41, 0x54,
-	0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x04, 0x2a, 0x74, 0x0a, 0x0b, 0x54,
-	0x70, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x50,
-	0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x49, 0x4e, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x53,
-	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x50, 0x4d, 0x5f, 0x48,
-	0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10,
-	0x03, 0x2a, 0x69, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x47, 0x50, 0x53, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x47, 0x50,
-	0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
-	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x47, 0x50, 0x53, 0x5f,
-	0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x1b, 0x0a, 0x17, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x47, 0x50, 0x53, 0x5f, 0x49, 0x4e,
-	0x50, 0x55, 0x54, 0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x11,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53,
-	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x10, 0x01, 0x12,
-	0x20, 0x0a, 0x1c, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x10,
-	0x02, 0x2a, 0xdb, 0x01, 0x0a, 0x13, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x5a, 0x5f, 0x41,
-	0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x70, 0x6d, 0x50, 0x43, 0x52, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x70, 0x63, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x70, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x44, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x67, 0x70, 0x73, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x47, 0x50, 0x53, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x67, 0x70, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42,
+	0x0a, 0x0a, 0x70, 0x63, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x70, 0x6d, 0x50, 0x43,
+	0x52, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x09, 0x70, 0x63, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x12, 0x59, 0x0a, 0x13, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x6a, 0x0a,
+	0x0f, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x45, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x5a, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x78, 0x0a, 0x11, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5f, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x54, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x43, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x5a, 0x5f,
+	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x04, 0x2a, 0x93, 0x01, 0x0a,
+	0x0f, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x43,
+	0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x03, 0x12,
+	0x21, 0x0a, 0x1d, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x53,
+	0x10, 0x04, 0x2a, 0x74, 0x0a, 0x0b, 0x54, 0x70, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67,
+	0x6f, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c,
+	0x47, 0x4f, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x53, 0x48,
+	0x41, 0x31, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x54, 0x50, 0x4d, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x5f,
+	0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x47, 0x50, 0x53, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x47, 0x50, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x47, 0x50, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x45,
+	0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x47, 0x50, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e,
+	0x54, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x54, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x54, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x56, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49,
+	0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x10, 0x02, 0x2a, 0xaa, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x41, 0x53, 0x55,
+	0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45,
+	0x41, 0x53, 0x55, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x24, 0x0a, 0x20, 0x4d, 0x45, 0x41, 0x53, 0x55, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xdb, 0x01, 0x0a, 0x13, 0x5a, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
 	0x1e, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
-	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0x01, 0x12, 0x29, 0x0a, 0x25, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x43,
-	0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24,
-	0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53,
-	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45,
-	0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
-	0x59, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b,
-	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54,
-	0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x54,
+	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02,
+	0x12, 0x28, 0x0a, 0x24, 0x5a, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x45,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x5a, 0x5f,
+	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0x59, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x54,
 	0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x53, 0x4b, 0x10, 0x01, 0x2a, 0xb4, 0x01, 0x0a, 0x1d, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x29,
-	0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4b,
-	0x45, 0x59, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x41,
-	0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x45,
-	0x59, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x35, 0x0a, 0x31, 0x41, 0x54,
-	0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x45, 0x59,
-	0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x49, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
-	0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65,
-	0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x53, 0x4b, 0x10, 0x01, 0x2a, 0xb4,
+	0x01, 0x0a, 0x1d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2d, 0x0a, 0x29, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41,
+	0x47, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12,
+	0x2d, 0x0a, 0x29, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47,
+	0x45, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x35,
+	0x0a, 0x31, 0x41, 0x54, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x4b, 0x45, 0x59, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5a, 0x24,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64,
+	0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1579,61 +1863,67 @@ func file_attest_attest_proto_rawDescGZIP() []byte {
 	return file_attest_attest_proto_rawDescData
 }
 
-var file_attest_attest_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_attest_attest_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_attest_attest_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_attest_attest_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_attest_attest_proto_goTypes = []interface{}{
 	(ZAttestReqType)(0),                // 0: org.lfedge.eve.attest.ZAttestReqType
 	(ZAttestRespType)(0),               // 1: org.lfedge.eve.attest.ZAttestRespType
 	(TpmHashAlgo)(0),                   // 2: org.lfedge.eve.attest.TpmHashAlgo
 	(AttestGPSInput)(0),                // 3: org.lfedge.eve.attest.AttestGPSInput
 	(AttestVersionType)(0),             // 4: org.lfedge.eve.attest.AttestVersionType
-	(ZAttestResponseCode)(0),           // 5: org.lfedge.eve.attest.ZAttestResponseCode
-	(AttestVolumeKeyType)(0),           // 6: org.lfedge.eve.attest.AttestVolumeKeyType
-	(AttestStorageKeysResponseCode)(0), // 7: org.lfedge.eve.attest.AttestStorageKeysResponseCode
-	(*ZAttestReq)(nil),                 // 8: org.lfedge.eve.attest.ZAttestReq
-	(*ZAttestResponse)(nil),            // 9: org.lfedge.eve.attest.ZAttestResponse
-	(*ZAttestNonceResp)(nil),           // 10: org.lfedge.eve.attest.ZAttestNonceResp
-	(*TpmEventDigest)(nil),             // 11: org.lfedge.eve.attest.TpmEventDigest
-	(*TpmEventLogEntry)(nil),           // 12: org.lfedge.eve.attest.TpmEventLogEntry
-	(*AttestGPSCoordinates)(nil),       // 13: org.lfedge.eve.attest.AttestGPSCoordinates
-	(*AttestVersionInfo)(nil),          // 14: org.lfedge.eve.attest.AttestVersionInfo
-	(*TpmPCRValue)(nil),                // 15: org.lfedge.eve.attest.TpmPCRValue
-	(*ZAttestQuote)(nil),               // 16: org.lfedge.eve.attest.ZAttestQuote
-	(*AttestVolumeKey)(nil),            // 17: org.lfedge.eve.attest.AttestVolumeKey
-	(*ZAttestQuoteResp)(nil),           // 18: org.lfedge.eve.attest.ZAttestQuoteResp
-	(*AttestStorageKeys)(nil),          // 19: org.lfedge.eve.attest.AttestStorageKeys
-	(*AttestStorageKeysResp)(nil),      // 20: org.lfedge.eve.attest.AttestStorageKeysResp
-	(*AttestVolumeKeyData)(nil),        // 21: org.lfedge.eve.attest.AttestVolumeKeyData
-	(*certs.ZCert)(nil),                // 22: org.lfedge.eve.certs.ZCert
+	(MeasurementChangeType)(0),         // 5: org.lfedge.eve.attest.MeasurementChangeType
+	(ZAttestResponseCode)(0),           // 6: org.lfedge.eve.attest.ZAttestResponseCode
+	(AttestVolumeKeyType)(0),           // 7: org.lfedge.eve.attest.AttestVolumeKeyType
+	(AttestStorageKeysResponseCode)(0), // 8: org.lfedge.eve.attest.AttestStorageKeysResponseCode
+	(*ZAttestReq)(nil),                 // 9: org.lfedge.eve.attest.ZAttestReq
+	(*ZAttestResponse)(nil),            // 10: org.lfedge.eve.attest.ZAttestResponse
+	(*ZAttestNonceResp)(nil),           // 11: org.lfedge.eve.attest.ZAttestNonceResp
+	(*TpmEventDigest)(nil),             // 12: org.lfedge.eve.attest.TpmEventDigest
+	(*TpmEventLogEntry)(nil),           // 13: org.lfedge.eve.attest.TpmEventLogEntry
+	(*AttestGPSCoordinates)(nil),       // 14: org.lfedge.eve.attest.AttestGPSCoordinates
+	(*AttestVersionInfo)(nil),          // 15: org.lfedge.eve.attest.AttestVersionInfo
+	(*TpmPCRValue)(nil),                // 16: org.lfedge.eve.attest.TpmPCRValue
+	(*TpmPCRReplay)(nil),               // 17: org.lfedge.eve.attest.TpmPCRReplay
+	(*MeasurementChange)(nil),          // 18: org.lfedge.eve.attest.MeasurementChange
+	(*ZAttestQuote)(nil),               // 19: org.lfedge.eve.attest.ZAttestQuote
+	(*AttestVolumeKey)(nil),            // 20: org.lfedge.eve.attest.AttestVolumeKey
+	(*ZAttestQuoteResp)(nil),           // 21: org.lfedge.eve.attest.ZAttestQuoteResp
+	(*AttestStorageKeys)(nil),          // 22: org.lfedge.eve.attest.AttestStorageKeys
+	(*AttestStorageKeysResp)(nil),      // 23: org.lfedge.eve.attest.AttestStorageKeysResp
+	(*AttestVolumeKeyData)(nil),        // 24: org.lfedge.eve.attest.AttestVolumeKeyData
+	(*certs.ZCert)(nil),                // 25: org.lfedge.eve.certs.ZCert
 }
 var file_attest_attest_proto_depIdxs = []int32{
 	0,  // 0: org.lfedge.eve.attest.ZAttestReq.reqType:type_name -> org.lfedge.eve.attest.ZAttestReqType
-	16, // 1: org.lfedge.eve.attest.ZAttestReq.quote:type_name -> org.lfedge.eve.attest.ZAttestQuote
-	22, // 2: org.lfedge.eve.attest.ZAttestReq.certs:type_name -> org.lfedge.eve.certs.ZCert
-	19, // 3: org.lfedge.eve.attest.ZAttestReq.storage_keys:type_name -> org.lfedge.eve.attest.AttestStorageKeys
+	19, // 1: org.lfedge.eve.attest.ZAttestReq.quote:type_name -> org.lfedge.eve.attest.ZAttestQuote
+	25, // 2: org.lfedge.eve.attest.ZAttestReq.certs:type_name -> org.lfedge.eve.certs.ZCert
+	22, // 3: org.lfedge.eve.attest.ZAttestReq.storage_keys:type_name -> org.lfedge.eve.attest.AttestStorageKeys
 	1,  // 4: org.lfedge.eve.attest.ZAttestResponse.respType:type_name -> org.lfedge.eve.attest.ZAttestRespType
-	10, // 5: org.lfedge.eve.attest.ZAttestResponse.nonce:type_name -> org.lfedge.eve.attest.ZAttestNonceResp
-	18, // 6: org.lfedge.eve.attest.ZAttestResponse.quoteResp:type_name -> org.lfedge.eve.attest.ZAttestQuoteResp
-	20, // 7: org.lfedge.eve.attest.ZAttestResponse.storage_keys_resp:type_name -> org.lfedge.eve.attest.AttestStorageKeysResp
+	11, // 5: org.lfedge.eve.attest.ZAttestResponse.nonce:type_name -> org.lfedge.eve.attest.ZAttestNonceResp
+	21, // 6: org.lfedge.eve.attest.ZAttestResponse.quoteResp:type_name -> org.lfedge.eve.attest.ZAttestQuoteResp
+	23, // 7: org.lfedge.eve.attest.ZAttestResponse.storage_keys_resp:type_name -> org.lfedge.eve.attest.AttestStorageKeysResp
 	2,  // 8: org.lfedge.eve.attest.TpmEventDigest.hash_algo:type_name -> org.lfedge.eve.attest.TpmHashAlgo
-	11, // 9: org.lfedge.eve.attest.TpmEventLogEntry.digest:type_name -> org.lfedge.eve.attest.TpmEventDigest
+	12, // 9: org.lfedge.eve.attest.TpmEventLogEntry.digest:type_name -> org.lfedge.eve.attest.TpmEventDigest
 	3,  // 10: org.lfedge.eve.attest.AttestGPSCoordinates.gps_input:type_name -> org.lfedge.eve.attest.AttestGPSInput
 	4,  // 11: org.lfedge.eve.attest.AttestVersionInfo.version_type:type_name -> org.lfedge.eve.attest.AttestVersionType
 	2,  // 12: org.lfedge.eve.attest.TpmPCRValue.hash_algo:type_name -> org.lfedge.eve.attest.TpmHashAlgo
-	15, // 13: org.lfedge.eve.attest.ZAttestQuote.pcr_values:type_name -> org.lfedge.eve.attest.TpmPCRValue
-	12, // 14: org.lfedge.eve.attest.ZAttestQuote.event_log:type_name -> org.lfedge.eve.attest.TpmEventLogEntry
-	14, // 15: org.lfedge.eve.attest.ZAttestQuote.versions:type_name -> org.lfedge.eve.attest.AttestVersionInfo
-	13, // 16: org.lfedge.eve.attest.ZAttestQuote.gps_info:type_name -> org.lfedge.eve.attest.AttestGPSCoordinates
-	6,  // 17: org.lfedge.eve.attest.AttestVolumeKey.key_type:type_name -> org.lfedge.eve.attest.AttestVolumeKeyType
-	5,  // 18: org.lfedge.eve.attest.ZAttestQuoteResp.response:type_name -> org.lfedge.eve.attest.ZAttestResponseCode
-	17, // 19: org.lfedge.eve.attest.ZAttestQuoteResp.keys:type_name -> org.lfedge.eve.attest.AttestVolumeKey
-	17, // 20: org.lfedge.eve.attest.AttestStorageKeys.keys:type_name -> org.lfedge.eve.attest.AttestVolumeKey
-	7,  // 21: org.lfedge.eve.attest.AttestStorageKeysResp.response:type_name -> org.lfedge.eve.attest.AttestStorageKeysResponseCode
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	5,  // 13: org.lfedge.eve.attest.MeasurementChange.change_type:type_name -> org.lfedge.eve.attest.MeasurementChangeType
+	16, // 14: org.lfedge.eve.attest.ZAttestQuote.pcr_values:type_name -> org.lfedge.eve.attest.TpmPCRValue
+	13, // 15: org.lfedge.eve.attest.ZAttestQuote.event_log:type_name -> org.lfedge.eve.attest.TpmEventLogEntry
+	15, // 16: org.lfedge.eve.attest.ZAttestQuote.versions:type_name -> org.lfedge.eve.attest.AttestVersionInfo
+	14, // 17: org.lfedge.eve.attest.ZAttestQuote.gps_info:type_name -> org.lfedge.eve.attest.AttestGPSCoordinates
+	17, // 18: org.lfedge.eve.attest.ZAttestQuote.pcr_replay:type_name -> org.lfedge.eve.attest.TpmPCRReplay
+	18, // 19: org.lfedge.eve.attest.ZAttestQuote.measurement_changes:type_name -> org.lfedge.eve.attest.MeasurementChange
+	7,  // 20: org.lfedge.eve.attest.AttestVolumeKey.key_type:type_name -> org.lfedge.eve.attest.AttestVolumeKeyType
+	6,  // 21: org.lfedge.eve.attest.ZAttestQuoteResp.response:type_name -> org.lfedge.eve.attest.ZAttestResponseCode
+	20, // 22: org.lfedge.eve.attest.ZAttestQuoteResp.keys:type_name -> org.lfedge.eve.attest.AttestVolumeKey
+	20, // 23: org.lfedge.eve.attest.AttestStorageKeys.keys:type_name -> org.lfedge.eve.attest.AttestVolumeKey
+	8,  // 24: org.lfedge.eve.attest.AttestStorageKeysResp.response:type_name -> org.lfedge.eve.attest.AttestStorageKeysResponseCode
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_attest_attest_proto_init() }
@@ -1739,7 +2029,7 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZAttestQuote); i {
+			switch v := v.(*TpmPCRReplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,7 +2041,7 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttestVolumeKey); i {
+			switch v := v.(*MeasurementChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,7 +2053,7 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZAttestQuoteResp); i {
+			switch v := v.(*ZAttestQuote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,7 +2065,7 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttestStorageKeys); i {
+			switch v := v.(*AttestVolumeKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,7 +2077,7 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttestStorageKeysResp); i {
+			switch v := v.(*ZAttestQuoteResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1799,6 +2089,30 @@ func file_attest_attest_proto_init() {
 			}
 		}
 		file_attest_attest_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestStorageKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attest_attest_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestStorageKeysResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attest_attest_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AttestVolumeKeyData); i {
 			case 0:
 				return &v.state
@@ -1816,8 +2130,8 @@ func file_attest_attest_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_attest_attest_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   14,
+			NumEnums:      9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
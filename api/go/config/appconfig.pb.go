@@ -226,6 +226,65 @@ type AppInstanceConfig struct {
 	// All changes to the cloud-init config are tracked using this version field -
 	// once the version is changed cloud-init tool restarts in a guest.
 	CloudInitVersion uint32 `protobuf:"varint,21,opt,name=cloud_init_version,json=cloudInitVersion,proto3" json:"cloud_init_version,omitempty"`
+	// snapshot_before_update requests EVE to take a snapshot of the volumes
+	// of the app instance which support snapshots (qcow2 files and zvols)
+	// while the app instance is stopped to be updated, i.e. when it is
+	// restarted or purged with a new version of this config.
+	SnapshotBeforeUpdate bool `protobuf:"varint,22,opt,name=snapshot_before_update,json=snapshotBeforeUpdate,proto3" json:"snapshot_before_update,omitempty"`
+	// rollback_on_boot_failure requests EVE to roll the volumes back to the
+	// snapshots taken before an update and to start the app instance again
+	// if the updated app instance fails to boot.
+	RollbackOnBootFailure bool `protobuf:"varint,23,opt,name=rollback_on_boot_failure,json=rollbackOnBootFailure,proto3" json:"rollback_on_boot_failure,omitempty"`
+	// depends_on_online lists the UUIDs of the app instances which must be
+	// online, i.e. running, before this app instance is started.
+	DependsOnOnline []string `protobuf:"bytes,24,rep,name=depends_on_online,json=dependsOnOnline,proto3" json:"depends_on_online,omitempty"`
+	// depends_on_healthy lists the UUIDs of the app instances which must be
+	// healthy, i.e. running without errors and passing their readiness_probe
+	// if they have one, before this app instance is started.
+	DependsOnHealthy []string `protobuf:"bytes,25,rep,name=depends_on_healthy,json=dependsOnHealthy,proto3" json:"depends_on_healthy,omitempty"`
+	// restart_with_dependencies requests EVE to restart this app instance
+	// when one of the app instances it depends on restarts.
+	RestartWithDependencies bool `protobuf:"varint,26,opt,name=restart_with_dependencies,json=restartWithDependencies,proto3" json:"restart_with_dependencies,omitempty"`
+	// liveness_probe checks that the app instance works, and is run by EVE
+	// from the host once the app instance is running. Its repeated failure
+	// is handled according to the restart_policy. The probe is one of:
+	//   "http://[host]:<port>/<path>" - HTTP GET of the path, which succeeds
+	//                                   with a 2xx or 3xx status code
+	//   "tcp://[host]:<port>"         - TCP connection to the port
+	//   "exec:<command> [<args>]"     - command run inside the container
+	//                                   task, which succeeds with exit status 0
+	//   "agent:"                      - ping of the guest agent of the VM
+	// If the host is omitted, the HTTP and TCP probes connect to the IP
+	// address of the app instance on its first network adapter.
+	// An empty string disables the probe.
+	LivenessProbe string `protobuf:"bytes,27,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	// readiness_probe checks that the app instance is ready to serve. The
+	// app instances which depend on this one being healthy wait until it
+	// succeeds. It has the same format as the liveness_probe.
+	ReadinessProbe string `protobuf:"bytes,28,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
+	// probe_initial_delay_seconds is the time after the boot of the app
+	// instance before its probes are run. Default value 0 -> no delay.
+	ProbeInitialDelaySeconds uint32 `protobuf:"varint,29,opt,name=probe_initial_delay_seconds,json=probeInitialDelaySeconds,proto3" json:"probe_initial_delay_seconds,omitempty"`
+	// probe_period_seconds is the interval between two runs of a probe.
+	// Default value 0 -> 10 seconds.
+	ProbePeriodSeconds uint32 `protobuf:"varint,30,opt,name=probe_period_seconds,json=probePeriodSeconds,proto3" json:"probe_period_seconds,omitempty"`
+	// probe_timeout_seconds is how long a run of a probe may take before it
+	// is considered failed. Default value 0 -> 1 second.
+	ProbeTimeoutSeconds uint32 `protobuf:"varint,31,opt,name=probe_timeout_seconds,json=probeTimeoutSeconds,proto3" json:"probe_timeout_seconds,omitempty"`
+	// probe_failure_threshold is the number of consecutive failures after
+	// which a probe is considered failed. Default value 0 -> 3.
+	ProbeFailureThreshold uint32 `protobuf:"varint,32,opt,name=probe_failure_threshold,json=probeFailureThreshold,proto3" json:"probe_failure_threshold,omitempty"`
+	// restart_policy is what EVE does when the app instance fails:
+	//   "never" or empty - nothing
+	//   "on-failure"     - restart it when its liveness probe fails or when
+	//                      its domain reports an error
+	//   "always"         - also restart it when it halts by itself
+	RestartPolicy string `protobuf:"bytes,33,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	// restart_backoff_max_seconds caps the delay between two automatic
+	// restarts, which doubles with every restart starting from 10 seconds.
+	// The delay is reset once the app instance was not restarted for
+	// 10 minutes. Default value 0 -> 300 seconds.
+	RestartBackoffMaxSeconds uint32 `protobuf:"varint,34,opt,name=restart_backoff_max_seconds,json=restartBackoffMaxSeconds,proto3" json:"restart_backoff_max_seconds,omitempty"`
 }
 
 func (x *AppInstanceConfig) Reset() {
@@ -393,6 +452,97 @@ func (x *AppInstanceConfig) GetCloudInitVersion() uint32 {
 	return 0
 }
 
+func (x *AppInstanceConfig) GetSnapshotBeforeUpdate() bool {
+	if x != nil {
+		return x.SnapshotBeforeUpdate
+	}
+	return false
+}
+
+func (x *AppInstanceConfig) GetRollbackOnBootFailure() bool {
+	if x != nil {
+		return x.RollbackOnBootFailure
+	}
+	return false
+}
+
+func (x *AppInstanceConfig) GetDependsOnOnline() []string {
+	if x != nil {
+		return x.DependsOnOnline
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetDependsOnHealthy() []string {
+	if x != nil {
+		return x.DependsOnHealthy
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetRestartWithDependencies() bool {
+	if x != nil {
+		return x.RestartWithDependencies
+	}
+	return false
+}
+
+func (x *AppInstanceConfig) GetLivenessProbe() string {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return ""
+}
+
+func (x *AppInstanceConfig) GetReadinessProbe() string {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return ""
+}
+
+func (x *AppInstanceConfig) GetProbeInitialDelaySeconds() uint32 {
+	if x != nil {
+		return x.ProbeInitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *AppInstanceConfig) GetProbePeriodSeconds() uint32 {
+	if x != nil {
+		return x.ProbePeriodSeconds
+	}
+	return 0
+}
+
+func (x *AppInstanceConfig) GetProbeTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.ProbeTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *AppInstanceConfig) GetProbeFailureThreshold() uint32 {
+	if x != nil {
+		return x.ProbeFailureThreshold
+	}
+	return 0
+}
+
+func (x *AppInstanceConfig) GetRestartPolicy() string {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return ""
+}
+
+func (x *AppInstanceConfig) GetRestartBackoffMaxSeconds() uint32 {
+	if x != nil {
+		return x.RestartBackoffMaxSeconds
+	}
+	return 0
+}
+
 // Reference to a Volume specified separately in the API
 // If a volume is purged (re-created from scratch) it will either have a new
 // UUID or a new generationCount
@@ -406,6 +556,14 @@ type VolumeRef struct {
 	// Indicates volume mount point inside container
 	// if mount_dir is empty then it will be mounted on /mnt
 	MountDir string `protobuf:"bytes,3,opt,name=mount_dir,json=mountDir,proto3" json:"mount_dir,omitempty"`
+	// Limits of the block I/O of the app instance to the volume, in bytes
+	// and in operations per second. A limit which is not set is unlimited.
+	// The limits apply to the block device the volume is on, hence volumes
+	// of the app instance on the same device share the sum of their limits.
+	ReadBpsLimit   uint64 `protobuf:"varint,4,opt,name=read_bps_limit,json=readBpsLimit,proto3" json:"read_bps_limit,omitempty"`
+	WriteBpsLimit  uint64 `protobuf:"varint,5,opt,name=write_bps_limit,json=writeBpsLimit,proto3" json:"write_bps_limit,omitempty"`
+	ReadIopsLimit  uint64 `protobuf:"varint,6,opt,name=read_iops_limit,json=readIopsLimit,proto3" json:"read_iops_limit,omitempty"`
+	WriteIopsLimit uint64 `protobuf:"varint,7,opt,name=write_iops_limit,json=writeIopsLimit,proto3" json:"write_iops_limit,omitempty"`
 }
 
 func (x *VolumeRef) Reset() {
@@ -461,6 +619,34 @@ func (x *VolumeRef) GetMountDir() string {
 	return ""
 }
 
+func (x *VolumeRef) GetReadBpsLimit() uint64 {
+	if x != nil {
+		return x.ReadBpsLimit
+	}
+	return 0
+}
+
+func (x *VolumeRef) GetWriteBpsLimit() uint64 {
+	if x != nil {
+		return x.WriteBpsLimit
+	}
+	return 0
+}
+
+func (x *VolumeRef) GetReadIopsLimit() uint64 {
+	if x != nil {
+		return x.ReadIopsLimit
+	}
+	return 0
+}
+
+func (x *VolumeRef) GetWriteIopsLimit() uint64 {
+	if x != nil {
+		return x.WriteIopsLimit
+	}
+	return 0
+}
+
 var File_config_appconfig_proto protoreflect.FileDescriptor
 
 var file_config_appconfig_proto_rawDesc = []byte{
@@ -478,7 +664,7 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x6d, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
 	0x6f, 0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x87, 0x08, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9f, 0x0d, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e,
 	0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
@@ -543,24 +729,75 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x69, 0x6e, 0x69, 0x74,
 	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
 	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a,
-	0x11, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03,
-	0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x34, 0x0a, 0x16, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x4f, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73,
+	0x4f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x1b, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61,
+	0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x09, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69,
+	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x42,
+	0x70, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x70, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6f, 0x70, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6f,
+	0x70, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x69, 0x6f, 0x70, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6f, 0x70, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a, 0x0a,
+	0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
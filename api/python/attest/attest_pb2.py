@@ -21,7 +21,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.attestZ$github.com/lf-edge/eve/api/go/attest',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x13\x61ttest/attest.proto\x12\x15org.lfedge.eve.attest\x1a\x11\x63\x65rts/certs.proto\"\xe4\x01\n\nZAttestReq\x12\x36\n\x07reqType\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.attest.ZAttestReqType\x12\x32\n\x05quote\x18\x02 \x01(\x0b\x32#.org.lfedge.eve.attest.ZAttestQuote\x12*\n\x05\x63\x65rts\x18\x03 \x03(\x0b\x32\x1b.org.lfedge.eve.certs.ZCert\x12>\n\x0cstorage_keys\x18\x04 \x01(\x0b\x32(.org.lfedge.eve.attest.AttestStorageKeys\"\x88\x02\n\x0fZAttestResponse\x12\x38\n\x08respType\x18\x01 \x01(\x0e\x32&.org.lfedge.eve.attest.ZAttestRespType\x12\x36\n\x05nonce\x18\x02 \x01(\x0b\x32\'.org.lfedge.eve.attest.ZAttestNonceResp\x12:\n\tquoteResp\x18\x03 \x01(\x0b\x32\'.org.lfedge.eve.attest.ZAttestQuoteResp\x12G\n\x11storage_keys_resp\x18\x04 \x01(\x0b\x32,.org.lfedge.eve.attest.AttestStorageKeysResp\"!\n\x10ZAttestNonceResp\x12\r\n\x05nonce\x18\x01 \x01(\x0c\"W\n\x0eTpmEventDigest\x12\x35\n\thash_algo\x18\x01 \x01(\x0e\x32\".org.lfedge.eve.attest.TpmHashAlgo\x12\x0e\n\x06\x64igest\x18\x02 \x01(\x0c\"\xd0\x01\n\x10TpmEventLogEntry\x12\r\n\x05index\x18\x01 \x01(\r\x12\x11\n\tpcr_index\x18\x02 \x01(\r\x12\x12\n\nevent_type\x18\x03 \x01(\r\x12\x35\n\x06\x64igest\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.attest.TpmEventDigest\x12\x19\n\x11\x65vent_data_binary\x18\x05 \x01(\x0c\x12\x19\n\x11\x65vent_data_string\x18\x06 \x01(\t\x12\x19\n\x11\x65vent_binary_size\x18\x07 \x01(\r\"u\n\x14\x41ttestGPSCoordinates\x12\x38\n\tgps_input\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.attest.AttestGPSInput\x12\x10\n\x08latitude\x18\x02 \x01(\x01\x12\x11\n\tlongitude\x18\x03 \x01(\x01\"d\n\x11\x41ttestVersionInfo\x12>\n\x0cversion_type\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.attest.AttestVersionType\x12\x0f\n\x07version\x18\x02 \x01(\t\"b\n\x0bTpmPCRValue\x12\r\n\x05index\x18\x01 \x01(\r\x12\x35\n\thash_algo\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.attest.TpmHashAlgo\x12\r\n\x05value\x18\x03 \x01(\x0c\"\xa4\x02\n\x0cZAttestQuote\x12\x12\n\nattestData\x18\x01 \x01(\x0c\x12\x11\n\tsignature\x18\x02 \x01(\x0c\x12\x36\n\npcr_values\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.attest.TpmPCRValue\x12:\n\tevent_log\x18\x04 \x03(\x0b\x32\'.org.lfedge.eve.attest.TpmEventLogEntry\x12:\n\x08versions\x18\x05 \x03(\x0b\x32(.org.lfedge.eve.attest.AttestVersionInfo\x12=\n\x08gps_info\x18\x06 \x01(\x0b\x32+.org.lfedge.eve.attest.AttestGPSCoordinates\"\\\n\x0f\x41ttestVolumeKey\x12<\n\x08key_type\x18\x01 \x01(\x0e\x32*.org.lfedge.eve.attest.AttestVolumeKeyType\x12\x0b\n\x03key\x18\x02 \x01(\x0c\"\x9f\x01\n\x10ZAttestQuoteResp\x12<\n\x08response\x18\x01 \x01(\x0e\x32*.org.lfedge.eve.attest.ZAttestResponseCode\x12\x17\n\x0fintegrity_token\x18\x02 \x01(\x0c\x12\x34\n\x04keys\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.attest.AttestVolumeKey\"b\n\x11\x41ttestStorageKeys\x12\x17\n\x0fintegrity_token\x18\x01 \x01(\x0c\x12\x34\n\x04keys\x18\x02 \x03(\x0b\x32&.org.lfedge.eve.attest.AttestVolumeKey\"_\n\x15\x41ttestStorageKeysResp\x12\x46\n\x08response\x18\x01 \x01(\x0e\x32\x34.org.lfedge.eve.attest.AttestStorageKeysResponseCode\"C\n\x13\x41ttestVolumeKeyData\x12\x15\n\rencrypted_key\x18\x01 \x01(\x0c\x12\x15\n\rdigest_sha256\x18\x02 \x01(\x0c*\x88\x01\n\x0eZAttestReqType\x12\x13\n\x0f\x41TTEST_REQ_NONE\x10\x00\x12\x13\n\x0f\x41TTEST_REQ_CERT\x10\x01\x12\x14\n\x10\x41TTEST_REQ_NONCE\x10\x02\x12\x14\n\x10\x41TTEST_REQ_QUOTE\x10\x03\x12 \n\x1cZ_ATTEST_REQ_TYPE_STORE_KEYS\x10\x04*\x93\x01\n\x0fZAttestRespType\x12\x14\n\x10\x41TTEST_RESP_NONE\x10\x00\x12\x14\n\x10\x41TTEST_RESP_CERT\x10\x01\x12\x15\n\x11\x41TTEST_RESP_NONCE\x10\x02\x12\x1a\n\x16\x41TTEST_RESP_QUOTE_RESP\x10\x03\x12!\n\x1dZ_ATTEST_RESP_TYPE_STORE_KEYS\x10\x04*t\n\x0bTpmHashAlgo\x12\x19\n\x15TPM_HASH_ALGO_INVALID\x10\x00\x12\x16\n\x12TPM_HASH_ALGO_SHA1\x10\x01\x12\x18\n\x14TPM_HASH_ALGO_SHA256\x10\x02\x12\x18\n\x14TPM_HASH_ALGO_SHA512\x10\x03*i\n\x0e\x41ttestGPSInput\x12\x1c\n\x18\x41TTEST_GPS_INPUT_INVALID\x10\x00\x12\x1c\n\x18\x41TTEST_GPS_INPUT_PRESENT\x10\x01\x12\x1b\n\x17\x41TTEST_GPS_INPUT_ABSENT\x10\x02*s\n\x11\x41ttestVersionType\x12\x1f\n\x1b\x41TTEST_VERSION_TYPE_INVALID\x10\x00\x12\x1b\n\x17\x41TTEST_VERSION_TYPE_EVE\x10\x01\x12 \n\x1c\x41TTEST_VERSION_TYPE_FIRMWARE\x10\x02*\xdb\x01\n\x13ZAttestResponseCode\x12\"\n\x1eZ_ATTEST_RESPONSE_CODE_INVALID\x10\x00\x12\"\n\x1eZ_ATTEST_RESPONSE_CODE_SUCCESS\x10\x01\x12)\n%Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH\x10\x02\x12(\n$Z_ATTEST_RESPONSE_CODE_NO_CERT_FOUND\x10\x03\x12\'\n#Z_ATTEST_RESPONSE_CODE_QUOTE_FAILED\x10\x04*Y\n\x13\x41ttestVolumeKeyType\x12\"\n\x1e\x41TTEST_VOLUME_KEY_TYPE_INVALID\x10\x00\x12\x1e\n\x1a\x41TTEST_VOLUME_KEY_TYPE_VSK\x10\x01*\xb4\x01\n\x1d\x41ttestStorageKeysResponseCode\x12-\n)ATTEST_STORAGE_KEYS_RESPONSE_CODE_INVALID\x10\x00\x12-\n)ATTEST_STORAGE_KEYS_RESPONSE_CODE_SUCCESS\x10\x01\x12\x35\n1ATTEST_STORAGE_KEYS_RESPONSE_CODE_ITOKEN_MISMATCH\x10\x02\x42=\n\x15org.lfedge.eve.attestZ$github.com/lf-edge/eve/api/go/attestb\x06proto3'
+  serialized_pb=b'\n\x13\x61ttest/attest.proto\x12\x15org.lfedge.eve.attest\x1a\x11\x63\x65rts/certs.proto\"\xe4\x01\n\nZAttestReq\x12\x36\n\x07reqType\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.attest.ZAttestReqType\x12\x32\n\x05quote\x18\x02 \x01(\x0b\x32#.org.lfedge.eve.attest.ZAttestQuote\x12*\n\x05\x63\x65rts\x18\x03 \x03(\x0b\x32\x1b.org.lfedge.eve.certs.ZCert\x12>\n\x0cstorage_keys\x18\x04 \x01(\x0b\x32(.org.lfedge.eve.attest.AttestStorageKeys\"\x88\x02\n\x0fZAttestResponse\x12\x38\n\x08respType\x18\x01 \x01(\x0e\x32&.org.lfedge.eve.attest.ZAttestRespType\x12\x36\n\x05nonce\x18\x02 \x01(\x0b\x32\'.org.lfedge.eve.attest.ZAttestNonceResp\x12:\n\tquoteResp\x18\x03 \x01(\x0b\x32\'.org.lfedge.eve.attest.ZAttestQuoteResp\x12G\n\x11storage_keys_resp\x18\x04 \x01(\x0b\x32,.org.lfedge.eve.attest.AttestStorageKeysResp\"!\n\x10ZAttestNonceResp\x12\r\n\x05nonce\x18\x01 \x01(\x0c\"W\n\x0eTpmEventDigest\x12\x35\n\thash_algo\x18\x01 \x01(\x0e\x32\".org.lfedge.eve.attest.TpmHashAlgo\x12\x0e\n\x06\x64igest\x18\x02 \x01(\x0c\"\xd0\x01\n\x10TpmEventLogEntry\x12\r\n\x05index\x18\x01 \x01(\r\x12\x11\n\tpcr_index\x18\x02 \x01(\r\x12\x12\n\nevent_type\x18\x03 \x01(\r\x12\x35\n\x06\x64igest\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.attest.TpmEventDigest\x12\x19\n\x11\x65vent_data_binary\x18\x05 \x01(\x0c\x12\x19\n\x11\x65vent_data_string\x18\x06 \x01(\t\x12\x19\n\x11\x65vent_binary_size\x18\x07 \x01(\r\"u\n\x14\x41ttestGPSCoordinates\x12\x38\n\tgps_input\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.attest.AttestGPSInput\x12\x10\n\x08latitude\x18\x02 \x01(\x01\x12\x11\n\tlongitude\x18\x03 \x01(\x01\"d\n\x11\x41ttestVersionInfo\x12>\n\x0cversion_type\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.attest.AttestVersionType\x12\x0f\n\x07version\x18\x02 \x01(\t\"b\n\x0bTpmPCRValue\x12\r\n\x05index\x18\x01 \x01(\r\x12\x35\n\thash_algo\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.attest.TpmHashAlgo\x12\r\n\x05value\x18\x03 \x01(\x0c\"L\n\x0cTpmPCRReplay\x12\r\n\x05index\x18\x01 \x01(\r\x12\x16\n\x0ereplayed_value\x18\x02 \x01(\x0c\x12\x15\n\rmatches_quote\x18\x03 \x01(\x08\"\xdc\x01\n\x11MeasurementChange\x12\x41\n\x0b\x63hange_type\x18\x01 \x01(\x0e\x32,.org.lfedge.eve.attest.MeasurementChangeType\x12\x11\n\tcomponent\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x11\n\tpcr_index\x18\x04 \x01(\r\x12\x17\n\x0fprevious_digest\x18\x05 \x01(\x0c\x12\x0e\n\x06\x64igest\x18\x06 \x01(\x0c\x12\x17\n\x0fprevious_detail\x18\x07 \x01(\t\x12\x0e\n\x06\x64\x65tail\x18\x08 \x01(\t\"\xa4\x03\n\x0cZAttestQuote\x12\x12\n\nattestData\x18\x01 \x01(\x0c\x12\x11\n\tsignature\x18\x02 \x01(\x0c\x12\x36\n\npcr_values\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.attest.TpmPCRValue\x12:\n\tevent_log\x18\x04 \x03(\x0b\x32\'.org.lfedge.eve.attest.TpmEventLogEntry\x12:\n\x08versions\x18\x05 \x03(\x0b\x32(.org.lfedge.eve.attest.AttestVersionInfo\x12=\n\x08gps_info\x18\x06 \x01(\x0b\x32+.org.lfedge.eve.attest.AttestGPSCoordinates\x12\x37\n\npcr_replay\x18\x07 \x03(\x0b\x32#.org.lfedge.eve.attest.TpmPCRReplay\x12\x45\n\x13measurement_changes\x18\x08 \x03(\x0b\x32(.org.lfedge.eve.attest.MeasurementChange\"\\\n\x0f\x41ttestVolumeKey\x12<\n\x08key_type\x18\x01 \x01(\x0e\x32*.org.lfedge.eve.attest.AttestVolumeKeyType\x12\x0b\n\x03key\x18\x02 \x01(\x0c\"\x9f\x01\n\x10ZAttestQuoteResp\x12<\n\x08response\x18\x01 \x01(\x0e\x32*.org.lfedge.eve.attest.ZAttestResponseCode\x12\x17\n\x0fintegrity_token\x18\x02 \x01(\x0c\x12\x34\n\x04keys\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.attest.AttestVolumeKey\"b\n\x11\x41ttestStorageKeys\x12\x17\n\x0fintegrity_token\x18\x01 \x01(\x0c\x12\x34\n\x04keys\x18\x02 \x03(\x0b\x32&.org.lfedge.eve.attest.AttestVolumeKey\"_\n\x15\x41ttestStorageKeysResp\x12\x46\n\x08response\x18\x01 \x01(\x0e\x32\x34.org.lfedge.eve.attest.AttestStorageKeysResponseCode\"C\n\x13\x41ttestVolumeKeyData\x12\x15\n\rencrypted_key\x18\x01 \x01(\x0c\x12\x15\n\rdigest_sha256\x18\x02 \x01(\x0c*\x88\x01\n\x0eZAttestReqType\x12\x13\n\x0f\x41TTEST_REQ_NONE\x10\x00\x12\x13\n\x0f\x41TTEST_REQ_CERT\x10\x01\x12\x14\n\x10\x41TTEST_REQ_NONCE\x10\x02\x12\x14\n\x10\x41TTEST_REQ_QUOTE\x10\x03\x12 \n\x1cZ_ATTEST_REQ_TYPE_STORE_KEYS\x10\x04*\x93\x01\n\x0fZAttestRespType\x12\x14\n\x10\x41TTEST_RESP_NONE\x10\x00\x12\x14\n\x10\x41TTEST_RESP_CERT\x10\x01\x12\x15\n\x11\x41TTEST_RESP_NONCE\x10\x02\x12\x1a\n\x16\x41TTEST_RESP_QUOTE_RESP\x10\x03\x12!\n\x1dZ_ATTEST_RESP_TYPE_STORE_KEYS\x10\x04*t\n\x0bTpmHashAlgo\x12\x19\n\x15TPM_HASH_ALGO_INVALID\x10\x00\x12\x16\n\x12TPM_HASH_ALGO_SHA1\x10\x01\x12\x18\n\x14TPM_HASH_ALGO_SHA256\x10\x02\x12\x18\n\x14TPM_HASH_ALGO_SHA512\x10\x03*i\n\x0e\x41ttestGPSInput\x12\x1c\n\x18\x41TTEST_GPS_INPUT_INVALID\x10\x00\x12\x1c\n\x18\x41TTEST_GPS_INPUT_PRESENT\x10\x01\x12\x1b\n\x17\x41TTEST_GPS_INPUT_ABSENT\x10\x02*s\n\x11\x41ttestVersionType\x12\x1f\n\x1b\x41TTEST_VERSION_TYPE_INVALID\x10\x00\x12\x1b\n\x17\x41TTEST_VERSION_TYPE_EVE\x10\x01\x12 \n\x1c\x41TTEST_VERSION_TYPE_FIRMWARE\x10\x02*\xaa\x01\n\x15MeasurementChangeType\x12#\n\x1fMEASUREMENT_CHANGE_TYPE_INVALID\x10\x00\x12!\n\x1dMEASUREMENT_CHANGE_TYPE_ADDED\x10\x01\x12#\n\x1fMEASUREMENT_CHANGE_TYPE_REMOVED\x10\x02\x12$\n MEASUREMENT_CHANGE_TYPE_MODIFIED\x10\x03*\xdb\x01\n\x13ZAttestResponseCode\x12\"\n\x1eZ_ATTEST_RESPONSE_CODE_INVALID\x10\x00\x12\"\n\x1eZ_ATTEST_RESPONSE_CODE_SUCCESS\x10\x01\x12)\n%Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH\x10\x02\x12(\n$Z_ATTEST_RESPONSE_CODE_NO_CERT_FOUND\x10\x03\x12\'\n#Z_ATTEST_RESPONSE_CODE_QUOTE_FAILED\x10\x04*Y\n\x13\x41ttestVolumeKeyType\x12\"\n\x1e\x41TTEST_VOLUME_KEY_TYPE_INVALID\x10\x00\x12\x1e\n\x1a\x41TTEST_VOLUME_KEY_TYPE_VSK\x10\x01*\xb4\x01\n\x1d\x41ttestStorageKeysResponseCode\x12-\n)ATTEST_STORAGE_KEYS_RESPONSE_CODE_INVALID\x10\x00\x12-\n)ATTEST_STORAGE_KEYS_RESPONSE_CODE_SUCCESS\x10\x01\x12\x35\n1ATTEST_STORAGE_KEYS_RESPONSE_CODE_ITOKEN_MISMATCH\x10\x02\x42=\n\x15org.lfedge.eve.attestZ$github.com/lf-edge/eve/api/go/attestb\x06proto3'
   ,
   dependencies=[certs_dot_certs__pb2.DESCRIPTOR,])
 
@@ -60,8 +60,8 @@ _ZATTESTREQTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2037,
-  serialized_end=2173,
+  serialized_start=2466,
+  serialized_end=2602,
 )
 _sym_db.RegisterEnumDescriptor(_ZATTESTREQTYPE)
 
@@ -101,8 +101,8 @@ _ZATTESTRESPTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2176,
-  serialized_end=2323,
+  serialized_start=2605,
+  serialized_end=2752,
 )
 _sym_db.RegisterEnumDescriptor(_ZATTESTRESPTYPE)
 
@@ -137,8 +137,8 @@ _TPMHASHALGO = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2325,
-  serialized_end=2441,
+  serialized_start=2754,
+  serialized_end=2870,
 )
 _sym_db.RegisterEnumDescriptor(_TPMHASHALGO)
 
@@ -168,8 +168,8 @@ _ATTESTGPSINPUT = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2443,
-  serialized_end=2548,
+  serialized_start=2872,
+  serialized_end=2977,
 )
 _sym_db.RegisterEnumDescriptor(_ATTESTGPSINPUT)
 
@@ -199,12 +199,48 @@ _ATTESTVERSIONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2550,
-  serialized_end=2665,
+  serialized_start=2979,
+  serialized_end=3094,
 )
 _sym_db.RegisterEnumDescriptor(_ATTESTVERSIONTYPE)
 
 AttestVersionType = enum_type_wrapper.EnumTypeWrapper(_ATTESTVERSIONTYPE)
+_MEASUREMENTCHANGETYPE = _descriptor.EnumDescriptor(
+  name='MeasurementChangeType',
+  full_name='org.lfedge.eve.attest.MeasurementChangeType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='MEASUREMENT_CHANGE_TYPE_INVALID', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MEASUREMENT_CHANGE_TYPE_ADDED', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MEASUREMENT_CHANGE_TYPE_REMOVED', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MEASUREMENT_CHANGE_TYPE_MODIFIED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3097,
+  serialized_end=3267,
+)
+_sym_db.RegisterEnumDescriptor(_MEASUREMENTCHANGETYPE)
+
+MeasurementChangeType = enum_type_wrapper.EnumTypeWrapper(_MEASUREMENTCHANGETYPE)
 _ZATTESTRESPONSECODE = _descriptor.EnumDescriptor(
   name='ZAttestResponseCode',
   full_name='org.lfedge.eve.attest.ZAttestResponseCode',
@@ -240,8 +276,8 @@ _ZATTESTRESPONSECODE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2668,
-  serialized_end=2887,
+  serialized_start=3270,
+  serialized_end=3489,
 )
 _sym_db.RegisterEnumDescriptor(_ZATTESTRESPONSECODE)
 
@@ -266,8 +302,8 @@ _ATTESTVOLUMEKEYTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2889,
-  serialized_end=2978,
+  serialized_start=3491,
+  serialized_end=3580,
 )
 _sym_db.RegisterEnumDescriptor(_ATTESTVOLUMEKEYTYPE)
 
@@ -297,8 +333,8 @@ _ATTESTSTORAGEKEYSRESPONSECODE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2981,
-  serialized_end=3161,
+  serialized_start=3583,
+  serialized_end=3763,
 )
 _sym_db.RegisterEnumDescriptor(_ATTESTSTORAGEKEYSRESPONSECODE)
 
@@ -323,6 +359,10 @@ ATTEST_GPS_INPUT_ABSENT = 2
 ATTEST_VERSION_TYPE_INVALID = 0
 ATTEST_VERSION_TYPE_EVE = 1
 ATTEST_VERSION_TYPE_FIRMWARE = 2
+MEASUREMENT_CHANGE_TYPE_INVALID = 0
+MEASUREMENT_CHANGE_TYPE_ADDED = 1
+MEASUREMENT_CHANGE_TYPE_REMOVED = 2
+MEASUREMENT_CHANGE_TYPE_MODIFIED = 3
 Z_ATTEST_RESPONSE_CODE_INVALID = 0
 Z_ATTEST_RESPONSE_CODE_SUCCESS = 1
 Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH = 2
@@ -718,6 +758,133 @@ _TPMPCRVALUE = _descriptor.Descriptor(
 )
 
 
+_TPMPCRREPLAY = _descriptor.Descriptor(
+  name='TpmPCRReplay',
+  full_name='org.lfedge.eve.attest.TpmPCRReplay',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='index', full_name='org.lfedge.eve.attest.TpmPCRReplay.index', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replayed_value', full_name='org.lfedge.eve.attest.TpmPCRReplay.replayed_value', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='matches_quote', full_name='org.lfedge.eve.attest.TpmPCRReplay.matches_quote', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1219,
+  serialized_end=1295,
+)
+
+
+_MEASUREMENTCHANGE = _descriptor.Descriptor(
+  name='MeasurementChange',
+  full_name='org.lfedge.eve.attest.MeasurementChange',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='change_type', full_name='org.lfedge.eve.attest.MeasurementChange.change_type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='component', full_name='org.lfedge.eve.attest.MeasurementChange.component', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.attest.MeasurementChange.name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pcr_index', full_name='org.lfedge.eve.attest.MeasurementChange.pcr_index', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='previous_digest', full_name='org.lfedge.eve.attest.MeasurementChange.previous_digest', index=4,
+      number=5, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='digest', full_name='org.lfedge.eve.attest.MeasurementChange.digest', index=5,
+      number=6, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='previous_detail', full_name='org.lfedge.eve.attest.MeasurementChange.previous_detail', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='detail', full_name='org.lfedge.eve.attest.MeasurementChange.detail', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1298,
+  serialized_end=1518,
+)
+
+
 _ZATTESTQUOTE = _descriptor.Descriptor(
   name='ZAttestQuote',
   full_name='org.lfedge.eve.attest.ZAttestQuote',
@@ -768,6 +935,20 @@ _ZATTESTQUOTE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pcr_replay', full_name='org.lfedge.eve.attest.ZAttestQuote.pcr_replay', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='measurement_changes', full_name='org.lfedge.eve.attest.ZAttestQuote.measurement_changes', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -780,8 +961,8 @@ _ZATTESTQUOTE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1220,
-  serialized_end=1512,
+  serialized_start=1521,
+  serialized_end=1941,
 )
 
 
@@ -819,8 +1000,8 @@ _ATTESTVOLUMEKEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1514,
-  serialized_end=1606,
+  serialized_start=1943,
+  serialized_end=2035,
 )
 
 
@@ -865,8 +1046,8 @@ _ZATTESTQUOTERESP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1609,
-  serialized_end=1768,
+  serialized_start=2038,
+  serialized_end=2197,
 )
 
 
@@ -904,8 +1085,8 @@ _ATTESTSTORAGEKEYS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1770,
-  serialized_end=1868,
+  serialized_start=2199,
+  serialized_end=2297,
 )
 
 
@@ -936,8 +1117,8 @@ _ATTESTSTORAGEKEYSRESP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1870,
-  serialized_end=1965,
+  serialized_start=2299,
+  serialized_end=2394,
 )
 
 
@@ -975,8 +1156,8 @@ _ATTESTVOLUMEKEYDATA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1967,
-  serialized_end=2034,
+  serialized_start=2396,
+  serialized_end=2463,
 )
 
 _ZATTESTREQ.fields_by_name['reqType'].enum_type = _ZATTESTREQTYPE
@@ -992,10 +1173,13 @@ _TPMEVENTLOGENTRY.fields_by_name['digest'].message_type = _TPMEVENTDIGEST
 _ATTESTGPSCOORDINATES.fields_by_name['gps_input'].enum_type = _ATTESTGPSINPUT
 _ATTESTVERSIONINFO.fields_by_name['version_type'].enum_type = _ATTESTVERSIONTYPE
 _TPMPCRVALUE.fields_by_name['hash_algo'].enum_type = _TPMHASHALGO
+_MEASUREMENTCHANGE.fields_by_name['change_type'].enum_type = _MEASUREMENTCHANGETYPE
 _ZATTESTQUOTE.fields_by_name['pcr_values'].message_type = _TPMPCRVALUE
 _ZATTESTQUOTE.fields_by_name['event_log'].message_type = _TPMEVENTLOGENTRY
 _ZATTESTQUOTE.fields_by_name['versions'].message_type = _ATTESTVERSIONINFO
 _ZATTESTQUOTE.fields_by_name['gps_info'].message_type = _ATTESTGPSCOORDINATES
+_ZATTESTQUOTE.fields_by_name['pcr_replay'].message_type = _TPMPCRREPLAY
+_ZATTESTQUOTE.fields_by_name['measurement_changes'].message_type = _MEASUREMENTCHANGE
 _ATTESTVOLUMEKEY.fields_by_name['key_type'].enum_type = _ATTESTVOLUMEKEYTYPE
 _ZATTESTQUOTERESP.fields_by_name['response'].enum_type = _ZATTESTRESPONSECODE
 _ZATTESTQUOTERESP.fields_by_name['keys'].message_type = _ATTESTVOLUMEKEY
@@ -1009,6 +1193,8 @@ DESCRIPTOR.message_types_by_name['TpmEventLogEntry'] = _TPMEVENTLOGENTRY
 DESCRIPTOR.message_types_by_name['AttestGPSCoordinates'] = _ATTESTGPSCOORDINATES
 DESCRIPTOR.message_types_by_name['AttestVersionInfo'] = _ATTESTVERSIONINFO
 DESCRIPTOR.message_types_by_name['TpmPCRValue'] = _TPMPCRVALUE
+DESCRIPTOR.message_types_by_name['TpmPCRReplay'] = _TPMPCRREPLAY
+DESCRIPTOR.message_types_by_name['MeasurementChange'] = _MEASUREMENTCHANGE
 DESCRIPTOR.message_types_by_name['ZAttestQuote'] = _ZATTESTQUOTE
 DESCRIPTOR.message_types_by_name['AttestVolumeKey'] = _ATTESTVOLUMEKEY
 DESCRIPTOR.message_types_by_name['ZAttestQuoteResp'] = _ZATTESTQUOTERESP
@@ -1020,6 +1206,7 @@ DESCRIPTOR.enum_types_by_name['ZAttestRespType'] = _ZATTESTRESPTYPE
 DESCRIPTOR.enum_types_by_name['TpmHashAlgo'] = _TPMHASHALGO
 DESCRIPTOR.enum_types_by_name['AttestGPSInput'] = _ATTESTGPSINPUT
 DESCRIPTOR.enum_types_by_name['AttestVersionType'] = _ATTESTVERSIONTYPE
+DESCRIPTOR.enum_types_by_name['MeasurementChangeType'] = _MEASUREMENTCHANGETYPE
 DESCRIPTOR.enum_types_by_name['ZAttestResponseCode'] = _ZATTESTRESPONSECODE
 DESCRIPTOR.enum_types_by_name['AttestVolumeKeyType'] = _ATTESTVOLUMEKEYTYPE
 DESCRIPTOR.enum_types_by_name['AttestStorageKeysResponseCode'] = _ATTESTSTORAGEKEYSRESPONSECODE
@@ -1081,6 +1268,20 @@ TpmPCRValue = _reflection.GeneratedProtocolMessageType('TpmPCRValue', (_message.
   })
 _sym_db.RegisterMessage(TpmPCRValue)
 
+TpmPCRReplay = _reflection.GeneratedProtocolMessageType('TpmPCRReplay', (_message.Message,), {
+  'DESCRIPTOR' : _TPMPCRREPLAY,
+  '__module__' : 'attest.attest_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.attest.TpmPCRReplay)
+  })
+_sym_db.RegisterMessage(TpmPCRReplay)
+
+MeasurementChange = _reflection.GeneratedProtocolMessageType('MeasurementChange', (_message.Message,), {
+  'DESCRIPTOR' : _MEASUREMENTCHANGE,
+  '__module__' : 'attest.attest_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.attest.MeasurementChange)
+  })
+_sym_db.RegisterMessage(MeasurementChange)
+
 ZAttestQuote = _reflection.GeneratedProtocolMessageType('ZAttestQuote', (_message.Message,), {
   'DESCRIPTOR' : _ZATTESTQUOTE,
   '__module__' : 'attest.attest_pb2'
@@ -22,7 +22,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xf9\x01\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"y\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xb1\x02\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\x12\x36\n\x07shaping\x18* \x01(\x0b\x32%.org.lfedge.eve.config.TrafficShaping\"}\n\x0eTrafficShaping\x12\x34\n\x06\x65gress\x18\x01 \x01(\x0b\x32$.org.lfedge.eve.config.TrafficShaper\x12\x35\n\x07ingress\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.config.TrafficShaper\"l\n\rTrafficShaper\x12\x10\n\x08rate_bps\x18\x01 \x01(\x04\x12\x13\n\x0b\x62urst_bytes\x18\x02 \x01(\r\x12\x34\n\x07\x63lasses\x18\x03 \x03(\x0b\x32#.org.lfedge.eve.config.TrafficClass\"v\n\x0cTrafficClass\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08priority\x18\x02 \x01(\r\x12\x10\n\x08rate_bps\x18\x03 \x01(\x04\x12\x10\n\x08\x63\x65il_bps\x18\x04 \x01(\x04\x12\x0c\n\x04\x64scp\x18\x05 \x03(\r\x12\x14\n\x0cremote_ports\x18\x06 \x03(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"\xba\x01\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\x12?\n\x0csim_profiles\x18\x04 \x03(\x0b\x32).org.lfedge.eve.config.CellularSimProfile\"M\n\x12\x43\x65llularSimProfile\x12\x10\n\x08sim_slot\x18\x01 \x01(\r\x12\x0b\n\x03\x61pn\x18\x02 \x01(\t\x12\x18\n\x10monthly_data_cap\x18\x03 \x01(\x04\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_fw__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
 
@@ -181,6 +181,13 @@ _NETWORKADAPTER = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='shaping', full_name='org.lfedge.eve.config.NetworkAdapter.shaping', index=11,
+      number=42, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -194,7 +201,159 @@ _NETWORKADAPTER = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=404,
-  serialized_end=653,
+  serialized_end=709,
+)
+
+
+_TRAFFICSHAPING = _descriptor.Descriptor(
+  name='TrafficShaping',
+  full_name='org.lfedge.eve.config.TrafficShaping',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='egress', full_name='org.lfedge.eve.config.TrafficShaping.egress', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ingress', full_name='org.lfedge.eve.config.TrafficShaping.ingress', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=711,
+  serialized_end=836,
+)
+
+
+_TRAFFICSHAPER = _descriptor.Descriptor(
+  name='TrafficShaper',
+  full_name='org.lfedge.eve.config.TrafficShaper',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='rate_bps', full_name='org.lfedge.eve.config.TrafficShaper.rate_bps', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='burst_bytes', full_name='org.lfedge.eve.config.TrafficShaper.burst_bytes', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='classes', full_name='org.lfedge.eve.config.TrafficShaper.classes', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=838,
+  serialized_end=946,
+)
+
+
+_TRAFFICCLASS = _descriptor.Descriptor(
+  name='TrafficClass',
+  full_name='org.lfedge.eve.config.TrafficClass',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.TrafficClass.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='priority', full_name='org.lfedge.eve.config.TrafficClass.priority', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rate_bps', full_name='org.lfedge.eve.config.TrafficClass.rate_bps', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ceil_bps', full_name='org.lfedge.eve.config.TrafficClass.ceil_bps', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dscp', full_name='org.lfedge.eve.config.TrafficClass.dscp', index=4,
+      number=5, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='remote_ports', full_name='org.lfedge.eve.config.TrafficClass.remote_ports', index=5,
+      number=6, type=13, cpp_type=3, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=948,
+  serialized_end=1066,
 )
 
 
@@ -239,8 +398,8 @@ _WIRELESSCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=656,
-  serialized_end=835,
+  serialized_start=1069,
+  serialized_end=1248,
 )
 
 
@@ -273,6 +432,13 @@ _CELLULARCONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sim_profiles', full_name='org.lfedge.eve.config.CellularConfig.sim_profiles', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -285,8 +451,54 @@ _CELLULARCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=837,
-  serialized_end=958,
+  serialized_start=1251,
+  serialized_end=1437,
+)
+
+
+_CELLULARSIMPROFILE = _descriptor.Descriptor(
+  name='CellularSimProfile',
+  full_name='org.lfedge.eve.config.CellularSimProfile',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sim_slot', full_name='org.lfedge.eve.config.CellularSimProfile.sim_slot', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='apn', full_name='org.lfedge.eve.config.CellularSimProfile.apn', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='monthly_data_cap', full_name='org.lfedge.eve.config.CellularSimProfile.monthly_data_cap', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1439,
+  serialized_end=1516,
 )
 
 
@@ -324,8 +536,8 @@ _CELLULARCONNECTIVITYPROBE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=960,
-  serialized_end=1027,
+  serialized_start=1518,
+  serialized_end=1585,
 )
 
 
@@ -363,8 +575,8 @@ _WIFICONFIG_CRYPTOBLOCK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1292,
-  serialized_end=1341,
+  serialized_start=1850,
+  serialized_end=1899,
 )
 
 _WIFICONFIG = _descriptor.Descriptor(
@@ -436,8 +648,8 @@ _WIFICONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1030,
-  serialized_end=1341,
+  serialized_start=1588,
+  serialized_end=1899,
 )
 
 _NETWORKCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._NETWORKTYPE
@@ -446,18 +658,27 @@ _NETWORKCONFIG.fields_by_name['dns'].message_type = config_dot_netcmn__pb2._ZNET
 _NETWORKCONFIG.fields_by_name['entProxy'].message_type = config_dot_netcmn__pb2._PROXYCONFIG
 _NETWORKCONFIG.fields_by_name['wireless'].message_type = _WIRELESSCONFIG
 _NETWORKADAPTER.fields_by_name['acls'].message_type = config_dot_fw__pb2._ACE
+_NETWORKADAPTER.fields_by_name['shaping'].message_type = _TRAFFICSHAPING
+_TRAFFICSHAPING.fields_by_name['egress'].message_type = _TRAFFICSHAPER
+_TRAFFICSHAPING.fields_by_name['ingress'].message_type = _TRAFFICSHAPER
+_TRAFFICSHAPER.fields_by_name['classes'].message_type = _TRAFFICCLASS
 _WIRELESSCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._WIRELESSTYPE
 _WIRELESSCONFIG.fields_by_name['cellularCfg'].message_type = _CELLULARCONFIG
 _WIRELESSCONFIG.fields_by_name['wifiCfg'].message_type = _WIFICONFIG
 _CELLULARCONFIG.fields_by_name['probe'].message_type = _CELLULARCONNECTIVITYPROBE
+_CELLULARCONFIG.fields_by_name['sim_profiles'].message_type = _CELLULARSIMPROFILE
 _WIFICONFIG_CRYPTOBLOCK.containing_type = _WIFICONFIG
 _WIFICONFIG.fields_by_name['keyScheme'].enum_type = config_dot_netcmn__pb2._WIFIKEYSCHEME
 _WIFICONFIG.fields_by_name['crypto'].message_type = _WIFICONFIG_CRYPTOBLOCK
 _WIFICONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 DESCRIPTOR.message_types_by_name['NetworkConfig'] = _NETWORKCONFIG
 DESCRIPTOR.message_types_by_name['NetworkAdapter'] = _NETWORKADAPTER
+DESCRIPTOR.message_types_by_name['TrafficShaping'] = _TRAFFICSHAPING
+DESCRIPTOR.message_types_by_name['TrafficShaper'] = _TRAFFICSHAPER
+DESCRIPTOR.message_types_by_name['TrafficClass'] = _TRAFFICCLASS
 DESCRIPTOR.message_types_by_name['WirelessConfig'] = _WIRELESSCONFIG
 DESCRIPTOR.message_types_by_name['CellularConfig'] = _CELLULARCONFIG
+DESCRIPTOR.message_types_by_name['CellularSimProfile'] = _CELLULARSIMPROFILE
 DESCRIPTOR.message_types_by_name['CellularConnectivityProbe'] = _CELLULARCONNECTIVITYPROBE
 DESCRIPTOR.message_types_by_name['WifiConfig'] = _WIFICONFIG
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
@@ -476,6 +697,27 @@ NetworkAdapter = _reflection.GeneratedProtocolMessageType('NetworkAdapter', (_me
   })
 _sym_db.RegisterMessage(NetworkAdapter)
 
+TrafficShaping = _reflection.GeneratedProtocolMessageType('TrafficShaping', (_message.Message,), {
+  'DESCRIPTOR' : _TRAFFICSHAPING,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.TrafficShaping)
+  })
+_sym_db.RegisterMessage(TrafficShaping)
+
+TrafficShaper = _reflection.GeneratedProtocolMessageType('TrafficShaper', (_message.Message,), {
+  'DESCRIPTOR' : _TRAFFICSHAPER,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.TrafficShaper)
+  })
+_sym_db.RegisterMessage(TrafficShaper)
+
+TrafficClass = _reflection.GeneratedProtocolMessageType('TrafficClass', (_message.Message,), {
+  'DESCRIPTOR' : _TRAFFICCLASS,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.TrafficClass)
+  })
+_sym_db.RegisterMessage(TrafficClass)
+
 WirelessConfig = _reflection.GeneratedProtocolMessageType('WirelessConfig', (_message.Message,), {
   'DESCRIPTOR' : _WIRELESSCONFIG,
   '__module__' : 'config.netconfig_pb2'
@@ -490,6 +732,13 @@ CellularConfig = _reflection.GeneratedProtocolMessageType('CellularConfig', (_me
   })
 _sym_db.RegisterMessage(CellularConfig)
 
+CellularSimProfile = _reflection.GeneratedProtocolMessageType('CellularSimProfile', (_message.Message,), {
+  'DESCRIPTOR' : _CELLULARSIMPROFILE,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.CellularSimProfile)
+  })
+_sym_db.RegisterMessage(CellularSimProfile)
+
 CellularConnectivityProbe = _reflection.GeneratedProtocolMessageType('CellularConnectivityProbe', (_message.Message,), {
   'DESCRIPTOR' : _CELLULARCONNECTIVITYPROBE,
   '__module__' : 'config.netconfig_pb2'
@@ -12,6 +12,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from config import acipherinfo_pb2 as config_dot_acipherinfo__pb2
 from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import netcmn_pb2 as config_dot_netcmn__pb2
 
@@ -22,9 +23,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xb3\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"\xbe\x03\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry*\xb3\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*C\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xb3\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"R\n\tVxlanPeer\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12\x1c\n\x14wireguard_public_key\x18\x02 \x01(\t\x12\x16\n\x0etunnel_address\x18\x03 \x01(\t\"\x96\x02\n\x0bVxlanConfig\x12\x0b\n\x03vni\x18\x01 \x01(\r\x12\x10\n\x08\x64st_port\x18\x02 \x01(\r\x12\x0b\n\x03mtu\x18\x03 \x01(\r\x12/\n\x05peers\x18\x04 \x03(\x0b\x32 .org.lfedge.eve.config.VxlanPeer\x12:\n\nencryption\x18\x05 \x01(\x0e\x32&.org.lfedge.eve.config.VxlanEncryption\x12\x16\n\x0etunnel_address\x18\x06 \x01(\t\x12\x1d\n\x15wireguard_listen_port\x18\x07 \x01(\r\x12\x37\n\x0b\x63ipher_data\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\"h\n\rWireGuardPeer\x12\x12\n\npublic_key\x18\x01 \x01(\t\x12\x10\n\x08\x65ndpoint\x18\x02 \x01(\t\x12\x13\n\x0b\x61llowed_ips\x18\x03 \x03(\t\x12\x1c\n\x14persistent_keepalive\x18\x04 \x01(\r\"\x85\x02\n\x0fWireGuardConfig\x12\x32\n\x04mode\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.config.WireGuardMode\x12\x16\n\x0etunnel_address\x18\x02 \x01(\t\x12\x13\n\x0blisten_port\x18\x03 \x01(\r\x12\x0b\n\x03mtu\x18\x04 \x01(\r\x12\x33\n\x05peers\x18\x05 \x03(\x0b\x32$.org.lfedge.eve.config.WireGuardPeer\x12\x37\n\x0b\x63ipher_data\x18\x06 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x16\n\x0ekey_generation\x18\x07 \x01(\r\"\xcc\x04\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x1e\n\x16ipv6_prefix_delegation\x18* \x01(\x08\x12\x31\n\x05vxlan\x18+ \x01(\x0b\x32\".org.lfedge.eve.config.VxlanConfig\x12\x39\n\twireguard\x18, \x01(\x0b\x32&.org.lfedge.eve.config.WireGuardConfig*\xdd\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\rZnetInstVXLAN\x10\x07\x12\x15\n\x11ZnetInstWireGuard\x10\x08\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*C\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02*h\n\x0fVxlanEncryption\x12\x19\n\x15VXLAN_ENCRYPTION_NONE\x10\x00\x12\x1a\n\x16VXLAN_ENCRYPTION_IPSEC\x10\x01\x12\x1e\n\x1aVXLAN_ENCRYPTION_WIREGUARD\x10\x02*B\n\rWireGuardMode\x12\x19\n\x15WIREGUARD_MODE_CLIENT\x10\x00\x12\x16\n\x12WIREGUARD_MODE_HUB\x10\x01\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
-  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
 
 _ZNETWORKINSTTYPE = _descriptor.EnumDescriptor(
   name='ZNetworkInstType',
@@ -69,15 +70,25 @@ _ZNETWORKINSTTYPE = _descriptor.EnumDescriptor(
       type=None,
       create_key=_descriptor._internal_create_key),
     _descriptor.EnumValueDescriptor(
-      name='ZNetInstLast', index=7, number=255,
+      name='ZnetInstVXLAN', index=7, number=7,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ZnetInstWireGuard', index=8, number=8,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ZNetInstLast', index=9, number=255,
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1062,
-  serialized_end=1241,
+  serialized_start=1965,
+  serialized_end=2186,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKINSTTYPE)
 
@@ -122,8 +133,8 @@ _ADDRESSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1243,
-  serialized_end=1330,
+  serialized_start=2188,
+  serialized_end=2275,
 )
 _sym_db.RegisterEnumDescriptor(_ADDRESSTYPE)
 
@@ -148,8 +159,8 @@ _ZNETWORKOPAQUECONFIGTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1332,
-  serialized_end=1399,
+  serialized_start=2277,
+  serialized_end=2344,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKOPAQUECONFIGTYPE)
 
@@ -179,12 +190,69 @@ _ZCSERVICETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1401,
-  serialized_end=1472,
+  serialized_start=2346,
+  serialized_end=2417,
 )
 _sym_db.RegisterEnumDescriptor(_ZCSERVICETYPE)
 
 ZcServiceType = enum_type_wrapper.EnumTypeWrapper(_ZCSERVICETYPE)
+_VXLANENCRYPTION = _descriptor.EnumDescriptor(
+  name='VxlanEncryption',
+  full_name='org.lfedge.eve.config.VxlanEncryption',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='VXLAN_ENCRYPTION_NONE', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VXLAN_ENCRYPTION_IPSEC', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VXLAN_ENCRYPTION_WIREGUARD', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2419,
+  serialized_end=2523,
+)
+_sym_db.RegisterEnumDescriptor(_VXLANENCRYPTION)
+
+VxlanEncryption = enum_type_wrapper.EnumTypeWrapper(_VXLANENCRYPTION)
+_WIREGUARDMODE = _descriptor.EnumDescriptor(
+  name='WireGuardMode',
+  full_name='org.lfedge.eve.config.WireGuardMode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='WIREGUARD_MODE_CLIENT', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='WIREGUARD_MODE_HUB', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2525,
+  serialized_end=2591,
+)
+_sym_db.RegisterEnumDescriptor(_WIREGUARDMODE)
+
+WireGuardMode = enum_type_wrapper.EnumTypeWrapper(_WIREGUARDMODE)
 ZNetInstFirst = 0
 ZnetInstSwitch = 1
 ZnetInstLocal = 2
@@ -192,6 +260,8 @@ ZnetInstCloud = 3
 ZnetInstMesh = 4
 ZnetInstHoneyPot = 5
 ZnetInstTransparent = 6
+ZnetInstVXLAN = 7
+ZnetInstWireGuard = 8
 ZNetInstLast = 255
 First = 0
 IPV4 = 1
@@ -204,6 +274,11 @@ ZNetOConfigLisp = 1
 zcloudInvalidSrv = 0
 mapServer = 1
 supportServer = 2
+VXLAN_ENCRYPTION_NONE = 0
+VXLAN_ENCRYPTION_IPSEC = 1
+VXLAN_ENCRYPTION_WIREGUARD = 2
+WIREGUARD_MODE_CLIENT = 0
+WIREGUARD_MODE_HUB = 1
 
 
 
@@ -248,8 +323,8 @@ _NETWORKINSTANCEOPAQUECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=93,
-  serialized_end=272,
+  serialized_start=119,
+  serialized_end=298,
 )
 
 
@@ -294,8 +369,8 @@ _ZCSERVICEPOINT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=274,
-  serialized_end=382,
+  serialized_start=300,
+  serialized_end=408,
 )
 
 
@@ -368,8 +443,262 @@ _NETWORKINSTANCELISPCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=385,
-  serialized_end=610,
+  serialized_start=411,
+  serialized_end=636,
+)
+
+
+_VXLANPEER = _descriptor.Descriptor(
+  name='VxlanPeer',
+  full_name='org.lfedge.eve.config.VxlanPeer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='address', full_name='org.lfedge.eve.config.VxlanPeer.address', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wireguard_public_key', full_name='org.lfedge.eve.config.VxlanPeer.wireguard_public_key', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tunnel_address', full_name='org.lfedge.eve.config.VxlanPeer.tunnel_address', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=638,
+  serialized_end=720,
+)
+
+
+_VXLANCONFIG = _descriptor.Descriptor(
+  name='VxlanConfig',
+  full_name='org.lfedge.eve.config.VxlanConfig',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='vni', full_name='org.lfedge.eve.config.VxlanConfig.vni', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dst_port', full_name='org.lfedge.eve.config.VxlanConfig.dst_port', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='org.lfedge.eve.config.VxlanConfig.mtu', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='peers', full_name='org.lfedge.eve.config.VxlanConfig.peers', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='encryption', full_name='org.lfedge.eve.config.VxlanConfig.encryption', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tunnel_address', full_name='org.lfedge.eve.config.VxlanConfig.tunnel_address', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wireguard_listen_port', full_name='org.lfedge.eve.config.VxlanConfig.wireguard_listen_port', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cipher_data', full_name='org.lfedge.eve.config.VxlanConfig.cipher_data', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=723,
+  serialized_end=1001,
+)
+
+
+_WIREGUARDPEER = _descriptor.Descriptor(
+  name='WireGuardPeer',
+  full_name='org.lfedge.eve.config.WireGuardPeer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='public_key', full_name='org.lfedge.eve.config.WireGuardPeer.public_key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='endpoint', full_name='org.lfedge.eve.config.WireGuardPeer.endpoint', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='allowed_ips', full_name='org.lfedge.eve.config.WireGuardPeer.allowed_ips', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='persistent_keepalive', full_name='org.lfedge.eve.config.WireGuardPeer.persistent_keepalive', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1003,
+  serialized_end=1107,
+)
+
+
+_WIREGUARDCONFIG = _descriptor.Descriptor(
+  name='WireGuardConfig',
+  full_name='org.lfedge.eve.config.WireGuardConfig',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='org.lfedge.eve.config.WireGuardConfig.mode', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tunnel_address', full_name='org.lfedge.eve.config.WireGuardConfig.tunnel_address', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='listen_port', full_name='org.lfedge.eve.config.WireGuardConfig.listen_port', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='org.lfedge.eve.config.WireGuardConfig.mtu', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='peers', full_name='org.lfedge.eve.config.WireGuardConfig.peers', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cipher_data', full_name='org.lfedge.eve.config.WireGuardConfig.cipher_data', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_generation', full_name='org.lfedge.eve.config.WireGuardConfig.key_generation', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1110,
+  serialized_end=1371,
 )
 
 
@@ -444,6 +773,27 @@ _NETWORKINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ipv6_prefix_delegation', full_name='org.lfedge.eve.config.NetworkInstanceConfig.ipv6_prefix_delegation', index=9,
+      number=42, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vxlan', full_name='org.lfedge.eve.config.NetworkInstanceConfig.vxlan', index=10,
+      number=43, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wireguard', full_name='org.lfedge.eve.config.NetworkInstanceConfig.wireguard', index=11,
+      number=44, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -456,14 +806,20 @@ _NETWORKINSTANCECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=613,
-  serialized_end=1059,
+  serialized_start=1374,
+  serialized_end=1962,
 )
 
 _NETWORKINSTANCEOPAQUECONFIG.fields_by_name['lispConfig'].message_type = _NETWORKINSTANCELISPCONFIG
 _NETWORKINSTANCEOPAQUECONFIG.fields_by_name['type'].enum_type = _ZNETWORKOPAQUECONFIGTYPE
 _ZCSERVICEPOINT.fields_by_name['zsType'].enum_type = _ZCSERVICETYPE
 _NETWORKINSTANCELISPCONFIG.fields_by_name['LispMSs'].message_type = _ZCSERVICEPOINT
+_VXLANCONFIG.fields_by_name['peers'].message_type = _VXLANPEER
+_VXLANCONFIG.fields_by_name['encryption'].enum_type = _VXLANENCRYPTION
+_VXLANCONFIG.fields_by_name['cipher_data'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
+_WIREGUARDCONFIG.fields_by_name['mode'].enum_type = _WIREGUARDMODE
+_WIREGUARDCONFIG.fields_by_name['peers'].message_type = _WIREGUARDPEER
+_WIREGUARDCONFIG.fields_by_name['cipher_data'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _NETWORKINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
 _NETWORKINSTANCECONFIG.fields_by_name['instType'].enum_type = _ZNETWORKINSTTYPE
 _NETWORKINSTANCECONFIG.fields_by_name['port'].message_type = config_dot_devcommon__pb2._ADAPTER
@@ -471,14 +827,22 @@ _NETWORKINSTANCECONFIG.fields_by_name['cfg'].message_type = _NETWORKINSTANCEOPAQ
 _NETWORKINSTANCECONFIG.fields_by_name['ipType'].enum_type = _ADDRESSTYPE
 _NETWORKINSTANCECONFIG.fields_by_name['ip'].message_type = config_dot_netcmn__pb2._IPSPEC
 _NETWORKINSTANCECONFIG.fields_by_name['dns'].message_type = config_dot_netcmn__pb2._ZNETSTATICDNSENTRY
+_NETWORKINSTANCECONFIG.fields_by_name['vxlan'].message_type = _VXLANCONFIG
+_NETWORKINSTANCECONFIG.fields_by_name['wireguard'].message_type = _WIREGUARDCONFIG
 DESCRIPTOR.message_types_by_name['NetworkInstanceOpaqueConfig'] = _NETWORKINSTANCEOPAQUECONFIG
 DESCRIPTOR.message_types_by_name['ZcServicePoint'] = _ZCSERVICEPOINT
 DESCRIPTOR.message_types_by_name['NetworkInstanceLispConfig'] = _NETWORKINSTANCELISPCONFIG
+DESCRIPTOR.message_types_by_name['VxlanPeer'] = _VXLANPEER
+DESCRIPTOR.message_types_by_name['VxlanConfig'] = _VXLANCONFIG
+DESCRIPTOR.message_types_by_name['WireGuardPeer'] = _WIREGUARDPEER
+DESCRIPTOR.message_types_by_name['WireGuardConfig'] = _WIREGUARDCONFIG
 DESCRIPTOR.message_types_by_name['NetworkInstanceConfig'] = _NETWORKINSTANCECONFIG
 DESCRIPTOR.enum_types_by_name['ZNetworkInstType'] = _ZNETWORKINSTTYPE
 DESCRIPTOR.enum_types_by_name['AddressType'] = _ADDRESSTYPE
 DESCRIPTOR.enum_types_by_name['ZNetworkOpaqueConfigType'] = _ZNETWORKOPAQUECONFIGTYPE
 DESCRIPTOR.enum_types_by_name['ZcServiceType'] = _ZCSERVICETYPE
+DESCRIPTOR.enum_types_by_name['VxlanEncryption'] = _VXLANENCRYPTION
+DESCRIPTOR.enum_types_by_name['WireGuardMode'] = _WIREGUARDMODE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 NetworkInstanceOpaqueConfig = _reflection.GeneratedProtocolMessageType('NetworkInstanceOpaqueConfig', (_message.Message,), {
@@ -502,6 +866,34 @@ NetworkInstanceLispConfig = _reflection.GeneratedProtocolMessageType('NetworkIns
   })
 _sym_db.RegisterMessage(NetworkInstanceLispConfig)
 
+VxlanPeer = _reflection.GeneratedProtocolMessageType('VxlanPeer', (_message.Message,), {
+  'DESCRIPTOR' : _VXLANPEER,
+  '__module__' : 'config.netinst_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VxlanPeer)
+  })
+_sym_db.RegisterMessage(VxlanPeer)
+
+VxlanConfig = _reflection.GeneratedProtocolMessageType('VxlanConfig', (_message.Message,), {
+  'DESCRIPTOR' : _VXLANCONFIG,
+  '__module__' : 'config.netinst_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VxlanConfig)
+  })
+_sym_db.RegisterMessage(VxlanConfig)
+
+WireGuardPeer = _reflection.GeneratedProtocolMessageType('WireGuardPeer', (_message.Message,), {
+  'DESCRIPTOR' : _WIREGUARDPEER,
+  '__module__' : 'config.netinst_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.WireGuardPeer)
+  })
+_sym_db.RegisterMessage(WireGuardPeer)
+
+WireGuardConfig = _reflection.GeneratedProtocolMessageType('WireGuardConfig', (_message.Message,), {
+  'DESCRIPTOR' : _WIREGUARDCONFIG,
+  '__module__' : 'config.netinst_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.WireGuardConfig)
+  })
+_sym_db.RegisterMessage(WireGuardConfig)
+
 NetworkInstanceConfig = _reflection.GeneratedProtocolMessageType('NetworkInstanceConfig', (_message.Message,), {
   'DESCRIPTOR' : _NETWORKINSTANCECONFIG,
   '__module__' : 'config.netinst_pb2'
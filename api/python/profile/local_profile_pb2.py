@@ -22,7 +22,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\026org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profile',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x0finfo/info.proto\x1a\x15metrics/metrics.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"\xbd\x01\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\x12@\n\x10\x63\x65llular_metrics\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.metrics.CellularMetric\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xb0\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\xc9\x01\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"J\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\"\xa9\x02\n\x0cLocalDevInfo\x12\x13\n\x0b\x64\x65vice_uuid\x18\x01 \x01(\t\x12\x30\n\x05state\x18\x02 \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12L\n\x18maintenance_mode_reasons\x18\x03 \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12-\n\tboot_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x10last_boot_reason\x18\x05 \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12\x1a\n\x12last_cmd_timestamp\x18\n \x01(\x04\"\xcd\x01\n\x0bLocalDevCmd\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12<\n\x07\x63ommand\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.profile.LocalDevCmd.Command\"W\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMMAND_SHUTDOWN\x10\x01\x12\x1d\n\x19\x43OMMAND_SHUTDOWN_POWEROFF\x10\x02\x42?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
+  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x0finfo/info.proto\x1a\x15metrics/metrics.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"\xbd\x01\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\x12@\n\x10\x63\x65llular_metrics\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.metrics.CellularMetric\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xb0\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\x8a\x02\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"\x8a\x01\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\x12\x14\n\x10\x43OMMAND_SNAPSHOT\x10\x03\x12\x14\n\x10\x43OMMAND_ROLLBACK\x10\x04\x12\x12\n\x0e\x43OMMAND_EXPORT\x10\x05\"\xa9\x02\n\x0cLocalDevInfo\x12\x13\n\x0b\x64\x65vice_uuid\x18\x01 \x01(\t\x12\x30\n\x05state\x18\x02 \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12L\n\x18maintenance_mode_reasons\x18\x03 \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12-\n\tboot_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x10last_boot_reason\x18\x05 \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12\x1a\n\x12last_cmd_timestamp\x18\n \x01(\x04\"\xcd\x01\n\x0bLocalDevCmd\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12<\n\x07\x63ommand\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.profile.LocalDevCmd.Command\"W\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMMAND_SHUTDOWN\x10\x01\x12\x1d\n\x19\x43OMMAND_SHUTDOWN_POWEROFF\x10\x02\"_\n\x16LocalWireGuardInfoList\x12\x45\n\x11network_instances\x18\x01 \x03(\x0b\x32*.org.lfedge.eve.profile.LocalWireGuardInfo\"^\n\x12LocalWireGuardInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x12\n\npublic_key\x18\x03 \x01(\t\x12\x1a\n\x12last_cmd_timestamp\x18\x04 \x01(\x04\"r\n\x15LocalWireGuardCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x43\n\rkey_rotations\x18\x02 \x03(\x0b\x32,.org.lfedge.eve.profile.WireGuardKeyRotation\"J\n\x14WireGuardKeyRotation\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\"c\n\x0fLocalAttestInfo\x12\x15\n\rpolicy_serial\x18\x01 \x01(\x04\x12\x39\n\x0b\x61ttestation\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.AttestationInfo\"@\n\x11LocalAttestPolicy\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rsigned_policy\x18\x02 \x01(\x0c\x42?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
   ,
   dependencies=[info_dot_info__pb2.DESCRIPTOR,metrics_dot_metrics__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
@@ -50,11 +50,26 @@ _APPCOMMAND_COMMAND = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_SNAPSHOT', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_ROLLBACK', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_EXPORT', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1179,
-  serialized_end=1253,
+  serialized_start=1180,
+  serialized_end=1318,
 )
 _sym_db.RegisterEnumDescriptor(_APPCOMMAND_COMMAND)
 
@@ -83,8 +98,8 @@ _LOCALDEVCMD_COMMAND = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1674,
-  serialized_end=1761,
+  serialized_start=1739,
+  serialized_end=1826,
 )
 _sym_db.RegisterEnumDescriptor(_LOCALDEVCMD_COMMAND)
 
@@ -475,7 +490,7 @@ _APPCOMMAND = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=1052,
-  serialized_end=1253,
+  serialized_end=1318,
 )
 
 
@@ -541,8 +556,8 @@ _LOCALDEVINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1256,
-  serialized_end=1553,
+  serialized_start=1321,
+  serialized_end=1618,
 )
 
 
@@ -588,8 +603,256 @@ _LOCALDEVCMD = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1556,
-  serialized_end=1761,
+  serialized_start=1621,
+  serialized_end=1826,
+)
+
+
+_LOCALWIREGUARDINFOLIST = _descriptor.Descriptor(
+  name='LocalWireGuardInfoList',
+  full_name='org.lfedge.eve.profile.LocalWireGuardInfoList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='network_instances', full_name='org.lfedge.eve.profile.LocalWireGuardInfoList.network_instances', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1828,
+  serialized_end=1923,
+)
+
+
+_LOCALWIREGUARDINFO = _descriptor.Descriptor(
+  name='LocalWireGuardInfo',
+  full_name='org.lfedge.eve.profile.LocalWireGuardInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.LocalWireGuardInfo.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.profile.LocalWireGuardInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='public_key', full_name='org.lfedge.eve.profile.LocalWireGuardInfo.public_key', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_cmd_timestamp', full_name='org.lfedge.eve.profile.LocalWireGuardInfo.last_cmd_timestamp', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1925,
+  serialized_end=2019,
+)
+
+
+_LOCALWIREGUARDCMDLIST = _descriptor.Descriptor(
+  name='LocalWireGuardCmdList',
+  full_name='org.lfedge.eve.profile.LocalWireGuardCmdList',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='server_token', full_name='org.lfedge.eve.profile.LocalWireGuardCmdList.server_token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_rotations', full_name='org.lfedge.eve.profile.LocalWireGuardCmdList.key_rotations', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2021,
+  serialized_end=2135,
+)
+
+
+_WIREGUARDKEYROTATION = _descriptor.Descriptor(
+  name='WireGuardKeyRotation',
+  full_name='org.lfedge.eve.profile.WireGuardKeyRotation',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.WireGuardKeyRotation.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayname', full_name='org.lfedge.eve.profile.WireGuardKeyRotation.displayname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='org.lfedge.eve.profile.WireGuardKeyRotation.timestamp', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2137,
+  serialized_end=2211,
+)
+
+
+_LOCALATTESTINFO = _descriptor.Descriptor(
+  name='LocalAttestInfo',
+  full_name='org.lfedge.eve.profile.LocalAttestInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='policy_serial', full_name='org.lfedge.eve.profile.LocalAttestInfo.policy_serial', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='attestation', full_name='org.lfedge.eve.profile.LocalAttestInfo.attestation', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2213,
+  serialized_end=2312,
+)
+
+
+_LOCALATTESTPOLICY = _descriptor.Descriptor(
+  name='LocalAttestPolicy',
+  full_name='org.lfedge.eve.profile.LocalAttestPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='server_token', full_name='org.lfedge.eve.profile.LocalAttestPolicy.server_token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='signed_policy', full_name='org.lfedge.eve.profile.LocalAttestPolicy.signed_policy', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2314,
+  serialized_end=2378,
 )
 
 _RADIOSTATUS.fields_by_name['cellular_status'].message_type = _CELLULARSTATUS
@@ -609,6 +872,9 @@ _LOCALDEVINFO.fields_by_name['boot_time'].message_type = google_dot_protobuf_dot
 _LOCALDEVINFO.fields_by_name['last_boot_reason'].enum_type = info_dot_info__pb2._BOOTREASON
 _LOCALDEVCMD.fields_by_name['command'].enum_type = _LOCALDEVCMD_COMMAND
 _LOCALDEVCMD_COMMAND.containing_type = _LOCALDEVCMD
+_LOCALWIREGUARDINFOLIST.fields_by_name['network_instances'].message_type = _LOCALWIREGUARDINFO
+_LOCALWIREGUARDCMDLIST.fields_by_name['key_rotations'].message_type = _WIREGUARDKEYROTATION
+_LOCALATTESTINFO.fields_by_name['attestation'].message_type = info_dot_info__pb2._ATTESTATIONINFO
 DESCRIPTOR.message_types_by_name['LocalProfile'] = _LOCALPROFILE
 DESCRIPTOR.message_types_by_name['RadioStatus'] = _RADIOSTATUS
 DESCRIPTOR.message_types_by_name['CellularStatus'] = _CELLULARSTATUS
@@ -619,6 +885,12 @@ DESCRIPTOR.message_types_by_name['LocalAppCmdList'] = _LOCALAPPCMDLIST
 DESCRIPTOR.message_types_by_name['AppCommand'] = _APPCOMMAND
 DESCRIPTOR.message_types_by_name['LocalDevInfo'] = _LOCALDEVINFO
 DESCRIPTOR.message_types_by_name['LocalDevCmd'] = _LOCALDEVCMD
+DESCRIPTOR.message_types_by_name['LocalWireGuardInfoList'] = _LOCALWIREGUARDINFOLIST
+DESCRIPTOR.message_types_by_name['LocalWireGuardInfo'] = _LOCALWIREGUARDINFO
+DESCRIPTOR.message_types_by_name['LocalWireGuardCmdList'] = _LOCALWIREGUARDCMDLIST
+DESCRIPTOR.message_types_by_name['WireGuardKeyRotation'] = _WIREGUARDKEYROTATION
+DESCRIPTOR.message_types_by_name['LocalAttestInfo'] = _LOCALATTESTINFO
+DESCRIPTOR.message_types_by_name['LocalAttestPolicy'] = _LOCALATTESTPOLICY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 LocalProfile = _reflection.GeneratedProtocolMessageType('LocalProfile', (_message.Message,), {
@@ -691,6 +963,48 @@ LocalDevCmd = _reflection.GeneratedProtocolMessageType('LocalDevCmd', (_message.
   })
 _sym_db.RegisterMessage(LocalDevCmd)
 
+LocalWireGuardInfoList = _reflection.GeneratedProtocolMessageType('LocalWireGuardInfoList', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALWIREGUARDINFOLIST,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalWireGuardInfoList)
+  })
+_sym_db.RegisterMessage(LocalWireGuardInfoList)
+
+LocalWireGuardInfo = _reflection.GeneratedProtocolMessageType('LocalWireGuardInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALWIREGUARDINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalWireGuardInfo)
+  })
+_sym_db.RegisterMessage(LocalWireGuardInfo)
+
+LocalWireGuardCmdList = _reflection.GeneratedProtocolMessageType('LocalWireGuardCmdList', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALWIREGUARDCMDLIST,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalWireGuardCmdList)
+  })
+_sym_db.RegisterMessage(LocalWireGuardCmdList)
+
+WireGuardKeyRotation = _reflection.GeneratedProtocolMessageType('WireGuardKeyRotation', (_message.Message,), {
+  'DESCRIPTOR' : _WIREGUARDKEYROTATION,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.WireGuardKeyRotation)
+  })
+_sym_db.RegisterMessage(WireGuardKeyRotation)
+
+LocalAttestInfo = _reflection.GeneratedProtocolMessageType('LocalAttestInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALATTESTINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAttestInfo)
+  })
+_sym_db.RegisterMessage(LocalAttestInfo)
+
+LocalAttestPolicy = _reflection.GeneratedProtocolMessageType('LocalAttestPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALATTESTPOLICY,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAttestPolicy)
+  })
+_sym_db.RegisterMessage(LocalAttestPolicy)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
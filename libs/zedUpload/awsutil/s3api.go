@@ -177,6 +177,17 @@ func (s *S3ctx) GetObjectSize(bname, bkey string) (error, int64) {
 	return nil, 0
 }
 
+// GetObjectSizeAndETag returns the size and the ETag of the object
+func (s *S3ctx) GetObjectSizeAndETag(bname, bkey string) (int64, string, error) {
+	resp, err := s.ss3.HeadObjectWithContext(s.ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bname),
+		Key:    aws.String(bkey)})
+	if err != nil {
+		return 0, "", err
+	}
+	return aws.Int64Value(resp.ContentLength), aws.StringValue(resp.ETag), nil
+}
+
 func (s *S3ctx) GetObjectMD5(bname, bkey string) (error, string) {
 	resp, err := s.ss3.HeadObject(&s3.HeadObjectInput{
 		Bucket: aws.String(bname),
@@ -187,7 +187,8 @@ func (s *S3ctx) UploadFile(fname, bname, bkey string, compression bool, prgNotif
 type partS3 struct {
 	cWriter     *CustomWriter
 	bname, bkey string
-	start, size int64 // offset in the file and size of range
+	etag        string // expected ETag of the object, empty if unknown
+	start, size int64  // offset in the file and size of range
 }
 
 func (s *S3ctx) downloadPart(ch chan *partS3, wg *sync.WaitGroup) {
@@ -202,9 +203,14 @@ func (s *S3ctx) downloadPart(ch chan *partS3, wg *sync.WaitGroup) {
 		}
 		//download range of bytes from file
 		byteRange := fmt.Sprintf("bytes=%d-%d", p.start, p.start+p.size-1)
-		_, err := s.dn.DownloadWithContext(s.ctx, p.cWriter, &s3.GetObjectInput{Bucket: aws.String(p.bname),
+		input := &s3.GetObjectInput{Bucket: aws.String(p.bname),
 			Key:   aws.String(p.bkey),
-			Range: aws.String(byteRange)})
+			Range: aws.String(byteRange)}
+		if p.etag != "" {
+			// fail instead of mixing parts of different versions of the object
+			input.IfMatch = aws.String(p.etag)
+		}
+		_, err := s.dn.DownloadWithContext(s.ctx, p.cWriter, input)
 		if err != nil {
 			p.cWriter.writerGlobalOptions.err = err
 		}
@@ -234,6 +240,7 @@ func getNeededParts(cWriterOptions *writerOptions, bname, bkey string, doneParts
 			},
 			bname: bname,
 			bkey:  bkey,
+			etag:  doneParts.ETag,
 			start: S3PartSize*i + currentPartSize,
 			size:  S3PartSize - currentPartSize,
 		}
@@ -255,7 +262,7 @@ func (s *S3ctx) DownloadFile(fname, bname, bkey string,
 	var fd *os.File
 	var wg sync.WaitGroup
 
-	err, bsize := s.GetObjectSize(bname, bkey)
+	bsize, etag, err := s.GetObjectSizeAndETag(bname, bkey)
 	if err != nil {
 		return doneParts, err
 	}
@@ -291,6 +298,20 @@ func (s *S3ctx) DownloadFile(fname, bname, bkey string,
 		}
 	}
 
+	// the object was replaced after the parts were downloaded
+	if doneParts.ETag != etag {
+		if len(doneParts.Parts) > 0 && doneParts.ETag != "" {
+			if s.log != nil {
+				s.log.Warnf("DownloadFile: ETag of %s changed from %s to %s, will download it again",
+					bkey, doneParts.ETag, etag)
+			}
+			doneParts = types.DownloadedParts{
+				PartSize: S3PartSize,
+			}
+		}
+		doneParts.ETag = etag
+	}
+
 	if len(doneParts.Parts) > 0 {
 		fd, err = os.OpenFile(fname, os.O_RDWR, 0666)
 		if err != nil {
@@ -305,10 +326,7 @@ func (s *S3ctx) DownloadFile(fname, bname, bkey string,
 	}
 	defer fd.Close()
 
-	asize := int64(0)
-	for _, p := range doneParts.Parts {
-		asize += p.Size
-	}
+	asize := doneParts.Size()
 
 	cWriterOpts := &writerOptions{
 		fp:        fd,
@@ -51,6 +51,8 @@ const (
 	SyncHttpTr        SyncTransportType = "http"
 	SyncSftpTr        SyncTransportType = "sftp"
 	SyncOCIRegistryTr SyncTransportType = "oci"
+	SyncNFSTr         SyncTransportType = "nfs"
+	SyncSMBTr         SyncTransportType = "smb"
 )
 
 //
@@ -283,6 +285,18 @@ func (ctx *DronaCtx) NewSyncerDest(tr SyncTransportType, UrlOrRegion, PathOrBkt
 		}
 		syncEp.failPostTime = time.Now()
 		return syncEp, nil
+	case SyncNFSTr:
+		syncEp := &NfsTransportMethod{transport: tr, server: UrlOrRegion, path: PathOrBkt, ctx: ctx}
+		syncEp.failPostTime = time.Now()
+		return syncEp, nil
+	case SyncSMBTr:
+		syncEp := &SmbTransportMethod{transport: tr, server: UrlOrRegion, path: PathOrBkt, ctx: ctx}
+		if auth != nil {
+			syncEp.uname = auth.Uname
+			syncEp.passwd = auth.Password
+		}
+		syncEp.failPostTime = time.Now()
+		return syncEp, nil
 	default:
 	}
 
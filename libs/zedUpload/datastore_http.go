@@ -134,7 +134,9 @@ func (ep *HttpTransportMethod) processHttpDownload(req *DronaRequest) (error, in
 	if req.ackback {
 		go statsUpdater(req, ep.ctx, prgChan)
 	}
-	stats, resp := zedHttp.ExecCmd(req.cancelContext, "get", file, "", req.objloc, req.sizelimit, prgChan, ep.hClient)
+	stats, resp := zedHttp.GetFile(req.cancelContext, file, req.objloc, req.sizelimit, req.doneParts, prgChan, ep.hClient)
+	req.doneParts = stats.DoneParts
+	req.ImageSha256 = resp.Sha256
 	return stats.Error, resp.BodyLength
 }
 
@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedUpload
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	nfs "github.com/lf-edge/eve/libs/zedUpload/nfsutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+// NfsTransportMethod accesses an NFS export with NFSv4.1, or NFSv3 when
+// the server does not support NFSv4.1. The export is mounted using
+// the userspace client for the duration of each operation.
+type NfsTransportMethod struct {
+	// required : transport type
+	transport SyncTransportType
+
+	// required : server address as "[nfs://]host[:port]"
+	server string
+
+	// optional : exported directory, defaults to /
+	path string
+
+	// optional : source IP address the client sockets are bound to
+	srcIP net.IP
+
+	failPostTime time.Time
+
+	ctx *DronaCtx
+}
+
+// Action performs the requested operation
+func (ep *NfsTransportMethod) Action(req *DronaRequest) error {
+	var err error
+	var size int64
+	var list []string
+	var contentLength int64
+
+	switch req.operation {
+	case SyncOpUpload:
+		size, err = ep.processNfsUpload(req)
+	case SyncOpDownload:
+		size, err = ep.processNfsDownload(req)
+	case SyncOpDelete:
+		err = ep.processNfsDelete(req)
+	case SyncOpList:
+		list, err = ep.processNfsList(req)
+		req.imgList = list
+	case SyncOpGetObjectMetaData:
+		contentLength, err = ep.processNfsObjectMetaData(req)
+		req.contentLength = contentLength
+	case SysOpDownloadByChunks:
+		err = ep.processNfsDownloadByChunks(req)
+	default:
+		err = fmt.Errorf("Unknown NFS datastore operation")
+	}
+
+	req.asize = size
+	if err != nil {
+		req.status = fmt.Sprintf("%v", err)
+	}
+	return err
+}
+
+// Open is a no-op, the export is mounted by each operation
+func (ep *NfsTransportMethod) Open() error {
+	return nil
+}
+
+// Close is a no-op
+func (ep *NfsTransportMethod) Close() error {
+	return nil
+}
+
+// WithSrcIPSelection use the specific ip as source address for this connection
+func (ep *NfsTransportMethod) WithSrcIPSelection(localAddr net.IP) error {
+	ep.srcIP = localAddr
+	return nil
+}
+
+// WithSrcIPAndProxySelection use the specific ip as source address for this
+// connection and connect via the provided proxy URL
+func (ep *NfsTransportMethod) WithSrcIPAndProxySelection(localAddr net.IP,
+	proxy *url.URL) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithSrcIPAndHTTPSCerts append certs for the datastore access
+func (ep *NfsTransportMethod) WithSrcIPAndHTTPSCerts(localAddr net.IP, certs [][]byte) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithSrcIPAndProxyAndHTTPSCerts takes a proxy and proxy certs
+func (ep *NfsTransportMethod) WithSrcIPAndProxyAndHTTPSCerts(localAddr net.IP, proxy *url.URL, certs [][]byte) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithBindIntf bind to specific interface for this connection
+func (ep *NfsTransportMethod) WithBindIntf(intf string) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithLogging enables or disables logging
+func (ep *NfsTransportMethod) WithLogging(onoff bool) error {
+	return nil
+}
+
+// share returns description of the export for the userspace NFS client.
+func (ep *NfsTransportMethod) share() (*nfs.Share, error) {
+	_, port, ip, err := resolveServer(ep.server, "nfs")
+	if err != nil {
+		return nil, err
+	}
+	share := &nfs.Share{
+		Server: ip,
+		Export: "/" + strings.TrimPrefix(ep.path, "/"),
+		SrcIP:  ep.srcIP,
+	}
+	if port != "" {
+		if share.Port, err = strconv.Atoi(port); err != nil {
+			return nil, fmt.Errorf("invalid NFS server port %s", port)
+		}
+	}
+	return share, nil
+}
+
+// File upload to NFS Datastore
+func (ep *NfsTransportMethod) processNfsUpload(req *DronaRequest) (int64, error) {
+	share, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "put", share, req.name, req.objloc,
+		req.sizelimit, prgChan)
+	return stats.Asize, stats.Error
+}
+
+// File download from NFS Datastore
+func (ep *NfsTransportMethod) processNfsDownload(req *DronaRequest) (int64, error) {
+	share, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "fetch", share, req.name, req.objloc,
+		req.sizelimit, prgChan)
+	return stats.Asize, stats.Error
+}
+
+// File delete from NFS Datastore
+func (ep *NfsTransportMethod) processNfsDelete(req *DronaRequest) error {
+	share, err := ep.share()
+	if err != nil {
+		return err
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "rm", share, req.name, "",
+		req.sizelimit, nil)
+	return stats.Error
+}
+
+// File list from NFS Datastore
+func (ep *NfsTransportMethod) processNfsList(req *DronaRequest) ([]string, error) {
+	share, err := ep.share()
+	if err != nil {
+		return nil, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, resp := mount.ExecCmd(req.cancelContext, "ls", share, "", "",
+		req.sizelimit, prgChan)
+	return resp.List, stats.Error
+}
+
+// File download from NFS Datastore, the content is posted by chunks
+func (ep *NfsTransportMethod) processNfsDownloadByChunks(req *DronaRequest) error {
+	share, err := ep.share()
+	if err != nil {
+		return err
+	}
+	readCloser, size, err := mount.Open(share, req.name)
+	if err != nil {
+		return err
+	}
+	// Unmount also when processChunkByChunk fails.
+	defer readCloser.Close()
+	req.chunkInfoChan = make(chan ChunkData, 1)
+	chunkChan := make(chan ChunkData)
+	go func(chunkChan chan ChunkData) {
+		for chunkData := range chunkChan {
+			ep.ctx.postChunk(req, chunkData)
+		}
+	}(chunkChan)
+	return processChunkByChunk(readCloser, size, chunkChan)
+}
+
+func (ep *NfsTransportMethod) processNfsObjectMetaData(req *DronaRequest) (int64, error) {
+	share, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	stats, resp := mount.ExecCmd(req.cancelContext, "stat", share, req.name, "",
+		req.sizelimit, nil)
+	return resp.ContentLength, stats.Error
+}
+
+func (ep *NfsTransportMethod) getContext() *DronaCtx {
+	return ep.ctx
+}
+
+// NewRequest creates a new request for the NFS datastore
+func (ep *NfsTransportMethod) NewRequest(opType SyncOpType, objname, objloc string, sizelimit int64, ackback bool, reply chan *DronaRequest) *DronaRequest {
+	dR := &DronaRequest{}
+	dR.syncEp = ep
+	dR.operation = opType
+	dR.name = objname
+	dR.ackback = ackback
+
+	dR.localName = objname
+	dR.objloc = objloc
+
+	// limit for this download
+	dR.sizelimit = sizelimit
+	dR.result = reply
+
+	return dR
+}
+
+// resolveServer parses server address given as "[scheme://]host[:port]"
+// and resolves the host. The network file system clients expect
+// an IP address.
+func resolveServer(server, scheme string) (host, port string, ip net.IP, err error) {
+	server = strings.TrimPrefix(server, scheme+"://")
+	server = strings.TrimSuffix(server, "/")
+	if server == "" {
+		return "", "", nil, fmt.Errorf("missing %s server address", scheme)
+	}
+	host = server
+	if h, p, splitErr := net.SplitHostPort(server); splitErr == nil {
+		host, port = h, p
+	} else if strings.HasPrefix(server, "[") && strings.HasSuffix(server, "]") {
+		host = server[1 : len(server)-1]
+	}
+	if ip = net.ParseIP(host); ip != nil {
+		return host, port, ip, nil
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to resolve %s server %s: %v",
+			scheme, host, err)
+	}
+	if len(ips) == 0 {
+		return "", "", nil, fmt.Errorf("no address found for %s server %s",
+			scheme, host)
+	}
+	return host, port, ips[0], nil
+}
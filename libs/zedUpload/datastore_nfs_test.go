@@ -0,0 +1,185 @@
+package zedUpload_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/nfstest"
+)
+
+const (
+	nfsUploadFile  = uploadFile
+	nfsDownloadDir = "./test/output/nfsDownload/"
+)
+
+var (
+	// parameters for NFS datastore, an in-process server is used when not set
+	nfsServer = os.Getenv("TEST_NFS_SERVER")
+	nfsPath   = os.Getenv("TEST_NFS_PATH")
+)
+
+func TestNFSDatastore(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatalf("setup error: %v", err)
+	}
+	if err := os.MkdirAll(nfsDownloadDir, 0755); err != nil {
+		t.Fatalf("unable to make download directory: %v", err)
+	}
+	server, path := nfsServer, nfsPath
+	if server == "" || path == "" {
+		dir, err := ioutil.TempDir("", "nfs_export")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		srv, err := nfstest.NewServer(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer srv.Close()
+		server, path = "nfs://"+srv.Addr().String(), nfstest.Export
+	}
+	t.Run("Functional", func(t *testing.T) {
+		if err := testMountObjectWithFile(zedUpload.SyncNFSTr, server,
+			path, nil, nfsUploadFile, nfsDownloadDir, "release/nfsteststuff"); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("Chunks", func(t *testing.T) {
+		if err := testMountObjectByChunks(zedUpload.SyncNFSTr, server,
+			path, nil, nfsUploadFile, "release/nfschunkstuff"); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("Negative", func(t *testing.T) {
+		isErr, _ := operationMount(zedUpload.SyncNFSTr, server, path,
+			nil, nfsDownloadDir+"missing", "nfsmissingstuff", zedUpload.SyncOpDownload)
+		if !isErr {
+			t.Errorf("Non-existent file seems to exist")
+		}
+	})
+}
+
+// operationMount runs a single operation against a datastore accessed
+// by mounting a network file system (NFS or SMB).
+func operationMount(tr zedUpload.SyncTransportType, server, path string,
+	auth *zedUpload.AuthInput, objloc, objkey string,
+	operation zedUpload.SyncOpType) (bool, string) {
+	respChan := make(chan *zedUpload.DronaRequest)
+
+	ctx, err := zedUpload.NewDronaCtx("zmounter", 0)
+	if ctx == nil {
+		return true, err.Error()
+	}
+
+	// create Endpoint
+	dEndPoint, err := ctx.NewSyncerDest(tr, server, path, auth)
+	if err != nil {
+		return true, err.Error()
+	}
+	req := dEndPoint.NewRequest(operation, objkey, objloc, 0, true, respChan)
+	if req != nil {
+		_ = req.Post()
+	}
+
+	for resp := range respChan {
+		if resp.IsDnUpdate() {
+			continue
+		}
+		return resp.IsError(), resp.GetStatus()
+	}
+	return true, "no response"
+}
+
+// testMountObjectWithFile uploads, downloads and removes the object
+// and checks that sizes match.
+func testMountObjectWithFile(tr zedUpload.SyncTransportType, server, path string,
+	auth *zedUpload.AuthInput, objloc, downloadDir, objkey string) error {
+	if isErr, msg := operationMount(tr, server, path, auth, objloc, objkey,
+		zedUpload.SyncOpUpload); isErr {
+		return fmt.Errorf("upload failed: %s", msg)
+	}
+	if isErr, msg := operationMount(tr, server, path, auth, "", "",
+		zedUpload.SyncOpList); isErr {
+		return fmt.Errorf("list failed: %s", msg)
+	}
+	downloadFile := downloadDir + "file0"
+	if isErr, msg := operationMount(tr, server, path, auth, downloadFile, objkey,
+		zedUpload.SyncOpDownload); isErr {
+		return fmt.Errorf("download failed: %s", msg)
+	}
+	uploadStat, err := os.Stat(objloc)
+	if err != nil {
+		return err
+	}
+	downloadStat, err := os.Stat(downloadFile)
+	if err != nil {
+		return err
+	}
+	if uploadStat.Size() != downloadStat.Size() {
+		return fmt.Errorf("Download size didn't match %v - %v",
+			downloadStat.Size(), uploadStat.Size())
+	}
+	if isErr, msg := operationMount(tr, server, path, auth, "", objkey,
+		zedUpload.SyncOpDelete); isErr {
+		return fmt.Errorf("delete failed: %s", msg)
+	}
+	return nil
+}
+
+// testMountObjectByChunks uploads the object, downloads it by chunks
+// and checks that the content matches.
+func testMountObjectByChunks(tr zedUpload.SyncTransportType, server, path string,
+	auth *zedUpload.AuthInput, objloc, objkey string) error {
+	if isErr, msg := operationMount(tr, server, path, auth, objloc, objkey,
+		zedUpload.SyncOpUpload); isErr {
+		return fmt.Errorf("upload failed: %s", msg)
+	}
+	defer operationMount(tr, server, path, auth, "", objkey, zedUpload.SyncOpDelete)
+	expected, err := ioutil.ReadFile(objloc)
+	if err != nil {
+		return err
+	}
+
+	ctx, err := zedUpload.NewDronaCtx("zmounter", 0)
+	if ctx == nil {
+		return err
+	}
+	dEndPoint, err := ctx.NewSyncerDest(tr, server, path, auth)
+	if err != nil {
+		return err
+	}
+	respChan := make(chan *zedUpload.DronaRequest)
+	req := dEndPoint.NewRequest(zedUpload.SysOpDownloadByChunks, objkey, "", 0, true, respChan)
+	if err = req.Post(); err != nil {
+		return err
+	}
+	var content []byte
+	for eof := false; !eof; {
+		resp := <-respChan
+		if !resp.IsDnUpdate() && resp.IsError() {
+			return fmt.Errorf("download by chunks failed: %s", resp.GetStatus())
+		}
+		var chunk []byte
+		_, chunk, eof = req.GetChunkDetails()
+		content = append(content, chunk...)
+	}
+	for resp := range respChan {
+		if resp.IsDnUpdate() {
+			continue
+		}
+		if resp.IsError() {
+			return fmt.Errorf("download by chunks failed: %s", resp.GetStatus())
+		}
+		break
+	}
+	if !bytes.Equal(content, expected) {
+		return fmt.Errorf("downloaded %d bytes differ from %d uploaded bytes",
+			len(content), len(expected))
+	}
+	return nil
+}
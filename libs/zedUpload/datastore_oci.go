@@ -4,7 +4,9 @@
 package zedUpload
 
 import (
+	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -152,6 +154,22 @@ func (ep *OCITransportMethod) processDownload(req *DronaRequest) (int64, string,
 		go statsUpdater(req, ep.ctx, prgChan)
 	}
 
+	if req.chunkSource != nil {
+		ctx := req.cancelContext
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		var reused int64
+		size, reused, err = ociutil.PullBlobChunked(ctx, ep.registry, ep.path, req.ImageSha256, req.objloc, ep.uname, ep.apiKey, req.sizelimit, req.chunkSource, ep.hClient, prgChan)
+		if err == nil {
+			req.reusedSize = reused
+			return size, "", nil
+		}
+		if !errors.Is(err, ociutil.ErrNotChunked) && req.logger != nil {
+			req.logger.Warnf("processDownload(%s): chunked download failed, downloading whole blob: %v", req.ImageSha256, err)
+		}
+	}
+
 	// Pull down the blob as is and save it to a file named for the hash
 	size, contentType, err = ociutil.PullBlob(ep.registry, ep.path, req.ImageSha256, req.objloc, ep.uname, ep.apiKey, req.sizelimit, ep.hClient, prgChan)
 	// zedUpload's job is to download a blob from an OCI registry. Done.
@@ -96,6 +96,11 @@ type DronaRequest struct {
 	//downloaded parts indexes
 	doneParts types.DownloadedParts
 
+	// chunks available locally for chunked OCI layers
+	chunkSource types.ChunkSource
+	// bytes taken from chunkSource instead of being downloaded
+	reusedSize int64
+
 	logger types.Logger
 }
 
@@ -358,6 +363,18 @@ func (req *DronaRequest) GetDoneParts() types.DownloadedParts {
 	return req.doneParts
 }
 
+// WithChunkSource can be used to provide chunks which are available locally,
+// so that only the rest of an estargz or zstd:chunked layer is downloaded
+func (req *DronaRequest) WithChunkSource(src types.ChunkSource) *DronaRequest {
+	req.chunkSource = src
+	return req
+}
+
+// GetReusedSize returns the number of bytes taken from the chunk source
+func (req *DronaRequest) GetReusedSize() int64 {
+	return req.reusedSize
+}
+
 // WithLogger sets logger
 func (req *DronaRequest) WithLogger(logger types.Logger) *DronaRequest {
 	req.logger = logger
@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedUpload
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	smb "github.com/lf-edge/eve/libs/zedUpload/smbutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+// SmbTransportMethod accesses an SMB share with the userspace client.
+// The share is connected for the duration of each operation.
+type SmbTransportMethod struct {
+	// required : transport type
+	transport SyncTransportType
+
+	// required : server address as "[smb://]host[:port]"
+	server string
+
+	// required : share name optionally followed by a directory
+	// inside the share, e.g. "images/eve"
+	path string
+
+	// optional : user name, possibly prefixed with the domain
+	// as "DOMAIN\user". Guest access is used when empty.
+	uname string
+
+	// optional, password
+	passwd string
+
+	// optional : source IP address the client sockets are bound to
+	srcIP net.IP
+
+	failPostTime time.Time
+
+	ctx *DronaCtx
+}
+
+// Action performs the requested operation
+func (ep *SmbTransportMethod) Action(req *DronaRequest) error {
+	var err error
+	var size int64
+	var list []string
+	var contentLength int64
+
+	switch req.operation {
+	case SyncOpUpload:
+		size, err = ep.processSmbUpload(req)
+	case SyncOpDownload:
+		size, err = ep.processSmbDownload(req)
+	case SyncOpDelete:
+		err = ep.processSmbDelete(req)
+	case SyncOpList:
+		list, err = ep.processSmbList(req)
+		req.imgList = list
+	case SyncOpGetObjectMetaData:
+		contentLength, err = ep.processSmbObjectMetaData(req)
+		req.contentLength = contentLength
+	case SysOpDownloadByChunks:
+		err = ep.processSmbDownloadByChunks(req)
+	default:
+		err = fmt.Errorf("Unknown SMB datastore operation")
+	}
+
+	req.asize = size
+	if err != nil {
+		req.status = fmt.Sprintf("%v", err)
+	}
+	return err
+}
+
+// Open is a no-op, the share is connected by each operation
+func (ep *SmbTransportMethod) Open() error {
+	return nil
+}
+
+// Close is a no-op
+func (ep *SmbTransportMethod) Close() error {
+	return nil
+}
+
+// WithSrcIPSelection use the specific ip as source address for this connection
+func (ep *SmbTransportMethod) WithSrcIPSelection(localAddr net.IP) error {
+	ep.srcIP = localAddr
+	return nil
+}
+
+// WithSrcIPAndProxySelection use the specific ip as source address for this
+// connection and connect via the provided proxy URL
+func (ep *SmbTransportMethod) WithSrcIPAndProxySelection(localAddr net.IP,
+	proxy *url.URL) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithSrcIPAndHTTPSCerts append certs for the datastore access
+func (ep *SmbTransportMethod) WithSrcIPAndHTTPSCerts(localAddr net.IP, certs [][]byte) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithSrcIPAndProxyAndHTTPSCerts takes a proxy and proxy certs
+func (ep *SmbTransportMethod) WithSrcIPAndProxyAndHTTPSCerts(localAddr net.IP, proxy *url.URL, certs [][]byte) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithBindIntf bind to specific interface for this connection
+func (ep *SmbTransportMethod) WithBindIntf(intf string) error {
+	return fmt.Errorf("not supported")
+}
+
+// WithLogging enables or disables logging
+func (ep *SmbTransportMethod) WithLogging(onoff bool) error {
+	return nil
+}
+
+// share returns description of the share for the userspace SMB client
+// and the directory inside the share where the objects are stored.
+func (ep *SmbTransportMethod) share() (*smb.Share, string, error) {
+	host, port, ip, err := resolveServer(ep.server, "smb")
+	if err != nil {
+		return nil, "", err
+	}
+	shareName := strings.Trim(ep.path, "/")
+	var dir string
+	if i := strings.Index(shareName, "/"); i >= 0 {
+		shareName, dir = shareName[:i], shareName[i+1:]
+	}
+	if shareName == "" {
+		return nil, "", fmt.Errorf("missing SMB share name")
+	}
+	domain, user := "", ep.uname
+	if i := strings.Index(user, `\`); i >= 0 {
+		domain, user = user[:i], user[i+1:]
+	}
+	share := &smb.Share{
+		Server:   ip,
+		Host:     host,
+		Name:     shareName,
+		User:     user,
+		Password: ep.passwd,
+		Domain:   domain,
+		SrcIP:    ep.srcIP,
+	}
+	if port != "" {
+		if share.Port, err = strconv.Atoi(port); err != nil {
+			return nil, "", fmt.Errorf("invalid SMB server port %s", port)
+		}
+	}
+	return share, dir, nil
+}
+
+// File upload to SMB Datastore
+func (ep *SmbTransportMethod) processSmbUpload(req *DronaRequest) (int64, error) {
+	share, dir, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "put", share,
+		joinRemotePath(dir, req.name), req.objloc, req.sizelimit, prgChan)
+	return stats.Asize, stats.Error
+}
+
+// File download from SMB Datastore
+func (ep *SmbTransportMethod) processSmbDownload(req *DronaRequest) (int64, error) {
+	share, dir, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "fetch", share,
+		joinRemotePath(dir, req.name), req.objloc, req.sizelimit, prgChan)
+	return stats.Asize, stats.Error
+}
+
+// File delete from SMB Datastore
+func (ep *SmbTransportMethod) processSmbDelete(req *DronaRequest) error {
+	share, dir, err := ep.share()
+	if err != nil {
+		return err
+	}
+	stats, _ := mount.ExecCmd(req.cancelContext, "rm", share,
+		joinRemotePath(dir, req.name), "", req.sizelimit, nil)
+	return stats.Error
+}
+
+// File list from SMB Datastore
+func (ep *SmbTransportMethod) processSmbList(req *DronaRequest) ([]string, error) {
+	share, dir, err := ep.share()
+	if err != nil {
+		return nil, err
+	}
+	prgChan := make(types.StatsNotifChan)
+	defer close(prgChan)
+	if req.ackback {
+		go statsUpdater(req, ep.ctx, prgChan)
+	}
+	stats, resp := mount.ExecCmd(req.cancelContext, "ls", share, dir, "",
+		req.sizelimit, prgChan)
+	return resp.List, stats.Error
+}
+
+// File download from SMB Datastore, the content is posted by chunks
+func (ep *SmbTransportMethod) processSmbDownloadByChunks(req *DronaRequest) error {
+	share, dir, err := ep.share()
+	if err != nil {
+		return err
+	}
+	readCloser, size, err := mount.Open(share, joinRemotePath(dir, req.name))
+	if err != nil {
+		return err
+	}
+	// Unmount also when processChunkByChunk fails.
+	defer readCloser.Close()
+	req.chunkInfoChan = make(chan ChunkData, 1)
+	chunkChan := make(chan ChunkData)
+	go func(chunkChan chan ChunkData) {
+		for chunkData := range chunkChan {
+			ep.ctx.postChunk(req, chunkData)
+		}
+	}(chunkChan)
+	return processChunkByChunk(readCloser, size, chunkChan)
+}
+
+func (ep *SmbTransportMethod) processSmbObjectMetaData(req *DronaRequest) (int64, error) {
+	share, dir, err := ep.share()
+	if err != nil {
+		return 0, err
+	}
+	stats, resp := mount.ExecCmd(req.cancelContext, "stat", share,
+		joinRemotePath(dir, req.name), "", req.sizelimit, nil)
+	return resp.ContentLength, stats.Error
+}
+
+func (ep *SmbTransportMethod) getContext() *DronaCtx {
+	return ep.ctx
+}
+
+// NewRequest creates a new request for the SMB datastore
+func (ep *SmbTransportMethod) NewRequest(opType SyncOpType, objname, objloc string, sizelimit int64, ackback bool, reply chan *DronaRequest) *DronaRequest {
+	dR := &DronaRequest{}
+	dR.syncEp = ep
+	dR.operation = opType
+	dR.name = objname
+	dR.ackback = ackback
+
+	dR.localName = objname
+	dR.objloc = objloc
+
+	// limit for this download
+	dR.sizelimit = sizelimit
+	dR.result = reply
+
+	return dR
+}
+
+// joinRemotePath returns path of the object inside the share.
+func joinRemotePath(dir, name string) string {
+	return strings.TrimPrefix(path.Join(dir, name), "/")
+}
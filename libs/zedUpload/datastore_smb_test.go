@@ -0,0 +1,69 @@
+package zedUpload_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/smbtest"
+)
+
+const (
+	smbUploadFile  = uploadFile
+	smbDownloadDir = "./test/output/smbDownload/"
+)
+
+var (
+	// parameters for SMB datastore, an in-process server is used when not set
+	smbServer = os.Getenv("TEST_SMB_SERVER")
+	smbShare  = os.Getenv("TEST_SMB_SHARE")
+	smbUser   = os.Getenv("TEST_SMB_USER")
+	smbPass   = os.Getenv("TEST_SMB_PASS")
+)
+
+func TestSMBDatastore(t *testing.T) {
+	if err := setup(); err != nil {
+		t.Fatalf("setup error: %v", err)
+	}
+	if err := os.MkdirAll(smbDownloadDir, 0755); err != nil {
+		t.Fatalf("unable to make download directory: %v", err)
+	}
+	server, share := smbServer, smbShare
+	auth := &zedUpload.AuthInput{AuthType: "password", Uname: smbUser, Password: smbPass}
+	if server == "" || share == "" {
+		dir, err := ioutil.TempDir("", "smb_share")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		srv, err := smbtest.NewServer(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer srv.Close()
+		srv.User, srv.Password = "smbuser", "smbpass"
+		server, share = "smb://"+srv.Addr().String(), smbtest.Share
+		auth.Uname, auth.Password = `WORKGROUP\`+srv.User, srv.Password
+	}
+	t.Run("Functional", func(t *testing.T) {
+		if err := testMountObjectWithFile(zedUpload.SyncSMBTr, server,
+			share, auth, smbUploadFile, smbDownloadDir, "release/smbteststuff"); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("Chunks", func(t *testing.T) {
+		if err := testMountObjectByChunks(zedUpload.SyncSMBTr, server,
+			share, auth, smbUploadFile, "release/smbchunkstuff"); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("Negative", func(t *testing.T) {
+		badAuth := &zedUpload.AuthInput{AuthType: "password", Uname: "baduser", Password: "badpass"}
+		isErr, _ := operationMount(zedUpload.SyncSMBTr, server, share,
+			badAuth, smbDownloadDir+"file0", "smbteststuff", zedUpload.SyncOpDownload)
+		if !isErr {
+			t.Errorf("Download with invalid credentials succeeded")
+		}
+	})
+}
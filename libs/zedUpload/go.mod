@@ -7,7 +7,9 @@ require (
 	github.com/Azure/azure-pipeline-go v0.2.3
 	github.com/Azure/azure-storage-blob-go v0.14.0
 	github.com/aws/aws-sdk-go v1.35.35
+	github.com/containerd/stargz-snapshotter/estargz v0.11.4
 	github.com/google/go-containerregistry v0.6.0
+	github.com/klauspost/compress v1.15.1
 	github.com/pkg/sftp v1.12.0
 	github.com/sirupsen/logrus v1.8.1
 	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a
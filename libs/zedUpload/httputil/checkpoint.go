@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+// checkpointSize is the amount of data after which the local file is synced
+// and a new checkpoint is reported. Only synced data are included
+// in the checkpoint, so that it never points beyond what survives a reboot.
+const checkpointSize int64 = 4 * 1024 * 1024
+
+// checkpoint writes sequentially downloaded content into the local file
+// and keeps the state needed to resume the download later:
+// the offset, the version of the remote object and the sha256 state.
+type checkpoint struct {
+	local        *os.File
+	hash         hash.Hash
+	offset       int64 // bytes written into the local file
+	synced       int64 // offset when the local file was synced last time
+	etag         string
+	lastModified string
+}
+
+// openCheckpoint opens the local file and continues after the content
+// described by doneParts if it is a valid checkpoint of a sequential
+// download. Otherwise the local file is truncated.
+func openCheckpoint(localFile string, doneParts types.DownloadedParts) (*checkpoint, error) {
+	cp := &checkpoint{hash: sha256.New()}
+	offset, err := resumeOffset(localFile, doneParts, cp.hash)
+	if err != nil {
+		cp.hash.Reset()
+		local, err := os.Create(localFile)
+		if err != nil {
+			return nil, err
+		}
+		cp.local = local
+		return cp, nil
+	}
+	local, err := os.OpenFile(localFile, os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	// Drop whatever was written after the checkpoint.
+	if err = local.Truncate(offset); err == nil {
+		_, err = local.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		local.Close()
+		return nil, err
+	}
+	cp.local = local
+	cp.offset = offset
+	cp.synced = offset
+	cp.etag = doneParts.ETag
+	cp.lastModified = doneParts.LastModified
+	return cp, nil
+}
+
+// resumeOffset validates the checkpoint and restores the hash state.
+func resumeOffset(localFile string, doneParts types.DownloadedParts, h hash.Hash) (int64, error) {
+	if doneParts.PartSize != 0 || len(doneParts.Parts) != 1 ||
+		doneParts.Parts[0].Ind != 0 {
+		return 0, fmt.Errorf("not a sequential download")
+	}
+	offset := doneParts.Parts[0].Size
+	if offset <= 0 {
+		return 0, fmt.Errorf("nothing downloaded")
+	}
+	info, err := os.Stat(localFile)
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() < offset {
+		return 0, fmt.Errorf("file size %d is less than checkpoint %d",
+			info.Size(), offset)
+	}
+	unmarshaler, ok := h.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return 0, fmt.Errorf("hash state cannot be restored")
+	}
+	if err = unmarshaler.UnmarshalBinary(doneParts.HashState); err != nil {
+		return 0, fmt.Errorf("invalid hash state: %v", err)
+	}
+	return offset, nil
+}
+
+// Write writes into the local file and hashes what was written.
+func (cp *checkpoint) Write(p []byte) (int, error) {
+	n, err := cp.local.Write(p)
+	cp.hash.Write(p[:n])
+	cp.offset += int64(n)
+	return n, err
+}
+
+// restart drops the downloaded content.
+func (cp *checkpoint) restart() error {
+	if err := cp.local.Truncate(0); err != nil {
+		return fmt.Errorf("failed truncate file: %s", err)
+	}
+	if _, err := cp.local.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed seek file: %s", err)
+	}
+	cp.hash.Reset()
+	cp.offset = 0
+	cp.synced = 0
+	cp.etag = ""
+	cp.lastModified = ""
+	return nil
+}
+
+// changed returns true if the response headers indicate that the remote
+// object is not the one the checkpoint was created for.
+func (cp *checkpoint) changed(etag, lastModified string) bool {
+	if cp.etag != "" && etag != "" {
+		return cp.etag != etag
+	}
+	return cp.lastModified != "" && cp.lastModified != lastModified
+}
+
+// ifRange returns the value for the If-Range header, empty if there
+// is no usable validator. Weak ETags are not allowed in If-Range.
+func (cp *checkpoint) ifRange() string {
+	if cp.etag != "" && cp.etag[0] == '"' {
+		return cp.etag
+	}
+	return cp.lastModified
+}
+
+// save syncs the local file and returns the checkpoint to store.
+func (cp *checkpoint) save() (types.DownloadedParts, error) {
+	if err := cp.local.Sync(); err != nil {
+		return types.DownloadedParts{}, err
+	}
+	state, err := cp.hash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return types.DownloadedParts{}, err
+	}
+	cp.synced = cp.offset
+	return types.DownloadedParts{
+		Parts:        []*types.PartDefinition{{Ind: 0, Size: cp.offset}},
+		ETag:         cp.etag,
+		LastModified: cp.lastModified,
+		HashState:    state,
+	}, nil
+}
+
+// sum returns hex encoded sha256 of the downloaded content.
+func (cp *checkpoint) sum() string {
+	return hex.EncodeToString(cp.hash.Sum(nil))
+}
+
+func (cp *checkpoint) close() error {
+	return cp.local.Close()
+}
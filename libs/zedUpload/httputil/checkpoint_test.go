@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+func testContent(size int) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	return content
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// rangeRecorder records Range headers of requests
+type rangeRecorder struct {
+	sync.Mutex
+	ranges []string
+}
+
+func (r *rangeRecorder) record(req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	r.ranges = append(r.ranges, req.Header.Get("Range"))
+}
+
+// serveContent serves content with the given ETag, supports ranges
+func serveContent(content []byte, etag string, rec *rangeRecorder) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		rec.record(req)
+		w.Header().Set("ETag", etag)
+		http.ServeContent(w, req, "disk.img", time.Time{}, bytes.NewReader(content))
+	}
+}
+
+// writePartial stores the beginning of content as if it was downloaded
+// before a restart and returns the checkpoint
+func writePartial(t *testing.T, localFile string, content []byte, etag string) types.DownloadedParts {
+	// garbage written after the checkpoint must be dropped
+	if err := ioutil.WriteFile(localFile, append(append([]byte{}, content...), "garbage"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.New()
+	h.Write(content)
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.DownloadedParts{
+		Parts:     []*types.PartDefinition{{Ind: 0, Size: int64(len(content))}},
+		ETag:      etag,
+		HashState: state,
+	}
+}
+
+func checkDownloaded(t *testing.T, localFile string, content []byte,
+	stats types.UpdateStats, resp Resp) {
+	t.Helper()
+	if stats.Error != nil {
+		t.Fatalf("download failed: %v", stats.Error)
+	}
+	if stats.Asize != int64(len(content)) {
+		t.Errorf("expected %d bytes, got %d", len(content), stats.Asize)
+	}
+	if resp.Sha256 != sha256Hex(content) {
+		t.Errorf("expected sha256 %s, got %s", sha256Hex(content), resp.Sha256)
+	}
+	got, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("downloaded content differs")
+	}
+}
+
+func tempFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "http_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "disk.img")
+}
+
+func TestGetFileResume(t *testing.T) {
+	content := testContent(int(checkpointSize) + 1000)
+	rec := &rangeRecorder{}
+	srv := httptest.NewServer(serveContent(content, `"v1"`, rec))
+	defer srv.Close()
+
+	localFile := tempFile(t)
+	doneParts := writePartial(t, localFile, content[:12345], `"v1"`)
+	stats, resp := GetFile(context.Background(), srv.URL, localFile,
+		int64(len(content)), doneParts, nil, nil)
+	checkDownloaded(t, localFile, content, stats, resp)
+	if len(rec.ranges) != 1 || rec.ranges[0] != "bytes=12345-" {
+		t.Errorf("expected single request from 12345, got %v", rec.ranges)
+	}
+}
+
+func TestGetFileRangeIgnored(t *testing.T) {
+	content := testContent(100000)
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Write(content)
+	}))
+	defer srv.Close()
+
+	localFile := tempFile(t)
+	doneParts := writePartial(t, localFile, content[:5000], `"v1"`)
+	stats, resp := GetFile(context.Background(), srv.URL, localFile,
+		int64(len(content)), doneParts, nil, nil)
+	checkDownloaded(t, localFile, content, stats, resp)
+	if requests != 1 {
+		t.Errorf("expected single request, got %d", requests)
+	}
+}
+
+func TestGetFileObjectChanged(t *testing.T) {
+	oldContent := testContent(100000)
+	content := bytes.ToUpper(testContent(100000))
+	rec := &rangeRecorder{}
+	srv := httptest.NewServer(serveContent(content, `"v2"`, rec))
+	defer srv.Close()
+
+	localFile := tempFile(t)
+	doneParts := writePartial(t, localFile, oldContent[:5000], `"v1"`)
+	stats, resp := GetFile(context.Background(), srv.URL, localFile,
+		int64(len(content)), doneParts, nil, nil)
+	checkDownloaded(t, localFile, content, stats, resp)
+}
+
+func TestGetFileInvalidCheckpoint(t *testing.T) {
+	content := testContent(100000)
+	rec := &rangeRecorder{}
+	srv := httptest.NewServer(serveContent(content, `"v1"`, rec))
+	defer srv.Close()
+
+	localFile := tempFile(t)
+	doneParts := writePartial(t, localFile, content[:5000], `"v1"`)
+	doneParts.HashState = []byte("invalid")
+	stats, resp := GetFile(context.Background(), srv.URL, localFile,
+		int64(len(content)), doneParts, nil, nil)
+	checkDownloaded(t, localFile, content, stats, resp)
+	if len(rec.ranges) != 1 || rec.ranges[0] != "" {
+		t.Errorf("expected single request without range, got %v", rec.ranges)
+	}
+}
+
+func TestGetFileCheckpoint(t *testing.T) {
+	content := testContent(int(2*checkpointSize) + 1000)
+	rec := &rangeRecorder{}
+	var requests int
+	handler := serveContent(content, `"v1"`, rec)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			// connection breaks after the first checkpoint
+			rec.record(req)
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			w.Header().Set("Accept-Ranges", "bytes")
+			w.Header().Set("ETag", `"v1"`)
+			w.Write(content[:checkpointSize+chunkSize])
+			panic(http.ErrAbortHandler)
+		}
+		handler(w, req)
+	}))
+	defer srv.Close()
+
+	localFile := tempFile(t)
+	prgChan := make(types.StatsNotifChan, 1000)
+	stats, resp := GetFile(context.Background(), srv.URL, localFile,
+		int64(len(content)), types.DownloadedParts{}, prgChan, nil)
+	checkDownloaded(t, localFile, content, stats, resp)
+	if len(rec.ranges) != 2 || rec.ranges[1] == "" {
+		t.Errorf("expected the second request with range, got %v", rec.ranges)
+	}
+	close(prgChan)
+	var saved types.DownloadedParts
+	for s := range prgChan {
+		if s.DoneParts.Size() > 0 && saved.Size() == 0 {
+			saved = s.DoneParts
+		}
+	}
+	if saved.Size() < checkpointSize || saved.ETag != `"v1"` ||
+		len(saved.HashState) == 0 {
+		t.Errorf("unexpected checkpoint %+v", saved)
+	}
+}
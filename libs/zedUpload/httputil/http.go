@@ -15,6 +15,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -36,6 +37,7 @@ type Resp struct {
 	List          []string //list of images at given path
 	BodyLength    int      // Body length in http response
 	ContentLength int64    // Content length in http response
+	Sha256        string   // sha256 of the downloaded content (hex encoded)
 }
 
 var userAgent = "UnityNetworkReporter/" + " (" + runtime.GOOS + " " + runtime.GOARCH + ")"
@@ -123,161 +125,8 @@ func ExecCmd(ctx context.Context, cmd, host, remoteFile, localFile string, objSi
 		rsp.List = imgList
 		return stats, rsp
 	case "get":
-		var copiedSize int64
-		stats.Size = objSize
-		dirErr := os.MkdirAll(filepath.Dir(localFile), 0755)
-		if dirErr != nil {
-			stats.Error = dirErr
-			return stats, Resp{}
-		}
-		local, fileErr := os.Create(localFile)
-		if fileErr != nil {
-			stats.Error = fileErr
-			return stats, Resp{}
-		}
-		defer local.Close()
-
-		var errorList []string
-		done := false
-		supportRange := false //is server supports ranges requests, false for the first request
-		forceRestart := false
-		NoSuitableAddrFound := false
-		delay := time.Second
-		lastModified := ""
-		appendToErrorList := func(attempt int, err error) {
-			errorList = append(errorList, fmt.Sprintf("(attempt %d/%d): %v", attempt, maxRetries, err))
-			logrus.Warnf("ExecCmd get %s failed (attempt %d/%d): %v", host, attempt, maxRetries, err)
-		}
-		for attempt := 0; attempt < maxRetries; attempt++ {
-			//check context error on every attempt
-			if ctx.Err() != nil {
-				appendToErrorList(attempt, ctx.Err())
-				break
-			}
-			if attempt > 0 {
-				time.Sleep(delay)
-				if delay < maxDelay {
-					delay = delay * 2
-				}
-			}
-
-			// restart from the beginning if server do not support ranges or we forced to restart
-			if !supportRange || forceRestart {
-				err := local.Truncate(0)
-				if err != nil {
-					appendToErrorList(attempt, fmt.Errorf("failed truncate file: %s", err))
-					continue
-				}
-				_, err = local.Seek(0, 0)
-				if err != nil {
-					appendToErrorList(attempt, fmt.Errorf("failed seek file: %s", err))
-					continue
-				}
-				copiedSize = 0
-				forceRestart = false
-			}
-			// we need innerCtx cancel to call in case of inactivity
-			innerCtx, innerCtxCancel := context.WithCancel(ctx)
-			inactivityTimer := time.AfterFunc(inactivityTimeout, func() {
-				//keep it to call cancel regardless of logic to releases resources
-				innerCtxCancel()
-			})
-			req, err := http.NewRequestWithContext(innerCtx, http.MethodGet, host, nil)
-			if err != nil {
-				stats.Error = fmt.Errorf("request failed for get %s: %s",
-					host, err)
-				return stats, Resp{}
-			}
-			req.Header.Set("User-Agent", userAgent)
-			req.Header.Set("Content-Type", "application/octet-stream")
-
-			withRange := false
-			//add Range header if server supports it and we already receive data
-			if supportRange && copiedSize > 0 {
-				withRange = true
-				req.Header.Set("Range", fmt.Sprintf("bytes=%d-", copiedSize))
-			}
-			resp, err := client.Do(req)
-			if err != nil {
-				// skip the error from http *net.DNSError has the suffix of "no suitable address found"
-				// for a cleaner error string output for http download failure
-				if !IsNoSuitableAddrErr(err) {
-					appendToErrorList(attempt, fmt.Errorf("client.Do failed: %s", err))
-				} else {
-					if !NoSuitableAddrFound {
-						NoSuitableAddrFound = true
-						appendToErrorList(attempt, fmt.Errorf(NoSuitableAddrStr))
-					}
-				}
-				continue
-			}
-
-			// supportRange indicates if server supports range requests
-			supportRange = resp.Header.Get("Accept-Ranges") == "bytes"
-
-			//if we not receive StatusOK for request without Range header or StatusPartialContent for request with range
-			//it indicates that server misconfigured
-			if !withRange && resp.StatusCode != http.StatusOK || withRange && resp.StatusCode != http.StatusPartialContent {
-				respErr := fmt.Errorf("bad response code: %d", resp.StatusCode)
-				err = resp.Body.Close()
-				if err != nil {
-					respErr = fmt.Errorf("respErr: %v; close Body error: %v", respErr, err)
-				}
-				appendToErrorList(attempt, respErr)
-				//we do not want to process server misconfiguration here
-				break
-			}
-			newLastModified := resp.Header.Get("Last-Modified")
-			if lastModified != "" && newLastModified != lastModified {
-				// last modified changed, retry from the beginning
-				lastModified = newLastModified
-				forceRestart = true
-				continue
-			}
-			if resp.StatusCode == http.StatusOK {
-				// we received StatusOK which is the response for the whole content, not for the partial one
-				rsp.BodyLength = int(resp.ContentLength)
-			}
-			//reset to be not affected by the client.Do timeouts
-			inactivityTimer.Reset(inactivityTimeout)
-			var written int64
-			for {
-				var copyErr error
-				if written, copyErr = io.CopyN(local, resp.Body, chunkSize); copyErr != nil && copyErr != io.EOF {
-					copiedSize += written
-					if innerCtx.Err() != nil {
-						// the error comes from canceled context, which indicates inactivity timeout
-						appendToErrorList(attempt, fmt.Errorf("inactivity for %s", inactivityTimeout))
-					} else {
-						appendToErrorList(attempt, fmt.Errorf("error from CopyN: %v", copyErr))
-					}
-					break
-				}
-				copiedSize += written
-				// we read chunk of data from response on each iteration, if data length is a multiple of chunkSize
-				// on the last iteration we will read 0 bytes and will hit written != chunkSize
-				if written != chunkSize {
-					// Must have reached EOF
-					done = true
-					break
-				}
-				//we received data so re-schedule inactivity timer
-				inactivityTimer.Reset(inactivityTimeout)
-				stats.Asize = copiedSize
-				types.SendStats(prgNotify, stats)
-			}
-			if done {
-				break
-			}
-			err = resp.Body.Close()
-			if err != nil {
-				appendToErrorList(attempt, fmt.Errorf("error close Body: %v", err))
-			}
-		}
-		if !done {
-			stats.Error = fmt.Errorf("%s: %s", host, strings.Join(errorList, "; "))
-		}
-		return stats, rsp
+		return GetFile(ctx, host, localFile, objSize, types.DownloadedParts{},
+			prgNotify, client)
 	case "post":
 		file, err := os.Open(localFile)
 		if err != nil {
@@ -349,3 +198,252 @@ func ExecCmd(ctx context.Context, cmd, host, remoteFile, localFile string, objSi
 		return stats, rsp
 	}
 }
+
+// GetFile downloads the file from the URL given as host into localFile.
+// If doneParts is a checkpoint of a previous, unfinished download of the same
+// object and the server supports ranges, the download continues where it
+// stopped. Otherwise (or if the object changed) the file is downloaded
+// from the beginning. The checkpoint of the download is reported in
+// UpdateStats.DoneParts.
+func GetFile(ctx context.Context, host, localFile string, objSize int64,
+	doneParts types.DownloadedParts, prgNotify types.StatsNotifChan,
+	client *http.Client) (types.UpdateStats, Resp) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client == nil {
+		client = getHttpClient()
+	}
+	stats := types.UpdateStats{Size: objSize}
+	rsp := Resp{}
+	dirErr := os.MkdirAll(filepath.Dir(localFile), 0755)
+	if dirErr != nil {
+		stats.Error = dirErr
+		return stats, rsp
+	}
+	cp, err := openCheckpoint(localFile, doneParts)
+	if err != nil {
+		stats.Error = err
+		return stats, rsp
+	}
+	defer cp.close()
+	if cp.offset > 0 {
+		logrus.Infof("ExecCmd get %s: resuming from %d", host, cp.offset)
+		stats.Asize = cp.offset
+		stats.DoneParts = doneParts
+	}
+
+	var errorList []string
+	done := false
+	// is server supports ranges requests, assume it does when resuming
+	// and check it with the first response
+	supportRange := cp.offset > 0
+	forceRestart := false
+	NoSuitableAddrFound := false
+	delay := time.Second
+	appendToErrorList := func(attempt int, err error) {
+		errorList = append(errorList, fmt.Sprintf("(attempt %d/%d): %v", attempt, maxRetries, err))
+		logrus.Warnf("ExecCmd get %s failed (attempt %d/%d): %v", host, attempt, maxRetries, err)
+	}
+	// restart drops the content received so far together with its checkpoint
+	restart := func() error {
+		stats.DoneParts = types.DownloadedParts{}
+		return cp.restart()
+	}
+	// saveCheckpoint reports the checkpoint with the content received so far
+	saveCheckpoint := func() {
+		if cp.offset == cp.synced {
+			return
+		}
+		parts, err := cp.save()
+		if err != nil {
+			logrus.Warnf("ExecCmd get %s: failed to save checkpoint: %v", host, err)
+			return
+		}
+		stats.DoneParts = parts
+	}
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		//check context error on every attempt
+		if ctx.Err() != nil {
+			appendToErrorList(attempt, ctx.Err())
+			break
+		}
+		if attempt > 0 {
+			time.Sleep(delay)
+			if delay < maxDelay {
+				delay = delay * 2
+			}
+		}
+
+		// restart from the beginning if server do not support ranges or we forced to restart
+		if !supportRange || forceRestart {
+			if err := restart(); err != nil {
+				appendToErrorList(attempt, err)
+				continue
+			}
+			forceRestart = false
+		}
+		// we need innerCtx cancel to call in case of inactivity
+		innerCtx, innerCtxCancel := context.WithCancel(ctx)
+		inactivityTimer := time.AfterFunc(inactivityTimeout, func() {
+			//keep it to call cancel regardless of logic to releases resources
+			innerCtxCancel()
+		})
+		req, err := http.NewRequestWithContext(innerCtx, http.MethodGet, host, nil)
+		if err != nil {
+			stats.Error = fmt.Errorf("request failed for get %s: %s",
+				host, err)
+			return stats, Resp{}
+		}
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set("Content-Type", "application/octet-stream")
+
+		withRange := false
+		//add Range header if server supports it and we already receive data
+		if supportRange && cp.offset > 0 {
+			withRange = true
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", cp.offset))
+			// ask for the whole content if the object changed in the meantime
+			if ifRange := cp.ifRange(); ifRange != "" {
+				req.Header.Set("If-Range", ifRange)
+			}
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			// skip the error from http *net.DNSError has the suffix of "no suitable address found"
+			// for a cleaner error string output for http download failure
+			if !IsNoSuitableAddrErr(err) {
+				appendToErrorList(attempt, fmt.Errorf("client.Do failed: %s", err))
+			} else {
+				if !NoSuitableAddrFound {
+					NoSuitableAddrFound = true
+					appendToErrorList(attempt, fmt.Errorf(NoSuitableAddrStr))
+				}
+			}
+			continue
+		}
+
+		// supportRange indicates if server supports range requests
+		supportRange = resp.Header.Get("Accept-Ranges") == "bytes" ||
+			resp.StatusCode == http.StatusPartialContent
+
+		if withRange && resp.StatusCode == http.StatusOK {
+			// the server ignored the range (or the object changed),
+			// continue with the whole content
+			logrus.Warnf("ExecCmd get %s: range from %d not honored, downloading from the beginning",
+				host, cp.offset)
+			if err := restart(); err != nil {
+				resp.Body.Close()
+				appendToErrorList(attempt, err)
+				continue
+			}
+			withRange = false
+		}
+		if withRange && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// the object is shorter than what we have, start from the beginning
+			resp.Body.Close()
+			appendToErrorList(attempt, fmt.Errorf("range from %d not satisfiable", cp.offset))
+			forceRestart = true
+			continue
+		}
+		//if we not receive StatusOK for request without Range header or StatusPartialContent for request with range
+		//it indicates that server misconfigured
+		if !withRange && resp.StatusCode != http.StatusOK || withRange && resp.StatusCode != http.StatusPartialContent {
+			respErr := fmt.Errorf("bad response code: %d", resp.StatusCode)
+			err = resp.Body.Close()
+			if err != nil {
+				respErr = fmt.Errorf("respErr: %v; close Body error: %v", respErr, err)
+			}
+			appendToErrorList(attempt, respErr)
+			//we do not want to process server misconfiguration here
+			break
+		}
+		newETag := resp.Header.Get("ETag")
+		newLastModified := resp.Header.Get("Last-Modified")
+		if withRange {
+			start, total, err := parseContentRange(resp.Header.Get("Content-Range"))
+			if err != nil || start != cp.offset || cp.changed(newETag, newLastModified) {
+				// object changed or unexpected range, retry from the beginning
+				resp.Body.Close()
+				appendToErrorList(attempt, fmt.Errorf("cannot continue from %d (%s)",
+					cp.offset, resp.Header.Get("Content-Range")))
+				forceRestart = true
+				continue
+			}
+			if total >= 0 {
+				rsp.BodyLength = int(total)
+			}
+		} else {
+			// we received StatusOK which is the response for the whole content, not for the partial one
+			rsp.BodyLength = int(resp.ContentLength)
+		}
+		cp.etag, cp.lastModified = newETag, newLastModified
+		//reset to be not affected by the client.Do timeouts
+		inactivityTimer.Reset(inactivityTimeout)
+		var written int64
+		for {
+			var copyErr error
+			if written, copyErr = io.CopyN(cp, resp.Body, chunkSize); copyErr != nil && copyErr != io.EOF {
+				if innerCtx.Err() != nil {
+					// the error comes from canceled context, which indicates inactivity timeout
+					appendToErrorList(attempt, fmt.Errorf("inactivity for %s", inactivityTimeout))
+				} else {
+					appendToErrorList(attempt, fmt.Errorf("error from CopyN: %v", copyErr))
+				}
+				break
+			}
+			// we read chunk of data from response on each iteration, if data length is a multiple of chunkSize
+			// on the last iteration we will read 0 bytes and will hit written != chunkSize
+			if written != chunkSize {
+				// Must have reached EOF
+				done = true
+				break
+			}
+			//we received data so re-schedule inactivity timer
+			inactivityTimer.Reset(inactivityTimeout)
+			stats.Asize = cp.offset
+			if cp.offset-cp.synced >= checkpointSize {
+				saveCheckpoint()
+			}
+			types.SendStats(prgNotify, stats)
+		}
+		if done {
+			break
+		}
+		// keep what we have for the next attempt or after restart
+		saveCheckpoint()
+		stats.Asize = cp.offset
+		types.SendStats(prgNotify, stats)
+		err = resp.Body.Close()
+		if err != nil {
+			appendToErrorList(attempt, fmt.Errorf("error close Body: %v", err))
+		}
+	}
+	if !done {
+		stats.Error = fmt.Errorf("%s: %s", host, strings.Join(errorList, "; "))
+		return stats, rsp
+	}
+	stats.Asize = cp.offset
+	rsp.Sha256 = cp.sum()
+	return stats, rsp
+}
+
+// parseContentRange returns the first byte position and the complete length
+// from the Content-Range header ("bytes first-last/length").
+// The length is -1 if unknown.
+func parseContentRange(contentRange string) (int64, int64, error) {
+	var first, last int64
+	var length string
+	_, err := fmt.Sscanf(contentRange, "bytes %d-%d/%s", &first, &last, &length)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q: %v", contentRange, err)
+	}
+	if length == "*" {
+		return first, -1, nil
+	}
+	total, err := strconv.ParseInt(length, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q: %v", contentRange, err)
+	}
+	return first, total, nil
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mount implements datastore operations for network file systems
+// (NFS, SMB). The share is mounted by a userspace client for the duration
+// of a command.
+package mount
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	chunkSize  int64 = 1024 * 1024
+	maxRetries       = 10
+	maxDelay         = time.Minute
+)
+
+// Share is a network share which can be mounted.
+type Share interface {
+	// Mount makes files of the share accessible. Read-only mounts are used
+	// by commands which do not modify the share.
+	Mount(readOnly bool) (FS, error)
+	// String returns description of the share without credentials.
+	String() string
+}
+
+// FS gives access to files of a mounted share. Names are slash-separated
+// paths relative to the root of the share.
+type FS interface {
+	// Open opens the file for reading.
+	Open(name string) (File, error)
+	// Create creates or truncates the file for writing.
+	Create(name string) (WriteFile, error)
+	// MkdirAll creates the directory together with all missing parents.
+	MkdirAll(name string) error
+	// Remove removes the file.
+	Remove(name string) error
+	// Stat returns information about the file.
+	Stat(name string) (os.FileInfo, error)
+	// ReadDir returns information about directory entries.
+	ReadDir(name string) ([]os.FileInfo, error)
+	// Unmount releases the share, FS is no longer usable afterwards.
+	Unmount() error
+}
+
+// File is a file of a mounted share opened for reading.
+type File interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// WriteFile is a file of a mounted share opened for writing.
+type WriteFile interface {
+	io.WriteCloser
+	// Sync makes sure that the written content reached the server.
+	Sync() error
+}
+
+// Resp response data from executing commands
+type Resp struct {
+	List          []string // list of files at given path
+	ContentLength int64    // size of the remote file
+}
+
+// mounted is a mounted share which can be re-mounted.
+type mounted struct {
+	share    Share
+	readOnly bool
+	fs       FS
+}
+
+func mountShare(share Share, readOnly bool) (*mounted, error) {
+	m := &mounted{share: share, readOnly: readOnly}
+	if err := m.mount(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *mounted) mount() error {
+	fs, err := m.share.Mount(m.readOnly)
+	if err != nil {
+		return fmt.Errorf("mount of %s failed: %v", m.share, err)
+	}
+	m.fs = fs
+	return nil
+}
+
+func (m *mounted) unmount() error {
+	fs := m.fs
+	m.fs = nil
+	if err := fs.Unmount(); err != nil {
+		return fmt.Errorf("unmount of %s failed: %v", m.share, err)
+	}
+	return nil
+}
+
+// remount is used to recover from stale file handles, broken connections
+// and other errors which may persist for the lifetime of the mount.
+func (m *mounted) remount() error {
+	if m.fs != nil {
+		if err := m.unmount(); err != nil {
+			logrus.Warnf("mount.ExecCmd: %v", err)
+		}
+	}
+	return m.mount()
+}
+
+func (m *mounted) close() {
+	if m.fs == nil {
+		return
+	}
+	if err := m.unmount(); err != nil {
+		logrus.Errorf("mount.ExecCmd: %v", err)
+	}
+}
+
+// cleanPath returns the path of a file inside the share.
+// The path is not allowed to escape the share.
+func cleanPath(remoteFile string) (string, error) {
+	for _, elem := range strings.Split(remoteFile, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("invalid path %s", remoteFile)
+		}
+	}
+	return strings.TrimPrefix(path.Clean("/"+remoteFile), "/"), nil
+}
+
+// ExecCmd performs various commands such as "ls", "fetch", etc.
+// The share is mounted for the duration of the command, remoteFile is a path
+// relative to the root of the share.
+func ExecCmd(ctx context.Context, cmd string, share Share, remoteFile, localFile string,
+	objSize int64, prgNotify types.StatsNotifChan) (types.UpdateStats, Resp) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stats := types.UpdateStats{}
+	remotePath, err := cleanPath(remoteFile)
+	if err != nil {
+		stats.Error = err
+		return stats, Resp{}
+	}
+	readOnly := cmd != "put" && cmd != "rm"
+	m, err := mountShare(share, readOnly)
+	if err != nil {
+		stats.Error = err
+		return stats, Resp{}
+	}
+	defer m.close()
+	switch cmd {
+	case "ls":
+		var list []string
+		if err := walk(m.fs, remotePath, "", &list); err != nil {
+			stats.Error = fmt.Errorf("list failed for %s: %v", remoteFile, err)
+			return stats, Resp{}
+		}
+		types.SendStats(prgNotify, stats)
+		return stats, Resp{List: list}
+	case "fetch":
+		stats.Size = objSize
+		stats.Asize, stats.Error = fetch(ctx, m, remotePath, localFile,
+			stats, prgNotify)
+		return stats, Resp{}
+	case "put":
+		stats.Asize, stats.Size, stats.Error = put(m.fs, remotePath, localFile,
+			prgNotify)
+		return stats, Resp{}
+	case "stat":
+		info, err := m.fs.Stat(remotePath)
+		if err != nil {
+			stats.Error = fmt.Errorf("stat failed for %s: %v", remoteFile, err)
+			return stats, Resp{}
+		}
+		return stats, Resp{ContentLength: info.Size()}
+	case "rm":
+		if err := m.fs.Remove(remotePath); err != nil {
+			stats.Error = fmt.Errorf("remove failed for %s: %v", remoteFile, err)
+		}
+		return stats, Resp{}
+	default:
+		stats.Error = fmt.Errorf("unknown subcommand: %v", cmd)
+		return stats, Resp{}
+	}
+}
+
+// walk appends paths (relative to the root directory of the walk) of all
+// files found recursively in the directory dir.
+func walk(fs FS, dir, rel string, list *[]string) error {
+	entries, err := fs.ReadDir(path.Join(dir, rel))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		entryPath := path.Join(rel, entry.Name())
+		if entry.IsDir() {
+			if err := walk(fs, dir, entryPath, list); err != nil {
+				return err
+			}
+			continue
+		}
+		*list = append(*list, entryPath)
+	}
+	return nil
+}
+
+// Open opens the remote file for reading and returns it together with
+// its size. The share stays mounted until the returned file is closed.
+func Open(share Share, remoteFile string) (io.ReadCloser, int64, error) {
+	remotePath, err := cleanPath(remoteFile)
+	if err != nil {
+		return nil, 0, err
+	}
+	m, err := mountShare(share, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := m.fs.Stat(remotePath)
+	if err != nil {
+		m.close()
+		return nil, 0, fmt.Errorf("stat failed for %s: %v", remoteFile, err)
+	}
+	file, err := m.fs.Open(remotePath)
+	if err != nil {
+		m.close()
+		return nil, 0, fmt.Errorf("open failed for %s: %v", remoteFile, err)
+	}
+	return &mountedFile{File: file, m: m}, info.Size(), nil
+}
+
+// mountedFile unmounts the share when closed.
+type mountedFile struct {
+	File
+	m *mounted
+}
+
+func (f *mountedFile) Close() error {
+	if f.m == nil {
+		return nil
+	}
+	err := f.File.Close()
+	f.m.close()
+	f.m = nil
+	return err
+}
+
+// fetch copies remote file into localFile. On error the copy is retried
+// (with the share re-mounted) and continues where it stopped, unless the remote
+// file was modified in the meantime.
+func fetch(ctx context.Context, m *mounted, remotePath, localFile string,
+	stats types.UpdateStats, prgNotify types.StatsNotifChan) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(localFile), 0755); err != nil {
+		return 0, err
+	}
+	local, err := os.Create(localFile)
+	if err != nil {
+		return 0, err
+	}
+	defer local.Close()
+
+	var (
+		copiedSize int64
+		errorList  []string
+		lastInfo   os.FileInfo
+	)
+	delay := time.Second
+	appendToErrorList := func(attempt int, err error) {
+		errorList = append(errorList, fmt.Sprintf("(attempt %d/%d): %v", attempt, maxRetries, err))
+		logrus.Warnf("mount.ExecCmd fetch %s failed (attempt %d/%d): %v",
+			remotePath, attempt, maxRetries, err)
+	}
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if ctx.Err() != nil {
+			appendToErrorList(attempt, ctx.Err())
+			break
+		}
+		if attempt > 0 {
+			time.Sleep(delay)
+			if delay < maxDelay {
+				delay = delay * 2
+			}
+			if err := m.remount(); err != nil {
+				appendToErrorList(attempt, err)
+				continue
+			}
+		}
+		info, err := m.fs.Stat(remotePath)
+		if err != nil {
+			appendToErrorList(attempt, err)
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				break
+			}
+			continue
+		}
+		if lastInfo != nil && (info.Size() != lastInfo.Size() ||
+			!info.ModTime().Equal(lastInfo.ModTime())) {
+			// Remote file changed, restart from the beginning.
+			if err = local.Truncate(0); err != nil {
+				appendToErrorList(attempt, fmt.Errorf("failed truncate file: %v", err))
+				continue
+			}
+			copiedSize = 0
+		}
+		lastInfo = info
+		remote, err := m.fs.Open(remotePath)
+		if err != nil {
+			appendToErrorList(attempt, err)
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				break
+			}
+			continue
+		}
+		if _, err = local.Seek(copiedSize, io.SeekStart); err == nil {
+			_, err = remote.Seek(copiedSize, io.SeekStart)
+		}
+		if err != nil {
+			remote.Close()
+			appendToErrorList(attempt, fmt.Errorf("failed seek file: %v", err))
+			continue
+		}
+		done := false
+		for {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+				break
+			}
+			var written int64
+			written, err = io.CopyN(local, remote, chunkSize)
+			copiedSize += written
+			if err == io.EOF {
+				err = nil
+				done = true
+				break
+			}
+			if err != nil {
+				break
+			}
+			stats.Asize = copiedSize
+			types.SendStats(prgNotify, stats)
+		}
+		remote.Close()
+		if done {
+			return copiedSize, nil
+		}
+		appendToErrorList(attempt, err)
+	}
+	return copiedSize, fmt.Errorf("%s: %s", m.share, strings.Join(errorList, "; "))
+}
+
+// put copies localFile into the share.
+// Returns the number of bytes copied and the size of the local file.
+func put(fs FS, remotePath, localFile string,
+	prgNotify types.StatsNotifChan) (int64, int64, error) {
+	local, err := os.Open(localFile)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer local.Close()
+	info, err := local.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	if dir := path.Dir(remotePath); dir != "." {
+		if err = fs.MkdirAll(dir); err != nil {
+			return 0, info.Size(), fmt.Errorf("mkdir failed for %s: %v", dir, err)
+		}
+	}
+	remote, err := fs.Create(remotePath)
+	if err != nil {
+		return 0, info.Size(), fmt.Errorf("create failed for %s: %v",
+			remotePath, err)
+	}
+	stats := types.UpdateStats{Size: info.Size()}
+	for {
+		var written int64
+		written, err = io.CopyN(remote, local, chunkSize)
+		stats.Asize += written
+		if err != nil {
+			break
+		}
+		types.SendStats(prgNotify, stats)
+	}
+	if err == io.EOF {
+		// Make sure that the content reached the server.
+		err = remote.Sync()
+	}
+	if closeErr := remote.Close(); err == nil {
+		err = closeErr
+	}
+	return stats.Asize, stats.Size, err
+}
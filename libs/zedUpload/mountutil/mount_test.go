@@ -0,0 +1,299 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package mount_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	nfs "github.com/lf-edge/eve/libs/zedUpload/nfsutil"
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/nfstest"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+const chunkSize = 1024 * 1024
+
+// newServer starts an NFS server exporting a temporary directory.
+func newServer(t *testing.T) (*nfstest.Server, *nfs.Share) {
+	srv, err := nfstest.NewServer(tempDir(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	share := &nfs.Share{
+		Server: srv.Addr().IP,
+		Port:   srv.Addr().Port,
+		Export: nfstest.Export,
+	}
+	return srv, share
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "mount_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testContent(size int) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	return content
+}
+
+func TestFetch(t *testing.T) {
+	srv, share := newServer(t)
+	local := tempDir(t)
+	content := testContent(chunkSize*2 + 100)
+	writeFile(t, filepath.Join(srv.Dir, "images/disk.img"), content)
+
+	prgChan := make(types.StatsNotifChan, 10)
+	localFile := filepath.Join(local, "downloads/disk.img")
+	stats, _ := mount.ExecCmd(context.Background(), "fetch", share, "images/disk.img",
+		localFile, int64(len(content)), prgChan)
+	if stats.Error != nil {
+		t.Fatalf("fetch failed: %v", stats.Error)
+	}
+	if stats.Asize != int64(len(content)) {
+		t.Errorf("expected %d bytes, got %d", len(content), stats.Asize)
+	}
+	close(prgChan)
+	var updates int
+	for range prgChan {
+		updates++
+	}
+	if updates == 0 {
+		t.Errorf("no progress reported")
+	}
+	got, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("downloaded content differs")
+	}
+
+	stats, _ = mount.ExecCmd(context.Background(), "fetch", share, "images/missing.img",
+		localFile, 0, nil)
+	if stats.Error == nil {
+		t.Errorf("fetch of missing file succeeded")
+	}
+}
+
+func TestFetchResume(t *testing.T) {
+	srv, share := newServer(t)
+	local := tempDir(t)
+	content := testContent(chunkSize + chunkSize/2)
+	writeFile(t, filepath.Join(srv.Dir, "disk.img"), content)
+
+	// The first attempt fails in the middle of the second chunk,
+	// the reads after the failure are recorded.
+	var (
+		mu          sync.Mutex
+		failedAt    int64 = -1
+		readsResume []int64
+	)
+	srv.ReadHook = func(name string, offset int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if failedAt >= 0 {
+			readsResume = append(readsResume, offset)
+			return nil
+		}
+		if offset > chunkSize {
+			failedAt = offset
+			return errors.New("injected failure")
+		}
+		return nil
+	}
+
+	localFile := filepath.Join(local, "disk.img")
+	stats, _ := mount.ExecCmd(context.Background(), "fetch", share,
+		"disk.img", localFile, int64(len(content)), nil)
+	if stats.Error != nil {
+		t.Fatalf("fetch failed: %v", stats.Error)
+	}
+	if srv.Mounts() != 2 {
+		t.Errorf("expected the share to be re-mounted, mounted %d times", srv.Mounts())
+	}
+	// The second attempt continues after the bytes received so far.
+	if failedAt < 0 || len(readsResume) == 0 || readsResume[0] != failedAt {
+		t.Errorf("expected to resume from %d, got reads from %v", failedAt, readsResume)
+	}
+	got, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("downloaded content differs")
+	}
+}
+
+func TestFetchRemoteChanged(t *testing.T) {
+	srv, share := newServer(t)
+	local := tempDir(t)
+	remoteFile := filepath.Join(srv.Dir, "disk.img")
+	writeFile(t, remoteFile, testContent(chunkSize*2))
+	content := bytes.Repeat([]byte("new content"), chunkSize/4)
+
+	// The remote file is replaced when the first attempt fails.
+	var once sync.Once
+	srv.ReadHook = func(name string, offset int64) error {
+		var err error
+		if offset > chunkSize {
+			once.Do(func() {
+				writeFile(t, remoteFile, content)
+				err = errors.New("injected failure")
+			})
+		}
+		return err
+	}
+
+	localFile := filepath.Join(local, "disk.img")
+	stats, _ := mount.ExecCmd(context.Background(), "fetch", share,
+		"disk.img", localFile, int64(len(content)), nil)
+	if stats.Error != nil {
+		t.Fatalf("fetch failed: %v", stats.Error)
+	}
+	got, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("expected the new content to be downloaded from the beginning")
+	}
+}
+
+func TestFetchCancel(t *testing.T) {
+	srv, share := newServer(t)
+	writeFile(t, filepath.Join(srv.Dir, "disk.img"), testContent(100))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats, _ := mount.ExecCmd(ctx, "fetch", share, "disk.img",
+		filepath.Join(tempDir(t), "disk.img"), 100, nil)
+	if stats.Error == nil ||
+		!strings.Contains(stats.Error.Error(), context.Canceled.Error()) {
+		t.Errorf("expected cancel error, got %v", stats.Error)
+	}
+}
+
+func TestPutListStatRemove(t *testing.T) {
+	srv, share := newServer(t)
+	local := tempDir(t)
+	content := testContent(chunkSize + 1)
+	localFile := filepath.Join(local, "upload.img")
+	writeFile(t, localFile, content)
+	writeFile(t, filepath.Join(srv.Dir, "images/other.img"), []byte("other"))
+	for i := 0; i < 5; i++ {
+		// More entries than returned by a single READDIR.
+		writeFile(t, filepath.Join(srv.Dir, "images/more", string(rune('a'+i))), nil)
+	}
+
+	stats, _ := mount.ExecCmd(context.Background(), "put", share, "images/new/upload.img",
+		localFile, 0, nil)
+	if stats.Error != nil {
+		t.Fatalf("put failed: %v", stats.Error)
+	}
+	if stats.Asize != int64(len(content)) || stats.Size != int64(len(content)) {
+		t.Errorf("unexpected put stats: %+v", stats)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(srv.Dir, "images/new/upload.img"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("uploaded content differs")
+	}
+
+	stats, resp := mount.ExecCmd(context.Background(), "ls", share, "images", "", 0, nil)
+	if stats.Error != nil {
+		t.Fatalf("ls failed: %v", stats.Error)
+	}
+	sort.Strings(resp.List)
+	expected := []string{"more/a", "more/b", "more/c", "more/d", "more/e",
+		"new/upload.img", "other.img"}
+	if strings.Join(resp.List, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected list %v, got %v", expected, resp.List)
+	}
+
+	stats, resp = mount.ExecCmd(context.Background(), "stat", share, "images/new/upload.img",
+		"", 0, nil)
+	if stats.Error != nil {
+		t.Fatalf("stat failed: %v", stats.Error)
+	}
+	if resp.ContentLength != int64(len(content)) {
+		t.Errorf("expected length %d, got %d", len(content), resp.ContentLength)
+	}
+
+	stats, _ = mount.ExecCmd(context.Background(), "rm", share, "images/new/upload.img",
+		"", 0, nil)
+	if stats.Error != nil {
+		t.Fatalf("rm failed: %v", stats.Error)
+	}
+	if _, err := os.Stat(filepath.Join(srv.Dir, "images/new/upload.img")); !os.IsNotExist(err) {
+		t.Errorf("file was not removed: %v", err)
+	}
+}
+
+func TestPathOutsideShare(t *testing.T) {
+	_, share := newServer(t)
+	stats, _ := mount.ExecCmd(context.Background(), "stat", share, "images/../../etc/passwd",
+		"", 0, nil)
+	if stats.Error == nil {
+		t.Errorf("access outside of the share was allowed")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	srv, share := newServer(t)
+	content := testContent(chunkSize + 100)
+	writeFile(t, filepath.Join(srv.Dir, "disk.img"), content)
+
+	file, size, err := mount.Open(share, "disk.img")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != int64(len(content)) {
+		t.Errorf("expected size %d, got %d", len(content), size)
+	}
+	got, err := ioutil.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("read content differs")
+	}
+	if err = file.Close(); err != nil {
+		t.Error(err)
+	}
+	if err = file.Close(); err != nil {
+		t.Errorf("second close failed: %v", err)
+	}
+
+	if _, _, err = mount.Open(share, "missing.img"); err == nil {
+		t.Errorf("open of missing file succeeded")
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rpc implements the subset of ONC RPC (RFC 5531) and XDR (RFC 4506)
+// used by the NFS client and by the in-process test server.
+package rpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Programs, versions and procedures
+const (
+	ProgPortmap = 100000
+	VersPortmap = 2
+	ProcGetPort = 3
+
+	ProgMount = 100005
+	VersMount = 3
+	ProcMnt   = 1
+	ProcUmnt  = 3
+
+	ProgNFS     = 100003
+	VersNFS     = 3
+	ProcNull    = 0
+	ProcGetattr = 1
+	ProcLookup  = 3
+	ProcRead    = 6
+	ProcWrite   = 7
+	ProcCreate  = 8
+	ProcMkdir   = 9
+	ProcRemove  = 12
+	ProcReaddir = 16
+	ProcFsinfo  = 19
+	ProcCommit  = 21
+
+	// NFSv4 has a single procedure besides NULL, the operations
+	// are carried by COMPOUND (RFC 8881, 16.2).
+	VersNFS4     = 4
+	ProcCompound = 1
+
+	// IPProtoTCP is the protocol number used in portmapper requests.
+	IPProtoTCP = 6
+)
+
+// Authentication flavors
+const (
+	AuthNone = 0
+	AuthSys  = 1
+)
+
+// Accept states of a reply
+const (
+	AcceptSuccess      = 0
+	AcceptProgUnavail  = 1
+	AcceptProgMismatch = 2
+	AcceptProcUnavail  = 3
+	AcceptGarbageArgs  = 4
+	AcceptSystemErr    = 5
+)
+
+const (
+	msgCall       = 0
+	msgReply      = 1
+	rpcVersion    = 2
+	replyAccepted = 0
+	replyDenied   = 1
+	lastFragment  = 1 << 31
+	// maxOpaque limits opaque data and strings decoded from a message.
+	maxOpaque = 1 << 24
+)
+
+// ErrShortMessage is returned when a message ends before all the expected
+// data were decoded.
+var ErrShortMessage = errors.New("short RPC message")
+
+// ErrProgUnavail is returned when the server does not provide the program.
+var ErrProgUnavail = errors.New("RPC program unavailable")
+
+// ProgMismatchError is returned when the server does not provide
+// the requested version of the program.
+type ProgMismatchError struct {
+	Low, High uint32
+}
+
+func (e *ProgMismatchError) Error() string {
+	return fmt.Sprintf("RPC program version mismatch (%d-%d)", e.Low, e.High)
+}
+
+// Writer encodes XDR data.
+type Writer struct {
+	buf []byte
+}
+
+// Uint32 appends an unsigned integer.
+func (w *Writer) Uint32(v uint32) {
+	w.buf = append(w.buf, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(w.buf[len(w.buf)-4:], v)
+}
+
+// Uint64 appends an unsigned hyper integer.
+func (w *Writer) Uint64(v uint64) {
+	w.Uint32(uint32(v >> 32))
+	w.Uint32(uint32(v))
+}
+
+// Bool appends a boolean.
+func (w *Writer) Bool(v bool) {
+	if v {
+		w.Uint32(1)
+	} else {
+		w.Uint32(0)
+	}
+}
+
+// FixedOpaque appends fixed-length opaque data.
+func (w *Writer) FixedOpaque(b []byte) {
+	w.buf = append(w.buf, b...)
+	if pad := len(b) % 4; pad != 0 {
+		w.buf = append(w.buf, make([]byte, 4-pad)...)
+	}
+}
+
+// Opaque appends variable-length opaque data.
+func (w *Writer) Opaque(b []byte) {
+	w.Uint32(uint32(len(b)))
+	w.FixedOpaque(b)
+}
+
+// String appends a string.
+func (w *Writer) String(s string) {
+	w.Opaque([]byte(s))
+}
+
+// Bytes returns the encoded data.
+func (w *Writer) Bytes() []byte {
+	return w.buf
+}
+
+// Reader decodes XDR data. The first error is kept and returned by Err,
+// values decoded after the error are zero.
+type Reader struct {
+	buf []byte
+	err error
+}
+
+// NewReader returns Reader decoding the given data.
+func NewReader(b []byte) *Reader {
+	return &Reader{buf: b}
+}
+
+func (r *Reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > len(r.buf) {
+		r.err = ErrShortMessage
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+// Uint32 decodes an unsigned integer.
+func (r *Reader) Uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+// Uint64 decodes an unsigned hyper integer.
+func (r *Reader) Uint64() uint64 {
+	return uint64(r.Uint32())<<32 | uint64(r.Uint32())
+}
+
+// Bool decodes a boolean.
+func (r *Reader) Bool() bool {
+	return r.Uint32() != 0
+}
+
+// FixedOpaque decodes fixed-length opaque data.
+func (r *Reader) FixedOpaque(n int) []byte {
+	padded := n
+	if pad := n % 4; pad != 0 {
+		padded += 4 - pad
+	}
+	b := r.next(padded)
+	if b == nil {
+		return nil
+	}
+	return b[:n]
+}
+
+// Opaque decodes variable-length opaque data.
+func (r *Reader) Opaque() []byte {
+	n := r.Uint32()
+	if r.err == nil && n > maxOpaque {
+		r.err = fmt.Errorf("RPC opaque data too long (%d bytes)", n)
+	}
+	return r.FixedOpaque(int(n))
+}
+
+// String decodes a string.
+func (r *Reader) String() string {
+	return string(r.Opaque())
+}
+
+// Err returns the first decoding error.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// WriteRecord writes the message as a single record fragment (RFC 5531, 11).
+func WriteRecord(w io.Writer, msg []byte) error {
+	buf := make([]byte, 4+len(msg))
+	binary.BigEndian.PutUint32(buf, lastFragment|uint32(len(msg)))
+	copy(buf[4:], msg)
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadRecord reads a message consisting of one or more record fragments.
+func ReadRecord(r io.Reader, maxSize int) ([]byte, error) {
+	var msg []byte
+	for {
+		var hdr [4]byte
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			return nil, err
+		}
+		marker := binary.BigEndian.Uint32(hdr[:])
+		size := int(marker &^ lastFragment)
+		if len(msg)+size > maxSize {
+			return nil, fmt.Errorf("RPC record too long (%d bytes)", len(msg)+size)
+		}
+		frag := make([]byte, size)
+		if _, err := io.ReadFull(r, frag); err != nil {
+			return nil, err
+		}
+		msg = append(msg, frag...)
+		if marker&lastFragment != 0 {
+			return msg, nil
+		}
+	}
+}
+
+// Cred is an authentication credential.
+type Cred struct {
+	Flavor uint32
+	Body   []byte
+}
+
+// AuthSysCred returns AUTH_SYS credential for the given user and group.
+func AuthSysCred(machineName string, uid, gid uint32) Cred {
+	var w Writer
+	w.Uint32(0) // stamp
+	w.String(machineName)
+	w.Uint32(uid)
+	w.Uint32(gid)
+	w.Uint32(0) // no auxiliary groups
+	return Cred{Flavor: AuthSys, Body: w.Bytes()}
+}
+
+// EncodeCall appends header of a call message, arguments of the procedure
+// are expected to follow.
+func EncodeCall(w *Writer, xid, prog, vers, proc uint32, cred Cred) {
+	w.Uint32(xid)
+	w.Uint32(msgCall)
+	w.Uint32(rpcVersion)
+	w.Uint32(prog)
+	w.Uint32(vers)
+	w.Uint32(proc)
+	w.Uint32(cred.Flavor)
+	w.Opaque(cred.Body)
+	w.Uint32(AuthNone) // verifier
+	w.Opaque(nil)
+}
+
+// DecodeReply decodes header of the reply to the call with the given xid.
+// Returns an error unless the call was accepted and executed, the results
+// of the procedure follow in the reader.
+func DecodeReply(r *Reader, xid uint32) error {
+	if replyXid := r.Uint32(); r.Err() == nil && replyXid != xid {
+		return fmt.Errorf("RPC reply xid %d does not match call xid %d", replyXid, xid)
+	}
+	if msgType := r.Uint32(); r.Err() == nil && msgType != msgReply {
+		return fmt.Errorf("unexpected RPC message type %d", msgType)
+	}
+	replyStat := r.Uint32()
+	if r.Err() != nil {
+		return r.Err()
+	}
+	if replyStat == replyDenied {
+		if rejectStat := r.Uint32(); rejectStat == 0 {
+			return fmt.Errorf("RPC call rejected: version mismatch (%d-%d)",
+				r.Uint32(), r.Uint32())
+		}
+		return fmt.Errorf("RPC call rejected: authentication error %d", r.Uint32())
+	}
+	r.Uint32() // verifier
+	r.Opaque()
+	switch acceptStat := r.Uint32(); acceptStat {
+	case AcceptSuccess:
+		return r.Err()
+	case AcceptProgUnavail:
+		return ErrProgUnavail
+	case AcceptProgMismatch:
+		err := &ProgMismatchError{Low: r.Uint32(), High: r.Uint32()}
+		if r.Err() != nil {
+			return r.Err()
+		}
+		return err
+	case AcceptProcUnavail:
+		return errors.New("RPC procedure unavailable")
+	case AcceptGarbageArgs:
+		return errors.New("RPC server failed to decode arguments")
+	default:
+		if r.Err() != nil {
+			return r.Err()
+		}
+		return fmt.Errorf("RPC call failed with status %d", acceptStat)
+	}
+}
+
+// CallHeader is the header of a call message.
+type CallHeader struct {
+	Xid  uint32
+	Prog uint32
+	Vers uint32
+	Proc uint32
+	Cred Cred
+}
+
+// DecodeCall decodes header of a call message, arguments of the procedure
+// follow in the reader.
+func DecodeCall(r *Reader) (CallHeader, error) {
+	var hdr CallHeader
+	hdr.Xid = r.Uint32()
+	if msgType := r.Uint32(); r.Err() == nil && msgType != msgCall {
+		return hdr, fmt.Errorf("unexpected RPC message type %d", msgType)
+	}
+	if vers := r.Uint32(); r.Err() == nil && vers != rpcVersion {
+		return hdr, fmt.Errorf("unsupported RPC version %d", vers)
+	}
+	hdr.Prog = r.Uint32()
+	hdr.Vers = r.Uint32()
+	hdr.Proc = r.Uint32()
+	hdr.Cred.Flavor = r.Uint32()
+	hdr.Cred.Body = r.Opaque()
+	r.Uint32() // verifier
+	r.Opaque()
+	return hdr, r.Err()
+}
+
+// EncodeReply appends header of a reply to an accepted call. Results
+// of the procedure are expected to follow when acceptStat is AcceptSuccess,
+// the lowest and the highest supported version when it is AcceptProgMismatch.
+func EncodeReply(w *Writer, xid, acceptStat uint32) {
+	w.Uint32(xid)
+	w.Uint32(msgReply)
+	w.Uint32(replyAccepted)
+	w.Uint32(AuthNone) // verifier
+	w.Opaque(nil)
+	w.Uint32(acceptStat)
+}
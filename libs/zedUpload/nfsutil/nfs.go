@@ -0,0 +1,904 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nfs implements a userspace NFS client over TCP. NFSv4.1 (RFC 8881)
+// is used when the server supports it, NFSv3 (RFC 1813) otherwise.
+// Unlike the kernel client, its sockets are bound to the selected source
+// address, and it does not depend on the NFS support of the kernel.
+package nfs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/internal/rpc"
+)
+
+const (
+	// callTimeout limits the time to connect and to get a reply to a call.
+	callTimeout = time.Minute
+	// Servers usually accept requests only from privileged source ports
+	// (the "secure" export option), these are tried first.
+	minReservedPort = 665
+	maxReservedPort = 1023
+	// defaultTransferSize is used when the server does not report
+	// its preferred sizes of READ and WRITE requests.
+	defaultTransferSize = 64 * 1024
+	maxTransferSize     = 1024 * 1024
+	// Maximum size of a reply, READ data plus headers.
+	maxReplySize   = maxTransferSize + 64*1024
+	fileHandleSize = 64
+)
+
+// portmapPort is the port of the portmapper (rpcbind) service,
+// variable to be changed by tests.
+var portmapPort = 111
+
+// Share is an NFS export.
+type Share struct {
+	// Server is the address of the NFS server.
+	Server net.IP
+	// Port of the NFS service, and of the MOUNT service used by NFSv3.
+	// When zero, NFSv4.1 uses the standard port and NFSv3 looks up
+	// both with the portmapper of the server.
+	Port int
+	// Export is the exported directory.
+	Export string
+	// SrcIP is the source address of the connections, optional.
+	SrcIP net.IP
+}
+
+// String returns description of the share.
+func (s *Share) String() string {
+	return fmt.Sprintf("%s:%s (nfs)", s.Server, s.Export)
+}
+
+// Mount mounts the export using NFSv4.1, or NFSv3 when the server does not
+// support NFSv4.1. The client does not cache any data, readOnly is not
+// needed to protect the export.
+func (s *Share) Mount(readOnly bool) (mount.FS, error) {
+	fs, err := s.mountV4()
+	if err == nil {
+		return fs, nil
+	}
+	if !errors.Is(err, errNoV4) {
+		return nil, err
+	}
+	return s.mountV3()
+}
+
+// mountV3 gets the file handle of the export with the MOUNT protocol.
+func (s *Share) mountV3() (mount.FS, error) {
+	mountPort, nfsPort := s.Port, s.Port
+	if s.Port == 0 {
+		var err error
+		if mountPort, err = s.getPort(rpc.ProgMount, rpc.VersMount); err != nil {
+			return nil, err
+		}
+		if nfsPort, err = s.getPort(rpc.ProgNFS, rpc.VersNFS); err != nil {
+			return nil, err
+		}
+	}
+	mountClient, err := s.dial(mountPort)
+	if err != nil {
+		return nil, err
+	}
+	rootFH, err := mnt(mountClient, s.Export)
+	if err != nil {
+		mountClient.close()
+		return nil, err
+	}
+	nfsClient, err := s.dial(nfsPort)
+	if err != nil {
+		umnt(mountClient, s.Export)
+		mountClient.close()
+		return nil, err
+	}
+	fs := &fileSystem{
+		share:       s,
+		mountClient: mountClient,
+		client:      nfsClient,
+		root:        rootFH,
+		readSize:    defaultTransferSize,
+		writeSize:   defaultTransferSize,
+	}
+	fs.fsinfo()
+	return fs, nil
+}
+
+// getPort asks the portmapper of the server for the TCP port of a service.
+func (s *Share) getPort(prog, vers uint32) (int, error) {
+	client, err := s.dial(portmapPort)
+	if err != nil {
+		return 0, err
+	}
+	defer client.close()
+	r, err := client.call(rpc.ProgPortmap, rpc.VersPortmap, rpc.ProcGetPort,
+		func(w *rpc.Writer) {
+			w.Uint32(prog)
+			w.Uint32(vers)
+			w.Uint32(rpc.IPProtoTCP)
+			w.Uint32(0)
+		})
+	if err != nil {
+		return 0, fmt.Errorf("portmapper request for program %d failed: %v", prog, err)
+	}
+	port := r.Uint32()
+	if r.Err() != nil {
+		return 0, r.Err()
+	}
+	if port == 0 || port > 65535 {
+		return 0, fmt.Errorf("program %d version %d is not registered with the portmapper",
+			prog, vers)
+	}
+	return int(port), nil
+}
+
+func (s *Share) dial(port int) (*rpcClient, error) {
+	addr := net.JoinHostPort(s.Server.String(), strconv.Itoa(port))
+	conn, err := dialTCP(s.SrcIP, addr)
+	if err != nil {
+		return nil, err
+	}
+	hostname, _ := os.Hostname()
+	return &rpcClient{
+		conn: conn,
+		cred: rpc.AuthSysCred(hostname, 0, 0),
+		xid:  uint32(time.Now().UnixNano()),
+	}, nil
+}
+
+// dialTCP connects to the address from the source address, trying
+// the privileged ports first.
+func dialTCP(srcIP net.IP, addr string) (net.Conn, error) {
+	start := minReservedPort + int(time.Now().UnixNano()%(maxReservedPort-minReservedPort+1))
+	for i := 0; i <= maxReservedPort-minReservedPort; i++ {
+		port := minReservedPort + (start-minReservedPort+i)%(maxReservedPort-minReservedPort+1)
+		dialer := net.Dialer{
+			Timeout:   callTimeout,
+			LocalAddr: &net.TCPAddr{IP: srcIP, Port: port},
+		}
+		conn, err := dialer.Dial("tcp", addr)
+		if err == nil {
+			return conn, nil
+		}
+		if errors.Is(err, syscall.EADDRINUSE) || errors.Is(err, syscall.EADDRNOTAVAIL) {
+			continue
+		}
+		if !errors.Is(err, syscall.EACCES) && !errors.Is(err, syscall.EPERM) {
+			return nil, err
+		}
+		// Not allowed to use privileged ports.
+		break
+	}
+	dialer := net.Dialer{Timeout: callTimeout}
+	if srcIP != nil {
+		dialer.LocalAddr = &net.TCPAddr{IP: srcIP}
+	}
+	return dialer.Dial("tcp", addr)
+}
+
+// rpcClient makes RPC calls over a TCP connection, one at a time.
+type rpcClient struct {
+	sync.Mutex
+	conn net.Conn
+	cred rpc.Cred
+	xid  uint32
+}
+
+func (c *rpcClient) call(prog, vers, proc uint32, args func(w *rpc.Writer)) (*rpc.Reader, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.xid++
+	var w rpc.Writer
+	rpc.EncodeCall(&w, c.xid, prog, vers, proc, c.cred)
+	if args != nil {
+		args(&w)
+	}
+	if err := c.conn.SetDeadline(time.Now().Add(callTimeout)); err != nil {
+		return nil, err
+	}
+	if err := rpc.WriteRecord(c.conn, w.Bytes()); err != nil {
+		return nil, err
+	}
+	reply, err := rpc.ReadRecord(c.conn, maxReplySize)
+	if err != nil {
+		return nil, err
+	}
+	r := rpc.NewReader(reply)
+	if err = rpc.DecodeReply(r, c.xid); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *rpcClient) close() {
+	c.conn.Close()
+}
+
+// mnt gets the file handle of the exported directory.
+func mnt(client *rpcClient, export string) ([]byte, error) {
+	r, err := client.call(rpc.ProgMount, rpc.VersMount, rpc.ProcMnt,
+		func(w *rpc.Writer) {
+			w.String(export)
+		})
+	if err != nil {
+		return nil, fmt.Errorf("MOUNT request failed: %v", err)
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return nil, r.Err()
+		}
+		return nil, &os.PathError{Op: "mount", Path: export, Err: statusError(status)}
+	}
+	fh := r.Opaque()
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	return fh, nil
+}
+
+func umnt(client *rpcClient, export string) {
+	// The server only uses the request to maintain the list of clients.
+	_, _ = client.call(rpc.ProgMount, rpc.VersMount, rpc.ProcUmnt,
+		func(w *rpc.Writer) {
+			w.String(export)
+		})
+}
+
+// NFS status codes which are not errno values, NFSv4 shares the NFSv3 ones.
+const (
+	statusBadHandle         = 10001
+	statusNotSync           = 10002
+	statusBadCookie         = 10003
+	statusNotSupp           = 10004
+	statusTooSmall          = 10005
+	statusServerFault       = 10006
+	statusBadType           = 10007
+	statusJukebox           = 10008
+	statusGrace             = 10013
+	statusMinorVersMismatch = 10021
+	statusCompleteAlready   = 10054
+)
+
+// statusError converts NFS (or MOUNT) status to an error. Status codes
+// below 10000 are errno values.
+func statusError(status uint32) error {
+	switch status {
+	case statusBadHandle:
+		return syscall.ESTALE
+	case statusNotSupp, statusBadType:
+		return syscall.EOPNOTSUPP
+	case statusJukebox, statusGrace:
+		return syscall.EAGAIN
+	case statusMinorVersMismatch:
+		return errMinorVersMismatch
+	case statusCompleteAlready:
+		return errCompleteAlready
+	case statusNotSync, statusBadCookie, statusTooSmall, statusServerFault:
+		return fmt.Errorf("NFS error %d", status)
+	}
+	return syscall.Errno(status)
+}
+
+// File types
+const (
+	typeRegular   = 1
+	typeDirectory = 2
+	typeSymlink   = 5
+)
+
+// fileAttr are attributes of a file (fattr3).
+type fileAttr struct {
+	Type    uint32
+	Mode    uint32
+	Size    uint64
+	FileID  uint64
+	ModTime time.Time
+}
+
+func decodeAttr(r *rpc.Reader) fileAttr {
+	var attr fileAttr
+	attr.Type = r.Uint32()
+	attr.Mode = r.Uint32()
+	r.Uint32() // nlink
+	r.Uint32() // uid
+	r.Uint32() // gid
+	attr.Size = r.Uint64()
+	r.Uint64() // used
+	r.Uint64() // rdev
+	r.Uint64() // fsid
+	attr.FileID = r.Uint64()
+	r.Uint64() // atime
+	sec, nsec := r.Uint32(), r.Uint32()
+	attr.ModTime = time.Unix(int64(sec), int64(nsec))
+	r.Uint64() // ctime
+	return attr
+}
+
+// decodePostOpAttr decodes optional attributes.
+func decodePostOpAttr(r *rpc.Reader) *fileAttr {
+	if !r.Bool() {
+		return nil
+	}
+	attr := decodeAttr(r)
+	return &attr
+}
+
+// skipWcc skips weak cache consistency data.
+func skipWcc(r *rpc.Reader) {
+	if r.Bool() {
+		r.Uint64() // size
+		r.Uint64() // mtime
+		r.Uint64() // ctime
+	}
+	decodePostOpAttr(r)
+}
+
+// fileInfo implements os.FileInfo.
+type fileInfo struct {
+	name string
+	attr fileAttr
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	return int64(fi.attr.Size)
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	mode := os.FileMode(fi.attr.Mode & 0777)
+	switch fi.attr.Type {
+	case typeRegular:
+	case typeDirectory:
+		mode |= os.ModeDir
+	case typeSymlink:
+		mode |= os.ModeSymlink
+	default:
+		mode |= os.ModeIrregular
+	}
+	return mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return fi.attr.ModTime
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.attr.Type == typeDirectory
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return nil
+}
+
+// fileSystem is a mounted export.
+type fileSystem struct {
+	share       *Share
+	mountClient *rpcClient
+	client      *rpcClient
+	root        []byte
+	readSize    int
+	writeSize   int
+}
+
+func (fs *fileSystem) call(proc uint32, args func(w *rpc.Writer)) (*rpc.Reader, error) {
+	return fs.client.call(rpc.ProgNFS, rpc.VersNFS, proc, args)
+}
+
+// fsinfo gets the preferred sizes of READ and WRITE requests.
+func (fs *fileSystem) fsinfo() {
+	r, err := fs.call(rpc.ProcFsinfo, func(w *rpc.Writer) {
+		w.Opaque(fs.root)
+	})
+	if err != nil || r.Uint32() != 0 {
+		return
+	}
+	decodePostOpAttr(r)
+	r.Uint32() // rtmax
+	rtpref := int(r.Uint32())
+	r.Uint32() // rtmult
+	r.Uint32() // wtmax
+	wtpref := int(r.Uint32())
+	if r.Err() != nil {
+		return
+	}
+	if rtpref > 0 && rtpref <= maxTransferSize {
+		fs.readSize = rtpref
+	}
+	if wtpref > 0 && wtpref <= maxTransferSize {
+		fs.writeSize = wtpref
+	}
+}
+
+// lookup returns the file handle and the attributes of the file.
+func (fs *fileSystem) lookup(name string) ([]byte, fileAttr, error) {
+	fh := fs.root
+	attr, err := fs.getattr(fh)
+	if err != nil {
+		return nil, attr, &os.PathError{Op: "getattr", Path: "/", Err: err}
+	}
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "" || elem == "." {
+			continue
+		}
+		if attr.Type != typeDirectory {
+			return nil, attr, &os.PathError{Op: "lookup", Path: name, Err: syscall.ENOTDIR}
+		}
+		fh, attr, err = fs.lookupIn(fh, elem)
+		if err != nil {
+			return nil, attr, &os.PathError{Op: "lookup", Path: name, Err: err}
+		}
+	}
+	return fh, attr, nil
+}
+
+func (fs *fileSystem) lookupIn(dirFH []byte, name string) ([]byte, fileAttr, error) {
+	r, err := fs.call(rpc.ProcLookup, func(w *rpc.Writer) {
+		w.Opaque(dirFH)
+		w.String(name)
+	})
+	if err != nil {
+		return nil, fileAttr{}, err
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return nil, fileAttr{}, r.Err()
+		}
+		return nil, fileAttr{}, statusError(status)
+	}
+	fh := r.Opaque()
+	attr := decodePostOpAttr(r)
+	if r.Err() != nil {
+		return nil, fileAttr{}, r.Err()
+	}
+	if attr == nil {
+		a, err := fs.getattr(fh)
+		return fh, a, err
+	}
+	return fh, *attr, nil
+}
+
+func (fs *fileSystem) getattr(fh []byte) (fileAttr, error) {
+	r, err := fs.call(rpc.ProcGetattr, func(w *rpc.Writer) {
+		w.Opaque(fh)
+	})
+	if err != nil {
+		return fileAttr{}, err
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return fileAttr{}, r.Err()
+		}
+		return fileAttr{}, statusError(status)
+	}
+	attr := decodeAttr(r)
+	return attr, r.Err()
+}
+
+// splitPath returns the directory and the base name of the file.
+func splitPath(name string) (string, string, error) {
+	dir, base := path.Split(strings.Trim(name, "/"))
+	if base == "" || base == "." {
+		return "", "", fmt.Errorf("invalid file name %q", name)
+	}
+	return dir, base, nil
+}
+
+// Open opens the file for reading.
+func (fs *fileSystem) Open(name string) (mount.File, error) {
+	fh, attr, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if attr.Type == typeDirectory {
+		return nil, &os.PathError{Op: "open", Path: name, Err: syscall.EISDIR}
+	}
+	return &file{fs: fs, name: name, fh: fh}, nil
+}
+
+// Create creates or truncates the file for writing.
+func (fs *fileSystem) Create(name string) (mount.WriteFile, error) {
+	dir, base, err := splitPath(name)
+	if err != nil {
+		return nil, err
+	}
+	dirFH, _, err := fs.lookup(dir)
+	if err != nil {
+		return nil, err
+	}
+	r, err := fs.call(rpc.ProcCreate, func(w *rpc.Writer) {
+		w.Opaque(dirFH)
+		w.String(base)
+		w.Uint32(0) // UNCHECKED
+		w.Bool(true)
+		w.Uint32(0644) // mode
+		w.Bool(false)  // uid
+		w.Bool(false)  // gid
+		w.Bool(true)
+		w.Uint64(0) // truncate
+		w.Uint32(0) // atime DONT_CHANGE
+		w.Uint32(1) // mtime SET_TO_SERVER_TIME
+	})
+	if err != nil {
+		return nil, &os.PathError{Op: "create", Path: name, Err: err}
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return nil, r.Err()
+		}
+		return nil, &os.PathError{Op: "create", Path: name, Err: statusError(status)}
+	}
+	var fh []byte
+	if r.Bool() {
+		fh = r.Opaque()
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	if fh == nil {
+		// The server did not return the handle.
+		if fh, _, err = fs.lookupIn(dirFH, base); err != nil {
+			return nil, &os.PathError{Op: "lookup", Path: name, Err: err}
+		}
+	}
+	return &file{fs: fs, name: name, fh: fh}, nil
+}
+
+// MkdirAll creates the directory together with all missing parents.
+func (fs *fileSystem) MkdirAll(name string) error {
+	fh := fs.root
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "" || elem == "." {
+			continue
+		}
+		childFH, attr, err := fs.lookupIn(fh, elem)
+		if errors.Is(err, syscall.ENOENT) {
+			childFH, err = fs.mkdir(fh, elem)
+			if errors.Is(err, syscall.EEXIST) {
+				// Created concurrently.
+				childFH, attr, err = fs.lookupIn(fh, elem)
+			} else {
+				attr.Type = typeDirectory
+			}
+		}
+		if err != nil {
+			return &os.PathError{Op: "mkdir", Path: name, Err: err}
+		}
+		if attr.Type != typeDirectory {
+			return &os.PathError{Op: "mkdir", Path: name, Err: syscall.ENOTDIR}
+		}
+		fh = childFH
+	}
+	return nil
+}
+
+func (fs *fileSystem) mkdir(dirFH []byte, name string) ([]byte, error) {
+	r, err := fs.call(rpc.ProcMkdir, func(w *rpc.Writer) {
+		w.Opaque(dirFH)
+		w.String(name)
+		w.Bool(true)
+		w.Uint32(0755) // mode
+		w.Bool(false)  // uid
+		w.Bool(false)  // gid
+		w.Bool(false)  // size
+		w.Uint32(0)    // atime DONT_CHANGE
+		w.Uint32(0)    // mtime DONT_CHANGE
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return nil, r.Err()
+		}
+		return nil, statusError(status)
+	}
+	var fh []byte
+	if r.Bool() {
+		fh = r.Opaque()
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	if fh == nil {
+		fh, _, err = fs.lookupIn(dirFH, name)
+	}
+	return fh, err
+}
+
+// Remove removes the file.
+func (fs *fileSystem) Remove(name string) error {
+	dir, base, err := splitPath(name)
+	if err != nil {
+		return err
+	}
+	dirFH, _, err := fs.lookup(dir)
+	if err != nil {
+		return err
+	}
+	r, err := fs.call(rpc.ProcRemove, func(w *rpc.Writer) {
+		w.Opaque(dirFH)
+		w.String(base)
+	})
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: name, Err: err}
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return r.Err()
+		}
+		return &os.PathError{Op: "remove", Path: name, Err: statusError(status)}
+	}
+	return r.Err()
+}
+
+// Stat returns information about the file.
+func (fs *fileSystem) Stat(name string) (os.FileInfo, error) {
+	_, attr, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{name: path.Base("/" + name), attr: attr}, nil
+}
+
+// ReadDir returns information about directory entries.
+func (fs *fileSystem) ReadDir(name string) ([]os.FileInfo, error) {
+	dirFH, attr, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if attr.Type != typeDirectory {
+		return nil, &os.PathError{Op: "readdir", Path: name, Err: syscall.ENOTDIR}
+	}
+	var (
+		entries    []os.FileInfo
+		cookie     uint64
+		cookieVerf []byte = make([]byte, 8)
+	)
+	for {
+		r, err := fs.call(rpc.ProcReaddir, func(w *rpc.Writer) {
+			w.Opaque(dirFH)
+			w.Uint64(cookie)
+			w.FixedOpaque(cookieVerf)
+			w.Uint32(uint32(fs.readSize))
+		})
+		if err != nil {
+			return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+		}
+		if status := r.Uint32(); status != 0 {
+			if r.Err() != nil {
+				return nil, r.Err()
+			}
+			return nil, &os.PathError{Op: "readdir", Path: name, Err: statusError(status)}
+		}
+		decodePostOpAttr(r)
+		cookieVerf = r.FixedOpaque(8)
+		var names []string
+		for r.Bool() {
+			r.Uint64() // fileid
+			names = append(names, r.String())
+			cookie = r.Uint64()
+		}
+		eof := r.Bool()
+		if r.Err() != nil {
+			return nil, r.Err()
+		}
+		for _, entryName := range names {
+			if entryName == "." || entryName == ".." {
+				continue
+			}
+			_, entryAttr, err := fs.lookupIn(dirFH, entryName)
+			if errors.Is(err, syscall.ENOENT) {
+				// Removed in the meantime.
+				continue
+			}
+			if err != nil {
+				return nil, &os.PathError{Op: "lookup",
+					Path: path.Join(name, entryName), Err: err}
+			}
+			entries = append(entries, &fileInfo{name: entryName, attr: entryAttr})
+		}
+		if eof || len(names) == 0 {
+			return entries, nil
+		}
+	}
+}
+
+// Unmount closes connections to the server.
+func (fs *fileSystem) Unmount() error {
+	umnt(fs.mountClient, fs.share.Export)
+	fs.mountClient.close()
+	fs.client.close()
+	return nil
+}
+
+// file is an open file. NFSv3 is stateless, the file only keeps
+// the handle and the offset.
+type file struct {
+	fs     *fileSystem
+	name   string
+	fh     []byte
+	offset int64
+	// Verifier of unstable writes not committed yet.
+	writeVerf []byte
+}
+
+func (f *file) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	count := len(p)
+	if count > f.fs.readSize {
+		count = f.fs.readSize
+	}
+	r, err := f.fs.call(rpc.ProcRead, func(w *rpc.Writer) {
+		w.Opaque(f.fh)
+		w.Uint64(uint64(f.offset))
+		w.Uint32(uint32(count))
+	})
+	if err != nil {
+		return 0, &os.PathError{Op: "read", Path: f.name, Err: err}
+	}
+	if status := r.Uint32(); status != 0 {
+		if r.Err() != nil {
+			return 0, r.Err()
+		}
+		return 0, &os.PathError{Op: "read", Path: f.name, Err: statusError(status)}
+	}
+	decodePostOpAttr(r)
+	r.Uint32() // count
+	eof := r.Bool()
+	data := r.Opaque()
+	if r.Err() != nil {
+		return 0, r.Err()
+	}
+	if len(data) > len(p) {
+		return 0, fmt.Errorf("NFS server returned %d bytes, %d were requested",
+			len(data), count)
+	}
+	n := copy(p, data)
+	f.offset += int64(n)
+	if n == 0 {
+		if eof {
+			return 0, io.EOF
+		}
+		return 0, io.ErrNoProgress
+	}
+	return n, nil
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		attr, err := f.fs.getattr(f.fh)
+		if err != nil {
+			return 0, &os.PathError{Op: "seek", Path: f.name, Err: err}
+		}
+		offset += int64(attr.Size)
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, &os.PathError{Op: "seek", Path: f.name, Err: syscall.EINVAL}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		data := p[written:]
+		if len(data) > f.fs.writeSize {
+			data = data[:f.fs.writeSize]
+		}
+		n, err := f.write(data)
+		written += n
+		if err != nil {
+			return written, &os.PathError{Op: "write", Path: f.name, Err: err}
+		}
+	}
+	return written, nil
+}
+
+func (f *file) write(data []byte) (int, error) {
+	r, err := f.fs.call(rpc.ProcWrite, func(w *rpc.Writer) {
+		w.Opaque(f.fh)
+		w.Uint64(uint64(f.offset))
+		w.Uint32(uint32(len(data)))
+		w.Uint32(0) // UNSTABLE, committed by Sync
+		w.Opaque(data)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if status := r.Uint32(); status != 0 {
+		skipWcc(r)
+		if r.Err() != nil {
+			return 0, r.Err()
+		}
+		return 0, statusError(status)
+	}
+	skipWcc(r)
+	count := int(r.Uint32())
+	r.Uint32() // committed
+	verf := r.FixedOpaque(8)
+	if r.Err() != nil {
+		return 0, r.Err()
+	}
+	if count == 0 || count > len(data) {
+		return 0, fmt.Errorf("NFS server wrote %d of %d bytes", count, len(data))
+	}
+	if err = checkWriteVerf(&f.writeVerf, verf); err != nil {
+		return 0, err
+	}
+	f.offset += int64(count)
+	return count, nil
+}
+
+// checkWriteVerf detects restart of the server, which loses uncommitted
+// data. The first verifier since the last commit is saved.
+func checkWriteVerf(saved *[]byte, verf []byte) error {
+	if *saved == nil {
+		*saved = append([]byte{}, verf...)
+		return nil
+	}
+	if string(verf) != string(*saved) {
+		return errors.New("NFS server restarted, written data were lost")
+	}
+	return nil
+}
+
+// Sync commits written data to the stable storage of the server.
+func (f *file) Sync() error {
+	if f.writeVerf == nil {
+		return nil
+	}
+	r, err := f.fs.call(rpc.ProcCommit, func(w *rpc.Writer) {
+		w.Opaque(f.fh)
+		w.Uint64(0)
+		w.Uint32(0) // up to the end of the file
+	})
+	if err != nil {
+		return &os.PathError{Op: "commit", Path: f.name, Err: err}
+	}
+	if status := r.Uint32(); status != 0 {
+		skipWcc(r)
+		if r.Err() != nil {
+			return r.Err()
+		}
+		return &os.PathError{Op: "commit", Path: f.name, Err: statusError(status)}
+	}
+	skipWcc(r)
+	verf := r.FixedOpaque(8)
+	if r.Err() != nil {
+		return r.Err()
+	}
+	if err = checkWriteVerf(&f.writeVerf, verf); err != nil {
+		return &os.PathError{Op: "commit", Path: f.name, Err: err}
+	}
+	f.writeVerf = nil
+	return nil
+}
+
+// Close commits written data, if not done by Sync.
+func (f *file) Close() error {
+	return f.Sync()
+}
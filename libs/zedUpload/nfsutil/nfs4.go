@@ -0,0 +1,988 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nfs
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/internal/rpc"
+)
+
+// NFSv4.1 (RFC 8881) operations
+const (
+	opClose           = 4
+	opCommit          = 5
+	opCreate          = 6
+	opGetattr         = 9
+	opGetfh           = 10
+	opLookup          = 15
+	opOpen            = 18
+	opPutfh           = 22
+	opPutrootfh       = 24
+	opRead            = 25
+	opReaddir         = 26
+	opRemove          = 28
+	opWrite           = 38
+	opExchangeID      = 42
+	opCreateSession   = 43
+	opDestroySession  = 44
+	opSequence        = 53
+	opDestroyClientID = 57
+	opReclaimComplete = 58
+)
+
+// Attributes of files, see fileAttr
+const (
+	attrType       = 1
+	attrSize       = 4
+	attrFileID     = 20
+	attrMode       = 33
+	attrTimeModify = 53
+)
+
+const (
+	minorVersion  = 1
+	sessionIDSize = 16
+	verifierSize  = 8
+	stateIDSize   = 16
+	// minOperations is the number of operations in a COMPOUND needed
+	// by the client, requestedOperations is requested for the session.
+	minOperations       = 5
+	requestedOperations = 16
+	// compoundOverhead is the size of COMPOUND headers accounted for
+	// when the sizes of READ and WRITE are derived from the limits
+	// of the session.
+	compoundOverhead = 4096
+
+	shareAccessRead        = 1
+	shareAccessWrite       = 2
+	shareAccessWantNoDeleg = 0x0400
+	openNoCreate           = 0
+	openCreate             = 1
+	createUnchecked        = 0
+	claimNull              = 0
+	delegateNone           = 0
+	delegateRead           = 1
+	delegateWrite          = 2
+	delegateNoneExt        = 3
+	whyNoDelegContention   = 1
+	whyNoDelegResource     = 2
+	limitSize              = 1
+	stableUnstable         = 0
+)
+
+// nfsPort is the port of the NFS service used by NFSv4, which does not
+// need the portmapper. Variable to be changed by tests.
+var nfsPort = 2049
+
+// openOwner identifies the opens of a client, all of them belong
+// to the same owner.
+var openOwner = []byte("zedUpload")
+
+// attrRequest is the bitmap of the attributes decoded into fileAttr.
+var attrRequest = []uint32{
+	1<<attrType | 1<<attrSize | 1<<attrFileID,
+	1<<(attrMode-32) | 1<<(attrTimeModify-32),
+}
+
+var (
+	// errNoV4 is returned when the server does not support NFSv4.1.
+	errNoV4              = errors.New("NFSv4.1 is not supported by the server")
+	errMinorVersMismatch = errors.New("NFS minor version not supported")
+	errCompleteAlready   = errors.New("NFS reclaim already completed")
+)
+
+// compound is a COMPOUND request.
+type compound struct {
+	ops rpc.Writer
+	n   uint32
+}
+
+// op appends an operation, its arguments are expected to follow.
+func (c *compound) op(code uint32) *rpc.Writer {
+	c.n++
+	c.ops.Uint32(code)
+	return &c.ops
+}
+
+// putfh makes the file the current file handle, the root of the server
+// when fh is nil.
+func (c *compound) putfh(fh []byte) {
+	if fh == nil {
+		c.op(opPutrootfh)
+		return
+	}
+	c.op(opPutfh).Opaque(fh)
+}
+
+func (c *compound) getattr() {
+	w := c.op(opGetattr)
+	encodeBitmap(w, attrRequest)
+}
+
+// results are the results of a COMPOUND. The server stops processing
+// the operations at the first failure.
+type results struct {
+	*rpc.Reader
+	status uint32
+	left   uint32
+}
+
+// next decodes the header of the result of the next operation. Returns
+// an error when the operation failed, the results of the operation follow
+// in the reader otherwise.
+func (r *results) next(op uint32) error {
+	if r.left == 0 {
+		if r.status != 0 {
+			return statusError(r.status)
+		}
+		return fmt.Errorf("missing result of NFS operation %d", op)
+	}
+	r.left--
+	code, status := r.Uint32(), r.Uint32()
+	if r.Err() != nil {
+		return r.Err()
+	}
+	if code != op {
+		return fmt.Errorf("unexpected result of NFS operation %d, expected %d", code, op)
+	}
+	if status != 0 {
+		return statusError(status)
+	}
+	return nil
+}
+
+// nextPutfh decodes the result of putfh.
+func (r *results) nextPutfh(fh []byte) error {
+	if fh == nil {
+		return r.next(opPutrootfh)
+	}
+	return r.next(opPutfh)
+}
+
+func (r *results) getattr() (fileAttr, error) {
+	if err := r.next(opGetattr); err != nil {
+		return fileAttr{}, err
+	}
+	return decodeAttr4(r.Reader)
+}
+
+func (c *rpcClient) compound(req *compound) (*results, error) {
+	r, err := c.call(rpc.ProgNFS, rpc.VersNFS4, rpc.ProcCompound,
+		func(w *rpc.Writer) {
+			w.String("") // tag
+			w.Uint32(minorVersion)
+			w.Uint32(req.n)
+			w.FixedOpaque(req.ops.Bytes())
+		})
+	if err != nil {
+		return nil, err
+	}
+	res := &results{Reader: r}
+	res.status = r.Uint32()
+	r.Opaque() // tag
+	res.left = r.Uint32()
+	return res, r.Err()
+}
+
+func encodeBitmap(w *rpc.Writer, bitmap []uint32) {
+	w.Uint32(uint32(len(bitmap)))
+	for _, word := range bitmap {
+		w.Uint32(word)
+	}
+}
+
+func decodeBitmap(r *rpc.Reader) []uint32 {
+	n := r.Uint32()
+	if n > 8 {
+		return nil
+	}
+	bitmap := make([]uint32, n)
+	for i := range bitmap {
+		bitmap[i] = r.Uint32()
+	}
+	return bitmap
+}
+
+// decodeAttr4 decodes attributes (fattr4) requested with attrRequest,
+// the server may leave some of them out.
+func decodeAttr4(r *rpc.Reader) (fileAttr, error) {
+	var attr fileAttr
+	mask := decodeBitmap(r)
+	vals := rpc.NewReader(r.Opaque())
+	if r.Err() != nil {
+		return attr, r.Err()
+	}
+	for i, word := range mask {
+		if i >= len(attrRequest) && word != 0 || i < len(attrRequest) && word&^attrRequest[i] != 0 {
+			return attr, errors.New("NFS server returned attributes which were not requested")
+		}
+	}
+	has := func(attr uint) bool {
+		return int(attr/32) < len(mask) && mask[attr/32]&(1<<(attr%32)) != 0
+	}
+	if has(attrType) {
+		attr.Type = vals.Uint32()
+	}
+	if has(attrSize) {
+		attr.Size = vals.Uint64()
+	}
+	if has(attrFileID) {
+		attr.FileID = vals.Uint64()
+	}
+	if has(attrMode) {
+		attr.Mode = vals.Uint32()
+	}
+	if has(attrTimeModify) {
+		sec, nsec := int64(vals.Uint64()), vals.Uint32()
+		attr.ModTime = time.Unix(sec, int64(nsec))
+	}
+	return attr, vals.Err()
+}
+
+// skipChangeInfo skips change_info4 of directory operations.
+func skipChangeInfo(r *rpc.Reader) {
+	r.Bool()   // atomic
+	r.Uint64() // before
+	r.Uint64() // after
+}
+
+// skipDelegation skips the delegation granted by OPEN. Delegations are
+// not requested and the session has no back channel to recall them,
+// servers are not expected to grant them.
+func skipDelegation(r *rpc.Reader) {
+	skipACE := func() {
+		r.Uint32() // type
+		r.Uint32() // flag
+		r.Uint32() // access mask
+		r.Opaque() // who
+	}
+	switch r.Uint32() {
+	case delegateRead:
+		r.FixedOpaque(stateIDSize)
+		r.Bool() // recall
+		skipACE()
+	case delegateWrite:
+		r.FixedOpaque(stateIDSize)
+		r.Bool() // recall
+		if r.Uint32() == limitSize {
+			r.Uint64()
+		} else {
+			r.Uint32() // blocks
+			r.Uint32() // block size
+		}
+		skipACE()
+	case delegateNoneExt:
+		switch r.Uint32() {
+		case whyNoDelegContention, whyNoDelegResource:
+			r.Bool()
+		}
+	}
+}
+
+// isNoV4 returns true if the error means that the server does not
+// support NFSv4.1.
+func isNoV4(err error) bool {
+	var mismatch *rpc.ProgMismatchError
+	return errors.Is(err, rpc.ErrProgUnavail) || errors.As(err, &mismatch) ||
+		errors.Is(err, errMinorVersMismatch)
+}
+
+// mountV4 creates an NFSv4.1 session and looks up the export. Returns
+// errNoV4 when the server does not support NFSv4.1.
+func (s *Share) mountV4() (*fileSystem4, error) {
+	port := s.Port
+	if port == 0 {
+		port = nfsPort
+	}
+	client, err := s.dial(port)
+	if err != nil {
+		if s.Port == 0 && errors.Is(err, syscall.ECONNREFUSED) {
+			return nil, fmt.Errorf("%w: %v", errNoV4, err)
+		}
+		return nil, err
+	}
+	fs := &fileSystem4{share: s, client: client}
+	if err = fs.createSession(); err != nil {
+		client.close()
+		return nil, err
+	}
+	root, attr, err := fs.lookupFrom(nil, s.Export)
+	if err == nil && attr.Type != typeDirectory {
+		err = syscall.ENOTDIR
+	}
+	if err != nil {
+		fs.Unmount()
+		return nil, &os.PathError{Op: "mount", Path: s.Export, Err: err}
+	}
+	fs.root = root
+	return fs, nil
+}
+
+// fileSystem4 is an export mounted with NFSv4.1. The session has a single
+// slot, the requests are sent one at a time.
+type fileSystem4 struct {
+	share      *Share
+	client     *rpcClient
+	clientID   uint64
+	sessionID  []byte
+	root       []byte
+	maxLookups int
+	readSize   int
+	writeSize  int
+
+	// mu protects the slot of the session.
+	mu    sync.Mutex
+	seqID uint32
+}
+
+// createSession registers a new client with the server and creates
+// a session. Each mount is a separate client with its own owner,
+// the server would discard state of other mounts with the same owner.
+func (fs *fileSystem4) createSession() error {
+	verifier := make([]byte, verifierSize)
+	if _, err := rand.Read(verifier); err != nil {
+		return err
+	}
+	hostname, _ := os.Hostname()
+	var c compound
+	w := c.op(opExchangeID)
+	w.FixedOpaque(verifier)
+	w.Opaque([]byte(fmt.Sprintf("zedUpload/%s/%x", hostname, verifier)))
+	w.Uint32(0) // flags
+	w.Uint32(0) // SP4_NONE
+	w.Uint32(0) // no implementation ID
+	res, err := fs.client.compound(&c)
+	if err == nil {
+		err = res.next(opExchangeID)
+	}
+	if err != nil {
+		if isNoV4(err) {
+			return fmt.Errorf("%w: %v", errNoV4, err)
+		}
+		return fmt.Errorf("EXCHANGE_ID failed: %v", err)
+	}
+	fs.clientID = res.Uint64()
+	seqID := res.Uint32()
+	if res.Err() != nil {
+		return res.Err()
+	}
+
+	c = compound{}
+	w = c.op(opCreateSession)
+	w.Uint64(fs.clientID)
+	w.Uint32(seqID)
+	w.Uint32(0) // flags, no back channel
+	// fore channel
+	w.Uint32(0) // header padding
+	w.Uint32(maxReplySize)
+	w.Uint32(maxReplySize)
+	w.Uint32(compoundOverhead) // cached reply
+	w.Uint32(requestedOperations)
+	w.Uint32(1) // slots
+	w.Uint32(0) // no RDMA
+	// back channel, not used
+	w.Uint32(0)
+	w.Uint32(compoundOverhead)
+	w.Uint32(compoundOverhead)
+	w.Uint32(0)
+	w.Uint32(2)
+	w.Uint32(1)
+	w.Uint32(0)
+	w.Uint32(0) // callback program
+	w.Uint32(1)
+	w.Uint32(rpc.AuthNone)
+	if res, err = fs.client.compound(&c); err == nil {
+		err = res.next(opCreateSession)
+	}
+	if err != nil {
+		return fmt.Errorf("CREATE_SESSION failed: %v", err)
+	}
+	fs.sessionID = res.FixedOpaque(sessionIDSize)
+	res.Uint32() // sequence
+	res.Uint32() // flags
+	res.Uint32() // header padding
+	maxRequest, maxResponse := res.Uint32(), res.Uint32()
+	res.Uint32() // cached reply
+	maxOperations := res.Uint32()
+	if res.Err() != nil {
+		return res.Err()
+	}
+	if maxOperations < minOperations {
+		fs.destroySession()
+		return fmt.Errorf("NFS server allows only %d operations in a request", maxOperations)
+	}
+	fs.maxLookups = int(maxOperations) - 4 // SEQUENCE, PUTFH, GETFH and GETATTR
+	fs.readSize = transferSize(maxResponse)
+	fs.writeSize = transferSize(maxRequest)
+	if fs.readSize <= 0 || fs.writeSize <= 0 {
+		fs.destroySession()
+		return fmt.Errorf("NFS server allows too small requests (%d) or replies (%d)",
+			maxRequest, maxResponse)
+	}
+
+	// The client has no state to reclaim, servers do not allow it to open
+	// files until it declares so.
+	c = compound{}
+	c.op(opReclaimComplete).Bool(false) // all file systems
+	if res, err = fs.call(&c); err == nil {
+		err = res.next(opReclaimComplete)
+	}
+	if err != nil && !errors.Is(err, errCompleteAlready) {
+		fs.destroySession()
+		return fmt.Errorf("RECLAIM_COMPLETE failed: %v", err)
+	}
+	return nil
+}
+
+// transferSize returns the size of READ or WRITE data fitting
+// into the size limit of the session.
+func transferSize(limit uint32) int {
+	size := int(limit) - compoundOverhead
+	if size > maxTransferSize {
+		size = maxTransferSize
+	}
+	return size
+}
+
+// destroySession releases the session and the client. The server releases
+// them also when the lease of the client expires.
+func (fs *fileSystem4) destroySession() {
+	var c compound
+	c.op(opDestroySession).FixedOpaque(fs.sessionID)
+	_, _ = fs.client.compound(&c)
+	c = compound{}
+	c.op(opDestroyClientID).Uint64(fs.clientID)
+	_, _ = fs.client.compound(&c)
+}
+
+// call sends the operations preceded by SEQUENCE, the results
+// of the operations follow in the results.
+func (fs *fileSystem4) call(c *compound) (*results, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var req compound
+	w := req.op(opSequence)
+	w.FixedOpaque(fs.sessionID)
+	w.Uint32(fs.seqID + 1)
+	w.Uint32(0)   // slot
+	w.Uint32(0)   // highest slot
+	w.Bool(false) // do not cache the reply
+	w.FixedOpaque(c.ops.Bytes())
+	req.n += c.n
+	res, err := fs.client.compound(&req)
+	if err != nil {
+		return nil, err
+	}
+	if err = res.next(opSequence); err != nil {
+		return nil, fmt.Errorf("SEQUENCE failed: %v", err)
+	}
+	fs.seqID++
+	res.FixedOpaque(sessionIDSize)
+	res.Uint32() // sequence
+	res.Uint32() // slot
+	res.Uint32() // highest slot
+	res.Uint32() // target highest slot
+	res.Uint32() // status flags
+	return res, res.Err()
+}
+
+// lookup returns the file handle and the attributes of the file.
+func (fs *fileSystem4) lookup(name string) ([]byte, fileAttr, error) {
+	fh, attr, err := fs.lookupFrom(fs.root, name)
+	if err != nil {
+		return nil, attr, &os.PathError{Op: "lookup", Path: name, Err: err}
+	}
+	return fh, attr, nil
+}
+
+// lookupFrom looks up the path in the directory, in the root of the server
+// when dirFH is nil. Long paths are looked up by several requests.
+func (fs *fileSystem4) lookupFrom(dirFH []byte, name string) ([]byte, fileAttr, error) {
+	var elems []string
+	for _, elem := range strings.Split(name, "/") {
+		if elem != "" && elem != "." {
+			elems = append(elems, elem)
+		}
+	}
+	fh := dirFH
+	for {
+		n := len(elems)
+		if n > fs.maxLookups {
+			n = fs.maxLookups
+		}
+		var c compound
+		c.putfh(fh)
+		for _, elem := range elems[:n] {
+			c.op(opLookup).String(elem)
+		}
+		c.op(opGetfh)
+		c.getattr()
+		res, err := fs.call(&c)
+		if err == nil {
+			err = res.nextPutfh(fh)
+		}
+		for i := 0; i < n && err == nil; i++ {
+			err = res.next(opLookup)
+		}
+		if err == nil {
+			err = res.next(opGetfh)
+		}
+		if err != nil {
+			return nil, fileAttr{}, err
+		}
+		fh = res.Opaque()
+		attr, err := res.getattr()
+		if err != nil {
+			return nil, attr, err
+		}
+		if elems = elems[n:]; len(elems) == 0 {
+			return fh, attr, nil
+		}
+	}
+}
+
+func (fs *fileSystem4) getattr(fh []byte) (fileAttr, error) {
+	var c compound
+	c.putfh(fh)
+	c.getattr()
+	res, err := fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err != nil {
+		return fileAttr{}, err
+	}
+	return res.getattr()
+}
+
+// open opens the file in the directory, for writing when create is set,
+// in which case the file is created or truncated.
+func (fs *fileSystem4) open(name string, create bool) (*file4, error) {
+	dir, base, err := splitPath(name)
+	if err != nil {
+		return nil, err
+	}
+	dirFH, _, err := fs.lookup(dir)
+	if err != nil {
+		return nil, err
+	}
+	var c compound
+	c.putfh(dirFH)
+	w := c.op(opOpen)
+	w.Uint32(0) // sequence, not used by NFSv4.1
+	if create {
+		w.Uint32(shareAccessWrite | shareAccessWantNoDeleg)
+	} else {
+		w.Uint32(shareAccessRead | shareAccessWantNoDeleg)
+	}
+	w.Uint32(0) // deny none
+	w.Uint64(fs.clientID)
+	w.Opaque(openOwner)
+	if create {
+		w.Uint32(openCreate)
+		w.Uint32(createUnchecked)
+		// An existing file is truncated.
+		encodeBitmap(w, []uint32{1 << attrSize, 1 << (attrMode - 32)})
+		var vals rpc.Writer
+		vals.Uint64(0)
+		vals.Uint32(0644)
+		w.Opaque(vals.Bytes())
+	} else {
+		w.Uint32(openNoCreate)
+	}
+	w.Uint32(claimNull)
+	w.String(base)
+	c.op(opGetfh)
+	res, err := fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opOpen)
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+	f := &file4{fs: fs, name: name}
+	f.stateID = res.FixedOpaque(stateIDSize)
+	skipChangeInfo(res.Reader)
+	res.Uint32() // flags
+	decodeBitmap(res.Reader)
+	skipDelegation(res.Reader)
+	if err = res.next(opGetfh); err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+	f.fh = res.Opaque()
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	return f, nil
+}
+
+// Open opens the file for reading.
+func (fs *fileSystem4) Open(name string) (mount.File, error) {
+	f, err := fs.open(name, false)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Create creates or truncates the file for writing.
+func (fs *fileSystem4) Create(name string) (mount.WriteFile, error) {
+	f, err := fs.open(name, true)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// MkdirAll creates the directory together with all missing parents.
+func (fs *fileSystem4) MkdirAll(name string) error {
+	fh := fs.root
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "" || elem == "." {
+			continue
+		}
+		childFH, attr, err := fs.lookupFrom(fh, elem)
+		if errors.Is(err, syscall.ENOENT) {
+			childFH, attr, err = fs.mkdir(fh, elem)
+			if errors.Is(err, syscall.EEXIST) {
+				// Created concurrently.
+				childFH, attr, err = fs.lookupFrom(fh, elem)
+			}
+		}
+		if err != nil {
+			return &os.PathError{Op: "mkdir", Path: name, Err: err}
+		}
+		if attr.Type != typeDirectory {
+			return &os.PathError{Op: "mkdir", Path: name, Err: syscall.ENOTDIR}
+		}
+		fh = childFH
+	}
+	return nil
+}
+
+func (fs *fileSystem4) mkdir(dirFH []byte, name string) ([]byte, fileAttr, error) {
+	var c compound
+	c.putfh(dirFH)
+	w := c.op(opCreate)
+	w.Uint32(typeDirectory)
+	w.String(name)
+	encodeBitmap(w, []uint32{0, 1 << (attrMode - 32)})
+	var vals rpc.Writer
+	vals.Uint32(0755)
+	w.Opaque(vals.Bytes())
+	c.op(opGetfh)
+	c.getattr()
+	res, err := fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opCreate)
+	}
+	if err != nil {
+		return nil, fileAttr{}, err
+	}
+	skipChangeInfo(res.Reader)
+	decodeBitmap(res.Reader)
+	if err = res.next(opGetfh); err != nil {
+		return nil, fileAttr{}, err
+	}
+	fh := res.Opaque()
+	attr, err := res.getattr()
+	return fh, attr, err
+}
+
+// Remove removes the file.
+func (fs *fileSystem4) Remove(name string) error {
+	dir, base, err := splitPath(name)
+	if err != nil {
+		return err
+	}
+	dirFH, _, err := fs.lookup(dir)
+	if err != nil {
+		return err
+	}
+	var c compound
+	c.putfh(dirFH)
+	c.op(opRemove).String(base)
+	res, err := fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opRemove)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: name, Err: err}
+	}
+	return nil
+}
+
+// Stat returns information about the file.
+func (fs *fileSystem4) Stat(name string) (os.FileInfo, error) {
+	_, attr, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{name: path.Base("/" + name), attr: attr}, nil
+}
+
+// ReadDir returns information about directory entries.
+func (fs *fileSystem4) ReadDir(name string) ([]os.FileInfo, error) {
+	dirFH, attr, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if attr.Type != typeDirectory {
+		return nil, &os.PathError{Op: "readdir", Path: name, Err: syscall.ENOTDIR}
+	}
+	var (
+		entries    []os.FileInfo
+		cookie     uint64
+		cookieVerf = make([]byte, verifierSize)
+	)
+	for {
+		var c compound
+		c.putfh(dirFH)
+		w := c.op(opReaddir)
+		w.Uint64(cookie)
+		w.FixedOpaque(cookieVerf)
+		w.Uint32(uint32(fs.readSize)) // size of names and cookies
+		w.Uint32(uint32(fs.readSize)) // size of the result
+		encodeBitmap(w, attrRequest)
+		res, err := fs.call(&c)
+		if err == nil {
+			err = res.next(opPutfh)
+		}
+		if err == nil {
+			err = res.next(opReaddir)
+		}
+		if err != nil {
+			return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+		}
+		cookieVerf = res.FixedOpaque(verifierSize)
+		var n int
+		for res.Bool() {
+			cookie = res.Uint64()
+			entryName := res.String()
+			entryAttr, err := decodeAttr4(res.Reader)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, &fileInfo{name: entryName, attr: entryAttr})
+			n++
+		}
+		eof := res.Bool()
+		if res.Err() != nil {
+			return nil, res.Err()
+		}
+		if eof || n == 0 {
+			return entries, nil
+		}
+	}
+}
+
+// Unmount destroys the session and closes the connection.
+func (fs *fileSystem4) Unmount() error {
+	fs.destroySession()
+	fs.client.close()
+	return nil
+}
+
+// file4 is a file opened with NFSv4.1, the open is identified
+// by the state ID.
+type file4 struct {
+	fs      *fileSystem4
+	name    string
+	fh      []byte
+	stateID []byte
+	offset  int64
+	// Verifier of unstable writes not committed yet.
+	writeVerf []byte
+}
+
+func (f *file4) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	count := len(p)
+	if count > f.fs.readSize {
+		count = f.fs.readSize
+	}
+	var c compound
+	c.putfh(f.fh)
+	w := c.op(opRead)
+	w.FixedOpaque(f.stateID)
+	w.Uint64(uint64(f.offset))
+	w.Uint32(uint32(count))
+	res, err := f.fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opRead)
+	}
+	if err != nil {
+		return 0, &os.PathError{Op: "read", Path: f.name, Err: err}
+	}
+	eof := res.Bool()
+	data := res.Opaque()
+	if res.Err() != nil {
+		return 0, res.Err()
+	}
+	if len(data) > count {
+		return 0, fmt.Errorf("NFS server returned %d bytes, %d were requested",
+			len(data), count)
+	}
+	n := copy(p, data)
+	f.offset += int64(n)
+	if n == 0 {
+		if eof {
+			return 0, io.EOF
+		}
+		return 0, io.ErrNoProgress
+	}
+	return n, nil
+}
+
+func (f *file4) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		attr, err := f.fs.getattr(f.fh)
+		if err != nil {
+			return 0, &os.PathError{Op: "seek", Path: f.name, Err: err}
+		}
+		offset += int64(attr.Size)
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, &os.PathError{Op: "seek", Path: f.name, Err: syscall.EINVAL}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+func (f *file4) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		data := p[written:]
+		if len(data) > f.fs.writeSize {
+			data = data[:f.fs.writeSize]
+		}
+		n, err := f.write(data)
+		written += n
+		if err != nil {
+			return written, &os.PathError{Op: "write", Path: f.name, Err: err}
+		}
+	}
+	return written, nil
+}
+
+func (f *file4) write(data []byte) (int, error) {
+	var c compound
+	c.putfh(f.fh)
+	w := c.op(opWrite)
+	w.FixedOpaque(f.stateID)
+	w.Uint64(uint64(f.offset))
+	w.Uint32(stableUnstable) // committed by Sync
+	w.Opaque(data)
+	res, err := f.fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opWrite)
+	}
+	if err != nil {
+		return 0, err
+	}
+	count := int(res.Uint32())
+	res.Uint32() // committed
+	verf := res.FixedOpaque(verifierSize)
+	if res.Err() != nil {
+		return 0, res.Err()
+	}
+	if count == 0 || count > len(data) {
+		return 0, fmt.Errorf("NFS server wrote %d of %d bytes", count, len(data))
+	}
+	if err = checkWriteVerf(&f.writeVerf, verf); err != nil {
+		return 0, err
+	}
+	f.offset += int64(count)
+	return count, nil
+}
+
+// Sync commits written data to the stable storage of the server.
+func (f *file4) Sync() error {
+	if f.writeVerf == nil {
+		return nil
+	}
+	var c compound
+	c.putfh(f.fh)
+	w := c.op(opCommit)
+	w.Uint64(0)
+	w.Uint32(0) // up to the end of the file
+	res, err := f.fs.call(&c)
+	if err == nil {
+		err = res.next(opPutfh)
+	}
+	if err == nil {
+		err = res.next(opCommit)
+	}
+	if err != nil {
+		return &os.PathError{Op: "commit", Path: f.name, Err: err}
+	}
+	verf := res.FixedOpaque(verifierSize)
+	if res.Err() != nil {
+		return res.Err()
+	}
+	if err = checkWriteVerf(&f.writeVerf, verf); err != nil {
+		return &os.PathError{Op: "commit", Path: f.name, Err: err}
+	}
+	f.writeVerf = nil
+	return nil
+}
+
+// Close commits written data and closes the file on the server.
+func (f *file4) Close() error {
+	if f.stateID == nil {
+		return nil
+	}
+	err := f.Sync()
+	var c compound
+	c.putfh(f.fh)
+	w := c.op(opClose)
+	w.Uint32(0) // sequence, not used by NFSv4.1
+	w.FixedOpaque(f.stateID)
+	res, closeErr := f.fs.call(&c)
+	if closeErr == nil {
+		closeErr = res.next(opPutfh)
+	}
+	if closeErr == nil {
+		closeErr = res.next(opClose)
+	}
+	f.stateID = nil
+	if err == nil && closeErr != nil {
+		err = &os.PathError{Op: "close", Path: f.name, Err: closeErr}
+	}
+	return err
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nfs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/nfstest"
+)
+
+func newServer(t *testing.T) *nfstest.Server {
+	dir, err := ioutil.TempDir("", "nfs_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	srv, err := nfstest.NewServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// useServerPorts makes the client use the server for the standard ports.
+func useServerPorts(t *testing.T, srv *nfstest.Server) {
+	origPortmapPort, origNFSPort := portmapPort, nfsPort
+	portmapPort, nfsPort = srv.Addr().Port, srv.Addr().Port
+	t.Cleanup(func() { portmapPort, nfsPort = origPortmapPort, origNFSPort })
+}
+
+func TestPortmapper(t *testing.T) {
+	srv := newServer(t)
+	srv.V3Only = true
+	useServerPorts(t, srv)
+
+	share := &Share{Server: srv.Addr().IP, Export: nfstest.Export}
+	fs, err := share.Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fs.(*fileSystem); !ok {
+		t.Errorf("expected NFSv3 mount, got %T", fs)
+	}
+	fs.Unmount()
+
+	share.Export = "/missing"
+	if _, err = share.Mount(true); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error for unknown export, got %v", err)
+	}
+}
+
+func TestVersionNegotiation(t *testing.T) {
+	srv := newServer(t)
+	useServerPorts(t, srv)
+	share := &Share{Server: srv.Addr().IP, Export: nfstest.Export}
+	fs, err := share.Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fs.(*fileSystem4); !ok {
+		t.Errorf("expected NFSv4.1 mount, got %T", fs)
+	}
+	fs.Unmount()
+
+	// The export is not looked up again with NFSv3.
+	share.Export = "/missing"
+	if _, err = share.Mount(true); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error for unknown export, got %v", err)
+	}
+	if srv.Mounts() != 2 {
+		t.Errorf("expected 2 NFSv4.1 mounts, got %d", srv.Mounts())
+	}
+
+	// A server not listening on the standard port does not support NFSv4.
+	srv.V3Only = true
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nfsPort = l.Addr().(*net.TCPAddr).Port
+	l.Close()
+	share.Export = nfstest.Export
+	if fs, err = share.Mount(true); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fs.(*fileSystem); !ok {
+		t.Errorf("expected NFSv3 mount, got %T", fs)
+	}
+	fs.Unmount()
+}
+
+func TestSourceAddress(t *testing.T) {
+	srv := newServer(t)
+	srcIP := net.ParseIP("127.0.0.2")
+	if l, err := net.Listen("tcp", "127.0.0.2:0"); err != nil {
+		t.Skipf("127.0.0.2 is not available: %v", err)
+	} else {
+		l.Close()
+	}
+	share := &Share{Server: srv.Addr().IP, Port: srv.Addr().Port,
+		Export: nfstest.Export, SrcIP: srcIP}
+	fs, err := share.Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+	if _, err = fs.ReadDir(""); err != nil {
+		t.Fatal(err)
+	}
+	clients := srv.Clients()
+	if len(clients) == 0 {
+		t.Fatal("no connections accepted")
+	}
+	for _, client := range clients {
+		addr := client.(*net.TCPAddr)
+		if !addr.IP.Equal(srcIP) {
+			t.Errorf("expected connection from %s, got %s", srcIP, addr)
+		}
+		if os.Geteuid() == 0 && addr.Port > maxReservedPort {
+			t.Errorf("expected privileged source port, got %s", addr)
+		}
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	t.Run("NFSv4.1", func(t *testing.T) {
+		testReadWrite(t, false)
+	})
+	t.Run("NFSv3", func(t *testing.T) {
+		testReadWrite(t, true)
+	})
+}
+
+func testReadWrite(t *testing.T, v3Only bool) {
+	srv := newServer(t)
+	srv.V3Only = v3Only
+	share := &Share{Server: srv.Addr().IP, Port: srv.Addr().Port, Export: nfstest.Export}
+	fs, err := share.Mount(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+
+	content := make([]byte, 100*1024+7)
+	for i := range content {
+		content[i] = byte(i % 253)
+	}
+	if err = fs.MkdirAll("a/b"); err != nil {
+		t.Fatal(err)
+	}
+	w, err := fs.Create("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(srv.Dir, "a/b/file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("written content differs")
+	}
+
+	info, err := fs.Stat("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name() != "file" || info.Size() != int64(len(content)) || info.IsDir() {
+		t.Errorf("unexpected file info %s %d %v", info.Name(), info.Size(), info.Mode())
+	}
+	r, err := fs.Open("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Seek(-10, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content[len(content)-10:]) {
+		t.Errorf("read after seek differs")
+	}
+
+	if err = fs.Remove("a/b/file"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fs.Stat("a/b/file"); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got %v", err)
+	}
+	if _, err = fs.Open("a/b"); err == nil {
+		t.Errorf("directory opened as a file")
+	}
+
+	// Longer paths than looked up by a single NFSv4.1 request.
+	if err = fs.MkdirAll("a/b/c/d/e"); err != nil {
+		t.Fatal(err)
+	}
+	if w, err = fs.Create("a/b/c/d/e/file"); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := fs.ReadDir("a/b/c/d/e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "file" || entries[0].IsDir() {
+		t.Errorf("unexpected directory entries %v", entries)
+	}
+	if srv.OpenFiles() != 0 {
+		t.Errorf("%d files left open", srv.OpenFiles())
+	}
+}
@@ -0,0 +1,843 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nfstest
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/internal/rpc"
+)
+
+// NFSv4.1 operations
+const (
+	op4Close           = 4
+	op4Commit          = 5
+	op4Create          = 6
+	op4Getattr         = 9
+	op4Getfh           = 10
+	op4Lookup          = 15
+	op4Open            = 18
+	op4Putfh           = 22
+	op4Putrootfh       = 24
+	op4Read            = 25
+	op4Readdir         = 26
+	op4Remove          = 28
+	op4Write           = 38
+	op4ExchangeID      = 42
+	op4CreateSession   = 43
+	op4DestroySession  = 44
+	op4Sequence        = 53
+	op4DestroyClientID = 57
+	op4ReclaimComplete = 58
+)
+
+// NFSv4.1 status codes which are not errno values
+const (
+	status4Inval             = 22
+	status4BadHandle         = 10001
+	status4BadCookie         = 10003
+	status4NotSupp           = 10004
+	status4BadType           = 10007
+	status4NoFileHandle      = 10020
+	status4MinorVersMismatch = 10021
+	status4StaleClientID     = 10022
+	status4BadStateID        = 10025
+	status4Symlink           = 10029
+	status4AttrNotSupp       = 10032
+	status4BadName           = 10041
+	status4BadSession        = 10052
+	status4BadSlot           = 10053
+	status4SeqMisordered     = 10063
+	status4SequencePos       = 10064
+	status4RetryUncachedRep  = 10068
+	status4TooManyOps        = 10070
+	status4OpNotInSession    = 10071
+	status4ClientIDBusy      = 10074
+	status4NotOnlyOp         = 10081
+)
+
+// Attributes supported by the server
+const (
+	attrType       = 1
+	attrSize       = 4
+	attrFileID     = 20
+	attrMode       = 33
+	attrTimeModify = 53
+)
+
+const (
+	// maxOperations is the number of operations allowed in a COMPOUND,
+	// small to exercise splitting of long lookups by clients.
+	maxOperations          = 6
+	shareAccessWantNoDeleg = 0x0400
+	openCreate             = 1
+	createUnchecked        = 0
+	createGuarded          = 1
+	claimNull              = 0
+	delegateNone           = 0
+	delegateNoneExt        = 3
+	typeDirectory          = 2
+	typeBlock              = 3
+	typeChar               = 4
+	typeSymlink            = 5
+	exchgidUseNonPNFS      = 0x00010000
+	// pseudoRoot is the file handle of the root of the server,
+	// Export is its only directory.
+	pseudoRoot = "#root"
+	// Cookies 1 and 2 are reserved, the cookie of an entry is its index
+	// in the directory plus cookieBase.
+	cookieBase = 3
+)
+
+// client4 is a client registered by EXCHANGE_ID. Every EXCHANGE_ID
+// registers a new client, the clients of the tests do not retry it.
+type client4 struct {
+	// seqID is the sequence expected by CREATE_SESSION.
+	seqID uint32
+}
+
+// session4 is a session with a single slot.
+type session4 struct {
+	clientID uint64
+	seqID    uint32
+	maxOps   uint32
+}
+
+// open4 is a file opened by a client.
+type open4 struct {
+	clientID uint64
+	fh       string
+}
+
+// compoundState is the state of a COMPOUND being processed.
+type compoundState struct {
+	numOps  uint32
+	index   uint32
+	session *session4
+	// fh is the current file handle, nil until set.
+	fh []byte
+}
+
+type channelAttrs struct {
+	headerPad         uint32
+	maxRequest        uint32
+	maxResponse       uint32
+	maxResponseCached uint32
+	maxOperations     uint32
+	maxRequests       uint32
+}
+
+func decodeChannelAttrs(r *rpc.Reader) channelAttrs {
+	attrs := channelAttrs{
+		headerPad:         r.Uint32(),
+		maxRequest:        r.Uint32(),
+		maxResponse:       r.Uint32(),
+		maxResponseCached: r.Uint32(),
+		maxOperations:     r.Uint32(),
+		maxRequests:       r.Uint32(),
+	}
+	if r.Uint32() > 0 {
+		r.Uint32() // RDMA
+	}
+	return attrs
+}
+
+func encodeChannelAttrs(w *rpc.Writer, attrs channelAttrs) {
+	w.Uint32(attrs.headerPad)
+	w.Uint32(attrs.maxRequest)
+	w.Uint32(attrs.maxResponse)
+	w.Uint32(attrs.maxResponseCached)
+	w.Uint32(attrs.maxOperations)
+	w.Uint32(attrs.maxRequests)
+	w.Uint32(0) // no RDMA
+}
+
+func minUint32(a, b uint32) uint32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// OpenFiles returns the number of files opened by NFSv4.1 clients
+// and not closed yet.
+func (s *Server) OpenFiles() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.opens)
+}
+
+func (s *Server) nfs4(w *rpc.Writer, proc uint32, r *rpc.Reader) bool {
+	switch proc {
+	case rpc.ProcNull:
+		return true
+	case rpc.ProcCompound:
+	default:
+		return false
+	}
+	tag := r.Opaque()
+	minorVersion := r.Uint32()
+	numOps := r.Uint32()
+	if minorVersion != 1 {
+		w.Uint32(status4MinorVersMismatch)
+		w.Opaque(tag)
+		w.Uint32(0)
+		return true
+	}
+	c := &compoundState{numOps: numOps}
+	var (
+		res    rpc.Writer
+		status uint32
+	)
+	for ; c.index < numOps && status == 0 && r.Err() == nil; c.index++ {
+		op := r.Uint32()
+		var opRes rpc.Writer
+		status = s.op4(&opRes, c, op, r)
+		res.Uint32(op)
+		res.Uint32(status)
+		if status == 0 {
+			res.FixedOpaque(opRes.Bytes())
+		}
+	}
+	w.Uint32(status)
+	w.Opaque(tag)
+	w.Uint32(c.index)
+	w.FixedOpaque(res.Bytes())
+	return true
+}
+
+func (s *Server) op4(w *rpc.Writer, c *compoundState, op uint32, r *rpc.Reader) uint32 {
+	switch op {
+	case op4ExchangeID, op4CreateSession, op4DestroySession, op4DestroyClientID:
+		if c.session == nil && c.numOps != 1 {
+			return status4NotOnlyOp
+		}
+	case op4Sequence:
+		if c.index != 0 {
+			return status4SequencePos
+		}
+	default:
+		if c.session == nil {
+			return status4OpNotInSession
+		}
+	}
+	switch op {
+	case op4ExchangeID:
+		return s.exchangeID(w, r)
+	case op4CreateSession:
+		return s.createSession(w, r)
+	case op4DestroySession:
+		return s.destroySession(r)
+	case op4DestroyClientID:
+		return s.destroyClientID(r)
+	case op4Sequence:
+		return s.sequence(w, c, r)
+	case op4ReclaimComplete:
+		r.Bool() // one file system
+		return 0
+	case op4Putrootfh:
+		c.fh = []byte(pseudoRoot)
+		return 0
+	case op4Putfh:
+		fh := r.Opaque()
+		if _, ok := s.localPath(fh); !ok && string(fh) != pseudoRoot {
+			return status4BadHandle
+		}
+		c.fh = fh
+		return 0
+	case op4Getfh:
+		if c.fh == nil {
+			return status4NoFileHandle
+		}
+		w.Opaque(c.fh)
+		return 0
+	case op4Lookup:
+		return s.lookup4(c, r)
+	case op4Getattr:
+		request := decodeBitmap(r)
+		fi, status := s.currentStat(c)
+		if status != 0 {
+			return status
+		}
+		encodeAttr4(w, fi, request)
+		return 0
+	case op4Open:
+		return s.open4(w, c, r)
+	case op4Close:
+		return s.close4(w, c, r)
+	case op4Read:
+		return s.read4(w, c, r)
+	case op4Write:
+		return s.write4(w, c, r)
+	case op4Commit:
+		r.Uint64() // offset
+		r.Uint32() // count
+		if _, status := s.currentPath(c); status != 0 {
+			return status
+		}
+		w.FixedOpaque(writeVerf())
+		return 0
+	case op4Create:
+		return s.create4(w, c, r)
+	case op4Remove:
+		name := r.String()
+		dirPath, status := s.currentPath(c)
+		if status == 0 {
+			status = checkName(name)
+		}
+		if status != 0 {
+			return status
+		}
+		if err := os.Remove(filepath.Join(dirPath, name)); err != nil {
+			return errStatus(err)
+		}
+		encodeChangeInfo(w)
+		return 0
+	case op4Readdir:
+		return s.readdir4(w, c, r)
+	}
+	return status4NotSupp
+}
+
+func (s *Server) exchangeID(w *rpc.Writer, r *rpc.Reader) uint32 {
+	r.FixedOpaque(8) // verifier
+	r.Opaque()       // owner
+	r.Uint32()       // flags
+	if r.Uint32() != 0 {
+		// Only SP4_NONE state protection is supported.
+		return status4NotSupp
+	}
+	if r.Uint32() > 0 {
+		r.Opaque() // implementation domain
+		r.Opaque() // implementation name
+		r.Uint64() // implementation date
+		r.Uint32()
+	}
+	s.mu.Lock()
+	s.lastID++
+	clientID := s.lastID
+	s.clients4[clientID] = &client4{seqID: 1}
+	s.mu.Unlock()
+	w.Uint64(clientID)
+	w.Uint32(1) // sequence
+	w.Uint32(exchgidUseNonPNFS)
+	w.Uint32(0) // SP4_NONE
+	w.Uint64(0) // server owner
+	w.Opaque([]byte("nfstest"))
+	w.Opaque([]byte("nfstest")) // server scope
+	w.Uint32(0)                 // no implementation ID
+	return 0
+}
+
+func (s *Server) createSession(w *rpc.Writer, r *rpc.Reader) uint32 {
+	clientID := r.Uint64()
+	seqID := r.Uint32()
+	r.Uint32() // flags
+	fore := decodeChannelAttrs(r)
+	back := decodeChannelAttrs(r)
+	r.Uint32() // callback program
+	for n := r.Uint32(); n > 0 && r.Err() == nil; n-- {
+		if r.Uint32() == rpc.AuthSys {
+			r.Uint32() // stamp
+			r.Opaque() // machine name
+			r.Uint32() // uid
+			r.Uint32() // gid
+			for gids := r.Uint32(); gids > 0 && r.Err() == nil; gids-- {
+				r.Uint32()
+			}
+		}
+	}
+	if r.Err() != nil {
+		return 0
+	}
+	fore.maxRequest = minUint32(fore.maxRequest, maxRequest)
+	fore.maxResponse = minUint32(fore.maxResponse, maxRequest)
+	fore.maxOperations = minUint32(fore.maxOperations, maxOperations)
+	fore.maxRequests = 1
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	client := s.clients4[clientID]
+	if client == nil {
+		return status4StaleClientID
+	}
+	if seqID != client.seqID {
+		return status4SeqMisordered
+	}
+	client.seqID++
+	s.lastID++
+	sessionID := make([]byte, 16)
+	binary.BigEndian.PutUint64(sessionID, s.lastID)
+	s.sessions[string(sessionID)] = &session4{
+		clientID: clientID,
+		maxOps:   fore.maxOperations,
+	}
+	s.mounts++
+	w.FixedOpaque(sessionID)
+	w.Uint32(seqID)
+	w.Uint32(0) // flags
+	encodeChannelAttrs(w, fore)
+	encodeChannelAttrs(w, back)
+	return 0
+}
+
+func (s *Server) destroySession(r *rpc.Reader) uint32 {
+	sessionID := string(r.FixedOpaque(16))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions[sessionID] == nil {
+		return status4BadSession
+	}
+	delete(s.sessions, sessionID)
+	return 0
+}
+
+func (s *Server) destroyClientID(r *rpc.Reader) uint32 {
+	clientID := r.Uint64()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.clients4[clientID] == nil {
+		return status4StaleClientID
+	}
+	for _, session := range s.sessions {
+		if session.clientID == clientID {
+			return status4ClientIDBusy
+		}
+	}
+	delete(s.clients4, clientID)
+	for other, open := range s.opens {
+		if open.clientID == clientID {
+			delete(s.opens, other)
+		}
+	}
+	return 0
+}
+
+func (s *Server) sequence(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	sessionID := r.FixedOpaque(16)
+	seqID := r.Uint32()
+	slot := r.Uint32()
+	r.Uint32() // highest slot
+	r.Bool()   // cache this
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session := s.sessions[string(sessionID)]
+	switch {
+	case session == nil:
+		return status4BadSession
+	case slot != 0:
+		return status4BadSlot
+	case c.numOps > session.maxOps:
+		return status4TooManyOps
+	case seqID == session.seqID:
+		// Replies are not cached.
+		return status4RetryUncachedRep
+	case seqID != session.seqID+1:
+		return status4SeqMisordered
+	}
+	session.seqID = seqID
+	c.session = session
+	w.FixedOpaque(sessionID)
+	w.Uint32(seqID)
+	w.Uint32(0) // slot
+	w.Uint32(0) // highest slot
+	w.Uint32(0) // target highest slot
+	w.Uint32(0) // status flags
+	return 0
+}
+
+// currentPath returns the local path of the current file handle.
+func (s *Server) currentPath(c *compoundState) (string, uint32) {
+	if c.fh == nil {
+		return "", status4NoFileHandle
+	}
+	if string(c.fh) == pseudoRoot {
+		// The pseudo file system only allows to look up the export.
+		return "", uint32(syscall.EACCES)
+	}
+	localPath, ok := s.localPath(c.fh)
+	if !ok {
+		return "", status4BadHandle
+	}
+	return localPath, 0
+}
+
+func (s *Server) currentStat(c *compoundState) (os.FileInfo, uint32) {
+	var localPath string
+	if c.fh != nil && string(c.fh) == pseudoRoot {
+		localPath = s.Dir
+	} else {
+		var status uint32
+		if localPath, status = s.currentPath(c); status != 0 {
+			return nil, status
+		}
+	}
+	fi, err := os.Lstat(localPath)
+	if err != nil {
+		return nil, errStatus(err)
+	}
+	return fi, 0
+}
+
+func checkName(name string) uint32 {
+	if name == "" {
+		return status4Inval
+	}
+	if name == "." || name == ".." || strings.Contains(name, "/") {
+		return status4BadName
+	}
+	return 0
+}
+
+func (s *Server) lookup4(c *compoundState, r *rpc.Reader) uint32 {
+	name := r.String()
+	if c.fh != nil && string(c.fh) == pseudoRoot {
+		if name != strings.Trim(Export, "/") {
+			return uint32(syscall.ENOENT)
+		}
+		c.fh = []byte("/")
+		return 0
+	}
+	fi, status := s.currentStat(c)
+	if status != 0 {
+		return status
+	}
+	if !fi.IsDir() {
+		return uint32(syscall.ENOTDIR)
+	}
+	if status = checkName(name); status != 0 {
+		return status
+	}
+	localPath, _ := s.currentPath(c)
+	if _, err := os.Lstat(filepath.Join(localPath, name)); err != nil {
+		return errStatus(err)
+	}
+	c.fh = []byte(filepath.Join(string(c.fh), name))
+	return 0
+}
+
+func decodeBitmap(r *rpc.Reader) []uint32 {
+	n := r.Uint32()
+	if n > 8 {
+		return nil
+	}
+	bitmap := make([]uint32, n)
+	for i := range bitmap {
+		bitmap[i] = r.Uint32()
+	}
+	return bitmap
+}
+
+func hasAttr(bitmap []uint32, attr uint) bool {
+	return int(attr/32) < len(bitmap) && bitmap[attr/32]&(1<<(attr%32)) != 0
+}
+
+// encodeAttr4 encodes the requested attributes supported by the server.
+func encodeAttr4(w *rpc.Writer, fi os.FileInfo, request []uint32) {
+	var (
+		mask [2]uint32
+		vals rpc.Writer
+	)
+	set := func(attr uint) bool {
+		if !hasAttr(request, attr) {
+			return false
+		}
+		mask[attr/32] |= 1 << (attr % 32)
+		return true
+	}
+	if set(attrType) {
+		vals.Uint32(fileType(fi))
+	}
+	if set(attrSize) {
+		vals.Uint64(uint64(fi.Size()))
+	}
+	if set(attrFileID) {
+		vals.Uint64(fileID(fi))
+	}
+	if set(attrMode) {
+		vals.Uint32(uint32(fi.Mode().Perm()))
+	}
+	if set(attrTimeModify) {
+		mtime := fi.ModTime()
+		vals.Uint64(uint64(mtime.Unix()))
+		vals.Uint32(uint32(mtime.Nanosecond()))
+	}
+	w.Uint32(uint32(len(mask)))
+	for _, word := range mask {
+		w.Uint32(word)
+	}
+	w.Opaque(vals.Bytes())
+}
+
+// decodeSetAttr decodes attributes of created files, the size can only be
+// set to zero to truncate the file.
+func decodeSetAttr(r *rpc.Reader) (truncate bool, mode os.FileMode, status uint32) {
+	mask := decodeBitmap(r)
+	vals := rpc.NewReader(r.Opaque())
+	for i, word := range mask {
+		if i == 0 && word&^(1<<attrSize) != 0 || i == 1 && word&^(1<<(attrMode-32)) != 0 ||
+			i > 1 && word != 0 {
+			return false, 0, status4AttrNotSupp
+		}
+	}
+	if hasAttr(mask, attrSize) {
+		if vals.Uint64() != 0 {
+			return false, 0, status4Inval
+		}
+		truncate = true
+	}
+	if hasAttr(mask, attrMode) {
+		mode = os.FileMode(vals.Uint32() & 0777)
+	}
+	if vals.Err() != nil {
+		return false, 0, status4Inval
+	}
+	return truncate, mode, 0
+}
+
+func encodeChangeInfo(w *rpc.Writer) {
+	w.Bool(false) // atomic
+	w.Uint64(0)   // before
+	w.Uint64(0)   // after
+}
+
+func (s *Server) open4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	r.Uint32() // sequence
+	access := r.Uint32()
+	r.Uint32() // deny
+	r.Uint64() // client ID
+	r.Opaque() // owner
+	var (
+		create, guarded, truncate bool
+		mode                      os.FileMode = 0644
+	)
+	if r.Uint32() == openCreate {
+		create = true
+		switch r.Uint32() {
+		case createUnchecked:
+		case createGuarded:
+			guarded = true
+		default:
+			// Exclusive creation is not supported.
+			return status4NotSupp
+		}
+		var setMode os.FileMode
+		var status uint32
+		if truncate, setMode, status = decodeSetAttr(r); status != 0 {
+			return status
+		}
+		if setMode != 0 {
+			mode = setMode
+		}
+	}
+	if r.Uint32() != claimNull {
+		return status4NotSupp
+	}
+	name := r.String()
+	dirPath, status := s.currentPath(c)
+	if status == 0 {
+		status = checkName(name)
+	}
+	if status != 0 {
+		return status
+	}
+	localPath := filepath.Join(dirPath, name)
+	if create {
+		flags := os.O_WRONLY | os.O_CREATE
+		if guarded {
+			flags |= os.O_EXCL
+		}
+		f, err := os.OpenFile(localPath, flags, mode)
+		if err != nil {
+			return errStatus(err)
+		}
+		if truncate {
+			err = f.Truncate(0)
+		}
+		f.Close()
+		if err != nil {
+			return errStatus(err)
+		}
+	}
+	fi, err := os.Lstat(localPath)
+	if err != nil {
+		return errStatus(err)
+	}
+	switch fileType(fi) {
+	case typeDirectory:
+		return uint32(syscall.EISDIR)
+	case typeSymlink:
+		return status4Symlink
+	}
+	fh := filepath.Join(string(c.fh), name)
+	other := make([]byte, 12)
+	s.mu.Lock()
+	s.lastID++
+	binary.BigEndian.PutUint64(other[4:], s.lastID)
+	s.opens[string(other)] = &open4{clientID: c.session.clientID, fh: fh}
+	s.mu.Unlock()
+	c.fh = []byte(fh)
+	w.Uint32(1) // state ID
+	w.FixedOpaque(other)
+	encodeChangeInfo(w)
+	w.Uint32(0) // flags
+	w.Uint32(0) // attributes set
+	if access&shareAccessWantNoDeleg != 0 {
+		w.Uint32(delegateNoneExt)
+		w.Uint32(0) // not wanted
+	} else {
+		w.Uint32(delegateNone)
+	}
+	return 0
+}
+
+// checkStateID checks that the state ID is an open of the current file.
+func (s *Server) checkStateID(c *compoundState, stateID []byte) uint32 {
+	if len(stateID) != 16 {
+		return status4BadStateID
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	open := s.opens[string(stateID[4:])]
+	if open == nil || open.fh != string(c.fh) || open.clientID != c.session.clientID {
+		return status4BadStateID
+	}
+	return 0
+}
+
+func (s *Server) close4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	r.Uint32() // sequence
+	stateID := r.FixedOpaque(16)
+	if status := s.checkStateID(c, stateID); status != 0 {
+		return status
+	}
+	s.mu.Lock()
+	delete(s.opens, string(stateID[4:]))
+	s.mu.Unlock()
+	w.FixedOpaque(stateID)
+	return 0
+}
+
+func (s *Server) read4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	stateID := r.FixedOpaque(16)
+	offset, count := r.Uint64(), r.Uint32()
+	localPath, status := s.currentPath(c)
+	if status == 0 {
+		status = s.checkStateID(c, stateID)
+	}
+	if status != 0 {
+		return status
+	}
+	if count > maxTransfer {
+		count = maxTransfer
+	}
+	if s.ReadHook != nil {
+		if err := s.ReadHook(string(c.fh), int64(offset)); err != nil {
+			return statusIO
+		}
+	}
+	data, eof, err := readAt(localPath, int64(offset), int(count))
+	if err != nil {
+		return errStatus(err)
+	}
+	w.Bool(eof)
+	w.Opaque(data)
+	return 0
+}
+
+func (s *Server) write4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	stateID := r.FixedOpaque(16)
+	offset := r.Uint64()
+	r.Uint32() // stable
+	data := r.Opaque()
+	localPath, status := s.currentPath(c)
+	if status == 0 {
+		status = s.checkStateID(c, stateID)
+	}
+	if status != 0 {
+		return status
+	}
+	if err := writeAt(localPath, int64(offset), data); err != nil {
+		return errStatus(err)
+	}
+	w.Uint32(uint32(len(data)))
+	w.Uint32(2) // FILE_SYNC4
+	w.FixedOpaque(writeVerf())
+	return 0
+}
+
+func (s *Server) create4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	objType := r.Uint32()
+	switch objType {
+	case typeSymlink:
+		r.Opaque() // link data
+	case typeBlock, typeChar:
+		r.Uint32() // major
+		r.Uint32() // minor
+	}
+	name := r.String()
+	_, mode, status := decodeSetAttr(r)
+	if status != 0 {
+		return status
+	}
+	if objType != typeDirectory {
+		return status4BadType
+	}
+	if mode == 0 {
+		mode = 0755
+	}
+	dirPath, status := s.currentPath(c)
+	if status == 0 {
+		status = checkName(name)
+	}
+	if status != 0 {
+		return status
+	}
+	if err := os.Mkdir(filepath.Join(dirPath, name), mode); err != nil {
+		return errStatus(err)
+	}
+	c.fh = []byte(filepath.Join(string(c.fh), name))
+	encodeChangeInfo(w)
+	w.Uint32(0) // attributes set
+	return 0
+}
+
+func (s *Server) readdir4(w *rpc.Writer, c *compoundState, r *rpc.Reader) uint32 {
+	cookie := r.Uint64()
+	r.FixedOpaque(8) // cookie verifier
+	r.Uint32()       // size of names and cookies
+	r.Uint32()       // size of the result
+	request := decodeBitmap(r)
+	localPath, status := s.currentPath(c)
+	if status != 0 {
+		return status
+	}
+	if cookie != 0 && cookie < cookieBase {
+		return status4BadCookie
+	}
+	entries, err := ioutil.ReadDir(localPath)
+	if err != nil {
+		return errStatus(err)
+	}
+	start := uint64(0)
+	if cookie != 0 {
+		start = cookie - cookieBase + 1
+	}
+	w.FixedOpaque(make([]byte, 8)) // cookie verifier
+	i := start
+	for ; i < uint64(len(entries)) && i < start+maxDirEntries; i++ {
+		w.Bool(true)
+		w.Uint64(i + cookieBase)
+		w.String(entries[i].Name())
+		encodeAttr4(w, entries[i], request)
+	}
+	w.Bool(false)
+	w.Bool(i >= uint64(len(entries)))
+	return 0
+}
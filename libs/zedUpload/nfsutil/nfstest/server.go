@@ -0,0 +1,512 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nfstest provides an in-process NFS server for tests,
+// similar to net/http/httptest. It serves a local directory with
+// the portmapper, MOUNT and NFS (v3 and v4.1) services on a single
+// TCP port.
+package nfstest
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/zedUpload/nfsutil/internal/rpc"
+)
+
+const (
+	// Export is the path of the exported directory.
+	Export = "/export"
+	// maxDirEntries is the number of entries returned by a single READDIR,
+	// small to exercise paging of clients.
+	maxDirEntries = 4
+	maxTransfer   = 32 * 1024
+	maxRequest    = maxTransfer + 4096
+	statusIO      = 5
+)
+
+// Server is an NFS server exporting a local directory as Export.
+// File handles are paths relative to the directory.
+type Server struct {
+	// Dir is the exported directory.
+	Dir string
+	// ReadHook, when set, is called before each READ. A returned error
+	// fails the READ with NFS3ERR_IO (NFS4ERR_IO).
+	ReadHook func(name string, offset int64) error
+	// V3Only, when set, makes the server reject NFSv4 requests,
+	// as a server supporting only NFSv3 does.
+	V3Only bool
+
+	listener net.Listener
+	mu       sync.Mutex
+	conns    map[net.Conn]struct{}
+	mounts   int
+	clients  []net.Addr
+	wg       sync.WaitGroup
+	// NFSv4.1 state
+	lastID   uint64
+	clients4 map[uint64]*client4
+	sessions map[string]*session4
+	opens    map[string]*open4
+}
+
+// NewServer starts a server exporting the directory, listening on
+// a random port of 127.0.0.1.
+func NewServer(dir string) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Dir:      dir,
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+		clients4: make(map[uint64]*client4),
+		sessions: make(map[string]*session4),
+		opens:    make(map[string]*open4),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Addr returns the address of the server.
+func (s *Server) Addr() *net.TCPAddr {
+	return s.listener.Addr().(*net.TCPAddr)
+}
+
+// Mounts returns the number of MOUNT requests and of NFSv4.1 sessions
+// created.
+func (s *Server) Mounts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mounts
+}
+
+// Clients returns addresses of all accepted connections.
+func (s *Server) Clients() []net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]net.Addr{}, s.clients...)
+}
+
+// CloseClientConns closes all connections, as a server restart would.
+func (s *Server) CloseClientConns() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.CloseClientConns()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.clients = append(s.clients, conn.RemoteAddr())
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+	for {
+		msg, err := rpc.ReadRecord(conn, maxRequest)
+		if err != nil {
+			return
+		}
+		r := rpc.NewReader(msg)
+		hdr, err := rpc.DecodeCall(r)
+		if err != nil {
+			return
+		}
+		var w rpc.Writer
+		s.handle(&w, hdr, r)
+		if err = rpc.WriteRecord(conn, w.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) handle(w *rpc.Writer, hdr rpc.CallHeader, r *rpc.Reader) {
+	var res rpc.Writer
+	var ok bool
+	switch {
+	case hdr.Prog == rpc.ProgPortmap && hdr.Vers == rpc.VersPortmap:
+		ok = s.portmap(&res, hdr.Proc, r)
+	case hdr.Prog == rpc.ProgMount && hdr.Vers == rpc.VersMount:
+		ok = s.mount(&res, hdr.Proc, r)
+	case hdr.Prog == rpc.ProgNFS && hdr.Vers == rpc.VersNFS:
+		ok = s.nfs(&res, hdr.Proc, r)
+	case hdr.Prog == rpc.ProgNFS && hdr.Vers == rpc.VersNFS4 && !s.V3Only:
+		ok = s.nfs4(&res, hdr.Proc, r)
+	case hdr.Prog == rpc.ProgNFS:
+		rpc.EncodeReply(w, hdr.Xid, rpc.AcceptProgMismatch)
+		w.Uint32(rpc.VersNFS)
+		if s.V3Only {
+			w.Uint32(rpc.VersNFS)
+		} else {
+			w.Uint32(rpc.VersNFS4)
+		}
+		return
+	default:
+		rpc.EncodeReply(w, hdr.Xid, rpc.AcceptProgUnavail)
+		return
+	}
+	if !ok {
+		rpc.EncodeReply(w, hdr.Xid, rpc.AcceptProcUnavail)
+		return
+	}
+	if r.Err() != nil {
+		rpc.EncodeReply(w, hdr.Xid, rpc.AcceptGarbageArgs)
+		return
+	}
+	rpc.EncodeReply(w, hdr.Xid, rpc.AcceptSuccess)
+	w.FixedOpaque(res.Bytes())
+}
+
+func (s *Server) portmap(w *rpc.Writer, proc uint32, r *rpc.Reader) bool {
+	switch proc {
+	case rpc.ProcNull:
+	case rpc.ProcGetPort:
+		prog, _, proto, _ := r.Uint32(), r.Uint32(), r.Uint32(), r.Uint32()
+		if proto == rpc.IPProtoTCP && (prog == rpc.ProgMount || prog == rpc.ProgNFS) {
+			w.Uint32(uint32(s.Addr().Port))
+		} else {
+			w.Uint32(0)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Server) mount(w *rpc.Writer, proc uint32, r *rpc.Reader) bool {
+	switch proc {
+	case rpc.ProcNull:
+	case rpc.ProcMnt:
+		if r.String() != Export {
+			w.Uint32(uint32(syscall.ENOENT))
+			return true
+		}
+		s.mu.Lock()
+		s.mounts++
+		s.mu.Unlock()
+		w.Uint32(0)
+		w.Opaque([]byte("/"))
+		w.Uint32(1) // auth flavors
+		w.Uint32(rpc.AuthSys)
+	case rpc.ProcUmnt:
+		_ = r.String() // exported directory
+	default:
+		return false
+	}
+	return true
+}
+
+// localPath converts the file handle to a path in Dir.
+func (s *Server) localPath(fh []byte) (string, bool) {
+	name := string(fh)
+	if !strings.HasPrefix(name, "/") || strings.Contains(name, "..") {
+		return "", false
+	}
+	return filepath.Join(s.Dir, name), true
+}
+
+func errStatus(err error) uint32 {
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		return uint32(errno)
+	}
+	return statusIO
+}
+
+// fileType returns the type of the file, the same in NFSv3 and NFSv4.
+func fileType(fi os.FileInfo) uint32 {
+	switch {
+	case fi.IsDir():
+		return 2
+	case fi.Mode()&os.ModeSymlink != 0:
+		return 5
+	default:
+		return 1
+	}
+}
+
+func fileID(fi os.FileInfo) uint64 {
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Ino)
+	}
+	return 0
+}
+
+func encodeAttr(w *rpc.Writer, fi os.FileInfo) {
+	w.Uint32(fileType(fi))
+	w.Uint32(uint32(fi.Mode().Perm()))
+	w.Uint32(1) // nlink
+	w.Uint32(0) // uid
+	w.Uint32(0) // gid
+	w.Uint64(uint64(fi.Size()))
+	w.Uint64(uint64(fi.Size())) // used
+	w.Uint64(0)                 // rdev
+	w.Uint64(1)                 // fsid
+	w.Uint64(fileID(fi))
+	mtime := fi.ModTime()
+	for i := 0; i < 3; i++ { // atime, mtime, ctime
+		w.Uint32(uint32(mtime.Unix()))
+		w.Uint32(uint32(mtime.Nanosecond()))
+	}
+}
+
+func encodePostOpAttr(w *rpc.Writer, localPath string) {
+	fi, err := os.Lstat(localPath)
+	if err != nil {
+		w.Bool(false)
+		return
+	}
+	w.Bool(true)
+	encodeAttr(w, fi)
+}
+
+func encodeWcc(w *rpc.Writer, localPath string) {
+	w.Bool(false)
+	encodePostOpAttr(w, localPath)
+}
+
+// encodeDirOpRes encodes result of CREATE and MKDIR.
+func encodeDirOpRes(w *rpc.Writer, err error, dirPath, fh, localPath string) {
+	if err != nil {
+		w.Uint32(errStatus(err))
+		encodeWcc(w, dirPath)
+		return
+	}
+	w.Uint32(0)
+	w.Bool(true)
+	w.Opaque([]byte(fh))
+	encodePostOpAttr(w, localPath)
+	encodeWcc(w, dirPath)
+}
+
+func (s *Server) nfs(w *rpc.Writer, proc uint32, r *rpc.Reader) bool {
+	if proc == rpc.ProcNull {
+		return true
+	}
+	fh := r.Opaque()
+	localPath, ok := s.localPath(fh)
+	if !ok {
+		switch proc {
+		case rpc.ProcGetattr, rpc.ProcLookup, rpc.ProcRead, rpc.ProcWrite,
+			rpc.ProcCreate, rpc.ProcMkdir, rpc.ProcRemove, rpc.ProcReaddir,
+			rpc.ProcFsinfo, rpc.ProcCommit:
+			w.Uint32(10001) // NFS3ERR_BADHANDLE
+			w.Bool(false)
+			return true
+		}
+		return false
+	}
+	switch proc {
+	case rpc.ProcGetattr:
+		fi, err := os.Lstat(localPath)
+		if err != nil {
+			w.Uint32(errStatus(err))
+			return true
+		}
+		w.Uint32(0)
+		encodeAttr(w, fi)
+	case rpc.ProcLookup:
+		name := r.String()
+		if name == "" || strings.Contains(name, "/") || name == ".." {
+			w.Uint32(uint32(syscall.EACCES))
+			encodePostOpAttr(w, localPath)
+			return true
+		}
+		childFH := filepath.Join(string(fh), name)
+		childPath := filepath.Join(localPath, name)
+		fi, err := os.Lstat(childPath)
+		if err != nil {
+			w.Uint32(errStatus(err))
+			encodePostOpAttr(w, localPath)
+			return true
+		}
+		w.Uint32(0)
+		w.Opaque([]byte(childFH))
+		w.Bool(true)
+		encodeAttr(w, fi)
+		encodePostOpAttr(w, localPath)
+	case rpc.ProcRead:
+		offset, count := r.Uint64(), r.Uint32()
+		if count > maxTransfer {
+			count = maxTransfer
+		}
+		if s.ReadHook != nil {
+			if err := s.ReadHook(string(fh), int64(offset)); err != nil {
+				w.Uint32(statusIO)
+				encodePostOpAttr(w, localPath)
+				return true
+			}
+		}
+		data, eof, err := readAt(localPath, int64(offset), int(count))
+		if err != nil {
+			w.Uint32(errStatus(err))
+			encodePostOpAttr(w, localPath)
+			return true
+		}
+		w.Uint32(0)
+		encodePostOpAttr(w, localPath)
+		w.Uint32(uint32(len(data)))
+		w.Bool(eof)
+		w.Opaque(data)
+	case rpc.ProcWrite:
+		offset := r.Uint64()
+		r.Uint32() // count
+		r.Uint32() // stable
+		data := r.Opaque()
+		err := writeAt(localPath, int64(offset), data)
+		if err != nil {
+			w.Uint32(errStatus(err))
+			encodeWcc(w, localPath)
+			return true
+		}
+		w.Uint32(0)
+		encodeWcc(w, localPath)
+		w.Uint32(uint32(len(data)))
+		w.Uint32(2) // FILE_SYNC
+		w.FixedOpaque(writeVerf())
+	case rpc.ProcCreate:
+		name := r.String()
+		r.Uint32() // mode, the file is always truncated
+		childFH := filepath.Join(string(fh), name)
+		childPath := filepath.Join(localPath, name)
+		f, err := os.Create(childPath)
+		if err == nil {
+			f.Close()
+		}
+		encodeDirOpRes(w, err, localPath, childFH, childPath)
+	case rpc.ProcMkdir:
+		name := r.String()
+		childFH := filepath.Join(string(fh), name)
+		childPath := filepath.Join(localPath, name)
+		err := os.Mkdir(childPath, 0755)
+		encodeDirOpRes(w, err, localPath, childFH, childPath)
+	case rpc.ProcRemove:
+		name := r.String()
+		err := syscall.Unlink(filepath.Join(localPath, name))
+		if err != nil {
+			w.Uint32(errStatus(err))
+		} else {
+			w.Uint32(0)
+		}
+		encodeWcc(w, localPath)
+	case rpc.ProcReaddir:
+		s.readdir(w, localPath, r.Uint64())
+	case rpc.ProcFsinfo:
+		w.Uint32(0)
+		encodePostOpAttr(w, localPath)
+		for i := 0; i < 2; i++ { // read, write
+			w.Uint32(maxTransfer) // max
+			w.Uint32(maxTransfer) // pref
+			w.Uint32(4096)        // mult
+		}
+		w.Uint32(4096)    // dtpref
+		w.Uint64(1 << 40) // maxfilesize
+		w.Uint32(0)       // time_delta
+		w.Uint32(1)
+		w.Uint32(0x1b) // properties
+	case rpc.ProcCommit:
+		w.Uint32(0)
+		encodeWcc(w, localPath)
+		w.FixedOpaque(writeVerf())
+	default:
+		return false
+	}
+	return true
+}
+
+// readdir returns entries from the cookie on, which is the index
+// of the next entry.
+func (s *Server) readdir(w *rpc.Writer, localPath string, cookie uint64) {
+	entries, err := ioutil.ReadDir(localPath)
+	if err != nil {
+		w.Uint32(errStatus(err))
+		encodePostOpAttr(w, localPath)
+		return
+	}
+	names := []string{".", ".."}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	w.Uint32(0)
+	encodePostOpAttr(w, localPath)
+	w.FixedOpaque(make([]byte, 8)) // cookie verifier
+	i := cookie
+	for ; i < uint64(len(names)) && i < cookie+maxDirEntries; i++ {
+		w.Bool(true)
+		w.Uint64(i + 1) // fileid
+		w.String(names[i])
+		w.Uint64(i + 1)
+	}
+	w.Bool(false)
+	w.Bool(i >= uint64(len(names)))
+}
+
+func readAt(name string, offset int64, count int) ([]byte, bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	data := make([]byte, count)
+	n, err := f.ReadAt(data, offset)
+	if err == io.EOF {
+		return data[:n], true, nil
+	}
+	return data[:n], false, err
+}
+
+func writeAt(name string, offset int64, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if _, err = f.WriteAt(data, offset); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeVerf returns the write verifier, constant as the server
+// never loses written data.
+func writeVerf() []byte {
+	verf := make([]byte, 8)
+	binary.BigEndian.PutUint64(verf, 1)
+	return verf
+}
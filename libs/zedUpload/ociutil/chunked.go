@@ -0,0 +1,421 @@
+package ociutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/containerd/stargz-snapshotter/estargz"
+	"github.com/containerd/stargz-snapshotter/estargz/zstdchunked"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// layers smaller than this are always downloaded as a whole
+	minChunkedSize = 1024 * 1024
+	// limit of the TOC and footer of a layer read into memory
+	maxTOCSize = 64 * 1024 * 1024
+)
+
+// ErrNotChunked is returned if a blob is not an estargz or zstd:chunked layer
+var ErrNotChunked = errors.New("not a chunked layer")
+
+var decompressors = []estargz.Decompressor{
+	new(estargz.GzipDecompressor),
+	new(estargz.LegacyGzipDecompressor),
+	new(zstdchunked.Decompressor),
+}
+
+// ChunkIndex returns the chunks of an estargz or zstd:chunked blob of the
+// given size, which can be used by PullBlobChunked for other layers.
+// Returns ErrNotChunked if the blob is in neither of the formats.
+func ChunkIndex(r io.ReaderAt, size int64) ([]types.Chunk, error) {
+	toc, payloadSize, _, err := readTOC(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return tocChunks(toc, payloadSize), nil
+}
+
+// PullBlobChunked downloads a layer from a registry and saves it to localFile
+// like PullBlob. If the layer is in the estargz or zstd:chunked format, only
+// the chunks which src does not have are downloaded, using HTTP range requests.
+// The progress reports the bytes actually downloaded.
+// Returns the size of the blob and the number of bytes taken from src.
+// Returns ErrNotChunked if the layer is not chunked and it should be downloaded
+// by PullBlob; any other error may be retried with PullBlob as well.
+func PullBlobChunked(ctx context.Context, registry, repo, hash, localFile, username, apiKey string, maxsize int64, src types.ChunkSource, client *http.Client, prgchan types.StatsNotifChan) (int64, int64, error) {
+	logrus.Infof("PullBlobChunked(%s, %s, %s) to %s", registry, repo, hash, localFile)
+
+	image := fmt.Sprintf("%s/%s", registry, repo)
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing reference %q: %v", image, err)
+	}
+	if d, ok := ref.(name.Digest); ok {
+		if hash != "" && checkAndCorrectHash(hash) != checkAndCorrectHash(d.DigestStr()) {
+			return 0, 0, fmt.Errorf("PullBlobChunked: given hash %s is different from the hash in reference %s",
+				hash, checkAndCorrectHash(d.DigestStr()))
+		}
+		hash = d.DigestStr()
+	}
+	if hash == "" {
+		return 0, 0, fmt.Errorf("PullBlobChunked: no hash for %s", image)
+	}
+	hash = checkAndCorrectHash(hash)
+	repository := ref.Context()
+	t := client.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	rt, err := transport.NewWithContext(ctx, repository.Registry, authenticator(username, apiKey),
+		t, []string{repository.Scope(transport.PullScope)})
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not authenticate to %s: %v", registry, err)
+	}
+	r := &rangeReader{
+		ctx:    ctx,
+		client: &http.Client{Transport: rt},
+		url: fmt.Sprintf("%s://%s/v2/%s/blobs/%s", repository.Registry.Scheme(),
+			repository.RegistryStr(), repository.RepositoryStr(), hash),
+	}
+	size, err := r.size()
+	if err != nil {
+		return 0, 0, err
+	}
+	if maxsize != 0 && size > maxsize {
+		return 0, 0, fmt.Errorf("actual size of blob (%s, %s, %s) %d is more than provided %d",
+			registry, repo, hash, size, maxsize)
+	}
+	if size < minChunkedSize {
+		return 0, 0, ErrNotChunked
+	}
+	toc, payloadSize, tail, err := readTOC(r, size)
+	if err != nil {
+		return 0, 0, err
+	}
+	chunks := tocChunks(toc, payloadSize)
+
+	// decide which chunks to take from src
+	var reused int64
+	local := make([]bool, len(chunks))
+	for i, c := range chunks {
+		if c.Key != "" && src.HasChunk(c.Key, c.Size) {
+			local[i] = true
+			reused += c.Size
+		}
+	}
+	logrus.Infof("PullBlobChunked(%s): %d bytes out of %d available locally",
+		hash, reused, size)
+
+	stats := types.UpdateStats{Size: r.fetched + payloadSize - reused, Asize: r.fetched}
+	types.SendStats(prgchan, stats)
+	r.progress = func() {
+		stats.Asize = r.fetched
+		types.SendStats(prgchan, stats)
+	}
+
+	f, err := os.Create(localFile)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not open local file %s for writing: %v", localFile, err)
+	}
+	defer f.Close()
+
+	// consecutive chunks which are not available locally
+	// are downloaded with a single request
+	pending := int64(-1)
+	fetch := func(end int64) error {
+		start := pending
+		pending = -1
+		if start < 0 || start == end {
+			return nil
+		}
+		body, err := r.open(start, end-start)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		n, err := io.Copy(f, body)
+		if err == nil && n != end-start {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	for i, c := range chunks {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+		if local[i] {
+			if err := fetch(c.Offset); err != nil {
+				return 0, 0, err
+			}
+			err := copyChunk(f, src, c)
+			if err == nil {
+				continue
+			}
+			logrus.Warnf("PullBlobChunked(%s): failed to copy chunk at %d, downloading it: %v",
+				hash, c.Offset, err)
+			reused -= c.Size
+			stats.Size += c.Size
+			if _, err := f.Seek(c.Offset, io.SeekStart); err != nil {
+				return 0, 0, err
+			}
+		}
+		if pending < 0 {
+			pending = c.Offset
+		}
+	}
+	if err := fetch(payloadSize); err != nil {
+		return 0, 0, err
+	}
+	if _, err := f.Write(tail); err != nil {
+		return 0, 0, err
+	}
+	if err := f.Truncate(size); err != nil {
+		return 0, 0, err
+	}
+
+	// the chunks are identified by their uncompressed content,
+	// the result is only correct if they were compressed the same way
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0, 0, err
+	}
+	if sum := "sha256:" + hex.EncodeToString(h.Sum(nil)); sum != hash {
+		return 0, 0, fmt.Errorf("PullBlobChunked: assembled blob has hash %s, expected %s",
+			sum, hash)
+	}
+	logrus.Infof("PullBlobChunked(%s): Done. Size: %d, downloaded: %d", hash, size, r.fetched)
+	return size, reused, nil
+}
+
+func copyChunk(w io.Writer, src types.ChunkSource, c types.Chunk) error {
+	rc, err := src.ReadChunk(c.Key, c.Size)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	n, err := io.Copy(w, io.LimitReader(rc, c.Size))
+	if err == nil && n != c.Size {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// parseFooter guards against decompressors which do not check the input
+func parseFooter(d estargz.Decompressor, p []byte) (payloadSize, tocOffset, tocSize int64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid footer: %v", r)
+		}
+	}()
+	return d.ParseFooter(p)
+}
+
+// readTOC reads the TOC of an estargz or zstd:chunked blob. Returns the TOC,
+// the size of the payload, and the rest of the blob with the TOC and footer.
+func readTOC(r io.ReaderAt, size int64) (*estargz.JTOC, int64, []byte, error) {
+	var footerSize int64
+	for _, d := range decompressors {
+		if s := d.FooterSize(); s > footerSize && s <= size {
+			footerSize = s
+		}
+	}
+	if footerSize == 0 {
+		return nil, 0, nil, ErrNotChunked
+	}
+	footer := make([]byte, footerSize)
+	if err := readAt(r, footer, size-footerSize); err != nil {
+		return nil, 0, nil, err
+	}
+	for _, d := range decompressors {
+		fSize := d.FooterSize()
+		if fSize > footerSize {
+			continue
+		}
+		payloadSize, tocOffset, tocSize, err := parseFooter(d, footer[footerSize-fSize:])
+		if err != nil {
+			continue
+		}
+		if tocSize <= 0 {
+			tocSize = size - tocOffset - fSize
+		}
+		if payloadSize < 0 || payloadSize > tocOffset || tocSize < 0 ||
+			tocOffset+tocSize > size-fSize || size-payloadSize > maxTOCSize {
+			continue
+		}
+		tail := make([]byte, size-payloadSize)
+		if err := readAt(r, tail, payloadSize); err != nil {
+			return nil, 0, nil, err
+		}
+		tocStart := tocOffset - payloadSize
+		toc, _, err := d.ParseTOC(bytes.NewReader(tail[tocStart : tocStart+tocSize]))
+		if err != nil {
+			continue
+		}
+		return toc, payloadSize, tail, nil
+	}
+	return nil, 0, nil, ErrNotChunked
+}
+
+func readAt(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if err == io.EOF && n == len(p) {
+		err = nil
+	}
+	return err
+}
+
+// tocChunks splits the payload at the offsets of the TOC entries
+func tocChunks(toc *estargz.JTOC, payloadSize int64) []types.Chunk {
+	byOffset := make(map[int64][]*estargz.TOCEntry)
+	offsets := []int64{0}
+	for _, e := range toc.Entries {
+		if e.Offset <= 0 || e.Offset >= payloadSize {
+			continue
+		}
+		if _, ok := byOffset[e.Offset]; !ok {
+			offsets = append(offsets, e.Offset)
+		}
+		byOffset[e.Offset] = append(byOffset[e.Offset], e)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	var chunks []types.Chunk
+	for i, off := range offsets {
+		end := payloadSize
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if end == off {
+			continue
+		}
+		// the beginning of the payload is not described by the TOC
+		var key string
+		if off > 0 {
+			key = chunkKey(end-off, byOffset[off], byOffset[end])
+		}
+		chunks = append(chunks, types.Chunk{Key: key, Offset: off, Size: end - off})
+	}
+	return chunks
+}
+
+// chunkKey identifies a chunk by the TOC entries starting there. The entries
+// following it are included without their content digests, since the tar
+// header of the next file may be compressed together with the chunk.
+func chunkKey(size int64, entries, next []*estargz.TOCEntry) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n", size)
+	enc := json.NewEncoder(h)
+	for i, list := range [][]*estargz.TOCEntry{entries, next} {
+		for _, e := range list {
+			entry := *e
+			entry.Offset = 0
+			if i > 0 {
+				entry.Digest = ""
+				entry.ChunkDigest = ""
+			}
+			if err := enc.Encode(&entry); err != nil {
+				return ""
+			}
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// rangeReader reads parts of a blob in a registry with HTTP range requests
+type rangeReader struct {
+	ctx      context.Context
+	client   *http.Client
+	url      string
+	fetched  int64  // bytes received so far
+	progress func() // called when data is received
+}
+
+func (r *rangeReader) size() (int64, error) {
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodHead, r.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.ContentLength < 0 {
+		return 0, fmt.Errorf("could not get size of %s: %s", r.url, resp.Status)
+	}
+	return resp.ContentLength, nil
+}
+
+// open returns a reader of n bytes of the blob at offset off
+func (r *rangeReader) open(off, n int64) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+n-1))
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusPartialContent ||
+		!strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", off)) {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: range request for %s returned %s",
+			ErrNotChunked, r.url, resp.Status)
+	}
+	return &countingReader{r: r, body: resp.Body, left: n}, nil
+}
+
+func (r *rangeReader) ReadAt(p []byte, off int64) (int, error) {
+	body, err := r.open(off, int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+	return io.ReadFull(body, p)
+}
+
+// countingReader limits the response to the requested range
+// and counts the received bytes
+type countingReader struct {
+	r    *rangeReader
+	body io.ReadCloser
+	left int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	if c.left <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > c.left {
+		p = p[:c.left]
+	}
+	n, err := c.body.Read(p)
+	c.left -= int64(n)
+	c.r.fetched += int64(n)
+	if n > 0 && c.r.progress != nil {
+		c.r.progress()
+	}
+	return n, err
+}
+
+func (c *countingReader) Close() error {
+	return c.body.Close()
+}
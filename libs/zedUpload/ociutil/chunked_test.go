@@ -0,0 +1,213 @@
+package ociutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/containerd/stargz-snapshotter/estargz"
+	"github.com/containerd/stargz-snapshotter/estargz/zstdchunked"
+	"github.com/klauspost/compress/zstd"
+)
+
+type testFile struct {
+	name string
+	data []byte
+}
+
+func randomData(seed int64, size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(data)
+	return data
+}
+
+func buildLayer(t *testing.T, files []testFile, opts ...estargz.Option) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		hdr := &tar.Header{
+			Name:     f.name,
+			Mode:     0644,
+			Size:     int64(len(f.data)),
+			ModTime:  time.Unix(1600000000, 0),
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tarBlob := bytes.NewReader(buf.Bytes())
+	blob, err := estargz.Build(io.NewSectionReader(tarBlob, 0, tarBlob.Size()), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer blob.Close()
+	layer, err := ioutil.ReadAll(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return layer
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// testChunkSource provides the chunks of local blobs
+type testChunkSource map[string][]byte
+
+func (s testChunkSource) add(t *testing.T, blob []byte) {
+	chunks, err := ChunkIndex(bytes.NewReader(blob), int64(len(blob)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range chunks {
+		if c.Key != "" {
+			s[c.Key] = blob[c.Offset : c.Offset+c.Size]
+		}
+	}
+}
+
+func (s testChunkSource) HasChunk(key string, size int64) bool {
+	data, ok := s[key]
+	return ok && int64(len(data)) == size
+}
+
+func (s testChunkSource) ReadChunk(key string, size int64) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(s[key])), nil
+}
+
+// the footer is read twice, separately and as a part of the tail
+const maxFooterSize = 51
+
+type testRegistry struct {
+	*httptest.Server
+	sent int64
+}
+
+func (r *testRegistry) served() int64 {
+	return atomic.LoadInt64(&r.sent)
+}
+
+type countingWriter struct {
+	http.ResponseWriter
+	sent *int64
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	atomic.AddInt64(w.sent, int64(n))
+	return n, err
+}
+
+// newTestRegistry serves the blobs with support for range requests
+func newTestRegistry(blobs ...[]byte) *testRegistry {
+	byDigest := make(map[string][]byte)
+	for _, b := range blobs {
+		byDigest[digest(b)] = b
+	}
+	reg := &testRegistry{}
+	reg.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/" {
+			return
+		}
+		blob, ok := byDigest[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(countingWriter{w, &reg.sent}, r, "", time.Time{},
+			bytes.NewReader(blob))
+	}))
+	return reg
+}
+
+type zstdCompression struct {
+	*zstdchunked.Compressor
+	*zstdchunked.Decompressor
+}
+
+func TestPullBlobChunked(t *testing.T) {
+	opts := []estargz.Option{estargz.WithCompression(zstdCompression{
+		&zstdchunked.Compressor{CompressionLevel: zstd.SpeedDefault}, &zstdchunked.Decompressor{}})}
+	files := []testFile{
+		{"a", randomData(1, 1024*1024)},
+		{"b", randomData(2, 512*1024)},
+		{"c", randomData(3, 512*1024)},
+	}
+	oldLayer := buildLayer(t, files, opts...)
+	files[2].data = randomData(4, 512*1024)
+	newLayer := buildLayer(t, files, opts...)
+
+	src := testChunkSource{}
+	src.add(t, oldLayer)
+
+	server := newTestRegistry(newLayer)
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	dir, err := ioutil.TempDir("", "chunked")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	localFile := filepath.Join(dir, "blob")
+
+	size, reused, err := PullBlobChunked(context.Background(), registry, "test/image",
+		digest(newLayer), localFile, "", "", 0, src, http.DefaultClient, nil)
+	if err != nil {
+		t.Fatalf("PullBlobChunked failed: %v", err)
+	}
+	if size != int64(len(newLayer)) {
+		t.Errorf("size %d, expected %d", size, len(newLayer))
+	}
+	// a and b are reused, c is downloaded
+	if reused < 1024*1024+512*1024 {
+		t.Errorf("reused %d bytes only", reused)
+	}
+	if served := server.served(); served+reused > size+maxFooterSize {
+		t.Errorf("%d bytes downloaded with %d reused out of %d",
+			served, reused, size)
+	}
+	result, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result, newLayer) {
+		t.Errorf("downloaded blob differs")
+	}
+}
+
+func TestPullBlobChunkedNotChunked(t *testing.T) {
+	blob := randomData(5, 2*1024*1024)
+	server := newTestRegistry(blob)
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	_, _, err := PullBlobChunked(context.Background(), registry, "test/image@"+digest(blob),
+		"", "", "", "", 0, testChunkSource{}, http.DefaultClient, nil)
+	if !errors.Is(err, ErrNotChunked) {
+		t.Errorf("expected ErrNotChunked, got %v", err)
+	}
+}
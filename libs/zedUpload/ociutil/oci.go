@@ -310,18 +310,20 @@ func Pull(registry, repo, localFile, username, apiKey string, client *http.Clien
 }
 
 func options(username, apiKey string, client *http.Client) []remote.Option {
-	// default to anonymous, unless we have auth credentials
-	auth := authn.Anonymous
-	// do we have auth to use?
-	if username != "" || apiKey != "" {
-		auth = authn.FromConfig(authn.AuthConfig{Username: username, Password: apiKey})
-	}
 	return []remote.Option{
-		remote.WithAuth(auth),
+		remote.WithAuth(authenticator(username, apiKey)),
 		remote.WithTransport(client.Transport),
 	}
 }
 
+func authenticator(username, apiKey string) authn.Authenticator {
+	// default to anonymous, unless we have auth credentials
+	if username != "" || apiKey != "" {
+		return authn.FromConfig(authn.AuthConfig{Username: username, Password: apiKey})
+	}
+	return authn.Anonymous
+}
+
 // LayersFromManifest get the descriptors for layers from a raw image manifest
 func LayersFromManifest(imageManifest []byte) ([]v1.Descriptor, error) {
 	manifest, err := v1.ParseManifest(bytes.NewReader(imageManifest))
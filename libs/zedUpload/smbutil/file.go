@@ -0,0 +1,474 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/internal/smb2"
+)
+
+// queryBufferSize is the size of QUERY_DIRECTORY and QUERY_INFO output,
+// charged a single credit.
+const queryBufferSize = creditSize
+
+// fileID identifies an open file (SMB2_FILEID).
+type fileID [16]byte
+
+// fileAttr are attributes of a file.
+type fileAttr struct {
+	Attributes uint32
+	Size       uint64
+	ModTime    time.Time
+}
+
+// fileInfo implements os.FileInfo.
+type fileInfo struct {
+	name string
+	attr fileAttr
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	return int64(fi.attr.Size)
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	mode := os.FileMode(0644)
+	if fi.IsDir() {
+		mode = os.ModeDir | 0755
+	}
+	if fi.attr.Attributes&smb2.AttrReadonly != 0 {
+		mode &^= 0222
+	}
+	return mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return fi.attr.ModTime
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.attr.Attributes&smb2.AttrDirectory != 0
+}
+
+func (fi *fileInfo) Sys() interface{} {
+	return nil
+}
+
+// fileSystem is a connected share.
+type fileSystem struct {
+	share *Share
+	conn  *conn
+}
+
+// smbPath converts a slash-separated path relative to the root
+// of the share to the path used by SMB.
+func smbPath(name string) string {
+	if name == "." {
+		return ""
+	}
+	return strings.ReplaceAll(strings.Trim(name, "/"), "/", `\`)
+}
+
+// create opens or creates the file (MS-SMB2 2.2.13, 2.2.14).
+func (fs *fileSystem) create(name string, access, disposition, options uint32) (fileID, fileAttr, error) {
+	var fid fileID
+	var attr fileAttr
+	smbName := smb2.EncodeString(smbPath(name))
+	body := make([]byte, 56+len(smbName))
+	le.PutUint16(body[0:], 57)
+	le.PutUint32(body[4:], smb2.ImpersonationLevel)
+	le.PutUint32(body[24:], access)
+	le.PutUint32(body[32:], smb2.ShareRead|smb2.ShareWrite|smb2.ShareDelete)
+	le.PutUint32(body[36:], disposition)
+	le.PutUint32(body[40:], options)
+	le.PutUint16(body[44:], smb2.HeaderSize+56)
+	le.PutUint16(body[46:], uint16(len(smbName)))
+	copy(body[56:], smbName)
+	if len(smbName) == 0 {
+		// The buffer is not empty, even without the name.
+		body = append(body, 0)
+	}
+	hdr, msg, err := fs.conn.call(smb2.CmdCreate, body, 0)
+	if err != nil {
+		return fid, attr, err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return fid, attr, statusError(hdr.Status)
+	}
+	if len(msg) < smb2.HeaderSize+88 {
+		return fid, attr, smb2.ErrShortMessage
+	}
+	resp := msg[smb2.HeaderSize:]
+	attr.ModTime = smb2.Time(le.Uint64(resp[24:]))
+	attr.Size = le.Uint64(resp[48:])
+	attr.Attributes = le.Uint32(resp[56:])
+	copy(fid[:], resp[64:80])
+	return fid, attr, nil
+}
+
+// close closes the file (MS-SMB2 2.2.15).
+func (fs *fileSystem) close(fid fileID) error {
+	body := make([]byte, 24)
+	le.PutUint16(body[0:], 24)
+	copy(body[8:], fid[:])
+	hdr, _, err := fs.conn.call(smb2.CmdClose, body, 0)
+	if err != nil {
+		return err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return statusError(hdr.Status)
+	}
+	return nil
+}
+
+// Open opens the file for reading.
+func (fs *fileSystem) Open(name string) (mount.File, error) {
+	fid, _, err := fs.create(name, smb2.AccessReadData|smb2.AccessReadAttributes|
+		smb2.AccessSynchronize, smb2.DispositionOpen, smb2.OptionNonDirectoryFile)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &file{fs: fs, name: name, fid: fid}, nil
+}
+
+// Create creates or truncates the file for writing.
+func (fs *fileSystem) Create(name string) (mount.WriteFile, error) {
+	fid, _, err := fs.create(name, smb2.AccessWriteData|smb2.AccessReadAttributes|
+		smb2.AccessSynchronize, smb2.DispositionOverwriteIf, smb2.OptionNonDirectoryFile)
+	if err != nil {
+		return nil, &os.PathError{Op: "create", Path: name, Err: err}
+	}
+	return &file{fs: fs, name: name, fid: fid}, nil
+}
+
+// MkdirAll creates the directory together with all missing parents.
+func (fs *fileSystem) MkdirAll(name string) error {
+	var dir string
+	for _, elem := range strings.Split(strings.Trim(name, "/"), "/") {
+		if elem == "" || elem == "." {
+			continue
+		}
+		dir = path.Join(dir, elem)
+		fid, attr, err := fs.create(dir, smb2.AccessReadAttributes|smb2.AccessSynchronize,
+			smb2.DispositionOpenIf, smb2.OptionDirectoryFile)
+		if err != nil {
+			return &os.PathError{Op: "mkdir", Path: dir, Err: err}
+		}
+		if err = fs.close(fid); err != nil {
+			return &os.PathError{Op: "mkdir", Path: dir, Err: err}
+		}
+		if attr.Attributes&smb2.AttrDirectory == 0 {
+			return &os.PathError{Op: "mkdir", Path: dir, Err: syscall.ENOTDIR}
+		}
+	}
+	return nil
+}
+
+// Remove removes the file, it is deleted when closed.
+func (fs *fileSystem) Remove(name string) error {
+	fid, _, err := fs.create(name, smb2.AccessDelete|smb2.AccessReadAttributes|
+		smb2.AccessSynchronize, smb2.DispositionOpen,
+		smb2.OptionNonDirectoryFile|smb2.OptionDeleteOnClose)
+	if err == nil {
+		err = fs.close(fid)
+	}
+	if err != nil {
+		return &os.PathError{Op: "remove", Path: name, Err: err}
+	}
+	return nil
+}
+
+// Stat returns information about the file, the response to CREATE
+// carries the attributes.
+func (fs *fileSystem) Stat(name string) (os.FileInfo, error) {
+	fid, attr, err := fs.create(name, smb2.AccessReadAttributes|smb2.AccessSynchronize,
+		smb2.DispositionOpen, 0)
+	if err == nil {
+		err = fs.close(fid)
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return &fileInfo{name: path.Base(name), attr: attr}, nil
+}
+
+// ReadDir returns information about directory entries.
+func (fs *fileSystem) ReadDir(name string) ([]os.FileInfo, error) {
+	fid, _, err := fs.create(name, smb2.AccessReadData|smb2.AccessReadAttributes|
+		smb2.AccessSynchronize, smb2.DispositionOpen, smb2.OptionDirectoryFile)
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	entries, err := fs.queryDirectory(fid)
+	if closeErr := fs.close(fid); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	return entries, nil
+}
+
+// queryDirectory lists the open directory (MS-SMB2 2.2.33, 2.2.34).
+func (fs *fileSystem) queryDirectory(fid fileID) ([]os.FileInfo, error) {
+	pattern := smb2.EncodeString("*")
+	var entries []os.FileInfo
+	for flags := byte(smb2.RestartScans); ; flags = 0 {
+		body := make([]byte, 32+len(pattern))
+		le.PutUint16(body[0:], 33)
+		body[2] = smb2.FileDirectoryInformation
+		body[3] = flags
+		copy(body[8:], fid[:])
+		le.PutUint16(body[24:], smb2.HeaderSize+32)
+		le.PutUint16(body[26:], uint16(len(pattern)))
+		le.PutUint32(body[28:], queryBufferSize)
+		copy(body[32:], pattern)
+		hdr, msg, err := fs.conn.call(smb2.CmdQueryDirectory, body, queryBufferSize)
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Status == smb2.StatusNoMoreFiles {
+			return entries, nil
+		}
+		if hdr.Status != smb2.StatusSuccess {
+			return nil, statusError(hdr.Status)
+		}
+		if len(msg) < smb2.HeaderSize+8 {
+			return nil, smb2.ErrShortMessage
+		}
+		resp := msg[smb2.HeaderSize:]
+		buf, err := smb2.Buffer(msg, int(le.Uint16(resp[2:])), int(le.Uint32(resp[4:])))
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) == 0 {
+			return entries, nil
+		}
+		entries, err = appendDirEntries(entries, buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// appendDirEntries decodes FILE_DIRECTORY_INFORMATION entries
+// (MS-FSCC 2.4.10), except "." and "..".
+func appendDirEntries(entries []os.FileInfo, buf []byte) ([]os.FileInfo, error) {
+	for {
+		if len(buf) < 64 {
+			return nil, smb2.ErrShortMessage
+		}
+		next := int(le.Uint32(buf[0:]))
+		nameLength := int(le.Uint32(buf[60:]))
+		if 64+nameLength > len(buf) || next > len(buf) {
+			return nil, smb2.ErrShortMessage
+		}
+		name := smb2.DecodeString(buf[64 : 64+nameLength])
+		if name != "." && name != ".." {
+			entries = append(entries, &fileInfo{
+				name: name,
+				attr: fileAttr{
+					ModTime:    smb2.Time(le.Uint64(buf[24:])),
+					Size:       le.Uint64(buf[40:]),
+					Attributes: le.Uint32(buf[56:]),
+				},
+			})
+		}
+		if next == 0 {
+			return entries, nil
+		}
+		buf = buf[next:]
+	}
+}
+
+// Unmount disconnects from the share.
+func (fs *fileSystem) Unmount() error {
+	fs.conn.treeDisconnect()
+	fs.conn.logoff()
+	fs.conn.close()
+	return nil
+}
+
+// file is an open file.
+type file struct {
+	fs     *fileSystem
+	name   string
+	fid    fileID
+	offset int64
+	closed bool
+}
+
+// Read reads from the file (MS-SMB2 2.2.19, 2.2.20).
+func (f *file) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	count := f.fs.conn.transferSize(len(p), f.fs.conn.maxRead)
+	body := make([]byte, 49)
+	le.PutUint16(body[0:], 49)
+	body[2] = smb2.HeaderSize + 16 // padding, offset of the data in the response
+	le.PutUint32(body[4:], uint32(count))
+	le.PutUint64(body[8:], uint64(f.offset))
+	copy(body[16:], f.fid[:])
+	hdr, msg, err := f.fs.conn.call(smb2.CmdRead, body, count)
+	if err != nil {
+		return 0, &os.PathError{Op: "read", Path: f.name, Err: err}
+	}
+	if hdr.Status == smb2.StatusEndOfFile {
+		return 0, io.EOF
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return 0, &os.PathError{Op: "read", Path: f.name, Err: statusError(hdr.Status)}
+	}
+	if len(msg) < smb2.HeaderSize+16 {
+		return 0, smb2.ErrShortMessage
+	}
+	resp := msg[smb2.HeaderSize:]
+	data, err := smb2.Buffer(msg, int(resp[2]), int(le.Uint32(resp[4:])))
+	if err != nil {
+		return 0, err
+	}
+	if len(data) > count {
+		return 0, fmt.Errorf("SMB server returned %d bytes, %d were requested",
+			len(data), count)
+	}
+	n := copy(p, data)
+	f.offset += int64(n)
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+func (f *file) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		size, err := f.size()
+		if err != nil {
+			return 0, &os.PathError{Op: "seek", Path: f.name, Err: err}
+		}
+		offset += size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, &os.PathError{Op: "seek", Path: f.name, Err: syscall.EINVAL}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// size gets the size of the open file with FileStandardInformation
+// (MS-SMB2 2.2.37, 2.2.38, MS-FSCC 2.4.41).
+func (f *file) size() (int64, error) {
+	body := make([]byte, 41)
+	le.PutUint16(body[0:], 41)
+	body[2] = smb2.InfoTypeFile
+	body[3] = smb2.FileStandardInformation
+	le.PutUint32(body[4:], queryBufferSize)
+	copy(body[24:], f.fid[:])
+	hdr, msg, err := f.fs.conn.call(smb2.CmdQueryInfo, body, queryBufferSize)
+	if err != nil {
+		return 0, err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return 0, statusError(hdr.Status)
+	}
+	if len(msg) < smb2.HeaderSize+8 {
+		return 0, smb2.ErrShortMessage
+	}
+	resp := msg[smb2.HeaderSize:]
+	info, err := smb2.Buffer(msg, int(le.Uint16(resp[2:])), int(le.Uint32(resp[4:])))
+	if err != nil {
+		return 0, err
+	}
+	if len(info) < 16 {
+		return 0, smb2.ErrShortMessage
+	}
+	return int64(le.Uint64(info[8:])), nil
+}
+
+func (f *file) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		data := p[written:]
+		data = data[:f.fs.conn.transferSize(len(data), f.fs.conn.maxWrite)]
+		n, err := f.write(data)
+		written += n
+		if err != nil {
+			return written, &os.PathError{Op: "write", Path: f.name, Err: err}
+		}
+	}
+	return written, nil
+}
+
+// write writes to the file (MS-SMB2 2.2.21, 2.2.22).
+func (f *file) write(data []byte) (int, error) {
+	body := make([]byte, 48+len(data))
+	le.PutUint16(body[0:], 49)
+	le.PutUint16(body[2:], smb2.HeaderSize+48)
+	le.PutUint32(body[4:], uint32(len(data)))
+	le.PutUint64(body[8:], uint64(f.offset))
+	copy(body[16:], f.fid[:])
+	copy(body[48:], data)
+	hdr, msg, err := f.fs.conn.call(smb2.CmdWrite, body, len(data))
+	if err != nil {
+		return 0, err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return 0, statusError(hdr.Status)
+	}
+	if len(msg) < smb2.HeaderSize+16 {
+		return 0, smb2.ErrShortMessage
+	}
+	count := int(le.Uint32(msg[smb2.HeaderSize+4:]))
+	if count == 0 || count > len(data) {
+		return 0, fmt.Errorf("SMB server wrote %d of %d bytes", count, len(data))
+	}
+	f.offset += int64(count)
+	return count, nil
+}
+
+// Sync flushes written data to the stable storage of the server
+// (MS-SMB2 2.2.17).
+func (f *file) Sync() error {
+	body := make([]byte, 24)
+	le.PutUint16(body[0:], 24)
+	copy(body[8:], f.fid[:])
+	hdr, _, err := f.fs.conn.call(smb2.CmdFlush, body, 0)
+	if err != nil {
+		return &os.PathError{Op: "flush", Path: f.name, Err: err}
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return &os.PathError{Op: "flush", Path: f.name, Err: statusError(hdr.Status)}
+	}
+	return nil
+}
+
+// Close closes the file.
+func (f *file) Close() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	if err := f.fs.close(f.fid); err != nil {
+		return &os.PathError{Op: "close", Path: f.name, Err: err}
+	}
+	return nil
+}
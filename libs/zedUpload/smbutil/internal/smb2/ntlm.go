@@ -0,0 +1,506 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smb2
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rc4"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+)
+
+// NTLM negotiate flags
+const (
+	ntlmUnicode          = 0x00000001
+	ntlmRequestTarget    = 0x00000004
+	ntlmSign             = 0x00000010
+	ntlmNTLM             = 0x00000200
+	ntlmAnonymous        = 0x00000800
+	ntlmAlwaysSign       = 0x00008000
+	ntlmExtendedSecurity = 0x00080000
+	ntlmTargetInfo       = 0x00800000
+	ntlmVersion          = 0x02000000
+	ntlm128              = 0x20000000
+	ntlmKeyExch          = 0x40000000
+	ntlm56               = 0x80000000
+
+	ntlmFlags = ntlmUnicode | ntlmRequestTarget | ntlmSign | ntlmNTLM |
+		ntlmAlwaysSign | ntlmExtendedSecurity | ntlmTargetInfo | ntlmVersion |
+		ntlm128 | ntlmKeyExch | ntlm56
+)
+
+// NTLM message types and AV pair IDs
+const (
+	ntlmNegotiate    = 1
+	ntlmChallenge    = 2
+	ntlmAuthenticate = 3
+
+	avEOL             = 0
+	avNbComputerName  = 1
+	avNbDomainName    = 2
+	avFlags           = 6
+	avTimestamp       = 7
+	avTargetName      = 9
+	avChannelBindings = 10
+
+	avFlagMIC = 0x00000002
+)
+
+const ntlmSignature = "NTLMSSP\x00"
+
+// ntlmVersionInfo is sent for debugging purposes only: Windows 10, NTLM
+// revision 15.
+var ntlmVersionInfo = []byte{10, 0, 0, 0, 0, 0, 0, 15}
+
+// Offsets of the fields of AUTHENTICATE_MESSAGE
+const (
+	authLmResponse  = 12
+	authNtResponse  = 20
+	authDomain      = 28
+	authUser        = 36
+	authWorkstation = 44
+	authSessionKey  = 52
+	authFlags       = 60
+	authMIC         = 72
+	authHeaderSize  = 88
+)
+
+// ErrLogonFailure is returned when NTLM authentication fails.
+var ErrLogonFailure = errors.New("logon failure, invalid user name or password")
+
+// NTLMClient authenticates with NTLMv2. It is anonymous when both User
+// and Password are empty.
+type NTLMClient struct {
+	User     string
+	Password string
+	// Domain of the user, the target name sent by the server is used
+	// when empty.
+	Domain string
+	// TargetName is the service principal name of the server, e.g.
+	// "cifs/host".
+	TargetName string
+
+	negotiate  []byte
+	sessionKey []byte
+	signKey    []byte
+	seal       *rc4.Cipher
+}
+
+// Negotiate returns NEGOTIATE_MESSAGE.
+func (c *NTLMClient) Negotiate() []byte {
+	msg := make([]byte, 40)
+	copy(msg, ntlmSignature)
+	le.PutUint32(msg[8:], ntlmNegotiate)
+	le.PutUint32(msg[12:], ntlmFlags)
+	copy(msg[32:], ntlmVersionInfo)
+	c.negotiate = msg
+	return msg
+}
+
+// Authenticate returns AUTHENTICATE_MESSAGE answering CHALLENGE_MESSAGE.
+func (c *NTLMClient) Authenticate(challenge []byte) ([]byte, error) {
+	if len(challenge) < 48 || string(challenge[:8]) != ntlmSignature ||
+		le.Uint32(challenge[8:]) != ntlmChallenge {
+		return nil, errors.New("invalid NTLM challenge")
+	}
+	flags := le.Uint32(challenge[20:]) & ntlmFlags
+	serverChallenge := challenge[24:32]
+	targetName, err := ntlmField(challenge, 12)
+	if err != nil {
+		return nil, err
+	}
+	targetInfo, err := ntlmField(challenge, 40)
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := parseAVPairs(targetInfo)
+	if err != nil {
+		return nil, err
+	}
+	domain := EncodeString(c.Domain)
+	if c.Domain == "" {
+		domain = targetName
+	}
+	user := EncodeString(c.User)
+
+	var lmResponse, ntResponse, encryptedKey []byte
+	if c.User == "" && c.Password == "" {
+		flags |= ntlmAnonymous
+		lmResponse = []byte{0}
+	} else {
+		ntowf := ntowfv2(c.User, c.Password, domain)
+		clientChallenge := make([]byte, 8)
+		if _, err := rand.Read(clientChallenge); err != nil {
+			return nil, err
+		}
+		timestamp := make([]byte, 8)
+		if ts, ok := lookupAVPair(pairs, avTimestamp); ok && len(ts) == 8 {
+			copy(timestamp, ts)
+			lmResponse = make([]byte, 24)
+		} else {
+			le.PutUint64(timestamp, Filetime(time.Now()))
+			lmResponse = append(hmacMD5(ntowf, serverChallenge, clientChallenge), clientChallenge...)
+		}
+		// The MIC is included and the target info says so.
+		info := []avPair{{avFlags, make([]byte, 4)}}
+		avFlag := uint32(avFlagMIC)
+		for _, pair := range pairs {
+			if pair.id == avFlags && len(pair.value) == 4 {
+				avFlag |= le.Uint32(pair.value)
+				continue
+			}
+			info = append(info, pair)
+		}
+		le.PutUint32(info[0].value, avFlag)
+		info = append(info, avPair{avChannelBindings, make([]byte, 16)})
+		if c.TargetName != "" {
+			info = append(info, avPair{avTargetName, EncodeString(c.TargetName)})
+		}
+		blob := ntlmv2Blob(timestamp, clientChallenge, encodeAVPairs(info))
+		ntProof := hmacMD5(ntowf, serverChallenge, blob)
+		ntResponse = append(ntProof, blob...)
+		keyExchangeKey := hmacMD5(ntowf, ntProof)
+		c.sessionKey = keyExchangeKey
+		if flags&ntlmKeyExch != 0 {
+			c.sessionKey = make([]byte, 16)
+			if _, err := rand.Read(c.sessionKey); err != nil {
+				return nil, err
+			}
+			encryptedKey = rc4Crypt(keyExchangeKey, c.sessionKey)
+		}
+	}
+
+	msg := make([]byte, authHeaderSize)
+	copy(msg, ntlmSignature)
+	le.PutUint32(msg[8:], ntlmAuthenticate)
+	msg = appendNTLMField(msg, authDomain, domain)
+	msg = appendNTLMField(msg, authUser, user)
+	msg = appendNTLMField(msg, authWorkstation, nil)
+	msg = appendNTLMField(msg, authLmResponse, lmResponse)
+	msg = appendNTLMField(msg, authNtResponse, ntResponse)
+	msg = appendNTLMField(msg, authSessionKey, encryptedKey)
+	le.PutUint32(msg[authFlags:], flags)
+	copy(msg[64:], ntlmVersionInfo)
+	if c.sessionKey != nil {
+		copy(msg[authMIC:], hmacMD5(c.sessionKey, c.negotiate, challenge, msg))
+		c.signKey, c.seal = ntlmSigningKeys(c.sessionKey, flags, true)
+	}
+	return msg, nil
+}
+
+// SessionKey returns the exported session key, nil for anonymous
+// authentication.
+func (c *NTLMClient) SessionKey() []byte {
+	return c.sessionKey
+}
+
+// MIC returns the signature of the first message of the client.
+func (c *NTLMClient) MIC(data []byte) []byte {
+	if c.sessionKey == nil {
+		return nil
+	}
+	return ntlmMIC(c.signKey, c.seal, data)
+}
+
+// NTLMServer verifies NTLMv2 authentication of a single client.
+type NTLMServer struct {
+	// TargetName is the name of the server.
+	TargetName string
+	// Password returns the password of the user, false if the user
+	// does not exist.
+	Password func(user string) (string, bool)
+
+	negotiate  []byte
+	challenge  []byte
+	sessionKey []byte
+	signKey    []byte
+	seal       *rc4.Cipher
+}
+
+// Challenge returns CHALLENGE_MESSAGE answering NEGOTIATE_MESSAGE.
+func (s *NTLMServer) Challenge(negotiate []byte) ([]byte, error) {
+	if len(negotiate) < 32 || string(negotiate[:8]) != ntlmSignature ||
+		le.Uint32(negotiate[8:]) != ntlmNegotiate {
+		return nil, errors.New("invalid NTLM negotiate message")
+	}
+	serverChallenge := make([]byte, 8)
+	if _, err := rand.Read(serverChallenge); err != nil {
+		return nil, err
+	}
+	timestamp := make([]byte, 8)
+	le.PutUint64(timestamp, Filetime(time.Now()))
+	name := EncodeString(s.TargetName)
+	info := encodeAVPairs([]avPair{
+		{avNbDomainName, name},
+		{avNbComputerName, name},
+		{avTimestamp, timestamp},
+	})
+	msg := make([]byte, 56)
+	copy(msg, ntlmSignature)
+	le.PutUint32(msg[8:], ntlmChallenge)
+	msg = appendNTLMField(msg, 12, name)
+	le.PutUint32(msg[20:], le.Uint32(negotiate[12:])&ntlmFlags)
+	copy(msg[24:], serverChallenge)
+	msg = appendNTLMField(msg, 40, info)
+	copy(msg[48:], ntlmVersionInfo)
+	s.negotiate = negotiate
+	s.challenge = msg
+	return msg, nil
+}
+
+// Authenticate verifies AUTHENTICATE_MESSAGE and returns the user name,
+// empty for anonymous authentication.
+func (s *NTLMServer) Authenticate(msg []byte) (string, error) {
+	if len(msg) < authHeaderSize || string(msg[:8]) != ntlmSignature ||
+		le.Uint32(msg[8:]) != ntlmAuthenticate || s.challenge == nil {
+		return "", errors.New("invalid NTLM authenticate message")
+	}
+	var fields [6][]byte
+	for i, offset := range []int{authLmResponse, authNtResponse, authDomain,
+		authUser, authWorkstation, authSessionKey} {
+		field, err := ntlmField(msg, offset)
+		if err != nil {
+			return "", err
+		}
+		fields[i] = field
+	}
+	ntResponse, domain, user, encryptedKey := fields[1], fields[2], fields[3], fields[5]
+	flags := le.Uint32(msg[authFlags:])
+	if len(ntResponse) == 0 && len(user) == 0 {
+		if flags&ntlmAnonymous == 0 {
+			return "", ErrLogonFailure
+		}
+		return "", nil
+	}
+	if len(ntResponse) < 16+28 {
+		return "", ErrLogonFailure
+	}
+	password, ok := s.Password(DecodeString(user))
+	if !ok {
+		return "", ErrLogonFailure
+	}
+	ntowf := ntowfv2(DecodeString(user), password, domain)
+	ntProof, blob := ntResponse[:16], ntResponse[16:]
+	if !hmac.Equal(ntProof, hmacMD5(ntowf, s.challenge[24:32], blob)) {
+		return "", ErrLogonFailure
+	}
+	keyExchangeKey := hmacMD5(ntowf, ntProof)
+	s.sessionKey = keyExchangeKey
+	if flags&ntlmKeyExch != 0 {
+		if len(encryptedKey) != 16 {
+			return "", errors.New("invalid NTLM session key")
+		}
+		s.sessionKey = rc4Crypt(keyExchangeKey, encryptedKey)
+	}
+	pairs, err := parseAVPairs(blob[28:])
+	if err != nil {
+		return "", err
+	}
+	if avFlag, ok := lookupAVPair(pairs, avFlags); ok && len(avFlag) == 4 &&
+		le.Uint32(avFlag)&avFlagMIC != 0 {
+		unsigned := append([]byte{}, msg...)
+		copy(unsigned[authMIC:authHeaderSize], make([]byte, 16))
+		mic := hmacMD5(s.sessionKey, s.negotiate, s.challenge, unsigned)
+		if !hmac.Equal(msg[authMIC:authHeaderSize], mic) {
+			return "", errors.New("invalid NTLM MIC")
+		}
+	}
+	s.signKey, s.seal = ntlmSigningKeys(s.sessionKey, flags, true)
+	return DecodeString(user), nil
+}
+
+// SessionKey returns the exported session key, nil for anonymous
+// authentication.
+func (s *NTLMServer) SessionKey() []byte {
+	return s.sessionKey
+}
+
+// CheckMIC verifies the signature of the first message of the client.
+func (s *NTLMServer) CheckMIC(mic, data []byte) bool {
+	return s.sessionKey != nil && hmac.Equal(mic, ntlmMIC(s.signKey, s.seal, data))
+}
+
+// ntowfv2 is the NTLMv2 hash of the password (MS-NLMP 3.3.2).
+// domain is UTF-16LE encoded.
+func ntowfv2(user, password string, domain []byte) []byte {
+	hash := md4(EncodeString(password))
+	return hmacMD5(hash[:], EncodeString(strings.ToUpper(user)), domain)
+}
+
+// ntlmv2Blob is the NTLMv2_CLIENT_CHALLENGE structure.
+func ntlmv2Blob(timestamp, clientChallenge, targetInfo []byte) []byte {
+	blob := make([]byte, 28, 28+len(targetInfo)+4)
+	blob[0] = 1 // RespType
+	blob[1] = 1 // HiRespType
+	copy(blob[8:], timestamp)
+	copy(blob[16:], clientChallenge)
+	blob = append(blob, targetInfo...)
+	return append(blob, 0, 0, 0, 0)
+}
+
+// ntlmSigningKeys returns the signing key and the sealing cipher
+// of one direction of the extended session security. Signatures are
+// sealed only with the key exchange.
+func ntlmSigningKeys(sessionKey []byte, flags uint32, fromClient bool) ([]byte, *rc4.Cipher) {
+	direction := "client-to-server"
+	if !fromClient {
+		direction = "server-to-client"
+	}
+	signKey := md5.Sum(append(append([]byte{}, sessionKey...),
+		"session key to "+direction+" signing key magic constant\x00"...))
+	sealKey := md5.Sum(append(append([]byte{}, sessionKey...),
+		"session key to "+direction+" sealing key magic constant\x00"...))
+	if flags&ntlmKeyExch == 0 {
+		return signKey[:], nil
+	}
+	seal, _ := rc4.NewCipher(sealKey[:])
+	return signKey[:], seal
+}
+
+// ntlmMIC is the NTLMSSP_MESSAGE_SIGNATURE of the first message with
+// the key exchange (sequence number 0).
+func ntlmMIC(signKey []byte, seal *rc4.Cipher, data []byte) []byte {
+	sig := make([]byte, 16)
+	le.PutUint32(sig, 1) // Version
+	copy(sig[4:12], hmacMD5(signKey, sig[12:16], data))
+	if seal != nil {
+		seal.XORKeyStream(sig[4:12], sig[4:12])
+	}
+	return sig
+}
+
+func hmacMD5(key []byte, data ...[]byte) []byte {
+	h := hmac.New(md5.New, key)
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func rc4Crypt(key, data []byte) []byte {
+	c, _ := rc4.NewCipher(key)
+	out := make([]byte, len(data))
+	c.XORKeyStream(out, data)
+	return out
+}
+
+// ntlmField returns the payload described by the length, maximum length
+// and offset at the field offset of the message.
+func ntlmField(msg []byte, field int) ([]byte, error) {
+	length := int(le.Uint16(msg[field:]))
+	offset := int(le.Uint32(msg[field+4:]))
+	if length == 0 {
+		return nil, nil
+	}
+	if offset+length > len(msg) {
+		return nil, fmt.Errorf("invalid NTLM message field at %d", field)
+	}
+	return msg[offset : offset+length], nil
+}
+
+// appendNTLMField appends the payload to the message and describes it
+// at the field offset.
+func appendNTLMField(msg []byte, field int, payload []byte) []byte {
+	le.PutUint16(msg[field:], uint16(len(payload)))
+	le.PutUint16(msg[field+2:], uint16(len(payload)))
+	le.PutUint32(msg[field+4:], uint32(len(msg)))
+	return append(msg, payload...)
+}
+
+type avPair struct {
+	id    uint16
+	value []byte
+}
+
+// parseAVPairs parses AV pairs up to MsvAvEOL.
+func parseAVPairs(b []byte) ([]avPair, error) {
+	var pairs []avPair
+	for {
+		if len(b) < 4 {
+			return nil, errors.New("invalid NTLM target info")
+		}
+		id, length := le.Uint16(b), int(le.Uint16(b[2:]))
+		if id == avEOL {
+			return pairs, nil
+		}
+		if len(b) < 4+length {
+			return nil, errors.New("invalid NTLM target info")
+		}
+		pairs = append(pairs, avPair{id, append([]byte{}, b[4:4+length]...)})
+		b = b[4+length:]
+	}
+}
+
+func lookupAVPair(pairs []avPair, id uint16) ([]byte, bool) {
+	for _, pair := range pairs {
+		if pair.id == id {
+			return pair.value, true
+		}
+	}
+	return nil, false
+}
+
+// encodeAVPairs encodes AV pairs terminated by MsvAvEOL.
+func encodeAVPairs(pairs []avPair) []byte {
+	var b []byte
+	for _, pair := range pairs {
+		var hdr [4]byte
+		le.PutUint16(hdr[:], pair.id)
+		le.PutUint16(hdr[2:], uint16(len(pair.value)))
+		b = append(append(b, hdr[:]...), pair.value...)
+	}
+	return append(b, 0, 0, 0, 0)
+}
+
+// md4 computes the MD4 digest (RFC 1320), needed for the NT hash
+// of the password.
+func md4(data []byte) [16]byte {
+	msg := append(append([]byte{}, data...), 0x80)
+	for len(msg)%64 != 56 {
+		msg = append(msg, 0)
+	}
+	var length [8]byte
+	le.PutUint64(length[:], uint64(len(data))*8)
+	msg = append(msg, length[:]...)
+
+	rotl := bits.RotateLeft32
+	a, b, c, d := uint32(0x67452301), uint32(0xefcdab89), uint32(0x98badcfe), uint32(0x10325476)
+	var x [16]uint32
+	for ; len(msg) > 0; msg = msg[64:] {
+		for i := range x {
+			x[i] = le.Uint32(msg[4*i:])
+		}
+		aa, bb, cc, dd := a, b, c, d
+		for _, k := range []int{0, 4, 8, 12} {
+			a = rotl(a+(b&c|^b&d)+x[k], 3)
+			d = rotl(d+(a&b|^a&c)+x[k+1], 7)
+			c = rotl(c+(d&a|^d&b)+x[k+2], 11)
+			b = rotl(b+(c&d|^c&a)+x[k+3], 19)
+		}
+		for _, k := range []int{0, 1, 2, 3} {
+			a = rotl(a+(b&c|b&d|c&d)+x[k]+0x5a827999, 3)
+			d = rotl(d+(a&b|a&c|b&c)+x[k+4]+0x5a827999, 5)
+			c = rotl(c+(d&a|d&b|a&b)+x[k+8]+0x5a827999, 9)
+			b = rotl(b+(c&d|c&a|d&a)+x[k+12]+0x5a827999, 13)
+		}
+		for _, k := range []int{0, 2, 1, 3} {
+			a = rotl(a+(b^c^d)+x[k]+0x6ed9eba1, 3)
+			d = rotl(d+(a^b^c)+x[k+8]+0x6ed9eba1, 9)
+			c = rotl(c+(d^a^b)+x[k+4]+0x6ed9eba1, 11)
+			b = rotl(b+(c^d^a)+x[k+12]+0x6ed9eba1, 15)
+		}
+		a, b, c, d = a+aa, b+bb, c+cc, d+dd
+	}
+	var sum [16]byte
+	le.PutUint32(sum[0:], a)
+	le.PutUint32(sum[4:], b)
+	le.PutUint32(sum[8:], c)
+	le.PutUint32(sum[12:], d)
+	return sum
+}
@@ -0,0 +1,376 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package smb2 implements the subset of SMB 2 and 3 (MS-SMB2), NTLM
+// (MS-NLMP) and SPNEGO (RFC 4178) used by the SMB client and by
+// the in-process test server.
+package smb2
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf16"
+)
+
+// HeaderSize is the size of the SMB2 header, offsets in message bodies
+// are relative to the start of the header.
+const HeaderSize = 64
+
+// Commands
+const (
+	CmdNegotiate      = 0x0000
+	CmdSessionSetup   = 0x0001
+	CmdLogoff         = 0x0002
+	CmdTreeConnect    = 0x0003
+	CmdTreeDisconnect = 0x0004
+	CmdCreate         = 0x0005
+	CmdClose          = 0x0006
+	CmdFlush          = 0x0007
+	CmdRead           = 0x0008
+	CmdWrite          = 0x0009
+	CmdQueryDirectory = 0x000e
+	CmdQueryInfo      = 0x0010
+)
+
+// Header flags
+const (
+	FlagResponse = 0x00000001
+	FlagAsync    = 0x00000002
+	FlagSigned   = 0x00000008
+)
+
+// Dialects, SMB 3.1.1 is not supported.
+const (
+	Dialect202 = 0x0202
+	Dialect210 = 0x0210
+	Dialect300 = 0x0300
+	Dialect302 = 0x0302
+)
+
+// Dialects lists the supported dialects, the newest last.
+var Dialects = []uint16{Dialect202, Dialect210, Dialect300, Dialect302}
+
+// Security modes, capabilities and session flags
+const (
+	SigningEnabled  = 0x0001
+	SigningRequired = 0x0002
+
+	CapLargeMTU = 0x00000004
+
+	SessionFlagGuest   = 0x0001
+	SessionFlagNull    = 0x0002
+	SessionFlagEncrypt = 0x0004
+)
+
+// NTSTATUS values
+const (
+	StatusSuccess                = 0x00000000
+	StatusPending                = 0x00000103
+	StatusNoMoreFiles            = 0x80000006
+	StatusInvalidParameter       = 0xc000000d
+	StatusNoSuchFile             = 0xc000000f
+	StatusEndOfFile              = 0xc0000011
+	StatusMoreProcessingRequired = 0xc0000016
+	StatusAccessDenied           = 0xc0000022
+	StatusObjectNameInvalid      = 0xc0000033
+	StatusObjectNameNotFound     = 0xc0000034
+	StatusObjectNameCollision    = 0xc0000035
+	StatusObjectPathNotFound     = 0xc000003a
+	StatusSharingViolation       = 0xc0000043
+	StatusLogonFailure           = 0xc000006d
+	StatusDiskFull               = 0xc000007f
+	StatusFileIsADirectory       = 0xc00000ba
+	StatusNotSupported           = 0xc00000bb
+	StatusBadNetworkName         = 0xc00000cc
+	StatusDirectoryNotEmpty      = 0xc0000101
+	StatusNotADirectory          = 0xc0000103
+	StatusFileClosed             = 0xc0000128
+	StatusUserSessionDeleted     = 0xc0000203
+	StatusNetworkSessionExpired  = 0xc000035c
+)
+
+// CREATE parameters
+const (
+	AccessReadData       = 0x00000001 // also FILE_LIST_DIRECTORY
+	AccessWriteData      = 0x00000002
+	AccessReadAttributes = 0x00000080
+	AccessDelete         = 0x00010000
+	AccessSynchronize    = 0x00100000
+
+	ShareRead   = 0x00000001
+	ShareWrite  = 0x00000002
+	ShareDelete = 0x00000004
+
+	DispositionOpen        = 0x00000001
+	DispositionCreate      = 0x00000002
+	DispositionOpenIf      = 0x00000003
+	DispositionOverwriteIf = 0x00000005
+
+	OptionDirectoryFile    = 0x00000001
+	OptionNonDirectoryFile = 0x00000040
+	OptionDeleteOnClose    = 0x00001000
+
+	ImpersonationLevel = 0x00000002 // Impersonation
+
+	AttrReadonly  = 0x00000001
+	AttrDirectory = 0x00000010
+	AttrNormal    = 0x00000080
+)
+
+// QUERY_DIRECTORY and QUERY_INFO parameters
+const (
+	FileDirectoryInformation = 0x01
+	FileStandardInformation  = 0x05
+	InfoTypeFile             = 0x01
+	RestartScans             = 0x01
+)
+
+// ErrShortMessage is returned when a message is shorter than its fields.
+var ErrShortMessage = errors.New("short SMB2 message")
+
+// Header is the SMB2 header (MS-SMB2 2.2.1). AsyncID replaces the process
+// and tree IDs of the sync header when FlagAsync is set.
+type Header struct {
+	CreditCharge uint16
+	Status       uint32
+	Command      uint16
+	Credits      uint16
+	Flags        uint32
+	NextCommand  uint32
+	MessageID    uint64
+	AsyncID      uint64
+	TreeID       uint32
+	SessionID    uint64
+	Signature    [16]byte
+}
+
+var le = binary.LittleEndian
+
+// NewMessage returns a message of the header followed by a body
+// of bodySize bytes.
+func NewMessage(hdr *Header, bodySize int) []byte {
+	msg := make([]byte, HeaderSize+bodySize)
+	copy(msg, "\xfeSMB")
+	le.PutUint16(msg[4:], HeaderSize)
+	le.PutUint16(msg[6:], hdr.CreditCharge)
+	le.PutUint32(msg[8:], hdr.Status)
+	le.PutUint16(msg[12:], hdr.Command)
+	le.PutUint16(msg[14:], hdr.Credits)
+	le.PutUint32(msg[16:], hdr.Flags)
+	le.PutUint32(msg[20:], hdr.NextCommand)
+	le.PutUint64(msg[24:], hdr.MessageID)
+	if hdr.Flags&FlagAsync != 0 {
+		le.PutUint64(msg[32:], hdr.AsyncID)
+	} else {
+		le.PutUint32(msg[36:], hdr.TreeID)
+	}
+	le.PutUint64(msg[40:], hdr.SessionID)
+	copy(msg[48:], hdr.Signature[:])
+	return msg
+}
+
+// DecodeHeader decodes the header of a message.
+func DecodeHeader(msg []byte) (Header, error) {
+	var hdr Header
+	if len(msg) < HeaderSize {
+		return hdr, ErrShortMessage
+	}
+	if string(msg[:4]) != "\xfeSMB" || le.Uint16(msg[4:]) != HeaderSize {
+		return hdr, fmt.Errorf("not an SMB2 message")
+	}
+	hdr.CreditCharge = le.Uint16(msg[6:])
+	hdr.Status = le.Uint32(msg[8:])
+	hdr.Command = le.Uint16(msg[12:])
+	hdr.Credits = le.Uint16(msg[14:])
+	hdr.Flags = le.Uint32(msg[16:])
+	hdr.NextCommand = le.Uint32(msg[20:])
+	hdr.MessageID = le.Uint64(msg[24:])
+	if hdr.Flags&FlagAsync != 0 {
+		hdr.AsyncID = le.Uint64(msg[32:])
+	} else {
+		hdr.TreeID = le.Uint32(msg[36:])
+	}
+	hdr.SessionID = le.Uint64(msg[40:])
+	copy(hdr.Signature[:], msg[48:])
+	return hdr, nil
+}
+
+// Buffer returns the part of the message given by an offset relative
+// to the start of the header and a length.
+func Buffer(msg []byte, offset, length int) ([]byte, error) {
+	if length == 0 {
+		return nil, nil
+	}
+	if offset < HeaderSize || offset+length > len(msg) {
+		return nil, ErrShortMessage
+	}
+	return msg[offset : offset+length], nil
+}
+
+// WriteMessage writes the message with the length prefix of the Direct
+// TCP transport (MS-SMB2 2.1).
+func WriteMessage(w io.Writer, msg []byte) error {
+	buf := make([]byte, 4+len(msg))
+	binary.BigEndian.PutUint32(buf, uint32(len(msg)))
+	copy(buf[4:], msg)
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadMessage reads a message of the Direct TCP transport.
+func ReadMessage(r io.Reader, maxSize int) ([]byte, error) {
+	var prefix [4]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(prefix[:])
+	if prefix[0] != 0 || int(size) > maxSize {
+		return nil, fmt.Errorf("SMB2 message of %d bytes exceeds the limit", size)
+	}
+	msg := make([]byte, size)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// EncodeString encodes the string as UTF-16LE.
+func EncodeString(s string) []byte {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(u))
+	for i, c := range u {
+		le.PutUint16(b[2*i:], c)
+	}
+	return b
+}
+
+// DecodeString decodes UTF-16LE string.
+func DecodeString(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = le.Uint16(b[2*i:])
+	}
+	return string(utf16.Decode(u))
+}
+
+// FILETIME counts 100ns intervals since 1601-01-01.
+const filetimeUnixEpoch = 116444736000000000
+
+// Filetime converts the time to FILETIME.
+func Filetime(t time.Time) uint64 {
+	return uint64(t.UnixNano()/100 + filetimeUnixEpoch)
+}
+
+// Time converts FILETIME to time.
+func Time(ft uint64) time.Time {
+	ns := (int64(ft) - filetimeUnixEpoch) * 100
+	return time.Unix(0, ns)
+}
+
+// Signer signs messages of an authenticated session, with HMAC-SHA256
+// for SMB 2 dialects and AES-CMAC for SMB 3 dialects (MS-SMB2 3.1.4.1).
+type Signer struct {
+	key   []byte
+	block cipher.Block
+}
+
+// NewSigner returns a signer using the session key of the dialect.
+func NewSigner(dialect uint16, sessionKey []byte) (*Signer, error) {
+	if dialect < Dialect300 {
+		return &Signer{key: sessionKey}, nil
+	}
+	signingKey := kdf(sessionKey, []byte("SMB2AESCMAC\x00"), []byte("SmbSign\x00"))
+	block, err := aes.NewCipher(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{block: block}, nil
+}
+
+func (s *Signer) sum(msg []byte) []byte {
+	var zero [16]byte
+	if s.block != nil {
+		return cmac(s.block, msg[:48], zero[:], msg[HeaderSize:])
+	}
+	h := hmac.New(sha256.New, s.key)
+	h.Write(msg[:48])
+	h.Write(zero[:])
+	h.Write(msg[HeaderSize:])
+	return h.Sum(nil)[:16]
+}
+
+// Sign sets the signed flag and the signature of the message.
+func (s *Signer) Sign(msg []byte) {
+	le.PutUint32(msg[16:], le.Uint32(msg[16:])|FlagSigned)
+	copy(msg[48:HeaderSize], s.sum(msg))
+}
+
+// Verify checks the signature of the message.
+func (s *Signer) Verify(msg []byte) bool {
+	return len(msg) >= HeaderSize &&
+		le.Uint32(msg[16:])&FlagSigned != 0 &&
+		hmac.Equal(msg[48:HeaderSize], s.sum(msg))
+}
+
+// kdf is the key derivation function of SP800-108 in counter mode
+// with HMAC-SHA256, deriving 128 bits.
+func kdf(key, label, context []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte{0, 0, 0, 1})
+	h.Write(label)
+	h.Write([]byte{0})
+	h.Write(context)
+	h.Write([]byte{0, 0, 0, 128})
+	return h.Sum(nil)[:16]
+}
+
+// cmac computes AES-CMAC (RFC 4493) of the concatenated parts.
+func cmac(block cipher.Block, parts ...[]byte) []byte {
+	var k1, k2 [16]byte
+	block.Encrypt(k1[:], k1[:])
+	k1 = cmacDouble(k1)
+	k2 = cmacDouble(k1)
+	var msg []byte
+	for _, part := range parts {
+		msg = append(msg, part...)
+	}
+	var x, last [16]byte
+	for len(msg) > 16 {
+		for i := range x {
+			x[i] ^= msg[i]
+		}
+		block.Encrypt(x[:], x[:])
+		msg = msg[16:]
+	}
+	copy(last[:], msg)
+	key := k1
+	if len(msg) < 16 {
+		last[len(msg)] = 0x80
+		key = k2
+	}
+	for i := range x {
+		x[i] ^= last[i] ^ key[i]
+	}
+	block.Encrypt(x[:], x[:])
+	return x[:]
+}
+
+// cmacDouble multiplies the subkey by x in GF(2^128).
+func cmacDouble(k [16]byte) [16]byte {
+	var d [16]byte
+	for i := 0; i < 15; i++ {
+		d[i] = k[i]<<1 | k[i+1]>>7
+	}
+	d[15] = k[15] << 1
+	if k[0]&0x80 != 0 {
+		d[15] ^= 0x87
+	}
+	return d
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smb2
+
+import (
+	"bytes"
+	"crypto/aes"
+	"encoding/hex"
+	"testing"
+)
+
+// The client and the test server share this package, the cryptographic
+// functions are checked against published test vectors.
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestMD4(t *testing.T) {
+	// RFC 1320, A.5
+	for input, digest := range map[string]string{
+		"":    "31d6cfe0d16ae931b73c59d7e0c089c0",
+		"abc": "a448017aaf21d8525fc10ae87aa6729d",
+		"12345678901234567890123456789012345678901234567890123456789012345678901234567890": "e33b4ddc9c38f2199c3e7b164fcc0536",
+	} {
+		sum := md4([]byte(input))
+		if got := hex.EncodeToString(sum[:]); got != digest {
+			t.Errorf("MD4(%q) = %s, expected %s", input, got, digest)
+		}
+	}
+}
+
+func TestCMAC(t *testing.T) {
+	// RFC 4493, 4
+	block, err := aes.NewCipher(unhex(t, "2b7e151628aed2a6abf7158809cf4f3c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := unhex(t, "6bc1bee22e409f96e93d7e117393172a"+
+		"ae2d8a571e03ac9c9eb76fac45af8e51"+
+		"30c81c46a35ce411e5fbc1191a0a52ef"+
+		"f69f2445df4f9b17ad2b417be66c3710")
+	for length, mac := range map[int]string{
+		0:  "bb1d6929e95937287fa37d129b756746",
+		16: "070a16b46b4d4144f79bdd9dd04a287c",
+		40: "dfa66747de9ae63030ca32611497c827",
+		64: "51f0bebf7e3b9d92fc49741779363cfe",
+	} {
+		// Split the message to check concatenation of the parts.
+		half := length / 2
+		got := hex.EncodeToString(cmac(block, msg[:half], msg[half:length]))
+		if got != mac {
+			t.Errorf("AES-CMAC of %d bytes = %s, expected %s", length, got, mac)
+		}
+	}
+}
+
+func TestKDF(t *testing.T) {
+	got := kdf([]byte("foo"), []byte("bar"), []byte("baz"))
+	if expected := unhex(t, "ca3928a6664e3cfdc87eef2dff7c78ac"); !bytes.Equal(got, expected) {
+		t.Errorf("KDF = %x, expected %x", got, expected)
+	}
+}
+
+func TestNTLMv2(t *testing.T) {
+	// MS-NLMP 4.2.4
+	ntowf := ntowfv2("User", "Password", EncodeString("Domain"))
+	if expected := unhex(t, "0c868a403bfd7a93a3001ef22ef02e3f"); !bytes.Equal(ntowf, expected) {
+		t.Fatalf("NTOWFv2 = %x, expected %x", ntowf, expected)
+	}
+	serverChallenge := unhex(t, "0123456789abcdef")
+	clientChallenge := unhex(t, "aaaaaaaaaaaaaaaa")
+	info := encodeAVPairs([]avPair{
+		{avNbDomainName, EncodeString("Domain")},
+		{avNbComputerName, EncodeString("Server")},
+	})
+	blob := ntlmv2Blob(make([]byte, 8), clientChallenge, info)
+	ntProof := hmacMD5(ntowf, serverChallenge, blob)
+	if expected := unhex(t, "68cd0ab851e51c96aabc927bebef6a1c"); !bytes.Equal(ntProof, expected) {
+		t.Errorf("NTProofStr = %x, expected %x", ntProof, expected)
+	}
+	sessionBaseKey := hmacMD5(ntowf, ntProof)
+	if expected := unhex(t, "8de40ccadbc14a82f15cb0ad0de95ca3"); !bytes.Equal(sessionBaseKey, expected) {
+		t.Errorf("SessionBaseKey = %x, expected %x", sessionBaseKey, expected)
+	}
+	lmResponse := hmacMD5(ntowf, serverChallenge, clientChallenge)
+	if expected := unhex(t, "86c35097ac9cec102554764a57cccc19"); !bytes.Equal(lmResponse, expected) {
+		t.Errorf("LMv2 response = %x, expected %x", lmResponse, expected)
+	}
+	encryptedKey := rc4Crypt(sessionBaseKey, unhex(t, "55555555555555555555555555555555"))
+	if expected := unhex(t, "c5dad2544fc9799094ce1ce90bc9d03e"); !bytes.Equal(encryptedKey, expected) {
+		t.Errorf("EncryptedRandomSessionKey = %x, expected %x", encryptedKey, expected)
+	}
+}
+
+func TestNTLMAuthentication(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		user     string
+		password string
+		domain   string
+		err      error
+	}{
+		{name: "valid", user: "user", password: "secret"},
+		{name: "valid with domain", user: "user", password: "secret", domain: "WORKGROUP"},
+		{name: "anonymous"},
+		{name: "bad password", user: "user", password: "wrong", err: ErrLogonFailure},
+		{name: "unknown user", user: "nobody", password: "secret", err: ErrLogonFailure},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &NTLMClient{User: tc.user, Password: tc.password,
+				Domain: tc.domain, TargetName: "cifs/server"}
+			server := &NTLMServer{TargetName: "SERVER",
+				Password: func(user string) (string, bool) {
+					return "secret", user == "user"
+				}}
+			challenge, err := server.Challenge(client.Negotiate())
+			if err != nil {
+				t.Fatal(err)
+			}
+			authenticate, err := client.Authenticate(challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+			user, err := server.Authenticate(authenticate)
+			if err != tc.err {
+				t.Fatalf("authentication error %v, expected %v", err, tc.err)
+			}
+			if err != nil {
+				return
+			}
+			if user != tc.user {
+				t.Errorf("authenticated as %q", user)
+			}
+			if !bytes.Equal(client.SessionKey(), server.SessionKey()) {
+				t.Errorf("session keys differ")
+			}
+			mic := client.MIC(NTLMMechTypes())
+			if tc.user != "" && !server.CheckMIC(mic, NTLMMechTypes()) {
+				t.Errorf("invalid MIC")
+			}
+		})
+	}
+}
+
+func TestSPNEGO(t *testing.T) {
+	b, err := EncodeNegTokenInit([]byte("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Layout of the token as sent by Windows.
+	if !bytes.Equal(b, unhex(t, "6025"+"06062b0601050502"+"a01b3019"+
+		"a00e300c060a2b06010401823702020a"+"a2070405746f6b656e")) {
+		t.Errorf("unexpected encoding %x", b)
+	}
+	token, err := DecodeNegTokenInit(b)
+	if err != nil || string(token) != "token" {
+		t.Errorf("decoded %q, %v", token, err)
+	}
+	b, err = EncodeNegTokenResp(NegTokenResp{NegState: NegStateAcceptIncomplete,
+		SupportedMech: NTLMMech(), ResponseToken: []byte("challenge")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := DecodeNegTokenResp(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.NegState != NegStateAcceptIncomplete || !resp.SupportedMech.Equal(NTLMMech()) ||
+		string(resp.ResponseToken) != "challenge" {
+		t.Errorf("decoded %+v", resp)
+	}
+}
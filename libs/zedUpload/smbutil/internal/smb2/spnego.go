@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smb2
+
+import (
+	"encoding/asn1"
+	"errors"
+)
+
+// SPNEGO negotiation states
+const (
+	NegStateAcceptCompleted  = 0
+	NegStateAcceptIncomplete = 1
+	NegStateReject           = 2
+)
+
+var (
+	spnegoOID = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 2}
+	ntlmOID   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 2, 10}
+)
+
+// initialContextToken is the GSS-API token with negTokenInit,
+// tagged [APPLICATION 0].
+type initialContextToken struct {
+	ThisMech asn1.ObjectIdentifier
+	Init     negTokenInit `asn1:"explicit,tag:0"`
+}
+
+type negTokenInit struct {
+	MechTypes   []asn1.ObjectIdentifier `asn1:"explicit,optional,tag:0"`
+	ReqFlags    asn1.BitString          `asn1:"explicit,optional,tag:1"`
+	MechToken   []byte                  `asn1:"explicit,optional,tag:2"`
+	MechListMIC []byte                  `asn1:"explicit,optional,tag:3"`
+}
+
+// NegTokenResp is the negTokenResp of SPNEGO, tagged [1].
+type NegTokenResp struct {
+	NegState      asn1.Enumerated       `asn1:"explicit,optional,tag:0"`
+	SupportedMech asn1.ObjectIdentifier `asn1:"explicit,optional,tag:1"`
+	ResponseToken []byte                `asn1:"explicit,optional,tag:2"`
+	MechListMIC   []byte                `asn1:"explicit,optional,tag:3"`
+}
+
+// NTLMMechTypes returns the DER encoded list of mechanisms offered
+// by the client, protected by the MIC of the mechanism.
+func NTLMMechTypes() []byte {
+	b, _ := asn1.Marshal([]asn1.ObjectIdentifier{ntlmOID})
+	return b
+}
+
+// EncodeNegTokenInit wraps the first NTLM token of the client.
+func EncodeNegTokenInit(mechToken []byte) ([]byte, error) {
+	b, err := asn1.Marshal(initialContextToken{
+		ThisMech: spnegoOID,
+		Init: negTokenInit{
+			MechTypes: []asn1.ObjectIdentifier{ntlmOID},
+			MechToken: mechToken,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	b[0] = 0x60 // [APPLICATION 0], constructed
+	return b, nil
+}
+
+// DecodeNegTokenInit returns the NTLM token of negTokenInit.
+func DecodeNegTokenInit(b []byte) ([]byte, error) {
+	var token initialContextToken
+	if _, err := asn1.UnmarshalWithParams(b, &token, "application,tag:0"); err != nil {
+		return nil, err
+	}
+	if !token.ThisMech.Equal(spnegoOID) || len(token.Init.MechTypes) == 0 ||
+		!token.Init.MechTypes[0].Equal(ntlmOID) {
+		return nil, errors.New("SPNEGO token does not offer NTLM")
+	}
+	return token.Init.MechToken, nil
+}
+
+// EncodeNegTokenResp encodes negTokenResp.
+func EncodeNegTokenResp(resp NegTokenResp) ([]byte, error) {
+	return asn1.MarshalWithParams(resp, "explicit,tag:1")
+}
+
+// DecodeNegTokenResp decodes negTokenResp.
+func DecodeNegTokenResp(b []byte) (NegTokenResp, error) {
+	var resp NegTokenResp
+	_, err := asn1.UnmarshalWithParams(b, &resp, "explicit,tag:1")
+	return resp, err
+}
+
+// NTLMMech is the object identifier of NTLM, the supported mechanism.
+func NTLMMech() asn1.ObjectIdentifier {
+	return ntlmOID
+}
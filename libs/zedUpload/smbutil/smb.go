@@ -0,0 +1,444 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package smb implements a userspace SMB client over TCP. SMB 2.0.2
+// up to 3.0.2 (MS-SMB2) is negotiated, users are authenticated with NTLMv2
+// and messages of authenticated sessions are signed. Encryption is not
+// supported. Unlike the kernel CIFS client, its socket is bound to
+// the selected source address, and it does not depend on the CIFS support
+// of the kernel.
+package smb
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	mount "github.com/lf-edge/eve/libs/zedUpload/mountutil"
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/internal/smb2"
+)
+
+const (
+	// callTimeout limits the time to connect and to get a response
+	// to a request.
+	callTimeout = time.Minute
+	defaultPort = 445
+	// READ and WRITE are charged a credit per 64KiB of data.
+	creditSize      = 64 * 1024
+	maxTransferSize = 1024 * 1024
+	// Maximum size of a response, READ data plus headers.
+	maxResponseSize = maxTransferSize + 64*1024
+	// creditTarget is the number of credits the client asks the server
+	// to keep available, enough for the largest READ or WRITE.
+	creditTarget = 2 * maxTransferSize / creditSize
+)
+
+var le = binary.LittleEndian
+
+// Share is an SMB share.
+type Share struct {
+	// Server is the address of the SMB server.
+	Server net.IP
+	// Port of the SMB service, 445 when zero.
+	Port int
+	// Host is the name of the server used in the path of the share,
+	// Server is used when empty.
+	Host string
+	// Name of the share.
+	Name string
+	// User name, guest access is used when empty.
+	User string
+	// Password of the user, never logged.
+	Password string
+	// Domain of the user, optional.
+	Domain string
+	// SrcIP is the source address of the connection, optional.
+	SrcIP net.IP
+}
+
+func (s *Share) host() string {
+	if s.Host != "" {
+		return s.Host
+	}
+	return s.Server.String()
+}
+
+// String returns description of the share without credentials.
+func (s *Share) String() string {
+	return fmt.Sprintf("//%s/%s (smb)", s.host(), s.Name)
+}
+
+// Mount connects to the share. The client does not cache any data,
+// readOnly is not needed to protect the share.
+func (s *Share) Mount(readOnly bool) (mount.FS, error) {
+	c, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	if err = c.negotiate(); err != nil {
+		c.close()
+		return nil, fmt.Errorf("SMB negotiation failed: %v", err)
+	}
+	if err = c.sessionSetup(s); err != nil {
+		c.close()
+		return nil, fmt.Errorf("SMB session setup failed: %v", err)
+	}
+	if err = c.treeConnect(s); err != nil {
+		c.logoff()
+		c.close()
+		return nil, err
+	}
+	return &fileSystem{share: s, conn: c}, nil
+}
+
+func (s *Share) dial() (*conn, error) {
+	port := s.Port
+	if port == 0 {
+		port = defaultPort
+	}
+	dialer := net.Dialer{Timeout: callTimeout}
+	if s.SrcIP != nil {
+		dialer.LocalAddr = &net.TCPAddr{IP: s.SrcIP}
+	}
+	netConn, err := dialer.Dial("tcp", net.JoinHostPort(s.Server.String(), strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+	return &conn{conn: netConn, credits: 1}, nil
+}
+
+// conn is a connection with a session and a tree connect. Requests are
+// sent one at a time.
+type conn struct {
+	sync.Mutex
+	conn      net.Conn
+	dialect   uint16
+	messageID uint64
+	credits   int
+	// largeMTU allows READ and WRITE larger than creditSize.
+	largeMTU  bool
+	maxRead   int
+	maxWrite  int
+	sessionID uint64
+	treeID    uint32
+	// signer is set for authenticated sessions.
+	signer *smb2.Signer
+}
+
+// call sends a request and returns the header and the whole response.
+// payload is the size of the data to read or write, which determines
+// the credit charge. Statuses of responses are checked by the caller.
+func (c *conn) call(cmd uint16, body []byte, payload int) (smb2.Header, []byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	charge := 1
+	if c.largeMTU && payload > creditSize {
+		charge = (payload-1)/creditSize + 1
+	}
+	if charge > c.credits {
+		return smb2.Header{}, nil, fmt.Errorf("SMB server granted %d credits, %d needed",
+			c.credits, charge)
+	}
+	c.credits -= charge
+	hdr := smb2.Header{
+		Command:   cmd,
+		MessageID: c.messageID,
+		TreeID:    c.treeID,
+		SessionID: c.sessionID,
+		Credits:   1,
+	}
+	if c.credits < creditTarget {
+		hdr.Credits = uint16(creditTarget - c.credits)
+	}
+	if c.dialect != smb2.Dialect202 {
+		hdr.CreditCharge = uint16(charge)
+	}
+	c.messageID += uint64(charge)
+	msg := smb2.NewMessage(&hdr, len(body))
+	copy(msg[smb2.HeaderSize:], body)
+	if c.signer != nil {
+		c.signer.Sign(msg)
+	}
+	if err := c.conn.SetDeadline(time.Now().Add(callTimeout)); err != nil {
+		return smb2.Header{}, nil, err
+	}
+	if err := smb2.WriteMessage(c.conn, msg); err != nil {
+		return smb2.Header{}, nil, err
+	}
+	for {
+		resp, err := smb2.ReadMessage(c.conn, maxResponseSize)
+		if err != nil {
+			return smb2.Header{}, nil, err
+		}
+		respHdr, err := smb2.DecodeHeader(resp)
+		if err != nil {
+			return smb2.Header{}, nil, err
+		}
+		if respHdr.Flags&smb2.FlagResponse == 0 {
+			return smb2.Header{}, nil, errors.New("SMB server sent a request")
+		}
+		c.credits += int(respHdr.Credits)
+		if respHdr.MessageID != hdr.MessageID {
+			// Not requested (e.g. oplock break), or late.
+			continue
+		}
+		if respHdr.Status == smb2.StatusPending && respHdr.Flags&smb2.FlagAsync != 0 {
+			// Interim response, the final one follows.
+			continue
+		}
+		if c.signer != nil && !c.signer.Verify(resp) && !sessionLost(respHdr.Status) {
+			return smb2.Header{}, nil, errors.New("invalid signature of SMB response")
+		}
+		if respHdr.Command != cmd {
+			return smb2.Header{}, nil, fmt.Errorf("SMB response to command %d, %d expected",
+				respHdr.Command, cmd)
+		}
+		return respHdr, resp, nil
+	}
+}
+
+// sessionLost is true for statuses which the server cannot sign because
+// the session is gone. They are accepted unsigned, as they only fail
+// the request.
+func sessionLost(status uint32) bool {
+	return status == smb2.StatusUserSessionDeleted ||
+		status == smb2.StatusNetworkSessionExpired
+}
+
+// transferSize limits the size of READ or WRITE data to the maximum and
+// to the available credits.
+func (c *conn) transferSize(size, max int) int {
+	c.Lock()
+	defer c.Unlock()
+	if size > max {
+		size = max
+	}
+	if c.largeMTU && c.credits > 0 && size > c.credits*creditSize {
+		size = c.credits * creditSize
+	}
+	return size
+}
+
+func (c *conn) close() {
+	c.conn.Close()
+}
+
+// negotiate selects the dialect (MS-SMB2 2.2.3, 2.2.4).
+func (c *conn) negotiate() error {
+	body := make([]byte, 36+2*len(smb2.Dialects))
+	le.PutUint16(body[0:], 36)
+	le.PutUint16(body[2:], uint16(len(smb2.Dialects)))
+	le.PutUint16(body[4:], smb2.SigningEnabled)
+	le.PutUint32(body[8:], smb2.CapLargeMTU)
+	if _, err := rand.Read(body[12:28]); err != nil { // ClientGuid
+		return err
+	}
+	for i, dialect := range smb2.Dialects {
+		le.PutUint16(body[36+2*i:], dialect)
+	}
+	hdr, msg, err := c.call(smb2.CmdNegotiate, body, 0)
+	if err != nil {
+		return err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return statusError(hdr.Status)
+	}
+	if len(msg) < smb2.HeaderSize+64 {
+		return smb2.ErrShortMessage
+	}
+	resp := msg[smb2.HeaderSize:]
+	c.dialect = le.Uint16(resp[4:])
+	supported := false
+	for _, dialect := range smb2.Dialects {
+		supported = supported || dialect == c.dialect
+	}
+	if !supported {
+		return fmt.Errorf("SMB server selected unsupported dialect 0x%x", c.dialect)
+	}
+	c.largeMTU = c.dialect != smb2.Dialect202 &&
+		le.Uint32(resp[24:])&smb2.CapLargeMTU != 0
+	c.maxRead = c.limitTransferSize(int(le.Uint32(resp[32:])))
+	c.maxWrite = c.limitTransferSize(int(le.Uint32(resp[36:])))
+	return nil
+}
+
+func (c *conn) limitTransferSize(size int) int {
+	max := maxTransferSize
+	if !c.largeMTU {
+		max = creditSize
+	}
+	if size <= 0 || size > max {
+		return max
+	}
+	return size
+}
+
+// sessionSetup authenticates the user with NTLM in SPNEGO
+// (MS-SMB2 2.2.5, 2.2.6).
+func (c *conn) sessionSetup(s *Share) error {
+	ntlm := &smb2.NTLMClient{
+		User:       s.User,
+		Password:   s.Password,
+		Domain:     s.Domain,
+		TargetName: "cifs/" + s.host(),
+	}
+	token, err := smb2.EncodeNegTokenInit(ntlm.Negotiate())
+	if err != nil {
+		return err
+	}
+	hdr, _, buf, err := c.sessionSetupRequest(token)
+	if err != nil {
+		return err
+	}
+	if hdr.Status != smb2.StatusMoreProcessingRequired {
+		return statusError(hdr.Status)
+	}
+	c.sessionID = hdr.SessionID
+	negResp, err := smb2.DecodeNegTokenResp(buf)
+	if err != nil {
+		return fmt.Errorf("invalid SPNEGO response: %v", err)
+	}
+	if negResp.NegState == smb2.NegStateReject || !negResp.SupportedMech.Equal(smb2.NTLMMech()) {
+		return errors.New("SMB server does not support NTLM authentication")
+	}
+	authenticate, err := ntlm.Authenticate(negResp.ResponseToken)
+	if err != nil {
+		return err
+	}
+	token, err = smb2.EncodeNegTokenResp(smb2.NegTokenResp{
+		ResponseToken: authenticate,
+		MechListMIC:   ntlm.MIC(smb2.NTLMMechTypes()),
+	})
+	if err != nil {
+		return err
+	}
+	hdr, msg, _, err := c.sessionSetupRequest(token)
+	if err != nil {
+		return err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return statusError(hdr.Status)
+	}
+	if le.Uint16(msg[smb2.HeaderSize+2:])&(smb2.SessionFlagGuest|smb2.SessionFlagNull) != 0 {
+		if s.User != "" {
+			// The server maps unknown users to guest.
+			return smb2.ErrLogonFailure
+		}
+		return nil
+	}
+	if ntlm.SessionKey() == nil {
+		return errors.New("SMB server did not allow guest access")
+	}
+	signer, err := smb2.NewSigner(c.dialect, ntlm.SessionKey())
+	if err != nil {
+		return err
+	}
+	// The final response proves that the server knows the session key,
+	// it is signed with SMB 3 dialects.
+	if hdr.Flags&smb2.FlagSigned != 0 || c.dialect >= smb2.Dialect300 {
+		if !signer.Verify(msg) {
+			return errors.New("invalid signature of SMB response")
+		}
+	}
+	c.signer = signer
+	return nil
+}
+
+// sessionSetupRequest sends SESSION_SETUP with the security token and
+// returns the response together with its security buffer.
+func (c *conn) sessionSetupRequest(token []byte) (smb2.Header, []byte, []byte, error) {
+	body := make([]byte, 24+len(token))
+	le.PutUint16(body[0:], 25)
+	body[3] = smb2.SigningEnabled
+	le.PutUint16(body[12:], smb2.HeaderSize+24)
+	le.PutUint16(body[14:], uint16(len(token)))
+	copy(body[24:], token)
+	hdr, msg, err := c.call(smb2.CmdSessionSetup, body, 0)
+	if err != nil || (hdr.Status != smb2.StatusSuccess &&
+		hdr.Status != smb2.StatusMoreProcessingRequired) {
+		return hdr, msg, nil, err
+	}
+	if len(msg) < smb2.HeaderSize+8 {
+		return hdr, nil, nil, smb2.ErrShortMessage
+	}
+	resp := msg[smb2.HeaderSize:]
+	buf, err := smb2.Buffer(msg, int(le.Uint16(resp[4:])), int(le.Uint16(resp[6:])))
+	return hdr, msg, buf, err
+}
+
+// treeConnect connects to the share (MS-SMB2 2.2.9, 2.2.10).
+func (c *conn) treeConnect(s *Share) error {
+	path := smb2.EncodeString(`\\` + s.host() + `\` + s.Name)
+	body := make([]byte, 8+len(path))
+	le.PutUint16(body[0:], 9)
+	le.PutUint16(body[4:], smb2.HeaderSize+8)
+	le.PutUint16(body[6:], uint16(len(path)))
+	copy(body[8:], path)
+	hdr, msg, err := c.call(smb2.CmdTreeConnect, body, 0)
+	if err != nil {
+		return err
+	}
+	if hdr.Status != smb2.StatusSuccess {
+		return &os.PathError{Op: "tree connect", Path: s.Name, Err: statusError(hdr.Status)}
+	}
+	if len(msg) < smb2.HeaderSize+16 {
+		return smb2.ErrShortMessage
+	}
+	c.treeID = hdr.TreeID
+	if shareType := msg[smb2.HeaderSize+2]; shareType != shareTypeDisk {
+		c.treeDisconnect()
+		return fmt.Errorf("SMB share %s is not a disk share", s.Name)
+	}
+	return nil
+}
+
+const shareTypeDisk = 0x01
+
+func (c *conn) treeDisconnect() {
+	body := make([]byte, 4)
+	le.PutUint16(body, 4)
+	_, _, _ = c.call(smb2.CmdTreeDisconnect, body, 0)
+}
+
+func (c *conn) logoff() {
+	body := make([]byte, 4)
+	le.PutUint16(body, 4)
+	_, _, _ = c.call(smb2.CmdLogoff, body, 0)
+}
+
+// statusError converts NTSTATUS of a failed request to an error.
+func statusError(status uint32) error {
+	switch status {
+	case smb2.StatusNoSuchFile, smb2.StatusObjectNameNotFound,
+		smb2.StatusObjectPathNotFound, smb2.StatusBadNetworkName:
+		return syscall.ENOENT
+	case smb2.StatusObjectNameCollision:
+		return syscall.EEXIST
+	case smb2.StatusAccessDenied:
+		return syscall.EACCES
+	case smb2.StatusFileIsADirectory:
+		return syscall.EISDIR
+	case smb2.StatusNotADirectory:
+		return syscall.ENOTDIR
+	case smb2.StatusDirectoryNotEmpty:
+		return syscall.ENOTEMPTY
+	case smb2.StatusObjectNameInvalid, smb2.StatusInvalidParameter:
+		return syscall.EINVAL
+	case smb2.StatusSharingViolation:
+		return syscall.EBUSY
+	case smb2.StatusDiskFull:
+		return syscall.ENOSPC
+	case smb2.StatusNotSupported:
+		return syscall.EOPNOTSUPP
+	case smb2.StatusLogonFailure:
+		return smb2.ErrLogonFailure
+	}
+	return fmt.Errorf("SMB status 0x%08x", status)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/internal/smb2"
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/smbtest"
+)
+
+func newServer(t *testing.T) *smbtest.Server {
+	dir, err := ioutil.TempDir("", "smb_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	srv, err := smbtest.NewServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.User, srv.Password = "user", "secret"
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newShare(srv *smbtest.Server) *Share {
+	return &Share{Server: srv.Addr().IP, Port: srv.Addr().Port,
+		Name: smbtest.Share, User: srv.User, Password: srv.Password}
+}
+
+func TestReadWrite(t *testing.T) {
+	for _, dialect := range smb2.Dialects {
+		dialect := dialect
+		t.Run(fmt.Sprintf("%x", dialect), func(t *testing.T) {
+			srv := newServer(t)
+			srv.MaxDialect = dialect
+			testReadWrite(t, srv, newShare(srv))
+		})
+	}
+	t.Run("guest", func(t *testing.T) {
+		srv := newServer(t)
+		srv.User, srv.Password = "", ""
+		testReadWrite(t, srv, newShare(srv))
+	})
+}
+
+func testReadWrite(t *testing.T, srv *smbtest.Server, share *Share) {
+	fs, err := share.Mount(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+
+	// Larger than a single READ or WRITE of the server.
+	content := make([]byte, 600*1024+7)
+	for i := range content {
+		content[i] = byte(i % 253)
+	}
+	if err = fs.MkdirAll("a/b"); err != nil {
+		t.Fatal(err)
+	}
+	w, err := fs.Create("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(srv.Dir, "a/b/file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("written content differs")
+	}
+
+	info, err := fs.Stat("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name() != "file" || info.Size() != int64(len(content)) || info.IsDir() {
+		t.Errorf("unexpected file info %s %d %v", info.Name(), info.Size(), info.Mode())
+	}
+	r, err := fs.Open("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err = ioutil.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("read content differs")
+	}
+	if _, err = r.Seek(-10, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = ioutil.ReadAll(r); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content[len(content)-10:]) {
+		t.Errorf("read after seek differs")
+	}
+
+	if err = fs.Remove("a/b/file"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fs.Stat("a/b/file"); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got %v", err)
+	}
+	if _, err = fs.Open("a/b"); err == nil {
+		t.Errorf("directory opened as a file")
+	}
+	if _, err = fs.Open("../file"); err == nil {
+		t.Errorf("file outside of the share opened")
+	}
+	if srv.OpenFiles() != 0 {
+		t.Errorf("%d files left open", srv.OpenFiles())
+	}
+}
+
+func TestReadDir(t *testing.T) {
+	srv := newServer(t)
+	// More entries than returned by a single QUERY_DIRECTORY.
+	var names []string
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("file%d", i)
+		names = append(names, name)
+		if err := ioutil.WriteFile(filepath.Join(srv.Dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(srv.Dir, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	names = append(names, "dir")
+	sort.Strings(names)
+
+	fs, err := newShare(srv).Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+	entries, err := fs.ReadDir("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+		if entry.IsDir() != (entry.Name() == "dir") {
+			t.Errorf("unexpected mode %v of %s", entry.Mode(), entry.Name())
+		}
+	}
+	sort.Strings(got)
+	if fmt.Sprint(got) != fmt.Sprint(names) {
+		t.Errorf("expected entries %v, got %v", names, got)
+	}
+	if _, err = fs.ReadDir("missing"); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got %v", err)
+	}
+	if srv.OpenFiles() != 0 {
+		t.Errorf("%d files left open", srv.OpenFiles())
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	srv := newServer(t)
+	for _, tc := range []struct {
+		name     string
+		user     string
+		password string
+		domain   string
+		ok       bool
+	}{
+		{name: "valid", user: "user", password: "secret", ok: true},
+		{name: "valid with domain", user: "user", password: "secret", domain: "WORKGROUP", ok: true},
+		{name: "bad password", user: "user", password: "wrong"},
+		{name: "unknown user", user: "nobody", password: "secret"},
+		{name: "guest"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			share := newShare(srv)
+			share.User, share.Password, share.Domain = tc.user, tc.password, tc.domain
+			fs, err := share.Mount(true)
+			if !tc.ok {
+				if err == nil {
+					fs.Unmount()
+					t.Fatal("mount succeeded")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			fs.Unmount()
+		})
+	}
+
+	share := newShare(srv)
+	share.Name = "missing"
+	if _, err := share.Mount(true); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error for unknown share, got %v", err)
+	}
+}
+
+func TestSourceAddress(t *testing.T) {
+	srv := newServer(t)
+	srcIP := net.ParseIP("127.0.0.2")
+	if l, err := net.Listen("tcp", "127.0.0.2:0"); err != nil {
+		t.Skipf("127.0.0.2 is not available: %v", err)
+	} else {
+		l.Close()
+	}
+	share := newShare(srv)
+	share.SrcIP = srcIP
+	fs, err := share.Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+	if _, err = fs.ReadDir(""); err != nil {
+		t.Fatal(err)
+	}
+	clients := srv.Clients()
+	if len(clients) == 0 {
+		t.Fatal("no connections accepted")
+	}
+	for _, client := range clients {
+		if addr := client.(*net.TCPAddr); !addr.IP.Equal(srcIP) {
+			t.Errorf("expected connection from %s, got %s", srcIP, addr)
+		}
+	}
+}
+
+func TestConnectionLost(t *testing.T) {
+	srv := newServer(t)
+	fs, err := newShare(srv).Mount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Unmount()
+	srv.CloseClientConns()
+	if _, err = fs.Stat(""); err == nil {
+		t.Errorf("request succeeded after the connection was closed")
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package smbtest
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/internal/smb2"
+)
+
+// openFile is a file or a directory opened by CREATE. The persistent
+// and the volatile parts of its file ID are the same.
+type openFile struct {
+	conn *connState
+	sess *session
+	// name is the slash-separated path relative to Dir.
+	name          string
+	localPath     string
+	file          *os.File // nil for directories
+	access        uint32
+	deleteOnClose bool
+	// entries of a directory listed by QUERY_DIRECTORY, next is
+	// the index of the next entry to return.
+	entries []os.FileInfo
+	next    int
+}
+
+func (o *openFile) isDir() bool {
+	return o.file == nil
+}
+
+// lookupFile returns the open file of the request with the file ID
+// at the offset of the body.
+func (c *connState) lookupFile(req *request, offset int) (*openFile, uint32) {
+	if len(req.body) < offset+16 {
+		return nil, smb2.StatusInvalidParameter
+	}
+	id := le.Uint64(req.body[offset:])
+	c.srv.mu.Lock()
+	o := c.srv.opens[id]
+	c.srv.mu.Unlock()
+	if o == nil || o.conn != c || o.sess != req.sess ||
+		le.Uint64(req.body[offset+8:]) != id {
+		return nil, smb2.StatusFileClosed
+	}
+	return o, smb2.StatusSuccess
+}
+
+// closeFile closes the file and deletes it when requested.
+func (c *connState) closeFile(id uint64, o *openFile) {
+	c.srv.mu.Lock()
+	delete(c.srv.opens, id)
+	c.srv.mu.Unlock()
+	if o.file != nil {
+		o.file.Close()
+	}
+	if o.deleteOnClose {
+		os.Remove(o.localPath)
+	}
+}
+
+// closeSessionFiles closes files opened in the session.
+func (c *connState) closeSessionFiles(sess *session) {
+	c.srv.mu.Lock()
+	opens := make(map[uint64]*openFile)
+	for id, o := range c.srv.opens {
+		if o.conn == c && (sess == nil || o.sess == sess) {
+			opens[id] = o
+		}
+	}
+	c.srv.mu.Unlock()
+	for id, o := range opens {
+		c.closeFile(id, o)
+	}
+}
+
+// closeFiles closes all files opened by the connection.
+func (c *connState) closeFiles() {
+	c.closeSessionFiles(nil)
+}
+
+// statusOf converts an error of the local file system to NTSTATUS.
+func statusOf(err error) uint32 {
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		return statusUnexpectedIOError
+	}
+	switch errno {
+	case syscall.ENOENT:
+		return smb2.StatusObjectNameNotFound
+	case syscall.EEXIST:
+		return smb2.StatusObjectNameCollision
+	case syscall.EACCES, syscall.EPERM:
+		return smb2.StatusAccessDenied
+	case syscall.EISDIR:
+		return smb2.StatusFileIsADirectory
+	case syscall.ENOTDIR:
+		return smb2.StatusNotADirectory
+	case syscall.ENOTEMPTY:
+		return smb2.StatusDirectoryNotEmpty
+	case syscall.ENOSPC:
+		return smb2.StatusDiskFull
+	}
+	return statusUnexpectedIOError
+}
+
+// fileName converts the path in a CREATE request to a slash-separated
+// path relative to Dir, which is empty for the root of the share.
+func fileName(buf []byte) (string, bool) {
+	name := smb2.DecodeString(buf)
+	if name == "" {
+		return "", true
+	}
+	elems := strings.Split(name, `\`)
+	for _, elem := range elems {
+		if elem == "" || elem == "." || elem == ".." || strings.Contains(elem, "/") {
+			return "", false
+		}
+	}
+	return path.Join(elems...), true
+}
+
+// create opens or creates a file or a directory (MS-SMB2 3.3.5.9).
+func (c *connState) create(req *request) (uint32, []byte) {
+	if len(req.body) < 56 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	access := le.Uint32(req.body[24:])
+	disposition := le.Uint32(req.body[36:])
+	options := le.Uint32(req.body[40:])
+	buf, err := smb2.Buffer(req.msg, int(le.Uint16(req.body[44:])), int(le.Uint16(req.body[46:])))
+	if err != nil {
+		return smb2.StatusInvalidParameter, nil
+	}
+	name, ok := fileName(buf)
+	if !ok {
+		return smb2.StatusObjectNameInvalid, nil
+	}
+	if options&smb2.OptionDeleteOnClose != 0 && access&smb2.AccessDelete == 0 {
+		return smb2.StatusAccessDenied, nil
+	}
+	localPath := filepath.Join(c.srv.Dir, filepath.FromSlash(name))
+	fi, err := os.Stat(localPath)
+	switch {
+	case err == nil:
+		switch {
+		case disposition == smb2.DispositionCreate:
+			return smb2.StatusObjectNameCollision, nil
+		case fi.IsDir() && options&smb2.OptionNonDirectoryFile != 0:
+			return smb2.StatusFileIsADirectory, nil
+		case !fi.IsDir() && options&smb2.OptionDirectoryFile != 0:
+			return smb2.StatusNotADirectory, nil
+		}
+	case os.IsNotExist(err):
+		if _, err := os.Stat(filepath.Dir(localPath)); err != nil {
+			return smb2.StatusObjectPathNotFound, nil
+		}
+		switch disposition {
+		case smb2.DispositionCreate, smb2.DispositionOpenIf, smb2.DispositionOverwriteIf:
+		default:
+			return smb2.StatusObjectNameNotFound, nil
+		}
+		if options&smb2.OptionDirectoryFile != 0 {
+			err = os.Mkdir(localPath, 0755)
+		} else {
+			var f *os.File
+			if f, err = os.Create(localPath); err == nil {
+				f.Close()
+			}
+		}
+		if err != nil {
+			return statusOf(err), nil
+		}
+		if fi, err = os.Stat(localPath); err != nil {
+			return statusOf(err), nil
+		}
+	default:
+		return statusOf(err), nil
+	}
+	o := &openFile{
+		conn:          c,
+		sess:          req.sess,
+		name:          name,
+		localPath:     localPath,
+		access:        access,
+		deleteOnClose: options&smb2.OptionDeleteOnClose != 0,
+	}
+	if !fi.IsDir() {
+		flags := os.O_RDONLY
+		if access&smb2.AccessWriteData != 0 {
+			flags = os.O_RDWR
+		}
+		if disposition == smb2.DispositionOverwriteIf {
+			flags |= os.O_TRUNC
+		}
+		if o.file, err = os.OpenFile(localPath, flags, 0); err != nil {
+			return statusOf(err), nil
+		}
+		if fi, err = o.file.Stat(); err != nil {
+			o.file.Close()
+			return statusOf(err), nil
+		}
+	}
+	id := c.srv.newID()
+	c.srv.mu.Lock()
+	c.srv.opens[id] = o
+	c.srv.mu.Unlock()
+	body := make([]byte, 88)
+	le.PutUint16(body[0:], 89)
+	le.PutUint32(body[4:], 1) // CreateAction FILE_OPENED
+	mtime := smb2.Filetime(fi.ModTime())
+	for i := 8; i < 40; i += 8 { // creation, access, write and change time
+		le.PutUint64(body[i:], mtime)
+	}
+	if !fi.IsDir() {
+		le.PutUint64(body[40:], uint64(fi.Size())) // AllocationSize
+		le.PutUint64(body[48:], uint64(fi.Size())) // EndofFile
+	}
+	le.PutUint32(body[56:], fileAttributes(fi))
+	le.PutUint64(body[64:], id)
+	le.PutUint64(body[72:], id)
+	return smb2.StatusSuccess, body
+}
+
+func fileAttributes(fi os.FileInfo) uint32 {
+	var attributes uint32 = smb2.AttrNormal
+	if fi.IsDir() {
+		attributes = smb2.AttrDirectory
+	}
+	if fi.Mode()&0200 == 0 {
+		attributes |= smb2.AttrReadonly
+	}
+	return attributes
+}
+
+// close closes the file (MS-SMB2 3.3.5.10).
+func (c *connState) close(req *request) (uint32, []byte) {
+	o, status := c.lookupFile(req, 8)
+	if o == nil {
+		return status, nil
+	}
+	c.closeFile(le.Uint64(req.body[8:]), o)
+	body := make([]byte, 60)
+	le.PutUint16(body[0:], 60)
+	return smb2.StatusSuccess, body
+}
+
+// read reads from the file (MS-SMB2 3.3.5.12).
+func (c *connState) read(req *request) (uint32, []byte) {
+	if len(req.body) < 48 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	o, status := c.lookupFile(req, 16)
+	if o == nil {
+		return status, nil
+	}
+	length := int(le.Uint32(req.body[4:]))
+	offset := int64(le.Uint64(req.body[8:]))
+	if !c.checkPayload(req, length) {
+		return smb2.StatusInvalidParameter, nil
+	}
+	if o.isDir() {
+		return smb2.StatusInvalidParameter, nil
+	}
+	if o.access&smb2.AccessReadData == 0 {
+		return smb2.StatusAccessDenied, nil
+	}
+	if c.srv.ReadHook != nil {
+		if err := c.srv.ReadHook(o.name, offset); err != nil {
+			return statusUnexpectedIOError, nil
+		}
+	}
+	data := make([]byte, length)
+	n, err := o.file.ReadAt(data, offset)
+	if err != nil && err != io.EOF {
+		return statusOf(err), nil
+	}
+	if n == 0 && length > 0 {
+		return smb2.StatusEndOfFile, nil
+	}
+	body := make([]byte, 16+n)
+	le.PutUint16(body[0:], 17)
+	body[2] = smb2.HeaderSize + 16 // DataOffset
+	le.PutUint32(body[4:], uint32(n))
+	copy(body[16:], data[:n])
+	return smb2.StatusSuccess, body
+}
+
+// write writes to the file (MS-SMB2 3.3.5.13).
+func (c *connState) write(req *request) (uint32, []byte) {
+	if len(req.body) < 48 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	o, status := c.lookupFile(req, 16)
+	if o == nil {
+		return status, nil
+	}
+	data, err := smb2.Buffer(req.msg, int(le.Uint16(req.body[2:])), int(le.Uint32(req.body[4:])))
+	if err != nil || !c.checkPayload(req, len(data)) || o.isDir() {
+		return smb2.StatusInvalidParameter, nil
+	}
+	if o.access&smb2.AccessWriteData == 0 {
+		return smb2.StatusAccessDenied, nil
+	}
+	n, err := o.file.WriteAt(data, int64(le.Uint64(req.body[8:])))
+	if err != nil {
+		return statusOf(err), nil
+	}
+	body := make([]byte, 16)
+	le.PutUint16(body[0:], 17)
+	le.PutUint32(body[4:], uint32(n))
+	return smb2.StatusSuccess, body
+}
+
+// flush flushes the file, it is processed asynchronously as servers
+// do with slow storage (MS-SMB2 3.3.5.11, 3.3.4.2).
+func (c *connState) flush(req *request, resp *smb2.Header) (uint32, []byte) {
+	o, status := c.lookupFile(req, 8)
+	if o == nil {
+		return status, nil
+	}
+	if o.isDir() || o.access&smb2.AccessWriteData == 0 {
+		return smb2.StatusAccessDenied, nil
+	}
+	resp.Flags |= smb2.FlagAsync
+	resp.AsyncID = c.srv.newID()
+	if err := o.file.Sync(); err != nil {
+		return statusOf(err), nil
+	}
+	return smb2.StatusSuccess, emptyBody()
+}
+
+// queryDirectory returns the next entries of the directory as
+// FILE_DIRECTORY_INFORMATION, only the "*" pattern is supported
+// (MS-SMB2 3.3.5.18).
+func (c *connState) queryDirectory(req *request) (uint32, []byte) {
+	if len(req.body) < 32 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	o, status := c.lookupFile(req, 8)
+	if o == nil {
+		return status, nil
+	}
+	if req.body[2] != smb2.FileDirectoryInformation {
+		return statusInvalidInfoClass, nil
+	}
+	pattern, err := smb2.Buffer(req.msg, int(le.Uint16(req.body[24:])), int(le.Uint16(req.body[26:])))
+	if err != nil || smb2.DecodeString(pattern) != "*" || !o.isDir() {
+		return smb2.StatusInvalidParameter, nil
+	}
+	outputLength := int(le.Uint32(req.body[28:]))
+	if outputLength > req.charge*creditSize && c.dialect != smb2.Dialect202 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	if o.entries == nil || req.body[3]&smb2.RestartScans != 0 {
+		dir, err := os.Stat(o.localPath)
+		if err != nil {
+			return statusOf(err), nil
+		}
+		entries, err := ioutil.ReadDir(o.localPath)
+		if err != nil {
+			return statusOf(err), nil
+		}
+		o.entries = append([]os.FileInfo{namedInfo{dir, "."}, namedInfo{dir, ".."}}, entries...)
+		o.next = 0
+	}
+	if o.next >= len(o.entries) {
+		return smb2.StatusNoMoreFiles, nil
+	}
+	var buf []byte
+	var last int
+	for i := 0; i < maxDirEntries && o.next < len(o.entries); i++ {
+		entry := dirEntry(o.entries[o.next])
+		offset := (len(buf) + 7) &^ 7
+		if offset+len(entry) > outputLength {
+			break
+		}
+		if i > 0 {
+			le.PutUint32(buf[last:], uint32(offset-last)) // NextEntryOffset
+		}
+		buf = append(buf, make([]byte, offset-len(buf))...)
+		buf = append(buf, entry...)
+		last = offset
+		o.next++
+	}
+	if len(buf) == 0 {
+		return statusBufferTooSmall, nil
+	}
+	body := make([]byte, 8+len(buf))
+	le.PutUint16(body[0:], 9)
+	le.PutUint16(body[2:], smb2.HeaderSize+8)
+	le.PutUint32(body[4:], uint32(len(buf)))
+	copy(body[8:], buf)
+	return smb2.StatusSuccess, body
+}
+
+// namedInfo renames FileInfo of a directory to "." or "..".
+type namedInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (fi namedInfo) Name() string {
+	return fi.name
+}
+
+// dirEntry encodes FILE_DIRECTORY_INFORMATION (MS-FSCC 2.4.10).
+func dirEntry(fi os.FileInfo) []byte {
+	name := smb2.EncodeString(fi.Name())
+	entry := make([]byte, 64+len(name))
+	mtime := smb2.Filetime(fi.ModTime())
+	for i := 8; i < 40; i += 8 { // creation, access, write and change time
+		le.PutUint64(entry[i:], mtime)
+	}
+	if !fi.IsDir() {
+		le.PutUint64(entry[40:], uint64(fi.Size())) // EndOfFile
+		le.PutUint64(entry[48:], uint64(fi.Size())) // AllocationSize
+	}
+	le.PutUint32(entry[56:], fileAttributes(fi))
+	le.PutUint32(entry[60:], uint32(len(name)))
+	copy(entry[64:], name)
+	return entry
+}
+
+// queryInfo returns FileStandardInformation of the file
+// (MS-SMB2 3.3.5.20.1, MS-FSCC 2.4.41).
+func (c *connState) queryInfo(req *request) (uint32, []byte) {
+	if len(req.body) < 40 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	o, status := c.lookupFile(req, 24)
+	if o == nil {
+		return status, nil
+	}
+	if req.body[2] != smb2.InfoTypeFile || req.body[3] != smb2.FileStandardInformation {
+		return statusInvalidInfoClass, nil
+	}
+	fi, err := os.Stat(o.localPath)
+	if err != nil {
+		return statusOf(err), nil
+	}
+	info := make([]byte, 24)
+	if !fi.IsDir() {
+		le.PutUint64(info[0:], uint64(fi.Size())) // AllocationSize
+		le.PutUint64(info[8:], uint64(fi.Size())) // EndOfFile
+	}
+	le.PutUint32(info[16:], 1) // NumberOfLinks
+	if o.deleteOnClose {
+		info[20] = 1
+	}
+	if fi.IsDir() {
+		info[21] = 1
+	}
+	if int(le.Uint32(req.body[4:])) < len(info) {
+		return smb2.StatusInvalidParameter, nil
+	}
+	body := make([]byte, 8+len(info))
+	le.PutUint16(body[0:], 9)
+	le.PutUint16(body[2:], smb2.HeaderSize+8)
+	le.PutUint32(body[4:], uint32(len(info)))
+	copy(body[8:], info)
+	return smb2.StatusSuccess, body
+}
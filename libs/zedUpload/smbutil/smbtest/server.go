@@ -0,0 +1,522 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package smbtest provides an in-process SMB server for tests,
+// similar to net/http/httptest. It serves a local directory as a share
+// over SMB 2.0.2 up to 3.0.2, authenticates users with NTLMv2 and
+// requires signed requests in authenticated sessions.
+package smbtest
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/smbutil/internal/smb2"
+)
+
+const (
+	// Share is the name of the share.
+	Share = "share"
+	// serverName is the NetBIOS name of the server.
+	serverName = "SMBTEST"
+	// maxTransfer is the maximum size of READ and WRITE, charged
+	// several credits.
+	maxTransfer = 256 * 1024
+	maxRequest  = maxTransfer + 4096
+	creditSize  = 64 * 1024
+	// maxCredits is the number of credits a client can hold.
+	maxCredits = 16
+	// maxDirEntries is the number of entries returned by a single
+	// QUERY_DIRECTORY, small to exercise paging of clients.
+	maxDirEntries = 4
+
+	statusNetworkNameDeleted = 0xc00000c9
+	statusUnexpectedIOError  = 0xc00000e9
+	statusInvalidInfoClass   = 0xc0000003
+	statusBufferTooSmall     = 0xc0000023
+)
+
+var le = binary.LittleEndian
+
+// Server is an SMB server sharing a local directory as Share.
+type Server struct {
+	// Dir is the shared directory.
+	Dir string
+	// User and Password are the credentials of the only user. Anonymous
+	// access is allowed when User is empty.
+	User     string
+	Password string
+	// MaxDialect, when set, limits the negotiated dialect, e.g. 0x0210
+	// for SMB 2.1.
+	MaxDialect uint16
+	// ReadHook, when set, is called before each READ with the path
+	// of the file relative to Dir. A returned error fails the READ with
+	// STATUS_UNEXPECTED_IO_ERROR.
+	ReadHook func(name string, offset int64) error
+
+	listener net.Listener
+	mu       sync.Mutex
+	conns    map[net.Conn]struct{}
+	clients  []net.Addr
+	sessions int
+	lastID   uint64
+	opens    map[uint64]*openFile
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server sharing the directory, listening on
+// a random port of 127.0.0.1.
+func NewServer(dir string) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Dir:      dir,
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+		opens:    make(map[uint64]*openFile),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Addr returns the address of the server.
+func (s *Server) Addr() *net.TCPAddr {
+	return s.listener.Addr().(*net.TCPAddr)
+}
+
+// Sessions returns the number of established sessions.
+func (s *Server) Sessions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions
+}
+
+// Clients returns addresses of all accepted connections.
+func (s *Server) Clients() []net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]net.Addr{}, s.clients...)
+}
+
+// OpenFiles returns the number of files and directories open.
+func (s *Server) OpenFiles() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.opens)
+}
+
+// CloseClientConns closes all connections, as a server restart would.
+func (s *Server) CloseClientConns() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.CloseClientConns()
+	s.wg.Wait()
+}
+
+func (s *Server) newID() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	return s.lastID
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.clients = append(s.clients, conn.RemoteAddr())
+		s.mu.Unlock()
+		s.wg.Add(1)
+		c := &connState{
+			srv:      s,
+			conn:     conn,
+			credits:  1,
+			sessions: make(map[uint64]*session),
+		}
+		go c.serve()
+	}
+}
+
+// connState is the state of a client connection.
+type connState struct {
+	srv     *Server
+	conn    net.Conn
+	dialect uint16
+	// credits granted and not used, nextID is the lowest message ID
+	// which can be used.
+	credits  int
+	nextID   uint64
+	sessions map[uint64]*session
+}
+
+type session struct {
+	ntlm *smb2.NTLMServer
+	// signer is set for authenticated users, nil for anonymous sessions.
+	signer *smb2.Signer
+	valid  bool
+	trees  map[uint32]bool
+}
+
+// request is a received request. charge is the number of credits
+// the request consumed.
+type request struct {
+	hdr    smb2.Header
+	msg    []byte
+	body   []byte
+	charge int
+	sess   *session
+}
+
+func (c *connState) serve() {
+	defer c.srv.wg.Done()
+	defer func() {
+		c.closeFiles()
+		c.srv.mu.Lock()
+		delete(c.srv.conns, c.conn)
+		c.srv.mu.Unlock()
+		c.conn.Close()
+	}()
+	for {
+		msg, err := smb2.ReadMessage(c.conn, maxRequest)
+		if err != nil {
+			return
+		}
+		hdr, err := smb2.DecodeHeader(msg)
+		if err != nil || hdr.Flags&smb2.FlagResponse != 0 || hdr.NextCommand != 0 {
+			// Compounded requests are not supported.
+			return
+		}
+		req := &request{hdr: hdr, msg: msg, body: msg[smb2.HeaderSize:]}
+		if !c.charge(req) {
+			// Servers disconnect clients which exceed their credits.
+			return
+		}
+		resp := smb2.Header{
+			Command:   hdr.Command,
+			Flags:     smb2.FlagResponse,
+			MessageID: hdr.MessageID,
+			TreeID:    hdr.TreeID,
+			SessionID: hdr.SessionID,
+		}
+		status, body := c.handle(req, &resp)
+		if len(body) == 0 {
+			body = errorBody()
+		}
+		resp.Status = status
+		resp.Credits = c.grant(hdr.Credits)
+		if resp.Flags&smb2.FlagAsync != 0 {
+			// The interim response grants the credits, it is not signed.
+			interim := resp
+			interim.Status = smb2.StatusPending
+			if err = smb2.WriteMessage(c.conn, smb2.NewMessage(&interim, len(errorBody()))); err != nil {
+				return
+			}
+			resp.Credits = 0
+		}
+		msg = smb2.NewMessage(&resp, len(body))
+		copy(msg[smb2.HeaderSize:], body)
+		if signer := c.signer(req, &resp); signer != nil {
+			signer.Sign(msg)
+		}
+		if err = smb2.WriteMessage(c.conn, msg); err != nil {
+			return
+		}
+	}
+}
+
+// signer returns the signer of the response, responses are signed
+// in authenticated sessions once the request was verified. The final
+// SESSION_SETUP response is signed with the new session key.
+func (c *connState) signer(req *request, resp *smb2.Header) *smb2.Signer {
+	if req.sess != nil {
+		return req.sess.signer
+	}
+	if req.hdr.Command == smb2.CmdSessionSetup && resp.Status == smb2.StatusSuccess {
+		if sess := c.sessions[resp.SessionID]; sess != nil {
+			return sess.signer
+		}
+	}
+	return nil
+}
+
+// charge takes the credits of the request (MS-SMB2 3.3.5.2.3, 3.3.5.2.5).
+func (c *connState) charge(req *request) bool {
+	charge := int(req.hdr.CreditCharge)
+	if charge == 0 || c.dialect == smb2.Dialect202 {
+		charge = 1
+	}
+	if req.hdr.MessageID < c.nextID || charge > c.credits {
+		return false
+	}
+	c.credits -= charge
+	c.nextID = req.hdr.MessageID + uint64(charge)
+	req.charge = charge
+	return true
+}
+
+// grant returns the number of credits granted by a response.
+func (c *connState) grant(requested uint16) uint16 {
+	n := int(requested)
+	if n == 0 {
+		n = 1
+	}
+	if n > maxCredits-c.credits {
+		n = maxCredits - c.credits
+	}
+	c.credits += n
+	return uint16(n)
+}
+
+// checkPayload checks that the size of READ or WRITE data is covered
+// by the credit charge.
+func (c *connState) checkPayload(req *request, size int) bool {
+	if size > maxTransfer {
+		return false
+	}
+	return c.dialect == smb2.Dialect202 || size <= req.charge*creditSize
+}
+
+// errorBody returns the body of the ERROR response (MS-SMB2 2.2.2).
+func errorBody() []byte {
+	body := make([]byte, 9)
+	le.PutUint16(body, 9)
+	return body
+}
+
+func (c *connState) handle(req *request, resp *smb2.Header) (uint32, []byte) {
+	switch req.hdr.Command {
+	case smb2.CmdNegotiate:
+		return c.negotiate(req)
+	case smb2.CmdSessionSetup:
+		return c.sessionSetup(req, resp)
+	}
+	sess := c.sessions[req.hdr.SessionID]
+	if sess == nil || !sess.valid {
+		return smb2.StatusUserSessionDeleted, nil
+	}
+	if sess.signer != nil && !sess.signer.Verify(req.msg) {
+		return smb2.StatusAccessDenied, nil
+	}
+	req.sess = sess
+	switch req.hdr.Command {
+	case smb2.CmdLogoff:
+		c.closeSessionFiles(sess)
+		delete(c.sessions, req.hdr.SessionID)
+		return smb2.StatusSuccess, emptyBody()
+	case smb2.CmdTreeConnect:
+		return c.treeConnect(req, resp)
+	}
+	if !sess.trees[req.hdr.TreeID] {
+		return statusNetworkNameDeleted, nil
+	}
+	switch req.hdr.Command {
+	case smb2.CmdTreeDisconnect:
+		delete(sess.trees, req.hdr.TreeID)
+		return smb2.StatusSuccess, emptyBody()
+	case smb2.CmdCreate:
+		return c.create(req)
+	case smb2.CmdClose:
+		return c.close(req)
+	case smb2.CmdRead:
+		return c.read(req)
+	case smb2.CmdWrite:
+		return c.write(req)
+	case smb2.CmdFlush:
+		return c.flush(req, resp)
+	case smb2.CmdQueryDirectory:
+		return c.queryDirectory(req)
+	case smb2.CmdQueryInfo:
+		return c.queryInfo(req)
+	}
+	return smb2.StatusNotSupported, nil
+}
+
+// emptyBody returns the body of LOGOFF, TREE_DISCONNECT
+// and FLUSH responses.
+func emptyBody() []byte {
+	body := make([]byte, 4)
+	le.PutUint16(body, 4)
+	return body
+}
+
+// negotiate selects the newest dialect offered by the client
+// (MS-SMB2 3.3.5.4).
+func (c *connState) negotiate(req *request) (uint32, []byte) {
+	if c.dialect != 0 || len(req.body) < 36 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	count := int(le.Uint16(req.body[2:]))
+	if len(req.body) < 36+2*count {
+		return smb2.StatusInvalidParameter, nil
+	}
+	for i := 0; i < count; i++ {
+		dialect := le.Uint16(req.body[36+2*i:])
+		if c.srv.MaxDialect != 0 && dialect > c.srv.MaxDialect {
+			continue
+		}
+		for _, supported := range smb2.Dialects {
+			if dialect == supported && dialect > c.dialect {
+				c.dialect = dialect
+			}
+		}
+	}
+	if c.dialect == 0 {
+		return smb2.StatusNotSupported, nil
+	}
+	maxSize := maxTransfer
+	var capabilities uint32
+	if c.dialect == smb2.Dialect202 {
+		maxSize = creditSize
+	} else {
+		capabilities = smb2.CapLargeMTU
+	}
+	body := make([]byte, 64)
+	le.PutUint16(body[0:], 65)
+	le.PutUint16(body[2:], smb2.SigningEnabled)
+	le.PutUint16(body[4:], c.dialect)
+	if _, err := rand.Read(body[8:24]); err != nil { // ServerGuid
+		return smb2.StatusInvalidParameter, nil
+	}
+	le.PutUint32(body[24:], capabilities)
+	le.PutUint32(body[28:], uint32(maxSize)) // MaxTransactSize
+	le.PutUint32(body[32:], uint32(maxSize)) // MaxReadSize
+	le.PutUint32(body[36:], uint32(maxSize)) // MaxWriteSize
+	le.PutUint64(body[40:], smb2.Filetime(time.Now()))
+	le.PutUint16(body[56:], smb2.HeaderSize+64)
+	return smb2.StatusSuccess, body
+}
+
+// sessionSetup authenticates the user with NTLM in SPNEGO, a new
+// session is created by the first request (MS-SMB2 3.3.5.5).
+func (c *connState) sessionSetup(req *request, resp *smb2.Header) (uint32, []byte) {
+	if c.dialect == 0 || len(req.body) < 24 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	token, err := smb2.Buffer(req.msg, int(le.Uint16(req.body[12:])), int(le.Uint16(req.body[14:])))
+	if err != nil {
+		return smb2.StatusInvalidParameter, nil
+	}
+	if req.hdr.SessionID == 0 {
+		negotiate, err := smb2.DecodeNegTokenInit(token)
+		if err != nil {
+			return smb2.StatusInvalidParameter, nil
+		}
+		sess := &session{
+			ntlm: &smb2.NTLMServer{
+				TargetName: serverName,
+				Password: func(user string) (string, bool) {
+					return c.srv.Password, c.srv.User != "" && strings.EqualFold(user, c.srv.User)
+				},
+			},
+			trees: make(map[uint32]bool),
+		}
+		challenge, err := sess.ntlm.Challenge(negotiate)
+		if err != nil {
+			return smb2.StatusInvalidParameter, nil
+		}
+		out, err := smb2.EncodeNegTokenResp(smb2.NegTokenResp{
+			NegState:      smb2.NegStateAcceptIncomplete,
+			SupportedMech: smb2.NTLMMech(),
+			ResponseToken: challenge,
+		})
+		if err != nil {
+			return smb2.StatusInvalidParameter, nil
+		}
+		resp.SessionID = c.srv.newID()
+		c.sessions[resp.SessionID] = sess
+		return smb2.StatusMoreProcessingRequired, sessionSetupBody(0, out)
+	}
+	sess := c.sessions[req.hdr.SessionID]
+	if sess == nil || sess.ntlm == nil || sess.valid {
+		return smb2.StatusUserSessionDeleted, nil
+	}
+	// A failed authentication ends the session.
+	delete(c.sessions, req.hdr.SessionID)
+	negResp, err := smb2.DecodeNegTokenResp(token)
+	if err != nil {
+		return smb2.StatusInvalidParameter, nil
+	}
+	user, err := sess.ntlm.Authenticate(negResp.ResponseToken)
+	if err != nil {
+		return smb2.StatusLogonFailure, nil
+	}
+	var flags uint16
+	if user == "" {
+		if c.srv.User != "" {
+			return smb2.StatusLogonFailure, nil
+		}
+		flags = smb2.SessionFlagNull
+	} else {
+		if negResp.MechListMIC != nil &&
+			!sess.ntlm.CheckMIC(negResp.MechListMIC, smb2.NTLMMechTypes()) {
+			return smb2.StatusLogonFailure, nil
+		}
+		if sess.signer, err = smb2.NewSigner(c.dialect, sess.ntlm.SessionKey()); err != nil {
+			return smb2.StatusLogonFailure, nil
+		}
+	}
+	out, err := smb2.EncodeNegTokenResp(smb2.NegTokenResp{NegState: smb2.NegStateAcceptCompleted})
+	if err != nil {
+		return smb2.StatusInvalidParameter, nil
+	}
+	sess.valid = true
+	c.sessions[req.hdr.SessionID] = sess
+	c.srv.mu.Lock()
+	c.srv.sessions++
+	c.srv.mu.Unlock()
+	return smb2.StatusSuccess, sessionSetupBody(flags, out)
+}
+
+func sessionSetupBody(flags uint16, token []byte) []byte {
+	body := make([]byte, 8+len(token))
+	le.PutUint16(body[0:], 9)
+	le.PutUint16(body[2:], flags)
+	le.PutUint16(body[4:], smb2.HeaderSize+8)
+	le.PutUint16(body[6:], uint16(len(token)))
+	copy(body[8:], token)
+	return body
+}
+
+// treeConnect connects to Share, the server name in the path
+// is not checked (MS-SMB2 3.3.5.7).
+func (c *connState) treeConnect(req *request, resp *smb2.Header) (uint32, []byte) {
+	if len(req.body) < 8 {
+		return smb2.StatusInvalidParameter, nil
+	}
+	buf, err := smb2.Buffer(req.msg, int(le.Uint16(req.body[4:])), int(le.Uint16(req.body[6:])))
+	if err != nil {
+		return smb2.StatusInvalidParameter, nil
+	}
+	path := smb2.DecodeString(buf)
+	if !strings.HasPrefix(path, `\\`) ||
+		!strings.EqualFold(path[strings.LastIndex(path, `\`)+1:], Share) {
+		return smb2.StatusBadNetworkName, nil
+	}
+	resp.TreeID = uint32(c.srv.newID())
+	req.sess.trees[resp.TreeID] = true
+	body := make([]byte, 16)
+	le.PutUint16(body[0:], 16)
+	body[2] = 0x01                    // ShareType disk
+	le.PutUint32(body[12:], 0x1f01ff) // MaximalAccess
+	return smb2.StatusSuccess, body
+}
@@ -0,0 +1,20 @@
+package types
+
+import "io"
+
+// Chunk is a part of a blob which may be found in other blobs by its Key,
+// e.g. a compressed file of an estargz layer
+type Chunk struct {
+	Key    string
+	Offset int64
+	Size   int64
+}
+
+// ChunkSource provides chunks of blobs which are available locally,
+// so that they need not be downloaded again
+type ChunkSource interface {
+	// HasChunk returns true if a chunk with the key and size is available
+	HasChunk(key string, size int64) bool
+	// ReadChunk opens the chunk with the key and size
+	ReadChunk(key string, size int64) (io.ReadCloser, error)
+}
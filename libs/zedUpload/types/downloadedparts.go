@@ -17,8 +17,15 @@ type PartDefinition struct {
 
 // DownloadedParts keeps information about downloaded parts of blob
 type DownloadedParts struct {
-	PartSize int64             // the maximum partition size
+	PartSize int64             // the maximum partition size, 0 for sequential download
 	Parts    []*PartDefinition // definition of downloaded parts
+	// ETag and LastModified identify the version of the remote object
+	// the parts were downloaded from
+	ETag         string `json:",omitempty"`
+	LastModified string `json:",omitempty"`
+	// HashState is the marshaled sha256 state over the content downloaded
+	// so far, only for sequential download
+	HashState []byte `json:",omitempty"`
 }
 
 // Hash returns hash of DownloadedParts struct
@@ -43,3 +50,12 @@ func (dp *DownloadedParts) SetPartSize(ind, size int64) {
 	}
 	dp.Parts = append(dp.Parts, &PartDefinition{Ind: ind, Size: size})
 }
+
+// Size returns the total size of the downloaded parts
+func (dp *DownloadedParts) Size() int64 {
+	var size int64
+	for _, p := range dp.Parts {
+		size += p.Size
+	}
+	return size
+}
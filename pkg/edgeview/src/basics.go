@@ -40,6 +40,7 @@ var (
 	pubsubopts    []string
 	pubsubpersist []string
 	pubsublarge   []string
+	pubsubhistory []string
 	sysopts       []string
 	logdirectory  []string
 	basics        basicItems
@@ -111,6 +112,10 @@ func initOpts() {
 		"zedagent",
 		"zedmanager"}
 
+	pubsubhistory = []string{
+		"nim",
+		"zedmanager"}
+
 	sysopts = []string{
 		"app",
 		"configitem",
@@ -123,6 +128,7 @@ func initOpts() {
 		"hw",
 		"lastreboot",
 		"ls",
+		"measurement",
 		"model",
 		"newlog",
 		"pci",
@@ -515,6 +521,18 @@ func getFileTimeStr(t1 time.Time) string {
 	return fmt.Sprintf("%d%02d%02d%02d%02d%02d", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
 }
 
+// persistentPubDir returns the directory with JSON files of a persistent
+// publication (given as "agent/topic"). Publications migrated by pillar
+// into the key-value store are no longer kept under /persist/status,
+// but their checkpoint is still written under /run.
+func persistentPubDir(name string) string {
+	dir := "/persist/status/" + name
+	if _, err := os.Stat(dir); err == nil {
+		return dir
+	}
+	return "/run/" + name
+}
+
 func listJSONFiles(path string) ([]string, error) {
 	files, err := ioutil.ReadDir(path)
 	if err != nil {
@@ -674,6 +692,8 @@ func printHelp(opt string) {
 			helpOn("hw", "display the hardware from lshw information in json format")
 		case "lastreboot":
 			helpOn("lastreboot", "display the last reboot reasons and stack if the information is saved")
+		case "measurement":
+			helpOn("measurement", "display measured boot components from TPM event log, its replay vs. quoted PCRs and changes since the last successful attestation")
 		case "ls":
 			helpOn("ls/<path to filenames>", "to display the file/directory information")
 			helpExample("ls//config/device.cert.pem", "display the /config/device.cert.pem file info", true)
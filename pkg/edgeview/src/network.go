@@ -511,7 +511,7 @@ func getConnectivity() {
 		}
 	}
 
-	retbytes, err := ioutil.ReadFile("/persist/status/nim/DevicePortConfigList/global.json")
+	retbytes, err := ioutil.ReadFile(persistentPubDir("nim/DevicePortConfigList") + "/global.json")
 	if err != nil {
 		return
 	}
@@ -23,7 +23,7 @@ func runPubsub(pubStr string) {
 		return
 	}
 
-	startdir := []string{"/run/", "/persist/status/", "/persist/pubsub-large/"}
+	startdir := []string{"/run/", "/run/pubsub-history/", "/persist/status/", "/persist/pubsub-large/"}
 	for _, p := range opts {
 		printTitle("\n === Pub/Sub: <"+p+"> ===\n\n", colorPURPLE, false)
 
@@ -38,7 +38,13 @@ func runPubsub(pubStr string) {
 		}
 
 		for _, sdir := range startdir {
-			if sdir == "/persist/status/" {
+			if sdir == "/run/pubsub-history/" {
+				// recent changes recorded by publications with history enabled
+				opts1, _ := checkOpts(pubStr, pubsubhistory)
+				if len(opts1) == 0 {
+					continue
+				}
+			} else if sdir == "/persist/status/" {
 				opts1, _ := checkOpts(pubStr, pubsubpersist)
 				if len(opts1) == 0 {
 					break
@@ -73,6 +73,8 @@ func runSystem(cmds cmdOpt, sysOpt string) {
 			getTop(cmds.Extraline)
 		} else if strings.HasPrefix(opt, "lastreboot") {
 			getLastReboot()
+		} else if opt == "measurement" {
+			getMeasurement()
 		} else if strings.HasPrefix(opt, "techsupport") {
 			runTechSupport(cmds, false)
 		} else if strings.HasPrefix(opt, "dmesg") {
@@ -86,6 +88,85 @@ func runSystem(cmds cmdOpt, sysOpt string) {
 	}
 }
 
+// measurementReport - the subset of MeasurementReport
+// from pillar attest package displayed by 'measurement'
+type measurementReport struct {
+	EventLogError string `json:"event_log_error"`
+	PCRReplay     []struct {
+		Index    int    `json:"index"`
+		Replayed string `json:"replayed"`
+		Quoted   string `json:"quoted"`
+		Match    bool   `json:"match"`
+	} `json:"pcr_replay"`
+	Measurements []struct {
+		PCRIndex  int    `json:"pcr_index"`
+		Component string `json:"component"`
+		Name      string `json:"name"`
+		Digest    string `json:"digest"`
+	} `json:"measurements"`
+	HasBaseline bool `json:"has_baseline"`
+	Changes     []struct {
+		Type           string `json:"type"`
+		Component      string `json:"component"`
+		Name           string `json:"name"`
+		PCRIndex       int    `json:"pcr_index"`
+		PreviousDigest string `json:"previous_digest"`
+		Digest         string `json:"digest"`
+		PreviousDetail string `json:"previous_detail"`
+		Detail         string `json:"detail"`
+	} `json:"changes"`
+}
+
+// getMeasurement - in 'runSystem'
+func getMeasurement() {
+	retData, err := ioutil.ReadFile("/run/eve.attest-measurements.json")
+	if err != nil {
+		fmt.Printf("measurement report not available: %v\n", err)
+		return
+	}
+	var report measurementReport
+	if err := json.Unmarshal(retData, &report); err != nil {
+		fmt.Printf("measurement report unmarshal error: %v\n", err)
+		return
+	}
+	if report.EventLogError != "" {
+		printColor(" - TPM event log error: "+report.EventLogError, colorRED)
+		return
+	}
+
+	printColor(" - event log replay vs. quoted PCRs:", colorCYAN)
+	for _, pcr := range report.PCRReplay {
+		if pcr.Match {
+			fmt.Printf("  PCR %2d: match %s\n", pcr.Index, pcr.Quoted)
+			continue
+		}
+		printColor(fmt.Sprintf("  PCR %2d: mismatch, replayed %s, quoted %s",
+			pcr.Index, pcr.Replayed, pcr.Quoted), colorRED)
+	}
+
+	printColor("\n - changes since the last successful attestation:", colorCYAN)
+	if !report.HasBaseline {
+		fmt.Printf("  no successful attestation recorded yet\n")
+	} else if len(report.Changes) == 0 {
+		fmt.Printf("  none\n")
+	}
+	for _, c := range report.Changes {
+		printColor(fmt.Sprintf("  %-8s %-12s PCR %2d %s", c.Type, c.Component, c.PCRIndex, c.Name),
+			colorYELLOW)
+		if c.PreviousDigest != "" {
+			fmt.Printf("    previous: %s %s\n", c.PreviousDigest, c.PreviousDetail)
+		}
+		if c.Digest != "" {
+			fmt.Printf("    current:  %s %s\n", c.Digest, c.Detail)
+		}
+	}
+
+	printColor("\n - measurements:", colorCYAN)
+	for _, m := range report.Measurements {
+		fmt.Printf("  PCR %2d %-12s %s %s\n", m.PCRIndex, m.Component, m.Digest, m.Name)
+	}
+}
+
 // getLogStats - in 'runSystem'
 func getLogStats() {
 	retData1, err := ioutil.ReadFile("/run/newlogd/NewlogMetrics/global.json")
@@ -572,7 +653,7 @@ func getCipher() {
 		}
 	}
 
-	jfiles, err = listJSONFiles("/persist/status/tpmmgr/EdgeNodeCert")
+	jfiles, err = listJSONFiles(persistentPubDir("tpmmgr/EdgeNodeCert"))
 	if err == nil {
 		printColor("\n - TPMmgr Edgenode Certs:", colorCYAN)
 		for _, l := range jfiles {
@@ -589,7 +670,7 @@ func getCipher() {
 		}
 	}
 
-	jfiles, err = listJSONFiles("/persist/status/zedagent/CipherContext")
+	jfiles, err = listJSONFiles(persistentPubDir("zedagent/CipherContext"))
 	if err == nil {
 		printColor("\n - Cipher Context:", colorCYAN)
 		for _, l := range jfiles {
@@ -606,7 +687,7 @@ func getCipher() {
 		}
 	}
 
-	jfiles, err = listJSONFiles("/persist/status/zedagent/ControllerCert")
+	jfiles, err = listJSONFiles(persistentPubDir("zedagent/ControllerCert"))
 	if err == nil {
 		printColor("\n - Controller Certs:", colorCYAN)
 		for _, l := range jfiles {
@@ -1051,7 +1132,7 @@ func gzipTechSuppFile(ifileName string) (string, error) {
 
 func getDevInfo() types.EdgeNodeInfo {
 	var devInfo types.EdgeNodeInfo
-	jfiles, err := listJSONFiles("/persist/status/zedagent/EdgeNodeInfo")
+	jfiles, err := listJSONFiles(persistentPubDir("zedagent/EdgeNodeInfo"))
 	if err == nil {
 		for _, l := range jfiles {
 			retbytes1, err := ioutil.ReadFile(l)
@@ -67,6 +67,8 @@ func getDangerousList() []string {
 		"/config/DevicePortConfig/override.json",
 		"/config/GlobalConfig/global.json",
 		"/config/Force-API-V1",
+		"/config/attest-policy-signer.cert.pem",
+		"/config/app-import-trusted.cert.pem",
 	}
 }
 
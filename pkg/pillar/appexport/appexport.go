@@ -0,0 +1,364 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package appexport writes and reads the app archives, which move an app
+// instance with the content of its volumes to another device.
+//
+// An app archive is a directory holding the compressed content of every
+// volume of the app instance, and a manifest with the configuration of the
+// app instance and the SHA-256 of the content of the volumes. The manifest
+// is signed with the key of the device which exported the app instance,
+// whose certificate is stored next to it. The certificate must chain to
+// one of the trusted certificates, see LoadTrustedCerts.
+package appexport
+
+import (
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// ManifestFilename is the name of the manifest in the archive
+	ManifestFilename = "manifest.json"
+	// SignatureFilename is the name of the signature of the manifest
+	SignatureFilename = "manifest.sig"
+	// CertFilename is the name of the PEM certificate of the device
+	// which signed the manifest
+	CertFilename = "device.cert.pem"
+	// VolumesDirname is the directory of the content of the volumes
+	VolumesDirname = "volumes"
+	// ContainerFormat is the format of the changes of a container volume
+	// to the image it was created from
+	ContainerFormat = "container"
+
+	manifestVersion = 1
+)
+
+// Volume is a volume of the app instance in the manifest
+type Volume struct {
+	VolumeID          uuid.UUID
+	GenerationCounter int64
+	DisplayName       string
+	types.VolumeExport
+}
+
+// Manifest describes the app instance in the archive
+type Manifest struct {
+	Version    int
+	CreateTime time.Time
+	Config     types.AppInstanceConfig
+	Volumes    []Volume
+}
+
+// volumeFile returns the file of the content of the volume, relative to
+// the archive directory
+func volumeFile(volumeID uuid.UUID, format string) string {
+	return filepath.Join(VolumesDirname,
+		fmt.Sprintf("%s.%s.gz", volumeID.String(), format))
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// ExportVolume writes the content of the volume, which export writes in
+// the format, compressed into the archive in dir
+func ExportVolume(dir string, volumeID uuid.UUID, format string,
+	export func(w io.Writer) error) (types.VolumeExport, error) {
+
+	ve := types.VolumeExport{
+		File:   volumeFile(volumeID, format),
+		Format: format,
+	}
+	path := filepath.Join(dir, ve.File)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return ve, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return ve, err
+	}
+	hash := sha256.New()
+	cw := &countingWriter{w: io.MultiWriter(f, hash)}
+	zw := gzip.NewWriter(cw)
+	err = export(zw)
+	if err == nil {
+		err = zw.Close()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return ve, err
+	}
+	ve.Size = cw.n
+	ve.Sha256 = hex.EncodeToString(hash.Sum(nil))
+	return ve, nil
+}
+
+// signatureAlgorithm returns the algorithm of the signatures made with
+// the key of the certificate
+func signatureAlgorithm(cert *x509.Certificate) (x509.SignatureAlgorithm, error) {
+	switch cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		return x509.ECDSAWithSHA256, nil
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA, nil
+	default:
+		return x509.UnknownSignatureAlgorithm,
+			fmt.Errorf("unsupported public key %T", cert.PublicKey)
+	}
+}
+
+// WriteManifest signs the manifest with the signer, whose certificate is
+// certDER, and writes them into the archive in dir
+func WriteManifest(dir string, manifest Manifest, signer crypto.Signer,
+	certDER []byte) error {
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return fmt.Errorf("cannot parse the device certificate: %v", err)
+	}
+	if _, err := signatureAlgorithm(cert); err != nil {
+		return err
+	}
+	manifest.Version = manifestVersion
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(data)
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("cannot sign the manifest: %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	if err := fileutils.WriteRename(filepath.Join(dir, CertFilename), certPEM); err != nil {
+		return err
+	}
+	if err := fileutils.WriteRename(filepath.Join(dir, SignatureFilename), sig); err != nil {
+		return err
+	}
+	// the manifest is written last, as it completes the archive
+	return fileutils.WriteRename(filepath.Join(dir, ManifestFilename), data)
+}
+
+// LoadTrustedCerts returns the pool of certificates in the PEM files,
+// which are the devices allowed to export app archives for import, or CAs
+// which issued their certificates. Files which do not exist are skipped.
+func LoadTrustedCerts(files ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		certPEM, err := ioutil.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(certPEM) {
+			return nil, fmt.Errorf("no PEM certificate in %s", file)
+		}
+	}
+	return pool, nil
+}
+
+// verifyCert checks that the certificate is one of the trusted ones,
+// or that it was issued by one of them. Device certificates are self-signed
+// and do not have key usages of a CA or of a code signer.
+func verifyCert(cert *x509.Certificate, trusted *x509.CertPool) error {
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     trusted,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("untrusted device certificate %s: %v",
+			cert.Subject.CommonName, err)
+	}
+	return nil
+}
+
+// ReadManifest reads the manifest of the archive in dir and verifies its
+// signature, and that the certificate of the device which exported the app
+// instance chains to one of the trusted certificates. Returns the manifest
+// and the certificate of the device.
+func ReadManifest(dir string, trusted *x509.CertPool) (*Manifest, *x509.Certificate, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, ManifestFilename))
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := ioutil.ReadFile(filepath.Join(dir, SignatureFilename))
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM, err := ioutil.ReadFile(filepath.Join(dir, CertFilename))
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM certificate in %s", CertFilename)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse %s: %v", CertFilename, err)
+	}
+	if err := verifyCert(cert, trusted); err != nil {
+		return nil, nil, err
+	}
+	algo, err := signatureAlgorithm(cert)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cert.CheckSignature(algo, data, sig); err != nil {
+		return nil, nil, fmt.Errorf("invalid signature of the manifest: %v", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("cannot parse the manifest: %v", err)
+	}
+	if manifest.Version != manifestVersion {
+		return nil, nil, fmt.Errorf("unsupported manifest version %d",
+			manifest.Version)
+	}
+	return &manifest, cert, nil
+}
+
+// FindVolume looks for the content of the volume in the archives in
+// importDir. Archives which can not be verified against the trusted
+// certificates are skipped. Returns the directory of the archive and
+// the volume, or nil if no archive has the volume.
+func FindVolume(log *base.LogObject, importDir string, trusted *x509.CertPool,
+	volumeID uuid.UUID) (string, *Volume) {
+
+	entries, err := ioutil.ReadDir(importDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("FindVolume: %v", err)
+		}
+		return "", nil
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(importDir, entry.Name())
+		manifest, cert, err := ReadManifest(dir, trusted)
+		if err != nil {
+			log.Errorf("FindVolume: skipping app archive %s: %v", dir, err)
+			continue
+		}
+		for i := range manifest.Volumes {
+			if uuid.Equal(manifest.Volumes[i].VolumeID, volumeID) {
+				log.Noticef("FindVolume: volume %s in app archive %s of %s exported by %s at %v",
+					volumeID, dir, manifest.Config.DisplayName,
+					cert.Subject.CommonName, manifest.CreateTime)
+				return dir, &manifest.Volumes[i]
+			}
+		}
+	}
+	return "", nil
+}
+
+// readCloser closes both the reader and the file it reads from
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (rc readCloser) Close() error {
+	var err error
+	for _, c := range rc.closers {
+		if e := c.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// OpenVolume verifies the content of the volume in the archive in dir
+// against its SHA-256 in the manifest, and returns a reader of the
+// uncompressed content
+func OpenVolume(dir string, volume Volume) (io.ReadCloser, error) {
+	if volume.File != volumeFile(volume.VolumeID, volume.Format) {
+		return nil, fmt.Errorf("unexpected file %s for volume %s",
+			volume.File, volume.VolumeID)
+	}
+	f, err := os.Open(filepath.Join(dir, volume.File))
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != volume.Sha256 {
+		f.Close()
+		return nil, fmt.Errorf("SHA-256 of %s is %s instead of %s",
+			volume.File, sum, volume.Sha256)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return readCloser{Reader: zr, closers: []io.Closer{zr, f}}, nil
+}
+
+// ReleaseVolume removes the content of the volume from the archive in dir
+// once it is imported, and the archive once all its volumes are
+func ReleaseVolume(dir string, volume Volume) error {
+	if volume.File != volumeFile(volume.VolumeID, volume.Format) {
+		return fmt.Errorf("unexpected file %s for volume %s",
+			volume.File, volume.VolumeID)
+	}
+	err := os.Remove(filepath.Join(dir, volume.File))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	remaining, err := ioutil.ReadDir(filepath.Join(dir, VolumesDirname))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(remaining) == 0 {
+		return os.RemoveAll(dir)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package appexport
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDeviceKey returns the key and the certificate of a device, self-signed
+// like the certificates of EVE devices are
+func newDeviceKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	mustNoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "device"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template,
+		&key.PublicKey, key)
+	mustNoError(t, err)
+	return key, certDER
+}
+
+// trustedPool writes the certificates into a PEM file and loads it
+func trustedPool(t *testing.T, certsDER ...[]byte) *x509.CertPool {
+	file, err := ioutil.TempFile("", "trusted")
+	mustNoError(t, err)
+	t.Cleanup(func() { os.Remove(file.Name()) })
+	for _, certDER := range certsDER {
+		mustNoError(t, pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	}
+	mustNoError(t, file.Close())
+	pool, err := LoadTrustedCerts(file.Name(), "/nonexistent/trusted.cert.pem")
+	mustNoError(t, err)
+	return pool
+}
+
+// exportApp exports an app instance with one volume of the content into
+// a new archive, which is returned with the volume in the manifest and
+// the certificate of the exporting device
+func exportApp(t *testing.T, content []byte) (string, Volume, []byte) {
+	dir, err := ioutil.TempDir("", "appexport")
+	mustNoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	archive := filepath.Join(dir, "app")
+
+	volumeID := uuid.FromStringOrNil("0e3b6b0f-2e4c-4e26-9a0f-7c3e1a1d2f6b")
+	ve, err := ExportVolume(archive, volumeID, "qcow2", func(w io.Writer) error {
+		_, err := w.Write(content)
+		return err
+	})
+	mustNoError(t, err)
+	volume := Volume{VolumeID: volumeID, DisplayName: "disk", VolumeExport: ve}
+	key, certDER := newDeviceKey(t)
+	manifest := Manifest{
+		CreateTime: time.Now(),
+		Config:     types.AppInstanceConfig{DisplayName: "app"},
+		Volumes:    []Volume{volume},
+	}
+	mustNoError(t, WriteManifest(archive, manifest, key, certDER))
+	return archive, volume, certDER
+}
+
+func TestExportImport(t *testing.T) {
+	log := base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+	content := bytes.Repeat([]byte("volume content "), 1000)
+	archive, volume, certDER := exportApp(t, content)
+	trusted := trustedPool(t, certDER)
+
+	manifest, cert, err := ReadManifest(archive, trusted)
+	mustNoError(t, err)
+	assert.Equal(t, "app", manifest.Config.DisplayName)
+	assert.Equal(t, "device", cert.Subject.CommonName)
+	assert.Equal(t, []Volume{volume}, manifest.Volumes)
+
+	dir, found := FindVolume(log, filepath.Dir(archive), trusted, volume.VolumeID)
+	if found == nil {
+		t.Fatal("volume not found")
+	}
+	assert.Equal(t, archive, dir)
+	_, notFound := FindVolume(log, filepath.Dir(archive), trusted,
+		uuid.FromStringOrNil("5b1b0b1e-8d3a-4a55-b2a4-0f3e9e6f1c2d"))
+	assert.Nil(t, notFound)
+
+	r, err := OpenVolume(dir, *found)
+	mustNoError(t, err)
+	imported, err := ioutil.ReadAll(r)
+	mustNoError(t, err)
+	mustNoError(t, r.Close())
+	assert.Equal(t, content, imported)
+
+	mustNoError(t, ReleaseVolume(dir, *found))
+	_, err = os.Stat(archive)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTamperedArchive(t *testing.T) {
+	log := base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+
+	// a modified manifest
+	archive, volume, certDER := exportApp(t, []byte("content"))
+	trusted := trustedPool(t, certDER)
+	manifestFile := filepath.Join(archive, ManifestFilename)
+	data, err := ioutil.ReadFile(manifestFile)
+	mustNoError(t, err)
+	data = bytes.Replace(data, []byte(`"app"`), []byte(`"evil"`), 1)
+	mustNoError(t, ioutil.WriteFile(manifestFile, data, 0600))
+	_, _, err = ReadManifest(archive, trusted)
+	assert.Error(t, err)
+	_, found := FindVolume(log, filepath.Dir(archive), trusted, volume.VolumeID)
+	assert.Nil(t, found)
+
+	// a modified content of a volume
+	archive, volume, certDER = exportApp(t, []byte("content"))
+	_, _, err = ReadManifest(archive, trustedPool(t, certDER))
+	mustNoError(t, err)
+	mustNoError(t, ioutil.WriteFile(filepath.Join(archive, volume.File),
+		[]byte("other content"), 0600))
+	_, err = OpenVolume(archive, volume)
+	assert.Error(t, err)
+
+	// a file outside of the archive
+	volume.File = "../../etc/passwd"
+	_, err = OpenVolume(archive, volume)
+	assert.Error(t, err)
+	assert.Error(t, ReleaseVolume(archive, volume))
+}
+
+func TestUntrustedArchive(t *testing.T) {
+	log := base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+	archive, volume, certDER := exportApp(t, []byte("content"))
+
+	// signed correctly, but by another device
+	_, otherCertDER := newDeviceKey(t)
+	untrusted := trustedPool(t, otherCertDER)
+	_, _, err := ReadManifest(archive, untrusted)
+	assert.Error(t, err)
+	_, found := FindVolume(log, filepath.Dir(archive), untrusted, volume.VolumeID)
+	assert.Nil(t, found)
+	_, _, err = ReadManifest(archive, x509.NewCertPool())
+	assert.Error(t, err)
+
+	// re-signed by a device with a forged certificate of the trusted one
+	key, _ := newDeviceKey(t)
+	cert, err := x509.ParseCertificate(certDER)
+	mustNoError(t, err)
+	forged := *cert
+	forged.PublicKey = &key.PublicKey
+	forgedDER, err := x509.CreateCertificate(rand.Reader, &forged, &forged,
+		&key.PublicKey, key)
+	mustNoError(t, err)
+	manifest, _, err := ReadManifest(archive, trustedPool(t, certDER))
+	mustNoError(t, err)
+	mustNoError(t, WriteManifest(archive, *manifest, key, forgedDER))
+	_, _, err = ReadManifest(archive, trustedPool(t, certDER))
+	assert.Error(t, err)
+
+	// device certificate issued by a trusted CA
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	mustNoError(t, err)
+	caTemplate := x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "fleet CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, &caTemplate, &caTemplate,
+		&caKey.PublicKey, caKey)
+	mustNoError(t, err)
+	issuedDER, err := x509.CreateCertificate(rand.Reader, cert, &caTemplate,
+		&key.PublicKey, caKey)
+	mustNoError(t, err)
+	mustNoError(t, WriteManifest(archive, *manifest, key, issuedDER))
+	_, _, err = ReadManifest(archive, trustedPool(t, caDER))
+	mustNoError(t, err)
+}
+
+func mustNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -134,6 +134,7 @@ var (
 	ErrTpmAgentUnavailable = errors.New("TPM agent is unavailable")
 	ErrNoEscrowData        = errors.New("No Escrow Data available")
 	ErrNoVerifier          = errors.New("No verifier support in Controller")
+	ErrNoLocalPolicy       = errors.New("No valid local attestation policy")
 )
 
 // Watchdog needs to be implemented by the consumer of this package
@@ -292,7 +293,7 @@ func handleInitializeAtNone(ctx *Context) error {
 	}
 	ctx.log.Errorf("Error %v while sending nonce request", err)
 	switch err {
-	case ErrControllerReqFailed:
+	case ErrControllerReqFailed, ErrNoLocalPolicy:
 		return startNewRetryTimer(ctx)
 	case ErrNoVerifier:
 		//Verifier support is missing in Controller
@@ -343,7 +344,7 @@ func handleInternalQuoteRecvdAtInternalQuoteWait(ctx *Context) error {
 		return triggerSelfEvent(ctx, EventQuoteMismatch)
 	case ErrNoCertYet:
 		return triggerSelfEvent(ctx, EventNoQuoteCertRecvd)
-	case ErrControllerReqFailed:
+	case ErrControllerReqFailed, ErrNoLocalPolicy:
 		return startNewRetryTimer(ctx)
 	case ErrNoVerifier:
 		//Verifier support is missing in Controller
@@ -24,6 +24,7 @@ var (
 	simulateTpmAgentDown         = false
 	simulateNoVerifier           = false
 	simulateNoEscrowData         = false
+	simulateNoLocalPolicy        = false
 )
 
 func (server *VerifierMock) SendNonceRequest(ctx *Context) error {
@@ -34,6 +35,9 @@ func (server *VerifierMock) SendNonceRequest(ctx *Context) error {
 	case simulateNoVerifier:
 		fmt.Printf("Simulating no verifier support in Controller\n")
 		return ErrNoVerifier
+	case simulateNoLocalPolicy:
+		fmt.Printf("Simulating missing local attestation policy\n")
+		return ErrNoLocalPolicy
 	}
 	return nil
 }
@@ -96,6 +100,7 @@ func initTest() *Context {
 	simulateTpmAgentDown = false
 	simulateNoVerifier = false
 	simulateNoEscrowData = false
+	simulateNoLocalPolicy = false
 
 	logger := logrus.StandardLogger()
 	log := base.NewSourceLogObject(logger, "test", 1234)
@@ -376,6 +381,30 @@ func TestNoVerifierInNonceWait(t *testing.T) {
 	}
 }
 
+func TestNoLocalPolicyInNonceWait(t *testing.T) {
+	fmt.Println("--------TestNoLocalPolicyInNonceWait----")
+	ctx := initTest()
+	simulateNoLocalPolicy = true
+
+	go func() {
+		ctx.eventTrigger <- EventInitialize
+	}()
+	for {
+		select {
+		case ctx.event = <-ctx.eventTrigger:
+			if err := despatchEvent(ctx.event, ctx.state, ctx); err != nil {
+				t.Errorf("%v", err)
+			}
+		case <-ctx.restartTimer.C:
+			// Retrying until the policy is delivered.
+			if ctx.state != StateNonceWait {
+				t.Errorf("Expected %s, Got %s", StateNonceWait.String(), ctx.state.String())
+			}
+			return
+		}
+	}
+}
+
 func TestInternalEscrowRcvdAtAnyOther(t *testing.T) {
 	fmt.Println("--------TestInternalEscrowRcvdAtAnyOther----")
 	ctx := initTest()
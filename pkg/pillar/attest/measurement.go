@@ -0,0 +1,371 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package attest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/cshari-zededa/eve-tpm2-tools/eventlog"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// When attestation fails after an update, the measurements below tell
+// which boot component has changed compared to the last boot which was
+// attested successfully.
+
+// Components of the measured boot, see Measurement
+const (
+	ComponentFirmware    = "firmware"
+	ComponentSecureBoot  = "secureboot"
+	ComponentBootloader  = "bootloader"
+	ComponentGrubCommand = "grub-command"
+	ComponentCmdline     = "cmdline"
+	ComponentHypervisor  = "hypervisor"
+	ComponentKernel      = "kernel"
+	ComponentInitrd      = "initrd"
+	ComponentRootfs      = "rootfs"
+	ComponentOther       = "other"
+)
+
+const (
+	// maxDetailLen limits the measured text kept with a Measurement
+	maxDetailLen = 256
+	// GRUB measures files into PCR 9 and the root filesystem into PCR 13,
+	// see pkg/grub
+	grubBinaryPCR = 9
+	grubRootfsPCR = 13
+	// Prefixes of descriptions of EV_IPL events logged by GRUB
+	grubCmdPrefix     = "grub_cmd "
+	grubCmdlinePrefix = "grub_kernel_cmdline "
+)
+
+// HexBytes is a byte slice encoded as a hex string in JSON
+type HexBytes []byte
+
+// MarshalText implements encoding.TextMarshaler
+func (b HexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	value, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = value
+	return nil
+}
+
+// Measurement is a boot component measured into a PCR, as recorded
+// in the TPM event log.
+type Measurement struct {
+	PCRIndex  int    `json:"pcr_index"`
+	EventType uint32 `json:"event_type"`
+	Component string `json:"component"`
+	// Name identifies the measurement within the component,
+	// e.g. the name of an EFI variable or of a file loaded by GRUB.
+	Name string `json:"name"`
+	// Digest is the SHA256 digest extended into the PCR.
+	Digest HexBytes `json:"digest"`
+	// Detail is the (truncated) measured text, e.g. the kernel command line.
+	Detail string `json:"detail,omitempty"`
+}
+
+// MeasurementChangeType tells how a measurement has changed.
+// The values are the same as of MeasurementChangeType in api/proto/attest.
+type MeasurementChangeType int
+
+// Types of measurement changes
+const (
+	MeasurementAdded MeasurementChangeType = iota + 1
+	MeasurementRemoved
+	MeasurementModified
+)
+
+// String returns the change type as used in JSON
+func (t MeasurementChangeType) String() string {
+	switch t {
+	case MeasurementAdded:
+		return "added"
+	case MeasurementRemoved:
+		return "removed"
+	case MeasurementModified:
+		return "modified"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t MeasurementChangeType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *MeasurementChangeType) UnmarshalText(text []byte) error {
+	for _, changeType := range []MeasurementChangeType{
+		MeasurementAdded, MeasurementRemoved, MeasurementModified} {
+		if changeType.String() == string(text) {
+			*t = changeType
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown measurement change type %q", text)
+}
+
+// MeasurementChange is a difference between the measurements of the current
+// boot and of the last successfully attested boot.
+type MeasurementChange struct {
+	Type           MeasurementChangeType `json:"type"`
+	Component      string                `json:"component"`
+	Name           string                `json:"name"`
+	PCRIndex       int                   `json:"pcr_index"`
+	PreviousDigest HexBytes              `json:"previous_digest,omitempty"`
+	Digest         HexBytes              `json:"digest,omitempty"`
+	PreviousDetail string                `json:"previous_detail,omitempty"`
+	Detail         string                `json:"detail,omitempty"`
+}
+
+// PCRReplay compares the value of a PCR obtained by replaying the event log
+// with its value reported by TPM along with the quote.
+type PCRReplay struct {
+	Index    int      `json:"index"`
+	Replayed HexBytes `json:"replayed"`
+	Quoted   HexBytes `json:"quoted"`
+	Match    bool     `json:"match"`
+}
+
+// MeasurementReport describes the measured boot of the device.
+type MeasurementReport struct {
+	// EventLogError is set when the event log could not be parsed.
+	EventLogError string `json:"event_log_error,omitempty"`
+	// PCRReplay is updated with every quote.
+	PCRReplay    []PCRReplay   `json:"pcr_replay,omitempty"`
+	Measurements []Measurement `json:"measurements,omitempty"`
+	// HasBaseline is false until there is a boot attested successfully.
+	HasBaseline bool `json:"has_baseline"`
+	// Changes since the last successfully attested boot.
+	Changes []MeasurementChange `json:"changes,omitempty"`
+}
+
+func truncateDetail(detail string) string {
+	if len(detail) > maxDetailLen {
+		return detail[:maxDetailLen] + "..."
+	}
+	return detail
+}
+
+// eventString returns the text of the event data, GRUB logs
+// NUL-terminated descriptions.
+func eventString(event eventlog.Event) string {
+	return string(bytes.TrimRight(event.Data, "\x00"))
+}
+
+// efiVariableName returns the name of the variable from UEFI_VARIABLE_DATA,
+// i.e. GUID, name length, data length and UTF-16 name.
+func efiVariableName(data []byte) string {
+	const headerLen = 16 + 8 + 8
+	if len(data) < headerLen {
+		return ""
+	}
+	nameLen := binary.LittleEndian.Uint64(data[16:24])
+	if nameLen > uint64(len(data)-headerLen)/2 {
+		return ""
+	}
+	name := make([]uint16, nameLen)
+	for i := range name {
+		name[i] = binary.LittleEndian.Uint16(data[headerLen+2*i:])
+	}
+	return string(utf16.Decode(name))
+}
+
+// grubFileComponent classifies files loaded and measured by GRUB,
+// e.g. "grub_linux Kernel" or "grub_multiboot /boot/xen.gz".
+func grubFileComponent(description string) (string, string) {
+	lower := strings.ToLower(description)
+	switch {
+	case strings.HasSuffix(lower, " kernel"):
+		return ComponentKernel, description
+	case strings.HasSuffix(lower, " initrd"):
+		return ComponentInitrd, description
+	case strings.Contains(lower, "xen"):
+		return ComponentHypervisor, description
+	case strings.Contains(lower, "initrd"), strings.Contains(lower, "initramfs"):
+		return ComponentInitrd, description
+	case strings.Contains(lower, "kernel"), strings.Contains(lower, "vmlinuz"):
+		return ComponentKernel, description
+	default:
+		return ComponentOther, description
+	}
+}
+
+// classifyEvent returns the component, name and detail of the measurement
+// recorded by the event, or an empty component for events which do not
+// measure any boot component.
+func classifyEvent(event eventlog.Event) (component, name, detail string) {
+	switch event.Typ {
+	case eventlog.NoAction, eventlog.Separator:
+		return "", "", ""
+	case eventlog.EFIBootServicesApplication:
+		return ComponentBootloader, "EFI application", ""
+	case eventlog.EFIVariableDriverConfig, eventlog.EFIVariableAuthority:
+		return ComponentSecureBoot, efiVariableName(event.Data), ""
+	case eventlog.EFIVariableBoot:
+		return ComponentFirmware, efiVariableName(event.Data), ""
+	case eventlog.Ipl:
+		text := eventString(event)
+		switch {
+		case strings.HasPrefix(text, grubCmdlinePrefix):
+			cmdline := strings.TrimPrefix(text, grubCmdlinePrefix)
+			return ComponentCmdline, "kernel command line", truncateDetail(cmdline)
+		case strings.HasPrefix(text, grubCmdPrefix):
+			cmd := truncateDetail(strings.TrimPrefix(text, grubCmdPrefix))
+			return ComponentGrubCommand, cmd, cmd
+		case event.Index == grubBinaryPCR:
+			component, name := grubFileComponent(text)
+			return component, name, ""
+		case event.Index == grubRootfsPCR:
+			return ComponentRootfs, "rootfs", truncateDetail(text)
+		}
+		return ComponentOther, truncateDetail(text), ""
+	}
+	name = fmt.Sprintf("event type 0x%x", uint32(event.Typ))
+	if event.Index <= 7 {
+		return ComponentFirmware, name, ""
+	}
+	return ComponentOther, name, ""
+}
+
+// Measurements lists the boot components measured according to the event log.
+func Measurements(events []eventlog.Event) []Measurement {
+	var measurements []Measurement
+	for _, event := range events {
+		component, name, detail := classifyEvent(event)
+		if component == "" {
+			continue
+		}
+		measurements = append(measurements, Measurement{
+			PCRIndex:  event.Index,
+			EventType: uint32(event.Typ),
+			Component: component,
+			Name:      name,
+			Digest:    event.Sha256Digest(),
+			Detail:    detail,
+		})
+	}
+	return measurements
+}
+
+// measurementKeys identifies measurements of the same component and name
+// (e.g. shim and GRUB are both EFI applications) by their order.
+func measurementKeys(measurements []Measurement) []string {
+	keys := make([]string, len(measurements))
+	seen := make(map[string]int)
+	for i, m := range measurements {
+		id := fmt.Sprintf("%d/%s/%s", m.PCRIndex, m.Component, m.Name)
+		keys[i] = fmt.Sprintf("%s#%d", id, seen[id])
+		seen[id]++
+	}
+	return keys
+}
+
+// DiffMeasurements returns changes of the current measurements
+// compared to the previous ones.
+func DiffMeasurements(previous, current []Measurement) []MeasurementChange {
+	var changes []MeasurementChange
+	previousKeys := measurementKeys(previous)
+	remaining := make(map[string]Measurement, len(previous))
+	for i, m := range previous {
+		remaining[previousKeys[i]] = m
+	}
+	for i, key := range measurementKeys(current) {
+		cur := current[i]
+		prev, found := remaining[key]
+		if !found {
+			changes = append(changes, MeasurementChange{
+				Type:      MeasurementAdded,
+				Component: cur.Component,
+				Name:      cur.Name,
+				PCRIndex:  cur.PCRIndex,
+				Digest:    cur.Digest,
+				Detail:    cur.Detail,
+			})
+			continue
+		}
+		delete(remaining, key)
+		if !bytes.Equal(prev.Digest, cur.Digest) {
+			changes = append(changes, MeasurementChange{
+				Type:           MeasurementModified,
+				Component:      cur.Component,
+				Name:           cur.Name,
+				PCRIndex:       cur.PCRIndex,
+				PreviousDigest: prev.Digest,
+				Digest:         cur.Digest,
+				PreviousDetail: prev.Detail,
+				Detail:         cur.Detail,
+			})
+		}
+	}
+	for _, key := range previousKeys {
+		prev, found := remaining[key]
+		if !found {
+			continue
+		}
+		changes = append(changes, MeasurementChange{
+			Type:           MeasurementRemoved,
+			Component:      prev.Component,
+			Name:           prev.Name,
+			PCRIndex:       prev.PCRIndex,
+			PreviousDigest: prev.Digest,
+			PreviousDetail: prev.Detail,
+		})
+	}
+	return changes
+}
+
+func sha256PCRValues(pcrs []types.PCRValue) map[int][]byte {
+	values := make(map[int][]byte)
+	for _, pcr := range pcrs {
+		if pcr.Algo == types.PCRExtendHashAlgoSha256 {
+			values[int(pcr.Index)] = pcr.Digest
+		}
+	}
+	return values
+}
+
+// CheckEventLogReplay replays the event log and compares the result with
+// the PCR values reported along with the quote. Only PCRs with events
+// in the log are compared, other PCRs may be extended later by EVE itself
+// (e.g. PCR 14 by measure-config), which is not recorded in the log.
+func CheckEventLogReplay(events []eventlog.Event, quoted []types.PCRValue) ([]PCRReplay, error) {
+	replayed, err := ReplayEventLog(events)
+	if err != nil {
+		return nil, err
+	}
+	values := sha256PCRValues(quoted)
+	var result []PCRReplay
+	for index, replayedValue := range replayed {
+		value, found := values[index]
+		if !found {
+			continue
+		}
+		result = append(result, PCRReplay{
+			Index:    index,
+			Replayed: replayedValue,
+			Quoted:   value,
+			Match:    bytes.Equal(replayedValue, value),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Index < result[j].Index
+	})
+	return result, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package attest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/cshari-zededa/eve-tpm2-tools/eventlog"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// efiVariableData builds UEFI_VARIABLE_DATA with the given name.
+func efiVariableData(name string, value []byte) []byte {
+	encoded := utf16.Encode([]rune(name))
+	data := make([]byte, 32)
+	binary.LittleEndian.PutUint64(data[16:], uint64(len(encoded)))
+	binary.LittleEndian.PutUint64(data[24:], uint64(len(value)))
+	for _, c := range encoded {
+		data = append(data, byte(c), byte(c>>8))
+	}
+	return append(data, value...)
+}
+
+// grubEvent is an EV_IPL event as logged by GRUB, the digest is that
+// of the measured content, not of the description.
+func grubEvent(seq, pcr int, description string, content []byte) eventlog.Event {
+	event := makeEvent(seq, pcr, eventlog.Ipl, content)
+	event.Data = append([]byte(description), 0)
+	return event
+}
+
+func bootEvents(kernel, cmdline string) []eventlog.Event {
+	return []eventlog.Event{
+		makeEvent(0, 0, eventlog.SCRTMVersion, []byte("bios-1.2")),
+		makeEvent(1, 7, eventlog.EFIVariableDriverConfig,
+			efiVariableData("SecureBoot", []byte{1})),
+		makeEvent(2, 0, eventlog.Separator, []byte{0, 0, 0, 0}),
+		makeEvent(3, 4, eventlog.EFIBootServicesApplication, []byte("shim")),
+		makeEvent(4, 4, eventlog.EFIBootServicesApplication, []byte("grub")),
+		grubEvent(5, 8, "grub_cmd set root=(hd0,gpt2)", []byte("set root=(hd0,gpt2)")),
+		grubEvent(6, 13, "squash4 0123abcd", []byte("rootfs")),
+		grubEvent(7, 9, "grub_linux Kernel", []byte(kernel)),
+		grubEvent(8, 9, "grub_initrd Initrd", []byte("initrd")),
+		grubEvent(9, 8, "grub_kernel_cmdline "+cmdline, []byte(cmdline)),
+	}
+}
+
+func TestMeasurements(t *testing.T) {
+	measurements := Measurements(bootEvents("kernel-1", "console=ttyS0"))
+	expected := []struct {
+		component, name, detail string
+	}{
+		{ComponentFirmware, "event type 0x8", ""},
+		{ComponentSecureBoot, "SecureBoot", ""},
+		{ComponentBootloader, "EFI application", ""},
+		{ComponentBootloader, "EFI application", ""},
+		{ComponentGrubCommand, "set root=(hd0,gpt2)", "set root=(hd0,gpt2)"},
+		{ComponentRootfs, "rootfs", "squash4 0123abcd"},
+		{ComponentKernel, "grub_linux Kernel", ""},
+		{ComponentInitrd, "grub_initrd Initrd", ""},
+		{ComponentCmdline, "kernel command line", "console=ttyS0"},
+	}
+	if len(measurements) != len(expected) {
+		t.Fatalf("expected %d measurements, got %+v", len(expected), measurements)
+	}
+	for i, e := range expected {
+		m := measurements[i]
+		if m.Component != e.component || m.Name != e.name || m.Detail != e.detail {
+			t.Errorf("measurement %d: expected %+v, got %+v", i, e, m)
+		}
+	}
+	for _, file := range []struct {
+		description, component string
+	}{
+		{"grub_multiboot /boot/xen.gz", ComponentHypervisor},
+		{"grub_module /boot/kernel", ComponentKernel},
+		{"grub_module /boot/initrd.img", ComponentInitrd},
+		{"grub_module /boot/ucode.img", ComponentOther},
+	} {
+		if component, _ := grubFileComponent(file.description); component != file.component {
+			t.Errorf("%s: expected %s, got %s", file.description, file.component, component)
+		}
+	}
+}
+
+func TestDiffMeasurements(t *testing.T) {
+	previous := Measurements(bootEvents("kernel-1", "console=ttyS0"))
+	if changes := DiffMeasurements(previous, previous); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+
+	events := bootEvents("kernel-2", "console=ttyS1")
+	// Second EFI application is a different GRUB.
+	events[4] = makeEvent(4, 4, eventlog.EFIBootServicesApplication, []byte("grub-2"))
+	// Commands are identified by their text.
+	events[5] = grubEvent(5, 8, "grub_cmd set root=(hd0,gpt3)", []byte("set root=(hd0,gpt3)"))
+	changes := DiffMeasurements(previous, Measurements(events))
+
+	type change struct {
+		typ             MeasurementChangeType
+		component, name string
+	}
+	expected := []change{
+		{MeasurementModified, ComponentBootloader, "EFI application"},
+		{MeasurementAdded, ComponentGrubCommand, "set root=(hd0,gpt3)"},
+		{MeasurementModified, ComponentKernel, "grub_linux Kernel"},
+		{MeasurementModified, ComponentCmdline, "kernel command line"},
+		{MeasurementRemoved, ComponentGrubCommand, "set root=(hd0,gpt2)"},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %+v", len(expected), changes)
+	}
+	for i, e := range expected {
+		c := changes[i]
+		if (change{c.Type, c.Component, c.Name}) != e {
+			t.Errorf("change %d: expected %+v, got %+v", i, e, c)
+		}
+	}
+	cmdline := changes[3]
+	if cmdline.PreviousDetail != "console=ttyS0" || cmdline.Detail != "console=ttyS1" {
+		t.Errorf("unexpected command line change: %+v", cmdline)
+	}
+
+	// Changes are stored in JSON in human-readable form.
+	data, err := json.Marshal(cmdline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"type":"modified"`) ||
+		!strings.Contains(string(data), `"digest":"`+hexDigest([]byte("console=ttyS1"))+`"`) {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+	var decoded MeasurementChange
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded.Digest) != string(cmdline.Digest) {
+		t.Errorf("digest changed in JSON round trip: %x", decoded.Digest)
+	}
+}
+
+func TestCheckEventLogReplay(t *testing.T) {
+	events := bootEvents("kernel-1", "console=ttyS0")
+	replayed, err := ReplayEventLog(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var quoted []types.PCRValue
+	for i := 0; i < 16; i++ {
+		value, found := replayed[i]
+		if !found {
+			value = pcrValue(byte(i))
+		}
+		quoted = append(quoted, types.PCRValue{
+			Index:  uint8(i),
+			Algo:   types.PCRExtendHashAlgoSha256,
+			Digest: value,
+		})
+	}
+	// Kernel loaded by GRUB is not the one in the event log.
+	quoted[9].Digest = pcrValue(9)
+
+	result, err := CheckEventLogReplay(events, quoted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// PCRs 0, 4, 7, 8, 9 and 13 have events, others are not compared.
+	var indexes []int
+	for _, pcr := range result {
+		indexes = append(indexes, pcr.Index)
+		if pcr.Match != (pcr.Index != 9) {
+			t.Errorf("unexpected replay result of PCR %d: %+v", pcr.Index, pcr)
+		}
+	}
+	if len(indexes) != 6 || indexes[0] != 0 || indexes[5] != 13 {
+		t.Errorf("unexpected replayed PCRs %v", indexes)
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package attest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cshari-zededa/eve-tpm2-tools/eventlog"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Local attestation verifies TPM quotes on the device itself, against
+// a policy signed by the fleet operator, for sites without access
+// to the Controller. The functions below implement the verification,
+// the Verifier interface around them is implemented by the consumer.
+
+const (
+	maxPCRIndex = 23
+	// maxPCRDigests limits the combinations of golden PCR values,
+	// each needs a TPM object sealed to it, see PCRDigests
+	maxPCRDigests = 16
+	// startupLocalitySignature prefixes the data of the EV_NO_ACTION event
+	// which records the locality from which TPM2_Startup was issued.
+	// The locality is the initial value of PCR 0.
+	startupLocalitySignature = "StartupLocality\x00"
+)
+
+// Policy lists the measurements of trusted device software and firmware.
+type Policy struct {
+	// Serial is increased with every new policy issued for the same devices.
+	// A policy with a lower serial number than the policy in use is rejected.
+	Serial uint64 `json:"serial"`
+	// Description is free-form, e.g. versions of EVE and BIOS it covers.
+	Description string `json:"description,omitempty"`
+	// PCRs are the golden values of PCRs in the SHA256 bank.
+	// Quoted PCRs not listed here are not checked against golden values.
+	PCRs []GoldenPCR `json:"pcrs"`
+	// EventLogPCRs are PCRs which must be reproduced by replaying
+	// the TPM event log.
+	EventLogPCRs []int `json:"event_log_pcrs,omitempty"`
+	// Events must all be found in the (replayed) event log.
+	Events []GoldenEvent `json:"events,omitempty"`
+}
+
+// GoldenPCR lists the allowed values of a PCR. More values can be allowed
+// for example to cover both EVE images during an update.
+type GoldenPCR struct {
+	Index int `json:"index"`
+	// Digests are hex-encoded SHA256 PCR values.
+	Digests []string `json:"digests"`
+}
+
+// GoldenEvent is a measurement expected in the event log, e.g. the digest
+// of a trusted bootloader or of the Secure Boot db.
+type GoldenEvent struct {
+	PCRIndex int `json:"pcr_index"`
+	// Type is the TCG event type, nil matches any type.
+	Type *uint32 `json:"type,omitempty"`
+	// Digests are hex-encoded SHA256 event digests, one of them must match.
+	Digests     []string `json:"digests"`
+	Description string   `json:"description,omitempty"`
+}
+
+// SignedPolicy is the format in which a Policy is delivered to the device.
+type SignedPolicy struct {
+	// Policy is the JSON-encoded Policy.
+	Policy []byte `json:"policy"`
+	// Signature of Policy by the policy signer, ECDSA (ASN.1) or RSA PKCS#1 v1.5
+	// with SHA256, depending on the key of the signer certificate.
+	Signature []byte `json:"signature"`
+}
+
+// ParseSignedPolicy verifies the signature of the policy and parses it.
+func ParseSignedPolicy(data []byte, signer *x509.Certificate) (*Policy, error) {
+	var signed SignedPolicy
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signed policy: %v", err)
+	}
+	sigAlg := x509.ECDSAWithSHA256
+	if _, isRSA := signer.PublicKey.(*rsa.PublicKey); isRSA {
+		sigAlg = x509.SHA256WithRSA
+	}
+	if err := signer.CheckSignature(sigAlg, signed.Policy, signed.Signature); err != nil {
+		return nil, fmt.Errorf("invalid policy signature: %v", err)
+	}
+	var policy Policy
+	if err := json.Unmarshal(signed.Policy, &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy: %v", err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %v", err)
+	}
+	return &policy, nil
+}
+
+func validateDigests(digests []string) error {
+	if len(digests) == 0 {
+		return fmt.Errorf("no digests")
+	}
+	for _, digest := range digests {
+		value, err := hex.DecodeString(digest)
+		if err != nil || len(value) != sha256.Size {
+			return fmt.Errorf("%q is not a hex-encoded SHA256 digest", digest)
+		}
+	}
+	return nil
+}
+
+func (p *Policy) validate() error {
+	for _, pcr := range p.PCRs {
+		if pcr.Index < 0 || pcr.Index > maxPCRIndex {
+			return fmt.Errorf("PCR index %d out of range", pcr.Index)
+		}
+		if err := validateDigests(pcr.Digests); err != nil {
+			return fmt.Errorf("PCR %d: %v", pcr.Index, err)
+		}
+	}
+	replayed := make(map[int]bool)
+	for _, index := range p.EventLogPCRs {
+		if index < 0 || index > maxPCRIndex {
+			return fmt.Errorf("event log PCR index %d out of range", index)
+		}
+		replayed[index] = true
+	}
+	for _, event := range p.Events {
+		// Without replay the event log could be forged.
+		if !replayed[event.PCRIndex] {
+			return fmt.Errorf("event %q: PCR %d is not in event_log_pcrs",
+				event.Description, event.PCRIndex)
+		}
+		if err := validateDigests(event.Digests); err != nil {
+			return fmt.Errorf("event %q: %v", event.Description, err)
+		}
+	}
+	return nil
+}
+
+// VerifyQuote checks that the quote was signed by the given attestation key,
+// that it was made for the given nonce and that it covers the PCR values
+// reported along with it. The quoted PCR values are returned.
+func VerifyQuote(quote types.AttestQuote, nonce []byte,
+	attestKey crypto.PublicKey) (map[int][]byte, error) {
+	if len(quote.Quote) == 0 {
+		return nil, fmt.Errorf("empty quote")
+	}
+	if quote.SigType != types.EcdsaSha256 {
+		return nil, fmt.Errorf("unsupported signature algorithm %d", quote.SigType)
+	}
+	ecKey, ok := attestKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported attestation key type %T", attestKey)
+	}
+	digest := sha256.Sum256(quote.Quote)
+	if !ecdsa.VerifyASN1(ecKey, digest[:], quote.Signature) {
+		return nil, fmt.Errorf("invalid quote signature")
+	}
+	attestData, err := tpm2.DecodeAttestationData(quote.Quote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode quote: %v", err)
+	}
+	if attestData.Type != tpm2.TagAttestQuote || attestData.AttestedQuoteInfo == nil {
+		return nil, fmt.Errorf("unexpected attestation data type 0x%x", attestData.Type)
+	}
+	if !bytes.Equal(attestData.ExtraData, nonce) {
+		return nil, ErrNonceMismatch
+	}
+	quoteInfo := attestData.AttestedQuoteInfo
+	if quoteInfo.PCRSelection.Hash != tpm2.AlgSHA256 {
+		return nil, fmt.Errorf("unexpected PCR bank %v", quoteInfo.PCRSelection.Hash)
+	}
+	reported := sha256PCRValues(quote.PCRs)
+	// PCR digest is the hash of the selected PCR values in the order
+	// of their indexes.
+	selection := append([]int{}, quoteInfo.PCRSelection.PCRs...)
+	sort.Ints(selection)
+	pcrs := make(map[int][]byte)
+	pcrsHash := sha256.New()
+	for _, index := range selection {
+		value, found := reported[index]
+		if !found {
+			return nil, fmt.Errorf("value of quoted PCR %d is not reported", index)
+		}
+		pcrsHash.Write(value)
+		pcrs[index] = value
+	}
+	if !bytes.Equal(pcrsHash.Sum(nil), quoteInfo.PCRDigest) {
+		return nil, fmt.Errorf("reported PCR values do not match the quote")
+	}
+	return pcrs, nil
+}
+
+// ReplayEventLog returns the SHA256 PCR values obtained by replaying
+// the measurements recorded in the event log.
+func ReplayEventLog(events []eventlog.Event) (map[int][]byte, error) {
+	pcrs := make(map[int][]byte)
+	for _, event := range events {
+		if event.Typ == eventlog.NoAction {
+			data := event.Data
+			if event.Index == 0 && bytes.HasPrefix(data, []byte(startupLocalitySignature)) &&
+				len(data) > len(startupLocalitySignature) {
+				initial := make([]byte, sha256.Size)
+				initial[sha256.Size-1] = data[len(startupLocalitySignature)]
+				pcrs[0] = initial
+			}
+			// Not extended into PCRs.
+			continue
+		}
+		digest := event.Sha256Digest()
+		if len(digest) != sha256.Size {
+			return nil, fmt.Errorf("event %d has no SHA256 digest", event.Sequence)
+		}
+		value, found := pcrs[event.Index]
+		if !found {
+			value = make([]byte, sha256.Size)
+		}
+		extended := sha256.Sum256(append(append([]byte{}, value...), digest...))
+		pcrs[event.Index] = extended[:]
+	}
+	return pcrs, nil
+}
+
+func containsDigest(digests []string, value []byte) bool {
+	for _, digest := range digests {
+		if strings.EqualFold(digest, hex.EncodeToString(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify checks quoted PCR values (see VerifyQuote) and the event log
+// against the policy. All violations are reported in the returned error.
+func (p *Policy) Verify(pcrs map[int][]byte, events []eventlog.Event) error {
+	var violations []string
+	for _, golden := range p.PCRs {
+		value, found := pcrs[golden.Index]
+		if !found {
+			violations = append(violations,
+				fmt.Sprintf("PCR %d is not quoted", golden.Index))
+			continue
+		}
+		if !containsDigest(golden.Digests, value) {
+			violations = append(violations, fmt.Sprintf(
+				"PCR %d has unexpected value %x", golden.Index, value))
+		}
+	}
+	if len(p.EventLogPCRs) > 0 {
+		replayed, err := ReplayEventLog(events)
+		if err != nil {
+			return fmt.Errorf("failed to replay event log: %v", err)
+		}
+		zeroPCR := make([]byte, sha256.Size)
+		for _, index := range p.EventLogPCRs {
+			value, found := pcrs[index]
+			if !found {
+				violations = append(violations,
+					fmt.Sprintf("PCR %d is not quoted", index))
+				continue
+			}
+			replayedValue, found := replayed[index]
+			if !found {
+				replayedValue = zeroPCR
+			}
+			if !bytes.Equal(value, replayedValue) {
+				violations = append(violations, fmt.Sprintf(
+					"PCR %d value %x does not match event log replay %x",
+					index, value, replayedValue))
+			}
+		}
+		for _, golden := range p.Events {
+			if !p.findEvent(golden, events) {
+				violations = append(violations, fmt.Sprintf(
+					"event %q not found in PCR %d", golden.Description, golden.PCRIndex))
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("policy %d violated: %s", p.Serial,
+			strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// PCRDigests returns the SHA256 digests of all combinations of golden
+// values of the given PCRs, as used by TPM2_PolicyPCR, i.e. of the values
+// concatenated in the order of PCR indexes. Each of the PCRs must have
+// golden values in the policy.
+func (p *Policy) PCRDigests(pcrs []int) ([][]byte, error) {
+	indexes := append([]int{}, pcrs...)
+	sort.Ints(indexes)
+	golden := make(map[int][]string)
+	for _, pcr := range p.PCRs {
+		golden[pcr.Index] = append(golden[pcr.Index], pcr.Digests...)
+	}
+	combinations := [][]byte{{}}
+	for _, index := range indexes {
+		digests, found := golden[index]
+		if !found {
+			return nil, fmt.Errorf("policy %d has no golden values of PCR %d",
+				p.Serial, index)
+		}
+		if len(combinations)*len(digests) > maxPCRDigests {
+			return nil, fmt.Errorf("policy %d has more than %d combinations of golden values",
+				p.Serial, maxPCRDigests)
+		}
+		var extended [][]byte
+		for _, prefix := range combinations {
+			for _, digest := range digests {
+				value, err := hex.DecodeString(digest)
+				if err != nil {
+					return nil, err
+				}
+				extended = append(extended, append(append([]byte{}, prefix...), value...))
+			}
+		}
+		combinations = extended
+	}
+	result := make([][]byte, len(combinations))
+	for i, values := range combinations {
+		digest := sha256.Sum256(values)
+		result[i] = digest[:]
+	}
+	return result, nil
+}
+
+func (p *Policy) findEvent(golden GoldenEvent, events []eventlog.Event) bool {
+	for _, event := range events {
+		if event.Index != golden.PCRIndex {
+			continue
+		}
+		if golden.Type != nil && uint32(event.Typ) != *golden.Type {
+			continue
+		}
+		if containsDigest(golden.Digests, event.Sha256Digest()) {
+			return true
+		}
+	}
+	return false
+}
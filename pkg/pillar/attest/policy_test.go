@@ -0,0 +1,318 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package attest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cshari-zededa/eve-tpm2-tools/eventlog"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func newTestSigner(t *testing.T) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "attest-policy-signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+func signPolicy(t *testing.T, key *ecdsa.PrivateKey, policy Policy) []byte {
+	policyBytes, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(policyBytes)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := json.Marshal(SignedPolicy{Policy: policyBytes, Signature: sig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func pcrValue(b byte) []byte {
+	value := make([]byte, sha256.Size)
+	for i := range value {
+		value[i] = b
+	}
+	return value
+}
+
+// makeQuote produces TPMS_ATTEST of a quote over PCRs 0-15, the same
+// selection tpmmgr uses, signed with the given attestation key.
+func makeQuote(t *testing.T, key *ecdsa.PrivateKey, nonce []byte,
+	pcrs map[int][]byte) types.AttestQuote {
+	pcrsHash := sha256.New()
+	var reported []types.PCRValue
+	for i := 0; i < 16; i++ {
+		value, found := pcrs[i]
+		if !found {
+			value = make([]byte, sha256.Size)
+		}
+		pcrsHash.Write(value)
+		reported = append(reported, types.PCRValue{
+			Index:  uint8(i),
+			Algo:   types.PCRExtendHashAlgoSha256,
+			Digest: value,
+		})
+	}
+	quote, err := tpmutil.Pack(uint32(0xff544347), tpm2.TagAttestQuote,
+		tpmutil.U16Bytes(nil), tpmutil.U16Bytes(nonce), tpm2.ClockInfo{}, uint64(0),
+		uint32(1), tpm2.AlgSHA256, uint8(3), [3]byte{0xff, 0xff, 0x00},
+		tpmutil.U16Bytes(pcrsHash.Sum(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(quote)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.AttestQuote{
+		Nonce:     nonce,
+		SigType:   types.EcdsaSha256,
+		Signature: sig,
+		Quote:     quote,
+		PCRs:      reported,
+	}
+}
+
+func makeEvent(seq, pcr int, typ eventlog.EventType, data []byte) eventlog.Event {
+	digest := sha256.Sum256(data)
+	return eventlog.Event{
+		Sequence: seq,
+		Index:    pcr,
+		Typ:      typ,
+		Data:     data,
+		Digests:  []eventlog.Digest{{Hash: crypto.SHA256, Data: digest[:]}},
+	}
+}
+
+func hexDigest(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+func TestParseSignedPolicy(t *testing.T) {
+	key, cert := newTestSigner(t)
+	policy := Policy{
+		Serial: 3,
+		PCRs:   []GoldenPCR{{Index: 14, Digests: []string{hex.EncodeToString(pcrValue(14))}}},
+	}
+	parsed, err := ParseSignedPolicy(signPolicy(t, key, policy), cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Serial != 3 || len(parsed.PCRs) != 1 {
+		t.Errorf("unexpected policy: %+v", parsed)
+	}
+
+	otherKey, _ := newTestSigner(t)
+	if _, err = ParseSignedPolicy(signPolicy(t, otherKey, policy), cert); err == nil ||
+		!strings.Contains(err.Error(), "invalid policy signature") {
+		t.Errorf("expected signature error, got %v", err)
+	}
+
+	// Events of PCRs not reproduced by event log replay are rejected.
+	policy.Events = []GoldenEvent{
+		{PCRIndex: 4, Digests: []string{hexDigest([]byte("grub"))}, Description: "grub"},
+	}
+	if _, err = ParseSignedPolicy(signPolicy(t, key, policy), cert); err == nil ||
+		!strings.Contains(err.Error(), "not in event_log_pcrs") {
+		t.Errorf("expected invalid policy error, got %v", err)
+	}
+
+	policy.Events = nil
+	policy.PCRs[0].Digests = []string{"abcd"}
+	if _, err = ParseSignedPolicy(signPolicy(t, key, policy), cert); err == nil {
+		t.Error("expected error for malformed digest")
+	}
+}
+
+func TestVerifyQuote(t *testing.T) {
+	akKey, _ := newTestSigner(t)
+	nonce := []byte("0123456789abcdef")
+	pcrs := map[int][]byte{0: pcrValue(0), 14: pcrValue(14)}
+	quote := makeQuote(t, akKey, nonce, pcrs)
+
+	quoted, err := VerifyQuote(quote, nonce, &akKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quoted) != 16 || hex.EncodeToString(quoted[14]) != hex.EncodeToString(pcrValue(14)) {
+		t.Errorf("unexpected quoted PCRs: %v", quoted)
+	}
+
+	if _, err = VerifyQuote(quote, []byte("other nonce"), &akKey.PublicKey); !errors.Is(err, ErrNonceMismatch) {
+		t.Errorf("expected nonce mismatch, got %v", err)
+	}
+
+	otherKey, _ := newTestSigner(t)
+	if _, err = VerifyQuote(quote, nonce, &otherKey.PublicKey); err == nil {
+		t.Error("expected invalid signature error")
+	}
+
+	// Reported PCR values are not covered by the signature, only by the quoted digest.
+	quote.PCRs[14].Digest = pcrValue(0x42)
+	if _, err = VerifyQuote(quote, nonce, &akKey.PublicKey); err == nil ||
+		!strings.Contains(err.Error(), "do not match the quote") {
+		t.Errorf("expected PCR digest mismatch, got %v", err)
+	}
+}
+
+func TestPolicyVerify(t *testing.T) {
+	separator := []byte{0, 0, 0, 0}
+	events := []eventlog.Event{
+		{Sequence: 0, Index: 0, Typ: eventlog.NoAction,
+			Data: append([]byte(startupLocalitySignature), 3)},
+		makeEvent(1, 0, eventlog.SCRTMVersion, []byte("bios-1.2")),
+		makeEvent(2, 0, eventlog.Separator, separator),
+		makeEvent(3, 4, eventlog.EFIBootServicesApplication, []byte("grub")),
+		makeEvent(4, 4, eventlog.Separator, separator),
+	}
+	replayed, err := ReplayEventLog(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// PCR 0 starts from the startup locality.
+	pcr0 := make([]byte, sha256.Size)
+	pcr0[sha256.Size-1] = 3
+	for _, event := range events[1:3] {
+		extended := sha256.Sum256(append(pcr0, event.Sha256Digest()...))
+		pcr0 = extended[:]
+	}
+	if hex.EncodeToString(replayed[0]) != hex.EncodeToString(pcr0) {
+		t.Errorf("unexpected replayed PCR 0: %x", replayed[0])
+	}
+
+	pcrs := map[int][]byte{0: replayed[0], 4: replayed[4], 14: pcrValue(14)}
+	grubType := uint32(eventlog.EFIBootServicesApplication)
+	policy := Policy{
+		Serial:       1,
+		PCRs:         []GoldenPCR{{Index: 14, Digests: []string{hex.EncodeToString(pcrValue(14))}}},
+		EventLogPCRs: []int{0, 4},
+		Events: []GoldenEvent{{
+			PCRIndex:    4,
+			Type:        &grubType,
+			Digests:     []string{hexDigest([]byte("old-grub")), hexDigest([]byte("grub"))},
+			Description: "grub",
+		}},
+	}
+	if err = policy.Verify(pcrs, events); err != nil {
+		t.Errorf("unexpected policy violation: %v", err)
+	}
+
+	// Golden PCR value mismatch.
+	pcrs[14] = pcrValue(0x14)
+	if err = policy.Verify(pcrs, events); err == nil ||
+		!strings.Contains(err.Error(), "PCR 14 has unexpected value") {
+		t.Errorf("expected PCR 14 violation, got %v", err)
+	}
+	pcrs[14] = pcrValue(14)
+
+	// Forged event log does not reproduce the quoted PCRs.
+	forged := append([]eventlog.Event{}, events...)
+	forged[3] = makeEvent(3, 4, eventlog.EFIBootServicesApplication, []byte("old-grub"))
+	if err = policy.Verify(pcrs, forged); err == nil ||
+		!strings.Contains(err.Error(), "does not match event log replay") {
+		t.Errorf("expected replay violation, got %v", err)
+	}
+
+	// Untrusted bootloader, recorded correctly in the event log.
+	untrusted := append([]eventlog.Event{}, events...)
+	untrusted[3] = makeEvent(3, 4, eventlog.EFIBootServicesApplication, []byte("evil-grub"))
+	replayed, err = ReplayEventLog(untrusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcrs[4] = replayed[4]
+	if err = policy.Verify(pcrs, untrusted); err == nil ||
+		!strings.Contains(err.Error(), `event "grub" not found in PCR 4`) {
+		t.Errorf("expected missing event violation, got %v", err)
+	}
+}
+
+func TestPolicyPCRDigests(t *testing.T) {
+	golden := func(values ...byte) []string {
+		var digests []string
+		for _, b := range values {
+			digests = append(digests, hex.EncodeToString(pcrValue(b)))
+		}
+		return digests
+	}
+	policy := Policy{
+		Serial: 2,
+		PCRs: []GoldenPCR{
+			{Index: 9, Digests: golden(0x91, 0x92)},
+			{Index: 0, Digests: golden(0x01)},
+			{Index: 14, Digests: golden(0x14)},
+		},
+	}
+	digests, err := policy.PCRDigests([]int{9, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// PCR values are concatenated in the order of PCR indexes.
+	var expected []string
+	for _, pcr9 := range []byte{0x91, 0x92} {
+		digest := sha256.Sum256(append(pcrValue(0x01), pcrValue(pcr9)...))
+		expected = append(expected, hex.EncodeToString(digest[:]))
+	}
+	if len(digests) != len(expected) {
+		t.Fatalf("expected %d digests, got %d", len(expected), len(digests))
+	}
+	for i := range expected {
+		if hex.EncodeToString(digests[i]) != expected[i] {
+			t.Errorf("digest %d: expected %s, got %x", i, expected[i], digests[i])
+		}
+	}
+
+	// PCRs without golden values cannot be sealed to.
+	if _, err = policy.PCRDigests([]int{0, 7}); err == nil ||
+		!strings.Contains(err.Error(), "no golden values of PCR 7") {
+		t.Errorf("expected missing PCR 7 error, got %v", err)
+	}
+
+	// Too many combinations.
+	policy.PCRs = nil
+	for i := 0; i < 5; i++ {
+		policy.PCRs = append(policy.PCRs, GoldenPCR{Index: i, Digests: golden(1, 2)})
+	}
+	if _, err = policy.PCRDigests([]int{0, 1, 2, 3, 4}); err == nil {
+		t.Error("expected error with 32 combinations of golden values")
+	}
+}
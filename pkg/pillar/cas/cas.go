@@ -101,6 +101,12 @@ type CAS interface {
 	//Arg 'snapshotID' should be of format <algo>:<hash> (currently supporting only sha256:<hash>).
 	//To keep this method idempotent, no error  is returned if the given 'snapshotID' is not found.
 	RemoveSnapshot(snapshotID string) error
+	// ExportSnapshot writes the changes of the snapshot to the image it was
+	// created from as a tar stream into w
+	ExportSnapshot(snapshotID string, w io.Writer) error
+	// ImportSnapshot applies the changes written by ExportSnapshot to the
+	// snapshot, which is created from the same image
+	ImportSnapshot(snapshotID string, r io.Reader) error
 
 	// PrepareContainerRootDir creates a reference pointing to the rootBlob and prepares a writable snapshot
 	// from the reference. Before preparing container's root directory, this API must remove any existing state
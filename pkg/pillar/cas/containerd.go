@@ -637,6 +637,28 @@ func (c *containerdCAS) RemoveSnapshot(snapshotID string) error {
 	return nil
 }
 
+// ExportSnapshot writes the changes of the snapshot to the image it was
+// created from as a tar stream into w
+func (c *containerdCAS) ExportSnapshot(snapshotID string, w io.Writer) error {
+	ctrdCtx, done := c.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if err := c.ctrdClient.CtrExportSnapshot(ctrdCtx, snapshotID, w); err != nil {
+		return fmt.Errorf("ExportSnapshot: Exception while exporting snapshot: %s. %s", snapshotID, err)
+	}
+	return nil
+}
+
+// ImportSnapshot applies the changes written by ExportSnapshot to the
+// snapshot
+func (c *containerdCAS) ImportSnapshot(snapshotID string, r io.Reader) error {
+	ctrdCtx, done := c.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if err := c.ctrdClient.CtrImportSnapshot(ctrdCtx, snapshotID, r); err != nil {
+		return fmt.Errorf("ImportSnapshot: Exception while importing snapshot: %s. %s", snapshotID, err)
+	}
+	return nil
+}
+
 // PrepareContainerRootDir prepares a writable snapshot from the reference. Before preparing container's root directory,
 // this API removes any existing state that may have accumulated (like existing snapshots being available, etc.)
 // This effectively voids any kind of caching, but on the flip side frees us
@@ -25,6 +25,8 @@ func getEncryptionBlock(
 	decBlock.WifiUserName = zconfigDecBlockPtr.WifiUserName
 	decBlock.WifiPassword = zconfigDecBlockPtr.WifiPassword
 	decBlock.ProtectedUserData = zconfigDecBlockPtr.ProtectedUserData
+	decBlock.IPsecPresharedKey = zconfigDecBlockPtr.IpsecPresharedKey
+	decBlock.WireGuardPrivateKey = zconfigDecBlockPtr.WireguardPrivateKey
 	return decBlock
 }
 
@@ -217,6 +217,7 @@ func handleBaseOsConfigCreate(ctxArg interface{}, key string, configArg interfac
 		cts := &status.ContentTreeStatusList[i]
 		cts.UpdateFromContentTreeConfig(ctc)
 	}
+	updateDeltaStatus(ctx, config, &status)
 	// Check image count
 	err := validateBaseOsConfig(ctx, config)
 	if err != nil {
@@ -255,6 +256,7 @@ func handleBaseOsConfigModify(ctxArg interface{}, key string, configArg interfac
 
 	// update the version field, uuids being the same
 	status.UUIDandVersion = config.UUIDandVersion
+	updateDeltaStatus(ctx, config, status)
 	publishBaseOsStatus(ctx, status)
 	baseOsHandleStatusUpdate(ctx, &config, status)
 }
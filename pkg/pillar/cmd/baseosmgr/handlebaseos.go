@@ -86,12 +86,15 @@ func baseOsHandleStatusUpdateImageSha(ctx *baseOsMgrContext, imageSha string) {
 func baseOsHandleStatusUpdateUUID(ctx *baseOsMgrContext, id string) {
 	log.Functionf("baseOsHandleStatusUpdateUUID for %s", id)
 	config := lookupBaseOsConfig(ctx, id)
+	if config == nil {
+		config = lookupBaseOsConfigDelta(ctx, id)
+	}
 	if config == nil {
 		// assume that this ContentTreeStatus is not for baseOs
 		log.Functionf("baseOsHandleStatusUpdateUUID(%s) config not found", id)
 		return
 	}
-	status := lookupBaseOsStatus(ctx, id)
+	status := lookupBaseOsStatus(ctx, config.Key())
 	if status == nil {
 		log.Functionf("baseOsHandleStatusUpdateUUID(%s) status not found", id)
 		return
@@ -299,6 +302,10 @@ func setProgressDone(status *types.BaseOsStatus, state types.SwState) {
 		cts.Progress = 100
 		cts.State = state
 	}
+	if status.DeltaContentTreeStatus != nil && !status.DeltaFailed {
+		status.DeltaContentTreeStatus.Progress = 100
+		status.DeltaContentTreeStatus.State = state
+	}
 }
 
 // Returns changed boolean when the status was changed
@@ -353,8 +360,19 @@ func doBaseOsActivate(ctx *baseOsMgrContext, uuidStr string,
 	log.Functionf("doBaseOsActivate: %s activating", uuidStr)
 
 	// install the image at proper partition; dd etc
-	changed, proceed, err = installDownloadedObjects(ctx, uuidStr, status.PartitionLabel,
-		&status.ContentTreeStatusList)
+	if useDelta(config, status) {
+		changed, proceed, err = installDelta(ctx, config, status)
+		if err != nil {
+			fallbackFromDelta(ctx, status, err)
+			// start downloading the full image
+			checkBaseOsVolumeStatus(ctx, status.UUIDandVersion.UUID,
+				config, status)
+			return true
+		}
+	} else {
+		changed, proceed, err = installDownloadedObjects(ctx, uuidStr,
+			status.PartitionLabel, &status.ContentTreeStatusList)
+	}
 	if err != nil {
 		status.SetErrorNow(err.Error())
 		changed = true
@@ -537,6 +555,13 @@ func checkBaseOsVolumeStatus(ctx *baseOsMgrContext, baseOsUUID uuid.UUID,
 	uuidStr := baseOsUUID.String()
 	log.Functionf("checkBaseOsVolumeStatus(%s) for %s",
 		config.BaseOsVersion, uuidStr)
+	if useDelta(config, status) {
+		changed, done, err := checkDeltaVolumeStatus(ctx, config, status)
+		if err == nil {
+			return changed, done
+		}
+		fallbackFromDelta(ctx, status, err)
+	}
 	ret := checkContentTreeStatus(ctx, baseOsUUID, config.ContentTreeConfigList,
 		status.ContentTreeStatusList)
 
@@ -675,6 +700,17 @@ func doBaseOsUninstall(ctx *baseOsMgrContext, uuidStr string,
 			continue
 		}
 	}
+	if status.DeltaContentTreeStatus != nil {
+		key := status.DeltaContentTreeStatus.Key()
+		if MaybeRemoveContentTreeConfig(ctx, key) {
+			changed = true
+		}
+		if lookupContentTreeStatus(ctx, key) != nil {
+			log.Functionf("doBaseOsUninstall(%s) for %s, delta %s not yet gone;",
+				status.BaseOsVersion, uuidStr, key)
+			removedAll = false
+		}
+	}
 
 	if !removedAll {
 		log.Functionf("doBaseOsUninstall(%s) for %s, Waiting for volumemgr purge",
@@ -785,6 +821,12 @@ func validateBaseOsConfig(ctx *baseOsMgrContext, config types.BaseOsConfig) erro
 			config.BaseOsVersion, imageCount)
 		return errors.New(errStr)
 	}
+	// the full image is needed if the delta can not be used
+	if config.HasDelta() && imageCount == 0 {
+		errStr := fmt.Sprintf("baseOs(%s) delta without image",
+			config.BaseOsVersion)
+		return errors.New(errStr)
+	}
 
 	return nil
 }
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Installing the rootfs from a delta against the current partition.
+// The delta content tree is used instead of ContentTreeConfigList until
+// anything fails, then DeltaFailed is set and the full image is downloaded.
+
+package baseosmgr
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// useDelta returns true if the rootfs should be installed from the delta
+func useDelta(config types.BaseOsConfig, status *types.BaseOsStatus) bool {
+	return config.HasDelta() && status.DeltaContentTreeStatus != nil &&
+		!status.DeltaFailed
+}
+
+// lookupBaseOsConfigDelta finds the config which has the delta contentID
+func lookupBaseOsConfigDelta(ctx *baseOsMgrContext, contentID string) *types.BaseOsConfig {
+	items := ctx.subBaseOsConfig.GetAll()
+	for _, c := range items {
+		config := c.(types.BaseOsConfig)
+		if config.DeltaContentTreeConfig != nil &&
+			config.DeltaContentTreeConfig.Key() == contentID {
+			return &config
+		}
+	}
+	return nil
+}
+
+// updateDeltaStatus sets up DeltaContentTreeStatus from the config,
+// removing the content tree of a delta which is no longer configured
+func updateDeltaStatus(ctx *baseOsMgrContext, config types.BaseOsConfig,
+	status *types.BaseOsStatus) {
+
+	deltaConfig := config.DeltaContentTreeConfig
+	if !config.HasDelta() {
+		deltaConfig = nil
+	}
+	old := status.DeltaContentTreeStatus
+	if old != nil && (deltaConfig == nil ||
+		!uuid.Equal(old.ContentID, deltaConfig.ContentID)) {
+		log.Functionf("updateDeltaStatus(%s) delta %s removed",
+			config.Key(), old.Key())
+		MaybeRemoveContentTreeConfig(ctx, old.Key())
+		status.DeltaContentTreeStatus = nil
+		status.DeltaFailed = false
+	}
+	if deltaConfig != nil && status.DeltaContentTreeStatus == nil {
+		cts := &types.ContentTreeStatus{}
+		cts.UpdateFromContentTreeConfig(*deltaConfig)
+		status.DeltaContentTreeStatus = cts
+	}
+}
+
+// checkDeltaVolumeStatus is checkBaseOsVolumeStatus for the delta.
+// Returns changed, done, and an error if the delta can not be downloaded.
+func checkDeltaVolumeStatus(ctx *baseOsMgrContext, config types.BaseOsConfig,
+	status *types.BaseOsStatus) (bool, bool, error) {
+
+	ctsList := []types.ContentTreeStatus{*status.DeltaContentTreeStatus}
+	ret := checkContentTreeStatus(ctx, status.UUIDandVersion.UUID,
+		[]types.ContentTreeConfig{*config.DeltaContentTreeConfig}, ctsList)
+	*status.DeltaContentTreeStatus = ctsList[0]
+
+	if ret.AllErrors != "" {
+		return ret.Changed, false, fmt.Errorf("delta download failed: %s",
+			ret.AllErrors)
+	}
+	status.State = ret.MinState
+	if ret.MinState < types.LOADED {
+		log.Functionf("checkDeltaVolumeStatus(%s) for %s, Waiting for volumemgr",
+			config.BaseOsVersion, config.Key())
+		return ret.Changed, false, nil
+	}
+	return ret.Changed, true, nil
+}
+
+// installDelta writes the rootfs produced by the delta to the partition
+// in the background. Returns changed, proceed, and the error of the install.
+func installDelta(ctx *baseOsMgrContext, config types.BaseOsConfig,
+	status *types.BaseOsStatus) (bool, bool, error) {
+
+	cts := status.DeltaContentTreeStatus
+	log.Functionf("installDelta(%s, %v)", cts.ContentID, cts.State)
+
+	switch cts.State {
+	case types.INSTALLED:
+		return false, true, nil
+	case types.LOADED:
+	default:
+		return false, false, nil
+	}
+
+	wres := ctx.worker.Pop(cts.Key())
+	if wres != nil {
+		log.Functionf("installDelta(%s): InstallWorkResult found", cts.ContentID)
+		if wres.Error != nil {
+			return true, false, fmt.Errorf("delta install failed: %v",
+				wres.Error)
+		}
+		cts.State = types.INSTALLED
+		return true, true, nil
+	}
+	AddWorkInstallDelta(ctx, cts.Key(), cts.ReferenceID(),
+		status.PartitionLabel, config.RootfsSha256)
+	log.Functionf("installDelta(%s) worker started", cts.ContentID)
+	return false, false, nil
+}
+
+// fallbackFromDelta stops using the delta; the full image is used instead
+func fallbackFromDelta(ctx *baseOsMgrContext, status *types.BaseOsStatus,
+	err error) {
+
+	log.Warnf("fallbackFromDelta(%s) for %s, using the full image: %v",
+		status.BaseOsVersion, status.Key(), err)
+	status.DeltaFailed = true
+	MaybeRemoveContentTreeConfig(ctx, status.DeltaContentTreeStatus.Key())
+	status.ClearError()
+}
@@ -20,6 +20,8 @@ type installWorkDescription struct {
 	contentID string
 	ref       string
 	target    string
+	// rootfsSha256 is set if ref is a delta producing the rootfs
+	rootfsSha256 string
 }
 
 // AddWorkInstall create a Work job to install the provided image to the target path
@@ -29,6 +31,24 @@ func AddWorkInstall(ctx *baseOsMgrContext, key, ref, target string) {
 		ref:       ref,
 		target:    target,
 	}
+	submitWorkInstall(ctx, key, d)
+	log.Functionf("AddWorkInstall(%s) done", key)
+}
+
+// AddWorkInstallDelta create a Work job to apply the provided delta
+// image to the current partition and install the result to the target path
+func AddWorkInstallDelta(ctx *baseOsMgrContext, key, ref, target, rootfsSha256 string) {
+	d := installWorkDescription{
+		contentID:    key,
+		ref:          ref,
+		target:       target,
+		rootfsSha256: rootfsSha256,
+	}
+	submitWorkInstall(ctx, key, d)
+	log.Functionf("AddWorkInstallDelta(%s) done", key)
+}
+
+func submitWorkInstall(ctx *baseOsMgrContext, key string, d installWorkDescription) {
 	// Don't fail on errors to make idempotent (Submit returns an error if
 	// the work was already submitted)
 	done, err := ctx.worker.TrySubmit(worker.Work{Key: key, Kind: workInstall,
@@ -38,7 +58,6 @@ func AddWorkInstall(ctx *baseOsMgrContext, key, ref, target string) {
 	} else if !done {
 		log.Fatalf("Failed to submit work due to queue length for %s", key)
 	}
-	log.Functionf("AddWorkInstall(%s) done", key)
 }
 
 // installWorker implementation of work.WorkFunction that installs an image to a particular location
@@ -57,7 +76,12 @@ func installWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
 	}
 
 	log.Functionf("installWorker to install %s to %s", d.ref, d.target)
-	err := zboot.WriteToPartition(log, d.ref, d.target)
+	var err error
+	if d.rootfsSha256 != "" {
+		err = zboot.WriteDeltaToPartition(log, d.ref, d.target, d.rootfsSha256)
+	} else {
+		err = zboot.WriteToPartition(log, d.ref, d.target)
+	}
 	log.Functionf("installWorker DONE install %s to %s: err %v",
 		d.ref, d.target, err)
 
@@ -122,6 +122,9 @@ type domainContext struct {
 	// CPUs management
 	cpuAllocator        *cpuallocator.CPUAllocator
 	cpuPinningSupported bool
+	// Memory reclaimed from idle domains when the host is low on memory
+	memoryReclaim        memoryReclaim
+	memoryReclaimPercent uint32
 }
 
 // AddAgentSpecificCLIFlags adds CLI options
@@ -850,6 +853,11 @@ func runHandler(ctx *domainContext, key string, configChannel <-chan Notify, cpu
 	min := max * 0.3
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
+	prober := newAppProber()
+	guest := newGuestAgentState()
+	resizer := newDomainResizer()
+	probeTicker := time.NewTicker(probeTickInterval)
+	defer probeTicker.Stop()
 
 	closed := false
 	for !closed {
@@ -869,6 +877,17 @@ func runHandler(ctx *domainContext, key string, configChannel <-chan Notify, cpu
 				} else {
 					handleModify(ctx, key, &config, status)
 				}
+				status = lookupDomainStatus(ctx, key)
+				if status == nil {
+					continue
+				}
+				changed := guest.syncFsFreeze(config, status)
+				if resizer.tick(ctx, config, status) {
+					changed = true
+				}
+				if changed {
+					publishDomainStatus(ctx, status)
+				}
 			} else {
 				// Closed
 				status := lookupDomainStatus(ctx, key)
@@ -907,6 +926,48 @@ func runHandler(ctx *domainContext, key string, configChannel <-chan Notify, cpu
 				verifyStatus(ctx, status)
 				maybeRetry(ctx, status)
 			}
+		case <-probeTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			config := lookupDomainConfig(ctx, key)
+			if status == nil || config == nil {
+				continue
+			}
+			changed := prober.tick(*config, status)
+			if guest.tick(*config, status) {
+				changed = true
+			}
+			if resizer.tick(ctx, *config, status) {
+				changed = true
+			}
+			if changed {
+				publishDomainStatus(ctx, status)
+			}
+		case run := <-prober.results:
+			status := lookupDomainStatus(ctx, key)
+			config := lookupDomainConfig(ctx, key)
+			if status != nil && config != nil && prober.record(*config, status, run) {
+				publishDomainStatus(ctx, status)
+			}
+		case result := <-guest.infoResults:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil && guest.record(status, result) {
+				publishDomainStatus(ctx, status)
+			}
+		case err := <-guest.freezeResults:
+			status := lookupDomainStatus(ctx, key)
+			config := lookupDomainConfig(ctx, key)
+			if status == nil {
+				guest.freezing = false
+				continue
+			}
+			changed := guest.recordFreeze(status, err)
+			// thaw if zedmanager cleared GuestFsFreeze in the meantime
+			if config != nil && guest.syncFsFreeze(*config, status) {
+				changed = true
+			}
+			if changed {
+				publishDomainStatus(ctx, status)
+			}
 		}
 	}
 	log.Functionf("runHandler(%s) DONE", key)
@@ -1485,6 +1546,13 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	}
 	defer file.Close()
 
+	ioLimitsError := strings.Join(containerd.UnthrottledDisks(status.DiskStatusList), "; ")
+	if ioLimitsError != "" {
+		log.Warnf("doActivate(%s) I/O limits not applied: %s",
+			config.Key(), ioLimitsError)
+	}
+	status.IoLimitsError = ioLimitsError
+
 	globalConfig := agentlog.GetGlobalConfig(log, ctx.subGlobalConfig)
 	if err := hyper.Task(status).Setup(*status, config, ctx.assignableAdapters, globalConfig, file); err != nil {
 		log.Errorf("Failed to create DomainStatus from %v: %s",
@@ -1961,6 +2029,7 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.MountDir = dc.MountDir
 		ds.DisplayName = dc.DisplayName
 		ds.WWN = dc.WWN
+		ds.IoLimits = dc.IoLimits
 		ds.CustomMeta = dc.CustomMeta
 		// Generate Devtype for hypervisor package
 		// XXX can hypervisor look at something different?
@@ -2242,11 +2311,17 @@ func handleModify(ctx *domainContext, key string,
 }
 
 func updateStatusFromConfig(status *types.DomainStatus, config types.DomainConfig) {
+	// the domain boots with the resources of the config; only the CPUs
+	// are assigned by domainmgr
+	cpus := status.CPUs
+	status.VmConfig = config.VmConfig
+	status.CPUs = cpus
 	status.VirtualizationMode = config.VirtualizationModeOrDefault()
 	status.EnableVnc = config.EnableVnc
 	status.VncDisplay = config.VncDisplay
 	status.VncPasswd = config.VncPasswd
 	status.DisableLogs = config.DisableLogs
+	status.EnableGuestAgent = config.EnableGuestAgent
 }
 
 // fillVifUsed iterates over vifs from received config and fill VifUsed
@@ -2511,6 +2586,7 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			ctx.metricInterval = metricInterval
 		}
 		ctx.processCloudInitMultiPart = gcp.GlobalValueBool(types.ProcessCloudInitMultiPart)
+		ctx.memoryReclaimPercent = gcp.GlobalValueInt(types.MemoryReclaimPercent)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s. "+
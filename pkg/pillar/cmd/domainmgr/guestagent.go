@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Use of the QEMU guest agent of the domains which enable it, by the
+// runHandler of each domain
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// guestInfoInterval is how often the guest agent is asked for the OS,
+	// hostname and IP addresses of the guest
+	guestInfoInterval = time.Minute
+	// guestFsFreezeMax is how long the filesystems of a guest stay frozen
+	// at most, should zedmanager never ask to thaw them
+	guestFsFreezeMax = 5 * time.Minute
+	// clockJumpThreshold is how far the wall clock may drift from the
+	// monotonic clock between two ticks. Beyond that the host was suspended
+	// or its clock was stepped, and the clock of the guest is set again.
+	clockJumpThreshold = 5 * time.Second
+)
+
+// guestInfoResult is the result of a query of the guest agent
+type guestInfoResult struct {
+	bootTime time.Time
+	info     types.GuestInfo
+	err      error
+}
+
+// guestAgentState tracks the use of the guest agent of a domain
+type guestAgentState struct {
+	infoResults chan guestInfoResult
+	querying    bool
+	lastQuery   time.Time
+	bootTime    time.Time
+	// lastTick holds a monotonic clock reading
+	lastTick time.Time
+	// freezeResults receives the result of a freeze, which can take
+	// as long as the guest needs to flush its filesystems
+	freezeResults chan error
+	freezing      bool
+}
+
+func newGuestAgentState() *guestAgentState {
+	return &guestAgentState{
+		infoResults:   make(chan guestInfoResult, 1),
+		lastTick:      time.Now(),
+		freezeResults: make(chan error, 1),
+	}
+}
+
+// guestAgent returns the guest agent of the domain, if it has one
+func guestAgent(status types.DomainStatus) (hypervisor.GuestAgent, error) {
+	if !status.EnableGuestAgent {
+		return nil, fmt.Errorf("domain %s has no guest agent",
+			status.DomainName)
+	}
+	agent, ok := hyper.Task(&status).(hypervisor.GuestAgent)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support a guest agent for domain %s",
+			hyper.Name(), status.DomainName)
+	}
+	return agent, nil
+}
+
+// pingGuestAgent checks that the guest agent of the domain responds
+func pingGuestAgent(status types.DomainStatus, timeout time.Duration) error {
+	agent, err := guestAgent(status)
+	if err != nil {
+		return err
+	}
+	return agent.GuestPing(status.DomainName, timeout)
+}
+
+// tick thaws the filesystems of the guest if needed, syncs its clock when
+// the host clock jumped, and starts a query of the guest agent when it is
+// due. Returns true if the status changed.
+func (state *guestAgentState) tick(config types.DomainConfig, status *types.DomainStatus) bool {
+	changed := false
+	now := time.Now()
+	// Round(0) strips the monotonic clock reading, which does not advance
+	// while the host is suspended
+	jump := now.Round(0).Sub(state.lastTick.Round(0)) - now.Sub(state.lastTick)
+	state.lastTick = now
+
+	running := status.EnableGuestAgent && status.Activated &&
+		status.State == types.RUNNING
+	if status.GuestFsFrozen {
+		switch {
+		case !running:
+			status.GuestFsFrozen = false
+			status.GuestFsFrozenUntil = time.Time{}
+			changed = true
+		case !config.GuestFsFreeze:
+			// an earlier thaw failed
+			changed = thawGuestFs(status)
+		case !now.Before(status.GuestFsFrozenUntil):
+			log.Errorf("tick(%s) filesystems frozen for %v, thawing",
+				status.Key(), guestFsFreezeMax)
+			changed = thawGuestFs(status)
+		}
+	}
+	if !state.bootTime.Equal(status.BootTime) {
+		state.bootTime = status.BootTime
+		state.lastQuery = time.Time{}
+	}
+	if !running {
+		if !reflect.DeepEqual(status.GuestInfo, types.GuestInfo{}) {
+			status.GuestInfo = types.GuestInfo{}
+			changed = true
+		}
+		return changed
+	}
+	if jump > clockJumpThreshold || jump < -clockJumpThreshold {
+		log.Noticef("tick(%s) host clock jumped by %v, setting the guest clock",
+			status.Key(), jump)
+		go setGuestTime(*status)
+	}
+	if !state.querying && now.Sub(state.lastQuery) >= guestInfoInterval {
+		state.querying = true
+		state.lastQuery = now
+		go state.query(*status)
+	}
+	return changed
+}
+
+func (state *guestAgentState) query(status types.DomainStatus) {
+	result := guestInfoResult{bootTime: status.BootTime}
+	agent, err := guestAgent(status)
+	if err == nil {
+		result.info, err = agent.GuestInfo(status.DomainName)
+	}
+	result.err = err
+	state.infoResults <- result
+}
+
+// record updates the guest info with the result of a query.
+// Returns true if the status changed.
+func (state *guestAgentState) record(status *types.DomainStatus,
+	result guestInfoResult) bool {
+
+	state.querying = false
+	if !result.bootTime.Equal(state.bootTime) {
+		// the domain rebooted during the query
+		return false
+	}
+	info := result.info
+	if result.err != nil {
+		log.Functionf("record(%s) guest agent query failed: %v",
+			status.Key(), result.err)
+		// keep what the agent reported before
+		info = status.GuestInfo
+		info.Connected = false
+	}
+	if reflect.DeepEqual(status.GuestInfo, info) {
+		return false
+	}
+	status.GuestInfo = info
+	return true
+}
+
+// syncFsFreeze freezes or thaws the filesystems of the guest when
+// zedmanager changed the GuestFsFreeze of the DomainConfig. The freeze
+// runs in the background, GuestFsFreeze of the DomainStatus is updated
+// when it is done (see recordFreeze).
+// Returns true if the status changed.
+func (state *guestAgentState) syncFsFreeze(config types.DomainConfig,
+	status *types.DomainStatus) bool {
+
+	if config.GuestFsFreeze == status.GuestFsFreeze || state.freezing {
+		return false
+	}
+	if !config.GuestFsFreeze {
+		status.GuestFsFreeze = false
+		if status.GuestFsFrozen {
+			thawGuestFs(status)
+		}
+		return true
+	}
+	state.freezing = true
+	go state.freeze(*status)
+	return false
+}
+
+func (state *guestAgentState) freeze(status types.DomainStatus) {
+	agent, err := guestAgent(status)
+	if err == nil {
+		err = agent.GuestFsFreeze(status.DomainName)
+	}
+	state.freezeResults <- err
+}
+
+// recordFreeze updates the status with the result of a freeze.
+// Returns true if the status changed.
+func (state *guestAgentState) recordFreeze(status *types.DomainStatus,
+	err error) bool {
+
+	state.freezing = false
+	status.GuestFsFreeze = true
+	if err != nil {
+		log.Errorf("recordFreeze(%s) freeze failed: %v", status.Key(), err)
+		status.GuestFsFrozen = false
+		return true
+	}
+	log.Noticef("recordFreeze(%s) filesystems frozen", status.Key())
+	status.GuestFsFrozen = true
+	status.GuestFsFrozenUntil = time.Now().Add(guestFsFreezeMax)
+	return true
+}
+
+// thawGuestFs thaws the filesystems of the guest.
+// Returns true if it succeeded.
+func thawGuestFs(status *types.DomainStatus) bool {
+	agent, err := guestAgent(*status)
+	if err == nil {
+		err = agent.GuestFsThaw(status.DomainName)
+	}
+	if err != nil {
+		log.Errorf("thawGuestFs(%s) failed: %v", status.Key(), err)
+		return false
+	}
+	log.Noticef("thawGuestFs(%s) filesystems thawed", status.Key())
+	status.GuestFsFrozen = false
+	status.GuestFsFrozenUntil = time.Time{}
+	return true
+}
+
+func setGuestTime(status types.DomainStatus) {
+	agent, err := guestAgent(status)
+	if err == nil {
+		err = agent.GuestSetTime(status.DomainName, time.Now())
+	}
+	if err != nil {
+		log.Warnf("setGuestTime(%s) failed: %v", status.Key(), err)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Health probes of the app instances, run from the host by the runHandler
+// of each domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// probeTickInterval is how often the runHandler checks if the probes are due
+const probeTickInterval = time.Second
+
+var (
+	// containerd client for the exec probes, created on first use
+	probeCtrdClient     *containerd.Client
+	probeCtrdClientErr  error
+	probeCtrdClientOnce sync.Once
+)
+
+// appProbeRun holds the results of a run of the probes of a domain
+type appProbeRun struct {
+	bootTime  time.Time
+	liveness  *types.AppProbeResult
+	readiness *types.AppProbeResult
+}
+
+// appProber runs the probes of a domain in the background and keeps their
+// history, which is published in the DomainStatus when their state changes
+type appProber struct {
+	results   chan appProbeRun
+	running   bool
+	lastRun   time.Time
+	bootTime  time.Time
+	liveness  types.AppProbeStatus
+	readiness types.AppProbeStatus
+}
+
+func newAppProber() *appProber {
+	return &appProber{results: make(chan appProbeRun, 1)}
+}
+
+// tick resets the probes when the domain boots or the probes change, and
+// starts a run of the probes when it is due.
+// Returns true if the status changed.
+func (prober *appProber) tick(config types.DomainConfig, status *types.DomainStatus) bool {
+	changed := false
+	healthConfig := config.HealthConfig
+	if probe := healthConfig.Liveness.String(); prober.liveness.Probe != probe {
+		prober.liveness = types.AppProbeStatus{Probe: probe}
+		changed = true
+	}
+	if probe := healthConfig.Readiness.String(); prober.readiness.Probe != probe {
+		prober.readiness = types.AppProbeStatus{Probe: probe}
+		changed = true
+	}
+	if !prober.bootTime.Equal(status.BootTime) {
+		prober.bootTime = status.BootTime
+		prober.liveness.Reset()
+		prober.readiness.Reset()
+		changed = true
+	}
+	if changed {
+		status.Liveness = prober.liveness
+		status.Readiness = prober.readiness
+	}
+	if prober.running || !healthConfig.HasProbes() ||
+		!status.Activated || status.State != types.RUNNING {
+		return changed
+	}
+	now := time.Now()
+	if now.Before(status.BootTime.Add(healthConfig.InitialDelay)) ||
+		now.Sub(prober.lastRun) < healthConfig.ProbePeriod() {
+		return changed
+	}
+	prober.running = true
+	prober.lastRun = now
+	go prober.run(healthConfig, *status)
+	return changed
+}
+
+func (prober *appProber) run(healthConfig types.AppHealthConfig, status types.DomainStatus) {
+	run := appProbeRun{bootTime: status.BootTime}
+	timeout := healthConfig.ProbeTimeout()
+	if healthConfig.Liveness.Type != types.AppProbeNone {
+		result := runAppProbe(healthConfig.Liveness, status, timeout)
+		run.liveness = &result
+	}
+	if healthConfig.Readiness.Type != types.AppProbeNone {
+		result := runAppProbe(healthConfig.Readiness, status, timeout)
+		run.readiness = &result
+	}
+	prober.results <- run
+}
+
+// record adds the results of a run of the probes.
+// Returns true if the status changed.
+func (prober *appProber) record(config types.DomainConfig, status *types.DomainStatus,
+	run appProbeRun) bool {
+
+	prober.running = false
+	if !run.bootTime.Equal(prober.bootTime) {
+		// the domain rebooted while the probes were running
+		return false
+	}
+	threshold := config.HealthConfig.ProbeFailureThreshold()
+	changed := false
+	if run.liveness != nil && prober.liveness.Probe != "" {
+		prober.liveness.Record(*run.liveness, threshold)
+		if probeStateChanged(status.Liveness, prober.liveness, threshold) {
+			changed = true
+		}
+	}
+	if run.readiness != nil && prober.readiness.Probe != "" {
+		prober.readiness.Record(*run.readiness, threshold)
+		if probeStateChanged(status.Readiness, prober.readiness, threshold) {
+			changed = true
+		}
+	}
+	if changed {
+		status.Liveness = prober.liveness
+		status.Readiness = prober.readiness
+	}
+	return changed
+}
+
+// probeStateChanged returns true if the new state of the probe is worth
+// publishing. Consecutive successes, and failures beyond the threshold,
+// only add to the history.
+func probeStateChanged(old, cur types.AppProbeStatus, threshold int) bool {
+	if old.Passing != cur.Passing || old.Failed != cur.Failed {
+		return true
+	}
+	return old.ConsecutiveFailures != cur.ConsecutiveFailures &&
+		cur.ConsecutiveFailures <= threshold
+}
+
+func runAppProbe(probe types.AppProbe, status types.DomainStatus,
+	timeout time.Duration) types.AppProbeResult {
+
+	err := doAppProbe(probe, status, timeout)
+	result := types.AppProbeResult{Time: time.Now(), Success: err == nil}
+	if err != nil {
+		log.Functionf("runAppProbe(%s) %s failed: %v",
+			status.Key(), probe, err)
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func doAppProbe(probe types.AppProbe, status types.DomainStatus,
+	timeout time.Duration) error {
+
+	hostPort := net.JoinHostPort(probe.Host, strconv.Itoa(int(probe.Port)))
+	switch probe.Type {
+	case types.AppProbeHTTP:
+		if probe.Host == "" {
+			return errors.New("no IP address of the app instance")
+		}
+		client := http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{DisableKeepAlives: true},
+			// a redirect is a success
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		resp, err := client.Get("http://" + hostPort + probe.Path)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("HTTP status %s", resp.Status)
+		}
+		return nil
+	case types.AppProbeTCP:
+		if probe.Host == "" {
+			return errors.New("no IP address of the app instance")
+		}
+		conn, err := net.DialTimeout("tcp", hostPort, timeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case types.AppProbeExec:
+		return execAppProbe(probe, status, timeout)
+	case types.AppProbeAgent:
+		return pingGuestAgent(status, timeout)
+	default:
+		return fmt.Errorf("unsupported probe type %s", probe.Type)
+	}
+}
+
+// execAppProbe runs the command of the probe inside the container task,
+// which is only reachable for containers run without a hypervisor
+func execAppProbe(probe types.AppProbe, status types.DomainStatus,
+	timeout time.Duration) error {
+
+	if status.VirtualizationMode != types.NOHYPER {
+		return errors.New("exec probes require a container run without a hypervisor")
+	}
+	probeCtrdClientOnce.Do(func() {
+		probeCtrdClient, probeCtrdClientErr = containerd.NewContainerdClient(false)
+	})
+	if probeCtrdClientErr != nil {
+		return probeCtrdClientErr
+	}
+	ctrdCtx, done := probeCtrdClient.CtrNewUserServicesCtx()
+	defer done()
+	ctrdCtx, cancel := context.WithTimeout(ctrdCtx, timeout)
+	defer cancel()
+	_, stdErr, err := probeCtrdClient.CtrExec(ctrdCtx, status.DomainName, probe.Command)
+	if err != nil {
+		if stdErr = strings.TrimSpace(stdErr); stdErr != "" {
+			return fmt.Errorf("%v: %s", err, stdErr)
+		}
+		return err
+	}
+	return nil
+}
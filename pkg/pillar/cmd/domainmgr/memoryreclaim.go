@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Host-pressure policy which lowers the balloon targets of idle domains
+// while the host is low on memory. The metrics timer task evaluates it,
+// and the runHandler of each domain applies the targets.
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// reclaimIdleCPUPercent is the CPU usage of its vCPUs below which
+	// a domain is idle
+	reclaimIdleCPUPercent = 5
+	// reclaimHysteresisPercent is how far above the threshold the free
+	// memory of the host has to be for the domains to get their memory back
+	reclaimHysteresisPercent = 10
+	// reclaimMinPercent is the lowest balloon target, in percent of the
+	// memory of the domain
+	reclaimMinPercent = 50
+	// reclaimHeadroomPercent is added to the memory the domain uses
+	reclaimHeadroomPercent = 25
+)
+
+// reclaimCandidate is a running domain which has a balloon
+type reclaimCandidate struct {
+	key    string
+	memory int // in kbytes
+	metric types.DomainMetric
+}
+
+type cpuSample struct {
+	cpuTotalNs uint64
+	time       time.Time
+}
+
+// memoryReclaim holds the balloon targets set by the policy
+type memoryReclaim struct {
+	sync.Mutex
+	// targets are in kbytes, by domain key
+	targets map[string]int
+	// samples are the previous CPU usage of the domains, to tell the
+	// idle ones
+	samples map[string]cpuSample
+}
+
+// target returns the balloon target set by the policy for the domain,
+// or 0 if its memory is not reclaimed
+func (reclaim *memoryReclaim) target(key string) int {
+	reclaim.Lock()
+	defer reclaim.Unlock()
+	return reclaim.targets[key]
+}
+
+// update evaluates the policy with the latest metrics. Memory is reclaimed
+// from the idle domains once the free memory of the host is below
+// thresholdPercent, and given back once it recovers or the domain is
+// busy again. A thresholdPercent of 0 disables the policy.
+func (reclaim *memoryReclaim) update(thresholdPercent uint32, hm types.HostMemory,
+	candidates []reclaimCandidate, now time.Time) {
+
+	reclaim.Lock()
+	defer reclaim.Unlock()
+	samples := make(map[string]cpuSample)
+	idle := make(map[string]bool)
+	for _, c := range candidates {
+		prev, ok := reclaim.samples[c.key]
+		if ok && c.metric.CPUTotalNs >= prev.cpuTotalNs && now.After(prev.time) {
+			busy := float64(c.metric.CPUTotalNs-prev.cpuTotalNs) /
+				float64(now.Sub(prev.time))
+			idle[c.key] = busy*100 < reclaimIdleCPUPercent
+		}
+		samples[c.key] = cpuSample{cpuTotalNs: c.metric.CPUTotalNs, time: now}
+	}
+	reclaim.samples = samples
+
+	targets := make(map[string]int)
+	if thresholdPercent != 0 && hm.TotalMemoryMB != 0 {
+		freePercent := hm.FreeMemoryMB * 100 / hm.TotalMemoryMB
+		low := freePercent < uint64(thresholdPercent)
+		recovered := freePercent >= uint64(thresholdPercent+reclaimHysteresisPercent)
+		for _, c := range candidates {
+			if !idle[c.key] || recovered {
+				continue
+			}
+			if target, ok := reclaim.targets[c.key]; ok {
+				targets[c.key] = target
+			} else if target := reclaimTarget(c); low && target < c.memory {
+				log.Noticef("memoryReclaim: host has %d of %d MB free, lowering the balloon target of idle %s to %d kB",
+					hm.FreeMemoryMB, hm.TotalMemoryMB, c.key, target)
+				targets[c.key] = target
+			}
+		}
+	}
+	for key := range reclaim.targets {
+		if _, ok := targets[key]; !ok {
+			log.Noticef("memoryReclaim: giving the memory back to %s", key)
+		}
+	}
+	reclaim.targets = targets
+}
+
+// reclaimTarget returns the balloon target for an idle domain, which
+// leaves it some headroom above the memory it uses
+func reclaimTarget(c reclaimCandidate) int {
+	used := int(c.metric.UsedMemory) << 10
+	target := used + used*reclaimHeadroomPercent/100
+	if min := c.memory * reclaimMinPercent / 100; target < min {
+		target = min
+	}
+	if target > c.memory {
+		target = c.memory
+	}
+	return target
+}
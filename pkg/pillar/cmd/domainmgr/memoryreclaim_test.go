@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryReclaim(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	const gb = 1024 * 1024
+	candidates := func(idleNs, busyNs uint64) []reclaimCandidate {
+		return []reclaimCandidate{
+			{key: "idle", memory: 4 * gb, metric: types.DomainMetric{
+				CPUTotalNs: idleNs, UsedMemory: 1024}},
+			{key: "busy", memory: 4 * gb, metric: types.DomainMetric{
+				CPUTotalNs: busyNs, UsedMemory: 512}},
+		}
+	}
+	low := types.HostMemory{TotalMemoryMB: 16384, FreeMemoryMB: 1024}
+	ok := types.HostMemory{TotalMemoryMB: 16384, FreeMemoryMB: 3072}
+	recovered := types.HostMemory{TotalMemoryMB: 16384, FreeMemoryMB: 8192}
+	var reclaim memoryReclaim
+	now := time.Now()
+
+	// nothing is known about the CPU usage of the domains yet
+	reclaim.update(10, low, candidates(0, 0), now)
+	assert.Equal(t, 0, reclaim.target("idle"))
+
+	// the idle domain keeps half of its memory, and the busy one all
+	now = now.Add(time.Minute)
+	reclaim.update(10, low, candidates(uint64(time.Second), uint64(30*time.Second)), now)
+	assert.Equal(t, 2*gb, reclaim.target("idle"))
+	assert.Equal(t, 0, reclaim.target("busy"))
+
+	// the target stays until the host recovers beyond the hysteresis
+	now = now.Add(time.Minute)
+	reclaim.update(10, ok, candidates(uint64(2*time.Second), uint64(60*time.Second)), now)
+	assert.Equal(t, 2*gb, reclaim.target("idle"))
+	now = now.Add(time.Minute)
+	reclaim.update(10, recovered, candidates(uint64(3*time.Second), uint64(90*time.Second)), now)
+	assert.Equal(t, 0, reclaim.target("idle"))
+
+	// the memory is given back as soon as the domain is busy again
+	now = now.Add(time.Minute)
+	reclaim.update(10, low, candidates(uint64(4*time.Second), uint64(120*time.Second)), now)
+	assert.Equal(t, 2*gb, reclaim.target("idle"))
+	now = now.Add(time.Minute)
+	reclaim.update(10, low, candidates(uint64(34*time.Second), uint64(150*time.Second)), now)
+	assert.Equal(t, 0, reclaim.target("idle"))
+
+	// the policy is disabled with a threshold of 0
+	now = now.Add(time.Minute)
+	reclaim.update(0, low, candidates(uint64(35*time.Second), uint64(180*time.Second)), now)
+	assert.Equal(t, 0, reclaim.target("idle"))
+}
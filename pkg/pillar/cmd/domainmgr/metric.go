@@ -99,6 +99,7 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 		return
 	}
 	now := time.Now()
+	var reclaimCandidates []reclaimCandidate
 	for domainName, dm := range dmList {
 		uuid, version, _, err := types.DomainnameToUUID(domainName)
 		if err != nil {
@@ -127,6 +128,14 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 			}
 			// XXX remove - this does not include qemu overhead
 			// dm.AllocatedMB = uint32((status.Memory + 1023) / 1024)
+			if status.Activated && status.State == types.RUNNING &&
+				status.MaxMem != 0 && status.VirtualizationMode != types.NOHYPER {
+				reclaimCandidates = append(reclaimCandidates, reclaimCandidate{
+					key:    status.Key(),
+					memory: status.Memory,
+					metric: dm,
+				})
+			}
 		} else if dm.UUIDandVersion.UUID == nilUUID && hm.Ncpus != 0 {
 			// Scale Xen Dom0 based CPUs seen by hypervisor
 			dm.CPUTotalNs /= uint64(hm.Ncpus)
@@ -167,6 +176,7 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 		dm.UsedMemoryPercent = 0
 		ctx.pubDomainMetric.Publish(dm.Key(), dm)
 	}
+	ctx.memoryReclaim.update(ctx.memoryReclaimPercent, hm, reclaimCandidates, now)
 	if hyper.Name() != "xen" {
 		// the the hypervisor other than Xen, we don't have the Dom0 stats in dmList. Get the host
 		// cpu and memory for the device here
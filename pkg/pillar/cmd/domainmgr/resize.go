@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Changes of the memory and vCPUs of running domains, which the runHandler
+// of each domain applies without a restart
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// resizeRetryInterval is how long a failed resize is not retried, unless
+// the requested memory or vCPUs change
+const resizeRetryInterval = time.Minute
+
+// domainResizer tracks the balloon target and the vCPUs of a domain
+type domainResizer struct {
+	bootTime time.Time
+	// balloon is the balloon target set since the boot, 0 if none was
+	balloon int
+	// retryAt is when the resize to failedBalloon and failedVCpus
+	// is tried again
+	retryAt       time.Time
+	failedBalloon int
+	failedVCpus   int
+}
+
+func newDomainResizer() *domainResizer {
+	return &domainResizer{}
+}
+
+// tick sets the balloon target and the vCPUs of the running domain to
+// those of the DomainConfig, less the memory reclaimed from the domain
+// while the host is low on memory. A domain which balloons boots with
+// MaxMem, hence its balloon target is set after every boot.
+// Returns true if the status changed.
+func (resizer *domainResizer) tick(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) bool {
+
+	if !status.Activated || status.State != types.RUNNING {
+		changed := status.BalloonTarget != 0 || status.ResizeError != ""
+		status.BalloonTarget = 0
+		status.ResizeError = ""
+		return changed
+	}
+	if !resizer.bootTime.Equal(status.BootTime) {
+		resizer.bootTime = status.BootTime
+		resizer.balloon = 0
+		resizer.retryAt = time.Time{}
+	}
+	if status.VirtualizationMode == types.NOHYPER ||
+		!status.VmConfig.ResizableTo(config.VmConfig) {
+		// containers are not resized, and other changes wait for
+		// zedmanager to restart the domain
+		return false
+	}
+	balloon := 0
+	if config.MaxMem != 0 {
+		balloon = config.Memory
+		reclaimed := ctx.memoryReclaim.target(status.Key())
+		if reclaimed != 0 && reclaimed < balloon {
+			balloon = reclaimed
+		}
+	}
+	if balloon == resizer.balloon && config.VCpus == status.VCpus {
+		return false
+	}
+	now := time.Now()
+	if now.Before(resizer.retryAt) && balloon == resizer.failedBalloon &&
+		config.VCpus == resizer.failedVCpus {
+		return false
+	}
+
+	changed := false
+	var errs []string
+	task := hyper.Task(status)
+	if balloon != resizer.balloon {
+		if err := task.SetMemory(status.DomainName, balloon); err != nil {
+			log.Errorf("resize(%s) setting the balloon target to %d kB failed: %v",
+				status.Key(), balloon, err)
+			errs = append(errs, err.Error())
+		} else {
+			log.Noticef("resize(%s) balloon target set to %d kB, memory %d kB",
+				status.Key(), balloon, config.Memory)
+			resizer.balloon = balloon
+			status.Memory = config.Memory
+			status.BalloonTarget = balloon
+			changed = true
+		}
+	}
+	if config.VCpus != status.VCpus {
+		if err := task.SetVCpus(status.DomainName, config.VCpus); err != nil {
+			log.Errorf("resize(%s) changing the vCPUs from %d to %d failed: %v",
+				status.Key(), status.VCpus, config.VCpus, err)
+			errs = append(errs, err.Error())
+		} else {
+			log.Noticef("resize(%s) vCPUs changed from %d to %d",
+				status.Key(), status.VCpus, config.VCpus)
+			status.VCpus = config.VCpus
+			changed = true
+		}
+	}
+	resizeError := strings.Join(errs, "; ")
+	if resizeError != "" {
+		resizer.retryAt = now.Add(resizeRetryInterval)
+		resizer.failedBalloon = balloon
+		resizer.failedVCpus = config.VCpus
+	}
+	if status.ResizeError != resizeError {
+		status.ResizeError = resizeError
+		changed = true
+	}
+	return changed
+}
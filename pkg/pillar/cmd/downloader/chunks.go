@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Index of the chunks of estargz and zstd:chunked layers in CAS, so that
+// only the chunks of a new layer which are not present locally are
+// downloaded from the registry.
+
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
+	zedUploadTypes "github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+)
+
+// smaller blobs are neither indexed nor downloaded in chunks
+const minChunkedBlobSize = 1024 * 1024
+
+type chunkLocation struct {
+	blob   string
+	offset int64
+	size   int64
+}
+
+// chunkIndex implements ChunkSource of zedUpload for the blobs in CAS
+type chunkIndex struct {
+	sync.Mutex
+	blobs blobSource
+	// chunks of the indexed blobs, nil for the blobs which are not chunked
+	byBlob map[string][]zedUploadTypes.Chunk
+	byKey  map[string]chunkLocation
+}
+
+func newChunkIndex() *chunkIndex {
+	return &chunkIndex{
+		byBlob: make(map[string][]zedUploadTypes.Chunk),
+		byKey:  make(map[string]chunkLocation),
+	}
+}
+
+// refresh indexes the blobs added to CAS since the last call and forgets
+// the removed ones. Returns true if any chunks are available.
+func (c *chunkIndex) refresh() bool {
+	c.Lock()
+	defer c.Unlock()
+	if c.blobs == nil {
+		blobs, err := cas.NewCAS(casClientType)
+		if err != nil {
+			log.Errorf("chunkIndex: failed to open CAS: %v", err)
+			return false
+		}
+		c.blobs = blobs
+	}
+	infos, err := c.blobs.ListBlobInfo()
+	if err != nil {
+		log.Errorf("chunkIndex: failed to list blobs: %v", err)
+		return false
+	}
+	present := make(map[string]bool)
+	changed := false
+	for _, info := range infos {
+		present[info.Digest] = true
+		if _, ok := c.byBlob[info.Digest]; ok {
+			continue
+		}
+		var chunks []zedUploadTypes.Chunk
+		if info.Size >= minChunkedBlobSize {
+			chunks, err = c.index(info)
+			if err != nil {
+				log.Warnf("chunkIndex: failed to index %s: %v", info.Digest, err)
+				continue
+			}
+		}
+		c.byBlob[info.Digest] = chunks
+		changed = changed || len(chunks) > 0
+	}
+	for digest, chunks := range c.byBlob {
+		if !present[digest] {
+			delete(c.byBlob, digest)
+			changed = changed || len(chunks) > 0
+		}
+	}
+	if changed {
+		c.byKey = make(map[string]chunkLocation)
+		for digest, chunks := range c.byBlob {
+			for _, chunk := range chunks {
+				if chunk.Key != "" {
+					c.byKey[chunk.Key] = chunkLocation{
+						blob:   digest,
+						offset: chunk.Offset,
+						size:   chunk.Size,
+					}
+				}
+			}
+		}
+		log.Functionf("chunkIndex: %d chunks available", len(c.byKey))
+	}
+	return len(c.byKey) > 0
+}
+
+// index returns the chunks of the blob, nil if it is not chunked
+func (c *chunkIndex) index(info *cas.BlobInfo) ([]zedUploadTypes.Chunk, error) {
+	ctrdCtx, done := c.blobs.CtrNewUserServicesCtx()
+	defer done()
+	reader, err := c.blobs.ReadBlob(ctrdCtx, info.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	readerAt, ok := reader.(io.ReaderAt)
+	if !ok {
+		return nil, nil
+	}
+	chunks, err := ociutil.ChunkIndex(readerAt, info.Size)
+	if errors.Is(err, ociutil.ErrNotChunked) {
+		return nil, nil
+	}
+	return chunks, err
+}
+
+// HasChunk returns true if a blob in CAS has the chunk
+func (c *chunkIndex) HasChunk(key string, size int64) bool {
+	c.Lock()
+	defer c.Unlock()
+	loc, ok := c.byKey[key]
+	return ok && loc.size == size
+}
+
+// ReadChunk opens the chunk in the blob which has it
+func (c *chunkIndex) ReadChunk(key string, size int64) (io.ReadCloser, error) {
+	c.Lock()
+	loc, ok := c.byKey[key]
+	blobs := c.blobs
+	c.Unlock()
+	if !ok || loc.size != size {
+		return nil, fmt.Errorf("chunk %s of size %d not available", key, size)
+	}
+	ctrdCtx, done := blobs.CtrNewUserServicesCtx()
+	reader, err := blobs.ReadBlob(ctrdCtx, loc.blob)
+	if err != nil {
+		done()
+		return nil, err
+	}
+	readerAt, ok := reader.(io.ReaderAt)
+	if !ok {
+		if closer, ok := reader.(io.Closer); ok {
+			closer.Close()
+		}
+		done()
+		return nil, fmt.Errorf("blob %s does not support random access", loc.blob)
+	}
+	return &chunkReader{
+		SectionReader: io.NewSectionReader(readerAt, loc.offset, loc.size),
+		blob:          reader,
+		done:          done,
+	}, nil
+}
+
+type chunkReader struct {
+	*io.SectionReader
+	blob io.Reader
+	done context.CancelFunc
+}
+
+func (r *chunkReader) Close() error {
+	var err error
+	if closer, ok := r.blob.(io.Closer); ok {
+		err = closer.Close()
+	}
+	r.done()
+	return err
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/containerd/stargz-snapshotter/estargz"
+	"github.com/containerd/stargz-snapshotter/estargz/zstdchunked"
+	"github.com/klauspost/compress/zstd"
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
+)
+
+type zstdCompression struct {
+	*zstdchunked.Compressor
+	*zstdchunked.Decompressor
+}
+
+// testChunkedLayer returns a zstd:chunked layer with files of random content
+func testChunkedLayer(t *testing.T, seed int64) []byte {
+	rnd := rand.New(rand.NewSource(seed))
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range []string{"a", "b"} {
+		data := make([]byte, 768*1024)
+		rnd.Read(data)
+		hdr := &tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tarBlob := bytes.NewReader(buf.Bytes())
+	blob, err := estargz.Build(io.NewSectionReader(tarBlob, 0, tarBlob.Size()),
+		estargz.WithCompression(zstdCompression{
+			&zstdchunked.Compressor{CompressionLevel: zstd.SpeedDefault},
+			&zstdchunked.Decompressor{}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer blob.Close()
+	layer, err := ioutil.ReadAll(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return layer
+}
+
+func TestChunkIndex(t *testing.T) {
+	layer := testChunkedLayer(t, 1)
+	layerDigest := "sha256:" + testSha(layer)
+	blobs := testBlobs{
+		layerDigest:                    layer,
+		"sha256:" + testSha([]byte{1}): bytes.Repeat([]byte{1}, 2*minChunkedBlobSize),
+	}
+	c := newChunkIndex()
+	c.blobs = blobs
+	if !c.refresh() {
+		t.Fatalf("no chunks found")
+	}
+
+	chunks, err := ociutil.ChunkIndex(bytes.NewReader(layer), int64(len(layer)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := 0
+	for _, chunk := range chunks {
+		if chunk.Key == "" {
+			continue
+		}
+		if !c.HasChunk(chunk.Key, chunk.Size) {
+			t.Errorf("chunk at %d not found", chunk.Offset)
+			continue
+		}
+		if c.HasChunk(chunk.Key, chunk.Size+1) {
+			t.Errorf("chunk at %d found with a wrong size", chunk.Offset)
+		}
+		r, err := c.ReadChunk(chunk.Key, chunk.Size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, layer[chunk.Offset:chunk.Offset+chunk.Size]) {
+			t.Errorf("chunk at %d has wrong content", chunk.Offset)
+		}
+		found++
+	}
+	if found == 0 {
+		t.Errorf("no chunks with keys in %v", chunks)
+	}
+
+	// chunks of removed blobs are forgotten
+	delete(blobs, layerDigest)
+	if c.refresh() {
+		t.Errorf("chunks of a removed blob found")
+	}
+}
@@ -32,6 +32,9 @@ type downloaderContext struct {
 	cipherMetrics            *cipher.AgentMetrics
 	GCInitialized            bool
 	downloadMaxPortCost      uint8
+	p2pKey                   string
+	p2p                      *p2pContext
+	chunks                   *chunkIndex
 	// cli options
 	versionPtr *bool
 }
@@ -33,6 +33,9 @@ func handleDNSImpl(ctxArg interface{}, key string,
 		return
 	}
 	ctx.deviceNetworkStatus = status
+	if ctx.dCtx != nil {
+		updateP2P(ctx)
+	}
 	log.Functionf("handleDNSImpl done for %s", key)
 }
 
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/lf-edge/eve/libs/zedUpload"
@@ -60,8 +61,8 @@ func saveDownloadedParts(locFilename string, downloadedParts types.DownloadedPar
 func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	status Status, syncOp zedUpload.SyncOpType, downloadURL string,
 	auth *zedUpload.AuthInput, dpath, region string, maxsize uint64, ifname string,
-	ipSrc net.IP, filename, locFilename string, certs [][]byte,
-	receiveChan chan<- CancelChannel) (string, bool, error) {
+	ipSrc net.IP, filename, locFilename, imageSha256 string, certs [][]byte,
+	chunks types.ChunkSource, receiveChan chan<- CancelChannel) (string, bool, error) {
 
 	// create Endpoint
 	var dEndPoint zedUpload.DronaEndPoint
@@ -70,6 +71,8 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	switch trType {
 	case zedUpload.SyncHttpTr, zedUpload.SyncSftpTr:
 		dEndPoint, err = ctx.dCtx.NewSyncerDest(trType, downloadURL, dpath, auth)
+	case zedUpload.SyncNFSTr, zedUpload.SyncSMBTr:
+		dEndPoint, err = ctx.dCtx.NewSyncerDest(trType, downloadURL, dpath, auth)
 	case zedUpload.SyncAzureTr:
 		dEndPoint, err = ctx.dCtx.NewSyncerDest(trType, downloadURL, dpath, auth)
 	case zedUpload.SyncAwsTr:
@@ -86,33 +89,43 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 		log.Errorf("NewSyncerDest failed: %s", err)
 		return "", cancel, err
 	}
-	// check for proxies on the selected management port interface
-	proxyLookupURL := zedcloud.IntfLookupProxyCfg(log, &ctx.deviceNetworkStatus, ifname, downloadURL, trType)
-	proxyURL, err := zedcloud.LookupProxy(log, &ctx.deviceNetworkStatus, ifname, proxyLookupURL)
-	if err == nil {
-		if proxyURL != nil {
-			log.Functionf("%s: Using proxy %s", trType, proxyURL.String())
-			if len(certs) > 0 {
-				log.Functionf("%s: Set server certs", trType)
-				err = dEndPoint.WithSrcIPAndProxyAndHTTPSCerts(ipSrc, proxyURL, certs)
+	switch trType {
+	case zedUpload.SyncNFSTr, zedUpload.SyncSMBTr:
+		// Network file system clients connect directly from the source
+		// address, proxies and datastore certificates do not apply.
+		if err = dEndPoint.WithSrcIPSelection(ipSrc); err != nil {
+			log.Errorf("Set source IP failed: %s", err)
+			return "", cancel, err
+		}
+	default:
+		// check for proxies on the selected management port interface
+		proxyLookupURL := zedcloud.IntfLookupProxyCfg(log, &ctx.deviceNetworkStatus, ifname, downloadURL, trType)
+		proxyURL, err := zedcloud.LookupProxy(log, &ctx.deviceNetworkStatus, ifname, proxyLookupURL)
+		if err == nil {
+			if proxyURL != nil {
+				log.Functionf("%s: Using proxy %s", trType, proxyURL.String())
+				if len(certs) > 0 {
+					log.Functionf("%s: Set server certs", trType)
+					err = dEndPoint.WithSrcIPAndProxyAndHTTPSCerts(ipSrc, proxyURL, certs)
+				} else {
+					err = dEndPoint.WithSrcIPAndProxySelection(ipSrc, proxyURL)
+				}
 			} else {
-				err = dEndPoint.WithSrcIPAndProxySelection(ipSrc, proxyURL)
+				if len(certs) > 0 {
+					log.Functionf("%s: Set server certs", trType)
+					err = dEndPoint.WithSrcIPAndHTTPSCerts(ipSrc, certs)
+				} else {
+					err = dEndPoint.WithSrcIPSelection(ipSrc)
+				}
 			}
-		} else {
-			if len(certs) > 0 {
-				log.Functionf("%s: Set server certs", trType)
-				err = dEndPoint.WithSrcIPAndHTTPSCerts(ipSrc, certs)
-			} else {
-				err = dEndPoint.WithSrcIPSelection(ipSrc)
+			if err != nil {
+				log.Errorf("Set source IP failed: %s", err)
+				return "", cancel, err
 			}
-		}
-		if err != nil {
-			log.Errorf("Set source IP failed: %s", err)
+		} else {
+			log.Errorf("Lookup Proxy failed: %s", err)
 			return "", cancel, err
 		}
-	} else {
-		log.Errorf("Lookup Proxy failed: %s", err)
-		return "", cancel, err
 	}
 
 	var respChan = make(chan *zedUpload.DronaRequest)
@@ -124,6 +137,12 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 
 	downloadedParts := loadDownloadedParts(locFilename)
 	downloadedPartsHash := downloadedParts.Hash()
+	resumedOffset := downloadedParts.Size()
+	if resumedOffset > 0 {
+		log.Noticef("Resuming download of %s from %d", locFilename, resumedOffset)
+	}
+	status.Resumed(resumedOffset)
+	status.Reused(0)
 
 	// create Request
 	req := dEndPoint.NewRequest(syncOp, filename, locFilename,
@@ -132,6 +151,9 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 		return "", cancel, errors.New("NewRequest failed")
 	}
 	req = req.WithDoneParts(downloadedParts)
+	if chunks != nil {
+		req = req.WithChunkSource(chunks)
+	}
 	req = req.WithCancel(context.Background())
 	defer req.Cancel()
 	req = req.WithLogger(logger)
@@ -166,6 +188,11 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 			downloadedPartsHash = newDownloadedPartsHash
 			downloadedParts = newDownloadedParts
 			saveDownloadedParts(locFilename, downloadedParts)
+			if downloadedParts.Size() < resumedOffset {
+				// the transport could not continue from the stored checkpoint
+				resumedOffset = 0
+				status.Resumed(resumedOffset)
+			}
 		}
 
 		if resp.IsDnUpdate() {
@@ -206,8 +233,24 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 		if resp.IsError() {
 			return "", cancel, err
 		}
+		// The transport may compute the hash while downloading. Check it now,
+		// otherwise a corrupted resumed download would be resumed over and over.
+		if sha := resp.GetSha256(); sha != "" && imageSha256 != "" &&
+			!strings.EqualFold(sha, imageSha256) {
+			err = fmt.Errorf("downloaded %s has sha256 %s, expected %s",
+				resp.GetLocalName(), sha, imageSha256)
+			log.Error(err)
+			if rmErr := os.RemoveAll(locFilename + progressFileSuffix); rmErr != nil {
+				log.Errorf("failed to remove progress file: %s", rmErr)
+			}
+			if rmErr := os.RemoveAll(locFilename); rmErr != nil {
+				log.Errorf("failed to remove %s: %s", locFilename, rmErr)
+			}
+			return "", cancel, err
+		}
 		log.Functionf("Done for %v size %d",
 			resp.GetLocalName(), resp.GetAsize())
+		status.Reused(resp.GetReusedSize())
 		return req.GetContentType(), cancel, nil
 	}
 	// if we got here, channel was closed
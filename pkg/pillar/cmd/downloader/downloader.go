@@ -54,6 +54,8 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	ctx := downloaderContext{
 		zedcloudMetrics: zedcloud.NewAgentMetrics(),
 		cipherMetrics:   cipher.NewAgentMetrics(agentName),
+		p2p:             newP2PContext(),
+		chunks:          newChunkIndex(),
 	}
 	agentbase.Init(&ctx, logger, log, agentName,
 		agentbase.WithArguments(arguments))
@@ -148,12 +150,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus))
 
 	ctx.dCtx = downloaderInit(&ctx)
+	updateP2P(&ctx)
 
 	// run gc every 5 minutes
 	gcInterval := 5 * time.Minute
 	gcTimer := flextimer.NewRangeTicker(time.Duration(0.3*float64(gcInterval)),
 		gcInterval)
 
+	// refresh content advertised to other edge nodes every minute
+	p2pInterval := time.Minute
+	p2pTimer := flextimer.NewRangeTicker(time.Duration(0.8*float64(p2pInterval)),
+		p2pInterval)
+
 	for {
 		select {
 		case change := <-ctx.decryptCipherContext.SubControllerCert.MsgChan():
@@ -202,6 +210,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 			ps.CheckMaxTimeTopic(agentName, "gcTimer", start,
 				warningTime, errorTime)
 
+		case <-p2pTimer.C:
+			start := time.Now()
+			ctx.p2p.refresh()
+			ps.CheckMaxTimeTopic(agentName, "p2pTimer", start,
+				warningTime, errorTime)
+
 		case <-stillRunning.C:
 		}
 		ps.StillRunning(agentName, warningTime, errorTime)
@@ -39,6 +39,10 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			maxStalledTime = time.Duration(gcp.GlobalValueInt(types.DownloadStalledTime)) * time.Second
 		}
 		ctx.downloadMaxPortCost = uint8(gcp.GlobalValueInt(types.DownloadMaxPortCost))
+		ctx.p2pKey = gcp.GlobalValueString(types.DownloadP2PKey)
+		if ctx.dCtx != nil {
+			updateP2P(ctx)
+		}
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
@@ -0,0 +1,793 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Exchange of downloaded content between edge nodes on the same network.
+// Each node advertises the largest blobs from its CAS over mDNS and serves
+// them over HTTPS, so that when many nodes of a site need the same image
+// only one of them downloads it from the datastore.
+// The nodes authenticate each other using a key shared by the site
+// (network.download.p2p.key): the advertisement carries a MAC of its content
+// including the fingerprint of the self-signed server certificate, and the
+// requests carry a MAC of the requested blob. The content itself is verified
+// by its sha256 like any other download.
+
+package downloader
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grandcat/zeroconf"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	p2pVersion = "1"
+	// blobs smaller than this are not worth to fetch from peers
+	p2pMinBlobSize = 1024 * 1024
+	// number of the largest blobs advertised over mDNS, the TXT record
+	// must fit into a single mDNS packet
+	p2pMaxAdvertised = 32
+	// number of hex characters of sha256 used to advertise a blob
+	p2pPrefixLen = 16
+	// TXT strings are limited to 255 bytes
+	p2pPrefixesPerString = 14
+	// number of blobs served at the same time
+	p2pMaxUploads = 4
+	p2pBrowseTime = 3 * time.Second
+	// how often to look for the content while another node downloads it
+	p2pPollInterval = 30 * time.Second
+	// for how long to wait for another node downloading the content
+	// from the datastore, covers also its verification
+	p2pMaxWait = 15 * time.Minute
+	// cancel a download from a peer if no data arrives for this long
+	p2pInactivityTimeout = time.Minute
+	p2pBlobPath          = "/blobs/"
+	p2pAuthScheme        = "EVE-P2P "
+	p2pChunkSize         = 1024 * 1024
+	// before downloading from a datastore wait up to this long
+	// in case another node started the same download
+	p2pMaxJitter  = 20 * time.Second
+	casClientType = "containerd"
+)
+
+var errPeerBusy = errors.New("peer is busy")
+
+// blobSource is the part of cas.CAS used to serve the content
+type blobSource interface {
+	ListBlobInfo() ([]*cas.BlobInfo, error)
+	GetBlobInfo(blobHash string) (*cas.BlobInfo, error)
+	ReadBlob(ctx context.Context, blobHash string) (io.Reader, error)
+	CtrNewUserServicesCtx() (context.Context, context.CancelFunc)
+}
+
+// p2pContext keeps the state of the content exchange with other edge nodes.
+// It is used from the main loop as well as from the download handlers
+// and the HTTPS server.
+type p2pContext struct {
+	sync.Mutex
+	key         []byte
+	ifaces      []string // ports where the content is advertised
+	blobs       blobSource
+	fingerprint string // sha256 of the server certificate
+	mdns        *zeroconf.Server
+	server      *http.Server
+	advertised  []string // prefixes of blobs available in CAS
+	// downloads from datastores in progress, keyed by sha256.
+	// Completed downloads are kept until the blob appears in CAS
+	// (the value is then the time of completion).
+	downloading map[string]time.Time
+	uploads     chan struct{}
+}
+
+func newP2PContext() *p2pContext {
+	return &p2pContext{
+		downloading: make(map[string]time.Time),
+		uploads:     make(chan struct{}, p2pMaxUploads),
+	}
+}
+
+// enabled returns true if the content exchange is configured
+func (p *p2pContext) enabled() bool {
+	p.Lock()
+	defer p.Unlock()
+	return len(p.key) > 0
+}
+
+// updateP2P applies the global config and the management ports
+// to the content exchange.
+func updateP2P(ctx *downloaderContext) {
+	// content is exchanged only over free ports, the peers are expected
+	// to be on the same network
+	ifaces := types.GetMgmtPortsByCost(ctx.deviceNetworkStatus, 0)
+	ctx.p2p.update(ctx.p2pKey, ifaces)
+}
+
+func (p *p2pContext) update(key string, ifaces []string) {
+	p.Lock()
+	defer p.Unlock()
+	sort.Strings(ifaces)
+	keyChanged := string(p.key) != key
+	ifacesChanged := strings.Join(p.ifaces, ",") != strings.Join(ifaces, ",")
+	if !keyChanged && !ifacesChanged {
+		return
+	}
+	p.key = []byte(key)
+	p.ifaces = ifaces
+	if key == "" {
+		log.Noticef("p2p: content exchange disabled")
+		p.stop()
+		return
+	}
+	p.start(ifacesChanged)
+}
+
+// start starts whatever is not running yet, and re-registers
+// the mDNS advertisement if requested. Otherwise the advertised
+// content is only updated.
+func (p *p2pContext) start(reregister bool) {
+	if p.server == nil {
+		if err := p.startServer(); err != nil {
+			log.Errorf("p2p: failed to start server: %v", err)
+			return
+		}
+		log.Noticef("p2p: content exchange enabled, serving on port %d",
+			types.P2PPort)
+	}
+	if p.blobs == nil {
+		p.openCAS()
+	}
+	if reregister || p.mdns == nil {
+		p.register()
+	} else {
+		p.announce()
+	}
+}
+
+// stop stops advertising and serving the content
+func (p *p2pContext) stop() {
+	if p.mdns != nil {
+		p.mdns.Shutdown()
+		p.mdns = nil
+	}
+	if p.server != nil {
+		if err := p.server.Close(); err != nil {
+			log.Warnf("p2p: failed to stop server: %v", err)
+		}
+		p.server = nil
+	}
+}
+
+func (p *p2pContext) openCAS() {
+	blobs, err := cas.NewCAS(casClientType)
+	if err != nil {
+		log.Errorf("p2p: failed to open CAS: %v", err)
+		return
+	}
+	p.blobs = blobs
+	p.advertised = p.listAdvertised()
+}
+
+// startServer starts the HTTPS server with a new self-signed certificate
+func (p *p2pContext) startServer() error {
+	cert, err := newP2PCertificate()
+	if err != nil {
+		return err
+	}
+	fingerprint := sha256.Sum256(cert.Certificate[0])
+	mux := http.NewServeMux()
+	mux.HandleFunc(p2pBlobPath, p.serveBlob)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", types.P2PPort),
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := server.ServeTLS(listener, "", "")
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("p2p: server failed: %v", err)
+		}
+	}()
+	p.server = server
+	p.fingerprint = hex.EncodeToString(fingerprint[:])
+	return nil
+}
+
+func newP2PCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	hostname, _ := os.Hostname()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hostname},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template,
+		&key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// register (re)starts the mDNS advertisement on the current ports
+func (p *p2pContext) register() {
+	if p.mdns != nil {
+		p.mdns.Shutdown()
+		p.mdns = nil
+	}
+	ifs := p2pInterfaces(p.ifaces)
+	if len(ifs) == 0 {
+		log.Warnf("p2p: no ports to advertise the content on")
+		return
+	}
+	instance, err := os.Hostname()
+	if err != nil {
+		log.Errorf("p2p: failed to get hostname: %v", err)
+		return
+	}
+	server, err := zeroconf.Register(instance, types.P2PServiceType, "local.",
+		types.P2PPort, p.text(), ifs)
+	if err != nil {
+		log.Errorf("p2p: mDNS registration failed: %v", err)
+		return
+	}
+	p.mdns = server
+	log.Functionf("p2p: advertised on %v", p.ifaces)
+}
+
+// announce updates the advertised content
+func (p *p2pContext) announce() {
+	if p.mdns != nil {
+		p.mdns.SetText(p.text())
+	}
+}
+
+func p2pInterfaces(ifnames []string) []net.Interface {
+	var ifs []net.Interface
+	for _, ifname := range ifnames {
+		intf, err := net.InterfaceByName(ifname)
+		if err != nil {
+			log.Warnf("p2p: interface %s: %v", ifname, err)
+			continue
+		}
+		ifs = append(ifs, *intf)
+	}
+	return ifs
+}
+
+// text returns the TXT record advertising the content
+func (p *p2pContext) text() []string {
+	var downloading []string
+	for sha := range p.downloading {
+		downloading = append(downloading, sha[:p2pPrefixLen])
+	}
+	sort.Strings(downloading)
+	return p2pText(p.key, p.fingerprint, p.advertised, downloading)
+}
+
+// refresh updates the advertised content from CAS, called periodically
+func (p *p2pContext) refresh() {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.key) == 0 {
+		return
+	}
+	if p.server == nil || p.blobs == nil || p.mdns == nil {
+		// retry what failed before
+		p.start(false)
+		if p.blobs == nil {
+			return
+		}
+	}
+	advertised := p.listAdvertised()
+	changed := strings.Join(advertised, ",") != strings.Join(p.advertised, ",")
+	p.advertised = advertised
+	// forget the completed downloads once they are in CAS
+	for sha, completed := range p.downloading {
+		if completed.IsZero() {
+			continue
+		}
+		if p.inCAS(sha) || time.Since(completed) > p2pMaxWait {
+			delete(p.downloading, sha)
+			changed = true
+		}
+	}
+	if changed {
+		p.announce()
+	}
+}
+
+// listAdvertised returns prefixes of the largest blobs in CAS
+func (p *p2pContext) listAdvertised() []string {
+	infos, err := p.blobs.ListBlobInfo()
+	if err != nil {
+		log.Errorf("p2p: %v", err)
+		return p.advertised
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Size > infos[j].Size
+	})
+	var prefixes []string
+	for _, info := range infos {
+		if info.Size < p2pMinBlobSize || len(prefixes) == p2pMaxAdvertised {
+			break
+		}
+		sha := strings.TrimPrefix(info.Digest, "sha256:")
+		if len(sha) != sha256.Size*2 {
+			continue
+		}
+		prefixes = append(prefixes, sha[:p2pPrefixLen])
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// inCAS returns true if the blob is in CAS. Not only the advertised blobs
+// are checked, most of the downloaded blobs are too small to be advertised.
+func (p *p2pContext) inCAS(sha string) bool {
+	_, err := p.blobs.GetBlobInfo("sha256:" + sha)
+	return err == nil
+}
+
+// startDownload advertises that the content is being downloaded
+// from a datastore, so that the other nodes wait for it
+func (p *p2pContext) startDownload(sha string) {
+	sha = strings.ToLower(sha)
+	p.Lock()
+	defer p.Unlock()
+	if len(p.key) == 0 || len(sha) != sha256.Size*2 {
+		return
+	}
+	p.downloading[sha] = time.Time{}
+	p.announce()
+}
+
+// doneDownload stops advertising the download. The successfully downloaded
+// content stays advertised as being downloaded until it appears in CAS.
+func (p *p2pContext) doneDownload(sha string, success bool) {
+	sha = strings.ToLower(sha)
+	p.Lock()
+	defer p.Unlock()
+	if _, ok := p.downloading[sha]; !ok {
+		return
+	}
+	if success {
+		p.downloading[sha] = time.Now()
+		return
+	}
+	delete(p.downloading, sha)
+	p.announce()
+}
+
+// serveBlob sends the blob from CAS to another edge node
+func (p *p2pContext) serveBlob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	sha := strings.TrimPrefix(r.URL.Path, p2pBlobPath)
+	p.Lock()
+	key, fingerprint, blobs := p.key, p.fingerprint, p.blobs
+	p.Unlock()
+	if len(key) == 0 || !p2pCheckAuth(key, fingerprint, sha,
+		r.Header.Get("Authorization")) {
+		log.Warnf("p2p: unauthorized request for %s from %s", sha, r.RemoteAddr)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if blobs == nil {
+		http.Error(w, "content not available", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case p.uploads <- struct{}{}:
+		defer func() { <-p.uploads }()
+	default:
+		http.Error(w, "too many downloads", http.StatusServiceUnavailable)
+		return
+	}
+	digest := "sha256:" + sha
+	info, err := blobs.GetBlobInfo(digest)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	ctrdCtx, done := blobs.CtrNewUserServicesCtx()
+	defer done()
+	reader, err := blobs.ReadBlob(ctrdCtx, digest)
+	if err != nil {
+		log.Errorf("p2p: failed to read %s: %v", digest, err)
+		http.Error(w, "failed to read content", http.StatusInternalServerError)
+		return
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	log.Noticef("p2p: sending %s (%d bytes) to %s", digest, info.Size, r.RemoteAddr)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	written, err := io.Copy(w, reader)
+	if err != nil {
+		log.Warnf("p2p: sending %s to %s failed after %d bytes: %v",
+			digest, r.RemoteAddr, written, err)
+	}
+}
+
+// p2pText returns the TXT record with prefixes of sha256 of available blobs
+// (h=) and of blobs being downloaded from datastores (d=), authenticated
+// by a MAC over all the other strings.
+func p2pText(key []byte, fingerprint string, available, downloading []string) []string {
+	text := []string{"v=" + p2pVersion, "fp=" + fingerprint}
+	text = append(text, packPrefixes("h", available)...)
+	text = append(text, packPrefixes("d", downloading)...)
+	return append(text, "mac="+p2pMAC(key, text...))
+}
+
+func packPrefixes(name string, prefixes []string) []string {
+	var text []string
+	for len(prefixes) > 0 {
+		n := len(prefixes)
+		if n > p2pPrefixesPerString {
+			n = p2pPrefixesPerString
+		}
+		text = append(text, name+"="+strings.Join(prefixes[:n], ","))
+		prefixes = prefixes[n:]
+	}
+	return text
+}
+
+func p2pMAC(key []byte, values ...string) string {
+	mac := hmac.New(sha256.New, key)
+	for _, value := range values {
+		mac.Write([]byte(value))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// p2pAuth returns the value of the Authorization header for the request
+// of the blob from the server with the given certificate fingerprint
+func p2pAuth(key []byte, fingerprint, sha string) string {
+	return p2pAuthScheme + p2pMAC(key, http.MethodGet, fingerprint, sha)
+}
+
+func p2pCheckAuth(key []byte, fingerprint, sha, auth string) bool {
+	return hmac.Equal([]byte(auth), []byte(p2pAuth(key, fingerprint, sha)))
+}
+
+// p2pPeer is another edge node advertising content
+type p2pPeer struct {
+	name        string
+	addrs       []net.IP
+	port        int
+	fingerprint string
+	available   map[string]bool
+	downloading map[string]bool
+}
+
+func (peer *p2pPeer) String() string {
+	return fmt.Sprintf("%s (%v)", peer.name, peer.addrs)
+}
+
+// parseP2PPeer parses and authenticates the TXT record of a peer
+func parseP2PPeer(key []byte, name string, text []string) (*p2pPeer, error) {
+	if len(text) == 0 || !strings.HasPrefix(text[len(text)-1], "mac=") {
+		return nil, fmt.Errorf("missing MAC")
+	}
+	mac := strings.TrimPrefix(text[len(text)-1], "mac=")
+	text = text[:len(text)-1]
+	if !hmac.Equal([]byte(mac), []byte(p2pMAC(key, text...))) {
+		return nil, fmt.Errorf("invalid MAC")
+	}
+	peer := &p2pPeer{
+		name:        name,
+		available:   make(map[string]bool),
+		downloading: make(map[string]bool),
+	}
+	for _, value := range text {
+		i := strings.Index(value, "=")
+		if i < 0 {
+			continue
+		}
+		switch value[:i] {
+		case "v":
+			if value[i+1:] != p2pVersion {
+				return nil, fmt.Errorf("unsupported version %s", value[i+1:])
+			}
+		case "fp":
+			peer.fingerprint = value[i+1:]
+		case "h":
+			for _, prefix := range strings.Split(value[i+1:], ",") {
+				peer.available[prefix] = true
+			}
+		case "d":
+			for _, prefix := range strings.Split(value[i+1:], ",") {
+				peer.downloading[prefix] = true
+			}
+		}
+	}
+	if peer.fingerprint == "" {
+		return nil, fmt.Errorf("missing certificate fingerprint")
+	}
+	return peer, nil
+}
+
+// browse returns the authenticated peers found on the ports
+func (p *p2pContext) browse(ctx context.Context) ([]*p2pPeer, error) {
+	p.Lock()
+	key := p.key
+	ifs := p2pInterfaces(p.ifaces)
+	p.Unlock()
+	if len(ifs) == 0 {
+		return nil, fmt.Errorf("no ports to look for peers on")
+	}
+	resolver, err := zeroconf.NewResolver(zeroconf.SelectIfaces(ifs),
+		zeroconf.SelectIPTraffic(zeroconf.IPv4))
+	if err != nil {
+		return nil, err
+	}
+	hostname, _ := os.Hostname()
+	bctx, cancel := context.WithTimeout(ctx, p2pBrowseTime)
+	defer cancel()
+	entries := make(chan *zeroconf.ServiceEntry)
+	peersChan := make(chan []*p2pPeer)
+	go func() {
+		var peers []*p2pPeer
+		for entry := range entries {
+			if entry.Instance == hostname || len(entry.AddrIPv4) == 0 {
+				continue
+			}
+			peer, err := parseP2PPeer(key, entry.Instance, entry.Text)
+			if err != nil {
+				log.Warnf("p2p: ignoring peer %s: %v", entry.Instance, err)
+				continue
+			}
+			peer.addrs = entry.AddrIPv4
+			peer.port = entry.Port
+			peers = append(peers, peer)
+		}
+		peersChan <- peers
+	}()
+	if err = resolver.Browse(bctx, types.P2PServiceType, "local.", entries); err != nil {
+		return nil, err
+	}
+	<-bctx.Done()
+	return <-peersChan, nil
+}
+
+// fetchFromPeers downloads the content into locFilename from another edge
+// node, if one has it. While another node downloads the content from
+// a datastore, waits for it. Returns true if the content was downloaded.
+func fetchFromPeers(ctx *downloaderContext, config types.DownloaderConfig,
+	status Status, locFilename string,
+	receiveChan chan<- CancelChannel) (fetched, cancelled bool) {
+
+	sha := strings.ToLower(config.ImageSha256)
+	if len(sha) != sha256.Size*2 || config.Size < p2pMinBlobSize {
+		return false, false
+	}
+	if doneParts := loadDownloadedParts(locFilename); doneParts.Size() > 0 {
+		// continue the partial download from the datastore instead
+		return false, false
+	}
+	fctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Tell caller where we can be cancelled
+	cancelChan := make(chan Notify, 1)
+	receiveChan <- cancelChan
+	doneChan := make(chan Notify)
+	defer close(doneChan)
+	go func() {
+		select {
+		case <-doneChan:
+			// remove cancel channel
+			receiveChan <- nil
+		case <-cancelChan:
+			cancelled = true
+			log.Errorf("p2p: download of %s cancelled by user", sha)
+			cancel()
+		}
+	}()
+
+	prefix := sha[:p2pPrefixLen]
+	start := time.Now()
+	jittered := false
+	for fctx.Err() == nil {
+		peers, err := ctx.p2p.browse(fctx)
+		if err != nil {
+			log.Warnf("p2p: failed to look for peers: %v", err)
+			break
+		}
+		waiting := false
+		for _, peer := range peers {
+			if !peer.available[prefix] {
+				waiting = waiting || peer.downloading[prefix]
+				continue
+			}
+			err = ctx.p2p.fetchFrom(fctx, peer, sha, config.Size, locFilename, status)
+			if err == nil {
+				log.Noticef("p2p: downloaded %s from %s", sha, peer)
+				return true, false
+			}
+			if err == errPeerBusy {
+				waiting = true
+			}
+			log.Warnf("p2p: download of %s from %s failed: %v", sha, peer, err)
+		}
+		delay := p2pPollInterval
+		if !waiting && len(peers) > 0 && !jittered {
+			// The other nodes may have received the same config
+			// at the same time, let only one of them go to the datastore.
+			jittered = true
+			waiting = true
+			delay = p2pJitter()
+		}
+		if !waiting || time.Since(start) > p2pMaxWait {
+			break
+		}
+		log.Noticef("p2p: waiting %v for another node to download %s", delay, sha)
+		select {
+		case <-fctx.Done():
+		case <-time.After(delay):
+		}
+	}
+	return false, cancelled
+}
+
+func p2pJitter() time.Duration {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(p2pMaxJitter)))
+	if err != nil {
+		return p2pMaxJitter
+	}
+	return time.Duration(n.Int64())
+}
+
+// fetchFrom downloads the blob from the peer and verifies its sha256
+func (p *p2pContext) fetchFrom(ctx context.Context, peer *p2pPeer, sha string,
+	size uint64, locFilename string, status Status) error {
+
+	p.Lock()
+	key := p.key
+	p.Unlock()
+	client := &http.Client{
+		Transport: &http.Transport{
+			// the peer is on the local network, no proxy
+			Proxy: nil,
+			TLSClientConfig: &tls.Config{
+				// the self-signed certificate is checked against
+				// the authenticated fingerprint instead
+				InsecureSkipVerify: true,
+				VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+					if len(rawCerts) == 0 {
+						return fmt.Errorf("no certificate")
+					}
+					fingerprint := sha256.Sum256(rawCerts[0])
+					if hex.EncodeToString(fingerprint[:]) != peer.fingerprint {
+						return fmt.Errorf("certificate does not match the advertised one")
+					}
+					return nil
+				},
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	var lastErr error
+	for _, addr := range peer.addrs {
+		url := fmt.Sprintf("https://%s%s", net.JoinHostPort(addr.String(),
+			strconv.Itoa(peer.port)), p2pBlobPath+sha)
+		lastErr = fetchURL(ctx, client, url, p2pAuth(key, peer.fingerprint, sha),
+			sha, size, locFilename, status)
+		if lastErr == nil || lastErr == errPeerBusy || ctx.Err() != nil {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+func fetchURL(ctx context.Context, client *http.Client, url, auth, sha string,
+	size uint64, locFilename string, status Status) error {
+
+	// cancel the request if no data arrives
+	rctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	inactivityTimer := time.AfterFunc(p2pInactivityTimeout, cancel)
+	defer inactivityTimer.Stop()
+
+	req, err := http.NewRequestWithContext(rctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", auth)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusServiceUnavailable:
+		return errPeerBusy
+	default:
+		return fmt.Errorf("bad response code: %d", resp.StatusCode)
+	}
+	if resp.ContentLength < 0 || uint64(resp.ContentLength) != size {
+		return fmt.Errorf("unexpected size %d, expected %d", resp.ContentLength, size)
+	}
+	local, err := os.Create(locFilename)
+	if err != nil {
+		return err
+	}
+	hash := sha256.New()
+	writer := io.MultiWriter(local, hash)
+	var copied int64
+	for {
+		var written int64
+		written, err = io.CopyN(writer, resp.Body, p2pChunkSize)
+		copied += written
+		if err != nil {
+			break
+		}
+		inactivityTimer.Reset(p2pInactivityTimeout)
+		status.Progress(uint(copied*100/resp.ContentLength), copied, resp.ContentLength)
+	}
+	if err == io.EOF {
+		err = nil
+		if copied != resp.ContentLength {
+			err = fmt.Errorf("received %d bytes out of %d", copied, resp.ContentLength)
+		} else if hex.EncodeToString(hash.Sum(nil)) != sha {
+			err = fmt.Errorf("received content does not match sha256")
+		}
+	}
+	if closeErr := local.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.Remove(locFilename); rmErr != nil {
+			log.Errorf("p2p: failed to remove %s: %v", locFilename, rmErr)
+		}
+		return err
+	}
+	return nil
+}
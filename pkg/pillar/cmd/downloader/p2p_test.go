@@ -0,0 +1,245 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+}
+
+// testBlobs serves blobs from memory
+type testBlobs map[string][]byte
+
+func (b testBlobs) ListBlobInfo() ([]*cas.BlobInfo, error) {
+	var infos []*cas.BlobInfo
+	for digest, content := range b {
+		infos = append(infos, &cas.BlobInfo{Digest: digest, Size: int64(len(content))})
+	}
+	return infos, nil
+}
+
+func (b testBlobs) GetBlobInfo(digest string) (*cas.BlobInfo, error) {
+	content, ok := b[digest]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", digest)
+	}
+	return &cas.BlobInfo{Digest: digest, Size: int64(len(content))}, nil
+}
+
+func (b testBlobs) ReadBlob(ctx context.Context, digest string) (io.Reader, error) {
+	content, ok := b[digest]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", digest)
+	}
+	return bytes.NewReader(content), nil
+}
+
+func (b testBlobs) CtrNewUserServicesCtx() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+// testStatus records the progress
+type testStatus struct {
+	currentSize int64
+}
+
+func (s *testStatus) Progress(p uint, currentSize, totalSize int64) bool {
+	s.currentSize = currentSize
+	return true
+}
+
+func (s *testStatus) Resumed(offset int64) {}
+
+func (s *testStatus) Reused(size int64) {}
+
+func testSha(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// startP2PServer serves the blobs of p over HTTPS and returns the peer
+// as it would be found over mDNS
+func startP2PServer(t *testing.T, p *p2pContext) *p2pPeer {
+	cert, err := newP2PCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := sha256.Sum256(cert.Certificate[0])
+	p.fingerprint = hex.EncodeToString(fingerprint[:])
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(p.serveBlob))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &p2pPeer{
+		name:        "peer",
+		addrs:       []net.IP{net.ParseIP(host)},
+		port:        portNum,
+		fingerprint: p.fingerprint,
+	}
+}
+
+func tempFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "p2p_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "blob")
+}
+
+func TestP2PText(t *testing.T) {
+	key := []byte("site key")
+	var available []string
+	for i := 0; i < 20; i++ {
+		available = append(available, fmt.Sprintf("%016x", i))
+	}
+	text := p2pText(key, "fingerprint", available, []string{"0123456789abcdef"})
+	for _, value := range text {
+		if len(value) > 255 {
+			t.Errorf("TXT string too long: %d", len(value))
+		}
+	}
+	peer, err := parseP2PPeer(key, "peer", text)
+	if err != nil {
+		t.Fatalf("parseP2PPeer failed: %v", err)
+	}
+	if peer.fingerprint != "fingerprint" || len(peer.available) != len(available) ||
+		!peer.available[available[19]] || !peer.downloading["0123456789abcdef"] {
+		t.Errorf("unexpected peer %+v", peer)
+	}
+
+	if _, err = parseP2PPeer([]byte("other key"), "peer", text); err == nil {
+		t.Errorf("advertisement with a different key accepted")
+	}
+	text[1] = "fp=other"
+	if _, err = parseP2PPeer(key, "peer", text); err == nil {
+		t.Errorf("modified advertisement accepted")
+	}
+}
+
+func TestP2PInCAS(t *testing.T) {
+	small := []byte("smaller than the advertised blobs")
+	p := newP2PContext()
+	p.blobs = testBlobs{"sha256:" + testSha(small): small}
+	p.advertised = p.listAdvertised()
+	if len(p.advertised) != 0 {
+		t.Fatalf("small blob advertised: %v", p.advertised)
+	}
+	if !p.inCAS(testSha(small)) {
+		t.Errorf("downloaded blob which is not advertised not found in CAS")
+	}
+	if p.inCAS(testSha([]byte("other"))) {
+		t.Errorf("missing blob found in CAS")
+	}
+}
+
+func TestP2PFetch(t *testing.T) {
+	key := []byte("site key")
+	content := bytes.Repeat([]byte("0123456789"), 300000)
+	sha := testSha(content)
+	p := newP2PContext()
+	p.key = key
+	p.blobs = testBlobs{"sha256:" + sha: content}
+
+	peer := startP2PServer(t, p)
+	locFilename := tempFile(t)
+
+	status := &testStatus{}
+	err := p.fetchFrom(context.Background(), peer, sha, uint64(len(content)),
+		locFilename, status)
+	if err != nil {
+		t.Fatalf("fetchFrom failed: %v", err)
+	}
+	got, err := ioutil.ReadFile(locFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Errorf("downloaded content differs")
+	}
+	if status.currentSize == 0 {
+		t.Errorf("no progress reported")
+	}
+	if err = os.Remove(locFilename); err != nil {
+		t.Fatal(err)
+	}
+
+	// blob not in CAS
+	missing := testSha([]byte("missing"))
+	err = p.fetchFrom(context.Background(), peer, missing, uint64(len(content)),
+		locFilename, status)
+	if err == nil {
+		t.Errorf("fetchFrom of missing blob succeeded")
+	}
+
+	// the client has a different key
+	client := newP2PContext()
+	client.key = []byte("other key")
+	err = client.fetchFrom(context.Background(), peer, sha, uint64(len(content)),
+		locFilename, status)
+	if err == nil {
+		t.Errorf("fetchFrom with a different key succeeded")
+	}
+
+	// the server certificate does not match the advertised one
+	peer.fingerprint = testSha([]byte("other certificate"))
+	err = p.fetchFrom(context.Background(), peer, sha, uint64(len(content)),
+		locFilename, status)
+	if err == nil {
+		t.Errorf("fetchFrom from server with other certificate succeeded")
+	}
+	if _, err = os.Stat(locFilename); !os.IsNotExist(err) {
+		t.Errorf("failed downloads left %s behind", locFilename)
+	}
+}
+
+func TestP2PFetchCorrupted(t *testing.T) {
+	key := []byte("site key")
+	content := bytes.Repeat([]byte("0123456789"), 300000)
+	sha := testSha(content)
+	p := newP2PContext()
+	p.key = key
+	// the peer serves different content under the sha256
+	p.blobs = testBlobs{"sha256:" + sha: bytes.Repeat([]byte("9876543210"), 300000)}
+
+	peer := startP2PServer(t, p)
+	locFilename := tempFile(t)
+	err := p.fetchFrom(context.Background(), peer, sha, uint64(len(content)),
+		locFilename, &testStatus{})
+	if err == nil {
+		t.Errorf("fetchFrom of corrupted content succeeded")
+	}
+	if _, err = os.Stat(locFilename); !os.IsNotExist(err) {
+		t.Errorf("corrupted content left in %s", locFilename)
+	}
+}
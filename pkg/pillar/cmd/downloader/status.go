@@ -11,6 +11,12 @@ import (
 type Status interface {
 	// Progress report progress; returns false if no change
 	Progress(uint, int64, int64) bool
+	// Resumed reports the offset the download continues from, 0 if it
+	// starts from the beginning
+	Resumed(int64)
+	// Reused reports the size taken from chunks of local blobs
+	// instead of being downloaded
+	Reused(int64)
 }
 
 // PublishStatus practical implementation of Status
@@ -34,3 +40,21 @@ func (d *PublishStatus) Progress(p uint, currentSize, totalSize int64) bool {
 	publishDownloaderStatus(d.ctx, d.status)
 	return true
 }
+
+// Resumed records the offset the download was resumed from
+func (d *PublishStatus) Resumed(offset int64) {
+	if d.status.ResumedOffset == offset {
+		return
+	}
+	d.status.ResumedOffset = offset
+	publishDownloaderStatus(d.ctx, d.status)
+}
+
+// Reused records the size which was not downloaded thanks to local chunks
+func (d *PublishStatus) Reused(size int64) {
+	if d.status.ReusedSize == size {
+		return
+	}
+	d.status.ReusedSize = size
+	publishDownloaderStatus(d.ctx, d.status)
+}
@@ -14,6 +14,7 @@ import (
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/libs/zedUpload"
+	zedUploadTypes "github.com/lf-edge/eve/libs/zedUpload/types"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	logutils "github.com/lf-edge/eve/pkg/pillar/utils/logging"
@@ -154,6 +155,23 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		remoteName = config.Name
 		serverURL = dst.Fqdn
 
+	case zconfig.DsType_DsNFS.String():
+		trType = zedUpload.SyncNFSTr
+		// dsPath is the exported directory
+		remoteName = config.Name
+		serverURL = dst.Fqdn
+
+	case zconfig.DsType_DsSMB.String():
+		auth = &zedUpload.AuthInput{
+			AuthType: "password",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		trType = zedUpload.SyncSMBTr
+		// dsPath is the share name optionally followed by a directory
+		remoteName = config.Name
+		serverURL = dst.Fqdn
+
 	case zconfig.DsType_DsHttp.String(), zconfig.DsType_DsHttps.String(), "":
 		auth = &zedUpload.AuthInput{
 			AuthType: "http",
@@ -167,6 +185,8 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		if err != nil {
 			errStr = errStr + "\n" + err.Error()
 		}
+		// keep the partial file to resume from the checkpoint
+		cleanOnError = false
 
 	case zconfig.DsType_DsGoogleStorage.String():
 		auth = &zedUpload.AuthInput{
@@ -211,6 +231,35 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		}
 	}
 
+	// Try to get the content from other edge nodes first. If none has it,
+	// let them know that it is being downloaded from the datastore.
+	if !dsLocal && ctx.p2p.enabled() {
+		st := &PublishStatus{
+			ctx:    ctx,
+			status: status,
+		}
+		var fetched bool
+		fetched, cancelled = fetchFromPeers(ctx, config, st, locFilename, receiveChan)
+		if cancelled {
+			handleSyncOpResponse(ctx, config, status, locFilename,
+				key, "download cancelled by user", cancelled, cleanOnError)
+			return
+		}
+		if fetched {
+			size := int64(config.Size)
+			status.Size = config.Size
+			st.Progress(100, size, size)
+			handleSyncOpResponse(ctx, config, status, locFilename,
+				key, "", cancelled, cleanOnError)
+			return
+		}
+		ctx.p2p.startDownload(config.ImageSha256)
+		defer func() {
+			ctx.p2p.doneDownload(config.ImageSha256,
+				status.State == types.DOWNLOADED)
+		}()
+	}
+
 	// Loop through all interfaces until a success
 	for addrIndex := 0; addrIndex < addrCount; addrIndex++ {
 		var ifname string
@@ -235,6 +284,13 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		log.Functionf("Using server URL %s IP source %v if %s transport %v",
 			serverURL, ipSrc, ifname, dsCtx.TransportMethod)
 
+		// a layer may be assembled partly from chunks of the blobs in CAS
+		var chunks zedUploadTypes.ChunkSource
+		if trType == zedUpload.SyncOCIRegistryTr &&
+			config.Size >= minChunkedBlobSize && ctx.chunks.refresh() {
+			chunks = ctx.chunks
+		}
+
 		// do the download
 		st := &PublishStatus{
 			ctx:    ctx,
@@ -243,8 +299,8 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		downloadStartTime := time.Now()
 		contentType, cancelled, err = download(ctx, trType, st, syncOp, serverURL, auth,
 			dsPath, dsCtx.Region,
-			config.Size, ifname, ipSrc, remoteName, locFilename, dst.DsCertPEM,
-			receiveChan)
+			config.Size, ifname, ipSrc, remoteName, locFilename, config.ImageSha256, dst.DsCertPEM,
+			chunks, receiveChan)
 		if err != nil {
 			if cancelled {
 				log.Errorf("download %s cancelled", serverURL)
@@ -276,9 +332,11 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		status.ContentType = contentType
 		ctx.zedcloudMetrics.RecordSuccess(log, ifname,
 			metricsURL, 1024, size, downloadTime, false)
-		if st.Progress(100, size, size) {
+		// the sizes report what was actually transferred
+		transferred := size - status.ReusedSize
+		if st.Progress(100, transferred, transferred) {
 			log.Noticef("updated sizes at end to %d/%d",
-				size, size)
+				transferred, transferred)
 		}
 		handleSyncOpResponse(ctx, config, status,
 			locFilename, key, "", cancelled, cleanOnError)
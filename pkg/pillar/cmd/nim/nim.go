@@ -46,6 +46,7 @@ const (
 	runDevicePortConfigDir    = "/run/global/DevicePortConfig"
 	maxReadSize               = 16384       // Punt on too large files
 	dpcAvailableTimeLimit     = time.Minute // TODO: make configurable?
+	dpcListHistory            = 16          // Recent DPCL changes kept for debugging
 )
 
 // Really a constant
@@ -463,9 +464,10 @@ func (n *nim) initPublications() (err error) {
 
 	n.pubDevicePortConfigList, err = n.PubSub.NewPublication(
 		pubsub.PublicationOptions{
-			AgentName:  agentName,
-			Persistent: true,
-			TopicType:  types.DevicePortConfigList{},
+			AgentName:   agentName,
+			Persistent:  true,
+			TopicType:   types.DevicePortConfigList{},
+			HistorySize: dpcListHistory,
 		})
 	if err != nil {
 		return err
@@ -1023,6 +1025,7 @@ func (n *nim) includeLastResortPort(ifAttrs netmonitor.IfAttrs) bool {
 	ifName := ifAttrs.IfName
 	exclude := strings.HasPrefix(ifName, "vif") ||
 		strings.HasPrefix(ifName, "nbu") ||
+		strings.HasPrefix(ifName, "nbi") ||
 		strings.HasPrefix(ifName, "nbo") ||
 		strings.HasPrefix(ifName, "keth")
 	if exclude {
@@ -70,7 +70,7 @@ var (
 	ecdhCertFile = types.CertificateDirname + "/ecdh.cert.pem"
 
 	//location of the attestation quote certificate
-	quoteCertFile = types.CertificateDirname + "/attest.cert.pem"
+	quoteCertFile = types.AttestCertName
 
 	//EkCertFile is location of the endorsement key certificate
 	EkCertFile = types.CertificateDirname + "/ek.cert.pem"
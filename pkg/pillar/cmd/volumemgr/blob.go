@@ -72,10 +72,12 @@ func downloadBlob(ctx *volumemgrContext, blob *types.BlobStatus) bool {
 	}
 	if blob.TotalSize != ds.TotalSize ||
 		blob.CurrentSize != ds.CurrentSize ||
+		blob.ReusedSize != ds.ReusedSize ||
 		blob.Size != ds.Size {
 		blob.Size = ds.Size
 		blob.TotalSize = ds.TotalSize
 		blob.CurrentSize = ds.CurrentSize
+		blob.ReusedSize = ds.ReusedSize
 		if blob.TotalSize > 0 {
 			blob.Progress = uint(100 * blob.CurrentSize / blob.TotalSize)
 		}
@@ -306,6 +308,14 @@ func getBlobChildren(ctx *volumemgrContext, blob *types.BlobStatus) []*types.Blo
 				childHash := strings.ToLower(child.Digest.Hex)
 				//Check if childBlob already exists
 				existingChild := lookupOrCreateBlobStatus(ctx, childHash)
+				if existingChild == nil {
+					// a layer shared with another image may be in CAS
+					existingChild = blobStatusFromCAS(ctx, childHash, string(child.MediaType))
+					if existingChild != nil {
+						existingChild.DatastoreID = blob.DatastoreID
+						existingChild.RelativeURL = replaceSha(blob.RelativeURL, child.Digest)
+					}
+				}
 				if existingChild != nil {
 					log.Tracef("getBlobChildren(%s): child blob %s already exists.", blob.Sha256, childHash)
 					blobChildren = append(blobChildren, existingChild)
@@ -438,6 +448,28 @@ func lookupOrCreateBlobStatus(ctx *volumemgrContext, blobSha string) *types.Blob
 	return nil
 }
 
+// blobStatusFromCAS returns a LOADED BlobStatus for a blob which is present
+// in CAS without a BlobStatus, nil if the blob is not in CAS.
+// The BlobStatus is not published.
+func blobStatusFromCAS(ctx *volumemgrContext, blobSha, mediaType string) *types.BlobStatus {
+	blobInfo, err := ctx.casClient.GetBlobInfo(cas.CheckAndCorrectBlobHash(blobSha))
+	if err != nil || blobInfo == nil {
+		return nil
+	}
+	log.Functionf("blobStatusFromCAS(%s): found in CAS", blobSha)
+	return &types.BlobStatus{
+		Sha256:                 blobSha,
+		Size:                   uint64(blobInfo.Size),
+		State:                  types.LOADED,
+		MediaType:              mediaType,
+		TotalSize:              blobInfo.Size,
+		CurrentSize:            blobInfo.Size,
+		Progress:               100,
+		LastRefCountChangeTime: time.Now(),
+		CreateTime:             time.Now(),
+	}
+}
+
 // lookupBlobStatuses returns a list of pointers.
 // It takes care to return the same pointer in the case that a sha is repeated
 func lookupBlobStatuses(ctx *volumemgrContext, shas ...string) []*types.BlobStatus {
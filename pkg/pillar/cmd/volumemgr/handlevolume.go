@@ -67,6 +67,11 @@ func handleVolumeModify(ctxArg interface{}, key string,
 			status.RefCount = config.RefCount
 			status.LastRefCountChangeTime = time.Now()
 		}
+		if config.SnapshotPolicy != status.SnapshotPolicy {
+			log.Functionf("SnapshotPolicy changed from %+v to %+v for %s",
+				status.SnapshotPolicy, config.SnapshotPolicy, config.DisplayName)
+			status.SnapshotPolicy = config.SnapshotPolicy
+		}
 		updateVolumeStatusRefCount(ctx, status)
 		publishVolumeStatus(ctx, status)
 		updateVolumeRefStatus(ctx, status)
@@ -119,12 +124,14 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 		RefCount:                config.RefCount,
 		Target:                  config.Target,
 		CustomMeta:              config.CustomMeta,
+		SnapshotPolicy:          config.SnapshotPolicy,
 		LastRefCountChangeTime:  time.Now(),
 		LastUse:                 time.Now(),
 		State:                   types.INITIAL,
 	}
 	updateVolumeStatusRefCount(ctx, status)
 	status.ContentFormat = volumeFormat[status.Key()]
+	status.KeyEncrypted = useVolumeKey(status)
 
 	created, err := volumehandlers.GetVolumeHandler(log, ctx, status).Populate()
 	if err != nil {
@@ -154,6 +161,7 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 			status.TotalSize = int64(actualSize)
 			status.CurrentSize = int64(actualSize)
 		}
+		refreshVolumeSnapshots(ctx, status)
 		publishVolumeStatus(ctx, status)
 		updateVolumeRefStatus(ctx, status)
 		if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
@@ -173,6 +181,19 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 	log.Tracef("handleDeferredVolumeCreate(%s) done", key)
 }
 
+// useVolumeKey returns true if the volume is encrypted with its own key.
+// The volumes created before the volume keys were supported keep using the
+// vault encryption only.
+func useVolumeKey(status *types.VolumeStatus) bool {
+	if vault.VolumeKeyExists(status.Key()) {
+		return true
+	}
+	if _, exists := volumeFormat[status.Key()]; exists {
+		return false
+	}
+	return status.Encrypted && !status.IsContainer() && vault.VolumeKeysSupported()
+}
+
 func handleVolumeRestart(ctxArg interface{}, restartCount int) {
 
 	log.Tracef("handleVolumeRestart: %d", restartCount)
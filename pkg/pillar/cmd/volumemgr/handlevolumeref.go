@@ -38,6 +38,11 @@ func handleVolumeRefCreate(ctxArg interface{}, key string,
 			VerifyOnly:             config.VerifyOnly,
 			Target:                 vs.Target,
 			CustomMeta:             vs.CustomMeta,
+			SnapshotPolicy:         vs.SnapshotPolicy,
+			Snapshots:              vs.Snapshots,
+			SnapshotCounter:        config.SnapshotCounter,
+			RollbackCounter:        config.RollbackCounter,
+			ExportCounter:          config.ExportCounter,
 		}
 		if vs.HasError() {
 			description := vs.ErrorDescription
@@ -56,6 +61,9 @@ func handleVolumeRefCreate(ctxArg interface{}, key string,
 			MountDir:               config.MountDir,
 			State:                  types.INITIAL, // Waiting for VolumeConfig from zedagent
 			VerifyOnly:             config.VerifyOnly,
+			SnapshotCounter:        config.SnapshotCounter,
+			RollbackCounter:        config.RollbackCounter,
+			ExportCounter:          config.ExportCounter,
 		}
 	}
 	publishVolumeRefStatus(ctx, status)
@@ -89,8 +97,11 @@ func handleVolumeRefModify(ctxArg interface{}, key string,
 		status.VerifyOnly = config.VerifyOnly
 		needUpdateVol = true
 	}
-	publishVolumeRefStatus(ctx, status)
 	vs := ctx.LookupVolumeStatus(config.VolumeKey())
+	if doVolumeSnapshotOps(ctx, config, status, vs) {
+		publishVolumeStatus(ctx, vs)
+	}
+	publishVolumeRefStatus(ctx, status)
 	if vs != nil {
 		if needUpdateVol {
 			doUpdateVol(ctx, vs)
@@ -187,6 +198,8 @@ func updateVolumeRefStatus(ctx *volumemgrContext, vs *types.VolumeStatus) {
 				status.Target = vs.Target
 				status.CustomMeta = vs.CustomMeta
 				status.WWN = vs.WWN
+				status.SnapshotPolicy = vs.SnapshotPolicy
+				status.Snapshots = vs.Snapshots
 				if vs.HasError() {
 					description := vs.ErrorDescription
 					description.ErrorEntities = []*types.ErrorEntity{{
@@ -215,6 +228,11 @@ func updateVolumeRefStatus(ctx *volumemgrContext, vs *types.VolumeStatus) {
 				WWN:                    vs.WWN,
 				VerifyOnly:             config.VerifyOnly,
 				Target:                 vs.Target,
+				SnapshotPolicy:         vs.SnapshotPolicy,
+				Snapshots:              vs.Snapshots,
+				SnapshotCounter:        config.SnapshotCounter,
+				RollbackCounter:        config.RollbackCounter,
+				ExportCounter:          config.ExportCounter,
 			}
 			if vs.HasError() {
 				description := vs.ErrorDescription
@@ -6,6 +6,7 @@ package volumemgr
 // Interface to worker to run the create and destroy in separate goroutines
 
 import (
+	"errors"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -17,6 +18,7 @@ const (
 	workCreate  = "create"
 	workIngest  = "ingest"
 	workPrepare = "prepare"
+	workExport  = "export"
 )
 
 // volumeWorkDescription volume creation/deletion work we feed into the worker go routine.
@@ -39,6 +41,17 @@ type casIngestWorkDescription struct {
 	loaded []string
 }
 
+// volumeExportWorkDescription volume export work we feed into the worker go routine
+type volumeExportWorkDescription struct {
+	status types.VolumeStatus
+	// refKey and counter identify the export requested by the VolumeRefConfig
+	refKey  string
+	counter uint32
+	dir     string
+	// used for results
+	export types.VolumeExport
+}
+
 // What we track for the result
 type volumeWorkResult struct {
 	worker.WorkResult // Error etc
@@ -112,6 +125,37 @@ func AddWorkPrepare(ctx *volumemgrContext, status *types.VolumeStatus) {
 	}
 }
 
+// exportWorkKey returns the key of the work exporting the volume of the
+// VolumeRefConfig, which differs from the key of the volume creation
+func exportWorkKey(refKey string) string {
+	return workExport + "-" + refKey
+}
+
+// AddWorkExport adds a Work job to export the volume into the app archive
+// in dir. A new export is not started while one is in progress, as the
+// response starts the next one if the counter changed meanwhile.
+func AddWorkExport(ctx *volumemgrContext, status *types.VolumeStatus,
+	refKey string, counter uint32, dir string) {
+
+	d := volumeExportWorkDescription{
+		status:  *status,
+		refKey:  refKey,
+		counter: counter,
+		dir:     dir,
+	}
+	key := exportWorkKey(refKey)
+	w := worker.Work{Kind: workExport, Key: key, Description: d}
+	done, err := ctx.worker.TrySubmit(w)
+	if err != nil {
+		var inProgress *worker.JobInProgressError
+		if !errors.As(err, &inProgress) {
+			log.Errorf("TrySubmit %s failed: %s", key, err)
+		}
+	} else if !done {
+		log.Fatalf("Failed to submit work due to queue length for %s", key)
+	}
+}
+
 // DeleteWorkCreate is called by user when work is done
 func DeleteWorkCreate(ctx *volumemgrContext, status *types.VolumeStatus) {
 	ctx.worker.Cancel(status.Key())
@@ -268,6 +312,23 @@ func volumePrepareWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
 	return result
 }
 
+// volumeExportWorker implementation of work.WorkFunction that exports a volume
+func volumeExportWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := w.Description.(volumeExportWorkDescription)
+	var err error
+	d.export, err = volumehandlers.GetVolumeHandler(log, ctx, &d.status).ExportVolume(d.dir)
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: d,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
 // processVolumeWorkResult handle the work result that was a volume action
 func processVolumeWorkResult(ctxPtr interface{}, res worker.WorkResult) error {
 	ctx := ctxPtr.(*volumemgrContext)
@@ -317,6 +378,35 @@ func processCasIngestWorkResult(ctxPtr interface{}, res worker.WorkResult) error
 	return nil
 }
 
+// processVolumeExportResult acknowledges the export in the VolumeRefStatus
+func processVolumeExportResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := res.Description.(volumeExportWorkDescription)
+	// nothing waits for the result but the VolumeRefStatus
+	ctx.worker.Pop(res.Key)
+	status := lookupVolumeRefStatus(ctx, d.refKey)
+	if status == nil {
+		log.Functionf("processVolumeExportResult for %s, VolumeRefStatus not found",
+			d.refKey)
+		return nil
+	}
+	status.ExportCounter = d.counter
+	status.Export = d.export
+	status.ExportError = ""
+	if res.Error != nil {
+		status.ExportError = res.Error.Error()
+	}
+	publishVolumeRefStatus(ctx, status)
+	config := lookupVolumeRefConfig(ctx, d.refKey)
+	if config != nil && config.ExportCounter != status.ExportCounter {
+		// requested again while the export was in progress
+		startVolumeExport(ctx, *config, status,
+			ctx.LookupVolumeStatus(config.VolumeKey()))
+	}
+	publishVolumeRefStatus(ctx, status)
+	return nil
+}
+
 // popasIngestWorkResult get the result exactly once
 func popCasIngestWorkResult(ctx *volumemgrContext, key string) *casIngestWorkResult {
 	res := ctx.worker.Pop(key)
@@ -17,6 +17,7 @@ import (
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
 	"github.com/lf-edge/eve/pkg/pillar/volumehandlers"
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
 	uuid "github.com/satori/go.uuid"
@@ -163,6 +164,7 @@ func getVolumeStatusByLocation(location string) (*types.VolumeStatus, error) {
 		ContentFormat:     zconfig.Format(parsedFormat),
 		FileLocation:      location,
 	}
+	vs.KeyEncrypted = vault.VolumeKeyExists(vs.Key())
 	return &vs, nil
 }
 
@@ -205,6 +207,8 @@ func gcPendingCreateVolume(ctx *volumemgrContext) {
 			unpublish(vcp)
 			continue
 		}
+		// it is created again from scratch
+		delete(volumeFormat, vcp.Key())
 		unpublish(vcp)
 	}
 	log.Trace("gcPendingCreateVolume done")
@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/volumehandlers"
+)
+
+// snapshotPrefix is the prefix of the names of the snapshots taken by
+// volumemgr; other snapshots of a volume are never deleted
+const snapshotPrefix = "eve-"
+
+// maxVolumeSnapshots is the number of snapshots kept for a volume. Older
+// snapshots are deleted once a new one is taken.
+const maxVolumeSnapshots = 1
+
+func snapshotName(t time.Time) string {
+	return snapshotPrefix + t.UTC().Format("20060102T150405")
+}
+
+// refreshVolumeSnapshots updates the list of snapshots in the VolumeStatus
+func refreshVolumeSnapshots(ctx *volumemgrContext, status *types.VolumeStatus) {
+	if status.State < types.CREATED_VOLUME {
+		return
+	}
+	snapshots, err := volumehandlers.GetVolumeHandler(log, ctx, status).ListSnapshots()
+	if err != nil {
+		if !errors.Is(err, volumehandlers.ErrSnapshotNotSupported) {
+			log.Errorf("refreshVolumeSnapshots(%s): %v", status.Key(), err)
+		}
+		status.Snapshots = nil
+		return
+	}
+	status.Snapshots = snapshots
+}
+
+// expiredSnapshots returns the snapshots taken by volumemgr which exceed
+// maxVolumeSnapshots, oldest first
+func expiredSnapshots(snapshots []types.VolumeSnapshot) []types.VolumeSnapshot {
+	var ours []types.VolumeSnapshot
+	for _, snapshot := range snapshots {
+		if strings.HasPrefix(snapshot.Name, snapshotPrefix) {
+			ours = append(ours, snapshot)
+		}
+	}
+	if len(ours) <= maxVolumeSnapshots {
+		return nil
+	}
+	return ours[:len(ours)-maxVolumeSnapshots]
+}
+
+// createVolumeSnapshot takes a snapshot of the volume and deletes the
+// expired ones
+func createVolumeSnapshot(ctx *volumemgrContext, status *types.VolumeStatus) error {
+	if status.State < types.CREATED_VOLUME {
+		return fmt.Errorf("volume %s is not created", status.DisplayName)
+	}
+	handler := volumehandlers.GetVolumeHandler(log, ctx, status)
+	name := snapshotName(time.Now())
+	if err := handler.CreateSnapshot(name); err != nil {
+		return err
+	}
+	refreshVolumeSnapshots(ctx, status)
+	for _, snapshot := range expiredSnapshots(status.Snapshots) {
+		if err := handler.DeleteSnapshot(snapshot.Name); err != nil {
+			log.Warnf("createVolumeSnapshot(%s): %v", status.Key(), err)
+		}
+	}
+	refreshVolumeSnapshots(ctx, status)
+	log.Noticef("createVolumeSnapshot(%s): created %s", status.Key(), name)
+	return nil
+}
+
+// rollbackVolume reverts the volume to its latest snapshot
+func rollbackVolume(ctx *volumemgrContext, status *types.VolumeStatus) error {
+	if status.State < types.CREATED_VOLUME {
+		return fmt.Errorf("volume %s is not created", status.DisplayName)
+	}
+	refreshVolumeSnapshots(ctx, status)
+	if len(status.Snapshots) == 0 {
+		return fmt.Errorf("volume %s has no snapshots", status.DisplayName)
+	}
+	name := status.Snapshots[len(status.Snapshots)-1].Name
+	handler := volumehandlers.GetVolumeHandler(log, ctx, status)
+	if err := handler.RollbackToSnapshot(name); err != nil {
+		return err
+	}
+	log.Noticef("rollbackVolume(%s): reverted to %s", status.Key(), name)
+	return nil
+}
+
+// startVolumeExport starts the export of the volume requested by the
+// VolumeRefConfig, which is acknowledged by processVolumeExportResult. An
+// export which can not start is acknowledged with the error right away.
+func startVolumeExport(ctx *volumemgrContext, config types.VolumeRefConfig,
+	status *types.VolumeRefStatus, vs *types.VolumeStatus) {
+
+	log.Functionf("startVolumeExport(%s): export counter from %d to %d",
+		config.Key(), status.ExportCounter, config.ExportCounter)
+	var err error
+	switch {
+	case vs == nil:
+		err = fmt.Errorf("volume %s not found", config.VolumeKey())
+	case vs.State < types.CREATED_VOLUME:
+		err = fmt.Errorf("volume %s is not created", vs.DisplayName)
+	case config.ExportDir == "":
+		err = fmt.Errorf("no directory to export volume %s", vs.DisplayName)
+	}
+	if err != nil {
+		log.Errorf("startVolumeExport(%s): %v", config.Key(), err)
+		status.ExportCounter = config.ExportCounter
+		status.Export = types.VolumeExport{}
+		status.ExportError = err.Error()
+		return
+	}
+	AddWorkExport(ctx, vs, config.Key(), config.ExportCounter, config.ExportDir)
+}
+
+// doVolumeSnapshotOps runs the snapshot and rollback requested by the
+// VolumeRefConfig and acknowledges them in the VolumeRefStatus, also if
+// they fail, and starts the export it requests. The app instance is
+// stopped by zedmanager before it requests them.
+// Returns true if the VolumeStatus changed.
+func doVolumeSnapshotOps(ctx *volumemgrContext, config types.VolumeRefConfig,
+	status *types.VolumeRefStatus, vs *types.VolumeStatus) bool {
+
+	if config.ExportCounter != status.ExportCounter {
+		startVolumeExport(ctx, config, status, vs)
+	}
+	if vs == nil {
+		if config.SnapshotCounter != status.SnapshotCounter ||
+			config.RollbackCounter != status.RollbackCounter {
+			status.SnapshotError = fmt.Sprintf("volume %s not found", config.VolumeKey())
+			status.SnapshotCounter = config.SnapshotCounter
+			status.RollbackCounter = config.RollbackCounter
+		}
+		return false
+	}
+	changed := false
+	if config.SnapshotCounter != status.SnapshotCounter {
+		log.Functionf("doVolumeSnapshotOps(%s): snapshot counter from %d to %d",
+			config.Key(), status.SnapshotCounter, config.SnapshotCounter)
+		status.SnapshotError = ""
+		if err := createVolumeSnapshot(ctx, vs); err != nil {
+			log.Errorf("doVolumeSnapshotOps(%s): snapshot failed: %v",
+				config.Key(), err)
+			status.SnapshotError = err.Error()
+		}
+		status.SnapshotCounter = config.SnapshotCounter
+		changed = true
+	}
+	if config.RollbackCounter != status.RollbackCounter {
+		log.Functionf("doVolumeSnapshotOps(%s): rollback counter from %d to %d",
+			config.Key(), status.RollbackCounter, config.RollbackCounter)
+		status.SnapshotError = ""
+		if err := rollbackVolume(ctx, vs); err != nil {
+			log.Errorf("doVolumeSnapshotOps(%s): rollback failed: %v",
+				config.Key(), err)
+			status.SnapshotError = err.Error()
+		}
+		status.RollbackCounter = config.RollbackCounter
+		changed = true
+	}
+	status.Snapshots = vs.Snapshots
+	return changed
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiredSnapshots(t *testing.T) {
+	now := time.Date(2023, 3, 1, 10, 20, 30, 0, time.UTC)
+	assert.Equal(t, "eve-20230301T102030", snapshotName(now))
+
+	older := types.VolumeSnapshot{Name: snapshotName(now.Add(-time.Hour))}
+	user := types.VolumeSnapshot{Name: "before-upgrade"}
+	latest := types.VolumeSnapshot{Name: snapshotName(now)}
+
+	assert.Empty(t, expiredSnapshots(nil))
+	assert.Empty(t, expiredSnapshots([]types.VolumeSnapshot{user, latest}))
+	// snapshots not taken by volumemgr are kept
+	assert.Equal(t, []types.VolumeSnapshot{older},
+		expiredSnapshots([]types.VolumeSnapshot{older, user, latest}))
+}
@@ -154,8 +154,10 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 				leftToProcess = true
 				continue
 			}
-			totalSize += blob.TotalSize
-			currentSize += blob.CurrentSize
+			// the content tree accounts for the whole blobs,
+			// including the chunks which were not downloaded
+			totalSize += blob.TotalSize + blob.ReusedSize
+			currentSize += blob.CurrentSize + blob.ReusedSize
 
 			// now the type should not be unknown (unless it is in error state)
 			// these calls might update Blob.State hence we check
@@ -603,6 +605,10 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 			}
 			status.ReferenceName = ctStatus.ReferenceID()
 			status.ContentFormat = ctStatus.Format
+			if status.IsContainer() {
+				// the containers are stored in the vault only
+				status.KeyEncrypted = false
+			}
 			changed = true
 			// Asynch preparation; ensure we have requested it
 			AddWorkPrepare(ctx, status)
@@ -713,6 +719,7 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 		if !status.HasError() {
 			status.State = types.CREATED_VOLUME
 			status.CreateTime = time.Now()
+			refreshVolumeSnapshots(ctx, status)
 		}
 		changed = true
 		// Work is done
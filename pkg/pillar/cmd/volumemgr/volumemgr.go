@@ -244,6 +244,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		workCreate:  {Request: volumeWorker, Response: processVolumeWorkResult},
 		workIngest:  {Request: casIngestWorker, Response: processCasIngestWorkResult},
 		workPrepare: {Request: volumePrepareWorker, Response: processVolumePrepareResult},
+		workExport:  {Request: volumeExportWorker, Response: processVolumeExportResult},
 	})
 
 	// Set up our publications before the subscriptions so ctx is set
@@ -62,6 +62,10 @@ type attestContext struct {
 	EventLogEntries []eventlog.Event
 	//EventLogParseErr stores any error that happened during EventLog parsing
 	EventLogParseErr error
+	//MeasurementReport describes EventLogEntries, see measuredboot.go
+	MeasurementReport zattest.MeasurementReport
+	//localVerifier is set (atomically) when quotes are verified on the device
+	localVerifier int32
 }
 
 const (
@@ -312,6 +316,7 @@ func (server *VerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
 		//or the TPM does not have SHA256 bank enabled for PCRs. We populate
 		//eventlog if we are able to parse eventlog successfully
 		encodeEventLog(attestCtx, attestReq.Quote)
+		encodeMeasurements(attestCtx, attestReq.Quote)
 
 		if len(attestReq.Quote.EventLog) > 0 && proto.Size(attestReq) > maxQuotePayloadSize {
 			log.Errorf("[ATTEST] attestReq size too much (%d) will remove large binaries", proto.Size(attestReq))
@@ -403,6 +408,7 @@ func (server *VerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
 			log.Noticeln("[ATTEST] no storage keys received from controller")
 			publishEncryptedKeyFromController(attestCtx, nil)
 		}
+		saveAttestedMeasurements(attestCtx)
 		ctx.ClearError()
 		triggerPublishDevInfo(attestCtx.zedagentCtx)
 		return nil
@@ -590,6 +596,7 @@ func parseTpmEventLog(attestCtx *attestContext) {
 	if err != nil {
 		log.Errorf("[ATTEST] Eventlog parsing error %v", err)
 	}
+	initMeasurementReport(attestCtx)
 }
 
 func encodeEventLog(attestCtx *attestContext, quoteMsg *attest.ZAttestQuote) error {
@@ -622,7 +629,7 @@ func cleanupEventLog(quoteMsg *attest.ZAttestQuote) {
 
 // initialize attest pubsub trigger handlers and channels
 func attestModuleInitialize(ctx *zedagentContext) error {
-	zattest.RegisterExternalIntf(&TpmAgentImpl{}, &VerifierImpl{}, &WatchdogImpl{})
+	zattest.RegisterExternalIntf(&TpmAgentImpl{}, &VerifierSelectorImpl{}, &WatchdogImpl{})
 
 	if ctx.attestCtx == nil {
 		ctx.attestCtx = &attestContext{}
@@ -710,6 +717,7 @@ func handleAttestQuoteImpl(ctxArg interface{}, key string,
 	attestCtx.InternalQuote = &types.AttestQuote{}
 	buf, _ := json.Marshal(&quote)
 	json.Unmarshal(buf, attestCtx.InternalQuote)
+	checkEventLogReplay(attestCtx, quote)
 
 	if attestCtx.attestFsmCtx == nil {
 		log.Fatalf("[ATTEST] Uninitialized access to attestFsmCtx")
@@ -196,6 +196,10 @@ func parseProfile(ctx *getconfigContext, config *zconfig.EdgeDevConfig) {
 		triggerLocalAppInfoPOST(ctx)
 		updateLocalDevInfoTicker(ctx, false)
 		triggerLocalDevInfoPOST(ctx)
+		updateLocalWireGuardTicker(ctx, false)
+		triggerLocalWireGuardPOST(ctx)
+		updateLocalAttestTicker(ctx, false)
+		triggerLocalAttestPOST(ctx)
 		ctx.lpsThrottledLocation = false
 	}
 	profileStateMachine(ctx, true)
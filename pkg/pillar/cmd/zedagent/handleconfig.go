@@ -120,6 +120,10 @@ type getconfigContext struct {
 
 	localAppInfoPOSTTicker flextimer.FlexTickerHandle
 	localDevInfoPOSTTicker flextimer.FlexTickerHandle
+	// WireGuard key rotations requested via local profile server.
+	localWireGuardPOSTTicker flextimer.FlexTickerHandle
+	// Attestation policy updates from local profile server.
+	localAttestPOSTTicker flextimer.FlexTickerHandle
 
 	// When enabled, device location reports are being published to the Local profile server
 	// at a significantly decreased rate.
@@ -129,6 +133,9 @@ type getconfigContext struct {
 	// localCommands : list of commands requested from a local server.
 	// This information is persisted under /persist/checkpoint/localcommands
 	localCommands *types.LocalCommands
+	// appRestarts : automatic restarts of the app instances by their
+	// restart policy, guarded by the lock of localCommands
+	appRestarts map[string]*appRestartState
 
 	callProcessLocalProfileServerChange bool //did we already call processLocalProfileServerChange
 
@@ -720,6 +720,16 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 			ReportAppMetric.Memory.UsedPercentage = dm.UsedMemoryPercent
 			availableMemoryPercent := 100.0 - dm.UsedMemoryPercent
 			ReportAppMetric.Memory.AvailPercentage = availableMemoryPercent
+
+			// Counters of the cgroup of the app instance
+			ReportAppMetric.Cpu.ThrottledPeriods = dm.CPUThrottledPeriods
+			ReportAppMetric.Cpu.ThrottledNs = dm.CPUThrottledNs
+			ReportAppMetric.PidsCurrent = dm.PidsCurrent
+			ReportAppMetric.PidsLimit = dm.PidsLimit
+			ReportAppMetric.IoReadBytes = dm.IoReadBytes
+			ReportAppMetric.IoWriteBytes = dm.IoWriteBytes
+			ReportAppMetric.IoReadOps = dm.IoReadOps
+			ReportAppMetric.IoWriteOps = dm.IoWriteOps
 		}
 
 		appInterfaceList := aiStatus.GetAppInterfaceList()
@@ -760,6 +770,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				networkDetails.RxAclDrops = metric.RxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+				networkDetails.TxClasses = trafficClassMetrics(metric.TxClasses)
+				networkDetails.RxClasses = trafficClassMetrics(metric.RxClasses)
 			} else {
 				// Note that the packets received on bu* and bo* where sent
 				// by the domU and vice versa, hence we swap here
@@ -775,6 +787,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				networkDetails.RxAclDrops = metric.TxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.RxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.TxAclRateLimitDrops
+				networkDetails.TxClasses = trafficClassMetrics(metric.RxClasses)
+				networkDetails.RxClasses = trafficClassMetrics(metric.TxClasses)
 			}
 			ReportAppMetric.Network = append(ReportAppMetric.Network,
 				networkDetails)
@@ -1075,6 +1089,20 @@ func encodeNetworkPortConfig(ctx *zedagentContext,
 	return dp
 }
 
+func trafficClassMetrics(classes []types.TrafficClassMetric) []*metrics.TrafficClassMetric {
+	var classMetrics []*metrics.TrafficClassMetric
+	for _, class := range classes {
+		classMetrics = append(classMetrics, &metrics.TrafficClassMetric{
+			Name:       class.Name,
+			Bytes:      class.Bytes,
+			Pkts:       class.Pkts,
+			Drops:      class.Drops,
+			Overlimits: class.Overlimits,
+		})
+	}
+	return classMetrics
+}
+
 // This function is called per change, hence needs to try over all management ports
 // When aiStatus is nil it means a delete and we send a message
 // containing only the UUID to inform zedcloud about the delete.
@@ -1112,6 +1140,19 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			ReportAppInfo.BootTime = bootTime
 		}
 
+		for _, blockedOn := range aiStatus.BlockedOnApps {
+			ReportAppInfo.BlockedOn = append(ReportAppInfo.BlockedOn,
+				blockedOn.String())
+		}
+
+		ReportAppInfo.GuestOsName = aiStatus.GuestInfo.OSName
+		ReportAppInfo.GuestOsVersion = aiStatus.GuestInfo.OSVersion
+		ReportAppInfo.GuestHostname = aiStatus.GuestInfo.Hostname
+		for _, ip := range aiStatus.GuestInfo.IPAddrs {
+			ReportAppInfo.GuestIpAddrs = append(ReportAppInfo.GuestIpAddrs,
+				ip.String())
+		}
+
 		for _, ia := range aiStatus.IoAdapterList {
 			reportAA := new(info.ZioBundle)
 			reportAA.Type = evecommon.PhyIoType(ia.Type)
@@ -1671,6 +1712,9 @@ func protoEncodeNetworkInstanceMetricProto(status types.NetworkInstanceMetrics)
 	case types.NetworkInstanceTypeCloud:
 		protoEncodeVpnInstanceMetric(status, metric)
 
+	case types.NetworkInstanceTypeVXLAN:
+		protoEncodeVxlanInstanceMetric(status, metric)
+
 	default:
 		protoEncodeGenericInstanceMetric(status, metric)
 	}
@@ -44,7 +44,12 @@ func handleNetworkInstanceImpl(ctxArg interface{}, key string,
 		log.Errorf("Received NetworkInstance error %s",
 			status.Error)
 	}
+	if status.ReconcileErr.HasError() {
+		log.Errorf("Received NetworkInstance reconcile error %s",
+			status.ReconcileErr.Error)
+	}
 	triggerPublishObjectInfo(ctx, zinfo.ZInfoTypes_ZiNetworkInstance, key)
+	processWireGuardKeyRotationStatus(ctx.getconfigCtx, status)
 	log.Functionf("handleNetworkInstanceImpl(%s) done", key)
 }
 
@@ -83,12 +88,17 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 		info.InstType = uint32(status.Type)
 		info.CurrentUplinkIntf = status.CurrentUplinkIntf
 
-		if !status.ErrorTime.IsZero() {
+		for _, niErr := range []types.ErrorAndTime{status.ErrorAndTime, status.ReconcileErr} {
+			if niErr.ErrorTime.IsZero() {
+				continue
+			}
 			errInfo := new(zinfo.ErrorInfo)
-			errInfo.Description = status.Error
-			errTime, _ := ptypes.TimestampProto(status.ErrorTime)
+			errInfo.Description = niErr.Error
+			errTime, _ := ptypes.TimestampProto(niErr.ErrorTime)
 			errInfo.Timestamp = errTime
 			info.NetworkErr = append(info.NetworkErr, errInfo)
+		}
+		if len(info.NetworkErr) > 0 {
 			info.State = zinfo.ZNetworkInstanceState_ZNETINST_STATE_ERROR
 		} else if status.Activated {
 			info.State = zinfo.ZNetworkInstanceState_ZNETINST_STATE_ONLINE
@@ -156,6 +166,9 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 		if status.VpnStatus != nil {
 			fillVpnInfo(info, status.VpnStatus)
 		}
+		if status.WireGuardStatus != nil {
+			fillWireGuardInfo(info, status.WireGuardStatus)
+		}
 	}
 
 	infoMsg.InfoContent = new(zinfo.ZInfoMsg_Niinfo)
@@ -235,6 +248,28 @@ func fillVpnInfo(info *zinfo.ZInfoNetworkInstance, vpnStatus *types.VpnStatus) {
 	}
 }
 
+func fillWireGuardInfo(info *zinfo.ZInfoNetworkInstance,
+	wgStatus *types.WireGuardStatus) {
+
+	wgInfo := new(zinfo.ZInfoWireGuard)
+	wgInfo.PublicKey = wgStatus.PublicKey
+	wgInfo.ListenPort = uint32(wgStatus.ListenPort)
+	wgInfo.KeyGeneration = wgStatus.KeyGeneration
+	for _, peer := range wgStatus.Peers {
+		peerInfo := new(zinfo.ZInfoWireGuardPeer)
+		peerInfo.PublicKey = peer.PublicKey
+		peerInfo.Endpoint = peer.Endpoint
+		if !peer.LastHandshake.IsZero() {
+			peerInfo.LastHandshake, _ = ptypes.TimestampProto(peer.LastHandshake)
+		}
+		peerInfo.Connected = peer.Connected
+		peerInfo.RxBytes = peer.RxBytes
+		peerInfo.TxBytes = peer.TxBytes
+		wgInfo.Peers = append(wgInfo.Peers, peerInfo)
+	}
+	info.InfoContent = &zinfo.ZInfoNetworkInstance_Wginfo{Wginfo: wgInfo}
+}
+
 func protoEncodeGenericInstanceMetric(status types.NetworkInstanceMetrics,
 	metric *zmet.ZMetricNetworkInstance) {
 	networkStats := new(zmet.ZMetricNetworkStats)
@@ -288,6 +323,32 @@ func protoEncodeVpnInstanceMetric(metrics types.NetworkInstanceMetrics,
 	protoEncodeVpnInstanceFlowMetric(metrics, instanceMetrics)
 }
 
+func protoEncodeVxlanInstanceMetric(metrics types.NetworkInstanceMetrics,
+	instanceMetrics *zmet.ZMetricNetworkInstance) {
+
+	protoEncodeGenericInstanceMetric(metrics, instanceMetrics)
+	if metrics.VxlanMetrics == nil {
+		return
+	}
+	stats := metrics.VxlanMetrics
+	vxlanMetric := new(zmet.ZMetricVxlan)
+	vxlanMetric.Vni = stats.VNI
+	vxlanMetric.Mtu = uint32(stats.MTU)
+	vxlanMetric.TunnelStat = protoEncodeVpnInstanceStat(stats.TunnelStats)
+	for _, peer := range stats.Peers {
+		peerMetric := new(zmet.ZMetricVxlanPeer)
+		peerMetric.Address = peer.Address
+		peerMetric.Stats = protoEncodeVpnInstanceStat(peer.Stats)
+		if !peer.LastHandshake.IsZero() {
+			peerMetric.LastHandshake, _ = ptypes.TimestampProto(peer.LastHandshake)
+		}
+		vxlanMetric.Peers = append(vxlanMetric.Peers, peerMetric)
+	}
+	instanceMetrics.InstanceContent = &zmet.ZMetricNetworkInstance_Vxlanm{
+		Vxlanm: vxlanMetric,
+	}
+}
+
 func protoEncodeVpnInstanceStat(stats types.LinkPktStats) *zmet.ZMetricConn {
 	connStat := new(zmet.ZMetricConn)
 	connStat.InPkts = new(zmet.PktStat)
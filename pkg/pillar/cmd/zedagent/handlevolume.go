@@ -32,6 +32,8 @@ func parseVolumeConfig(ctx *getconfigContext,
 	h := sha256.New()
 	for _, cfgVolume := range cfgVolumeList {
 		computeConfigElementSha(h, cfgVolume)
+		// the snapshot policy is set in the app instances
+		computeConfigElementSha(h, getVolumeSnapshotPolicy(cfgVolume, config))
 	}
 	newHash := h.Sum(nil)
 	if bytes.Equal(newHash, volumeHash) {
@@ -71,6 +73,7 @@ func parseVolumeConfig(ctx *getconfigContext,
 		} else {
 			// check links from apps
 			volume.HasNoAppReferences = checkVolumeHasNoAppReferences(ctx, cfgVolume, config)
+			volume.SnapshotPolicy = getVolumeSnapshotPolicy(cfgVolume, config)
 			publishVolumeConfig(ctx, volume)
 		}
 	}
@@ -104,6 +107,7 @@ func parseVolumeConfig(ctx *getconfigContext,
 		volumeConfig.RefCount = 1
 		volumeConfig.HasNoAppReferences = checkVolumeHasNoAppReferences(ctx, cfgVolume, config)
 		volumeConfig.Target = cfgVolume.GetTarget()
+		volumeConfig.SnapshotPolicy = getVolumeSnapshotPolicy(cfgVolume, config)
 
 		// Add config submitted via local profile server.
 		addLocalVolumeConfig(ctx, volumeConfig)
@@ -139,6 +143,28 @@ func checkVolumeHasNoAppReferences(ctx *getconfigContext, cfgVolume *zconfig.Vol
 	return true
 }
 
+// getVolumeSnapshotPolicy returns the snapshot policy of the volume, which
+// is requested by the app instances using it
+func getVolumeSnapshotPolicy(cfgVolume *zconfig.Volume,
+	devConfig *zconfig.EdgeDevConfig) types.VolumeSnapshotPolicy {
+
+	var policy types.VolumeSnapshotPolicy
+	for _, app := range devConfig.GetApps() {
+		for _, vr := range app.VolumeRefList {
+			if vr.Uuid == cfgVolume.GetUuid() &&
+				vr.GenerationCount == cfgVolume.GenerationCount {
+				if app.GetSnapshotBeforeUpdate() {
+					policy.SnapshotBeforeUpdate = true
+				}
+				if app.GetRollbackOnBootFailure() {
+					policy.RollbackOnBootFailure = true
+				}
+			}
+		}
+	}
+	return policy
+}
+
 func publishVolumeConfig(ctx *getconfigContext,
 	config types.VolumeConfig) {
 
@@ -84,6 +84,11 @@ func PublishHardwareInfoToZedCloud(ctx *zedagentContext) {
 		attrSmart.Temperature = getSmartAttr(types.SmartAttrIDTemperatureCelsius, disk.SmartAttrs)
 		stDiskInfo.SmartData = append(stDiskInfo.SmartData, attrSmart)
 
+		if health := lookupDiskHealthStatus(ctx, disk.DiskName, disk.SerialNumber); health != nil {
+			stDiskInfo.HealthRisk = info.DiskHealthRisk(health.Risk)
+			stDiskInfo.HealthErr = encodeErrorInfo(health.ErrorDescription)
+		}
+
 		hwInfo.Disks = append(hwInfo.Disks, stDiskInfo)
 	}
 
@@ -0,0 +1,623 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// all things related to attestation with the local verifier, for sites
+// without access to the Controller
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/info"
+	"github.com/lf-edge/eve/api/go/profile"
+	zattest "github.com/lf-edge/eve/pkg/pillar/attest"
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+const (
+	localAttestURLPath               = "/api/v1/attest"
+	localAttestPOSTInterval          = time.Minute
+	localAttestPOSTThrottledInterval = time.Hour
+	localAttestNonceSize             = 32
+	localAttestEscrowKeySize         = 32
+)
+
+// localAttestEscrow is the vault key escrowed on the device, encrypted
+// with a key sealed by TPM to the golden values of the vault sealing PCRs,
+// once for every combination of the golden values listed in the policy
+type localAttestEscrow struct {
+	Sealed     []sealedEscrowKey `json:"sealed"`
+	Nonce      []byte            `json:"nonce"`
+	Ciphertext []byte            `json:"ciphertext"`
+}
+
+type sealedEscrowKey struct {
+	Private []byte `json:"private"`
+	Public  []byte `json:"public"`
+}
+
+var throttledLocalAttest bool
+
+// LocalVerifierImpl implements zattest.Verifier interface, verifying quotes
+// on the device against the signed local attestation policy
+type LocalVerifierImpl struct{}
+
+// VerifierSelectorImpl implements zattest.Verifier interface, forwarding
+// requests either to the Controller or to the local verifier,
+// as selected by the attest.local.verifier config item
+type VerifierSelectorImpl struct {
+	controller VerifierImpl
+	local      LocalVerifierImpl
+}
+
+func (selector *VerifierSelectorImpl) verifier(ctx *zattest.Context) zattest.Verifier {
+	attestCtx, ok := ctx.OpaqueCtx.(*attestContext)
+	if !ok {
+		log.Fatalf("[ATTEST] Unexpected type from opaque ctx: %T",
+			ctx.OpaqueCtx)
+	}
+	if attestCtx.usesLocalVerifier() {
+		return &selector.local
+	}
+	return &selector.controller
+}
+
+// SendNonceRequest implements SendNonceRequest method of zattest.Verifier
+func (selector *VerifierSelectorImpl) SendNonceRequest(ctx *zattest.Context) error {
+	return selector.verifier(ctx).SendNonceRequest(ctx)
+}
+
+// SendAttestQuote implements SendAttestQuote method of zattest.Verifier
+func (selector *VerifierSelectorImpl) SendAttestQuote(ctx *zattest.Context) error {
+	return selector.verifier(ctx).SendAttestQuote(ctx)
+}
+
+// SendAttestEscrow implements SendAttestEscrow method of zattest.Verifier
+func (selector *VerifierSelectorImpl) SendAttestEscrow(ctx *zattest.Context) error {
+	return selector.verifier(ctx).SendAttestEscrow(ctx)
+}
+
+// usesLocalVerifier is safe to call from the attestation state machine,
+// while the selection is updated with global config.
+func (attestCtx *attestContext) usesLocalVerifier() bool {
+	return atomic.LoadInt32(&attestCtx.localVerifier) != 0
+}
+
+// updateAttestVerifier selects the verifier according to global config
+// and restarts attestation if it has changed.
+func updateAttestVerifier(ctx *zedagentContext) {
+	attestCtx := ctx.attestCtx
+	if attestCtx == nil {
+		return
+	}
+	useLocal := ctx.globalConfig.GlobalValueBool(types.AttestLocalVerifier)
+	if useLocal == attestCtx.usesLocalVerifier() {
+		return
+	}
+	var value int32
+	if useLocal {
+		value = 1
+		log.Noticef("[ATTEST] Switching to the local verifier")
+	} else {
+		log.Noticef("[ATTEST] Switching to the Controller verifier")
+	}
+	atomic.StoreInt32(&attestCtx.localVerifier, value)
+	if attestCtx.Started {
+		_ = restartAttestation(ctx)
+	}
+	if useLocal && ctx.getconfigCtx != nil && ctx.getconfigCtx.localAttestPOSTTicker.C != nil {
+		triggerLocalAttestPOST(ctx.getconfigCtx)
+	}
+}
+
+func loadAttestPolicySigner() (*x509.Certificate, error) {
+	certBytes, err := ioutil.ReadFile(types.AttestPolicySignerCertName)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(certBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s",
+			types.AttestPolicySignerCertName)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// readLocalAttestPolicy returns the policy in use with its signed content.
+// The signature is verified on every read, the file is not in the vault.
+func readLocalAttestPolicy() (*zattest.Policy, []byte, error) {
+	signer, err := loadAttestPolicySigner()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load policy signer: %v", err)
+	}
+	signedPolicy, err := ioutil.ReadFile(types.LocalAttestPolicyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, err := zattest.ParseSignedPolicy(signedPolicy, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return policy, signedPolicy, nil
+}
+
+// ingestLocalAttestPolicy verifies the received policy and replaces
+// the policy in use if the new one has a higher serial number.
+// Returns true if the policy in use has changed.
+func ingestLocalAttestPolicy(signedPolicy []byte) (bool, error) {
+	signer, err := loadAttestPolicySigner()
+	if err != nil {
+		return false, fmt.Errorf("failed to load policy signer: %v", err)
+	}
+	policy, err := zattest.ParseSignedPolicy(signedPolicy, signer)
+	if err != nil {
+		return false, err
+	}
+	current, currentBytes, err := readLocalAttestPolicy()
+	if err == nil {
+		if bytes.Equal(signedPolicy, currentBytes) {
+			return false, nil
+		}
+		if policy.Serial <= current.Serial {
+			return false, fmt.Errorf("policy serial %d is not higher than %d of the policy in use",
+				policy.Serial, current.Serial)
+		}
+	} else if !os.IsNotExist(err) {
+		log.Warnf("[ATTEST] Replacing unusable attestation policy: %v", err)
+	}
+	if err = os.MkdirAll(types.LocalAttestDirname, 0700); err != nil {
+		return false, err
+	}
+	if err = fileutils.WriteRename(types.LocalAttestPolicyFile, signedPolicy); err != nil {
+		return false, err
+	}
+	log.Noticef("[ATTEST] Attestation policy %d (%s) is now in use",
+		policy.Serial, policy.Description)
+	return true, nil
+}
+
+// ingestUSBAttestPolicy processes the policy copied from a USB stick
+// by device-steps.sh, if there is any.
+func ingestUSBAttestPolicy() bool {
+	signedPolicy, err := ioutil.ReadFile(types.LocalAttestIncomingPolicyFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("[ATTEST] Failed to read policy from USB: %v", err)
+		}
+		return false
+	}
+	changed, err := ingestLocalAttestPolicy(signedPolicy)
+	if err != nil {
+		log.Errorf("[ATTEST] Rejected policy from USB: %v", err)
+	}
+	if err = os.Remove(types.LocalAttestIncomingPolicyFile); err != nil {
+		log.Errorf("[ATTEST] Failed to remove policy from USB: %v", err)
+	}
+	return changed
+}
+
+// SendNonceRequest implements SendNonceRequest method of zattest.Verifier
+func (server *LocalVerifierImpl) SendNonceRequest(ctx *zattest.Context) error {
+	if ctx.OpaqueCtx == nil {
+		log.Fatalf("[ATTEST] Uninitialized access to OpaqueCtx")
+	}
+	attestCtx, ok := ctx.OpaqueCtx.(*attestContext)
+	if !ok {
+		log.Fatalf("[ATTEST] Unexpected type from opaque ctx: %T",
+			ctx.OpaqueCtx)
+	}
+	if len(attestCtx.Nonce) > 0 {
+		//Clear existing nonce before generating another one
+		unpublishAttestNonce(attestCtx)
+		attestCtx.Nonce = nil
+	}
+	// Do not bother TPM with quotes before there is a policy to verify them against.
+	if _, _, err := readLocalAttestPolicy(); err != nil {
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] No valid local attestation policy: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrNoLocalPolicy
+	}
+	nonce := make([]byte, localAttestNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		log.Fatalf("[ATTEST] Failed to generate nonce: %v", err)
+	}
+	attestCtx.Nonce = nonce
+	ctx.ClearError()
+	triggerPublishDevInfo(attestCtx.zedagentCtx)
+	return nil
+}
+
+// SendAttestQuote implements SendAttestQuote method of zattest.Verifier
+func (server *LocalVerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
+	if ctx.OpaqueCtx == nil {
+		log.Fatalf("[ATTEST] Uninitialized access to OpaqueCtx")
+	}
+	attestCtx, ok := ctx.OpaqueCtx.(*attestContext)
+	if !ok {
+		log.Fatalf("[ATTEST] Unexpected type from opaque ctx: %T",
+			ctx.OpaqueCtx)
+	}
+	policy, _, err := readLocalAttestPolicy()
+	if err != nil {
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] No valid local attestation policy: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrNoLocalPolicy
+	}
+	attestKey, err := etpm.GetPublicKeyFromCert(types.AttestCertName)
+	if err != nil {
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] Failed to load attestation key: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrNoCertYet
+	}
+	pcrs, err := zattest.VerifyQuote(*attestCtx.InternalQuote, attestCtx.Nonce, attestKey)
+	if err == nil && len(policy.EventLogPCRs) > 0 && attestCtx.EventLogParseErr != nil {
+		err = fmt.Errorf("event log is not available: %v", attestCtx.EventLogParseErr)
+	}
+	if err == nil {
+		err = policy.Verify(pcrs, attestCtx.EventLogEntries)
+	}
+	if err != nil {
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] Local verification failed: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		if errors.Is(err, zattest.ErrNonceMismatch) {
+			return zattest.ErrNonceMismatch
+		}
+		return zattest.ErrQuoteMismatch
+	}
+	log.Noticef("[ATTEST] Local verification with policy %d successful", policy.Serial)
+	if err = releaseEscrowedVaultKey(attestCtx); err != nil {
+		// The escrow was sealed to golden values of a policy which
+		// does not cover the current boot, the vault stays locked.
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] Failed to release escrowed vault key: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrQuoteMismatch
+	}
+	saveAttestedMeasurements(attestCtx)
+	ctx.ClearError()
+	triggerPublishDevInfo(attestCtx.zedagentCtx)
+	return nil
+}
+
+// releaseEscrowedVaultKey hands the vault key escrowed after the last
+// successful local attestation over to vaultmgr. This is what allows
+// to unlock the vault after the sealing PCRs have changed, e.g. with
+// an EVE update covered by the policy. TPM unseals the escrow only if the
+// PCRs have golden values of the policy in use when it was made.
+func releaseEscrowedVaultKey(attestCtx *attestContext) error {
+	escrowBytes, err := ioutil.ReadFile(types.LocalAttestEscrowFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("[ATTEST] Failed to read escrowed vault key: %v", err)
+		}
+		// Same as when the Controller has no key for us.
+		log.Noticeln("[ATTEST] no escrowed vault key")
+		publishEncryptedKeyFromController(attestCtx, nil)
+		return nil
+	}
+	escrowedKey, err := openLocalAttestEscrow(escrowBytes)
+	if err != nil {
+		return err
+	}
+	publishEncryptedKeyFromController(attestCtx, escrowedKey)
+	log.Noticef("[ATTEST] released escrowed vault key")
+	return nil
+}
+
+func openLocalAttestEscrow(escrowBytes []byte) ([]byte, error) {
+	var escrow localAttestEscrow
+	if err := json.Unmarshal(escrowBytes, &escrow); err != nil {
+		return nil, fmt.Errorf("failed to parse escrowed vault key: %v", err)
+	}
+	var key []byte
+	var err error
+	for _, sealed := range escrow.Sealed {
+		key, err = etpm.UnsealWithPCRs(sealed.Private, sealed.Public,
+			etpm.DiskKeySealingPCRs)
+		if err == nil {
+			break
+		}
+	}
+	if key == nil {
+		return nil, fmt.Errorf("escrowed vault key is not sealed to the current PCR values: %v",
+			err)
+	}
+	gcm, err := newEscrowCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, escrow.Nonce, escrow.Ciphertext, nil)
+}
+
+// sealLocalAttestEscrow encrypts the vault key with a random key,
+// which is sealed to every combination of golden values of the vault
+// sealing PCRs in the policy.
+func sealLocalAttestEscrow(escrowData []byte) ([]byte, error) {
+	policy, _, err := readLocalAttestPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("no valid local attestation policy: %v", err)
+	}
+	pcrDigests, err := policy.PCRDigests(etpm.DiskKeySealingPCRs.PCRs)
+	if err != nil {
+		return nil, err
+	}
+	key, err := etpm.GetRandom(localAttestEscrowKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate escrow key: %v", err)
+	}
+	var escrow localAttestEscrow
+	for _, pcrDigest := range pcrDigests {
+		priv, public, err := etpm.SealToPCRDigest(key, etpm.DiskKeySealingPCRs, pcrDigest)
+		if err != nil {
+			return nil, err
+		}
+		escrow.Sealed = append(escrow.Sealed, sealedEscrowKey{Private: priv, Public: public})
+	}
+	gcm, err := newEscrowCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	escrow.Nonce = make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(escrow.Nonce); err != nil {
+		return nil, err
+	}
+	escrow.Ciphertext = gcm.Seal(nil, escrow.Nonce, escrowData, nil)
+	return json.Marshal(escrow)
+}
+
+func newEscrowCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// SendAttestEscrow implements SendAttestEscrow method of zattest.Verifier
+func (server *LocalVerifierImpl) SendAttestEscrow(ctx *zattest.Context) error {
+	if ctx.OpaqueCtx == nil {
+		log.Fatalf("[ATTEST] Uninitialized access to OpaqueCtx")
+	}
+	attestCtx, ok := ctx.OpaqueCtx.(*attestContext)
+	if !ok {
+		log.Fatalf("[ATTEST] Unexpected type from opaque ctx: %T",
+			ctx.OpaqueCtx)
+	}
+	if attestCtx.SkipEscrow {
+		log.Notice("[ATTEST] Escrow successful skipped")
+		return nil
+	}
+	if attestCtx.EscrowData == nil {
+		errorDescription := types.ErrorDescription{Error: "[ATTEST] No escrow data"}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrNoEscrowData
+	}
+	// Without golden values of all the vault sealing PCRs in the policy
+	// there is nothing to seal the escrow to and the escrow is not made.
+	escrow, err := sealLocalAttestEscrow(attestCtx.EscrowData)
+	if err == nil {
+		err = os.MkdirAll(types.LocalAttestDirname, 0700)
+	}
+	if err == nil {
+		err = fileutils.WriteRename(types.LocalAttestEscrowFile, escrow)
+	}
+	if err != nil {
+		errorDescription := types.ErrorDescription{
+			Error: fmt.Sprintf("[ATTEST] Failed to escrow vault key: %v", err),
+		}
+		log.Error(errorDescription.Error)
+		setAttestErrorAndTriggerInfo(ctx, errorDescription)
+		return zattest.ErrControllerReqFailed
+	}
+	log.Noticef("[ATTEST] Escrowed vault key locally, len %d", len(attestCtx.EscrowData))
+	ctx.ClearError()
+	triggerPublishDevInfo(attestCtx.zedagentCtx)
+	// we stored the key successfully and do not allow to clean vault
+	if err := vault.DisallowVaultCleanup(); err != nil {
+		log.Errorf("cannot disallow vault cleanup: %s", err)
+	}
+	return nil
+}
+
+// updateLocalAttestTicker sets ticker options to the initial value
+// if throttle set, will use localAttestPOSTThrottledInterval as interval
+func updateLocalAttestTicker(ctx *getconfigContext, throttle bool) {
+	interval := float64(localAttestPOSTInterval)
+	if throttle {
+		interval = float64(localAttestPOSTThrottledInterval)
+	}
+	max := 1.1 * interval
+	min := 0.8 * max
+	throttledLocalAttest = throttle
+	ctx.localAttestPOSTTicker.UpdateRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func initializeLocalAttest(ctx *getconfigContext) {
+	max := 1.1 * float64(localAttestPOSTInterval)
+	min := 0.8 * max
+	ctx.localAttestPOSTTicker = flextimer.NewRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func triggerLocalAttestPOST(ctx *getconfigContext) {
+	log.Functionf("Triggering POST for %s to local server", localAttestURLPath)
+	if throttledLocalAttest {
+		log.Functionln("throttledLocalAttest flag set")
+		return
+	}
+	ctx.localAttestPOSTTicker.TickNow()
+}
+
+// Run a periodic check for a new attestation policy from USB stick and
+// a periodic POST request to send the attestation status to local server
+// and optionally receive a new policy in the response.
+// Only active with the local verifier.
+func localAttestPOSTTask(ctx *getconfigContext) {
+
+	log.Functionf("localAttestPOSTTask: waiting for localAttestPOSTTicker")
+	// wait for the first trigger
+	<-ctx.localAttestPOSTTicker.C
+	log.Functionln("localAttestPOSTTask: waiting for localAttestPOSTTicker done")
+	// trigger again to pass into the loop
+	triggerLocalAttestPOST(ctx)
+
+	wdName := agentName + "-localattest"
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(25 * time.Second)
+	ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	ctx.zedagentCtx.ps.RegisterFileWatchdog(wdName)
+
+	for {
+		select {
+		case <-ctx.localAttestPOSTTicker.C:
+			attestCtx := ctx.zedagentCtx.attestCtx
+			if attestCtx == nil || !attestCtx.usesLocalVerifier() {
+				break
+			}
+			start := time.Now()
+			changed := ingestUSBAttestPolicy()
+			if postLocalAttestInfo(ctx) {
+				changed = true
+			}
+			if changed {
+				// Verify the device with the new policy.
+				_ = restartAttestation(ctx.zedagentCtx)
+			}
+			ctx.zedagentCtx.ps.CheckMaxTimeTopic(wdName, "localAttestPOSTTask", start,
+				warningTime, errorTime)
+		case <-stillRunning.C:
+		}
+		ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// Post the attestation status to the local server and ingest a new policy
+// if it is provided in the response. Returns true if the policy in use
+// has changed.
+func postLocalAttestInfo(ctx *getconfigContext) bool {
+	localProfileServer := ctx.localProfileServer
+	if localProfileServer == "" {
+		return false
+	}
+	localServerURL, err := makeLocalServerBaseURL(localProfileServer)
+	if err != nil {
+		log.Errorf("sendLocalAttestInfo: makeLocalServerBaseURL: %v", err)
+		return false
+	}
+	if !ctx.localServerMap.upToDate {
+		err := updateLocalServerMap(ctx, localServerURL)
+		if err != nil {
+			log.Errorf("sendLocalAttestInfo: updateLocalServerMap: %v", err)
+			return false
+		}
+		// Make sure HasLocalServer is set correctly for the AppInstanceConfig
+		updateHasLocalServer(ctx)
+	}
+	srvMap := ctx.localServerMap.servers
+	if len(srvMap) == 0 {
+		log.Functionf("sendLocalAttestInfo: cannot find any configured apps for localServerURL: %s",
+			localServerURL)
+		return false
+	}
+
+	localInfo := prepareLocalAttestInfo(ctx)
+	var errList []string
+	for bridgeName, servers := range srvMap {
+		for _, srv := range servers {
+			fullURL := srv.localServerAddr + localAttestURLPath
+			attestPolicy := &profile.LocalAttestPolicy{}
+			resp, err := zedcloud.SendLocalProto(
+				zedcloudCtx, fullURL, bridgeName, srv.bridgeIP, localInfo, attestPolicy)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("SendLocalProto: %v", err))
+				continue
+			}
+			switch resp.StatusCode {
+			case http.StatusNotFound:
+				// Throttle sending to be about once per hour.
+				updateLocalAttestTicker(ctx, true)
+				return false
+			case http.StatusOK, http.StatusCreated:
+				if len(attestPolicy.SignedPolicy) != 0 {
+					if attestPolicy.GetServerToken() != ctx.profileServerToken {
+						errList = append(errList,
+							fmt.Sprintf("invalid token submitted by local server (%s)",
+								attestPolicy.GetServerToken()))
+						continue
+					}
+					updateLocalAttestTicker(ctx, false)
+					changed, err := ingestLocalAttestPolicy(attestPolicy.SignedPolicy)
+					if err != nil {
+						log.Errorf("[ATTEST] Rejected policy from local server: %v", err)
+					}
+					return changed
+				}
+				// No content in the response.
+				fallthrough
+			case http.StatusNoContent:
+				log.Functionf("Local server %s does not provide a new attestation policy",
+					localServerURL)
+				updateLocalAttestTicker(ctx, false)
+				return false
+			default:
+				errList = append(errList, fmt.Sprintf("SendLocal: wrong response status code: %d",
+					resp.StatusCode))
+				continue
+			}
+		}
+	}
+	log.Errorf("sendLocalAttestInfo: all attempts failed: %s", strings.Join(errList, ";"))
+	return false
+}
+
+func prepareLocalAttestInfo(ctx *getconfigContext) *profile.LocalAttestInfo {
+	msg := &profile.LocalAttestInfo{}
+	if policy, _, err := readLocalAttestPolicy(); err == nil {
+		msg.PolicySerial = policy.Serial
+	}
+	attestCtx := ctx.zedagentCtx.attestCtx
+	if attestCtx != nil && attestCtx.attestFsmCtx != nil {
+		msg.Attestation = &info.AttestationInfo{
+			State: info.AttestationState(attestCtx.attestFsmCtx.GetState()),
+		}
+		if attestCtx.attestFsmCtx.HasError() {
+			msg.Attestation.Error = encodeErrorInfo(attestCtx.attestFsmCtx.ErrorDescription)
+		}
+	}
+	return msg
+}
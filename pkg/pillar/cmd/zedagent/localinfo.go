@@ -333,6 +333,30 @@ func triggerLocalCommand(ctx *getconfigContext, cmd types.AppCommand,
 			changedVolumes = true
 		}
 		checkAndPublishAppInstanceConfig(ctx, *app)
+
+	case types.AppCommandSnapshot:
+		// zedmanager restarts the application and snapshots its volumes
+		// while it is stopped.
+		appCounters.SnapshotCmd.Counter++
+		appCounters.SnapshotCmd.ApplyTime = timestamp
+		app.LocalSnapshotCmd = appCounters.SnapshotCmd
+		checkAndPublishAppInstanceConfig(ctx, *app)
+
+	case types.AppCommandRollback:
+		// zedmanager restarts the application and reverts its volumes
+		// to their latest snapshots while it is stopped.
+		appCounters.RollbackCmd.Counter++
+		appCounters.RollbackCmd.ApplyTime = timestamp
+		app.LocalRollbackCmd = appCounters.RollbackCmd
+		checkAndPublishAppInstanceConfig(ctx, *app)
+
+	case types.AppCommandExport:
+		// zedmanager restarts the application and has volumemgr export
+		// its volumes into the app archive while it is stopped.
+		appCounters.ExportCmd.Counter++
+		appCounters.ExportCmd.ApplyTime = timestamp
+		app.LocalExportCmd = appCounters.ExportCmd
+		checkAndPublishAppInstanceConfig(ctx, *app)
 	}
 	return changedVolumes
 }
@@ -372,6 +396,14 @@ func processAppCommandStatus(
 			updated = true
 			log.Noticef("Local purge completed: %+v", appCmd)
 		}
+	case types.AppCommandSnapshot, types.AppCommandRollback, types.AppCommandExport:
+		if appStatus.RestartStartedAt.After(appCmd.DeviceTimestamp) &&
+			appStatus.VolumeSnapshotOp == types.NoVolumeSnapshotOp {
+			appCmd.Completed = true
+			appCmd.LastCompletedTimestamp = appCmd.LocalServerTimestamp
+			updated = true
+			log.Noticef("Local snapshot command completed: %+v", appCmd)
+		}
 	}
 	if updated {
 		persistLocalCommands(ctx.localCommands)
@@ -386,6 +418,9 @@ func addLocalAppConfig(ctx *getconfigContext, appInstance *types.AppInstanceConf
 	if hasCounters {
 		appInstance.LocalRestartCmd = appCounters.RestartCmd
 		appInstance.LocalPurgeCmd = appCounters.PurgeCmd
+		appInstance.LocalSnapshotCmd = appCounters.SnapshotCmd
+		appInstance.LocalRollbackCmd = appCounters.RollbackCmd
+		appInstance.LocalExportCmd = appCounters.ExportCmd
 	}
 	for i := range appInstance.VolumeRefConfigList {
 		vr := &appInstance.VolumeRefConfigList[i]
@@ -399,6 +434,7 @@ func addLocalAppConfig(ctx *getconfigContext, appInstance *types.AppInstanceConf
 func delLocalAppConfig(ctx *getconfigContext, appUUID string) {
 	delete(ctx.localCommands.AppCommands, appUUID)
 	delete(ctx.localCommands.AppCounters, appUUID)
+	forgetAppRestarts(ctx, appUUID)
 	persistLocalCommands(ctx.localCommands)
 }
 
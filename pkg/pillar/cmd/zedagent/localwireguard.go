@@ -0,0 +1,326 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/profile"
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	localWireGuardURLPath               = "/api/v1/wireguard"
+	localWireGuardPOSTInterval          = time.Minute
+	localWireGuardPOSTThrottledInterval = time.Hour
+)
+
+var throttledLocalWireGuard bool
+
+// updateLocalWireGuardTicker sets ticker options to the initial value
+// if throttle set, will use localWireGuardPOSTThrottledInterval as interval
+func updateLocalWireGuardTicker(ctx *getconfigContext, throttle bool) {
+	interval := float64(localWireGuardPOSTInterval)
+	if throttle {
+		interval = float64(localWireGuardPOSTThrottledInterval)
+	}
+	max := 1.1 * interval
+	min := 0.8 * max
+	throttledLocalWireGuard = throttle
+	ctx.localWireGuardPOSTTicker.UpdateRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func initializeLocalWireGuard(ctx *getconfigContext) {
+	max := 1.1 * float64(localWireGuardPOSTInterval)
+	min := 0.8 * max
+	ctx.localWireGuardPOSTTicker = flextimer.NewRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func triggerLocalWireGuardPOST(ctx *getconfigContext) {
+	log.Functionf("Triggering POST for %s to local server", localWireGuardURLPath)
+	if throttledLocalWireGuard {
+		log.Functionln("throttledLocalWireGuard flag set")
+		return
+	}
+	ctx.localWireGuardPOSTTicker.TickNow()
+}
+
+// Run a periodic POST request to send information about WireGuard network
+// instances to local server and optionally receive key rotation requests
+// in the response.
+func localWireGuardPOSTTask(ctx *getconfigContext) {
+
+	log.Functionf("localWireGuardPOSTTask: waiting for localWireGuardPOSTTicker")
+	// wait for the first trigger
+	<-ctx.localWireGuardPOSTTicker.C
+	log.Functionln("localWireGuardPOSTTask: waiting for localWireGuardPOSTTicker done")
+	// trigger again to pass into the loop
+	triggerLocalWireGuardPOST(ctx)
+
+	wdName := agentName + "-localwireguard"
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(25 * time.Second)
+	ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	ctx.zedagentCtx.ps.RegisterFileWatchdog(wdName)
+
+	for {
+		select {
+		case <-ctx.localWireGuardPOSTTicker.C:
+			start := time.Now()
+			cmdList := postLocalWireGuardInfo(ctx)
+			if cmdList != nil {
+				processReceivedWireGuardKeyRotations(ctx, cmdList)
+			}
+			ctx.zedagentCtx.ps.CheckMaxTimeTopic(wdName, "localWireGuardPOSTTask", start,
+				warningTime, errorTime)
+		case <-stillRunning.C:
+		}
+		ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// Post the current state of WireGuard network instances to the local server
+// and optionally receive a set of key rotations to perform in the response.
+func postLocalWireGuardInfo(ctx *getconfigContext) *profile.LocalWireGuardCmdList {
+	localProfileServer := ctx.localProfileServer
+	if localProfileServer == "" {
+		return nil
+	}
+	localInfo := prepareLocalWireGuardInfo(ctx)
+	if len(localInfo.NetworkInstances) == 0 {
+		// Nothing to report.
+		return nil
+	}
+	localServerURL, err := makeLocalServerBaseURL(localProfileServer)
+	if err != nil {
+		log.Errorf("sendLocalWireGuardInfo: makeLocalServerBaseURL: %v", err)
+		return nil
+	}
+	if !ctx.localServerMap.upToDate {
+		err := updateLocalServerMap(ctx, localServerURL)
+		if err != nil {
+			log.Errorf("sendLocalWireGuardInfo: updateLocalServerMap: %v", err)
+			return nil
+		}
+		// Make sure HasLocalServer is set correctly for the AppInstanceConfig
+		updateHasLocalServer(ctx)
+	}
+	srvMap := ctx.localServerMap.servers
+	if len(srvMap) == 0 {
+		log.Functionf("sendLocalWireGuardInfo: cannot find any configured apps for localServerURL: %s",
+			localServerURL)
+		return nil
+	}
+
+	var errList []string
+	for bridgeName, servers := range srvMap {
+		for _, srv := range servers {
+			fullURL := srv.localServerAddr + localWireGuardURLPath
+			cmdList := &profile.LocalWireGuardCmdList{}
+			resp, err := zedcloud.SendLocalProto(
+				zedcloudCtx, fullURL, bridgeName, srv.bridgeIP, localInfo, cmdList)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("SendLocalProto: %v", err))
+				continue
+			}
+			switch resp.StatusCode {
+			case http.StatusNotFound:
+				// Throttle sending to be about once per hour.
+				updateLocalWireGuardTicker(ctx, true)
+				return nil
+			case http.StatusOK, http.StatusCreated:
+				if len(cmdList.KeyRotations) != 0 {
+					if cmdList.GetServerToken() != ctx.profileServerToken {
+						errList = append(errList,
+							fmt.Sprintf("invalid token submitted by local server (%s)",
+								cmdList.GetServerToken()))
+						continue
+					}
+					updateLocalWireGuardTicker(ctx, false)
+					return cmdList
+				}
+				// No content in the response.
+				fallthrough
+			case http.StatusNoContent:
+				log.Functionf("Local server %s does not request any WireGuard key rotation",
+					localServerURL)
+				updateLocalWireGuardTicker(ctx, false)
+				return nil
+			default:
+				errList = append(errList, fmt.Sprintf("SendLocal: wrong response status code: %d",
+					resp.StatusCode))
+				continue
+			}
+		}
+	}
+	log.Errorf("sendLocalWireGuardInfo: all attempts failed: %s", strings.Join(errList, ";"))
+	return nil
+}
+
+func prepareLocalWireGuardInfo(ctx *getconfigContext) *profile.LocalWireGuardInfoList {
+	msg := profile.LocalWireGuardInfoList{}
+	ctx.localCommands.Lock()
+	defer ctx.localCommands.Unlock()
+	addNetworkInstanceFunc := func(key string, value interface{}) bool {
+		status := value.(types.NetworkInstanceStatus)
+		if status.Type != types.NetworkInstanceTypeWireGuard {
+			return true
+		}
+		niInfo := new(profile.LocalWireGuardInfo)
+		niInfo.Id = status.UUIDandVersion.UUID.String()
+		niInfo.Name = status.DisplayName
+		if status.WireGuardStatus != nil {
+			niInfo.PublicKey = status.WireGuardStatus.PublicKey
+		}
+		if rotation, hasEntry := ctx.localCommands.WireGuardKeyRotations[niInfo.Id]; hasEntry {
+			niInfo.LastCmdTimestamp = rotation.LastCompletedTimestamp
+		}
+		msg.NetworkInstances = append(msg.NetworkInstances, niInfo)
+		return true
+	}
+	ctx.zedagentCtx.subNetworkInstanceStatus.Iterate(addNetworkInstanceFunc)
+	return &msg
+}
+
+func processReceivedWireGuardKeyRotations(ctx *getconfigContext,
+	cmdList *profile.LocalWireGuardCmdList) {
+	ctx.localCommands.Lock()
+	defer ctx.localCommands.Unlock()
+
+	var changes bool
+	processedNIs := make(map[string]struct{})
+	for _, rotationReq := range cmdList.KeyRotations {
+		var err error
+		niUUID := nilUUID
+		if rotationReq.Id != "" {
+			niUUID, err = uuid.FromString(rotationReq.Id)
+			if err != nil {
+				log.Warnf("Failed to parse UUID from WireGuard key rotation request: %v",
+					err)
+				continue
+			}
+		}
+		displayName := rotationReq.Displayname
+		if niUUID == nilUUID && displayName == "" {
+			log.Warnf("WireGuard key rotation request is missing both UUID "+
+				"and display name: %+v", rotationReq)
+			continue
+		}
+		niConfig := findWireGuardNetworkInstance(ctx, niUUID, displayName)
+		if niConfig == nil {
+			log.Warnf("Failed to find WireGuard network instance with UUID=%s, "+
+				"displayName=%s", niUUID, displayName)
+			continue
+		}
+		niUUID = niConfig.UUIDandVersion.UUID
+		if _, duplicate := processedNIs[niUUID.String()]; duplicate {
+			log.Warnf("Multiple key rotations requested for network instance "+
+				"with UUID=%s", niUUID)
+			continue
+		}
+		processedNIs[niUUID.String()] = struct{}{}
+
+		// Accept (or skip already accepted) key rotation.
+		rotation, hasEntry := ctx.localCommands.WireGuardKeyRotations[niUUID.String()]
+		if !hasEntry {
+			rotation = &types.LocalWireGuardKeyRotation{}
+			if ctx.localCommands.WireGuardKeyRotations == nil {
+				ctx.localCommands.WireGuardKeyRotations =
+					make(map[string]*types.LocalWireGuardKeyRotation)
+			}
+			ctx.localCommands.WireGuardKeyRotations[niUUID.String()] = rotation
+		}
+		if rotation.LocalServerTimestamp == rotationReq.Timestamp {
+			// already accepted
+			continue
+		}
+		rotation.Counter++
+		rotation.LocalServerTimestamp = rotationReq.Timestamp
+		rotation.Completed = false
+		changes = true
+		log.Noticef("Accepted local WireGuard key rotation for network instance %s: %+v",
+			niUUID, rotation)
+
+		// Re-publish configuration with the increased counter to trigger the rotation.
+		niConfig.WireGuard.LocalKeyRotation = rotation.Counter
+		ctx.pubNetworkInstanceConfig.Publish(niConfig.Key(), *niConfig)
+	}
+
+	if changes {
+		persistLocalCommands(ctx.localCommands)
+	} else {
+		touchLocalCommands()
+	}
+}
+
+// processWireGuardKeyRotationStatus marks locally requested key rotation
+// as completed once zedrouter reports that the new key pair is used.
+func processWireGuardKeyRotationStatus(ctx *getconfigContext,
+	status types.NetworkInstanceStatus) {
+	if status.Type != types.NetworkInstanceTypeWireGuard ||
+		status.WireGuardStatus == nil {
+		return
+	}
+	ctx.localCommands.Lock()
+	defer ctx.localCommands.Unlock()
+	rotation, hasEntry := ctx.localCommands.WireGuardKeyRotations[status.Key()]
+	if !hasEntry || rotation.Completed {
+		return
+	}
+	if status.WireGuardStatus.LocalKeyRotation < rotation.Counter {
+		// Rotation is still ongoing.
+		return
+	}
+	rotation.Completed = true
+	rotation.LastCompletedTimestamp = rotation.LocalServerTimestamp
+	log.Noticef("Local WireGuard key rotation completed for network instance %s: %+v",
+		status.Key(), rotation)
+	persistLocalCommands(ctx.localCommands)
+	// Let the local server learn the new public key.
+	triggerLocalWireGuardPOST(ctx)
+}
+
+func findWireGuardNetworkInstance(ctx *getconfigContext, niUUID uuid.UUID,
+	displayName string) (niConfig *types.NetworkInstanceConfig) {
+	matchNI := func(_ string, value interface{}) bool {
+		config := value.(types.NetworkInstanceConfig)
+		if config.Type == types.NetworkInstanceTypeWireGuard &&
+			(niUUID == nilUUID || niUUID == config.UUIDandVersion.UUID) &&
+			(displayName == "" || displayName == config.DisplayName) {
+			niConfig = &config
+			// stop iteration
+			return false
+		}
+		return true
+	}
+	ctx.pubNetworkInstanceConfig.Iterate(matchNI)
+	return niConfig
+}
+
+// Add config submitted for the network instance via local profile server.
+// ctx.localCommands should be locked!
+func addLocalNetworkInstanceConfig(ctx *getconfigContext,
+	niConfig *types.NetworkInstanceConfig) {
+	uuid := niConfig.UUIDandVersion.UUID.String()
+	if rotation, hasEntry := ctx.localCommands.WireGuardKeyRotations[uuid]; hasEntry {
+		niConfig.WireGuard.LocalKeyRotation = rotation.Counter
+	}
+}
+
+// Delete all local config for this network instance.
+// ctx.localCommands should be locked!
+func delLocalNetworkInstanceConfig(ctx *getconfigContext, niUUID string) {
+	if _, hasEntry := ctx.localCommands.WireGuardKeyRotations[niUUID]; !hasEntry {
+		return
+	}
+	delete(ctx.localCommands.WireGuardKeyRotations, niUUID)
+	persistLocalCommands(ctx.localCommands)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// Measured boot components as recorded in the TPM event log, compared
+// with the quoted PCRs and with the last boot attested successfully
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/lf-edge/eve/api/go/attest"
+	zattest "github.com/lf-edge/eve/pkg/pillar/attest"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// initMeasurementReport lists measurements from the parsed event log
+// and their changes since the last boot attested successfully
+func initMeasurementReport(attestCtx *attestContext) {
+	report := &attestCtx.MeasurementReport
+	if attestCtx.EventLogParseErr != nil {
+		report.EventLogError = attestCtx.EventLogParseErr.Error()
+		writeMeasurementReport(attestCtx)
+		return
+	}
+	report.Measurements = zattest.Measurements(attestCtx.EventLogEntries)
+	previous, err := loadAttestedMeasurements()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("[ATTEST] Failed to load attested measurements: %v", err)
+		}
+		writeMeasurementReport(attestCtx)
+		return
+	}
+	report.HasBaseline = true
+	report.Changes = zattest.DiffMeasurements(previous, report.Measurements)
+	if len(report.Changes) > 0 {
+		log.Noticef("[ATTEST] %d measurements changed since the last successful attestation",
+			len(report.Changes))
+	}
+	for _, change := range report.Changes {
+		log.Noticef("[ATTEST] %s %s %q in PCR %d", change.Type, change.Component,
+			change.Name, change.PCRIndex)
+	}
+	writeMeasurementReport(attestCtx)
+}
+
+// checkEventLogReplay compares the event log with PCR values in the quote
+func checkEventLogReplay(attestCtx *attestContext, quote types.AttestQuote) {
+	if attestCtx.EventLogParseErr != nil {
+		return
+	}
+	replay, err := zattest.CheckEventLogReplay(attestCtx.EventLogEntries, quote.PCRs)
+	if err != nil {
+		log.Errorf("[ATTEST] Failed to replay event log: %v", err)
+	}
+	for _, pcr := range replay {
+		if !pcr.Match {
+			log.Warnf("[ATTEST] Event log replay does not match quoted PCR %d: %x vs %x",
+				pcr.Index, pcr.Replayed, pcr.Quoted)
+		}
+	}
+	attestCtx.MeasurementReport.PCRReplay = replay
+	writeMeasurementReport(attestCtx)
+}
+
+func writeMeasurementReport(attestCtx *attestContext) {
+	data, err := json.MarshalIndent(attestCtx.MeasurementReport, "", "  ")
+	if err != nil {
+		log.Errorf("[ATTEST] Failed to marshal measurement report: %v", err)
+		return
+	}
+	if err = fileutils.WriteRename(types.AttestMeasurementReportFile, data); err != nil {
+		log.Errorf("[ATTEST] Failed to write measurement report: %v", err)
+	}
+}
+
+func loadAttestedMeasurements() ([]zattest.Measurement, error) {
+	data, err := ioutil.ReadFile(types.AttestedMeasurementsFile)
+	if err != nil {
+		return nil, err
+	}
+	var measurements []zattest.Measurement
+	if err = json.Unmarshal(data, &measurements); err != nil {
+		return nil, err
+	}
+	return measurements, nil
+}
+
+// saveAttestedMeasurements stores measurements of the current boot
+// to be compared with in the next boots, after successful attestation
+func saveAttestedMeasurements(attestCtx *attestContext) {
+	measurements := attestCtx.MeasurementReport.Measurements
+	if attestCtx.EventLogParseErr != nil || len(measurements) == 0 {
+		return
+	}
+	data, err := json.Marshal(measurements)
+	if err != nil {
+		log.Errorf("[ATTEST] Failed to marshal attested measurements: %v", err)
+		return
+	}
+	if err = os.MkdirAll(types.LocalAttestDirname, 0700); err != nil {
+		log.Errorf("[ATTEST] Failed to create %s: %v", types.LocalAttestDirname, err)
+		return
+	}
+	if err = fileutils.WriteRename(types.AttestedMeasurementsFile, data); err != nil {
+		log.Errorf("[ATTEST] Failed to save attested measurements: %v", err)
+	}
+}
+
+func encodeMeasurements(attestCtx *attestContext, quoteMsg *attest.ZAttestQuote) {
+	report := attestCtx.MeasurementReport
+	for _, pcr := range report.PCRReplay {
+		quoteMsg.PcrReplay = append(quoteMsg.PcrReplay, &attest.TpmPCRReplay{
+			Index:         uint32(pcr.Index),
+			ReplayedValue: pcr.Replayed,
+			MatchesQuote:  pcr.Match,
+		})
+	}
+	for _, change := range report.Changes {
+		quoteMsg.MeasurementChanges = append(quoteMsg.MeasurementChanges,
+			&attest.MeasurementChange{
+				ChangeType:     attest.MeasurementChangeType(change.Type),
+				Component:      change.Component,
+				Name:           change.Name,
+				PcrIndex:       uint32(change.PCRIndex),
+				PreviousDigest: change.PreviousDigest,
+				Digest:         change.Digest,
+				PreviousDetail: change.PreviousDetail,
+				Detail:         change.Detail,
+			})
+	}
+}
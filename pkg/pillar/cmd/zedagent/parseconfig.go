@@ -6,14 +6,17 @@ package zedagent
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"hash"
 	"io/ioutil"
+	"math"
 	"net"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -329,6 +332,12 @@ func parseBaseOsConfig(getconfigCtx *getconfigContext,
 		baseOs.ContentTreeConfigList = make([]types.ContentTreeConfig,
 			len(cfgOs.Drives))
 		parseContentTreeConfigList(baseOs.ContentTreeConfigList, cfgOs.Drives)
+		if cfgOs.GetDeltaDrive().GetImage() != nil && cfgOs.GetRootfsSha256() != "" {
+			delta := make([]types.ContentTreeConfig, 1)
+			parseContentTreeConfigList(delta, []*zconfig.Drive{cfgOs.DeltaDrive})
+			baseOs.DeltaContentTreeConfig = &delta[0]
+			baseOs.RootfsSha256 = strings.ToLower(cfgOs.GetRootfsSha256())
+		}
 
 		log.Tracef("parseBaseOsConfig publishing %v",
 			baseOs)
@@ -389,6 +398,7 @@ func unpublishDeletedNetworkInstanceConfig(ctx *getconfigContext,
 			log.Fatalf("Network Instance UnPublish (key:%s, name:%s) FAILED: %s",
 				key, config.DisplayName, err)
 		}
+		delLocalNetworkInstanceConfig(ctx, key)
 	}
 }
 
@@ -477,6 +487,7 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 			networkInstanceConfig.Logicallabel = apiConfigEntry.Port.Name
 		}
 		networkInstanceConfig.IpType = types.AddressType(apiConfigEntry.IpType)
+		networkInstanceConfig.IPv6PrefixDelegation = apiConfigEntry.Ipv6PrefixDelegation
 
 		switch networkInstanceConfig.Type {
 		case types.NetworkInstanceTypeSwitch:
@@ -520,6 +531,36 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					networkInstanceConfig.DisplayName,
 					networkInstanceConfig.IpType)
 			}
+
+		case types.NetworkInstanceTypeVXLAN:
+			err := parseVxlanConfig(ctx, apiConfigEntry.GetVxlan(),
+				&networkInstanceConfig)
+			if err != nil {
+				errStr := fmt.Sprintf("Network Instance %s VXLAN config parse failed: %s",
+					networkInstanceConfig.Key(), err)
+				log.Error(errStr)
+				networkInstanceConfig.SetErrorNow(errStr)
+			}
+
+		case types.NetworkInstanceTypeWireGuard:
+			err := parseWireGuardConfig(ctx, apiConfigEntry.GetWireguard(),
+				&networkInstanceConfig)
+			if err != nil {
+				errStr := fmt.Sprintf("Network Instance %s WireGuard config parse failed: %s",
+					networkInstanceConfig.Key(), err)
+				log.Error(errStr)
+				networkInstanceConfig.SetErrorNow(errStr)
+			}
+		}
+
+		if networkInstanceConfig.IPv6PrefixDelegation &&
+			(networkInstanceConfig.Type != types.NetworkInstanceTypeLocal ||
+				networkInstanceConfig.IpType != types.AddressTypeIPV6) {
+			errStr := fmt.Sprintf("Network Instance %s: IPv6 prefix delegation is only supported for local IPv6 network instances",
+				networkInstanceConfig.Key())
+			log.Error(errStr)
+			networkInstanceConfig.SetErrorNow(errStr)
+			networkInstanceConfig.IPv6PrefixDelegation = false
 		}
 
 		// other than switch-type(l2)
@@ -538,11 +579,212 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 				&networkInstanceConfig)
 		}
 
+		// Add config submitted via local profile server.
+		addLocalNetworkInstanceConfig(ctx, &networkInstanceConfig)
+
 		ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
 			networkInstanceConfig)
 	}
 }
 
+// parseVxlanConfig parses and validates configuration of VXLAN network instance.
+func parseVxlanConfig(ctx *getconfigContext, vxlanCfg *zconfig.VxlanConfig,
+	config *types.NetworkInstanceConfig) error {
+
+	if vxlanCfg == nil {
+		return errors.New("missing VXLAN configuration")
+	}
+	if config.Logicallabel == "" {
+		return errors.New("VXLAN network instance requires a port")
+	}
+	if config.IpType == types.AddressTypeNone {
+		return errors.New("VXLAN network instance requires an IP subnet")
+	}
+	if vxlanCfg.GetVni() == 0 || vxlanCfg.GetVni() > types.MaxVxlanVNI {
+		return fmt.Errorf("invalid VNI %d", vxlanCfg.GetVni())
+	}
+	vxlan := types.VxlanConfig{
+		VNI:        vxlanCfg.GetVni(),
+		DstPort:    types.DefaultVxlanDstPort,
+		Encryption: types.VxlanEncryption(vxlanCfg.GetEncryption()),
+	}
+	if port := vxlanCfg.GetDstPort(); port != 0 {
+		if port > math.MaxUint16 {
+			return fmt.Errorf("invalid destination port %d", port)
+		}
+		vxlan.DstPort = uint16(port)
+	}
+	if mtu := vxlanCfg.GetMtu(); mtu != 0 {
+		if mtu < 576 || mtu > math.MaxUint16 {
+			return fmt.Errorf("invalid MTU %d", mtu)
+		}
+		vxlan.MTU = uint16(mtu)
+	}
+	withWireGuard := vxlan.Encryption == types.VxlanEncryptionWireGuard
+	switch vxlan.Encryption {
+	case types.VxlanEncryptionNone:
+	case types.VxlanEncryptionIPsec:
+	case types.VxlanEncryptionWireGuard:
+		ip, subnet, err := net.ParseCIDR(vxlanCfg.GetTunnelAddress())
+		if err != nil {
+			return fmt.Errorf("invalid tunnel address %q: %v",
+				vxlanCfg.GetTunnelAddress(), err)
+		}
+		vxlan.TunnelAddress = net.IPNet{IP: ip, Mask: subnet.Mask}
+		listenPort := vxlanCfg.GetWireguardListenPort()
+		if listenPort == 0 || listenPort > math.MaxUint16 {
+			return fmt.Errorf("invalid WireGuard listen port %d", listenPort)
+		}
+		vxlan.WireGuardListenPort = uint16(listenPort)
+	default:
+		return fmt.Errorf("unsupported encryption %d", vxlan.Encryption)
+	}
+	if len(vxlanCfg.GetPeers()) == 0 {
+		return errors.New("no VXLAN peers configured")
+	}
+	for _, peerCfg := range vxlanCfg.GetPeers() {
+		peer := types.VxlanPeer{
+			Address: net.ParseIP(peerCfg.GetAddress()),
+		}
+		if peer.Address == nil {
+			return fmt.Errorf("invalid peer address %q", peerCfg.GetAddress())
+		}
+		if len(vxlan.Peers) > 0 &&
+			(peer.Address.To4() == nil) != (vxlan.Peers[0].Address.To4() == nil) {
+			return fmt.Errorf("peer %s: mixing IPv4 and IPv6 peers is not supported",
+				peer.Address)
+		}
+		if withWireGuard {
+			peer.WireGuardPublicKey = peerCfg.GetWireguardPublicKey()
+			if peer.WireGuardPublicKey == "" {
+				return fmt.Errorf("peer %s: missing WireGuard public key",
+					peer.Address)
+			}
+			peer.TunnelAddress = net.ParseIP(peerCfg.GetTunnelAddress())
+			if peer.TunnelAddress == nil ||
+				!vxlan.TunnelAddress.Contains(peer.TunnelAddress) {
+				return fmt.Errorf("peer %s: invalid tunnel address %q",
+					peer.Address, peerCfg.GetTunnelAddress())
+			}
+		}
+		vxlan.Peers = append(vxlan.Peers, peer)
+	}
+	if vxlan.Encryption != types.VxlanEncryptionNone {
+		vxlan.CipherBlockStatus = parseCipherBlock(ctx, config.Key(),
+			vxlanCfg.GetCipherData())
+		if !vxlan.CipherBlockStatus.IsCipher {
+			return errors.New("encryption requires cipher data with the secret key")
+		}
+	}
+	config.Vxlan = vxlan
+	return nil
+}
+
+// parseWireGuardConfig parses and validates configuration of WireGuard VPN
+// network instance.
+func parseWireGuardConfig(ctx *getconfigContext, wgCfg *zconfig.WireGuardConfig,
+	config *types.NetworkInstanceConfig) error {
+
+	if wgCfg == nil {
+		return errors.New("missing WireGuard configuration")
+	}
+	if config.Logicallabel == "" {
+		return errors.New("WireGuard network instance requires a port")
+	}
+	if config.IpType != types.AddressTypeIPV4 {
+		return errors.New("WireGuard network instance requires an IPv4 subnet")
+	}
+	wg := types.WireGuardConfig{
+		Mode:          types.WireGuardMode(wgCfg.GetMode()),
+		KeyGeneration: wgCfg.GetKeyGeneration(),
+	}
+	switch wg.Mode {
+	case types.WireGuardModeClient, types.WireGuardModeHub:
+	default:
+		return fmt.Errorf("unsupported mode %d", wg.Mode)
+	}
+	ip, subnet, err := net.ParseCIDR(wgCfg.GetTunnelAddress())
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("invalid tunnel address %q", wgCfg.GetTunnelAddress())
+	}
+	wg.TunnelAddress = net.IPNet{IP: ip.To4(), Mask: subnet.Mask}
+	listenPort := wgCfg.GetListenPort()
+	if listenPort > math.MaxUint16 ||
+		(listenPort == 0 && wg.Mode == types.WireGuardModeHub) {
+		return fmt.Errorf("invalid listen port %d", listenPort)
+	}
+	wg.ListenPort = uint16(listenPort)
+	if mtu := wgCfg.GetMtu(); mtu != 0 {
+		if mtu < 576 || mtu > math.MaxUint16 {
+			return fmt.Errorf("invalid MTU %d", mtu)
+		}
+		wg.MTU = uint16(mtu)
+	}
+	if len(wgCfg.GetPeers()) == 0 {
+		return errors.New("no WireGuard peers configured")
+	}
+	for _, peerCfg := range wgCfg.GetPeers() {
+		peer, err := parseWireGuardPeer(peerCfg)
+		if err != nil {
+			return err
+		}
+		if peer.Endpoint == nil && wg.Mode == types.WireGuardModeClient {
+			return fmt.Errorf("peer %s: endpoint is required in the client mode",
+				peer.PublicKey)
+		}
+		wg.Peers = append(wg.Peers, peer)
+	}
+	if wgCfg.GetCipherData() != nil {
+		wg.CipherBlockStatus = parseCipherBlock(ctx, config.Key(),
+			wgCfg.GetCipherData())
+		if !wg.CipherBlockStatus.IsCipher {
+			return errors.New("invalid cipher data with the private key")
+		}
+	}
+	config.WireGuard = wg
+	return nil
+}
+
+func parseWireGuardPeer(peerCfg *zconfig.WireGuardPeer) (types.WireGuardPeer, error) {
+	peer := types.WireGuardPeer{PublicKey: peerCfg.GetPublicKey()}
+	key, err := base64.StdEncoding.DecodeString(peer.PublicKey)
+	if err != nil || len(key) != 32 {
+		return peer, fmt.Errorf("invalid peer public key %q", peer.PublicKey)
+	}
+	if endpoint := peerCfg.GetEndpoint(); endpoint != "" {
+		host, port, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			return peer, fmt.Errorf("peer %s: invalid endpoint %q: %v",
+				peer.PublicKey, endpoint, err)
+		}
+		ip := net.ParseIP(host)
+		portNum, err := strconv.ParseUint(port, 10, 16)
+		if ip == nil || err != nil || portNum == 0 {
+			return peer, fmt.Errorf("peer %s: invalid endpoint %q (expected IP:port)",
+				peer.PublicKey, endpoint)
+		}
+		peer.Endpoint = &net.UDPAddr{IP: ip, Port: int(portNum)}
+	}
+	if len(peerCfg.GetAllowedIps()) == 0 {
+		return peer, fmt.Errorf("peer %s: no allowed IPs", peer.PublicKey)
+	}
+	for _, allowedIP := range peerCfg.GetAllowedIps() {
+		_, subnet, err := net.ParseCIDR(allowedIP)
+		if err != nil || subnet.IP.To4() == nil {
+			return peer, fmt.Errorf("peer %s: invalid allowed IPv4 subnet %q",
+				peer.PublicKey, allowedIP)
+		}
+		peer.AllowedIPs = append(peer.AllowedIPs, *subnet)
+	}
+	keepalive := peerCfg.GetPersistentKeepalive()
+	if keepalive > math.MaxUint16 {
+		return peer, fmt.Errorf("peer %s: invalid persistent keepalive %d",
+			peer.PublicKey, keepalive)
+	}
+	peer.PersistentKeepalive = uint16(keepalive)
+	return peer, nil
+}
+
 var networkInstancePrevConfigHash []byte
 
 func parseNetworkInstanceConfig(getconfigCtx *getconfigContext,
@@ -637,11 +879,17 @@ func parseAppInstanceConfig(getconfigCtx *getconfigContext,
 		appInstance.Service = cfgApp.Service
 		appInstance.CloudInitVersion = cfgApp.CloudInitVersion
 		appInstance.FixedResources.CPUsPinned = cfgApp.Fixedresources.PinCpu
+		appInstance.FixedResources.EnableGuestAgent = cfgApp.Fixedresources.EnableGuestAgent
+		appInstance.FixedResources.CPUWeight = cfgApp.Fixedresources.GetCpuWeight()
+		appInstance.FixedResources.PidsMax = cfgApp.Fixedresources.GetPidsMax()
 
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
 			len(cfgApp.VolumeRefList))
 		parseVolumeRefList(appInstance.VolumeRefConfigList, cfgApp.GetVolumeRefList())
 
+		parseAppDependencies(&appInstance, cfgApp)
+		parseAppHealthConfig(&appInstance, cfgApp)
+
 		// fill in the collect stats IP address of the App
 		appInstance.CollectStatsIPAddr = net.ParseIP(cfgApp.GetCollectStatsIPAddr())
 
@@ -699,6 +947,70 @@ func parseAppInstanceConfig(getconfigCtx *getconfigContext,
 	}
 }
 
+// parseAppDependencies fills the app instances the app instance depends on
+func parseAppDependencies(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	appInstance.Dependencies = nil
+	addDependencies := func(uuids []string, cond types.AppDependencyCondition) {
+		for _, uuidStr := range uuids {
+			id, err := uuid.FromString(uuidStr)
+			if err != nil {
+				errStr := fmt.Sprintf("Malformed UUID %s of dependency: %v",
+					uuidStr, err)
+				log.Error(errStr)
+				appInstance.Errors = append(appInstance.Errors, errStr)
+				continue
+			}
+			if uuid.Equal(id, appInstance.UUIDandVersion.UUID) {
+				errStr := "App instance can not depend on itself"
+				log.Error(errStr)
+				appInstance.Errors = append(appInstance.Errors, errStr)
+				continue
+			}
+			appInstance.Dependencies = append(appInstance.Dependencies,
+				types.AppDependency{AppUUID: id, Condition: cond})
+		}
+	}
+	addDependencies(cfgApp.GetDependsOnOnline(), types.AppDependencyOnline)
+	addDependencies(cfgApp.GetDependsOnHealthy(), types.AppDependencyHealthy)
+	appInstance.RestartWithDependencies = cfgApp.GetRestartWithDependencies()
+}
+
+// parseAppHealthConfig fills the health probes and the restart policy of
+// the app instance
+func parseAppHealthConfig(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig) {
+
+	healthConfig := types.AppHealthConfig{
+		InitialDelay:      time.Duration(cfgApp.GetProbeInitialDelaySeconds()) * time.Second,
+		Period:            time.Duration(cfgApp.GetProbePeriodSeconds()) * time.Second,
+		Timeout:           time.Duration(cfgApp.GetProbeTimeoutSeconds()) * time.Second,
+		FailureThreshold:  int(cfgApp.GetProbeFailureThreshold()),
+		RestartBackoffMax: time.Duration(cfgApp.GetRestartBackoffMaxSeconds()) * time.Second,
+	}
+	var err error
+	healthConfig.Liveness, err = types.ParseAppProbe(cfgApp.GetLivenessProbe())
+	if err != nil {
+		errStr := fmt.Sprintf("Invalid liveness probe: %v", err)
+		log.Error(errStr)
+		appInstance.Errors = append(appInstance.Errors, errStr)
+	}
+	healthConfig.Readiness, err = types.ParseAppProbe(cfgApp.GetReadinessProbe())
+	if err != nil {
+		errStr := fmt.Sprintf("Invalid readiness probe: %v", err)
+		log.Error(errStr)
+		appInstance.Errors = append(appInstance.Errors, errStr)
+	}
+	healthConfig.RestartPolicy, err = types.ParseAppRestartPolicy(cfgApp.GetRestartPolicy())
+	if err != nil {
+		errStr := fmt.Sprintf("Invalid restart policy: %v", err)
+		log.Error(errStr)
+		appInstance.Errors = append(appInstance.Errors, errStr)
+	}
+	appInstance.HealthConfig = healthConfig
+}
+
 var systemAdaptersPrevConfigHash []byte
 
 func parseSystemAdapterConfig(getconfigCtx *getconfigContext, config *zconfig.EdgeDevConfig,
@@ -1660,6 +1972,12 @@ func parseVolumeRefList(volumeRefConfigList []types.VolumeRefConfig,
 		volume.GenerationCounter = volumeRef.GenerationCount
 		volume.RefCount = 1
 		volume.MountDir = volumeRef.GetMountDir()
+		volume.IoLimits = types.VolumeIoLimits{
+			ReadBps:   volumeRef.GetReadBpsLimit(),
+			WriteBps:  volumeRef.GetWriteBpsLimit(),
+			ReadIops:  volumeRef.GetReadIopsLimit(),
+			WriteIops: volumeRef.GetWriteIopsLimit(),
+		}
 		volume.VerifyOnly = true
 		volumeRefConfigList[idx] = *volume
 		idx++
@@ -1879,6 +2197,18 @@ func parseNetworkWirelessConfig(ctx *getconfigContext, key string, netEnt *zconf
 			wcell.ProbeAddr = cellular.GetProbe().GetProbeAddress()
 			wcell.DisableProbe = cellular.GetProbe().GetDisable()
 			wcell.LocationTracking = cellular.GetLocationTracking()
+			for _, simProfile := range cellular.GetSimProfiles() {
+				slot := simProfile.GetSimSlot()
+				if slot == 0 || slot > math.MaxUint8 {
+					log.Errorf("parseNetworkWirelessConfig: invalid SIM slot %d", slot)
+					continue
+				}
+				wcell.SimProfiles = append(wcell.SimProfiles, types.CellSimProfile{
+					SlotNumber:     uint8(slot),
+					APN:            simProfile.GetApn(),
+					MonthlyDataCap: simProfile.GetMonthlyDataCap(),
+				})
+			}
 			wconfig.Cellular = append(wconfig.Cellular, wcell)
 		}
 		log.Functionf("parseNetworkWirelessConfig: Wireless of network Cellular, %v", wconfig.Cellular)
@@ -1981,6 +2311,11 @@ func parseIpspec(ipspec *zconfig.Ipspec,
 		}
 		config.DnsServers = append(config.DnsServers, ds)
 	}
+	if config.IPv6PrefixDelegation {
+		// Subnet, Gateway and DhcpRange are derived by zedrouter
+		// from the prefix delegated to the uplink.
+		return nil
+	}
 	// Parse Subnet
 	if s := ipspec.GetSubnet(); s != "" {
 		_, subnet, err := net.ParseCIDR(s)
@@ -2037,6 +2372,13 @@ func parseIpspec(ipspec *zconfig.Ipspec,
 	// last addressable endpoint, with 0 base, and subnet.IP as start,
 	// it accounts for (2^(iplen - subnetMask) - 2) addresses
 	dhcpRangeEnd := addrCount - 2
+	if config.Subnet.IP.To4() == nil &&
+		dhcpRangeEnd > types.IPv6DhcpRangeOffset+types.BitMapMax {
+		// IPv6 subnets are usually /64, do not start the range
+		// in the middle of the (capped) address space
+		dhcpRangeStart = types.IPv6DhcpRangeOffset
+		dhcpRangeEnd = dhcpRangeStart + types.BitMapMax
+	}
 
 	// if not set, take some default
 	if config.DhcpRange.Start == nil {
@@ -2269,9 +2611,95 @@ func parseUnderlayNetworkConfigEntry(
 	// XXX set ulCfg.IntfOrder from API once available
 	ulCfg.IntfOrder = intfOrder
 	ulCfg.AccessVlanID = intfEnt.AccessVlanId
+	ulCfg.Shaping, err = parseTrafficShaping(intfEnt.GetShaping())
+	if err != nil {
+		ulCfg.Error = fmt.Sprintf("App %s-%s: bad traffic shaping of %s: %v\n",
+			cfgApp.Displayname, cfgApp.Uuidandversion.Uuid, intfEnt.Name, err)
+		log.Errorf("%s", ulCfg.Error)
+		return ulCfg
+	}
 	return ulCfg
 }
 
+func parseTrafficShaping(cfg *zconfig.TrafficShaping) (types.TrafficShaping, error) {
+	var shaping types.TrafficShaping
+	var err error
+	shaping.Egress, err = parseTrafficShaper(cfg.GetEgress())
+	if err != nil {
+		return shaping, fmt.Errorf("egress: %v", err)
+	}
+	shaping.Ingress, err = parseTrafficShaper(cfg.GetIngress())
+	if err != nil {
+		return shaping, fmt.Errorf("ingress: %v", err)
+	}
+	return shaping, nil
+}
+
+func parseTrafficShaper(cfg *zconfig.TrafficShaper) (types.TrafficShaper, error) {
+	shaper := types.TrafficShaper{
+		RateBps:    cfg.GetRateBps(),
+		BurstBytes: cfg.GetBurstBytes(),
+	}
+	if shaper.RateBps == 0 {
+		if len(cfg.GetClasses()) != 0 {
+			return shaper, errors.New("traffic classes without rate")
+		}
+		return shaper, nil
+	}
+	var guaranteed uint64
+	names := make(map[string]bool)
+	for _, classCfg := range cfg.GetClasses() {
+		class := types.TrafficClass{
+			Name:     classCfg.GetName(),
+			Priority: classCfg.GetPriority(),
+			RateBps:  classCfg.GetRateBps(),
+			CeilBps:  classCfg.GetCeilBps(),
+		}
+		if class.Name == "" || class.Name == types.DefaultTrafficClassName {
+			return shaper, fmt.Errorf("invalid traffic class name %q", class.Name)
+		}
+		if names[class.Name] {
+			return shaper, fmt.Errorf("duplicate traffic class %s", class.Name)
+		}
+		names[class.Name] = true
+		if class.Priority > 7 {
+			return shaper, fmt.Errorf("priority %d of traffic class %s is not within 0-7",
+				class.Priority, class.Name)
+		}
+		if class.RateBps == 0 {
+			return shaper, fmt.Errorf("traffic class %s without rate", class.Name)
+		}
+		if class.CeilBps != 0 && class.CeilBps < class.RateBps {
+			return shaper, fmt.Errorf("ceil of traffic class %s is below its rate",
+				class.Name)
+		}
+		for _, dscp := range classCfg.GetDscp() {
+			if dscp > 63 {
+				return shaper, fmt.Errorf("DSCP %d of traffic class %s is not within 0-63",
+					dscp, class.Name)
+			}
+			class.DSCP = append(class.DSCP, uint8(dscp))
+		}
+		for _, port := range classCfg.GetRemotePorts() {
+			if port == 0 || port > 65535 {
+				return shaper, fmt.Errorf("invalid port %d of traffic class %s",
+					port, class.Name)
+			}
+			class.RemotePorts = append(class.RemotePorts, uint16(port))
+		}
+		if len(class.DSCP) == 0 && len(class.RemotePorts) == 0 {
+			return shaper, fmt.Errorf("traffic class %s matches no traffic", class.Name)
+		}
+		guaranteed += class.RateBps
+		shaper.Classes = append(shaper.Classes, class)
+	}
+	if guaranteed > shaper.RateBps {
+		return shaper, fmt.Errorf("rates of the traffic classes exceed the rate %d",
+			shaper.RateBps)
+	}
+	return shaper, nil
+}
+
 var itemsPrevConfigHash []byte
 
 func parseConfigItems(ctx *getconfigContext, config *zconfig.EdgeDevConfig,
@@ -7,13 +7,16 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	"sort"
+	"strconv"
 	"testing"
+	"time"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	zcommon "github.com/lf-edge/eve/api/go/evecommon"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
 )
 
 func initGetConfigCtx(g *GomegaWithT) *getconfigContext {
@@ -1132,3 +1135,355 @@ func TestInvalidLowerLayerReferences(t *testing.T) {
 	g.Expect(dpc.HasError()).To(BeFalse())
 	g.Expect(dpc.Ports).To(HaveLen(2))
 }
+
+func TestVolumeSnapshotPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+	volume1 := &zconfig.Volume{Uuid: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", GenerationCount: 1}
+	volume2 := &zconfig.Volume{Uuid: "6ba7b811-9dad-11d1-80b4-00c04fd430c8"}
+	config := &zconfig.EdgeDevConfig{
+		Volumes: []*zconfig.Volume{volume1, volume2},
+		Apps: []*zconfig.AppInstanceConfig{
+			{
+				Displayname:          "app1",
+				SnapshotBeforeUpdate: true,
+				VolumeRefList: []*zconfig.VolumeRef{
+					{Uuid: volume1.Uuid, GenerationCount: 1},
+				},
+			},
+			{
+				Displayname:           "app2",
+				RollbackOnBootFailure: true,
+				VolumeRefList: []*zconfig.VolumeRef{
+					{Uuid: volume1.Uuid, GenerationCount: 1},
+					// reference to an older generation of volume2
+					{Uuid: volume2.Uuid, GenerationCount: 1},
+				},
+			},
+		},
+	}
+	g.Expect(getVolumeSnapshotPolicy(volume1, config)).To(Equal(types.VolumeSnapshotPolicy{
+		SnapshotBeforeUpdate:  true,
+		RollbackOnBootFailure: true,
+	}))
+	g.Expect(getVolumeSnapshotPolicy(volume2, config)).To(BeZero())
+}
+
+func TestParseAppDependencies(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	appUUID := uuid.FromStringOrNil("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+	dbUUID := uuid.FromStringOrNil("6ba7b813-9dad-11d1-80b4-00c04fd430c8")
+	brokerUUID := uuid.FromStringOrNil("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+	cfgApp := &zconfig.AppInstanceConfig{
+		Uuidandversion:          &zconfig.UUIDandVersion{Uuid: appUUID.String()},
+		DependsOnOnline:         []string{dbUUID.String(), "not-a-uuid"},
+		DependsOnHealthy:        []string{brokerUUID.String(), appUUID.String()},
+		RestartWithDependencies: true,
+	}
+	appInstance := types.AppInstanceConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: appUUID},
+	}
+	parseAppDependencies(&appInstance, cfgApp)
+	g.Expect(appInstance.Dependencies).To(Equal([]types.AppDependency{
+		{AppUUID: dbUUID, Condition: types.AppDependencyOnline},
+		{AppUUID: brokerUUID, Condition: types.AppDependencyHealthy},
+	}))
+	g.Expect(appInstance.RestartWithDependencies).To(BeTrue())
+	// the malformed UUID and the dependency on itself
+	g.Expect(appInstance.Errors).To(HaveLen(2))
+}
+
+func TestParseAppHealthConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	cfgApp := &zconfig.AppInstanceConfig{
+		LivenessProbe:       "tcp://:5432",
+		ReadinessProbe:      "exec:pg_isready",
+		ProbePeriodSeconds:  5,
+		RestartPolicy:       "on-failure",
+		ProbeTimeoutSeconds: 2,
+	}
+	var appInstance types.AppInstanceConfig
+	parseAppHealthConfig(&appInstance, cfgApp)
+	g.Expect(appInstance.Errors).To(BeEmpty())
+	g.Expect(appInstance.HealthConfig).To(Equal(types.AppHealthConfig{
+		Liveness:      types.AppProbe{Type: types.AppProbeTCP, Port: 5432},
+		Readiness:     types.AppProbe{Type: types.AppProbeExec, Command: []string{"pg_isready"}},
+		Period:        5 * time.Second,
+		Timeout:       2 * time.Second,
+		RestartPolicy: types.AppRestartOnFailure,
+	}))
+
+	cfgApp = &zconfig.AppInstanceConfig{
+		LivenessProbe: "udp://:53",
+		RestartPolicy: "sometimes",
+	}
+	appInstance = types.AppInstanceConfig{}
+	parseAppHealthConfig(&appInstance, cfgApp)
+	g.Expect(appInstance.Errors).To(HaveLen(2))
+}
+
+func TestParseTrafficShaping(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	cfg := &zconfig.TrafficShaping{
+		Egress: &zconfig.TrafficShaper{
+			RateBps: 10000000,
+			Classes: []*zconfig.TrafficClass{
+				{
+					Name:     "voice",
+					Priority: 0,
+					RateBps:  2000000,
+					Dscp:     []uint32{46},
+				},
+				{
+					Name:        "web",
+					Priority:    3,
+					RateBps:     5000000,
+					CeilBps:     8000000,
+					RemotePorts: []uint32{80, 443},
+				},
+			},
+		},
+	}
+	shaping, err := parseTrafficShaping(cfg)
+	g.Expect(err).To(BeNil())
+	g.Expect(shaping.Ingress.IsSet()).To(BeFalse())
+	g.Expect(shaping.Egress).To(Equal(types.TrafficShaper{
+		RateBps: 10000000,
+		Classes: []types.TrafficClass{
+			{Name: "voice", RateBps: 2000000, DSCP: []uint8{46}},
+			{Name: "web", Priority: 3, RateBps: 5000000, CeilBps: 8000000,
+				RemotePorts: []uint16{80, 443}},
+		},
+	}))
+	// 10ms of traffic at 10Mbit/s
+	g.Expect(shaping.Egress.Burst()).To(BeEquivalentTo(12500))
+
+	// Classes guarantee more than the rate
+	cfg.Egress.Classes[1].RateBps = 9000000
+	_, err = parseTrafficShaping(cfg)
+	g.Expect(err).ToNot(BeNil())
+	cfg.Egress.Classes[1].RateBps = 5000000
+
+	cfg.Egress.Classes[0].Dscp = []uint32{64}
+	_, err = parseTrafficShaping(cfg)
+	g.Expect(err).ToNot(BeNil())
+
+	_, err = parseTrafficShaping(&zconfig.TrafficShaping{
+		Ingress: &zconfig.TrafficShaper{
+			Classes: []*zconfig.TrafficClass{{Name: "bulk", RateBps: 1000}},
+		},
+	})
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestParseIpspecIPv6(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	ipspec := &zconfig.Ipspec{
+		Subnet: "2001:db8:0:1::/64",
+		Dns:    []string{"2001:4860:4860::8888"},
+	}
+	config := types.NetworkInstanceConfig{
+		Type:   types.NetworkInstanceTypeLocal,
+		IpType: types.AddressTypeIPV6,
+	}
+	err := parseIpspec(ipspec, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.Gateway.String()).To(Equal("2001:db8:0:1::1"))
+	g.Expect(config.DhcpRange.Start.String()).To(Equal("2001:db8:0:1::100"))
+	g.Expect(config.DhcpRange.End.String()).To(Equal("2001:db8:0:1::1ff"))
+	g.Expect(config.DnsServers).To(HaveLen(1))
+
+	// Small subnet keeps the IPv4 defaults (range in the upper half)
+	ipspec.Subnet = "2001:db8:0:1::/120"
+	config = types.NetworkInstanceConfig{
+		Type:   types.NetworkInstanceTypeLocal,
+		IpType: types.AddressTypeIPV6,
+	}
+	err = parseIpspec(ipspec, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.DhcpRange.Start.String()).To(Equal("2001:db8:0:1::80"))
+	g.Expect(config.DhcpRange.End.String()).To(Equal("2001:db8:0:1::fe"))
+
+	// With prefix delegation the subnet is ignored
+	config = types.NetworkInstanceConfig{
+		Type:                 types.NetworkInstanceTypeLocal,
+		IpType:               types.AddressTypeIPV6,
+		IPv6PrefixDelegation: true,
+	}
+	err = parseIpspec(ipspec, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.Subnet.IP).To(BeNil())
+	g.Expect(config.Gateway).To(BeNil())
+	g.Expect(config.DnsServers).To(HaveLen(1))
+}
+
+func TestParseVxlanConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	ctx := &getconfigContext{}
+	vxlanCfg := &zconfig.VxlanConfig{
+		Vni: 100,
+		Peers: []*zconfig.VxlanPeer{
+			{Address: "192.168.1.2"},
+			{Address: "192.168.1.3"},
+		},
+	}
+	config := types.NetworkInstanceConfig{
+		Type:         types.NetworkInstanceTypeVXLAN,
+		Logicallabel: "eth0",
+		IpType:       types.AddressTypeIPV4,
+	}
+	err := parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.Vxlan.VNI).To(BeEquivalentTo(100))
+	g.Expect(config.Vxlan.DstPort).To(BeEquivalentTo(types.DefaultVxlanDstPort))
+	g.Expect(config.Vxlan.MTU).To(BeZero())
+	g.Expect(config.Vxlan.Peers).To(HaveLen(2))
+	g.Expect(config.Vxlan.Peers[1].Address.String()).To(Equal("192.168.1.3"))
+
+	// Invalid VNI
+	vxlanCfg.Vni = types.MaxVxlanVNI + 1
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	vxlanCfg.Vni = 100
+
+	// Mixed IPv4 and IPv6 peers
+	vxlanCfg.Peers = append(vxlanCfg.Peers, &zconfig.VxlanPeer{Address: "2001:db8::4"})
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	vxlanCfg.Peers = vxlanCfg.Peers[:2]
+
+	// Encryption without the secret
+	vxlanCfg.Encryption = zconfig.VxlanEncryption_VXLAN_ENCRYPTION_IPSEC
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	cipherData := &zconfig.CipherBlock{
+		CipherContextId: "ctx1",
+		CipherData:      []byte("encrypted"),
+	}
+	vxlanCfg.CipherData = cipherData
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.Vxlan.Encryption).To(Equal(types.VxlanEncryptionIPsec))
+	g.Expect(config.Vxlan.CipherBlockStatus.IsCipher).To(BeTrue())
+
+	// WireGuard requires tunnel addresses and public keys
+	vxlanCfg.Encryption = zconfig.VxlanEncryption_VXLAN_ENCRYPTION_WIREGUARD
+	vxlanCfg.TunnelAddress = "10.99.0.1/24"
+	vxlanCfg.WireguardListenPort = 51820
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	for i, peer := range vxlanCfg.Peers {
+		peer.WireguardPublicKey = "key" + strconv.Itoa(i)
+		peer.TunnelAddress = "10.99.0." + strconv.Itoa(i+2)
+	}
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.Vxlan.TunnelAddress.String()).To(Equal("10.99.0.1/24"))
+	g.Expect(config.Vxlan.WireGuardListenPort).To(BeEquivalentTo(51820))
+	g.Expect(config.Vxlan.Peers[0].TunnelAddress.String()).To(Equal("10.99.0.2"))
+
+	// Tunnel address of a peer outside of the tunnel subnet
+	vxlanCfg.Peers[1].TunnelAddress = "10.98.0.3"
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+
+	// Port is mandatory
+	config.Logicallabel = ""
+	vxlanCfg.Peers[1].TunnelAddress = "10.99.0.3"
+	err = parseVxlanConfig(ctx, vxlanCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestParseWireGuardConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	ctx := &getconfigContext{}
+	peerKey := "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+	wgCfg := &zconfig.WireGuardConfig{
+		Mode:          zconfig.WireGuardMode_WIREGUARD_MODE_CLIENT,
+		TunnelAddress: "10.100.0.2/24",
+		Peers: []*zconfig.WireGuardPeer{
+			{
+				PublicKey:           peerKey,
+				Endpoint:            "192.168.1.2:51820",
+				AllowedIps:          []string{"10.100.0.0/24", "172.30.0.0/16"},
+				PersistentKeepalive: 25,
+			},
+		},
+	}
+	config := types.NetworkInstanceConfig{
+		Type:         types.NetworkInstanceTypeWireGuard,
+		Logicallabel: "eth0",
+		IpType:       types.AddressTypeIPV4,
+	}
+	err := parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.WireGuard.Mode).To(Equal(types.WireGuardModeClient))
+	g.Expect(config.WireGuard.TunnelAddress.String()).To(Equal("10.100.0.2/24"))
+	g.Expect(config.WireGuard.ListenPort).To(BeZero())
+	g.Expect(config.WireGuard.HasLocalKey()).To(BeTrue())
+	g.Expect(config.WireGuard.Peers).To(HaveLen(1))
+	peer := config.WireGuard.Peers[0]
+	g.Expect(peer.Endpoint.String()).To(Equal("192.168.1.2:51820"))
+	g.Expect(peer.AllowedIPs).To(HaveLen(2))
+	g.Expect(peer.AllowedIPs[1].String()).To(Equal("172.30.0.0/16"))
+	g.Expect(peer.PersistentKeepalive).To(BeEquivalentTo(25))
+
+	// Hub requires a fixed listen port
+	wgCfg.Mode = zconfig.WireGuardMode_WIREGUARD_MODE_HUB
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	wgCfg.ListenPort = 51820
+	wgCfg.Peers[0].Endpoint = ""
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.WireGuard.ListenPort).To(BeEquivalentTo(51820))
+	g.Expect(config.WireGuard.Peers[0].Endpoint).To(BeNil())
+
+	// Client requires peer endpoints
+	wgCfg.Mode = zconfig.WireGuardMode_WIREGUARD_MODE_CLIENT
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	wgCfg.Peers[0].Endpoint = "192.168.1.2"
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	wgCfg.Peers[0].Endpoint = "[2001:db8::2]:51820"
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).To(BeNil())
+
+	// Invalid public key
+	wgCfg.Peers[0].PublicKey = "not-a-key"
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	wgCfg.Peers[0].PublicKey = peerKey
+
+	// Only IPv4 is routed through the tunnel
+	wgCfg.Peers[0].AllowedIps = []string{"2001:db8:1::/64"}
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+	wgCfg.Peers[0].AllowedIps = []string{"10.100.0.0/24"}
+
+	// Private key provided by the controller
+	wgCfg.CipherData = &zconfig.CipherBlock{
+		CipherContextId: "ctx1",
+		CipherData:      []byte("encrypted"),
+	}
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).To(BeNil())
+	g.Expect(config.WireGuard.HasLocalKey()).To(BeFalse())
+
+	// Port is mandatory
+	config.Logicallabel = ""
+	err = parseWireGuardConfig(ctx, wgCfg, &config)
+	g.Expect(err).ToNot(BeNil())
+}
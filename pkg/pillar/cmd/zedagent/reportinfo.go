@@ -23,6 +23,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/netclone"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/utils/disks"
 	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
 	"github.com/lf-edge/eve/pkg/pillar/vault"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
@@ -201,7 +202,7 @@ func objectInfoTask(ctxPtr *zedagentContext, triggerInfo <-chan infoForObjectKey
 	}
 }
 
-func fillStorageChildren(children []*types.StorageChildren) []*info.StorageChildren {
+func fillStorageChildren(ctx *zedagentContext, children []*types.StorageChildren) []*info.StorageChildren {
 	var infoChildren []*info.StorageChildren
 	for _, child := range children {
 		childInfo := new(info.StorageChildren)
@@ -217,14 +218,68 @@ func fillStorageChildren(children []*types.StorageChildren) []*info.StorageChild
 				diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
 				diskInfo.DiskName.Serial = disk.DiskName.Serial
 			}
+			fillStorageDiskHealth(ctx, diskInfo)
 			childInfo.Disks = append(childInfo.Disks, diskInfo)
 		}
-		childInfo.Children = fillStorageChildren(child.Children)
+		childInfo.Children = fillStorageChildren(ctx, child.Children)
 		infoChildren = append(infoChildren, childInfo)
 	}
 	return infoChildren
 }
 
+// lookupDiskHealthStatus returns health of the disk published by zfsmanager,
+// the disk is identified by serial number or by name of the disk or its partition
+func lookupDiskHealthStatus(ctx *zedagentContext, diskName, serial string) *types.DiskHealthStatus {
+	if ctx.subDiskHealthStatus == nil {
+		return nil
+	}
+	if devName, err := disks.GetDiskNameByPartName(diskName); err == nil {
+		diskName = devName
+	}
+	for _, st := range ctx.subDiskHealthStatus.GetAll() {
+		status := st.(types.DiskHealthStatus)
+		if serial != "" && status.SerialNumber == serial {
+			return &status
+		}
+		if diskName != "" && status.DiskName == diskName {
+			return &status
+		}
+	}
+	return nil
+}
+
+func fillStorageDiskHealth(ctx *zedagentContext, diskInfo *info.StorageDiskState) {
+	if diskInfo.DiskName == nil {
+		return
+	}
+	status := lookupDiskHealthStatus(ctx, diskInfo.DiskName.Name, diskInfo.DiskName.Serial)
+	if status == nil {
+		return
+	}
+	diskInfo.HealthRisk = info.DiskHealthRisk(status.Risk)
+	diskInfo.HealthErr = encodeErrorInfo(status.ErrorDescription)
+}
+
+func fillStorageScanStatus(scan types.ZFSScanStatus) *info.StorageScanStatus {
+	scanInfo := new(info.StorageScanStatus)
+	scanInfo.Function = info.StorageScanFunction(scan.Function)
+	scanInfo.State = info.StorageScanState(scan.State)
+	scanInfo.Paused = scan.Paused
+	if !scan.StartTime.IsZero() {
+		scanInfo.StartTime, _ = ptypes.TimestampProto(scan.StartTime)
+	}
+	if !scan.EndTime.IsZero() {
+		scanInfo.EndTime, _ = ptypes.TimestampProto(scan.EndTime)
+	}
+	scanInfo.ToExamine = scan.ToExamine
+	scanInfo.Examined = scan.Examined
+	scanInfo.Processed = scan.Processed
+	scanInfo.Errors = scan.Errors
+	scanInfo.Progress = scan.Progress()
+	scanInfo.EtaSeconds = uint64(scan.ETA.Seconds())
+	return scanInfo
+}
+
 // PublishDeviceInfoToZedCloud This function is called per change, hence needs to try over all management ports
 func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 	aa := ctx.assignableAdapters
@@ -423,9 +478,11 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 					diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
 					diskInfo.DiskName.Serial = disk.DiskName.Serial
 				}
+				fillStorageDiskHealth(ctx, diskInfo)
 				storageInfo.Disks = append(storageInfo.Disks, diskInfo)
 			}
-			storageInfo.Children = fillStorageChildren(zfsPoolStatus.Children)
+			storageInfo.Children = fillStorageChildren(ctx, zfsPoolStatus.Children)
+			storageInfo.ScanStatus = fillStorageScanStatus(zfsPoolStatus.ScanStatus)
 			ReportDeviceInfo.StorageInfo = append(ReportDeviceInfo.StorageInfo, storageInfo)
 			log.Tracef("sending info for ZFS zpool %s", zfsPoolStatus.PoolName)
 		}
@@ -449,6 +506,7 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 			rDiskStatus.DiskName.Name = *proto.String(mi.Source)
 			rDiskStatus.DiskName.Serial = *proto.String(serialNumber)
 			rDiskStatus.Status = info.StorageStatus_STORAGE_STATUS_ONLINE
+			fillStorageDiskHealth(ctx, rDiskStatus)
 			xStorageInfo.Disks = append(xStorageInfo.Disks, rDiskStatus)
 		}
 		ReportDeviceInfo.StorageInfo = append(ReportDeviceInfo.StorageInfo, xStorageInfo)
@@ -916,16 +974,42 @@ func encodeCellModuleInfo(wwanModule types.WwanCellModule) *info.ZCellularModule
 func encodeSimCards(cellModule string, wwanSimCards []types.WwanSimCard) (simCards []*info.ZSimcardInfo) {
 	for _, simCard := range wwanSimCards {
 		simCards = append(simCards, &info.ZSimcardInfo{
-			Name:           simCard.Name,
-			CellModuleName: cellModule,
-			Imsi:           simCard.IMSI,
-			Iccid:          simCard.ICCID,
+			Name:             simCard.Name,
+			CellModuleName:   cellModule,
+			Imsi:             simCard.IMSI,
+			Iccid:            simCard.ICCID,
+			SlotNumber:       uint32(simCard.SlotNumber),
+			SlotActivated:    simCard.SlotActivated,
+			MonthlyDataUsage: simCard.MonthlyDataUsage,
 			// TODO SIM card state
 		})
 	}
 	return simCards
 }
 
+func encodeCellErrorCode(errCode types.WwanErrorCode) info.ZCellularErrorCode {
+	switch errCode {
+	case types.WwanErrorUnspecified:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_UNSPECIFIED
+	case types.WwanErrorModemNotFound:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_MODEM_NOT_FOUND
+	case types.WwanErrorModemCommandFailed:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_MODEM_COMMAND_FAILED
+	case types.WwanErrorSimNotReady:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_SIM_NOT_READY
+	case types.WwanErrorRegistrationFailed:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_REGISTRATION_FAILED
+	case types.WwanErrorConnectionFailed:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_CONNECTION_FAILED
+	case types.WwanErrorIPConfigFailed:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_IP_CONFIG_FAILED
+	case types.WwanErrorDataCapExceeded:
+		return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_DATA_CAP_EXCEEDED
+	}
+	log.Errorf("Invalid wwan error code: %v", errCode)
+	return info.ZCellularErrorCode_Z_CELLULAR_ERROR_CODE_UNSPECIFIED
+}
+
 func encodeCellProviders(wwanProviders []types.WwanProvider) (providers []*info.ZCellularProvider) {
 	for _, provider := range wwanProviders {
 		providers = append(providers, &info.ZCellularProvider{
@@ -984,6 +1068,8 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 						Providers:      encodeCellProviders(wwanStatus.Providers),
 						ConfigError:    wwanStatus.ConfigError,
 						ProbeError:     wwanStatus.ProbeError,
+						ConfigErrorCode: encodeCellErrorCode(
+							wwanStatus.ConfigErrorCode),
 					},
 				}
 			}
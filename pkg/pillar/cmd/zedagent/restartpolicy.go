@@ -0,0 +1,149 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// appRestartBackoffMin is the delay before the first automatic restart,
+	// which doubles with every further restart
+	appRestartBackoffMin = 10 * time.Second
+	// appRestartBackoffReset is how long an app instance must not be
+	// restarted automatically for its backoff to start over
+	appRestartBackoffReset = 10 * time.Minute
+)
+
+// appRestartState tracks the automatic restarts of an app instance
+type appRestartState struct {
+	restarts    int
+	lastRestart time.Time
+	// timer re-evaluates the restart policy once the backoff expires
+	timer *time.Timer
+}
+
+// appRestartReason returns why the restart policy requests a restart of the
+// app instance, or an empty string
+func appRestartReason(config types.AppInstanceConfig,
+	status types.AppInstanceStatus) string {
+
+	policy := config.HealthConfig.RestartPolicy
+	if policy == types.AppRestartNever || !config.Activate {
+		return ""
+	}
+	if status.RestartInprogress != types.NotInprogress ||
+		status.PurgeInprogress != types.NotInprogress {
+		return ""
+	}
+	switch {
+	case status.Liveness.Failed && livenessFailedSince(status.Liveness, status.BootTime):
+		return "liveness probe failed"
+	case status.State == types.BROKEN:
+		return "app instance is broken"
+	case status.IsErrorSource(types.DomainStatus{}):
+		return "app instance failed"
+	case policy == types.AppRestartAlways && status.Activated &&
+		status.State == types.HALTED:
+		return "app instance halted"
+	}
+	return ""
+}
+
+// livenessFailedSince returns true if the latest failure of the liveness
+// probe happened after the boot, since domainmgr resets the probe shortly
+// after the domain comes up again
+func livenessFailedSince(probe types.AppProbeStatus, bootTime time.Time) bool {
+	n := len(probe.History)
+	return n != 0 && !probe.History[n-1].Success &&
+		probe.History[n-1].Time.After(bootTime)
+}
+
+// appRestartBackoff returns the delay after the latest automatic restart
+// before the next one
+func appRestartBackoff(restarts int, max time.Duration) time.Duration {
+	backoff := appRestartBackoffMin
+	for i := 1; i < restarts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// applyRestartPolicy restarts the app instance through its local restart
+// command if its restart policy requests it, once the backoff expired
+func applyRestartPolicy(ctx *getconfigContext, status types.AppInstanceStatus) {
+	ctx.localCommands.Lock()
+	defer ctx.localCommands.Unlock()
+	appUUID := status.UUIDandVersion.UUID.String()
+	c, _ := ctx.pubAppInstanceConfig.Get(appUUID)
+	if c == nil {
+		return
+	}
+	config := c.(types.AppInstanceConfig)
+	reason := appRestartReason(config, status)
+	if reason == "" {
+		return
+	}
+	state, ok := ctx.appRestarts[appUUID]
+	if !ok {
+		state = &appRestartState{}
+		if ctx.appRestarts == nil {
+			ctx.appRestarts = make(map[string]*appRestartState)
+		}
+		ctx.appRestarts[appUUID] = state
+	}
+	now := time.Now()
+	if now.Sub(state.lastRestart) > appRestartBackoffReset {
+		state.restarts = 0
+	}
+	if state.restarts != 0 {
+		backoff := appRestartBackoff(state.restarts,
+			config.HealthConfig.MaxRestartBackoff())
+		if wait := state.lastRestart.Add(backoff).Sub(now); wait > 0 {
+			if state.timer == nil {
+				log.Noticef("applyRestartPolicy(%s): %s, restarting in %v",
+					appUUID, reason, wait)
+				state.timer = time.AfterFunc(wait, func() {
+					retryRestartPolicy(ctx, appUUID)
+				})
+			}
+			return
+		}
+	}
+	log.Noticef("applyRestartPolicy(%s): %s, restarting (restart %d)",
+		appUUID, reason, state.restarts+1)
+	state.restarts++
+	state.lastRestart = now
+	triggerLocalCommand(ctx, types.AppCommandRestart, &config, now.String())
+	persistLocalCommands(ctx.localCommands)
+}
+
+// retryRestartPolicy applies the restart policy again to the latest status
+// of the app instance once the backoff expired
+func retryRestartPolicy(ctx *getconfigContext, appUUID string) {
+	ctx.localCommands.Lock()
+	if state, ok := ctx.appRestarts[appUUID]; ok {
+		state.timer = nil
+	}
+	ctx.localCommands.Unlock()
+	st, _ := ctx.subAppInstanceStatus.Get(appUUID)
+	if st == nil {
+		return
+	}
+	applyRestartPolicy(ctx, st.(types.AppInstanceStatus))
+}
+
+// forgetAppRestarts drops the restart state of a deleted app instance.
+// ctx.localCommands should be locked!
+func forgetAppRestarts(ctx *getconfigContext, appUUID string) {
+	if state, ok := ctx.appRestarts[appUUID]; ok && state.timer != nil {
+		state.timer.Stop()
+	}
+	delete(ctx.appRestarts, appUUID)
+}
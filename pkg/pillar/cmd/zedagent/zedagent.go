@@ -141,6 +141,7 @@ type zedagentContext struct {
 	subLocationInfo           pubsub.Subscription
 	subZFSPoolStatus          pubsub.Subscription
 	subZFSPoolMetrics         pubsub.Subscription
+	subDiskHealthStatus       pubsub.Subscription
 	subEdgeviewStatus         pubsub.Subscription
 	subNetworkMetrics         pubsub.Subscription
 	subClientMetrics          pubsub.Subscription
@@ -431,6 +432,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	initializeLocalDevInfo(getconfigCtx)
 	go localDevInfoPOSTTask(getconfigCtx)
 
+	initializeLocalWireGuard(getconfigCtx)
+	go localWireGuardPOSTTask(getconfigCtx)
+
+	initializeLocalAttest(getconfigCtx)
+	go localAttestPOSTTask(getconfigCtx)
+
 	// start the config fetch tasks, when zboot status is ready
 	log.Functionf("Creating %s at %s", "configTimerTask", agentlog.GetMyStack())
 	go configTimerTask(getconfigCtx, handleChannel)
@@ -944,6 +951,11 @@ func mainEventLoop(zedagentCtx *zedagentContext, stillRunning *time.Ticker) {
 		case change := <-zedagentCtx.subZFSPoolMetrics.MsgChan():
 			zedagentCtx.subZFSPoolMetrics.ProcessChange(change)
 
+		case change := <-zedagentCtx.subDiskHealthStatus.MsgChan():
+			zedagentCtx.subDiskHealthStatus.ProcessChange(change)
+			triggerPublishDevInfo(zedagentCtx)
+			triggerPublishHwInfo(zedagentCtx)
+
 		case <-hwInfoTiker.C:
 			triggerPublishHwInfo(zedagentCtx)
 
@@ -1793,6 +1805,19 @@ func initPostOnboardSubs(zedagentCtx *zedagentContext) {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	zedagentCtx.subDiskHealthStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zfsmanager",
+		MyAgentName: agentName,
+		TopicImpl:   types.DiskHealthStatus{},
+		Activate:    true,
+		Ctx:         zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
 func triggerPublishHwInfo(ctxPtr *zedagentContext) {
@@ -1948,6 +1973,7 @@ func handleAppInstanceStatusCreate(ctxArg interface{}, key string,
 	triggerPublishObjectInfo(ctx, info.ZInfoTypes_ZiApp, key)
 	triggerPublishDevInfo(ctx)
 	processAppCommandStatus(ctx.getconfigCtx, status)
+	applyRestartPolicy(ctx.getconfigCtx, status)
 	triggerLocalAppInfoPOST(ctx.getconfigCtx)
 	ctx.iteration++
 	log.Functionf("handleAppInstanceStatusCreate(%s) DONE", key)
@@ -1964,6 +1990,7 @@ func handleAppInstanceStatusModify(ctxArg interface{}, key string,
 	ctx := ctxArg.(*zedagentContext)
 	triggerPublishObjectInfo(ctx, info.ZInfoTypes_ZiApp, key)
 	processAppCommandStatus(ctx.getconfigCtx, status)
+	applyRestartPolicy(ctx.getconfigCtx, status)
 	triggerLocalAppInfoPOST(ctx.getconfigCtx)
 	ctx.iteration++
 	log.Functionf("handleAppInstanceStatusModify(%s) DONE", key)
@@ -2214,6 +2241,7 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		ctx.GCInitialized = true
 		ctx.gcpMaintenanceMode = gcp.GlobalValueTriState(types.MaintenanceMode)
 		mergeMaintenanceMode(ctx)
+		updateAttestVerifier(ctx)
 	}
 
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+// Export of an app instance into an app archive, from which another device
+// imports the content of the volumes of the same app instance. The volumes
+// are exported by volumemgr while the app instance is down; the manifest is
+// signed once all of them are.
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/appexport"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+// appExportDir returns the directory of the app archive of the app
+// instance, and of the archive while it is written
+func appExportDir(status *types.AppInstanceStatus) (string, string) {
+	dir := filepath.Join(types.AppExportDirname, status.Key())
+	return dir, dir + ".partial"
+}
+
+// startAppExport prepares the directory the volumes are exported into
+func startAppExport(status *types.AppInstanceStatus) (string, error) {
+	_, partialDir := appExportDir(status)
+	if err := os.RemoveAll(partialDir); err != nil {
+		err = fmt.Errorf("cannot remove %s: %v", partialDir, err)
+		log.Errorf("startAppExport(%s): %v", status.Key(), err)
+		return "", err
+	}
+	if err := os.MkdirAll(partialDir, 0700); err != nil {
+		err = fmt.Errorf("cannot create %s: %v", partialDir, err)
+		log.Errorf("startAppExport(%s): %v", status.Key(), err)
+		return "", err
+	}
+	status.ExportError = ""
+	return partialDir, nil
+}
+
+// finishAppExport writes the signed manifest of the volumes exported by
+// volumemgr and replaces the previous app archive of the app instance.
+// The partial archive is removed if any volume failed to export.
+func finishAppExport(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, keys []string) {
+
+	dir, partialDir := appExportDir(status)
+	err := writeAppExportManifest(ctx, config, partialDir, keys)
+	if err == nil {
+		if err = os.RemoveAll(dir); err == nil {
+			err = os.Rename(partialDir, dir)
+		}
+	}
+	if err != nil {
+		log.Errorf("finishAppExport(%s) failed: %v", status.Key(), err)
+		status.ExportError = err.Error()
+		if err := os.RemoveAll(partialDir); err != nil {
+			log.Warnf("finishAppExport(%s): %v", status.Key(), err)
+		}
+		return
+	}
+	status.ExportError = ""
+	status.ExportedAt = time.Now()
+	log.Noticef("finishAppExport(%s) exported into %s", status.Key(), dir)
+}
+
+func writeAppExportManifest(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	dir string, keys []string) error {
+
+	manifest := appexport.Manifest{
+		CreateTime: time.Now(),
+		Config:     config,
+	}
+	for _, key := range keys {
+		vrs := lookupVolumeRefStatus(ctx, key)
+		if vrs == nil {
+			return fmt.Errorf("no VolumeRefStatus for %s", key)
+		}
+		if vrs.ExportError != "" {
+			return fmt.Errorf("export of volume %s failed: %s",
+				vrs.DisplayName, vrs.ExportError)
+		}
+		manifest.Volumes = append(manifest.Volumes, appexport.Volume{
+			VolumeID:          vrs.VolumeID,
+			GenerationCounter: vrs.GenerationCounter,
+			DisplayName:       vrs.DisplayName,
+			VolumeExport:      vrs.Export,
+		})
+	}
+	cert, err := zedcloud.GetClientCert()
+	if err != nil {
+		return fmt.Errorf("cannot get the device certificate: %v", err)
+	}
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok || len(cert.Certificate) == 0 {
+		return errors.New("the device key can not sign the app archive")
+	}
+	return appexport.WriteManifest(dir, manifest, signer, cert.Certificate[0])
+}
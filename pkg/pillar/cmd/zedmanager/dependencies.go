@@ -0,0 +1,344 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+// Start ordering of the app instances which depend on other app instances
+// being online or healthy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// dependencyGraph returns the keys of the dependencies of every app instance
+// indexed by the key of the app instance
+func dependencyGraph(configs []types.AppInstanceConfig) map[string][]string {
+	graph := make(map[string][]string, len(configs))
+	for _, config := range configs {
+		var deps []string
+		for _, dep := range config.Dependencies {
+			deps = append(deps, dep.AppUUID.String())
+		}
+		graph[config.Key()] = deps
+	}
+	return graph
+}
+
+func appDependencyGraph(ctx *zedmanagerContext) map[string][]string {
+	var configs []types.AppInstanceConfig
+	for _, c := range ctx.subAppInstanceConfig.GetAll() {
+		configs = append(configs, c.(types.AppInstanceConfig))
+	}
+	return dependencyGraph(configs)
+}
+
+// dependencyCycle returns the cycle of dependencies the app instance is
+// part of, starting and ending with it, or nil if there is none
+func dependencyCycle(graph map[string][]string, key string) []string {
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(node string) bool
+	visit = func(node string) bool {
+		path = append(path, node)
+		for _, dep := range graph[node] {
+			if dep == key {
+				path = append(path, dep)
+				return true
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			if visit(dep) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(key) {
+		return path
+	}
+	return nil
+}
+
+// dependencyOrder returns the keys of the app instances sorted so that an
+// app instance comes after the app instances it depends on. The app
+// instances which are part of or depend on a cycle come last.
+func dependencyOrder(graph map[string][]string) []string {
+	pending := make(map[string]int, len(graph))
+	dependents := make(map[string][]string)
+	for key, deps := range graph {
+		for _, dep := range deps {
+			// unknown dependencies never block the order
+			if _, ok := graph[dep]; !ok {
+				continue
+			}
+			pending[key]++
+			dependents[dep] = append(dependents[dep], key)
+		}
+	}
+	var ready []string
+	for key := range graph {
+		if pending[key] == 0 {
+			ready = append(ready, key)
+		}
+	}
+	var order []string
+	for len(ready) != 0 {
+		sort.Strings(ready)
+		key := ready[0]
+		ready = ready[1:]
+		order = append(order, key)
+		for _, dependent := range dependents[key] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	var blocked []string
+	for key := range graph {
+		if pending[key] != 0 {
+			blocked = append(blocked, key)
+		}
+	}
+	sort.Strings(blocked)
+	return append(order, blocked...)
+}
+
+// dependencyMet returns true if the app instance meets the condition
+func dependencyMet(status *types.AppInstanceStatus, cond types.AppDependencyCondition) bool {
+	if status == nil || !status.Activated || status.State != types.RUNNING {
+		return false
+	}
+	if cond == types.AppDependencyHealthy &&
+		(status.HasError() || !appReady(status)) {
+		return false
+	}
+	return true
+}
+
+// blockingDependencies returns the dependencies of the app instance which
+// do not meet their condition, and those of them which are not configured,
+// either unknown or deleted, which never meet it
+func blockingDependencies(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) (blocking []uuid.UUID, unknown []uuid.UUID) {
+
+	for _, dep := range config.Dependencies {
+		if lookupAppInstanceConfig(ctx, dep.AppUUID.String()) == nil {
+			log.Functionf("blockingDependencies(%s) %s is unknown",
+				config.Key(), dep.AppUUID)
+			blocking = append(blocking, dep.AppUUID)
+			unknown = append(unknown, dep.AppUUID)
+			continue
+		}
+		status := lookupAppInstanceStatus(ctx, dep.AppUUID.String())
+		if !dependencyMet(status, dep.Condition) {
+			log.Functionf("blockingDependencies(%s) %s is not %s",
+				config.Key(), dep.AppUUID, dep.Condition)
+			blocking = append(blocking, dep.AppUUID)
+		}
+	}
+	return blocking, unknown
+}
+
+// dependencyBootTimes returns the boot times of the dependencies
+func dependencyBootTimes(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) map[string]time.Time {
+
+	bootTimes := make(map[string]time.Time)
+	for _, dep := range config.Dependencies {
+		status := lookupAppInstanceStatus(ctx, dep.AppUUID.String())
+		if status != nil {
+			bootTimes[dep.AppUUID.String()] = status.BootTime
+		}
+	}
+	return bootTimes
+}
+
+func equalBootTimes(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, t := range a {
+		if other, ok := b[key]; !ok || !other.Equal(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalUUIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !uuid.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// setDependencyError reports the error of the dependencies and blocks the
+// start of the app instance.
+// Returns changed
+func setDependencyError(status *types.AppInstanceStatus, errStr string) bool {
+	changed := false
+	if !status.IsErrorSource(types.AppDependency{}) || status.Error != errStr {
+		log.Errorf("checkAppDependencies(%s) failed: %s",
+			status.Key(), errStr)
+		status.SetErrorWithSource(errStr, types.AppDependency{},
+			time.Now())
+		changed = true
+	}
+	if status.State != types.START_BLOCKED {
+		status.State = types.START_BLOCKED
+		changed = true
+	}
+	return changed
+}
+
+// checkAppDependencies holds the start of the app instance until its
+// dependencies meet their condition, in which case it is in START_BLOCKED
+// state. A cycle of dependencies and dependencies on app instances which
+// are not configured are reported as errors.
+// Returns changed, blocked
+func checkAppDependencies(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (bool, bool) {
+
+	changed := false
+	if len(config.Dependencies) != 0 {
+		if cycle := dependencyCycle(appDependencyGraph(ctx), config.Key()); cycle != nil {
+			var names []string
+			for _, key := range cycle {
+				name := key
+				if c := lookupAppInstanceConfig(ctx, key); c != nil {
+					name = c.DisplayName
+				}
+				names = append(names, name)
+			}
+			errStr := fmt.Sprintf("Dependency cycle %s",
+				strings.Join(names, " -> "))
+			return setDependencyError(status, errStr), true
+		}
+	}
+	blockedOn, unknown := blockingDependencies(ctx, config)
+	if !equalUUIDs(status.BlockedOnApps, blockedOn) {
+		status.BlockedOnApps = blockedOn
+		changed = true
+	}
+	if len(unknown) != 0 {
+		var ids []string
+		for _, id := range unknown {
+			ids = append(ids, id.String())
+		}
+		errStr := fmt.Sprintf("Dependency on unknown app instance %s",
+			strings.Join(ids, ", "))
+		if setDependencyError(status, errStr) {
+			changed = true
+		}
+		return changed, true
+	}
+	if status.IsErrorSource(types.AppDependency{}) {
+		log.Functionf("Clearing dependency error %s", status.Error)
+		status.ClearErrorWithSource()
+		changed = true
+	}
+	if len(blockedOn) != 0 {
+		if status.State != types.START_BLOCKED {
+			log.Noticef("checkAppDependencies(%s) blocked on %v",
+				status.Key(), blockedOn)
+			status.State = types.START_BLOCKED
+			changed = true
+		}
+		return changed, true
+	}
+	if status.State == types.START_BLOCKED {
+		log.Noticef("checkAppDependencies(%s) dependencies met", status.Key())
+		switch {
+		case status.RestartInprogress != types.NotInprogress:
+			status.State = types.RESTARTING
+		case status.PurgeInprogress != types.NotInprogress:
+			status.State = types.PURGING
+		default:
+			status.State = types.INSTALLED
+		}
+		changed = true
+	}
+	bootTimes := dependencyBootTimes(ctx, config)
+	if !equalBootTimes(status.DependencyBootTimes, bootTimes) {
+		status.DependencyBootTimes = bootTimes
+		changed = true
+	}
+	return changed, false
+}
+
+// dependencyRestarted returns true if the dependency is running again
+// after it restarted since the app instance was started
+func dependencyRestarted(status *types.AppInstanceStatus,
+	depStatus types.AppInstanceStatus) bool {
+
+	bootTime, ok := status.DependencyBootTimes[depStatus.Key()]
+	if !ok || bootTime.IsZero() {
+		return false
+	}
+	return depStatus.State == types.RUNNING && !depStatus.BootTime.IsZero() &&
+		!depStatus.BootTime.Equal(bootTime)
+}
+
+func dependsOn(config types.AppInstanceConfig, key string) bool {
+	for _, dep := range config.Dependencies {
+		if dep.AppUUID.String() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// updateDependentApps re-evaluates the app instances which depend on the
+// app instance after its status changed. The blocked ones are started once
+// their dependencies meet their conditions, and the ones which ask for it
+// are restarted if the dependency restarted.
+func updateDependentApps(ctx *zedmanagerContext, depStatus types.AppInstanceStatus) {
+	for _, key := range dependencyOrder(appDependencyGraph(ctx)) {
+		config := lookupAppInstanceConfig(ctx, key)
+		if config == nil || !dependsOn(*config, depStatus.Key()) {
+			continue
+		}
+		status := lookupAppInstanceStatus(ctx, key)
+		if status == nil {
+			continue
+		}
+		if status.State == types.START_BLOCKED {
+			log.Functionf("updateDependentApps(%s) re-evaluating %s",
+				depStatus.Key(), key)
+			if doUpdate(ctx, *config, status) {
+				publishAppInstanceStatus(ctx, status)
+			}
+			continue
+		}
+		if !config.RestartWithDependencies || !status.Activated ||
+			status.RestartInprogress != types.NotInprogress ||
+			status.PurgeInprogress != types.NotInprogress ||
+			!effectiveActivateCurrentProfile(*config, ctx.currentProfile) {
+			continue
+		}
+		if dependencyRestarted(status, depStatus) {
+			log.Noticef("updateDependentApps(%s) restarted, restarting %s",
+				depStatus.Key(), key)
+			status.RestartInprogress = types.BringDown
+			status.State = types.RESTARTING
+			status.RestartStartedAt = time.Now()
+			doUpdate(ctx, *config, status)
+			publishAppInstanceStatus(ctx, status)
+		}
+	}
+}
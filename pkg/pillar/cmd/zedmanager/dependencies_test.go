@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+func testAppConfig(id string, deps ...string) types.AppInstanceConfig {
+	config := types.AppInstanceConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: uuid.FromStringOrNil(id)},
+	}
+	for _, dep := range deps {
+		config.Dependencies = append(config.Dependencies,
+			types.AppDependency{AppUUID: uuid.FromStringOrNil(dep)})
+	}
+	return config
+}
+
+const (
+	dbApp        = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	brokerApp    = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	analyticsApp = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	unknownApp   = "6ba7b813-9dad-11d1-80b4-00c04fd430c8"
+)
+
+func TestDependencyOrder(t *testing.T) {
+	graph := dependencyGraph([]types.AppInstanceConfig{
+		testAppConfig(analyticsApp, brokerApp, dbApp),
+		testAppConfig(brokerApp, dbApp, unknownApp),
+		testAppConfig(dbApp),
+	})
+	order := dependencyOrder(graph)
+	expected := []string{dbApp, brokerApp, analyticsApp}
+	if len(order) != len(expected) {
+		t.Fatalf("dependencyOrder returned %v, expected %v", order, expected)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("dependencyOrder returned %v, expected %v", order, expected)
+		}
+	}
+	for _, key := range expected {
+		if cycle := dependencyCycle(graph, key); cycle != nil {
+			t.Errorf("dependencyCycle(%s) returned %v, expected none", key, cycle)
+		}
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	graph := dependencyGraph([]types.AppInstanceConfig{
+		testAppConfig(analyticsApp, brokerApp),
+		testAppConfig(brokerApp, dbApp),
+		testAppConfig(dbApp, analyticsApp),
+		testAppConfig(unknownApp, dbApp),
+	})
+	cycle := dependencyCycle(graph, brokerApp)
+	expected := []string{brokerApp, dbApp, analyticsApp, brokerApp}
+	if len(cycle) != len(expected) {
+		t.Fatalf("dependencyCycle returned %v, expected %v", cycle, expected)
+	}
+	for i := range expected {
+		if cycle[i] != expected[i] {
+			t.Fatalf("dependencyCycle returned %v, expected %v", cycle, expected)
+		}
+	}
+	// depends on the cycle without being part of it
+	if cycle := dependencyCycle(graph, unknownApp); cycle != nil {
+		t.Errorf("dependencyCycle returned %v, expected none", cycle)
+	}
+	order := dependencyOrder(graph)
+	if len(order) != 4 || order[3] != unknownApp {
+		t.Errorf("dependencyOrder returned %v", order)
+	}
+}
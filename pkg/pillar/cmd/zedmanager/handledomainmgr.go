@@ -49,6 +49,8 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		MetaDataType:      aiConfig.MetaDataType,
 		Service:           aiConfig.Service,
 		CloudInitVersion:  aiConfig.CloudInitVersion,
+		HealthConfig:      domainHealthConfig(aiConfig, ns),
+		GuestFsFreeze:     aiStatus.GuestFsFreeze,
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
@@ -76,6 +78,7 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		disk.WWN = vrs.WWN
 		disk.Target = vrs.Target
 		disk.CustomMeta = vrs.CustomMeta
+		disk.IoLimits = vrc.IoLimits
 		dc.DiskConfigList = append(dc.DiskConfigList, disk)
 	}
 	// let's fill some of the default values (arguably we may want controller
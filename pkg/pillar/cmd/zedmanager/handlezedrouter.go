@@ -61,6 +61,12 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 				changed = true
 				break
 			}
+			if !reflect.DeepEqual(new.Shaping, old.Shaping) {
+				log.Functionf("Under traffic shaping changed from %+v to %+v",
+					old.Shaping, new.Shaping)
+				changed = true
+				break
+			}
 		}
 	} else {
 		log.Tracef("appNetwork config add for %s", key)
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+// Health probes of the app instances, which domainmgr runs and reports in
+// the DomainStatus
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// domainHealthConfig returns the health config for the DomainConfig, in
+// which the probes without a host connect to the IP address of the app
+// instance on its first network adapter
+func domainHealthConfig(config types.AppInstanceConfig,
+	ns *types.AppNetworkStatus) types.AppHealthConfig {
+
+	healthConfig := config.HealthConfig
+	appIP := ""
+	if ns != nil && len(ns.UnderlayNetworkList) != 0 {
+		appIP = ns.UnderlayNetworkList[0].AllocatedIPv4Addr
+	}
+	for _, probe := range []*types.AppProbe{&healthConfig.Liveness, &healthConfig.Readiness} {
+		switch probe.Type {
+		case types.AppProbeHTTP, types.AppProbeTCP:
+			if probe.Host == "" {
+				probe.Host = appIP
+			}
+		}
+	}
+	return healthConfig
+}
+
+// updateProbeStatus copies the state of the health probes from the
+// DomainStatus, and reports a failed liveness probe as an error of the
+// app instance. Returns true if the status changed.
+func updateProbeStatus(status *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	changed := false
+	if !reflect.DeepEqual(status.Liveness, ds.Liveness) {
+		status.Liveness = ds.Liveness
+		changed = true
+	}
+	if !reflect.DeepEqual(status.Readiness, ds.Readiness) {
+		status.Readiness = ds.Readiness
+		changed = true
+	}
+	if status.Liveness.Failed {
+		if !status.HasError() {
+			errStr := fmt.Sprintf("Liveness probe %s failed: %s",
+				status.Liveness.Probe, status.Liveness.LastError())
+			log.Errorf("updateProbeStatus(%s): %s", status.Key(), errStr)
+			description := types.ErrorDescription{
+				Error:         errStr,
+				ErrorSeverity: types.ErrorSeverityWarning,
+			}
+			status.SetErrorWithSourceAndDescription(description,
+				types.AppProbeStatus{})
+			changed = true
+		}
+	} else if status.IsErrorSource(types.AppProbeStatus{}) {
+		log.Functionf("Clearing liveness probe error %s", status.Error)
+		status.ClearErrorWithSource()
+		changed = true
+	}
+	return changed
+}
+
+// appReady returns true if the app instance has no readiness probe or its
+// readiness probe is passing
+func appReady(status *types.AppInstanceStatus) bool {
+	return status.Readiness.Probe == "" || status.Readiness.Passing
+}
@@ -76,3 +76,25 @@ func sysTotalMemory(ctx *zedmanagerContext) (uint64, error) {
 	}
 	return 0, fmt.Errorf("Global host memory is empty")
 }
+
+// checkResizeMemory returns why the memory of an active app instance
+// cannot grow to that of its config, or an empty string
+func checkResizeMemory(ctxPtr *zedmanagerContext, config types.AppInstanceConfig,
+	status types.AppInstanceStatus) string {
+
+	grow := config.FixedResources.Memory - status.FixedResources.Memory
+	if grow <= 0 || !(status.Activated || status.ActivateInprogress) ||
+		ctxPtr.globalConfig.GlobalValueBool(types.IgnoreMemoryCheckForApps) {
+		return ""
+	}
+	remaining, _, _, err := getRemainingMemory(ctxPtr)
+	if err != nil {
+		return fmt.Sprintf("memory resize: getRemainingMemory failed: %s", err)
+	}
+	need := uint64(grow) << 10
+	if remaining < need {
+		return fmt.Sprintf("memory resize: remaining memory bytes %d app instance needs %d more",
+			remaining, need)
+	}
+	return ""
+}
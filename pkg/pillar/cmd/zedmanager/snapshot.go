@@ -0,0 +1,311 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+// Snapshots of the volumes of an app instance, taken and rolled back by
+// volumemgr while the app instance is brought down, or while its
+// filesystems are frozen by its guest agent
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// rollbackBootWindow is how long after booting an updated app instance a
+// failure of its domain is considered a boot failure
+const rollbackBootWindow = 5 * time.Minute
+
+// liveSnapshotThawMargin is how long before domainmgr thaws the filesystems
+// of the guest on its own (DomainStatus.GuestFsFrozenUntil) the freeze is
+// no longer relied upon, which leaves time for the completion of the
+// snapshots to be handled
+const liveSnapshotThawMargin = 10 * time.Second
+
+// startVolumeSnapshotOp sets the operation to run on the volumes once the
+// app instance is down
+func startVolumeSnapshotOp(status *types.AppInstanceStatus, op types.VolumeSnapshotOp) {
+	log.Functionf("startVolumeSnapshotOp(%s) %s", status.Key(), op)
+	status.VolumeSnapshotOp = op
+	status.VolumeSnapshotOpStarted = false
+	// a new update or a local command replaces the previous update
+	status.RollbackOnBootFailure = false
+	// and is not run live
+	status.VolumeSnapshotLive = false
+	status.GuestFsFreeze = false
+}
+
+// liveSnapshotSupported returns true if the volumes of the app instance can
+// be snapshotted while it is running, with its filesystems frozen by its
+// guest agent. The internal snapshots of qcow2 images can not be taken
+// while QEMU uses them, hence all volumes must be zvols.
+func liveSnapshotSupported(config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) bool {
+
+	if !config.FixedResources.EnableGuestAgent ||
+		status.State != types.RUNNING ||
+		status.RestartInprogress != types.NotInprogress ||
+		!status.GuestInfo.Connected {
+		return false
+	}
+	for _, vrs := range status.VolumeRefStatusList {
+		if !strings.HasPrefix(vrs.ActiveFileLocation, types.ZVolDevicePrefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// snapshotVolumeRefKeys returns the keys of the VolumeRefConfigs the
+// operation applies to
+func snapshotVolumeRefKeys(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, op types.VolumeSnapshotOp) []string {
+
+	var keys []string
+	for _, vrs := range status.VolumeRefStatusList {
+		if getVolumeRefConfigFromAIConfig(&config, vrs) == nil {
+			// removed by a purge
+			continue
+		}
+		if volumeRecreated(status, vrs) {
+			continue
+		}
+		vrsPubSub := lookupVolumeRefStatus(ctx, vrs.Key())
+		if vrsPubSub == nil {
+			continue
+		}
+		policy := vrsPubSub.SnapshotPolicy
+		switch op {
+		case types.SnapshotBeforeUpdate:
+			if !policy.SnapshotBeforeUpdate {
+				continue
+			}
+		case types.RollbackAfterBootFailure:
+			if !policy.SnapshotBeforeUpdate || !policy.RollbackOnBootFailure {
+				continue
+			}
+		}
+		keys = append(keys, vrs.Key())
+	}
+	return keys
+}
+
+// volumeRecreated returns true if a purge replaces the volume with a new
+// generation of it, in which case the old and the new generation are both
+// in the VolumeRefStatusList
+func volumeRecreated(status *types.AppInstanceStatus, vrs types.VolumeRefStatus) bool {
+	for _, other := range status.VolumeRefStatusList {
+		if other.VolumeID == vrs.VolumeID && other.Key() != vrs.Key() {
+			return true
+		}
+	}
+	return false
+}
+
+// doVolumeSnapshotOp requests the VolumeSnapshotOp of the app instance
+// from volumemgr and waits until it is done for all volumes. It must only
+// be called when the domain is down or its filesystems are frozen.
+// Returns changed, done
+func doVolumeSnapshotOp(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (bool, bool) {
+
+	op := status.VolumeSnapshotOp
+	if op == types.NoVolumeSnapshotOp {
+		return false, true
+	}
+	changed := false
+	keys := snapshotVolumeRefKeys(ctx, config, status, op)
+	if !status.VolumeSnapshotOpStarted {
+		log.Noticef("doVolumeSnapshotOp(%s) %s for %v",
+			status.Key(), op, keys)
+		var exportDir string
+		if op == types.ExportVolumes {
+			var err error
+			if exportDir, err = startAppExport(status); err != nil {
+				status.ExportError = err.Error()
+				status.VolumeSnapshotOp = types.NoVolumeSnapshotOp
+				return true, true
+			}
+		}
+		for _, key := range keys {
+			vrc := lookupVolumeRefConfig(ctx, key)
+			if vrc == nil {
+				log.Warnf("doVolumeSnapshotOp(%s) no VolumeRefConfig for %s",
+					status.Key(), key)
+				continue
+			}
+			if op == types.ExportVolumes {
+				vrc.ExportCounter++
+				vrc.ExportDir = exportDir
+			} else if op.IsRollback() {
+				vrc.RollbackCounter++
+			} else {
+				vrc.SnapshotCounter++
+			}
+			publishVolumeRefConfig(ctx, vrc)
+		}
+		status.VolumeSnapshotOpStarted = true
+		changed = true
+	}
+	failed := false
+	for _, key := range keys {
+		vrc := lookupVolumeRefConfig(ctx, key)
+		vrs := lookupVolumeRefStatus(ctx, key)
+		if vrc == nil || vrs == nil {
+			continue
+		}
+		if vrs.SnapshotCounter != vrc.SnapshotCounter ||
+			vrs.RollbackCounter != vrc.RollbackCounter ||
+			vrs.ExportCounter != vrc.ExportCounter {
+			log.Functionf("doVolumeSnapshotOp(%s) waiting for %s",
+				status.Key(), key)
+			return changed, false
+		}
+		if op == types.ExportVolumes {
+			continue
+		}
+		if vrs.SnapshotError != "" {
+			log.Errorf("doVolumeSnapshotOp(%s) %s failed for %s: %s",
+				status.Key(), op, key, vrs.SnapshotError)
+			failed = true
+		}
+	}
+	if op == types.ExportVolumes {
+		finishAppExport(ctx, config, status, keys)
+	}
+	if op == types.SnapshotBeforeUpdate && !failed {
+		status.RollbackOnBootFailure =
+			len(snapshotVolumeRefKeys(ctx, config, status, types.RollbackAfterBootFailure)) != 0
+	}
+	log.Noticef("doVolumeSnapshotOp(%s) %s done, rollback on boot failure %t",
+		status.Key(), op, status.RollbackOnBootFailure)
+	status.VolumeSnapshotOp = types.NoVolumeSnapshotOp
+	status.VolumeSnapshotOpStarted = false
+	return true, true
+}
+
+// doLiveVolumeSnapshot runs the VolumeSnapshotOp of the running app
+// instance. It has domainmgr freeze the filesystems of the guest, requests
+// the snapshots from volumemgr, and has domainmgr thaw the filesystems once
+// they are done. Should the freeze fail, or the filesystems be thawed
+// before the snapshots are done, the app instance is restarted to take
+// the snapshots while it is down instead.
+// Returns changed
+func doLiveVolumeSnapshot(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus, ds types.DomainStatus,
+	dc *types.DomainConfig) bool {
+
+	if status.RestartInprogress != types.NotInprogress ||
+		status.PurgeInprogress != types.NotInprogress {
+		// the snapshots are taken while the app instance is down
+		log.Noticef("doLiveVolumeSnapshot(%s) restarted, snapshots taken while down",
+			status.Key())
+		status.VolumeSnapshotLive = false
+		status.GuestFsFreeze = false
+		dc.GuestFsFreeze = false
+		return true
+	}
+	if !status.GuestFsFreeze {
+		log.Noticef("doLiveVolumeSnapshot(%s) freezing the filesystems",
+			status.Key())
+		status.GuestFsFreeze = true
+		dc.GuestFsFreeze = true
+		return true
+	}
+	if !ds.GuestFsFreeze {
+		log.Functionf("doLiveVolumeSnapshot(%s) waiting for the freeze",
+			status.Key())
+		return false
+	}
+	frozen := ds.GuestFsFrozen &&
+		time.Now().Add(liveSnapshotThawMargin).Before(ds.GuestFsFrozenUntil)
+	if !frozen {
+		if status.VolumeSnapshotOpStarted {
+			// the snapshots taken so far may be inconsistent, the whole
+			// operation is run again while the app instance is down
+			log.Warnf("doLiveVolumeSnapshot(%s) thawed during %s, restarting to take the snapshots",
+				status.Key(), status.VolumeSnapshotOp)
+			status.VolumeSnapshotOpStarted = false
+		} else {
+			log.Warnf("doLiveVolumeSnapshot(%s) freeze failed, restarting to take the snapshots",
+				status.Key())
+		}
+		status.VolumeSnapshotLive = false
+		status.GuestFsFreeze = false
+		dc.GuestFsFreeze = false
+		status.RestartInprogress = types.BringDown
+		status.State = types.RESTARTING
+		status.RestartStartedAt = time.Now()
+		dc.Activate = false
+		return true
+	}
+	changed, done := doVolumeSnapshotOp(ctx, config, status)
+	if !done {
+		return changed
+	}
+	log.Noticef("doLiveVolumeSnapshot(%s) thawing the filesystems",
+		status.Key())
+	status.VolumeSnapshotLive = false
+	status.GuestFsFreeze = false
+	dc.GuestFsFreeze = false
+	return true
+}
+
+// maybeRollbackAfterBootFailure starts a restart of the app instance with
+// the volumes rolled back if the domain failed shortly after the app instance
+// was updated. Returns true if the restart was started.
+func maybeRollbackAfterBootFailure(status *types.AppInstanceStatus,
+	dc *types.DomainConfig, now time.Time) bool {
+
+	if !status.RollbackOnBootFailure {
+		return false
+	}
+	// The policy applies only to the first boot of the update
+	status.RollbackOnBootFailure = false
+	if !status.BootTime.IsZero() &&
+		now.Sub(status.BootTime) > rollbackBootWindow {
+		log.Functionf("maybeRollbackAfterBootFailure(%s) booted at %v",
+			status.Key(), status.BootTime)
+		return false
+	}
+	log.Noticef("maybeRollbackAfterBootFailure(%s) rolling back the volumes",
+		status.Key())
+	startVolumeSnapshotOp(status, types.RollbackAfterBootFailure)
+	status.RestartInprogress = types.BringDown
+	status.State = types.RESTARTING
+	status.RestartStartedAt = now
+	dc.Activate = false
+	return true
+}
+
+// expireRollbackOnBootFailure clears RollbackOnBootFailure once the updated
+// app instance has been running for rollbackBootWindow, so that a failure
+// after a later boot, e.g. after a reboot of the device, does not revert the
+// volumes to the snapshots taken before the update.
+// Returns true if the status changed.
+func expireRollbackOnBootFailure(status *types.AppInstanceStatus, now time.Time) bool {
+	if !status.RollbackOnBootFailure || status.State != types.RUNNING ||
+		status.RestartInprogress != types.NotInprogress ||
+		status.PurgeInprogress != types.NotInprogress ||
+		status.BootTime.IsZero() || now.Sub(status.BootTime) <= rollbackBootWindow {
+		return false
+	}
+	log.Noticef("expireRollbackOnBootFailure(%s) running since %v, no rollback anymore",
+		status.Key(), status.BootTime)
+	status.RollbackOnBootFailure = false
+	return true
+}
+
+// checkRollbackOnBootFailure expires the rollback of the app instances which
+// booted successfully after an update
+func checkRollbackOnBootFailure(ctx *zedmanagerContext) {
+	now := time.Now()
+	for _, st := range ctx.pubAppInstanceStatus.GetAll() {
+		status := st.(types.AppInstanceStatus)
+		if expireRollbackOnBootFailure(&status, now) {
+			publishAppInstanceStatus(ctx, &status)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, agentName, 0)
+}
+
+func updatedAppStatus(bootTime time.Time) *types.AppInstanceStatus {
+	return &types.AppInstanceStatus{
+		State:                 types.RUNNING,
+		BootTime:              bootTime,
+		RollbackOnBootFailure: true,
+	}
+}
+
+func TestMaybeRollbackAfterBootFailure(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		status   *types.AppInstanceStatus
+		rollback bool
+	}{
+		{
+			name:     "failed before booting",
+			status:   updatedAppStatus(time.Time{}),
+			rollback: true,
+		},
+		{
+			name:     "failed within the window",
+			status:   updatedAppStatus(now.Add(-time.Minute)),
+			rollback: true,
+		},
+		{
+			name:     "failed after the window",
+			status:   updatedAppStatus(now.Add(-rollbackBootWindow - time.Second)),
+			rollback: false,
+		},
+		{
+			name: "no snapshots taken",
+			status: &types.AppInstanceStatus{
+				State:    types.RUNNING,
+				BootTime: now.Add(-time.Minute),
+			},
+			rollback: false,
+		},
+	}
+	for _, test := range tests {
+		dc := &types.DomainConfig{Activate: true}
+		rollback := maybeRollbackAfterBootFailure(test.status, dc, now)
+		if rollback != test.rollback {
+			t.Errorf("%s: expected rollback %t, got %t", test.name,
+				test.rollback, rollback)
+		}
+		if test.status.RollbackOnBootFailure {
+			t.Errorf("%s: RollbackOnBootFailure not cleared", test.name)
+		}
+		if !rollback {
+			if !dc.Activate || test.status.State != types.RUNNING ||
+				test.status.VolumeSnapshotOp != types.NoVolumeSnapshotOp {
+				t.Errorf("%s: unexpected restart %+v", test.name, test.status)
+			}
+			continue
+		}
+		if dc.Activate || test.status.State != types.RESTARTING ||
+			test.status.RestartInprogress != types.BringDown ||
+			test.status.VolumeSnapshotOp != types.RollbackAfterBootFailure {
+			t.Errorf("%s: rollback not started %+v", test.name, test.status)
+		}
+	}
+}
+
+func TestExpireRollbackOnBootFailure(t *testing.T) {
+	now := time.Now()
+	pastWindow := now.Add(-rollbackBootWindow - time.Second)
+
+	status := updatedAppStatus(now.Add(-time.Minute))
+	if expireRollbackOnBootFailure(status, now) || !status.RollbackOnBootFailure {
+		t.Errorf("expired within the window")
+	}
+
+	// the restart of the update is not done, BootTime is the previous one
+	status = updatedAppStatus(pastWindow)
+	status.RestartInprogress = types.BringUp
+	if expireRollbackOnBootFailure(status, now) || !status.RollbackOnBootFailure {
+		t.Errorf("expired during the restart")
+	}
+
+	status = updatedAppStatus(pastWindow)
+	status.State = types.BOOTING
+	if expireRollbackOnBootFailure(status, now) || !status.RollbackOnBootFailure {
+		t.Errorf("expired while not running")
+	}
+
+	status = updatedAppStatus(pastWindow)
+	if !expireRollbackOnBootFailure(status, now) || status.RollbackOnBootFailure {
+		t.Errorf("not expired after running past the window")
+	}
+	// a failure after a later boot does not roll back
+	status.BootTime = now
+	if maybeRollbackAfterBootFailure(status, &types.DomainConfig{}, now) {
+		t.Errorf("rolled back after the rollback expired")
+	}
+}
@@ -55,6 +55,15 @@ func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 	uuidStr := status.Key()
 	uninstall := (status.PurgeInprogress != types.BringDown)
 	changed, done := doRemove(ctx, status, uninstall)
+	if done && !uninstall {
+		// Snapshot or roll back the volumes which are not purged
+		config := lookupAppInstanceConfig(ctx, uuidStr)
+		if config != nil {
+			c, d := doVolumeSnapshotOp(ctx, *config, status)
+			changed = changed || c
+			done = d
+		}
+	}
 	if changed {
 		log.Functionf("removeAIStatus status change for %s",
 			uuidStr)
@@ -154,7 +163,8 @@ func doUpdate(ctx *zedmanagerContext,
 			// Since we are not activating we set the state to
 			// HALTED to indicate it is not running since it
 			// might have been halted before the device was rebooted
-			if status.State == types.INSTALLED || status.State == types.START_DELAYED {
+			if status.State == types.INSTALLED || status.State == types.START_DELAYED ||
+				status.State == types.START_BLOCKED {
 				status.State = types.HALTED
 				changed = true
 			}
@@ -322,7 +332,7 @@ func doInstall(ctx *zedmanagerContext,
 		// No VolumeRefStatus
 		minState = types.INITIAL
 	}
-	if status.State >= types.BOOTING {
+	if status.State >= types.BOOTING && status.State != types.START_BLOCKED {
 		// Leave unchanged
 	} else {
 		status.State = minState
@@ -406,7 +416,7 @@ func doPrepare(ctx *zedmanagerContext,
 	changed := false
 
 	// Automatically move from VERIFIED to INSTALLED
-	if status.State >= types.BOOTING {
+	if status.State >= types.BOOTING && status.State != types.START_BLOCKED {
 		// Leave unchanged
 	} else {
 		status.State = types.INSTALLED
@@ -448,10 +458,18 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 				changed = true
 			}
 			if !ds.Activated && !ds.HasError() {
-				log.Functionf("RestartInprogress(%s) came down - set bring up",
-					status.Key())
-				status.RestartInprogress = types.BringUp
-				changed = true
+				c, done := doVolumeSnapshotOp(ctx, config, status)
+				changed = changed || c
+				if done {
+					log.Functionf("RestartInprogress(%s) came down - set bring up",
+						status.Key())
+					status.RestartInprogress = types.BringUp
+					changed = true
+				} else {
+					log.Functionf("RestartInprogress(%s) came down - waiting for volume snapshots",
+						status.Key())
+					return changed
+				}
 			}
 		}
 	}
@@ -530,6 +548,7 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	// Commit that we will be using memory and
 	// Track that we have cleanup work in case something fails
 	status.ActivateInprogress = true
+	status.FixedResources = config.FixedResources
 
 	// Make sure we have an AppNetworkConfig
 	MaybeAddAppNetworkConfig(ctx, config, status)
@@ -585,6 +604,15 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		// if the VM already active or in restarting/purging state - continue with the doActivate logic
 	}
 
+	// Do the app instances it depends on allow the application to start?
+	if !status.Activated || status.RestartInprogress == types.BringUp || status.PurgeInprogress == types.BringUp {
+		c, blocked := checkAppDependencies(ctx, config, status)
+		changed = changed || c
+		if blocked {
+			return changed
+		}
+	}
+
 	// Make sure we have a DomainConfig
 	// We modify it below and then publish it
 	dc, err := MaybeAddDomainConfig(ctx, config, *status, ns)
@@ -619,6 +647,18 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if updateProbeStatus(status, *ds) {
+		changed = true
+	}
+	if updateGuestInfo(status, *ds) {
+		changed = true
+	}
+	if updateResizeError(status, *ds) {
+		changed = true
+	}
+	if updateIoLimitsError(status, *ds) {
+		changed = true
+	}
 	c := updateVifUsed(status, *ds)
 	if c {
 		changed = true
@@ -630,16 +670,27 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 				status.Key())
 			dc.Activate = false
 		} else if !ds.Activated {
-			log.Functionf("RestartInprogress(%s) Set Activate",
-				status.Key())
-			status.RestartInprogress = types.BringUp
-			changed = true
-			dc.Activate = true
+			c, done := doVolumeSnapshotOp(ctx, config, status)
+			changed = changed || c
+			if done {
+				log.Functionf("RestartInprogress(%s) Set Activate",
+					status.Key())
+				status.RestartInprogress = types.BringUp
+				changed = true
+				dc.Activate = true
+			} else {
+				log.Functionf("RestartInprogress(%s) waiting for volume snapshots",
+					status.Key())
+			}
 		} else {
 			log.Functionf("RestartInprogress(%s) waiting for domain down",
 				status.Key())
 		}
 	}
+	if status.VolumeSnapshotLive {
+		c := doLiveVolumeSnapshot(ctx, config, status, *ds, dc)
+		changed = changed || c
+	}
 	publishDomainConfig(ctx, dc)
 	// Look for xen errors. Ignore if we are going down
 	if status.RestartInprogress != types.BringDown {
@@ -648,6 +699,9 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 				uuidStr, ds.Error)
 			status.SetErrorWithSourceAndDescription(ds.ErrorDescription, types.DomainStatus{})
 			changed = true
+			if maybeRollbackAfterBootFailure(status, dc, time.Now()) {
+				publishDomainConfig(ctx, dc)
+			}
 		} else if status.IsErrorSource(types.DomainStatus{}) {
 			log.Functionf("Clearing domainmgr error %s", status.Error)
 			status.ClearErrorWithSource()
@@ -723,6 +777,69 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	return changed
 }
 
+// updateGuestInfo copies what the guest agent reported from the
+// DomainStatus. Returns true if the status changed.
+func updateGuestInfo(status *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if cmp.Equal(status.GuestInfo, ds.GuestInfo) {
+		return false
+	}
+	status.GuestInfo = ds.GuestInfo
+	return true
+}
+
+// updateResizeError reports that domainmgr failed to resize the running
+// domain, which keeps its previous memory and vCPUs until it restarts.
+// An error from another source is not replaced.
+// Returns true if the status changed.
+func updateResizeError(status *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if ds.ResizeError == "" {
+		if status.IsErrorSource(types.VmConfig{}) {
+			log.Functionf("Clearing resize error %s", status.Error)
+			status.ClearErrorWithSource()
+			return true
+		}
+		return false
+	}
+	errStr := fmt.Sprintf("Resize failed: %s", ds.ResizeError)
+	if status.Error == errStr ||
+		(status.HasError() && !status.IsErrorSource(types.VmConfig{})) {
+		return false
+	}
+	description := types.ErrorDescription{
+		Error:               errStr,
+		ErrorSeverity:       types.ErrorSeverityWarning,
+		ErrorRetryCondition: "The resources are applied when the app instance restarts",
+	}
+	status.SetErrorWithSourceAndDescription(description, types.VmConfig{})
+	return true
+}
+
+// updateIoLimitsError reports the disks whose I/O limits domainmgr could
+// not apply, which are used without limits.
+// An error from another source is not replaced.
+// Returns true if the status changed.
+func updateIoLimitsError(status *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if ds.IoLimitsError == "" {
+		if status.IsErrorSource(types.VolumeIoLimits{}) {
+			log.Functionf("Clearing I/O limits error %s", status.Error)
+			status.ClearErrorWithSource()
+			return true
+		}
+		return false
+	}
+	errStr := fmt.Sprintf("I/O limits not applied: %s", ds.IoLimitsError)
+	if status.Error == errStr ||
+		(status.HasError() && !status.IsErrorSource(types.VolumeIoLimits{})) {
+		return false
+	}
+	description := types.ErrorDescription{
+		Error:         errStr,
+		ErrorSeverity: types.ErrorSeverityWarning,
+	}
+	status.SetErrorWithSourceAndDescription(description, types.VolumeIoLimits{})
+	return true
+}
+
 // Check if VifUsed has changed and return true if it has
 func updateVifUsed(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
 	changed := false
@@ -1021,6 +1138,18 @@ func doInactivateHalt(ctx *zedmanagerContext,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if updateProbeStatus(status, *ds) {
+		changed = true
+	}
+	if updateGuestInfo(status, *ds) {
+		changed = true
+	}
+	if updateResizeError(status, *ds) {
+		changed = true
+	}
+	if updateIoLimitsError(status, *ds) {
+		changed = true
+	}
 	c := updateVifUsed(status, *ds)
 	if c {
 		changed = true
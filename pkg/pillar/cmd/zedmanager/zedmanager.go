@@ -30,6 +30,8 @@ const (
 	// Time limits for event loop handlers
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
+	// Number of recent AppInstanceStatus changes kept for debugging
+	appInstanceStatusHistory = 16
 )
 
 // Version can be set from Makefile
@@ -103,8 +105,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 
 	// Create publish before subscribing and activating subscriptions
 	pubAppInstanceStatus, err := ps.NewPublication(pubsub.PublicationOptions{
-		AgentName: agentName,
-		TopicType: types.AppInstanceStatus{},
+		AgentName:   agentName,
+		TopicType:   types.AppInstanceStatus{},
+		HistorySize: appInstanceStatusHistory,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -332,6 +335,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	// The ticker that triggers a check for the applications in the START_DELAYED state
 	delayedStartTicker := time.NewTicker(1 * time.Second)
 
+	// The ticker that triggers a check for the updated applications which
+	// booted successfully
+	rollbackTicker := time.NewTicker(time.Minute)
+
 	log.Functionf("Handling all inputs")
 	for {
 		select {
@@ -373,6 +380,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		case <-delayedStartTicker.C:
 			checkDelayedStartApps(&ctx)
 
+		case <-rollbackTicker.C:
+			checkRollbackOnBootFailure(&ctx)
+
 		case <-stillRunning.C:
 		}
 		ps.StillRunning(agentName, warningTime, errorTime)
@@ -457,24 +467,30 @@ func handleZedrouterRestarted(ctxArg interface{}, restartCounter int) {
 }
 
 // handleAppInstanceStatusCreate - Handle AIS create. Publish AppStatusSummary to ledmanager
+// and update the app instances depending on it
 func handleAppInstanceStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateDependentApps(ctx, statusArg.(types.AppInstanceStatus))
 }
 
 // handleAppInstanceStatusModify - Handle AIS modify. Publish AppStatusSummary to ledmanager
+// and update the app instances depending on it
 func handleAppInstanceStatusModify(ctxArg interface{}, key string,
 	statusArg interface{}, oldStatusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateDependentApps(ctx, statusArg.(types.AppInstanceStatus))
 }
 
 // handleAppInstanceStatusDelete - Handle AIS delete. Publish AppStatusSummary to ledmanager
+// and update the app instances depending on it
 func handleAppInstanceStatusDelete(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateDependentApps(ctx, statusArg.(types.AppInstanceStatus))
 }
 
 func publishAppInstanceSummary(ctxPtr *zedmanagerContext) {
@@ -707,6 +723,19 @@ func handleModify(ctxArg interface{}, key string,
 	if needPurge {
 		needRestart = false
 	}
+	if !needPurge && !needRestart &&
+		!cmp.Equal(config.FixedResources, status.FixedResources) {
+		// domainmgr resizes the running domain
+		if reason := checkResizeMemory(ctx, config, *status); reason != "" {
+			needRestart = true
+			restartReason += reason + "\n"
+		} else {
+			log.Noticef("handleModify(%s) resizing memory from %d to %d kB, vCPUs from %d to %d",
+				status.Key(), status.FixedResources.Memory, config.FixedResources.Memory,
+				status.FixedResources.VCpus, config.FixedResources.VCpus)
+			status.FixedResources = config.FixedResources
+		}
+	}
 
 	if config.RestartCmd.Counter != oldConfig.RestartCmd.Counter ||
 		config.LocalRestartCmd.Counter != oldConfig.LocalRestartCmd.Counter {
@@ -724,6 +753,8 @@ func handleModify(ctxArg interface{}, key string,
 			status.RestartInprogress = types.BringDown
 			status.State = types.RESTARTING
 			status.RestartStartedAt = time.Now()
+			// the domain comes back with the new resources
+			status.FixedResources = config.FixedResources
 		} else {
 			log.Functionf("handleModify(%v) for %s restartcmd ignored config !Activate",
 				config.UUIDandVersion, config.DisplayName)
@@ -764,6 +795,57 @@ func handleModify(ctxArg interface{}, key string,
 		return
 	}
 
+	if status.UUIDandVersion.Version != config.UUIDandVersion.Version &&
+		(status.RestartInprogress == types.BringDown ||
+			status.PurgeInprogress == types.DownloadAndVerify) {
+		// The app instance is stopped to be updated
+		startVolumeSnapshotOp(status, types.SnapshotBeforeUpdate)
+	} else if config.LocalSnapshotCmd.Counter != oldConfig.LocalSnapshotCmd.Counter ||
+		config.LocalRollbackCmd.Counter != oldConfig.LocalRollbackCmd.Counter {
+
+		log.Functionf("handleModify(%v) for %s snapshotcmd from %d to %d "+
+			"rollbackcmd from %d to %d",
+			config.UUIDandVersion, config.DisplayName,
+			oldConfig.LocalSnapshotCmd.Counter, config.LocalSnapshotCmd.Counter,
+			oldConfig.LocalRollbackCmd.Counter, config.LocalRollbackCmd.Counter)
+		if effectiveActivate && status.PurgeInprogress == types.NotInprogress {
+			if config.LocalRollbackCmd.Counter != oldConfig.LocalRollbackCmd.Counter {
+				startVolumeSnapshotOp(status, types.RollbackVolumes)
+				status.RestartInprogress = types.BringDown
+				status.State = types.RESTARTING
+				status.RestartStartedAt = time.Now()
+			} else if liveSnapshotSupported(config, status) {
+				startVolumeSnapshotOp(status, types.SnapshotVolumes)
+				status.VolumeSnapshotLive = true
+			} else {
+				startVolumeSnapshotOp(status, types.SnapshotVolumes)
+				status.RestartInprogress = types.BringDown
+				status.State = types.RESTARTING
+				status.RestartStartedAt = time.Now()
+			}
+		} else {
+			log.Functionf("handleModify(%v) for %s snapshot command ignored "+
+				"config !Activate or purge in progress",
+				config.UUIDandVersion, config.DisplayName)
+		}
+	} else if config.LocalExportCmd.Counter != oldConfig.LocalExportCmd.Counter {
+		log.Functionf("handleModify(%v) for %s exportcmd from %d to %d",
+			config.UUIDandVersion, config.DisplayName,
+			oldConfig.LocalExportCmd.Counter, config.LocalExportCmd.Counter)
+		if effectiveActivate && status.PurgeInprogress == types.NotInprogress {
+			// the volumes are exported while the app instance is down,
+			// also if they could be snapshotted live
+			startVolumeSnapshotOp(status, types.ExportVolumes)
+			status.RestartInprogress = types.BringDown
+			status.State = types.RESTARTING
+			status.RestartStartedAt = time.Now()
+		} else {
+			log.Functionf("handleModify(%v) for %s export command ignored "+
+				"config !Activate or purge in progress",
+				config.UUIDandVersion, config.DisplayName)
+		}
+	}
+
 	status.UUIDandVersion = config.UUIDandVersion
 	publishAppInstanceStatus(ctx, status)
 
@@ -809,7 +891,7 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 		needPurge = true
 		purgeReason += str + "\n"
 	} else {
-		for _, vrc := range config.VolumeRefConfigList {
+		for i, vrc := range config.VolumeRefConfigList {
 			vrs := getVolumeRefStatusFromAIStatus(&status, vrc)
 			if vrs == nil {
 				str := fmt.Sprintf("Missing VolumeRefStatus for "+
@@ -820,6 +902,14 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 				purgeReason += str + "\n"
 				continue
 			}
+			old := oldConfig.VolumeRefConfigList[i]
+			if old.IoLimits != vrc.IoLimits {
+				str := fmt.Sprintf("IoLimits of volume %s changed from %+v to %+v",
+					vrs.DisplayName, old.IoLimits, vrc.IoLimits)
+				log.Functionf(str)
+				needRestart = true
+				restartReason += str + "\n"
+			}
 		}
 	}
 	if len(oldConfig.UnderlayNetworkList) != len(config.UnderlayNetworkList) {
@@ -870,8 +960,12 @@ func quantifyChanges(config types.AppInstanceConfig, oldConfig types.AppInstance
 		str := fmt.Sprintf("FixedResources changed: %v",
 			cmp.Diff(oldConfig.FixedResources, config.FixedResources))
 		log.Functionf(str)
-		needRestart = true
-		restartReason += str + "\n"
+		if status.FixedResources.ResizableTo(config.FixedResources) {
+			log.Functionf("FixedResources can be resized without a restart")
+		} else {
+			needRestart = true
+			restartReason += str + "\n"
+		}
 	}
 	log.Functionf("quantifyChanges for %s %s returns %v, %v",
 		config.Key(), config.DisplayName, needPurge, needRestart)
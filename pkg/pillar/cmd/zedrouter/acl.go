@@ -374,36 +374,27 @@ func determineIPVer(isMgmt bool, bridgeIP string) int {
 	}
 }
 
+// applyACLRules prepares rules for the application to be installed into
+// the "-apps" chains. Rules are not executed here but collected into NLaclMap
+// and applied by the NIReconciler.
+// The catch all log/drop rules are towards the end of the rule list
+// and the returned list keeps the order. This ensures that the acl match
+// rules will be at the top of the rule stack for an app network instance
+// and the drop rules at the end.
 func applyACLRules(aclArgs types.AppNetworkACLArgs,
 	rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
-	var err error
 	var activeRules types.IPTablesRuleList
 	log.Tracef("applyACLRules: ipVer %d, bridgeName %s appIP %s with %d rules\n",
 		aclArgs.IPVer, aclArgs.BridgeName, aclArgs.AppIP, len(rules))
-
-	// the catch all log/drop rules are towards the end of the rule list
-	// hence we are inserting the rule in reverse order at
-	// the top of a target chain, to ensure the drop rules
-	// will be at the end of the rule stack, and the acl match
-	// rules will be at the top of the rule stack for an app
-	// network instance
-	numRules := len(rules)
-	for numRules > 0 {
-		numRules--
-		rule := rules[numRules]
-		log.Tracef("createACLConfiglet: add rule %v\n", rule)
+	for _, rule := range rules {
+		log.Tracef("applyACLRules: add rule %v\n", rule)
 		if err := rulePrefix(aclArgs, &rule); err != nil {
-			log.Tracef("createACLConfiglet: skipping rule %v\n", rule)
+			log.Tracef("applyACLRules: skipping rule %v\n", rule)
 			continue
 		}
-		err = executeIPTablesRule("-I", rule)
-		if err == nil {
-			activeRules = append(activeRules, rule)
-		} else {
-			return activeRules, err
-		}
+		activeRules = append(activeRules, rule)
 	}
-	return activeRules, err
+	return activeRules, nil
 }
 
 // Returns a list of iptables commands, without the initial "-A FORWARD"
@@ -469,13 +460,50 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 			aclRule4.Action = []string{"-j", "ACCEPT"}
 			rulesList = append(rulesList, aclRule1, aclRule2, aclRule3, aclRule4)
 
-			aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-d", "169.254.169.254",
+			metadataIP := types.MetadataServerIPv6.String()
+			aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-d", metadataIP,
 				"-p", "tcp", "--dport", "http"}
 			aclRule1.Action = []string{"-j", "ACCEPT"}
-			aclRule2.Rule = []string{"-i", aclArgs.BridgeName, "-s", "169.254.169.254",
+			aclRule2.Rule = []string{"-i", aclArgs.BridgeName, "-s", metadataIP,
 				"-p", "tcp", "--sport", "http"}
 			aclRule2.Action = []string{"-j", "ACCEPT"}
 			rulesList = append(rulesList, aclRule1, aclRule2)
+
+			// Mark DHCPv6, DNS and meta-data traffic the same way as for IPv4.
+			aclRule5.Table = "mangle"
+			aclRule5.Chain = "PREROUTING"
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName,
+				"-p", "udp", "--dport", "dhcpv6-server"}
+			chainName := fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 6)
+			aclRule5.ActionChainMark = 6
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName, "-d", aclArgs.BridgeIP,
+				"-p", "udp", "--dport", "domain"}
+			chainName = fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 7)
+			aclRule5.ActionChainMark = 7
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName, "-d", aclArgs.BridgeIP,
+				"-p", "tcp", "--dport", "domain"}
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName,
+				"-d", metadataIP, "-p", "tcp", "--dport", "http"}
+			chainName = fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 8)
+			aclRule5.ActionChainMark = 8
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
 		} else if aclArgs.NIType == types.NetworkInstanceTypeSwitch {
 			aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-m", "set",
 				"--match-set", "ipv6.local", "dst", "-p", "ipv6-icmp"}
@@ -585,7 +613,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "bootps"}
 			chainName := fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 6)
-			createMarkAndAcceptChain(aclArgs, chainName, 6)
+			aclRule5.ActionChainMark = 6
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -594,7 +622,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "domain"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 7)
-			createMarkAndAcceptChain(aclArgs, chainName, 7)
+			aclRule5.ActionChainMark = 7
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -612,7 +640,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "tcp", "--dport", "http"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 8)
-			createMarkAndAcceptChain(aclArgs, chainName, 8)
+			aclRule5.ActionChainMark = 8
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -652,7 +680,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "bootps:bootpc"}
 			chainName := fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 6)
-			createMarkAndAcceptChain(aclArgs, chainName, 6)
+			aclRule5.ActionChainMark = 6
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -662,7 +690,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "domain"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 7)
-			createMarkAndAcceptChain(aclArgs, chainName, 7)
+			aclRule5.ActionChainMark = 7
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -680,7 +708,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "tcp", "--dport", "http"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 8)
-			createMarkAndAcceptChain(aclArgs, chainName, 8)
+			aclRule5.ActionChainMark = 8
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -737,7 +765,7 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 	// a reserved drop/reject marking at the end of rule set in mangle table
 	// for this application instance.
 	switch aclArgs.NIType {
-	case types.NetworkInstanceTypeLocal:
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeVXLAN:
 		aclRule3.Table = "mangle"
 		aclRule3.Chain = "PREROUTING"
 		aclRule3.Rule = []string{"-i", aclArgs.BridgeName}
@@ -746,7 +774,7 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 		aclRule3.ActionChainName = chainName
 		marking := iptables.GetConnmark(
 			uint8(aclArgs.AppNum), iptables.DefaultDropAceID, true)
-		createMarkAndAcceptChain(aclArgs, chainName, marking)
+		aclRule3.ActionChainMark = marking
 		aclRule3.Action = []string{"-j", chainName}
 		aclRule3.RuleID = iptables.DefaultDropAceID
 		aclRule3.IsDefaultDrop = true
@@ -970,7 +998,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				return nil, nil, errors.New(errStr)
 			}
 			targetPort := fmt.Sprintf("%d", action.TargetPort)
-			target := fmt.Sprintf("%s:%d", aclArgs.AppIP, action.TargetPort)
+			target := net.JoinHostPort(aclArgs.AppIP, targetPort)
 			// These rules are applied on the upLink interfaces,
 			// the uplink IP address, and port number.
 			// We add those to the dependList we return
@@ -986,16 +1014,19 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					dependList = append(dependList, depend)
 					continue
 				}
-				// Pick first IPv4 address
+				// Pick first address of the same IP version as the app
 				var extIP net.IP
 				for _, ip := range extIPs {
-					if ip.To4() != nil {
-						extIP = ip
-						break
+					isIPv4 := ip.To4() != nil
+					if isIPv4 != (aclArgs.IPVer == 4) || ip.IsLinkLocalUnicast() {
+						continue
 					}
+					extIP = ip
+					break
 				}
 				if len(extIP) == 0 {
-					log.Errorf("Can't add hairpin rule for %s: no IPv4 address", upLink)
+					log.Errorf("Can't add hairpin rule for %s: no IPv%d address",
+						upLink, aclArgs.IPVer)
 					depend := types.ACLDepend{Ifname: upLink}
 					dependList = append(dependList, depend)
 					continue
@@ -1030,7 +1061,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					// Embed App id in marking value
 					markingValue := iptables.GetConnmark(
 						uint8(aclArgs.AppNum), uint32(aclRule1.RuleID), false)
-					createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+					aclRule1.ActionChainMark = markingValue
 					aclRule1.Action = []string{"-j", chainName}
 					aclRule1.ActionChainName = chainName
 					rulesList = append(rulesList, aclRule1)
@@ -1066,7 +1097,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					// Embed App id in marking value
 					markingValue := iptables.GetConnmark(
 						uint8(aclArgs.AppNum), uint32(aclRuleH.RuleID), false)
-					createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+					aclRuleH.ActionChainMark = markingValue
 					aclRuleH.Action = []string{"-j", chainName}
 					aclRuleH.ActionChainName = chainName
 					rulesList = append(rulesList, aclRuleH)
@@ -1170,7 +1201,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 	rulesList = append(rulesList, aclRule4, aclRule3)
 
 	switch aclArgs.NIType {
-	case types.NetworkInstanceTypeLocal:
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeVXLAN:
 		if aclRule4.RuleID != -1 {
 			aclRule4.Table = "mangle"
 			aclRule4.Chain = "PREROUTING"
@@ -1181,7 +1212,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(
 				uint8(aclArgs.AppNum), uint32(aclRule4.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule4.ActionChainMark = markingValue
 			aclRule4.Action = []string{"-j", chainName}
 			aclRule4.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule4)
@@ -1190,7 +1221,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				" programmed due to ACL ID allocation failure",
 				aclRule4.Table, aclRule4.Chain, aclRule4.Rule, aclRule4.Action)
 		}
-	case types.NetworkInstanceTypeCloud:
+	case types.NetworkInstanceTypeCloud, types.NetworkInstanceTypeWireGuard:
 		fallthrough
 	case types.NetworkInstanceTypeSwitch:
 		if aclRule4.RuleID != -1 {
@@ -1203,7 +1234,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(
 				uint8(aclArgs.AppNum), uint32(aclRule4.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule4.ActionChainMark = markingValue
 			aclRule4.Action = []string{"-j", chainName}
 			aclRule4.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule4)
@@ -1223,7 +1254,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(uint8(aclArgs.AppNum), uint32(aclRule3.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule3.ActionChainMark = markingValue
 			aclRule3.Action = []string{"-j", chainName}
 			aclRule3.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule3)
@@ -1295,28 +1326,6 @@ func rulePrefix(aclArgs types.AppNetworkACLArgs, rule *types.IPTablesRule) error
 		return nil
 	}
 
-	if aclArgs.IPVer == 6 {
-		// The input rules (from domU are applied to raw to intercept
-		// before lisp/pcap can pick them up.
-		// The output rules (to domU) are applied in forwarding path
-		// since packets are forwarded from lispers.net interface after
-		// decap.
-		// Note that the counter parsing code assumes this.
-		if rule.Rule[0] == "-i" {
-			rule.Table = "raw"
-			rule.Chain = "PREROUTING"
-			if !rule.AnyPhysdev {
-				rule.Prefix = []string{"-m", "physdev", "--physdev-in", vifName}
-			}
-		} else if rule.Rule[0] == "-o" {
-			rule.Chain = "FORWARD"
-			if aclArgs.AppIP != "" {
-				rule.Prefix = []string{"-d", aclArgs.AppIP}
-			}
-		}
-		return nil
-	}
-
 	// table, chain are already set, nothing extra need to be done
 	if rule.Table != "" || rule.Chain != "" {
 		// NAT verbatim rule, already set
@@ -1386,11 +1395,10 @@ func containsRule(set types.IPTablesRuleList, member types.IPTablesRule) bool {
 	return false
 }
 
-func diffIpsets(newIpsets, oldIpsets []string) ([]string, []string, bool) {
+func diffIpsets(newIpsets, oldIpsets []string) ([]string, []string) {
 
 	staleIpsets := []string{}
 	newIpsetMap := make(map[string]bool)
-	restartDnsmasq := false
 
 	// Add all new ipsets in a map
 	for _, ipset := range newIpsets {
@@ -1406,24 +1414,12 @@ func diffIpsets(newIpsets, oldIpsets []string) ([]string, []string, bool) {
 		}
 	}
 
-	// When the ipset did not change, lengths of old and new ipsets should
-	// be same and then stale ipsets list should be empty.
-
-	// In case if the ipset has changed but the length remained same, there
-	// will at least be one stale entry in the old ipset that needs to be removed.
-	if (len(newIpsets) != len(oldIpsets)) || (len(staleIpsets) != 0) {
-		restartDnsmasq = true
-	}
-	log.Functionf("diffIpsets: restart %v, new %v, stale %v",
-		restartDnsmasq, newIpsets, staleIpsets)
-	return newIpsets, staleIpsets, restartDnsmasq
+	log.Functionf("diffIpsets: new %v, stale %v", newIpsets, staleIpsets)
+	return newIpsets, staleIpsets
 }
 
-// it will be difficult the maintain the precedence/order of the iptables
-// rules, across multiple app instance modules
-// apply rules as a block
-// lets just delete the existing ACL iptables rules block
-// and add the new ACL rules, for the appNetwork.
+// Rebuild the ACL rules of the appNetwork as a block. The previous block is replaced
+// in NLaclMap and NIReconciler updates the "-apps" chains accordingly.
 func updateACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, oldACLs []types.ACE, ACLs []types.ACE,
 	oldRules types.IPTablesRuleList, oldDepend []types.ACLDepend, force bool) (types.IPTablesRuleList, []types.ACLDepend, error) {
 
@@ -1437,13 +1433,6 @@ func updateACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		return oldRules, oldDepend, nil
 	}
 
-	rules, err := deleteACLConfiglet(aclArgs, oldRules)
-	if err != nil {
-		log.Functionf("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s: delete fail\n",
-			aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
-		return rules, nil, err
-	}
-
 	rulesList, dependList, err := createACLConfiglet(ctx, aclArgs, ACLs)
 
 	// Before adding new rules, clear flows if any created matching the old rules
@@ -1480,24 +1469,6 @@ func updateACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 	return rulesList, dependList, err
 }
 
-func deleteACLConfiglet(aclArgs types.AppNetworkACLArgs,
-	rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
-	var err error
-	var activeRules types.IPTablesRuleList
-	log.Functionf("deleteACLConfiglet: ifname %s vifName %s ACLs %v\n",
-		aclArgs.BridgeName, aclArgs.VifName, rules)
-
-	for _, rule := range rules {
-		log.Tracef("deleteACLConfiglet: rule %v\n", rule)
-		if err != nil {
-			activeRules = append(activeRules, rule)
-		} else {
-			err = executeIPTablesRule("-D", rule)
-		}
-	}
-	return activeRules, err
-}
-
 // utility routines for ACLs
 func compareACLs(ACL0 []types.ACE, ACL1 []types.ACE) bool {
 	if len(ACL0) != len(ACL1) {
@@ -1657,42 +1628,6 @@ func checkForMatchCondition(ace types.ACE, ace1 types.ACE, matchTypes []string)
 	return true
 }
 
-// utility routines for IpTables Rules
-func executeIPTablesRule(operation string, rule types.IPTablesRule) error {
-	var err error
-	ruleStr := []string{}
-	if rule.Table != "" {
-		ruleStr = append(ruleStr, "-t")
-		ruleStr = append(ruleStr, rule.Table)
-	}
-	ruleStr = append(ruleStr, operation)
-	ruleStr = append(ruleStr, appChain(rule.Chain))
-	ruleStr = append(ruleStr, rule.Prefix...)
-	ruleStr = append(ruleStr, rule.Rule...)
-	if len(rule.Action) > 0 {
-		ruleStr = append(ruleStr, rule.Action...)
-	}
-	if rule.IPVer == 4 {
-		err = iptables.IptableCmd(log, ruleStr...)
-		if operation == "-D" && rule.Table == "mangle" {
-			if rule.ActionChainName != "" {
-				chainFlush := []string{"-t", "mangle", "--flush", rule.ActionChainName}
-				chainDelete := []string{"-t", "mangle", "-X", rule.ActionChainName}
-				err = iptables.IptableCmd(log, chainFlush...)
-				if err == nil {
-					iptables.IptableCmd(log, chainDelete...)
-				}
-			}
-		}
-	} else if rule.IPVer == 6 {
-		err = iptables.Ip6tableCmd(log, ruleStr...)
-	} else {
-		errStr := fmt.Sprintf("ACL: Unknown IP version %d", rule.IPVer)
-		err = errors.New(errStr)
-	}
-	return err
-}
-
 func createFlowMonDummyInterface() {
 	// Check if our dummy interface already exits.
 	link, err := netlink.LinkByName(dummyIntfName)
@@ -1737,134 +1672,3 @@ func createFlowMonDummyInterface() {
 			dummyIntfName, err)
 	}
 }
-
-func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
-	name string, marking uint32) error {
-
-	// not for dom0
-	if aclArgs.IsMgmt {
-		return errors.New("invalid chain creation")
-	}
-
-	chainFlush := []string{"-t", "mangle", "--flush", name}
-
-	newChain := []string{"-t", "mangle", "-N", name}
-	log.Functionf("createMarkAndAcceptChain: Creating new chain (%s)", name)
-	err := iptables.IptableCmd(log, newChain...)
-	if err != nil {
-		// if chain already exists, we can skip this error
-		if !strings.Contains(err.Error(), "Chain already exists") {
-			log.Errorf("createMarkAndAcceptChain: New chain (%s) creation failed: %s",
-				name, err)
-			return err
-		}
-		log.Functionf("createMarkAndAcceptChain: Chain (%s) flushing and recreating of rules: %s",
-			name, err)
-		if err := iptables.IptableCmd(log, chainFlush...); err != nil {
-			log.Errorf("createMarkAndAcceptChain: Flush exists chain (%s) failed: %s",
-				name, err)
-			return err
-		}
-	}
-
-	rule1 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--restore-mark"}
-	rule2 := []string{"-A", name, "-t", "mangle", "-m", "mark", "!", "--mark", "0",
-		"-j", "ACCEPT"}
-
-	rule3 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--set-mark",
-		strconv.FormatUint(uint64(marking), 10)}
-	rule4 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--restore-mark"}
-	rule5 := []string{"-A", name, "-t", "mangle", "-j", "ACCEPT"}
-
-	chainDelete := []string{"-t", "mangle", "-X", name}
-
-	err = iptables.IptableCmd(log, rule1...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule1, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule2...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule2, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule3...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule3, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule4...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule4, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule5...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule5, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	return nil
-}
-
-// insert or remove the App Container API endpoint blocking ACL
-func appConfigContainerStatsACL(appIPAddr net.IP, isRemove bool) {
-	var err error
-	action := "-I"
-	// install or remove the App Container Stats blocking ACL
-	// This ACL blocks the other Apps accessing through the same subnet to 'appIPAddr:DOCKERAPIPORT'
-	// in TCP protocol, and only allow the Dom0 process to query the App's docker stats
-	// - this blocking is only possible in the 'raw' table and 'PREROUTING' chain due to the marking
-	//   is done in the 'mangle' of 'PREROUTING'. Install to the front of the 'PREROUTING' list
-	// - this blocking ACL does not block the Dom0 access to the above TCP endpoint on the same
-	//   subnet. This is due to the IP packets from Dom0 to the internal bridge entering the linux
-	//   forwarding through the 'OUTPUT' chain
-	// - this blocking does not seem to work if further matching to the '--physdev', so the drop action
-	//   needs to be at network layer3
-	// - XXX currently the 'drop' mark (0x800000) on the flow of internal traffic on bridge does not work,
-	//   later it may be possible to change below '-j DROP' to '-j MARK' action
-	if isRemove {
-		action = "-D"
-		err = iptables.IptableCmd(log, "-t", "raw", action, appChain("PREROUTING"),
-			"-d", appIPAddr.String(), "-p", "tcp",
-			"--dport", strconv.Itoa(DOCKERAPIPORT), "-j", "DROP")
-	} else {
-		err = iptables.IptableCmd(log, "-t", "raw", action, appChain("PREROUTING"), "1",
-			"-d", appIPAddr.String(), "-p", "tcp",
-			"--dport", strconv.Itoa(DOCKERAPIPORT), "-j", "DROP")
-	}
-	if err != nil {
-		log.Errorf("appCheckContainerStatsACL: iptableCmd err %v", err)
-	} else {
-		log.Functionf("appCheckContainerStatsACL: iptableCmd %s for %s", action, appIPAddr.String())
-	}
-}
-
-// Install iptables rule to ensure that packets marked with the drop action are indeed dropped and never
-// sent out via downlink or uplink interfaces. Whereas routed packets marked by drop ACEs are blackholed into a dummy
-// interface using a high-priority IP rule, packets which are only bridged and not routed by EVE escape this IP rule
-// and would otherwise continue in their path even if marked for dropping.
-func dropEscapedFlows() {
-	err := iptables.IptableCmd(log, "-t", "mangle", "-I", appChain("POSTROUTING"),
-		"--match", "connmark", "--mark",
-		fmt.Sprintf("%d/%d", iptables.AceDropAction, iptables.AceActionMask),
-		"!", "-o", dummyIntfName,
-		"-j", "DROP")
-	if err != nil {
-		log.Errorf("dropEscapedFlows: iptableCmd err %v", err)
-	}
-}
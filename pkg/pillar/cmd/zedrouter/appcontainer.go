@@ -14,7 +14,6 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -55,7 +54,7 @@ func appCheckStatsCollect(ctx *zedrouterContext, config *types.AppNetworkConfig,
 		if oldIPAddr == nil && status.GetStatsIPAddr != nil {
 			ensureStatsCollectRunning(ctx)
 		}
-		appChangeContainerStatsACL(status.GetStatsIPAddr, oldIPAddr)
+		// The App Container blocking ACL is (re)installed by NIReconciler.
 	}
 }
 
@@ -218,35 +217,6 @@ func processAppContainerStats(stats apitypes.ContainerStats, container apitypes.
 	return acStats, nil
 }
 
-func appChangeContainerStatsACL(newIPAddr, oldIPAddr net.IP) {
-	if oldIPAddr != nil {
-		// remove the previous installed blocking ACL
-		appConfigContainerStatsACL(oldIPAddr, true)
-	}
-	if newIPAddr != nil {
-		// install the App Container blocking ACL
-		appConfigContainerStatsACL(newIPAddr, false)
-	}
-}
-
-// reinstall the App Container blocking ACL to place in the top
-func appStatsMayNeedReinstallACL(ctx *zedrouterContext, config types.AppNetworkConfig) {
-	sub := ctx.subAppNetworkConfig
-	items := sub.GetAll()
-	for _, item := range items {
-		cfg := item.(types.AppNetworkConfig)
-		if cfg.Key() == config.Key() {
-			log.Functionf("appStatsMayNeedReinstallACL: same app, skip")
-			continue
-		}
-		if cfg.GetStatsIPAddr != nil {
-			appConfigContainerStatsACL(cfg.GetStatsIPAddr, true)
-			appConfigContainerStatsACL(cfg.GetStatsIPAddr, false)
-			log.Functionf("appStatsMayNeedReinstallACL: reinstall %s\n", cfg.GetStatsIPAddr.String())
-		}
-	}
-}
-
 func getAppContainerLogs(ctx *zedrouterContext, status types.AppNetworkStatus, last map[string]time.Time, cli *client.Client, containers []apitypes.Container) int {
 	var buf bytes.Buffer
 	var numlogs int
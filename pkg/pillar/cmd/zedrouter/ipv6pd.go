@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// IPv6 prefix delegation for local network instances.
+// Instead of a subnet configured by the controller, the network instance
+// gets a /64 carved out of the prefix delegated (DHCPv6-PD) to its uplink
+// port. Applications connected to such NI get globally routable addresses
+// and traffic is only routed, not NATed.
+
+package zedrouter
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// getDelegatedSubnet returns the prefix delegated to the uplink port
+// of the network instance and the /64 subnet allocated for the NI from it.
+// Subnet which is already used by the NI is preserved if it is still
+// inside the delegated prefix.
+func getDelegatedSubnet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (prefix, subnet net.IPNet, err error) {
+
+	ifNameList := getIfNameListForLLOrIfname(ctx, status.CurrentUplinkIntf)
+	if len(ifNameList) == 0 {
+		err = fmt.Errorf("no uplink port for IPv6 prefix delegation")
+		return
+	}
+	port := ctx.deviceNetworkStatus.GetPortByIfName(ifNameList[0])
+	if port == nil || len(port.DelegatedPrefixes) == 0 {
+		err = fmt.Errorf("no IPv6 prefix delegated to uplink port %s",
+			ifNameList[0])
+		return
+	}
+	prefix = port.DelegatedPrefixes[0]
+	if status.Subnet.IP != nil && prefix.Contains(status.Subnet.IP) {
+		return prefix, status.Subnet, nil
+	}
+	// Collect /64 subnets already used by other network instances.
+	var usedSubnets []net.IPNet
+	for _, st := range ctx.pubNetworkInstanceStatus.GetAll() {
+		niStatus := st.(types.NetworkInstanceStatus)
+		if uuid.Equal(niStatus.UUID, status.UUID) ||
+			niStatus.Subnet.IP == nil {
+			continue
+		}
+		usedSubnets = append(usedSubnets, niStatus.Subnet)
+	}
+	for index := 0; ; index++ {
+		subnet, err = types.GetIPv6SubnetFromPrefix(prefix, index)
+		if err != nil {
+			err = fmt.Errorf("no free /64 subnet left in delegated prefix %s",
+				prefix.String())
+			return
+		}
+		var used bool
+		for _, usedSubnet := range usedSubnets {
+			if usedSubnet.Contains(subnet.IP) || subnet.Contains(usedSubnet.IP) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return prefix, subnet, nil
+		}
+	}
+}
+
+// updateDelegatedPrefix (re)numbers network instance using IPv6 prefix
+// delegation. Called on activation and whenever the prefix delegated
+// to the uplink may have changed.
+func updateDelegatedPrefix(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	if !status.IPv6PrefixDelegation {
+		return nil
+	}
+	prefix, subnet, err := getDelegatedSubnet(ctx, status)
+	if err != nil {
+		return err
+	}
+	if types.EqualSubnet(subnet, status.Subnet) {
+		if !types.EqualSubnet(prefix, status.DelegatedPrefix) {
+			status.DelegatedPrefix = prefix
+			publishNetworkInstanceStatus(ctx, status)
+		}
+		return nil
+	}
+	log.Noticef("updateDelegatedPrefix(%s): subnet changed from %s to %s",
+		status.DisplayName, status.Subnet.String(), subnet.String())
+
+	hostsDirpath := runDirname + "/hosts." + status.BridgeName
+	if status.Activated && status.Subnet.IP != nil {
+		natInactivate(ctx, status)
+	}
+	if status.BridgeIPAddr != "" {
+		removeFromHostsConfiglet(hostsDirpath, "router")
+	}
+	status.DelegatedPrefix = prefix
+	status.Subnet = subnet
+	status.Gateway = types.AddToIP(subnet.IP, 1)
+	status.DhcpRange.Start = types.AddToIP(subnet.IP, types.IPv6DhcpRangeOffset)
+	status.DhcpRange.End = types.AddToIP(status.DhcpRange.Start, types.BitMapMax)
+	if err := setBridgeIPAddr(ctx, status); err != nil {
+		return err
+	}
+	if status.BridgeIPAddr != "" {
+		// XXX arbitrary name "router"!!
+		addToHostsConfiglet(hostsDirpath, "router",
+			[]string{status.BridgeIPAddr})
+	}
+	if status.Activated {
+		if err := natActivate(ctx, status); err != nil {
+			return err
+		}
+	}
+	if status.Server4Running && status.MetaDataServerIP != status.BridgeIPAddr {
+		deleteServer4(ctx, status.MetaDataServerIP, status.BridgeName)
+		status.Server4Running = false
+		status.MetaDataServerIP = status.BridgeIPAddr
+		if err := createServer4(ctx, status.MetaDataServerIP, status.BridgeName); err == nil {
+			status.Server4Running = true
+		}
+	}
+	publishNetworkInstanceStatus(ctx, status)
+	renumberAppsOnNetworkInstance(ctx, status)
+	return nil
+}
+
+// renumberAppsOnNetworkInstance allocates new IP addresses for applications
+// connected to the network instance after its subnet has changed.
+func renumberAppsOnNetworkInstance(ctx *zedrouterContext,
+	niStatus *types.NetworkInstanceStatus) {
+
+	hostsDirpath := runDirname + "/hosts." + niStatus.BridgeName
+	for _, st := range ctx.pubAppNetworkStatus.GetAll() {
+		status := st.(types.AppNetworkStatus)
+		config := lookupAppNetworkConfig(ctx, status.Key())
+		if config == nil || !status.Activated {
+			continue
+		}
+		var changed bool
+		for i := range status.UnderlayNetworkList {
+			ulStatus := &status.UnderlayNetworkList[i]
+			if !uuid.Equal(ulStatus.Network, niStatus.UUID) {
+				continue
+			}
+			if ulStatus.AppIPAddr != nil {
+				log.Warnf("renumberAppsOnNetworkInstance(%s): static IP %s of app %s "+
+					"is not renumbered", niStatus.DisplayName,
+					ulStatus.AppIPAddr.String(), status.DisplayName)
+				continue
+			}
+			oldIPAddr := ulStatus.AllocatedIPv4Addr
+			delete(niStatus.IPAssignments, ulStatus.Mac)
+			appIPAddr, err := getUlAddrs(ctx, niStatus, ulStatus,
+				status.UUIDandVersion.UUID)
+			if err != nil {
+				addError(ctx, &status, "getUlAddrs", err)
+				continue
+			}
+			if oldIPAddr != "" {
+				ipsetDel("ipv6.eids."+ulStatus.Vif, oldIPAddr)
+			}
+			ulStatus.AllocatedIPv4Addr = appIPAddr
+			removeFromHostsConfiglet(hostsDirpath, status.DisplayName)
+			if appIPAddr != "" {
+				addToHostsConfiglet(hostsDirpath, status.DisplayName,
+					[]string{appIPAddr})
+			}
+			createDefaultIpsetConfiglet(ulStatus.Vif,
+				niStatus.DnsNameToIPList, appIPAddr)
+			ipsets := compileAppInstanceIpsets(ctx, config.UnderlayNetworkList)
+			ulConfig := &config.UnderlayNetworkList[i]
+			doAppNetworkModifyUNetAcls(ctx, &status,
+				ulConfig, ulConfig, ulStatus, ipsets, true)
+			// BridgeIPSets were updated and published
+			// by doAppNetworkModifyUNetAcls.
+			if st := lookupNetworkInstanceStatus(ctx, niStatus.Key()); st != nil {
+				*niStatus = *st
+			}
+			changed = true
+		}
+		if changed {
+			publishAppNetworkStatus(ctx, &status)
+		}
+	}
+}
+
+// maybeUpdateDelegatedPrefixes is called when DeviceNetworkStatus changes
+// to follow changes of the prefixes delegated to uplink ports.
+func maybeUpdateDelegatedPrefixes(ctx *zedrouterContext) {
+	for _, st := range ctx.pubNetworkInstanceStatus.GetAll() {
+		status := st.(types.NetworkInstanceStatus)
+		if !status.IPv6PrefixDelegation || !status.Activated {
+			continue
+		}
+		if err := updateDelegatedPrefix(ctx, &status); err != nil {
+			log.Errorf("maybeUpdateDelegatedPrefixes(%s): %v",
+				status.DisplayName, err)
+		}
+	}
+}
@@ -12,12 +12,10 @@ import (
 	"net"
 	"strings"
 
-	"github.com/lf-edge/eve/pkg/pillar/base"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
-	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/vishvananda/netlink"
 )
@@ -75,71 +73,6 @@ func checkPortAvailable(
 	return nil
 }
 
-func disableIcmpRedirects(bridgeName string) {
-	sysctlSetting := fmt.Sprintf("net.ipv4.conf.%s.send_redirects=0", bridgeName)
-	args := []string{"-w", sysctlSetting}
-	log.Functionf("Calling command %s %v\n", "sysctl", args)
-	out, err := base.Exec(log, "sysctl", args...).CombinedOutput()
-	if err != nil {
-		errStr := fmt.Sprintf("sysctl command %s failed %s output %s",
-			args, err, out)
-		log.Errorln(errStr)
-	}
-}
-
-// doCreateBridge
-//
-//	returns (error, bridgeMac-string)
-func doCreateBridge(bridgeName string, bridgeNum int,
-	status *types.NetworkInstanceStatus) (error, string) {
-
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Fatalf("bridgeCreate(%s) %s not possible",
-			status.DisplayName, status.BridgeName)
-	}
-	// Start clean
-	// delete the bridge
-	attrs := netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	link := &netlink.Bridge{LinkAttrs: attrs}
-	netlink.LinkDel(link)
-
-	//    ip link add ${bridgeName} type bridge
-	attrs = netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	bridgeMac := fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
-	hw, err := net.ParseMAC(bridgeMac)
-	if err != nil {
-		log.Fatal("ParseMAC failed: ", bridgeMac, err)
-	}
-	attrs.HardwareAddr = hw
-	link = &netlink.Bridge{LinkAttrs: attrs}
-	if err := netlink.LinkAdd(link); err != nil {
-		errStr := fmt.Sprintf("LinkAdd on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	//    ip link set ${bridgeName} up
-	if err := netlink.LinkSetUp(link); err != nil {
-		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	disableIcmpRedirects(bridgeName)
-
-	// Get Ifindex of bridge and store it in network instance status
-	bridgeLink, err := netlink.LinkByName(bridgeName)
-	if err != nil {
-		errStr := fmt.Sprintf("doCreateBridge: LinkByName(%s) failed: %s",
-			bridgeName, err)
-		log.Errorln(errStr)
-		return errors.New(errStr), ""
-	}
-	index := bridgeLink.Attrs().Index
-	status.BridgeIfindex = index
-	return err, bridgeMac
-}
-
 // doLookupBridge is used for switch network instance where nim
 // has created the bridge. All such NIs have an external port.
 //
@@ -180,17 +113,8 @@ func doLookupBridge(ctx *zedrouterContext,
 func networkInstanceBridgeDelete(
 	ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Noticef("networkInstanceBridgeDelete(%s) %s ignored",
-			status.DisplayName, status.BridgeName)
-	} else {
-		attrs := netlink.NewLinkAttrs()
-		attrs.Name = status.BridgeName
-		link := &netlink.Bridge{LinkAttrs: attrs}
-		// Remove link and associated addresses
-		netlink.LinkDel(link)
-	}
-
+	// Bridge created by zedrouter is removed by NIReconciler
+	// once the network instance status is unpublished.
 	if status.BridgeNum != 0 {
 		status.BridgeName = ""
 		status.BridgeNum = 0
@@ -216,17 +140,7 @@ func doBridgeAclsDelete(
 			}
 			log.Functionf("NetworkInstance - deleting Acls for UL Interface(%s)",
 				ulStatus.Name)
-			appIP := ulStatus.AllocatedIPv4Addr
-			aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: ulStatus.Bridge,
-				VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIP,
-				UpLinks: status.IfNameList}
-			rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-			ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-			if err != nil {
-				log.Errorf("NetworkInstance DeleteACL failed: %s\n",
-					err)
-			}
-			setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+			setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 		}
 	}
 	return
@@ -285,6 +199,7 @@ func handleNetworkInstanceModify(
 		niUpdateNIprobing(ctx, status)
 		status.ChangeInProgress = types.ChangeInProgressTypeNone
 		publishNetworkInstanceStatus(ctx, status)
+		reconcileNIs(ctx)
 		log.Functionf("handleNetworkInstanceModify(%s) done\n", key)
 	} else {
 		log.Fatalf("handleNetworkInstanceModify(%s) no status", key)
@@ -338,6 +253,7 @@ func handleNetworkInstanceCreate(
 		status.SetErrorNow(err.Error())
 		status.ChangeInProgress = types.ChangeInProgressTypeNone
 		publishNetworkInstanceStatus(ctx, &status)
+		reconcileNIs(ctx)
 		return
 	}
 	publishNetworkInstanceStatus(ctx, &status)
@@ -357,6 +273,7 @@ func handleNetworkInstanceCreate(
 
 	status.ChangeInProgress = types.ChangeInProgressTypeNone
 	publishNetworkInstanceStatus(ctx, &status)
+	reconcileNIs(ctx)
 	// Hooks for updating dependent objects
 	checkAndRecreateAppNetwork(ctx, status)
 	log.Functionf("handleNetworkInstanceCreate(%s) done\n", key)
@@ -402,6 +319,8 @@ func maybeNetworkInstanceDelete(ctx *zedrouterContext, status *types.NetworkInst
 	doNetworkInstanceDelete(ctx, status)
 	ctx.networkInstanceStatusMap.Delete(status.UUID)
 	ctx.pubNetworkInstanceStatus.Unpublish(status.Key())
+	// Remove the bridge (unless created by nim) and stop dnsmasq (and radvd).
+	reconcileNIs(ctx)
 
 	deleteNetworkInstanceMetrics(ctx, status.Key())
 	log.Noticef("maybeNetworkInstanceDelete(%s) done", status.Key())
@@ -423,50 +342,24 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	// Allocate bridgeNum.
 	bridgeNum := bridgeNumAllocate(ctx, status.UUID)
 	status.BridgeNum = bridgeNum
-	bridgeMac := ""
-	var bridgeName string
+	bridgeMac := fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
+	bridgeName := fmt.Sprintf("bn%d", bridgeNum)
 	var err error
 
-	switch status.Type {
-	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeCloud:
-		bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-		status.BridgeName = bridgeName
-		if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
+	if status.Type == types.NetworkInstanceTypeSwitch &&
+		status.CurrentUplinkIntf != "" {
+		// Find bridge created by nim
+		if bridgeName, bridgeMac, err = doLookupBridge(ctx, status); err != nil {
+			// We will retry later
 			return err
 		}
-
-	case types.NetworkInstanceTypeSwitch:
-		if status.CurrentUplinkIntf == "" {
-			// Create a local-only bridge
-			bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-			status.BridgeName = bridgeName
-			if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
-				return err
-			}
-		} else {
-			// Find bridge created by nim
-			if bridgeName, bridgeMac, err = doLookupBridge(ctx, status); err != nil {
-				// We will retry later
-				return err
-			}
-			status.BridgeName = bridgeName
-		}
 	}
-
-	// Get Ifindex of bridge and store it in network instance status
-	bridgeLink, err := netlink.LinkByName(bridgeName)
-	if err != nil {
-		err = fmt.Errorf("doNetworkInstanceCreate: LinkByName(%s) failed: %v",
-			bridgeName, err)
-		log.Error(err)
-		return err
+	if status.Type == types.NetworkInstanceTypeVXLAN {
+		bridgeMac = vxlanBridgeMac(status)
 	}
-	status.BridgeIfindex = bridgeLink.Attrs().Index
-
+	// Otherwise the bridge is created by NIReconciler.
+	status.BridgeName = bridgeName
 	status.BridgeMac = bridgeMac
-	publishNetworkInstanceStatus(ctx, status)
-
-	log.Functionf("bridge created. BridgeMac: %s\n", bridgeMac)
 
 	if err := setBridgeIPAddr(ctx, status); err != nil {
 		return err
@@ -486,39 +379,41 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 			[]string{status.BridgeIPAddr})
 	}
 
-	// Start clean
-	deleteDnsmasqConfiglet(bridgeName)
-	stopDnsmasq(bridgeName, false, false)
-
-	if status.BridgeIPAddr != "" {
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			status.BridgeIPAddr, status,
-			hostsDirpath, status.BridgeIPSets,
-			status.CurrentUplinkIntf, dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
+	// Create bridge (unless created by nim) and start dnsmasq (and radvd).
+	publishNetworkInstanceStatus(ctx, status)
+	reconcileStatus := reconcileNIs(ctx)
+	niStatus := reconcileStatus.NIs[status.UUID]
+	if niStatus.Error != nil {
+		return niStatus.Error
+	}
+	if niStatus.BridgeIfIndex == 0 {
+		err = fmt.Errorf("doNetworkInstanceCreate: bridge %s is not available",
+			bridgeName)
+		log.Error(err)
+		return err
 	}
+	// Store Ifindex of bridge in network instance status
+	status.BridgeIfindex = niStatus.BridgeIfIndex
+	log.Functionf("bridge created. BridgeMac: %s\n", bridgeMac)
 
 	// monitor the DNS and DHCP information
 	log.Functionf("Creating %s at %s", "DNSDhcpMonitor", agentlog.GetMyStack())
 	go DNSDhcpMonitor(bridgeName, bridgeNum, ctx, status)
 
-	if status.IsIPv6() {
-		// XXX do we need same logic as for IPv4 dnsmasq to not
-		// advertise as default router? Might we need lower
-		// radvd preference if isolated local network?
-		restartRadvdWithNewConfig(bridgeName)
-	}
-
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		err := vpnCreate(ctx, status)
 		if err != nil {
 			return err
 		}
+	case types.NetworkInstanceTypeVXLAN:
+		if err := vxlanCreate(ctx, status); err != nil {
+			return err
+		}
+	case types.NetworkInstanceTypeWireGuard:
+		if err := wgCreate(ctx, status); err != nil {
+			return err
+		}
 	default:
 	}
 	return nil
@@ -545,6 +440,10 @@ func doNetworkInstanceSanityCheck(
 		// Do nothing
 	case types.NetworkInstanceTypeCloud:
 		// Do nothing
+	case types.NetworkInstanceTypeVXLAN, types.NetworkInstanceTypeWireGuard:
+		if err := checkWireGuardListenPort(ctx, status); err != nil {
+			return err
+		}
 	default:
 		err := fmt.Sprintf("Instance type %d not supported", status.Type)
 		return errors.New(err)
@@ -565,6 +464,11 @@ func doNetworkInstanceSanityCheck(
 	case types.AddressTypeIPV4, types.AddressTypeIPV6,
 		types.AddressTypeCryptoIPV4, types.AddressTypeCryptoIPV6:
 
+		if status.IPv6PrefixDelegation && status.Subnet.IP == nil {
+			// Subnet is derived from the delegated prefix
+			// during activation.
+			break
+		}
 		err := doNetworkInstanceSubnetSanityCheck(ctx, status)
 		if err != nil {
 			return err
@@ -699,6 +603,21 @@ func doNetworkInstanceModify(ctx *zedrouterContext,
 		return err
 	}
 
+	if config.Type == types.NetworkInstanceTypeVXLAN {
+		if err := vxlanModify(ctx, config.Vxlan, status); err != nil {
+			log.Error(err)
+			status.SetErrorNow(err.Error())
+			return err
+		}
+	}
+	if config.Type == types.NetworkInstanceTypeWireGuard {
+		if err := wgModify(ctx, config.WireGuard, status); err != nil {
+			log.Error(err)
+			status.SetErrorNow(err.Error())
+			return err
+		}
+	}
+
 	if config.Activate && !status.Activated {
 		err := doNetworkInstanceActivate(ctx, status)
 		if err != nil {
@@ -781,46 +700,6 @@ func haveSwitchNetworkInstances(ctx *zedrouterContext) bool {
 	return false
 }
 
-func restartDnsmasq(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
-
-	log.Functionf("restartDnsmasq(%s) ipsets %v\n",
-		status.BridgeName, status.BridgeIPSets)
-	bridgeName := status.BridgeName
-	stopDnsmasq(bridgeName, false, true)
-
-	hostsDirpath := runDirname + "/hosts." + bridgeName
-	// XXX arbitrary name "router"!!
-	addToHostsConfiglet(hostsDirpath, "router",
-		[]string{status.BridgeIPAddr})
-
-	// Use existing BridgeIPSets
-	dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	createDnsmasqConfiglet(ctx, bridgeName, status.BridgeIPAddr,
-		status, hostsDirpath, status.BridgeIPSets,
-		status.CurrentUplinkIntf, dnsServers, ntpServers)
-	createHostDnsmasqFile(ctx, bridgeName)
-	startDnsmasq(bridgeName)
-}
-
-func createHostDnsmasqFile(ctx *zedrouterContext, bridge string) {
-	pub := ctx.pubAppNetworkStatus
-	items := pub.GetAll()
-	for _, st := range items {
-		status := st.(types.AppNetworkStatus)
-		for _, ulStatus := range status.UnderlayNetworkList {
-			if strings.Compare(bridge, ulStatus.Bridge) != 0 {
-				continue
-			}
-			addhostDnsmasq(bridge, ulStatus.Mac,
-				ulStatus.AllocatedIPv4Addr, status.UUIDandVersion.UUID.String())
-			log.Functionf("createHostDnsmasqFile:(%s) mac=%s, IP=%s\n", bridge, ulStatus.Mac, ulStatus.AllocatedIPv4Addr)
-		}
-	}
-}
-
 // Returns an IP address as a string, or "" for switch networks or, on error
 func lookupOrAllocateIPv4(status *types.NetworkInstanceStatus,
 	appID uuid.UUID, appNum int, mac net.HardwareAddr) (string, error) {
@@ -958,37 +837,15 @@ func setBridgeIPAddr(
 		return nil
 	}
 
-	// Get the linux interface with the attributes.
-	// This is used to add an IP Address below.
-	link, _ := netlink.LinkByName(status.BridgeName)
-	if link == nil {
-		// XXX..Why would this fail? Should this be Fatal instead??
-		errStr := fmt.Sprintf("Failed to get link for Bridge %s", status.BridgeName)
+	bridgeMac, err := net.ParseMAC(status.BridgeMac)
+	if err != nil {
+		errStr := fmt.Sprintf("Invalid MAC address %s of bridge %s: %v",
+			status.BridgeMac, status.BridgeName, err)
 		return errors.New(errStr)
 	}
-	log.Functionf("Bridge: %s, Link: %+v\n", status.BridgeName, link)
-
-	var err error
-
 	// Assign the gateway Address as the bridge IP address
-	var bridgeMac net.HardwareAddr
-
-	switch link.(type) {
-	case *netlink.Bridge:
-		// XXX always true?
-		bridgeLink := link.(*netlink.Bridge)
-		bridgeMac = bridgeLink.HardwareAddr
-	default:
-		// XXX - Same here.. Should be Fatal??
-		errStr := fmt.Sprintf("Not a bridge %s",
-			status.BridgeName)
-		return errors.New(errStr)
-	}
-	if status.Gateway != nil {
-		ipAddr = status.Gateway.String()
-		addrs := types.AssignedAddrs{IPv4Addr: status.Gateway}
-		status.IPAssignments[bridgeMac.String()] = addrs
-	}
+	addrs := types.AssignedAddrs{IPv4Addr: status.Gateway}
+	status.IPAssignments[bridgeMac.String()] = addrs
 	log.Functionf("BridgeMac: %s, ipAddr: %s\n",
 		bridgeMac.String(), ipAddr)
 
@@ -1007,22 +864,27 @@ func setBridgeIPAddr(
 		return errors.New(errStr)
 	}
 
-	prefixLen := getPrefixLenForBridgeIP(status)
-	if err = doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
-		log.Errorf("Failed to configure IPAddr on Interface\n")
-		return err
+	if !strings.HasPrefix(status.BridgeName, "bn") {
+		// Bridge created by nim is not managed by NIReconciler,
+		// assign the IP address directly.
+		link, _ := netlink.LinkByName(status.BridgeName)
+		if link == nil {
+			errStr := fmt.Sprintf("Failed to get link for Bridge %s", status.BridgeName)
+			return errors.New(errStr)
+		}
+		prefixLen := getPrefixLenForBridgeIP(status)
+		if err = doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
+			log.Errorf("Failed to configure IPAddr on Interface\n")
+			return err
+		}
 	}
-
+	// IP address of bridge created by zedrouter (and radvd config if ipv6)
+	// is applied by NIReconciler.
 	status.BridgeIPAddr = ipAddr
 	addr := net.ParseIP(ipAddr)
 	recordIPAssignment(ctx, status, addr, bridgeMac.String())
 	log.Functionf("Published NetworkStatus. BridgeIpAddr: %s\n",
 		status.BridgeIPAddr)
-	// Create new radvd configuration and restart radvd if ipv6
-	if status.IsIPv6() {
-		log.Functionf("Restart Radvd\n")
-		restartRadvdWithNewConfig(status.BridgeName)
-	}
 	return nil
 }
 
@@ -1041,12 +903,12 @@ func updateBridgeIPAddr(
 		log.Functionf("updateBridgeIPAddr: %s\n", err)
 		return
 	}
-	if status.BridgeIPAddr != old && status.BridgeIPAddr != "" {
-		log.Functionf("updateBridgeIPAddr(%s) restarting dnsmasq\n",
+	if status.BridgeIPAddr != old {
+		log.Functionf("updateBridgeIPAddr(%s) reconfiguring dnsmasq\n",
 			status.Key())
-		restartDnsmasq(ctx, status)
+		publishNetworkInstanceStatus(ctx, status)
+		reconcileNIs(ctx)
 	}
-	// TBD:XXX if no ip Addr, we may need to stop the dns
 }
 
 // maybeUpdateBridgeIPAddr
@@ -1252,36 +1114,44 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 	log.Functionf("IfNameList: %+v", status.IfNameList)
 	switch status.Type {
 	case types.NetworkInstanceTypeSwitch:
-		err = bridgeActivate(ctx, status)
-		if err != nil {
-			updateBridgeIPAddr(ctx, status)
-		}
-		// Drop external connection request to meta-data server
-		portName := "k" + status.BridgeName
-		link, _ := netlink.LinkByName(portName)
-		if link != nil {
-			err = iptables.IptableCmd(log, "-t", "filter",
-				"-A", appChain("INPUT"), "-i", status.BridgeName,
-				"-p", "tcp", "--dport", "80", "-m", "physdev",
-				"--physdev-in", portName, "-j", "DROP")
-			if err != nil {
-				log.Errorf("doNetworkInstanceActivate: Failed adding Iptables command that rejects external connections to meta-data store: %s", err)
-			}
-		}
+		// Uplink port is put under the bridge by NIReconciler.
 	case types.NetworkInstanceTypeLocal:
-		err = natActivate(ctx, status)
+		err = updateDelegatedPrefix(ctx, status)
+		if err == nil {
+			err = natActivate(ctx, status)
+		}
 
 	case types.NetworkInstanceTypeCloud:
 		err = vpnActivate(ctx, status)
 
+	case types.NetworkInstanceTypeVXLAN:
+		err = vxlanActivate(ctx, status)
+
+	case types.NetworkInstanceTypeWireGuard:
+		err = wgActivate(ctx, status)
+
 	default:
 		errStr := fmt.Sprintf("doNetworkInstanceActivate: NetworkInstance %d not yet supported",
 			status.Type)
 		err = errors.New(errStr)
 	}
+	if err == nil {
+		// Apply uplink-dependent config (bridged uplink port, NAT, routes).
+		status.Activated = true
+		publishNetworkInstanceStatus(ctx, status)
+		reconcileStatus := reconcileNIs(ctx)
+		if niErr := reconcileStatus.NIs[status.UUID].Error; niErr != nil {
+			status.Activated = false
+			publishNetworkInstanceStatus(ctx, status)
+			if status.Type == types.NetworkInstanceTypeSwitch {
+				updateBridgeIPAddr(ctx, status)
+			}
+			err = niErr
+		}
+	}
 	if err == nil && !status.Server4Running {
 		switch status.IpType {
-		case types.AddressTypeIPV4:
+		case types.AddressTypeIPV4, types.AddressTypeIPV6:
 			status.MetaDataServerIP = status.BridgeIPAddr
 			log.Errorf("Creating Meta data server on bridge %s with IP %s",
 				status.BridgeName, status.MetaDataServerIP)
@@ -1381,27 +1251,17 @@ func doNetworkInstanceInactivate(
 	log.Functionf("doNetworkInstanceInactivate NetworkInstance key %s type %d\n",
 		status.UUID, status.Type)
 
-	bridgeInactivateforNetworkInstance(ctx, status)
 	switch status.Type {
-	case types.NetworkInstanceTypeLocal:
-		natInactivate(ctx, status, false)
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeVXLAN,
+		types.NetworkInstanceTypeWireGuard:
+		natInactivate(ctx, status)
 	case types.NetworkInstanceTypeCloud:
 		vpnInactivate(ctx, status)
-	case types.NetworkInstanceTypeSwitch:
-		portName := "k" + status.BridgeName
-		link, _ := netlink.LinkByName(portName)
-		if link != nil {
-			err := iptables.IptableCmd(log, "-t", "filter",
-				"-D", appChain("INPUT"), "-i", status.BridgeName,
-				"-p", "tcp", "--dport", "80", "-m", "physdev",
-				"--physdev-in", portName, "-j", "DROP")
-			if err != nil {
-				log.Errorf("doNetworkInstanceInactivate: Failed deleting Iptables command that rejects external connections to meta-data store: %s", err)
-			}
-		}
 	}
-
-	return
+	// Remove uplink-dependent config (bridged uplink port, NAT, routes).
+	status.Activated = false
+	publishNetworkInstanceStatus(ctx, status)
+	reconcileNIs(ctx)
 }
 
 func doNetworkInstanceDelete(
@@ -1419,6 +1279,12 @@ func doNetworkInstanceDelete(
 		natDelete(status)
 	case types.NetworkInstanceTypeCloud:
 		vpnDelete(ctx, status)
+	case types.NetworkInstanceTypeVXLAN:
+		natDelete(status)
+		vxlanDelete(ctx, status)
+	case types.NetworkInstanceTypeWireGuard:
+		natDelete(status)
+		wgDelete(ctx, status)
 	default:
 		log.Errorf("NetworkInstance(%s-%s): Type %d not yet supported",
 			status.DisplayName, status.UUID, status.Type)
@@ -1430,11 +1296,6 @@ func doNetworkInstanceDelete(
 	}
 	doBridgeAclsDelete(ctx, status)
 	if status.BridgeName != "" {
-		stopDnsmasq(status.BridgeName, false, false)
-
-		if status.IsIPv6() {
-			stopRadvd(status.BridgeName, true)
-		}
 		DNSStopMonitor(status.BridgeNum)
 	}
 	if status.BridgeMac != "" {
@@ -1505,6 +1366,12 @@ func createNetworkInstanceMetrics(ctx *zedrouterContext,
 		if strongSwanVpnStatusGet(ctx, status, &niMetrics) {
 			publishNetworkInstanceStatus(ctx, status)
 		}
+	case types.NetworkInstanceTypeVXLAN:
+		niMetrics.VxlanMetrics = getVxlanMetrics(ctx, status)
+	case types.NetworkInstanceTypeWireGuard:
+		if wgStatusGet(status) {
+			publishNetworkInstanceStatus(ctx, status)
+		}
 	default:
 	}
 
@@ -1537,6 +1404,7 @@ func publishNetworkInstanceStatus(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
 
 	copyProbeStats(ctx, status)
+	status.ReconcileErr = ctx.niReconcileErrors[status.UUID]
 	ctx.networkInstanceStatusMap.Store(status.UUID, status)
 	pub := ctx.pubNetworkInstanceStatus
 	pub.Publish(status.Key(), *status)
@@ -1551,102 +1419,6 @@ func publishNetworkInstanceMetrics(ctx *zedrouterContext,
 
 // ==== Bridge
 
-func bridgeActivate(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus) error {
-
-	log.Functionf("bridgeActivate(%s)\n", status.DisplayName)
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Noticef("bridgeActivate(%s) %s ignored",
-			status.DisplayName, status.BridgeName)
-		return nil
-	}
-	// Do we have any external port?
-	if status.Logicallabel == "" {
-		log.Noticef("bridgeActivate(%s) no logicallabel",
-			status.DisplayName)
-		return nil
-	}
-	bridgeLink, err := findBridge(status.BridgeName)
-	if err != nil {
-		errStr := fmt.Sprintf("findBridge(%s) failed %s",
-			status.BridgeName, err)
-		return errors.New(errStr)
-	}
-	// Find logicallabel for first in list
-	if len(status.IfNameList) == 0 {
-		errStr := fmt.Sprintf("IfNameList empty for %s",
-			status.BridgeName)
-		return errors.New(errStr)
-	}
-	ifname := status.IfNameList[0]
-	alink, _ := netlink.LinkByName(ifname)
-	if alink == nil {
-		errStr := fmt.Sprintf("Unknown Logicallabel %s, %s",
-			status.Logicallabel, ifname)
-		return errors.New(errStr)
-	}
-	// Make sure it is up
-	//    ip link set ${logicallabel} up
-	if err := netlink.LinkSetUp(alink); err != nil {
-		errStr := fmt.Sprintf("LinkSetUp on %s ifname %s failed: %s",
-			status.Logicallabel, ifname, err)
-		return errors.New(errStr)
-	}
-	// ip link set ${logicallabel} master ${bridge_name}
-	if err := netlink.LinkSetMaster(alink, bridgeLink); err != nil {
-		errStr := fmt.Sprintf("LinkSetMaster %s ifname %s bridge %s failed: %s",
-			status.Logicallabel, ifname, status.BridgeName, err)
-		return errors.New(errStr)
-	}
-	log.Functionf("bridgeActivate: added %s ifname %s to bridge %s\n",
-		status.Logicallabel, ifname, status.BridgeName)
-	return nil
-}
-
-// bridgeInactivateforNetworkInstance deletes any bnX bridge but not
-// others created by nim
-func bridgeInactivateforNetworkInstance(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus) {
-
-	log.Functionf("bridgeInactivateforNetworkInstance(%s) %s",
-		status.DisplayName, status.BridgeName)
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Noticef("bridgeInactivateforNetworkInstance(%s) %s ignored",
-			status.DisplayName, status.BridgeName)
-		return
-	}
-	// Do we have any external port?
-	if status.Logicallabel == "" {
-		log.Noticef("bridgeInactivateforNetworkInstance(%s) no logicallabel",
-			status.DisplayName)
-		return
-	}
-	// Find logicallabel
-	if len(status.IfNameList) == 0 {
-		errStr := fmt.Sprintf("IfNameList empty for %s",
-			status.BridgeName)
-		log.Errorln(errStr)
-		return
-	}
-	ifname := status.IfNameList[0]
-	alink, _ := netlink.LinkByName(ifname)
-	if alink == nil {
-		errStr := fmt.Sprintf("Unknown logicallabel %s, %s",
-			status.Logicallabel, ifname)
-		log.Errorln(errStr)
-		return
-	}
-	// ip link set ${logicallabel} nomaster
-	if err := netlink.LinkSetNoMaster(alink); err != nil {
-		errStr := fmt.Sprintf("LinkSetNoMaster %s ifname %s failed: %s",
-			status.Logicallabel, ifname, err)
-		log.Functionln(errStr)
-		return
-	}
-	log.Functionf("bridgeInactivateforNetworkInstance: removed %s ifname %s from bridge\n",
-		status.Logicallabel, ifname)
-}
-
 // ==== Nat
 
 // When the uplink port changes, doNetworkInstanceFallback will redo
@@ -1655,7 +1427,6 @@ func natActivate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) error {
 
 	log.Functionf("natActivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
 
 	// status.IfNameList should not have more than one interface name.
 	// Put a check anyway.
@@ -1668,19 +1439,9 @@ func natActivate(ctx *zedrouterContext,
 		return err
 	}
 	for _, a := range status.IfNameList {
-		log.Functionf("Adding iptables rules for %s \n", a)
-		err := iptables.IptableCmd(log, "-t", "nat", "-A", appChain("POSTROUTING"),
-			"-o", a, "-s", subnetStr, "-j", "MASQUERADE")
-		if err != nil {
-			log.Errorf("IptableCmd failed: %s", err)
-			return err
-		}
-		err = PbrRouteAddAll(status.BridgeName, a)
-		if err != nil {
-			log.Errorf("PbrRouteAddAll for Bridge(%s) and interface %s failed. "+
-				"Err: %s", status.BridgeName, a, err)
-			return err
-		}
+		// MASQUERADE rule and routes of the uplink are installed
+		// by NIReconciler.
+		log.Functionf("Adding source rules for %s \n", a)
 		devicenetwork.AddGatewaySourceRule(log, status.Subnet,
 			net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
 		devicenetwork.AddSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
@@ -1690,30 +1451,14 @@ func natActivate(ctx *zedrouterContext,
 }
 
 func natInactivate(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus, inActivateOld bool) {
+	status *types.NetworkInstanceStatus) {
 
 	log.Functionf("natInactivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
-	var oldUplinkIntf string
-	if inActivateOld {
-		// XXX Should we instead use status.ProgUplinkIntf
-		oldUplinkIntf = status.PrevUplinkIntf
-	} else {
-		oldUplinkIntf = status.CurrentUplinkIntf
-	}
-	err := iptables.IptableCmd(log, "-t", "nat", "-D", appChain("POSTROUTING"),
-		"-o", oldUplinkIntf, "-s", subnetStr, "-j", "MASQUERADE")
-	if err != nil {
-		log.Errorf("natInactivate: iptableCmd failed %s\n", err)
-	}
+	// MASQUERADE rule and routes of the uplink are removed by NIReconciler.
 	devicenetwork.DelGatewaySourceRule(log, status.Subnet,
 		net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
 	devicenetwork.DelSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
 	devicenetwork.DelInwardSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatInPrio)
-	err = PbrRouteDeleteAll(status.BridgeName, oldUplinkIntf)
-	if err != nil {
-		log.Errorf("natInactivate: PbrRouteDeleteAll failed %s\n", err)
-	}
 }
 
 func natDelete(status *types.NetworkInstanceStatus) {
@@ -1909,35 +1654,6 @@ func vifNameToBridgeName(ctx *zedrouterContext, vifName string) string {
 	return ""
 }
 
-// Get All ifindices for the Network Instances which are using ifname
-func getAllNIindices(ctx *zedrouterContext, ifname string) []int {
-
-	var indicies []int
-	pub := ctx.pubNetworkInstanceStatus
-	if pub == nil {
-		return indicies
-	}
-	instanceItems := pub.GetAll()
-	for _, st := range instanceItems {
-		status := st.(types.NetworkInstanceStatus)
-		if !status.IsUsingIfName(ifname) {
-			continue
-		}
-		if status.BridgeName == "" {
-			continue
-		}
-		link, err := netlink.LinkByName(status.BridgeName)
-		if err != nil {
-			errStr := fmt.Sprintf("LinkByName(%s) failed: %s",
-				status.BridgeName, err)
-			log.Errorln(errStr)
-			continue
-		}
-		indicies = append(indicies, link.Attrs().Index)
-	}
-	return indicies
-}
-
 // checkAndReprogramNetworkInstances handles changes to CurrentUplinkIntf
 // when NeedIntfUpdate is set.
 func checkAndReprogramNetworkInstances(ctx *zedrouterContext) {
@@ -1990,35 +1706,33 @@ func doNetworkInstanceFallback(
 	log.Functionf("IfNameList: %+v", status.IfNameList)
 
 	switch status.Type {
-	case types.NetworkInstanceTypeLocal:
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeVXLAN,
+		types.NetworkInstanceTypeWireGuard:
 		if !status.Activated {
 			return nil
 		}
-		natInactivate(ctx, status, true)
+		// New uplink may have a different IPv6 prefix delegated.
+		if err = updateDelegatedPrefix(ctx, status); err != nil {
+			log.Errorf("doNetworkInstanceFallback: %s", err)
+		}
+		// ... or a different MTU.
+		if status.Type == types.NetworkInstanceTypeVXLAN {
+			if err = vxlanUpdateMTU(ctx, status); err != nil {
+				log.Errorf("doNetworkInstanceFallback: %s", err)
+			}
+		}
+		if status.Type == types.NetworkInstanceTypeWireGuard {
+			if err = wgUpdateMTU(ctx, status); err != nil {
+				log.Errorf("doNetworkInstanceFallback: %s", err)
+			}
+		}
+		natInactivate(ctx, status)
 		err = natActivate(ctx, status)
 		if err != nil {
 			log.Errorf("doNetworkInstanceFallback: %s", err)
 		}
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
 
-		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
-		}
-
 		// Go through the list of all application connected to this network instance
 		// and clear conntrack flows corresponding to them.
 		apps := ctx.pubAppNetworkStatus.GetAll()
@@ -2056,24 +1770,6 @@ func doNetworkInstanceFallback(
 		}
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
 
-		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
-		}
-
 		// Go through the list of all application connected to this network instance
 		// and clear conntrack flows corresponding to them.
 		apps := ctx.pubAppNetworkStatus.GetAll()
@@ -2099,5 +1795,8 @@ func doNetworkInstanceFallback(
 	}
 	status.NeedIntfUpdate = false
 	publishNetworkInstanceStatus(ctx, status)
+	// Move NAT and routes to the current uplink and restart dnsmasq
+	// to use DNS servers received from DHCP for the current uplink.
+	reconcileNIs(ctx)
 	return err
 }
@@ -9,8 +9,10 @@ package zedrouter
 
 import (
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	psutilnet "github.com/shirou/gopsutil/net"
+	"github.com/vishvananda/netlink"
 	"strings"
 )
 
@@ -23,6 +25,7 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 	}
 	// Call iptables once to get counters
 	ac := iptables.FetchIprulesCounters(log)
+	shapedVIFs := getShapedVIFs(ctx)
 
 	// If we have both ethN and kethN then rename ethN to eethN ('e' for EVE)
 	// and kethN to ethN (the actual port)
@@ -110,7 +113,67 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 			bridgeName, vifName, ipVer, inout)
 		metric.RxAclRateLimitDrops = iptables.GetIPRuleACLRateLimitDrop(log, ac,
 			bridgeName, vifName, ipVer, !inout)
+		if shaping, shaped := shapedVIFs[vifName]; shaped {
+			// Traffic sent to the app is shaped on the VIF,
+			// traffic sent by the app on the IFB device.
+			metric.TxClasses = getTrafficClassMetrics(vifName, shaping.Ingress)
+			if ifbName, err := ifbNameForVIF(vifName); err == nil {
+				metric.RxClasses = getTrafficClassMetrics(ifbName, shaping.Egress)
+			}
+		}
 		metrics = append(metrics, metric)
 	}
 	return types.NetworkMetrics{MetricList: metrics, TotalRuleCount: uint64(len(ac))}
 }
+
+// getShapedVIFs returns traffic shaping of every VIF with shaping enabled.
+func getShapedVIFs(ctx *zedrouterContext) map[string]types.TrafficShaping {
+	shapedVIFs := make(map[string]types.TrafficShaping)
+	for _, st := range ctx.pubAppNetworkStatus.GetAll() {
+		status := st.(types.AppNetworkStatus)
+		for _, ulStatus := range status.UnderlayNetworkList {
+			if ulStatus.Vif != "" && ulStatus.Shaping.IsSet() {
+				shapedVIFs[ulStatus.Vif] = ulStatus.Shaping
+			}
+		}
+	}
+	return shapedVIFs
+}
+
+// getTrafficClassMetrics reads counters of the HTB classes of the traffic shaper
+// installed on the given interface.
+func getTrafficClassMetrics(ifName string,
+	shaper types.TrafficShaper) (classMetrics []types.TrafficClassMetric) {
+	if !shaper.IsSet() {
+		return nil
+	}
+	link, err := netlink.LinkByName(ifName)
+	if err != nil {
+		log.Warnf("getTrafficClassMetrics: LinkByName(%s) failed: %v", ifName, err)
+		return nil
+	}
+	classes, err := netlink.ClassList(link, netlink.HANDLE_NONE)
+	if err != nil {
+		log.Warnf("getTrafficClassMetrics: ClassList(%s) failed: %v", ifName, err)
+		return nil
+	}
+	classNames := linuxitems.TrafficClassNames(shaper)
+	for _, class := range classes {
+		attrs := class.Attrs()
+		name, ok := classNames[attrs.Handle]
+		if !ok || attrs.Statistics == nil {
+			continue
+		}
+		classMetric := types.TrafficClassMetric{Name: name}
+		if basic := attrs.Statistics.Basic; basic != nil {
+			classMetric.Bytes = basic.Bytes
+			classMetric.Pkts = uint64(basic.Packets)
+		}
+		if queue := attrs.Statistics.Queue; queue != nil {
+			classMetric.Drops = uint64(queue.Drops)
+			classMetric.Overlimits = uint64(queue.Overlimits)
+		}
+		classMetrics = append(classMetrics, classMetric)
+	}
+	return classMetrics
+}
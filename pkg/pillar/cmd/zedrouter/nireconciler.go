@@ -0,0 +1,507 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Translate network instances and application connections (as recorded
+// in the published NetworkInstanceStatus and AppNetworkStatus) into the
+// arguments for NIReconciler, which applies them into the network stack.
+
+package zedrouter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// initNIReconciler creates the NIReconciler. The export of its states is
+// enabled by the ExportNetworkInstanceState global setting.
+func initNIReconciler(ctx *zedrouterContext) {
+	ctx.niReconciler = &nireconciler.LinuxNIReconciler{
+		Log:            log,
+		NetworkMonitor: &netmonitor.LinuxNetworkMonitor{Log: log},
+	}
+}
+
+// reconcileNIs applies the current config of all network instances and app
+// connections into the network stack. Should be called after a change
+// of NetworkInstanceStatus or AppNetworkStatus was published.
+func reconcileNIs(ctx *zedrouterContext) nireconciler.ReconcileStatus {
+	args, buildErrs := getNIReconcilerArgs(ctx)
+	ctx.niReconcileStatus = ctx.niReconciler.Reconcile(context.Background(), args)
+	if ctx.niReconcileStatus.Error != nil {
+		log.Errorf("reconcileNIs: %v", ctx.niReconcileStatus.Error)
+	}
+	updateNIReconcileErrors(ctx, buildErrs, ctx.niReconcileStatus)
+	// Ipsets can only be destroyed once they are no longer referenced
+	// by any iptables rule.
+	removeStaleIpsets(ctx)
+	return ctx.niReconcileStatus
+}
+
+// updateNIReconcileErrors records errors of building and applying
+// the config of every NI and publishes NetworkInstanceStatus of NIs
+// with the error changed.
+func updateNIReconcileErrors(ctx *zedrouterContext,
+	buildErrs map[uuid.UUID]error, reconcileStatus nireconciler.ReconcileStatus) {
+
+	niErrs := make(map[uuid.UUID]string)
+	for niUUID, err := range buildErrs {
+		niErrs[niUUID] = err.Error()
+	}
+	for niUUID, niStatus := range reconcileStatus.NIs {
+		if niStatus.Error == nil {
+			continue
+		}
+		if errStr, hasErr := niErrs[niUUID]; hasErr {
+			niErrs[niUUID] = errStr + "; " + niStatus.Error.Error()
+		} else {
+			niErrs[niUUID] = niStatus.Error.Error()
+		}
+	}
+	var changed []uuid.UUID
+	for niUUID, prevErr := range ctx.niReconcileErrors {
+		if _, hasErr := niErrs[niUUID]; !hasErr {
+			log.Noticef("updateNIReconcileErrors(%s): cleared error %s",
+				niUUID, prevErr.Error)
+			delete(ctx.niReconcileErrors, niUUID)
+			changed = append(changed, niUUID)
+		}
+	}
+	for niUUID, errStr := range niErrs {
+		if prevErr, hasErr := ctx.niReconcileErrors[niUUID]; hasErr &&
+			prevErr.Error == errStr {
+			continue
+		}
+		log.Errorf("updateNIReconcileErrors(%s): %s", niUUID, errStr)
+		var niErr types.ErrorAndTime
+		niErr.SetErrorNow(errStr)
+		ctx.niReconcileErrors[niUUID] = niErr
+		changed = append(changed, niUUID)
+	}
+	for _, niUUID := range changed {
+		status := lookupNetworkInstanceStatus(ctx, niUUID.String())
+		if status == nil {
+			// NI was deleted.
+			delete(ctx.niReconcileErrors, niUUID)
+			continue
+		}
+		publishNetworkInstanceStatus(ctx, status)
+	}
+}
+
+// getNIReconcilerArgs returns arguments for NIReconciler and errors
+// preventing to build the complete config of some network instances.
+func getNIReconcilerArgs(ctx *zedrouterContext) (args nireconciler.Args,
+	buildErrs map[uuid.UUID]error) {
+	buildErrs = make(map[uuid.UUID]error)
+	if ctx.iptablesInitialized {
+		args.Rules = append(args.Rules, dropEscapedFlowsRule())
+	}
+	appItems := ctx.pubAppNetworkStatus.GetAll()
+	appKeys := sortedKeys(appItems)
+	for _, key := range appKeys {
+		status := appItems[key].(types.AppNetworkStatus)
+		if status.GetStatsIPAddr != nil {
+			args.Rules = append(args.Rules,
+				containerStatsACLRule(status.GetStatsIPAddr))
+		}
+	}
+	niItems := ctx.pubNetworkInstanceStatus.GetAll()
+	for _, key := range sortedKeys(niItems) {
+		status := niItems[key].(types.NetworkInstanceStatus)
+		if status.BridgeName == "" {
+			continue
+		}
+		niConfig, err := getNIConfig(ctx, &status)
+		if err != nil {
+			buildErrs[status.UUID] = err
+		}
+		args.NIs = append(args.NIs, niConfig)
+	}
+	for _, key := range appKeys {
+		status := appItems[key].(types.AppNetworkStatus)
+		app, hasConfig := getAppConnConfig(ctx, &status)
+		if hasConfig {
+			args.Apps = append(args.Apps, app)
+		}
+	}
+	return args, buildErrs
+}
+
+// getNIConfig returns config of the network instance for NIReconciler.
+// The returned error is set if only a partial config could be built.
+func getNIConfig(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (nireconciler.NIConfig, error) {
+	var buildErr error
+
+	niConfig := nireconciler.NIConfig{
+		UUID:        status.UUID,
+		DisplayName: status.DisplayName,
+		Bridge: linuxitems.Bridge{
+			IfName:       status.BridgeName,
+			CreatedByNIM: !strings.HasPrefix(status.BridgeName, "bn"),
+		},
+		Radvd: getRadvdConfig(ctx, status),
+	}
+	if !niConfig.Bridge.CreatedByNIM {
+		if status.BridgeMac != "" {
+			mac, err := net.ParseMAC(status.BridgeMac)
+			if err != nil {
+				log.Errorf("getNIConfig(%s): ParseMAC %s failed: %v",
+					status.Key(), status.BridgeMac, err)
+			} else {
+				niConfig.Bridge.MACAddress = mac
+			}
+		}
+		if status.BridgeIPAddr != "" {
+			ip := net.ParseIP(status.BridgeIPAddr)
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			prefixLen := getPrefixLenForBridgeIP(status)
+			niConfig.Bridge.IPAddresses = []*net.IPNet{
+				{IP: ip, Mask: net.CIDRMask(prefixLen, 8*len(ip))},
+			}
+		}
+	}
+	var uplink string
+	if len(status.IfNameList) > 0 {
+		uplink = status.IfNameList[0]
+	}
+	if status.Activated && uplink != "" {
+		switch status.Type {
+		case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeVXLAN:
+			niConfig.UplinkIfName = uplink
+			niConfig.CopyUplinkRoutes = true
+			ipVer := 4
+			if status.IsIPv6() {
+				ipVer = 6
+			}
+			// Subnet delegated to the NI is routed by the upstream router,
+			// NAT66 is only used with the controller-configured subnets.
+			if !status.IPv6PrefixDelegation {
+				niConfig.Rules = append(niConfig.Rules, types.IPTablesRule{
+					IPVer:    ipVer,
+					Table:    "nat",
+					Chain:    "POSTROUTING",
+					Rule:     []string{"-o", uplink, "-s", status.Subnet.String()},
+					Action:   []string{"-j", "MASQUERADE"},
+					RuleName: "SNAT traffic leaving " + status.DisplayName,
+				})
+			}
+			if status.Type == types.NetworkInstanceTypeVXLAN {
+				if err := addVxlanConfig(ctx, status, uplink, &niConfig); err != nil {
+					buildErr = fmt.Errorf("failed to build VXLAN config: %w", err)
+				}
+			}
+		case types.NetworkInstanceTypeWireGuard:
+			// Only AllowedIPs of the peers are routed, uplink routes are not copied.
+			niConfig.UplinkIfName = uplink
+			if err := addWireGuardConfig(ctx, status, uplink, &niConfig); err != nil {
+				buildErr = fmt.Errorf("failed to build WireGuard config: %w", err)
+			}
+		case types.NetworkInstanceTypeSwitch:
+			if !niConfig.Bridge.CreatedByNIM && status.Logicallabel != "" {
+				niConfig.UplinkIfName = uplink
+				niConfig.BridgeUplink = true
+			}
+		}
+		if niConfig.UplinkIfName != "" {
+			port := ctx.deviceNetworkStatus.GetPortByIfName(uplink)
+			if port != nil {
+				niConfig.UplinkLogicalLabel = port.Logicallabel
+			}
+		}
+	}
+	if status.Activated && status.Type == types.NetworkInstanceTypeSwitch &&
+		niConfig.Bridge.CreatedByNIM {
+		// Drop external connection request to meta-data server.
+		// NIM bridges the port renamed with the "k" prefix, the physdev match
+		// does not require it to exist.
+		portName := "k" + status.BridgeName
+		niConfig.Rules = append(niConfig.Rules, types.IPTablesRule{
+			IPVer: 4,
+			Table: "filter",
+			Chain: "INPUT",
+			Rule: []string{"-i", status.BridgeName, "-p", "tcp",
+				"--dport", "80", "-m", "physdev", "--physdev-in", portName},
+			Action:   []string{"-j", "DROP"},
+			RuleName: "Block external access to meta-data server",
+		})
+	}
+	niConfig.Dnsmasq = getDnsmasqConfig(ctx, status)
+	return niConfig, buildErr
+}
+
+// getRadvdConfig returns config for radvd advertising the IPv6 subnet
+// of the network instance or nil if the NI is not IPv6.
+func getRadvdConfig(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) *linuxitems.Radvd {
+
+	if !status.IsIPv6() {
+		return nil
+	}
+	radvd := &linuxitems.Radvd{
+		ListenIf:   status.BridgeName,
+		DomainName: status.DomainName,
+	}
+	if status.Subnet.IP != nil {
+		subnet := status.Subnet
+		radvd.Prefix = &subnet
+	}
+	for _, dnsServer := range status.DnsServers {
+		if dnsServer.To4() == nil {
+			radvd.DNSServers = append(radvd.DNSServers, dnsServer)
+		}
+	}
+	if len(radvd.DNSServers) == 0 && status.BridgeIPAddr != "" {
+		// dnsmasq running on the bridge is the DNS server.
+		if bridgeIP := net.ParseIP(status.BridgeIPAddr); bridgeIP != nil {
+			radvd.DNSServers = []net.IP{bridgeIP}
+		}
+	}
+	return radvd
+}
+
+// getDnsmasqConfig returns config for dnsmasq running for the network instance
+// or nil if the bridge has no IP address to listen on.
+func getDnsmasqConfig(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) *linuxitems.Dnsmasq {
+
+	if status.BridgeIPAddr == "" {
+		return nil
+	}
+	bridgeIP := net.ParseIP(status.BridgeIPAddr)
+	if bridgeIP == nil {
+		log.Errorf("getDnsmasqConfig(%s): failed to parse bridge IP %s",
+			status.Key(), status.BridgeIPAddr)
+		return nil
+	}
+	uplink := status.CurrentUplinkIntf
+	dnsmasq := &linuxitems.Dnsmasq{
+		ListenIf:           status.BridgeName,
+		ListenIP:           bridgeIP,
+		UplinkIf:           uplink,
+		UpstreamServers:    getUpstreamDNSServers(ctx, uplink),
+		HostsDir:           runDirname + "/hosts." + status.BridgeName,
+		DomainName:         status.DomainName,
+		DNSServers:         status.DnsServers,
+		DHCPRangeStart:     status.DhcpRange.Start,
+		WithAllOnesNetmask: !ctx.disableDHCPAllOnesNetMask,
+	}
+	if status.Type == types.NetworkInstanceTypeVXLAN {
+		// Applications on other nodes are reached directly over L2,
+		// not through the gateway.
+		dnsmasq.WithAllOnesNetmask = false
+		dnsmasq.MTU = status.VxlanMTU
+	}
+	if status.Type == types.NetworkInstanceTypeWireGuard && status.WireGuardStatus != nil {
+		dnsmasq.MTU = status.WireGuardStatus.MTU
+	}
+	// By default dnsmasq advertises a router (and we can have a
+	// static router defined in the NetworkInstanceConfig).
+	// To support airgap networks we interpret gateway=0.0.0.0
+	// to not advertise ourselves as a router.
+	if status.Logicallabel == "" {
+		log.Functionf("getDnsmasqConfig(%s): internal switch without external port, "+
+			"dnsmasq suppress router advertise", status.Key())
+	} else if status.Gateway != nil {
+		if !status.Gateway.IsUnspecified() {
+			dnsmasq.Router = status.Gateway
+		}
+	} else {
+		dnsmasq.Router = bridgeIP
+	}
+	if status.NtpServer != nil {
+		dnsmasq.NTPServers = []net.IP{status.NtpServer}
+	} else {
+		dnsmasq.NTPServers = types.GetNTPServers(*ctx.deviceNetworkStatus, uplink)
+	}
+	if status.Subnet.IP != nil {
+		subnet := status.Subnet
+		dnsmasq.Subnet = &subnet
+	}
+	for _, host := range status.BridgeIPSets {
+		dnsmasq.IPSets = append(dnsmasq.IPSets, linuxitems.DnsmasqIPSet{
+			Domain:        host,
+			IPSetBasename: hostIpsetBasename(host),
+		})
+	}
+	for _, vif := range status.Vifs {
+		addrs, ok := status.IPAssignments[vif.MacAddr]
+		if !ok || addrs.IPv4Addr == nil {
+			continue
+		}
+		mac, err := net.ParseMAC(vif.MacAddr)
+		if err != nil {
+			log.Errorf("getDnsmasqConfig(%s): ParseMAC %s failed: %v",
+				status.Key(), vif.MacAddr, err)
+			continue
+		}
+		dnsmasq.DHCPHosts = append(dnsmasq.DHCPHosts, linuxitems.DnsmasqDHCPHost{
+			MAC:      mac,
+			IP:       addrs.IPv4Addr,
+			Hostname: vif.AppID.String(),
+		})
+	}
+	sort.Slice(dnsmasq.DHCPHosts, func(i, j int) bool {
+		return dnsmasq.DHCPHosts[i].MAC.String() < dnsmasq.DHCPHosts[j].MAC.String()
+	})
+	// XXX look at zedrouter loglevel; perhaps we should introduce a
+	// separate logger for dnsmasq or per bridge to have more fine-grained
+	// control
+	switch logger.GetLevel() {
+	case logrus.TraceLevel:
+		dnsmasq.LogQueries = true
+		dnsmasq.LogDHCP = true
+	case logrus.DebugLevel:
+		dnsmasq.LogDHCP = true
+	}
+	return dnsmasq
+}
+
+// getUpstreamDNSServers returns DNS servers to which dnsmasq should forward
+// requests received from applications.
+// If the uplink has lost its DNS servers (e.g. when the Internet connectivity
+// is lost), the previously known servers are used.
+func getUpstreamDNSServers(ctx *zedrouterContext, uplink string) []net.IP {
+	servers := types.GetDNSServers(*ctx.deviceNetworkStatus, uplink)
+	if len(servers) == 0 && uplink != "" {
+		servers = ctx.dnsServers[uplink]
+	}
+	return servers
+}
+
+// getAppConnConfig returns VIFs and ACLs of the application.
+// Returns false if the app has no connection to configure.
+func getAppConnConfig(ctx *zedrouterContext,
+	status *types.AppNetworkStatus) (nireconciler.AppConnConfig, bool) {
+
+	appID := status.UUIDandVersion.UUID
+	app := nireconciler.AppConnConfig{
+		UUID:        appID,
+		DisplayName: status.DisplayName,
+	}
+	aclMap := ctx.NLaclMap[appID]
+	for _, ulStatus := range status.UnderlayNetworkList {
+		if status.Activated && ulStatus.Vif != "" && ulStatus.Bridge != "" {
+			vif := linuxitems.VIF{
+				IfName:         ulStatus.Vif,
+				NetAdapterName: ulStatus.Name,
+				AppDisplayName: status.DisplayName,
+				BridgeIfName:   ulStatus.Bridge,
+			}
+			if mac, err := net.ParseMAC(ulStatus.Mac); err == nil {
+				vif.GuestMAC = mac
+			}
+			app.VIFs = append(app.VIFs, vif)
+			if ulStatus.Shaping.IsSet() {
+				ifbName, err := ifbNameForVIF(ulStatus.Vif)
+				if err != nil {
+					log.Errorf("getAppConnConfig(%s): cannot shape traffic of %s: %v",
+						status.DisplayName, ulStatus.Name, err)
+				} else {
+					app.Shapers = append(app.Shapers, linuxitems.TrafficShaper{
+						VIFIfName:      ulStatus.Vif,
+						IFBIfName:      ifbName,
+						NetAdapterName: ulStatus.Name,
+						AppDisplayName: status.DisplayName,
+						Egress:         ulStatus.Shaping.Egress,
+						Ingress:        ulStatus.Shaping.Ingress,
+					})
+				}
+			}
+		}
+		if acls, ok := aclMap[ulStatus.Name]; ok {
+			app.Rules = append(app.Rules, acls.ACLRules...)
+		}
+	}
+	return app, len(app.VIFs) > 0 || len(app.Rules) > 0
+}
+
+const (
+	// Prefix of host-side names of application VIFs.
+	vifPrefix = "nbu"
+	// Prefix of names of IFB devices shaping traffic sent by applications.
+	ifbPrefix = "nbi"
+	// IFNAMSIZ without the terminating null byte.
+	maxIfNameLen = 15
+)
+
+// ifbNameForVIF returns name of the IFB device used to shape traffic
+// sent by the application over the given VIF.
+func ifbNameForVIF(vifName string) (string, error) {
+	if !strings.HasPrefix(vifName, vifPrefix) {
+		return "", fmt.Errorf("VIF %s does not have the %s prefix",
+			vifName, vifPrefix)
+	}
+	ifbName := ifbPrefix + strings.TrimPrefix(vifName, vifPrefix)
+	if len(ifbName) > maxIfNameLen {
+		return "", fmt.Errorf("IFB name %s is longer than %d characters",
+			ifbName, maxIfNameLen)
+	}
+	return ifbName, nil
+}
+
+// Rule ensuring that packets marked with the drop action are indeed dropped and never
+// sent out via downlink or uplink interfaces. Whereas routed packets marked by drop ACEs
+// are blackholed into a dummy interface using a high-priority IP rule, packets which
+// are only bridged and not routed by EVE escape this IP rule and would otherwise
+// continue in their path even if marked for dropping.
+func dropEscapedFlowsRule() types.IPTablesRule {
+	return types.IPTablesRule{
+		IPVer: 4,
+		Table: "mangle",
+		Chain: "POSTROUTING",
+		Rule: []string{"--match", "connmark", "--mark",
+			fmt.Sprintf("%d/%d", iptables.AceDropAction, iptables.AceActionMask),
+			"!", "-o", dummyIntfName},
+		Action:   []string{"-j", "DROP"},
+		RuleName: "Drop flows marked with the drop action",
+	}
+}
+
+// App Container API endpoint blocking ACL.
+// This ACL blocks the other Apps accessing through the same subnet to 'appIPAddr:DOCKERAPIPORT'
+// in TCP protocol, and only allow the Dom0 process to query the App's docker stats
+//   - this blocking is only possible in the 'raw' table and 'PREROUTING' chain due to the marking
+//     is done in the 'mangle' of 'PREROUTING'. Rules not specific to NI or app are installed
+//     to the front of the 'PREROUTING' list.
+//   - this blocking ACL does not block the Dom0 access to the above TCP endpoint on the same
+//     subnet. This is due to the IP packets from Dom0 to the internal bridge entering the linux
+//     forwarding through the 'OUTPUT' chain
+//   - this blocking does not seem to work if further matching to the '--physdev', so the drop action
+//     needs to be at network layer3
+//   - XXX currently the 'drop' mark (0x800000) on the flow of internal traffic on bridge does not work,
+//     later it may be possible to change below '-j DROP' to '-j MARK' action
+func containerStatsACLRule(appIPAddr net.IP) types.IPTablesRule {
+	return types.IPTablesRule{
+		IPVer: 4,
+		Table: "raw",
+		Chain: "PREROUTING",
+		Rule: []string{"-d", appIPAddr.String(), "-p", "tcp",
+			"--dport", strconv.Itoa(DOCKERAPIPORT)},
+		Action:   []string{"-j", "DROP"},
+		RuleName: "Block access to container stats of " + appIPAddr.String(),
+	}
+}
+
+func sortedKeys(items map[string]interface{}) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
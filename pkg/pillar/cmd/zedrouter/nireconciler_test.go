@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import "testing"
+
+func TestIfbNameForVIF(t *testing.T) {
+	ifbName, err := ifbNameForVIF("nbu1x12")
+	if err != nil || ifbName != "nbi1x12" {
+		t.Errorf("unexpected IFB name %q (err: %v)", ifbName, err)
+	}
+	if _, err = ifbNameForVIF("vif1.0"); err == nil {
+		t.Errorf("expected error for VIF without the nbu prefix")
+	}
+	if _, err = ifbNameForVIF("nbu12345x1234567"); err == nil {
+		t.Errorf("expected error for too long IFB name")
+	}
+}
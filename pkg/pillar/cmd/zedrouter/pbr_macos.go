@@ -18,18 +18,6 @@ func getDefaultIPv4Route(ifindex int) *netlink.Route {
 	return nil
 }
 
-func getDefaultRouteTable() int {
-	return 0
-}
-
-func getRouteUpdateTypeDELROUTE() uint16 {
-	return 0
-}
-
-func getRouteUpdateTypeNEWROUTE() uint16 {
-	return 0
-}
-
 // Handle a link being added or deleted
 func PbrLinkChange(deviceNetworkStatus *types.DeviceNetworkStatus,
 	change netlink.LinkUpdate) string {
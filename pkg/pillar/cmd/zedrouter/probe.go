@@ -317,7 +317,9 @@ func launchHostProbe(ctx *zedrouterContext) {
 		var anyNIStateChg bool
 		// XXX Revisit when we support other network instance types.
 		if netstatus.Type != types.NetworkInstanceTypeLocal &&
-			netstatus.Type != types.NetworkInstanceTypeCloud {
+			netstatus.Type != types.NetworkInstanceTypeCloud &&
+			netstatus.Type != types.NetworkInstanceTypeVXLAN &&
+			netstatus.Type != types.NetworkInstanceTypeWireGuard {
 			log.Tracef("launchHostProbe: ni(%s) type %v, skip probing\n", netstatus.BridgeName, netstatus.Type)
 			return true
 		}
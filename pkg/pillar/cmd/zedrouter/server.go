@@ -2,7 +2,8 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // A http server providing meta-data information to application instances
-// at http://169.254.169.254. The source IP address is used to tell
+// at http://169.254.169.254 (http://[fd00:ec2::254] for IPv6 network
+// instances). The source IP address is used to tell
 // which app instance is sending the request
 
 package zedrouter
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
@@ -168,10 +170,10 @@ func createServer4(ctx *zedrouterContext, bridgeIP string, bridgeName string) er
 	mux.Handle("/eve/v1/tpm/signer", signerHandler)
 
 	targetPort := 80
-	subnetStr := "169.254.169.254/32"
-	target := fmt.Sprintf("%s:%d", bridgeIP, targetPort)
+	subnetStr, iptableCmd, network := metaDataServerAddr(bridgeIP)
+	target := net.JoinHostPort(bridgeIP, strconv.Itoa(targetPort))
 	log.Noticef("add NAT to target %s", target)
-	if err := iptables.IptableCmd(log, "-t", "nat", "-I", appChain("PREROUTING"),
+	if err := iptableCmd(log, "-t", "nat", "-I", appChain("PREROUTING"),
 		"-i", bridgeName, "-p", "tcp", "-d", subnetStr,
 		"--dport", strconv.Itoa(targetPort),
 		"-j", "DNAT", "--to-destination", target); err != nil {
@@ -180,9 +182,7 @@ func createServer4(ctx *zedrouterContext, bridgeIP string, bridgeName string) er
 	doneChan := make(chan struct{})
 	ackChan := make(chan struct{})
 	// Need one server per local IP address
-	// XXX once we have an IPv6 bridge IP address add:
-	// go runServer(mux, "tcp6", "["+bridgeIP6+"%"+bridgeName+"]")
-	go runServer(mux, "tcp4", bridgeIP, doneChan, ackChan)
+	go runServer(mux, network, bridgeIP, doneChan, ackChan)
 	setDoneChan(bridgeName, bridgeIP, doneChan, ackChan)
 	log.Noticef("started http server on %s/%s", bridgeName, bridgeIP)
 	return nil
@@ -197,10 +197,10 @@ func deleteServer4(ctx *zedrouterContext, bridgeIP string, bridgeName string) {
 		return
 	}
 	targetPort := 80
-	subnetStr := "169.254.169.254/32"
-	target := fmt.Sprintf("%s:%d", bridgeIP, targetPort)
+	subnetStr, iptableCmd, _ := metaDataServerAddr(bridgeIP)
+	target := net.JoinHostPort(bridgeIP, strconv.Itoa(targetPort))
 	log.Noticef("delete NAT from target %s", target)
-	if err := iptables.IptableCmd(log, "-t", "nat", "-D", appChain("PREROUTING"),
+	if err := iptableCmd(log, "-t", "nat", "-D", appChain("PREROUTING"),
 		"-i", bridgeName, "-p", "tcp", "-d", subnetStr,
 		"--dport", strconv.Itoa(targetPort),
 		"-j", "DNAT", "--to-destination", target); err != nil {
@@ -221,6 +221,26 @@ func deleteServer4(ctx *zedrouterContext, bridgeIP string, bridgeName string) {
 	log.Noticef("stopped http server on %s/%s", bridgeName, bridgeIP)
 }
 
+// metaDataServerAddr returns the well-known address of the meta-data server
+// (which is DNATed to bridgeIP), iptables command and network to listen on
+// for the IP version of the bridge IP.
+func metaDataServerAddr(bridgeIP string) (subnetStr string,
+	iptableCmd func(*base.LogObject, ...string) error, network string) {
+	if ip := net.ParseIP(bridgeIP); ip != nil && ip.To4() == nil {
+		return types.MetadataServerIPv6.String() + "/128", iptables.Ip6tableCmd, "tcp6"
+	}
+	return "169.254.169.254/32", iptables.IptableCmd, "tcp4"
+}
+
+// getRemoteIP returns IP address of the app instance sending the request.
+func getRemoteIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
 // map from bridgeName/bridgeIP to doneChanVal
 type doneChanKey struct {
 	bridgeName string
@@ -309,7 +329,7 @@ func runServer(mux http.Handler, network string, ipaddr string,
 	w := logger.Writer()
 	defer w.Close()
 	srv := http.Server{
-		Addr:         ipaddr + ":80",
+		Addr:         net.JoinHostPort(ipaddr, "80"),
 		Handler:      mux,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 5 * time.Second,
@@ -341,7 +361,7 @@ func runServer(mux http.Handler, network string, ipaddr string,
 		default:
 		}
 		var err error
-		listener, err = net.Listen("tcp", srv.Addr)
+		listener, err = net.Listen(network, srv.Addr)
 		if err == nil {
 			break
 		}
@@ -482,7 +502,7 @@ func isECONNREFUSED(e0 error) bool {
 // ServeHTTP for networkHandler provides a json return
 func (hdl networkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Tracef("networkHandler.ServeHTTP")
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	externalIP, code := getExternalIPForApp(hdl.ctx, remoteIP)
 	var ipStr string
 	var hostname string
@@ -524,7 +544,7 @@ func (hdl networkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 // ServeHTTP for externalIPHandler provides a text IP address
 func (hdl externalIPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Tracef("externalIPHandler.ServeHTTP")
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	externalIP, code := getExternalIPForApp(hdl.ctx, remoteIP)
 	w.WriteHeader(code)
 	w.Header().Add("Content-Type", "text/plain")
@@ -561,7 +581,7 @@ func getExternalIPForApp(ctx *zedrouterContext, remoteIP net.IP) (net.IP, int) {
 // ServeHTTP for hostnameHandler returns text
 func (hdl hostnameHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Tracef("hostnameHandler.ServeHTTP")
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	w.Header().Add("Content-Type", "text/plain")
 	if anStatus == nil {
@@ -580,7 +600,7 @@ func (hdl openstackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Tracef("openstackHandler ServeHTTP request: %s", r.URL.String())
 	dirname, filename := path.Split(strings.TrimSuffix(r.URL.Path, "/"))
 	dirname = strings.TrimSuffix(dirname, "/")
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	var hostname string
 	var id string
@@ -703,7 +723,7 @@ func (hdl appInstMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	if anStatus == nil {
 		msg := fmt.Sprintf("appInstMetaHandler: no AppNetworkStatus for %s", remoteIP.String())
@@ -824,7 +844,7 @@ func (hdl signerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, msg, http.StatusBadRequest)
 		return
 	}
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	if anStatus == nil {
 		msg := fmt.Sprintf("signerHandler: no AppNetworkStatus for %s",
@@ -851,7 +871,7 @@ func (hdl AppInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Tracef("wwanAppInfoHandler.ServeHTTP")
 	w.Header().Add("Content-Type", "application/json")
 
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	if anStatus == nil {
 		log.Errorf("Could not find network instance by ip %v", remoteIP)
@@ -884,7 +904,7 @@ func (hdl AppInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (hdl AppCustomBlobsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	blobName := path.Base(r.URL.Path)
 
-	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	remoteIP := getRemoteIP(r)
 	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
 	if anStatus == nil {
 		log.Errorf("Could not find network instance by ip %v", remoteIP)
@@ -0,0 +1,370 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// VXLAN network instance. It is a local network instance (NAT, dnsmasq,
+// metadata server) with its bridge stretched over a VXLAN interface
+// to the same network instance deployed on other EVE nodes.
+// Every node runs its own gateway and DHCP server, each with a distinct
+// gateway IP and a non-overlapping DHCP range (assigned by the controller),
+// and DHCP/RA messages are not allowed to cross the VXLAN interface.
+// The encapsulated traffic can be encrypted either with manually keyed
+// IPsec (ESP in transport mode) or by running VXLAN over WireGuard.
+
+package zedrouter
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+func vxlanIfName(bridgeNum int) string {
+	return fmt.Sprintf("vx%d", bridgeNum)
+}
+
+// vxlanBridgeMac returns MAC address for the bridge of a VXLAN network instance.
+// Bridges of the same NI deployed on different nodes are inside the same L2
+// segment, therefore the MAC address cannot be derived from the bridge number
+// (which is only unique within the node). Instead, it is generated from the NI
+// UUID and the gateway IP, which is different on every node.
+func vxlanBridgeMac(status *types.NetworkInstanceStatus) string {
+	h := sha256.New()
+	h.Write(status.UUIDandVersion.UUID[:])
+	h.Write(status.Gateway)
+	hash := h.Sum(nil)
+	mac := net.HardwareAddr{hash[0], hash[1], hash[2], hash[3], hash[4], hash[5]}
+	// Unicast, locally administered.
+	mac[0] &= ^byte(1)
+	mac[0] |= byte(1 << 1)
+	return mac.String()
+}
+
+// vxlanCreate decrypts the pre-shared key or the WireGuard private key
+// of the network instance.
+func vxlanCreate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("vxlanCreate(%s)", status.DisplayName)
+	delete(ctx.vxlanSecrets, status.UUID)
+	if status.Vxlan.Encryption == types.VxlanEncryptionNone {
+		return nil
+	}
+	cipherStatus, decBlock, err := cipher.GetCipherCredentials(
+		&ctx.decryptCipherContext, status.Vxlan.CipherBlockStatus)
+	ctx.pubCipherBlockStatus.Publish(cipherStatus.Key(), cipherStatus)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt VXLAN secrets: %v", err)
+	}
+	switch status.Vxlan.Encryption {
+	case types.VxlanEncryptionIPsec:
+		if decBlock.IPsecPresharedKey == "" {
+			return errors.New("missing IPsec pre-shared key")
+		}
+	case types.VxlanEncryptionWireGuard:
+		if decBlock.WireGuardPrivateKey == "" {
+			return errors.New("missing WireGuard private key")
+		}
+	}
+	ctx.vxlanSecrets[status.UUID] = decBlock
+	return nil
+}
+
+// vxlanModify applies changed VXLAN config (e.g. a new set of peers
+// or rotated keys).
+func vxlanModify(ctx *zedrouterContext, config types.VxlanConfig,
+	status *types.NetworkInstanceStatus) error {
+
+	status.Vxlan = config
+	if err := vxlanCreate(ctx, status); err != nil {
+		return err
+	}
+	if status.Activated {
+		return vxlanUpdateMTU(ctx, status)
+	}
+	return nil
+}
+
+func vxlanDelete(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	log.Functionf("vxlanDelete(%s)", status.DisplayName)
+	delete(ctx.vxlanSecrets, status.UUID)
+}
+
+// vxlanActivate determines the MTU of the VXLAN segment and installs NAT
+// for the uplink.
+func vxlanActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("vxlanActivate(%s)", status.DisplayName)
+	if err := vxlanUpdateMTU(ctx, status); err != nil {
+		return err
+	}
+	return natActivate(ctx, status)
+}
+
+func getUplinkMTU(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (uint16, error) {
+	if len(status.IfNameList) == 0 {
+		return 0, errors.New("network instance has no uplink port")
+	}
+	link, err := netlink.LinkByName(status.IfNameList[0])
+	if err != nil {
+		return 0, fmt.Errorf("failed to get uplink %s: %v",
+			status.IfNameList[0], err)
+	}
+	return uint16(link.Attrs().MTU), nil
+}
+
+// vxlanUpdateMTU (re)computes the MTU of the VXLAN segment, which depends
+// on the MTU of the current uplink. The MTU is advertised to applications
+// by DHCP.
+func vxlanUpdateMTU(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	uplinkMTU, err := getUplinkMTU(ctx, status)
+	if err != nil {
+		return err
+	}
+	mtu, err := status.Vxlan.GetMTU(uplinkMTU)
+	if err != nil {
+		return err
+	}
+	if mtu != status.VxlanMTU {
+		log.Noticef("vxlanUpdateMTU(%s): MTU changed from %d to %d",
+			status.DisplayName, status.VxlanMTU, mtu)
+		status.VxlanMTU = mtu
+	}
+	return nil
+}
+
+// getVxlanLocalIP returns IP address of the uplink used as the source
+// of the VXLAN (or ESP) traffic.
+func getVxlanLocalIP(ctx *zedrouterContext, uplink string, ipv6 bool) net.IP {
+	port := ctx.deviceNetworkStatus.GetPortByIfName(uplink)
+	if port == nil {
+		return nil
+	}
+	for _, addr := range port.AddrInfoList {
+		if addr.Addr.IsLinkLocalUnicast() {
+			continue
+		}
+		if (addr.Addr.To4() == nil) == ipv6 {
+			return addr.Addr
+		}
+	}
+	return nil
+}
+
+// getVxlanIPsec returns IPsec SA pairs for all peers of the network instance.
+func getVxlanIPsec(ctx *zedrouterContext, status *types.NetworkInstanceStatus,
+	uplink string, localIP net.IP) (ipsec []linuxitems.IPsecTransport) {
+	secrets, hasSecrets := ctx.vxlanSecrets[status.UUID]
+	if !hasSecrets {
+		return nil
+	}
+	for _, peer := range status.Vxlan.Peers {
+		ipsec = append(ipsec, linuxitems.IPsecTransport{
+			LocalIP:      localIP,
+			PeerIP:       peer.Address,
+			DstPort:      status.Vxlan.DstPort,
+			PresharedKey: secrets.IPsecPresharedKey,
+			UplinkIfName: uplink,
+		})
+	}
+	return ipsec
+}
+
+// addVxlanConfig adds VXLAN interface (with WireGuard or IPsec if encryption
+// is enabled) into the config of the network instance.
+func addVxlanConfig(ctx *zedrouterContext, status *types.NetworkInstanceStatus,
+	uplink string, niConfig *nireconciler.NIConfig) error {
+
+	vxlanCfg := status.Vxlan
+	if vxlanCfg.Encryption != types.VxlanEncryptionNone {
+		if _, hasSecrets := ctx.vxlanSecrets[status.UUID]; !hasSecrets {
+			return errors.New("missing decrypted VXLAN secrets")
+		}
+	}
+	vxlan := &linuxitems.Vxlan{
+		IfName:         vxlanIfName(status.BridgeNum),
+		VNI:            vxlanCfg.VNI,
+		DstPort:        vxlanCfg.DstPort,
+		MTU:            status.VxlanMTU,
+		UnderlayIfName: uplink,
+		UnderlayIfType: linuxitems.UplinkTypename,
+		BridgeIfName:   status.BridgeName,
+	}
+	switch vxlanCfg.Encryption {
+	case types.VxlanEncryptionNone, types.VxlanEncryptionIPsec:
+		vxlan.LocalIP = getVxlanLocalIP(ctx, uplink, vxlanCfg.UnderlayIsIPv6())
+		if vxlan.LocalIP == nil {
+			return fmt.Errorf("uplink %s has no suitable IP address for VXLAN",
+				uplink)
+		}
+		for _, peer := range vxlanCfg.Peers {
+			vxlan.Remotes = append(vxlan.Remotes, peer.Address)
+		}
+		if vxlanCfg.Encryption == types.VxlanEncryptionIPsec {
+			niConfig.IPsec = getVxlanIPsec(ctx, status, uplink, vxlan.LocalIP)
+		}
+	case types.VxlanEncryptionWireGuard:
+		uplinkMTU, err := getUplinkMTU(ctx, status)
+		if err != nil {
+			return err
+		}
+		wgMTU, err := vxlanCfg.GetWireGuardMTU(uplinkMTU)
+		if err != nil {
+			return err
+		}
+		tunnelAddr := vxlanCfg.TunnelAddress
+		wg := &linuxitems.WireGuard{
+			IfName:       wireguardIfName(status.BridgeNum),
+			PrivateKey:   ctx.vxlanSecrets[status.UUID].WireGuardPrivateKey,
+			ListenPort:   vxlanCfg.WireGuardListenPort,
+			IPAddress:    &tunnelAddr,
+			MTU:          wgMTU,
+			UplinkIfName: uplink,
+		}
+		for _, peer := range vxlanCfg.Peers {
+			tunnelIP, bits := peer.TunnelAddress, 8*net.IPv6len
+			if ip4 := tunnelIP.To4(); ip4 != nil {
+				tunnelIP, bits = ip4, 8*net.IPv4len
+			}
+			allowedIP := &net.IPNet{IP: tunnelIP, Mask: net.CIDRMask(bits, bits)}
+			wg.Peers = append(wg.Peers, linuxitems.WireGuardPeer{
+				PublicKey: peer.WireGuardPublicKey,
+				Endpoint: &net.UDPAddr{IP: peer.Address,
+					Port: int(vxlanCfg.WireGuardListenPort)},
+				AllowedIPs:          []*net.IPNet{allowedIP},
+				PersistentKeepalive: wgPersistentKeepalive,
+			})
+			vxlan.Remotes = append(vxlan.Remotes, peer.TunnelAddress)
+		}
+		niConfig.WireGuard = wg
+		vxlan.LocalIP = tunnelAddr.IP
+		vxlan.UnderlayIfName = wg.IfName
+		vxlan.UnderlayIfType = linuxitems.WireGuardTypename
+	}
+	niConfig.Vxlan = vxlan
+	niConfig.Rules = append(niConfig.Rules, vxlanIsolationRules(status, vxlan.IfName)...)
+	return nil
+}
+
+// vxlanIsolationRules returns iptables rules preventing DHCP and IPv6 router
+// discovery from crossing the VXLAN interface. Every node serves its own
+// applications. Rules are matching on the ingress side only, this is sufficient
+// to isolate the nodes from each other and unlike physdev-out it also works
+// for traffic sent by local dnsmasq/radvd.
+func vxlanIsolationRules(status *types.NetworkInstanceStatus,
+	vxlanIfName string) (rules []types.IPTablesRule) {
+
+	physdevIn := []string{"-m", "physdev", "--physdev-in", vxlanIfName}
+	ipVer := 4
+	var fwdMatches, inputMatches [][]string
+	if status.IsIPv6() {
+		ipVer = 6
+		fwdMatches = [][]string{
+			{"-p", "udp", "--dport", "546:547"},
+			{"-p", "ipv6-icmp", "--icmpv6-type", "router-advertisement"},
+		}
+		inputMatches = [][]string{
+			{"-p", "udp", "--dport", "547"},
+			{"-p", "ipv6-icmp", "--icmpv6-type", "router-solicitation"},
+		}
+	} else {
+		fwdMatches = [][]string{{"-p", "udp", "--dport", "67:68"}}
+		inputMatches = [][]string{{"-p", "udp", "--dport", "67"}}
+	}
+	for _, match := range fwdMatches {
+		rules = append(rules, types.IPTablesRule{
+			IPVer:    ipVer,
+			Chain:    "FORWARD",
+			Rule:     append(append([]string{}, physdevIn...), match...),
+			Action:   []string{"-j", "DROP"},
+			RuleName: "Block DHCP/RA from remote nodes of " + status.DisplayName,
+		})
+	}
+	for _, match := range inputMatches {
+		rules = append(rules, types.IPTablesRule{
+			IPVer: ipVer,
+			Chain: "INPUT",
+			Rule: append(append([]string{"-i", status.BridgeName},
+				physdevIn...), match...),
+			Action:   []string{"-j", "DROP"},
+			RuleName: "Block DHCP/RS from remote nodes of " + status.DisplayName,
+		})
+	}
+	return rules
+}
+
+// getVxlanMetrics collects counters of the VXLAN interface and, with
+// encryption enabled, of the traffic exchanged with every peer.
+func getVxlanMetrics(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) *types.VxlanMetrics {
+
+	metrics := &types.VxlanMetrics{
+		VNI:        status.Vxlan.VNI,
+		MTU:        status.VxlanMTU,
+		Encryption: status.Vxlan.Encryption,
+	}
+	if link, err := netlink.LinkByName(vxlanIfName(status.BridgeNum)); err == nil {
+		if stats := link.Attrs().Statistics; stats != nil {
+			metrics.TunnelStats.InPkts.Pkts = stats.RxPackets
+			metrics.TunnelStats.InPkts.Bytes = stats.RxBytes
+			metrics.TunnelStats.OutPkts.Pkts = stats.TxPackets
+			metrics.TunnelStats.OutPkts.Bytes = stats.TxBytes
+		}
+	}
+	var wgPeers map[string]wgPeerStats
+	if status.Vxlan.Encryption == types.VxlanEncryptionWireGuard {
+		var err error
+		wgPeers, err = getWireGuardPeerStats(wireguardIfName(status.BridgeNum))
+		if err != nil {
+			log.Warnf("getVxlanMetrics(%s): %v", status.DisplayName, err)
+		}
+	}
+	var localIP net.IP
+	var uplink string
+	if len(status.IfNameList) > 0 {
+		uplink = status.IfNameList[0]
+		localIP = getVxlanLocalIP(ctx, uplink, status.Vxlan.UnderlayIsIPv6())
+	}
+	for _, peer := range status.Vxlan.Peers {
+		peerMetrics := types.VxlanPeerMetrics{Address: peer.Address.String()}
+		switch status.Vxlan.Encryption {
+		case types.VxlanEncryptionIPsec:
+			if localIP == nil {
+				break
+			}
+			ipsec := linuxitems.IPsecTransport{
+				LocalIP:      localIP,
+				PeerIP:       peer.Address,
+				DstPort:      status.Vxlan.DstPort,
+				PresharedKey: ctx.vxlanSecrets[status.UUID].IPsecPresharedKey,
+			}
+			outSA, inSA := ipsec.OutboundSA(), ipsec.InboundSA()
+			if sa, err := netlink.XfrmStateGet(&outSA); err == nil {
+				peerMetrics.Stats.OutPkts.Pkts = sa.Statistics.Packets
+				peerMetrics.Stats.OutPkts.Bytes = sa.Statistics.Bytes
+			}
+			if sa, err := netlink.XfrmStateGet(&inSA); err == nil {
+				peerMetrics.Stats.InPkts.Pkts = sa.Statistics.Packets
+				peerMetrics.Stats.InPkts.Bytes = sa.Statistics.Bytes
+			}
+		case types.VxlanEncryptionWireGuard:
+			if stats, found := wgPeers[peer.WireGuardPublicKey]; found {
+				peerMetrics.Stats.InPkts.Bytes = stats.rxBytes
+				peerMetrics.Stats.OutPkts.Bytes = stats.txBytes
+				peerMetrics.LastHandshake = stats.lastHandshake
+			}
+		}
+		metrics.Peers = append(metrics.Peers, peerMetrics)
+	}
+	return metrics
+}
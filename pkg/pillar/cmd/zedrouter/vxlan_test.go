@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestVxlanIsolationRules(t *testing.T) {
+	status := &types.NetworkInstanceStatus{}
+	status.DisplayName = "ni1"
+	status.BridgeName = "bn1"
+	status.IpType = types.AddressTypeIPV4
+	rules := vxlanIsolationRules(status, "vx1")
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d: %+v", len(rules), rules)
+	}
+	for _, rule := range rules {
+		ruleStr := strings.Join(rule.Rule, " ")
+		if rule.IPVer != 4 || !strings.Contains(ruleStr, "--physdev-in vx1") {
+			t.Errorf("unexpected rule: %+v", rule)
+		}
+	}
+
+	status.IpType = types.AddressTypeIPV6
+	rules = vxlanIsolationRules(status, "vx1")
+	var blocksRA bool
+	for _, rule := range rules {
+		if rule.IPVer != 6 {
+			t.Errorf("unexpected IP version: %+v", rule)
+		}
+		if rule.Chain == "FORWARD" &&
+			strings.Contains(strings.Join(rule.Rule, " "), "router-advertisement") {
+			blocksRA = true
+		}
+	}
+	if !blocksRA {
+		t.Errorf("router advertisements from remote nodes are not blocked: %+v", rules)
+	}
+}
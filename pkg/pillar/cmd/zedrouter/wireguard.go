@@ -0,0 +1,427 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// WireGuard VPN network instance. Applications are connected to a bridge
+// with dnsmasq and metadata server, same as with a local network instance,
+// but the routing table of the NI only routes AllowedIPs of the peers
+// through the WireGuard interface, there is no direct access to the uplink
+// network. In the client mode the traffic entering the tunnel is NATed
+// to the tunnel address. In the hub mode there is no NAT and applications
+// are reachable from the peers, which are also routed to each other
+// (if their AllowedIPs permit).
+// Private key is either provided by the controller (encrypted) or generated
+// by EVE and persisted in the vault. The latter is rotated whenever
+// the controller increases the key generation or when the local profile
+// server requests a rotation.
+
+package zedrouter
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	// Keepalive interval (in seconds) used with WireGuard peers of VXLAN NI.
+	// Keeps NAT/firewall mappings along the path open.
+	wgPersistentKeepalive = 25
+	// Peer without a handshake for this long cannot exchange any traffic
+	// (Reject-After-Time of the WireGuard protocol).
+	wgHandshakeTimeout = 180 * time.Second
+	wgKeyLen           = 32
+)
+
+func wireguardIfName(bridgeNum int) string {
+	return fmt.Sprintf("wg%d", bridgeNum)
+}
+
+// wgLocalKey : key pair generated by EVE for WireGuard VPN network instance.
+// Persisted in the vault together with the generation and the local rotation
+// for which it was generated.
+type wgLocalKey struct {
+	KeyGeneration    uint32
+	LocalKeyRotation uint32
+	PrivateKey       string
+}
+
+func wgLocalKeyPath(niUUID uuid.UUID) string {
+	return filepath.Join(types.WireGuardKeysDirName, niUUID.String()+".json")
+}
+
+// generateWireGuardKey returns a new base64-encoded private key.
+func generateWireGuardKey() (string, error) {
+	key := make([]byte, wgKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	// Clamp the key as described in RFC 7748.
+	key[0] &= 248
+	key[31] = (key[31] & 127) | 64
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// wireGuardPublicKey derives the base64-encoded public key
+// from the base64-encoded private key.
+func wireGuardPublicKey(privateKey string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil || len(key) != wgKeyLen {
+		return "", errors.New("invalid WireGuard private key")
+	}
+	pubKey, err := curve25519.X25519(key, curve25519.Basepoint)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(pubKey), nil
+}
+
+// wgGetLocalKey loads the key pair generated for the network instance.
+// A new key pair is generated (and persisted) if there is none yet or if
+// the persisted one belongs to a different key generation or rotation.
+func wgGetLocalKey(status *types.NetworkInstanceStatus) (key wgLocalKey, err error) {
+	config := status.WireGuard
+	keyPath := wgLocalKeyPath(status.UUID)
+	data, err := ioutil.ReadFile(keyPath)
+	if err == nil {
+		err = json.Unmarshal(data, &key)
+	}
+	if err == nil && key.PrivateKey != "" &&
+		key.KeyGeneration == config.KeyGeneration &&
+		key.LocalKeyRotation == config.LocalKeyRotation {
+		return key, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		log.Warnf("wgGetLocalKey(%s): failed to load key: %v",
+			status.DisplayName, err)
+	}
+	if _, err := os.Stat(types.SealedDirName); err != nil {
+		return key, fmt.Errorf("vault is not available: %v", err)
+	}
+	key = wgLocalKey{
+		KeyGeneration:    config.KeyGeneration,
+		LocalKeyRotation: config.LocalKeyRotation,
+	}
+	if key.PrivateKey, err = generateWireGuardKey(); err != nil {
+		return key, fmt.Errorf("failed to generate WireGuard key: %v", err)
+	}
+	if data, err = json.Marshal(key); err != nil {
+		return key, err
+	}
+	if err = os.MkdirAll(types.WireGuardKeysDirName, 0700); err != nil {
+		return key, err
+	}
+	if err = fileutils.WriteRename(keyPath, data); err != nil {
+		return key, fmt.Errorf("failed to persist WireGuard key: %v", err)
+	}
+	log.Noticef("wgGetLocalKey(%s): generated new key pair (generation %d, "+
+		"local rotation %d)", status.DisplayName, key.KeyGeneration,
+		key.LocalKeyRotation)
+	return key, nil
+}
+
+// wgCreate prepares the private key of the network instance and the initial
+// WireGuard status.
+func wgCreate(ctx *zedrouterContext, status *types.NetworkInstanceStatus) error {
+	log.Functionf("wgCreate(%s)", status.DisplayName)
+	delete(ctx.wgPrivateKeys, status.UUID)
+	config := status.WireGuard
+	wgStatus := &types.WireGuardStatus{
+		ListenPort: config.ListenPort,
+	}
+	if status.WireGuardStatus != nil {
+		wgStatus.MTU = status.WireGuardStatus.MTU
+		wgStatus.Peers = status.WireGuardStatus.Peers
+	}
+	var privateKey string
+	if config.HasLocalKey() {
+		key, err := wgGetLocalKey(status)
+		if err != nil {
+			return err
+		}
+		privateKey = key.PrivateKey
+		wgStatus.KeyGeneration = key.KeyGeneration
+		wgStatus.LocalKeyRotation = key.LocalKeyRotation
+	} else {
+		cipherStatus, decBlock, err := cipher.GetCipherCredentials(
+			&ctx.decryptCipherContext, config.CipherBlockStatus)
+		ctx.pubCipherBlockStatus.Publish(cipherStatus.Key(), cipherStatus)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt WireGuard private key: %v", err)
+		}
+		if decBlock.WireGuardPrivateKey == "" {
+			return errors.New("missing WireGuard private key")
+		}
+		privateKey = decBlock.WireGuardPrivateKey
+		if config.LocalKeyRotation != 0 {
+			log.Warnf("wgCreate(%s): local key rotation has no effect with the key "+
+				"provided by the controller", status.DisplayName)
+		}
+		// Acknowledge the rotation anyway, otherwise it would never complete.
+		wgStatus.LocalKeyRotation = config.LocalKeyRotation
+	}
+	publicKey, err := wireGuardPublicKey(privateKey)
+	if err != nil {
+		return err
+	}
+	wgStatus.PublicKey = publicKey
+	ctx.wgPrivateKeys[status.UUID] = privateKey
+	status.WireGuardStatus = wgStatus
+	return nil
+}
+
+// wgModify applies changed WireGuard config (e.g. a new set of peers
+// or rotated key).
+func wgModify(ctx *zedrouterContext, config types.WireGuardConfig,
+	status *types.NetworkInstanceStatus) error {
+
+	status.WireGuard = config
+	if err := wgCreate(ctx, status); err != nil {
+		return err
+	}
+	if status.Activated {
+		return wgUpdateMTU(ctx, status)
+	}
+	return nil
+}
+
+// wgDelete forgets the private key. Key generated by EVE is removed
+// from the vault.
+func wgDelete(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	log.Functionf("wgDelete(%s)", status.DisplayName)
+	delete(ctx.wgPrivateKeys, status.UUID)
+	keyPath := wgLocalKeyPath(status.UUID)
+	if err := os.Remove(keyPath); err != nil && !os.IsNotExist(err) {
+		log.Errorf("wgDelete(%s): failed to remove %s: %v",
+			status.DisplayName, keyPath, err)
+	}
+}
+
+// wgActivate determines the MTU of the tunnel and installs PBR rules
+// for the NI subnet.
+func wgActivate(ctx *zedrouterContext, status *types.NetworkInstanceStatus) error {
+	log.Functionf("wgActivate(%s)", status.DisplayName)
+	if err := wgUpdateMTU(ctx, status); err != nil {
+		return err
+	}
+	return natActivate(ctx, status)
+}
+
+// wgUpdateMTU (re)computes the MTU of the tunnel, which depends on the MTU
+// of the current uplink. The MTU is advertised to applications by DHCP.
+func wgUpdateMTU(ctx *zedrouterContext, status *types.NetworkInstanceStatus) error {
+	if status.WireGuardStatus == nil {
+		return errors.New("missing WireGuard status")
+	}
+	uplinkMTU, err := getUplinkMTU(ctx, status)
+	if err != nil {
+		return err
+	}
+	mtu, err := status.WireGuard.GetMTU(uplinkMTU)
+	if err != nil {
+		return err
+	}
+	if mtu != status.WireGuardStatus.MTU {
+		log.Noticef("wgUpdateMTU(%s): MTU changed from %d to %d",
+			status.DisplayName, status.WireGuardStatus.MTU, mtu)
+		status.WireGuardStatus.MTU = mtu
+	}
+	return nil
+}
+
+// addWireGuardConfig adds WireGuard interface, routes for AllowedIPs of the peers
+// and (in the client mode) NAT into the config of the network instance.
+func addWireGuardConfig(ctx *zedrouterContext, status *types.NetworkInstanceStatus,
+	uplink string, niConfig *nireconciler.NIConfig) error {
+
+	privateKey, hasKey := ctx.wgPrivateKeys[status.UUID]
+	if !hasKey || status.WireGuardStatus == nil {
+		return errors.New("missing WireGuard private key")
+	}
+	config := status.WireGuard
+	tunnelAddr := config.TunnelAddress
+	wg := &linuxitems.WireGuard{
+		IfName:       wireguardIfName(status.BridgeNum),
+		PrivateKey:   privateKey,
+		ListenPort:   config.ListenPort,
+		IPAddress:    &tunnelAddr,
+		MTU:          status.WireGuardStatus.MTU,
+		UplinkIfName: uplink,
+	}
+	for _, peer := range config.Peers {
+		wgPeer := linuxitems.WireGuardPeer{
+			PublicKey:           peer.PublicKey,
+			Endpoint:            peer.Endpoint,
+			PersistentKeepalive: peer.PersistentKeepalive,
+		}
+		for i := range peer.AllowedIPs {
+			allowedIP := peer.AllowedIPs[i]
+			wgPeer.AllowedIPs = append(wgPeer.AllowedIPs, &allowedIP)
+		}
+		wg.Peers = append(wg.Peers, wgPeer)
+	}
+	niConfig.WireGuard = wg
+	niConfig.WireGuardRoutes = true
+	if config.Mode == types.WireGuardModeClient {
+		niConfig.Rules = append(niConfig.Rules, types.IPTablesRule{
+			IPVer:    4,
+			Table:    "nat",
+			Chain:    "POSTROUTING",
+			Rule:     []string{"-o", wg.IfName, "-s", status.Subnet.String()},
+			Action:   []string{"-j", "MASQUERADE"},
+			RuleName: "SNAT traffic entering WireGuard tunnel of " + status.DisplayName,
+		})
+	}
+	return nil
+}
+
+// wgStatusGet refreshes the state of the peers.
+// Returns true if the status changed enough to be re-published, i.e. if any peer
+// changed its endpoint, connectivity or did a new handshake. Transfer counters
+// alone do not trigger publication.
+func wgStatusGet(status *types.NetworkInstanceStatus) bool {
+	if status.Type != types.NetworkInstanceTypeWireGuard ||
+		status.WireGuardStatus == nil {
+		return false
+	}
+	ifName := wireguardIfName(status.BridgeNum)
+	dump, err := getWireGuardPeerStats(ifName)
+	if err != nil {
+		// Peers are reported as disconnected.
+		log.Warnf("wgStatusGet(%s): %v", status.DisplayName, err)
+	}
+	now := time.Now()
+	var peers []types.WireGuardPeerStatus
+	for _, peer := range status.WireGuard.Peers {
+		peerStatus := types.WireGuardPeerStatus{PublicKey: peer.PublicKey}
+		if peer.Endpoint != nil {
+			peerStatus.Endpoint = peer.Endpoint.String()
+		}
+		if stats, found := dump[peer.PublicKey]; found {
+			if stats.endpoint != "" {
+				peerStatus.Endpoint = stats.endpoint
+			}
+			peerStatus.LastHandshake = stats.lastHandshake
+			peerStatus.Connected = !stats.lastHandshake.IsZero() &&
+				now.Sub(stats.lastHandshake) < wgHandshakeTimeout
+			peerStatus.RxBytes = stats.rxBytes
+			peerStatus.TxBytes = stats.txBytes
+		}
+		peers = append(peers, peerStatus)
+	}
+	changed := len(peers) != len(status.WireGuardStatus.Peers)
+	for i := 0; !changed && i < len(peers); i++ {
+		prev := status.WireGuardStatus.Peers[i]
+		changed = prev.PublicKey != peers[i].PublicKey ||
+			prev.Endpoint != peers[i].Endpoint ||
+			prev.Connected != peers[i].Connected ||
+			!prev.LastHandshake.Equal(peers[i].LastHandshake)
+	}
+	status.WireGuardStatus.Peers = peers
+	return changed
+}
+
+type wgPeerStats struct {
+	// endpoint : empty if not known yet.
+	endpoint      string
+	lastHandshake time.Time
+	rxBytes       uint64
+	txBytes       uint64
+}
+
+// getWireGuardPeerStats returns statistics of WireGuard peers, indexed
+// by their public keys.
+func getWireGuardPeerStats(ifName string) (map[string]wgPeerStats, error) {
+	out, err := exec.Command("/usr/bin/wg", "show", ifName, "dump").Output()
+	if err != nil {
+		return nil, fmt.Errorf("wg show %s dump failed: %v", ifName, err)
+	}
+	return parseWireGuardDump(string(out)), nil
+}
+
+// parseWireGuardDump parses output of "wg show <interface> dump".
+// The first line describes the interface itself, every following line
+// describes one peer with tab-separated fields: public-key, preshared-key,
+// endpoint, allowed-ips, latest-handshake, transfer-rx, transfer-tx,
+// persistent-keepalive.
+func parseWireGuardDump(dump string) map[string]wgPeerStats {
+	peers := make(map[string]wgPeerStats)
+	scanner := bufio.NewScanner(strings.NewReader(dump))
+	firstLine := true
+	for scanner.Scan() {
+		if firstLine {
+			firstLine = false
+			continue
+		}
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) < 7 {
+			continue
+		}
+		var stats wgPeerStats
+		if fields[2] != "(none)" {
+			stats.endpoint = fields[2]
+		}
+		if handshake, err := strconv.ParseInt(fields[4], 10, 64); err == nil &&
+			handshake > 0 {
+			stats.lastHandshake = time.Unix(handshake, 0)
+		}
+		stats.rxBytes, _ = strconv.ParseUint(fields[5], 10, 64)
+		stats.txBytes, _ = strconv.ParseUint(fields[6], 10, 64)
+		peers[fields[0]] = stats
+	}
+	return peers
+}
+
+// wgListenPort returns the UDP port on which WireGuard interface
+// of the network instance listens. Zero if there is no such interface
+// or if the port is picked by the kernel.
+func wgListenPort(status *types.NetworkInstanceStatus) uint16 {
+	switch status.Type {
+	case types.NetworkInstanceTypeVXLAN:
+		if status.Vxlan.Encryption == types.VxlanEncryptionWireGuard {
+			return status.Vxlan.WireGuardListenPort
+		}
+	case types.NetworkInstanceTypeWireGuard:
+		return status.WireGuard.ListenPort
+	}
+	return 0
+}
+
+// checkWireGuardListenPort returns error if another network instance
+// already uses the same WireGuard listen port.
+func checkWireGuardListenPort(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	port := wgListenPort(status)
+	if port == 0 {
+		return nil
+	}
+	var err error
+	ctx.networkInstanceStatusMap.Range(func(key, value interface{}) bool {
+		other := value.(*types.NetworkInstanceStatus)
+		if other == status || wgListenPort(other) != port {
+			return true
+		}
+		err = fmt.Errorf("WireGuard listen port %d is already used by "+
+			"network instance %s", port, other.DisplayName)
+		return false
+	})
+	return err
+}
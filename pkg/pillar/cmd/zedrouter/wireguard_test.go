@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseWireGuardDump(t *testing.T) {
+	dump := "cHJpdmF0ZQ==\tcHVibGlj\t51820\toff\n" +
+		"cGVlcjE=\t(none)\t192.168.20.5:51820\t10.99.0.2/32\t1680000000\t1024\t2048\t25\n" +
+		"cGVlcjI=\t(none)\t(none)\t10.99.0.3/32\t0\t0\t0\t25\n"
+	peers := parseWireGuardDump(dump)
+	if len(peers) != 2 {
+		t.Fatalf("expected 2 peers, got %d: %+v", len(peers), peers)
+	}
+	peer1 := peers["cGVlcjE="]
+	if peer1.rxBytes != 1024 || peer1.txBytes != 2048 {
+		t.Errorf("unexpected counters of peer1: %+v", peer1)
+	}
+	if !peer1.lastHandshake.Equal(time.Unix(1680000000, 0)) {
+		t.Errorf("unexpected handshake time of peer1: %v", peer1.lastHandshake)
+	}
+	if peer1.endpoint != "192.168.20.5:51820" {
+		t.Errorf("unexpected endpoint of peer1: %s", peer1.endpoint)
+	}
+	peer2 := peers["cGVlcjI="]
+	if !peer2.lastHandshake.IsZero() {
+		t.Errorf("peer2 never did a handshake, got %v", peer2.lastHandshake)
+	}
+	if peer2.endpoint != "" {
+		t.Errorf("endpoint of peer2 is not known, got %s", peer2.endpoint)
+	}
+}
+
+func TestWireGuardPublicKey(t *testing.T) {
+	// Test vector from RFC 7748, section 6.1.
+	privateKey := "dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo="
+	publicKey, err := wireGuardPublicKey(privateKey)
+	if err != nil {
+		t.Fatalf("wireGuardPublicKey failed: %v", err)
+	}
+	if publicKey != "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=" {
+		t.Errorf("unexpected public key: %s", publicKey)
+	}
+	if _, err = wireGuardPublicKey("c2hvcnQ="); err == nil {
+		t.Errorf("expected error for a short key")
+	}
+
+	generated, err := generateWireGuardKey()
+	if err != nil {
+		t.Fatalf("generateWireGuardKey failed: %v", err)
+	}
+	if _, err = wireGuardPublicKey(generated); err != nil {
+		t.Errorf("generated key is not valid: %v", err)
+	}
+}
+
+func TestWireGuardWithoutKernelSupport(t *testing.T) {
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedrouter", 1234)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.NetworkInstanceStatus{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubApps, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.AppNetworkStatus{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &zedrouterContext{
+		pubAppNetworkStatus:      pubApps,
+		pubNetworkInstanceStatus: pub,
+		deviceNetworkStatus:      &types.DeviceNetworkStatus{},
+		wgPrivateKeys:            make(map[uuid.UUID]string),
+		niReconcileErrors:        make(map[uuid.UUID]types.ErrorAndTime),
+	}
+	niUUID := uuid.FromStringOrNil("0d6a128b-b36f-4bd0-a71c-087ba2d71ebc")
+	status := types.NetworkInstanceStatus{
+		NetworkInstanceConfig: types.NetworkInstanceConfig{
+			UUIDandVersion: types.UUIDandVersion{UUID: niUUID},
+			Type:           types.NetworkInstanceTypeWireGuard,
+		},
+		NetworkInstanceInfo: types.NetworkInstanceInfo{
+			BridgeName: "bn1",
+			BridgeNum:  1,
+			IfNameList: []string{"eth0"},
+		},
+		Activated:       true,
+		WireGuardStatus: &types.WireGuardStatus{},
+	}
+	publishNetworkInstanceStatus(ctx, &status)
+	getReconcileErr := func() types.ErrorAndTime {
+		published := lookupNetworkInstanceStatus(ctx, niUUID.String())
+		if published == nil {
+			t.Fatal("NetworkInstanceStatus is not published")
+		}
+		return published.ReconcileErr
+	}
+
+	// WireGuard config cannot be built without the private key.
+	_, buildErrs := getNIReconcilerArgs(ctx)
+	if buildErrs[niUUID] == nil {
+		t.Fatal("expected error building WireGuard config without private key")
+	}
+	updateNIReconcileErrors(ctx, buildErrs, nireconciler.ReconcileStatus{})
+	if reconcileErr := getReconcileErr(); !reconcileErr.HasError() {
+		t.Errorf("build error is not reported in NetworkInstanceStatus")
+	}
+
+	// Kernel without WireGuard support fails to create the interface.
+	ctx.wgPrivateKeys[niUUID] = "dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo="
+	args, buildErrs := getNIReconcilerArgs(ctx)
+	if len(buildErrs) != 0 {
+		t.Fatalf("unexpected build errors: %v", buildErrs)
+	}
+	if len(args.NIs) != 1 || args.NIs[0].WireGuard == nil {
+		t.Fatalf("expected WireGuard config in NIReconciler args: %+v", args)
+	}
+	linkErr := "netlink.LinkAdd(wg1) failed: operation not supported"
+	reconcileStatus := nireconciler.ReconcileStatus{
+		Error: errors.New("failed to apply config"),
+		NIs: map[uuid.UUID]nireconciler.NIStatus{
+			niUUID: {Error: errors.New(linkErr)},
+		},
+	}
+	updateNIReconcileErrors(ctx, buildErrs, reconcileStatus)
+	reconcileErr := getReconcileErr()
+	if reconcileErr.Error != linkErr {
+		t.Errorf("expected reconcile error %q, got %q", linkErr, reconcileErr.Error)
+	}
+
+	// Error is kept when the status is published by a handler.
+	publishNetworkInstanceStatus(ctx, &status)
+	if getReconcileErr().Error != linkErr {
+		t.Errorf("reconcile error lost when NetworkInstanceStatus was republished")
+	}
+
+	// Same error does not change the error time.
+	updateNIReconcileErrors(ctx, buildErrs, reconcileStatus)
+	if !getReconcileErr().ErrorTime.Equal(reconcileErr.ErrorTime) {
+		t.Errorf("error time changed while the error remained the same")
+	}
+
+	// Error is cleared once the config is applied.
+	reconcileStatus = nireconciler.ReconcileStatus{
+		NIs: map[uuid.UUID]nireconciler.NIStatus{niUUID: {}},
+	}
+	updateNIReconcileErrors(ctx, buildErrs, reconcileStatus)
+	if reconcileErr = getReconcileErr(); reconcileErr.HasError() {
+		t.Errorf("reconcile error was not cleared")
+	}
+	if len(ctx.niReconcileErrors) != 0 {
+		t.Errorf("unexpected reconcile errors: %v", ctx.niReconcileErrors)
+	}
+}
@@ -3,9 +3,9 @@
 
 // Process input in the form of a collection of AppNetworkConfig structs
 // from zedmanager and zedagent. Publish the status as AppNetworkStatus.
-// Produce the updated configlets (for radvd, dnsmasq, ip*tables,
-// ipset, ip link/addr/route configuration) based on that and apply those
-// configlets.
+// Network instances and application connections are applied into
+// the network stack (bridges, dnsmasq, radvd, ip*tables, routes) by NIReconciler,
+// remaining configlets (ipset, hosts, ip rules) are applied directly.
 
 package zedrouter
 
@@ -27,6 +27,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -91,6 +92,8 @@ type zedrouterContext struct {
 	networkInstanceStatusMap  sync.Map
 	NLaclMap                  map[uuid.UUID]map[string]types.ULNetworkACLs // app uuid plus bridge ul name
 	dnsServers                map[string][]net.IP                          // Key is ifname
+	vxlanSecrets              map[uuid.UUID]types.EncryptionBlock          // Key is NI UUID
+	wgPrivateKeys             map[uuid.UUID]string                         // Key is NI UUID
 	checkNIUplinks            chan bool
 	hostProbeTimer            *time.Timer
 	hostFastProbe             bool
@@ -104,6 +107,14 @@ type zedrouterContext struct {
 	metricInterval            uint32 // In seconds
 	iptablesInitialized       bool   // iptablesInitialized from nim
 
+	// Applies network instance config into the network stack
+	niReconciler      *nireconciler.LinuxNIReconciler
+	niReconcileStatus nireconciler.ReconcileStatus
+	// Errors of building or applying NI config, published in NetworkInstanceStatus
+	niReconcileErrors map[uuid.UUID]types.ErrorAndTime // Key is NI UUID
+	// Ipsets to destroy once no longer referenced by iptables rules
+	staleIpsets []string
+
 	zedcloudMetrics *zedcloud.AgentMetrics
 	cipherMetrics   *cipher.AgentMetrics
 
@@ -139,6 +150,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		assignableAdapters: &aa,
 		agentStartTime:     time.Now(),
 		dnsServers:         make(map[string][]net.IP),
+		vxlanSecrets:       make(map[uuid.UUID]types.EncryptionBlock),
+		wgPrivateKeys:      make(map[uuid.UUID]string),
+		niReconcileErrors:  make(map[uuid.UUID]types.ErrorAndTime),
 		aclog:              agentlog.CustomLogInit(logrus.InfoLevel),
 		NLaclMap:           make(map[uuid.UUID]map[string]types.ULNetworkACLs),
 		flowPublishMap:     make(map[string]time.Time),
@@ -185,6 +199,8 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	// Create the dummy interface used to re-direct DROP/REJECT packets.
 	createFlowMonDummyInterface()
 
+	initNIReconciler(&zedrouterCtx)
+
 	subDeviceNetworkStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "nim",
 		MyAgentName:   agentName,
@@ -591,7 +607,6 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	}
 
 	PbrInit(&zedrouterCtx)
-	routeChanges := devicenetwork.RouteChangeInit(log)
 	linkChanges := devicenetwork.LinkChangeInit(log)
 
 	interval := time.Duration(zedrouterCtx.metricInterval) * time.Second
@@ -719,16 +734,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 			ps.CheckMaxTimeTopic(agentName, "linkChanges", start,
 				warningTime, errorTime)
 
-		case change, ok := <-routeChanges:
+		case <-zedrouterCtx.niReconcileStatus.ResumeReconcile:
 			start := time.Now()
-			if !ok {
-				log.Errorf("routeChanges closed\n")
-				routeChanges = devicenetwork.RouteChangeInit(log)
-				break
-			}
-			PbrRouteChange(&zedrouterCtx,
-				zedrouterCtx.deviceNetworkStatus, change)
-			ps.CheckMaxTimeTopic(agentName, "routeChanges", start,
+			reconcileNIs(&zedrouterCtx)
+			ps.CheckMaxTimeTopic(agentName, "reconcileNIs", start,
 				warningTime, errorTime)
 
 		case <-zedrouterCtx.publishTicker.C:
@@ -905,6 +914,7 @@ func handleAppNetworkConfigDelete(ctxArg interface{}, key string,
 		return
 	}
 	handleDelete(ctx, key, status)
+	reconcileNIs(ctx)
 	log.Functionf("handleAppNetworkConfigDelete(%s) done\n", key)
 	// on resource release, check whether any one else
 	// needs it
@@ -935,6 +945,11 @@ func lookupAppNetworkStatusByAppIP(ctx *zedrouterContext, ip net.IP) *types.AppN
 			if ipStr == ulStatus.AllocatedIPv4Addr {
 				return &status
 			}
+			for _, ipv6Addr := range ulStatus.AllocatedIPv6List {
+				if ipStr == ipv6Addr {
+					return &status
+				}
+			}
 		}
 	}
 	return nil
@@ -1012,6 +1027,7 @@ func handleAppNetworkCreate(ctxArg interface{}, key string, configArg interface{
 	if status.HasError() && config.Activate && !status.Activated {
 		releaseAppNetworkResources(ctx, key, &status)
 	}
+	reconcileNIs(ctx)
 	log.Functionf("handleAppNetworkCreate(%s) done\n", key)
 	// on resource release, check whether any one else
 	// needs it
@@ -1182,7 +1198,7 @@ func appNetworkDoActivateUnderlayNetwork(
 	}
 	// Fetch the network that this underlay is attached to
 	bridgeName := netInstStatus.BridgeName
-	vifName := "nbu" + strconv.Itoa(ulNum) + "x" +
+	vifName := vifPrefix + strconv.Itoa(ulNum) + "x" +
 		strconv.Itoa(status.AppNum)
 	uLink, err := findBridge(bridgeName)
 	if err != nil {
@@ -1274,41 +1290,19 @@ func appNetworkDoActivateUnderlayNetwork(
 	}
 	setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
 
-	if appIPAddr != "" {
-		// XXX clobber any IPv6 EID entry since same name
-		// but that's probably OK since we're doing IPv4 EIDs
-		addhostDnsmasq(bridgeName, appMac, appIPAddr,
-			config.UUIDandVersion.UUID.String())
-	}
-
-	// Look for added or deleted ipsets
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netInstStatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netInstStatus, hostsDirpath,
-			newIpsets, netInstStatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
+	// Look for added or deleted ipsets.
+	// DHCP host entry for the app and ipsets are configured into dnsmasq
+	// by NIReconciler (based on Vifs and BridgeIPSets).
+	newIpsets, staleIpsets := diffIpsets(ipsets, netInstStatus.BridgeIPSets)
 	netInstStatus.AddVif(log, vifName, appMac,
 		config.UUIDandVersion.UUID)
 	netInstStatus.BridgeIPSets = newIpsets
 	log.Functionf("set BridgeIPSets to %v for %s", newIpsets,
 		netInstStatus.Key())
 
-	// Check App Container Stats ACL need to be reinstalled
-	appStatsMayNeedReinstallACL(ctx, config)
-
 	publishNetworkInstanceStatus(ctx, netInstStatus)
 
-	maybeRemoveStaleIpsets(staleIpsets)
+	maybeRemoveStaleIpsets(ctx, staleIpsets)
 	return nil
 }
 
@@ -1350,7 +1344,10 @@ func generateAppMac(appUUID uuid.UUID, ulNum int, appNum int,
 	case types.NetworkInstanceTypeSwitch:
 		mac := net.HardwareAddr{0x02, 0x16, 0x3e, hash[0], hash[1], hash[2]}
 		return mac.String()
-	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeCloud:
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeCloud,
+		types.NetworkInstanceTypeVXLAN, types.NetworkInstanceTypeWireGuard:
+		// With VXLAN network instance the collision would also be a problem
+		// from the networking point of view - the L2 segment spans multiple nodes.
 		mac := net.HardwareAddr{hash[0], hash[1], hash[2], hash[3], hash[4], hash[5]}
 		// Mark this MAC address as unicast by setting the I/G bit to zero.
 		mac[0] &= ^byte(1)
@@ -1454,6 +1451,7 @@ func checkAndRecreateAppNetwork(ctx *zedrouterContext, niStatus types.NetworkIns
 		log.Functionf("checkAndRecreateAppNetwork(%s) try remove error %s for %s",
 			niStatus.Key(), status.Error, status.DisplayName)
 		doActivate(ctx, *config, &status) // If error we will try again
+		reconcileNIs(ctx)
 		log.Functionf("checkAndRecreateAppNetwork(%s) done for %s\n",
 			niStatus.Key(), config.DisplayName)
 	}
@@ -1688,6 +1686,7 @@ func handleAppNetworkModify(ctxArg interface{}, key string,
 		config.Activate && !status.Activated {
 		releaseAppNetworkResources(ctx, key, status)
 	}
+	reconcileNIs(ctx)
 	log.Functionf("handleAppNetworkModify(%s) done\n", key)
 	// on resource release, check whether any one else
 	// needs it
@@ -1782,27 +1781,12 @@ func doAppNetworkModifyUNetAcls(
 	ulStatus.ACLDependList = dependList
 	setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
 
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netstatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
+	newIpsets, staleIpsets := diffIpsets(ipsets, netstatus.BridgeIPSets)
 	netstatus.BridgeIPSets = newIpsets
 	log.Functionf("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
 	publishNetworkInstanceStatus(ctx, netstatus)
 
-	maybeRemoveStaleIpsets(staleIpsets)
+	maybeRemoveStaleIpsets(ctx, staleIpsets)
 }
 
 // Check if any references to network instances changed and update the appnums
@@ -1865,11 +1849,19 @@ func doAppNetworkModifyUNetAppNum(
 	return nil
 }
 
-func maybeRemoveStaleIpsets(staleIpsetHosts []string) {
-	// Remove stale ipsets previously created for ACLs with the "host" match.
+// maybeRemoveStaleIpsets schedules removal of stale ipsets previously created
+// for ACLs with the "host" match. Ipsets are destroyed by removeStaleIpsets
+// after NIReconciler has removed iptables rules referencing them.
+func maybeRemoveStaleIpsets(ctx *zedrouterContext, staleIpsetHosts []string) {
+	ctx.staleIpsets = append(ctx.staleIpsets, staleIpsetHosts...)
+}
+
+func removeStaleIpsets(ctx *zedrouterContext) {
 	// In case if there are any references to these ipsets from other
 	// domUs, then the kernel would not remove them.
 	// The ipset destroy command would just fail.
+	staleIpsetHosts := ctx.staleIpsets
+	ctx.staleIpsets = nil
 	for _, host := range staleIpsetHosts {
 		ipsetBasename := hostIpsetBasename(host)
 		err := ipsetDestroy(fmt.Sprintf("ipv4.%s", ipsetBasename))
@@ -1990,25 +1982,11 @@ func appNetworkDoInactivateUnderlayNetwork(
 		}
 	}
 
-	appIPAddr := ulStatus.AllocatedIPv4Addr
-	if appIPAddr != "" {
-		removehostDnsmasq(bridgeName, ulStatus.Mac,
-			appIPAddr)
-	}
-
 	appID := status.UUIDandVersion.UUID
-	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: bridgeName,
-		VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIPAddr,
-		UpLinks: netstatus.IfNameList}
 
 	// XXX Could ulStatus.Vif not be set? Means we didn't add
 	if ulStatus.Vif != "" {
-		rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-		ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-		if err != nil {
-			addError(ctx, status, "deleteACL", err)
-		}
-		setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+		setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 	} else {
 		log.Warnf("doInactivate(%s): no vifName for bridge %s for %s\n",
 			status.UUIDandVersion, bridgeName,
@@ -2020,21 +1998,7 @@ func appNetworkDoInactivateUnderlayNetwork(
 	removeFromHostsConfiglet(hostsDirpath,
 		status.DisplayName)
 	// Look for added or deleted ipsets
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netstatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
+	newIpsets, staleIpsets := diffIpsets(ipsets, netstatus.BridgeIPSets)
 	if netstatus.Type == types.NetworkInstanceTypeSwitch {
 		if ulStatus.AccessVlanID <= 1 {
 			netstatus.NumTrunkPorts--
@@ -2050,7 +2014,7 @@ func appNetworkDoInactivateUnderlayNetwork(
 	}
 	netstatus.BridgeIPSets = newIpsets
 	log.Functionf("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
-	maybeRemoveStaleIpsets(staleIpsets)
+	maybeRemoveStaleIpsets(ctx, staleIpsets)
 
 	netstatus.RemoveVif(log, ulStatus.Vif)
 	publishNetworkInstanceStatus(ctx, netstatus)
@@ -2087,6 +2051,9 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		ctx.GCInitialized = true
 		ctx.appStatsInterval = gcp.GlobalValueInt(types.AppContainerStatsInterval)
 		ctx.disableDHCPAllOnesNetMask = gcp.GlobalValueBool(types.DisableDHCPAllOnesNetMask)
+		exportState := gcp.GlobalValueBool(types.ExportNetworkInstanceState)
+		ctx.niReconciler.ExportCurrentState = exportState
+		ctx.niReconciler.ExportIntendedState = exportState
 		metricInterval := gcp.GlobalValueInt(types.MetricInterval)
 		if metricInterval != 0 && ctx.metricInterval != metricInterval {
 			if ctx.publishTicker != nil {
@@ -2183,8 +2150,8 @@ func handleDNSImpl(ctxArg interface{}, key string,
 	// if we received DeviceNetworkStatus it indicates that default iptables chains allocated
 	if !ctx.iptablesInitialized {
 		// Setup initial iptables rules
-		dropEscapedFlows()
 		ctx.iptablesInitialized = true
+		reconcileNIs(ctx)
 	}
 
 	// Ignore test status and timestamps
@@ -2195,9 +2162,7 @@ func handleDNSImpl(ctxArg interface{}, key string,
 	log.Functionf("handleDNSImpl: changed %v",
 		cmp.Diff(ctx.deviceNetworkStatus, status))
 
-	if isDNSServerChanged(ctx, &status) {
-		doDnsmasqRestart(ctx)
-	}
+	recordDNSServers(ctx, &status)
 
 	changedDepend := changedACLDepend(ctx, *ctx.deviceNetworkStatus,
 		status)
@@ -2209,10 +2174,14 @@ func handleDNSImpl(ctxArg interface{}, key string,
 		updateACLIPAddr(ctx, changedDepend)
 	}
 
+	// Follow changes of IPv6 prefixes delegated to uplinks
+	maybeUpdateDelegatedPrefixes(ctx)
 	// Look for ports which disappeared
 	maybeRetryNetworkInstances(ctx)
 	propagateNetworkInstToAppNetwork(ctx)
 	handleMetaDataServerChange(ctx, &status)
+	// Update upstream DNS servers of dnsmasq, etc.
+	reconcileNIs(ctx)
 	log.Functionf("handleDNSImpl done for %s\n", key)
 }
 
@@ -2506,66 +2475,36 @@ func releaseAppNetworkResources(ctx *zedrouterContext, key string,
 	log.Functionf("relaseAppNetworkResources(%s)\n", key)
 	appID := status.UUIDandVersion.UUID
 	for _, ulStatus := range status.UnderlayNetworkList {
-		aclArgs := types.AppNetworkACLArgs{BridgeName: ulStatus.Bridge,
-			VifName: ulStatus.Vif}
-		rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-		ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-		if err != nil {
-			addError(ctx, status, "deleteACL", err)
-		}
-		setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+		setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 	}
 	publishAppNetworkStatus(ctx, status)
 }
 
-func isDNSServerChanged(ctx *zedrouterContext, newStatus *types.DeviceNetworkStatus) bool {
-	var dnsDiffer bool
+func recordDNSServers(ctx *zedrouterContext, newStatus *types.DeviceNetworkStatus) {
 	for _, port := range newStatus.Ports {
-		if _, ok := ctx.dnsServers[port.IfName]; !ok {
-			// if dnsServer does not have valid server IPs, assign now
-			// and if we lose uplink connection, it will not overwrite the previous server IPs
-			if len(port.DNSServers) > 0 { // just assigned
-				ctx.dnsServers[port.IfName] = port.DNSServers
-			}
-		} else {
-			// only check if we have valid new DNS server sets on the uplink
-			// valid DNS server IP changes will trigger the restart of dnsmasq.
-			if len(port.DNSServers) != 0 {
-				// new one has different entries, and not the Internet disconnect case
-				if len(ctx.dnsServers[port.IfName]) != len(port.DNSServers) {
-					ctx.dnsServers[port.IfName] = port.DNSServers
-					dnsDiffer = true
-					continue
-				}
-				for idx, server := range port.DNSServers { // compare each one and update if changed
-					if server.Equal(ctx.dnsServers[port.IfName][idx]) == false {
-						log.Functionf("isDnsServerChanged: intf %s exist %v, new %v\n",
-							port.IfName, ctx.dnsServers[port.IfName], port.DNSServers)
-						ctx.dnsServers[port.IfName] = port.DNSServers
-						dnsDiffer = true
-						break
-					}
-				}
-
-			}
+		// only record valid DNS server sets; if we lose uplink connection,
+		// the previous server IPs are kept and used by dnsmasq.
+		if len(port.DNSServers) == 0 {
+			continue
+		}
+		if !equalIPLists(ctx.dnsServers[port.IfName], port.DNSServers) {
+			log.Functionf("recordDNSServers: intf %s exist %v, new %v\n",
+				port.IfName, ctx.dnsServers[port.IfName], port.DNSServers)
+			ctx.dnsServers[port.IfName] = port.DNSServers
 		}
 	}
-	return dnsDiffer
 }
 
-func doDnsmasqRestart(ctx *zedrouterContext) {
-	pub := ctx.pubNetworkInstanceStatus
-	stList := pub.GetAll()
-	for _, st := range stList {
-		status := st.(types.NetworkInstanceStatus)
-		if status.Type != types.NetworkInstanceTypeLocal {
-			continue
-		}
-		if status.Activated {
-			log.Functionf("restart dnsmasq on bridgename %s\n", status.BridgeName)
-			restartDnsmasq(ctx, &status)
+func equalIPLists(ips1, ips2 []net.IP) bool {
+	if len(ips1) != len(ips2) {
+		return false
+	}
+	for i := range ips1 {
+		if !ips1[i].Equal(ips2[i]) {
+			return false
 		}
 	}
+	return true
 }
 
 // XXX: Dead code. May be useful when we do wireguard/tailscale
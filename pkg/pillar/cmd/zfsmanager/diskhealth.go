@@ -0,0 +1,407 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/hardware"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils/disks"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+)
+
+const (
+	diskHealthInterval = time.Hour // interval between readings of SMART attributes
+	// diskHealthDir keeps history of SMART attributes of every disk across reboots
+	diskHealthDir = types.DiskHealthDirname
+	// maximal size of the history file, well above 30 days of hourly samples
+	maxDiskHealthHistorySize = 1024 * 1024
+
+	// diskHealthHistoryPeriod - older samples are dropped from the history
+	diskHealthHistoryPeriod = 30 * 24 * time.Hour
+	// diskHealthTrendWindow - growth of error counters within this window is evaluated
+	diskHealthTrendWindow = 7 * 24 * time.Hour
+	// diskHealthSustainedSamples - number of the last samples which must all exceed
+	// the temperature limit, so that a short spike does not raise the risk
+	diskHealthSustainedSamples = 3
+
+	nvmeWearElevated = 80 // percentage used of rated endurance
+	nvmeWearHigh     = 95
+	// nvmeWearOutHorizon - high risk if the disk is projected to reach its rated endurance sooner
+	nvmeWearOutHorizon = 30 * 24 * time.Hour
+)
+
+// diskCounterLimit defines when growing error counter makes the disk likely to fail.
+// Any non-zero value of these counters is an elevated risk.
+type diskCounterLimit struct {
+	id         int    // SMART attribute ID
+	name       string // used in the error description
+	high       int    // value considered as high risk
+	highGrowth int    // growth within diskHealthTrendWindow considered as high risk
+}
+
+var diskCounterLimits = []diskCounterLimit{
+	{id: types.SmartAttrIDRealLocatedSectorCt, name: "reallocated sectors", high: 100, highGrowth: 10},
+	{id: types.SmartAttrIDCurrentPendingSectorCt, name: "pending sectors", high: 10, highGrowth: 5},
+	{id: types.SmartAttrIDReportedUncorrect, name: "uncorrectable errors", high: 10, highGrowth: 5},
+	{id: types.SmartAttrIDNVMeMediaErrors, name: "media errors", high: 10, highGrowth: 5},
+}
+
+// diskHealthAttrs are SMART attributes kept in the history
+var diskHealthAttrs = []int{
+	types.SmartAttrIDRealLocatedSectorCt,
+	types.SmartAttrIDCurrentPendingSectorCt,
+	types.SmartAttrIDReportedUncorrect,
+	types.SmartAttrIDNVMeMediaErrors,
+	types.SmartAttrIDNVMePercentageUsed,
+	types.SmartAttrIDTemperatureCelsius,
+}
+
+// diskTemperatureLimits returns sustained temperature in Celsius considered as elevated
+// and high risk. NVMe disks are designed to run hotter than rotating ones.
+func diskTemperatureLimits(diskType types.DiskType) (elevated, high int) {
+	if diskType == types.SmartDiskTypeNvme {
+		return 70, 80
+	}
+	return 55, 65
+}
+
+// diskHealthSample - values of SMART attributes of the disk indexed by attribute ID
+type diskHealthSample struct {
+	Time   time.Time
+	Values map[int]int
+}
+
+// diskHealthHistory is stored in diskHealthDir for every disk
+type diskHealthHistory struct {
+	DiskType types.DiskType
+	Samples  []diskHealthSample
+}
+
+// evaluateDiskHealth returns failure risk of the disk with the reasons for it
+// based on the history of its SMART attributes, the last sample is the current one
+func evaluateDiskHealth(history diskHealthHistory) (types.DiskHealthRisk, []string) {
+	if len(history.Samples) == 0 {
+		return types.DiskHealthRiskUnspecified, nil
+	}
+	last := history.Samples[len(history.Samples)-1]
+	if len(last.Values) == 0 {
+		return types.DiskHealthRiskUnspecified, nil
+	}
+	risk := types.DiskHealthRiskLow
+	var reasons []string
+	raise := func(r types.DiskHealthRisk, format string, args ...interface{}) {
+		if r > risk {
+			risk = r
+		}
+		reasons = append(reasons, fmt.Sprintf(format, args...))
+	}
+
+	for _, limit := range diskCounterLimits {
+		value, ok := last.Values[limit.id]
+		if !ok {
+			continue
+		}
+		base, since := oldestDiskHealthValue(history.Samples, limit.id,
+			last.Time.Add(-diskHealthTrendWindow))
+		growth := value - base
+		switch {
+		case value >= limit.high:
+			raise(types.DiskHealthRiskHigh, "%d %s", value, limit.name)
+		case growth >= limit.highGrowth:
+			raise(types.DiskHealthRiskHigh, "%s grew from %d to %d since %s",
+				limit.name, base, value, since.UTC().Format(time.RFC3339))
+		case growth > 0:
+			raise(types.DiskHealthRiskElevated, "%s grew from %d to %d since %s",
+				limit.name, base, value, since.UTC().Format(time.RFC3339))
+		case value > 0:
+			raise(types.DiskHealthRiskElevated, "%d %s", value, limit.name)
+		}
+	}
+
+	if wear, ok := last.Values[types.SmartAttrIDNVMePercentageUsed]; ok {
+		base, since := oldestDiskHealthValue(history.Samples,
+			types.SmartAttrIDNVMePercentageUsed, last.Time.Add(-diskHealthHistoryPeriod))
+		var wearOut time.Duration
+		if elapsed := last.Time.Sub(since); wear > base && wear < 100 && elapsed >= 24*time.Hour {
+			wearOut = time.Duration(float64(elapsed) * float64(100-wear) / float64(wear-base))
+		}
+		switch {
+		case wear >= nvmeWearHigh:
+			raise(types.DiskHealthRiskHigh, "%d%% of rated endurance used", wear)
+		case wearOut != 0 && wearOut < nvmeWearOutHorizon:
+			raise(types.DiskHealthRiskHigh, "%d%% of rated endurance used, all in %d days at the current rate",
+				wear, int(wearOut.Hours()/24)+1)
+		case wear >= nvmeWearElevated:
+			raise(types.DiskHealthRiskElevated, "%d%% of rated endurance used", wear)
+		}
+	}
+
+	elevatedTemp, highTemp := diskTemperatureLimits(history.DiskType)
+	var temps []int
+	for i := len(history.Samples) - 1; i >= 0 && len(temps) < diskHealthSustainedSamples; i-- {
+		if temp, ok := history.Samples[i].Values[types.SmartAttrIDTemperatureCelsius]; ok {
+			temps = append(temps, temp)
+		}
+	}
+	if len(temps) == diskHealthSustainedSamples {
+		minTemp := temps[0]
+		for _, temp := range temps {
+			if temp < minTemp {
+				minTemp = temp
+			}
+		}
+		switch {
+		case minTemp >= highTemp:
+			raise(types.DiskHealthRiskHigh, "temperature of %d°C or more in the last %d readings",
+				minTemp, diskHealthSustainedSamples)
+		case minTemp >= elevatedTemp:
+			raise(types.DiskHealthRiskElevated, "temperature of %d°C or more in the last %d readings",
+				minTemp, diskHealthSustainedSamples)
+		}
+	}
+	return risk, reasons
+}
+
+// oldestDiskHealthValue returns the oldest value of the attribute not older than since
+// together with the time of its sample
+func oldestDiskHealthValue(samples []diskHealthSample, id int, since time.Time) (int, time.Time) {
+	for _, sample := range samples {
+		if sample.Time.Before(since) {
+			continue
+		}
+		if value, ok := sample.Values[id]; ok {
+			return value, sample.Time
+		}
+	}
+	// we always have the current value
+	last := samples[len(samples)-1]
+	return last.Values[id], last.Time
+}
+
+func newDiskHealthSample(disk *types.DiskSmartInfo, now time.Time) diskHealthSample {
+	sample := diskHealthSample{Time: now, Values: make(map[int]int)}
+	for _, id := range diskHealthAttrs {
+		if attr := disk.GetSmartAttrViaID(id); attr != nil {
+			sample.Values[id] = attr.RawValue
+		}
+	}
+	return sample
+}
+
+func diskHealthHistoryFile(key string) string {
+	return filepath.Join(diskHealthDir, key+".json")
+}
+
+func loadDiskHealthHistory(key string) diskHealthHistory {
+	var history diskHealthHistory
+	filename := diskHealthHistoryFile(key)
+	data, err := fileutils.ReadWithMaxSize(log, filename, maxDiskHealthHistorySize)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("loadDiskHealthHistory: %s", err)
+		}
+		return history
+	}
+	if err := json.Unmarshal(data, &history); err != nil {
+		log.Errorf("loadDiskHealthHistory: cannot parse %s: %s", filename, err)
+	}
+	return history
+}
+
+func saveDiskHealthHistory(key string, history diskHealthHistory) {
+	data, err := json.Marshal(history)
+	if err != nil {
+		log.Errorf("saveDiskHealthHistory: %s", err)
+		return
+	}
+	if err := os.MkdirAll(diskHealthDir, 0700); err != nil {
+		log.Errorf("saveDiskHealthHistory: %s", err)
+		return
+	}
+	if err := fileutils.WriteRename(diskHealthHistoryFile(key), data); err != nil {
+		log.Errorf("saveDiskHealthHistory: %s", err)
+	}
+}
+
+func diskHealthTask(ctxPtr *zfsContext) {
+	processDiskHealth(ctxPtr)
+
+	t := time.NewTicker(diskHealthInterval)
+	for range t.C {
+		processDiskHealth(ctxPtr)
+	}
+}
+
+// processDiskHealth reads SMART attributes of disks, evaluates their failure risk
+// from the history and publishes DiskHealthStatus
+func processDiskHealth(ctxPtr *zfsContext) {
+	log.Functionf("processDiskHealth start")
+	disksInfo, err := hardware.ReadSMARTinfoForDisks()
+	if err != nil {
+		log.Errorf("processDiskHealth: %s", err)
+		return
+	}
+	now := time.Now()
+	seen := make(map[string]bool)
+	for _, disk := range disksInfo.Disks {
+		if disk.CollectingStatus != types.SmartCollectingStatusSuccess {
+			continue
+		}
+		status := types.DiskHealthStatus{
+			DiskName:     disk.DiskName,
+			SerialNumber: disk.SerialNumber,
+		}
+		key := status.Key()
+		seen[key] = true
+
+		history := loadDiskHealthHistory(key)
+		history.DiskType = disk.DiskType
+		var samples []diskHealthSample
+		for _, sample := range history.Samples {
+			if now.Sub(sample.Time) < diskHealthHistoryPeriod {
+				samples = append(samples, sample)
+			}
+		}
+		history.Samples = append(samples, newDiskHealthSample(disk, now))
+		saveDiskHealthHistory(key, history)
+
+		var reasons []string
+		status.Risk, reasons = evaluateDiskHealth(history)
+		if status.Risk >= types.DiskHealthRiskElevated {
+			description := types.ErrorDescription{
+				Error: fmt.Sprintf("disk %s (serial %s) has %s risk of failure: %s",
+					disk.DiskName, disk.SerialNumber, status.Risk, strings.Join(reasons, "; ")),
+				ErrorSeverity: types.ErrorSeverityWarning,
+				ErrorEntities: []*types.ErrorEntity{{
+					EntityType: types.ErrorEntityDisk,
+					EntityID:   key,
+				}},
+			}
+			if status.Risk == types.DiskHealthRiskHigh {
+				description.ErrorSeverity = types.ErrorSeverityError
+			}
+			// keep the time when we raised the same error first
+			if prev := lookupDiskHealthStatus(ctxPtr, key); prev != nil &&
+				prev.Error == description.Error {
+				description.ErrorTime = prev.ErrorTime
+			}
+			status.SetErrorDescription(description)
+		}
+		status.OfflinedByEVE = offlineFailingDisk(ctxPtr, status)
+		if status.Risk >= types.DiskHealthRiskElevated {
+			log.Warnf("processDiskHealth: %s", status.Error)
+		}
+		if err := ctxPtr.diskHealthStatusPub.Publish(key, status); err != nil {
+			log.Errorf("processDiskHealth: cannot publish %s: %s", key, err)
+		}
+	}
+	for key := range ctxPtr.diskHealthStatusPub.GetAll() {
+		if !seen[key] {
+			if err := ctxPtr.diskHealthStatusPub.Unpublish(key); err != nil {
+				log.Errorf("processDiskHealth: cannot unpublish %s: %s", key, err)
+			}
+		}
+	}
+	log.Functionf("processDiskHealth done")
+}
+
+func lookupDiskHealthStatus(ctxPtr *zfsContext, key string) *types.DiskHealthStatus {
+	st, _ := ctxPtr.diskHealthStatusPub.Get(key)
+	if st == nil {
+		return nil
+	}
+	status := st.(types.DiskHealthStatus)
+	return &status
+}
+
+// offlineFailingDisks returns value of storage.zfs.offline.failing.disks
+// we run outside of the main loop, so we read it directly from the subscription
+func offlineFailingDisks(ctxPtr *zfsContext) bool {
+	gcp, err := ctxPtr.subGlobalConfig.Get("global")
+	if err != nil {
+		return types.DefaultConfigItemValueMap().GlobalValueBool(types.ZFSOfflineFailingDisks)
+	}
+	globalConfig := gcp.(types.ConfigItemValueMap)
+	return globalConfig.GlobalValueBool(types.ZFSOfflineFailingDisks)
+}
+
+// offlineFailingDisk takes the disk with high risk of failure offline in the persist pool
+// if it is a member of mirror or raidz vdev whose other members are online and it is allowed
+// returns true if the disk is offline because of that, disksStateProcessing will not bring it online
+func offlineFailingDisk(ctxPtr *zfsContext, status types.DiskHealthStatus) bool {
+	diskName := status.DiskName
+	if status.Risk != types.DiskHealthRiskHigh ||
+		vault.ReadPersistType() != types.PersistZFS || !offlineFailingDisks(ctxPtr) {
+		ctxPtr.diskHealthOfflined.Delete(diskName)
+		return false
+	}
+	persistPool, err := libzfs.PoolOpen(types.PersistPool)
+	if err != nil {
+		log.Errorf("offlineFailingDisk: cannot open pool: %s", err)
+		return false
+	}
+	defer persistPool.Close()
+	vdevTree, err := persistPool.VDevTree()
+	if err != nil {
+		log.Errorf("offlineFailingDisk: cannot get vdev tree: %s", err)
+		return false
+	}
+	member, siblings := findRedundantVDevMember(vdevTree, diskName)
+	if member == nil {
+		// not in pool or without redundancy
+		ctxPtr.diskHealthOfflined.Delete(diskName)
+		return false
+	}
+	if member.Stat.State == libzfs.VDevStateOffline {
+		// already offline, e.g. we took it offline before reboot
+		ctxPtr.diskHealthOfflined.Store(diskName, true)
+		return true
+	}
+	for _, sibling := range siblings {
+		if sibling.Stat.State != libzfs.VDevStateHealthy {
+			log.Warnf("offlineFailingDisk: keep %s online as %s is %s",
+				member.Name, sibling.Name, sibling.Stat.State.String())
+			return false
+		}
+	}
+	log.Noticef("offlineFailingDisk: take %s offline: %s", member.Name, status.Error)
+	if err := persistPool.Offline(false, member.Name); err != nil {
+		log.Errorf("offlineFailingDisk: cannot take %s offline: %s", member.Name, err)
+		return false
+	}
+	ctxPtr.diskHealthOfflined.Store(diskName, true)
+	return true
+}
+
+// findRedundantVDevMember looks for the disk among members of mirror and raidz vdevs
+// and returns it together with other members of the vdev
+func findRedundantVDevMember(vdevTree libzfs.VDevTree, diskName string) (
+	member *libzfs.VDevTree, siblings []libzfs.VDevTree) {
+	for _, vdev := range vdevTree.Devices {
+		if vdev.Type != libzfs.VDevTypeMirror && vdev.Type != libzfs.VDevTypeRaidz {
+			continue
+		}
+		for i, dev := range vdev.Devices {
+			// we can receive partition for example /dev/sda1
+			devName, err := disks.GetDiskNameByPartName(dev.Name)
+			if err != nil || filepath.Base(devName) != filepath.Base(diskName) {
+				continue
+			}
+			member = &vdev.Devices[i]
+			siblings = append(siblings, vdev.Devices[:i]...)
+			siblings = append(siblings, vdev.Devices[i+1:]...)
+			return member, siblings
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestEvaluateDiskHealth(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := 24 * time.Hour
+	sample := func(ago time.Duration, values map[int]int) diskHealthSample {
+		return diskHealthSample{Time: now.Add(-ago), Values: values}
+	}
+	reallocated := func(ago time.Duration, count int) diskHealthSample {
+		return sample(ago, map[int]int{
+			types.SmartAttrIDRealLocatedSectorCt: count,
+			types.SmartAttrIDTemperatureCelsius:  40,
+		})
+	}
+	wear := func(ago time.Duration, used int) diskHealthSample {
+		return sample(ago, map[int]int{
+			types.SmartAttrIDNVMePercentageUsed: used,
+			types.SmartAttrIDNVMeMediaErrors:    0,
+		})
+	}
+	temperature := func(ago time.Duration, temp int) diskHealthSample {
+		return sample(ago, map[int]int{types.SmartAttrIDTemperatureCelsius: temp})
+	}
+
+	testMatrix := map[string]struct {
+		history         diskHealthHistory
+		expectedRisk    types.DiskHealthRisk
+		expectedReasons int
+	}{
+		"no history": {
+			history:      diskHealthHistory{},
+			expectedRisk: types.DiskHealthRiskUnspecified,
+		},
+		"no attributes": {
+			history: diskHealthHistory{
+				Samples: []diskHealthSample{sample(0, nil)},
+			},
+			expectedRisk: types.DiskHealthRiskUnspecified,
+		},
+		"healthy": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples:  []diskHealthSample{reallocated(2*day, 0), reallocated(0, 0)},
+			},
+			expectedRisk: types.DiskHealthRiskLow,
+		},
+		"stable reallocated sectors": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples:  []diskHealthSample{reallocated(2*day, 8), reallocated(0, 8)},
+			},
+			expectedRisk:    types.DiskHealthRiskElevated,
+			expectedReasons: 1,
+		},
+		"slowly growing reallocated sectors": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples:  []diskHealthSample{reallocated(2*day, 8), reallocated(0, 10)},
+			},
+			expectedRisk:    types.DiskHealthRiskElevated,
+			expectedReasons: 1,
+		},
+		"fast growing reallocated sectors": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples: []diskHealthSample{
+					reallocated(2*day, 8), reallocated(day, 12), reallocated(0, 20)},
+			},
+			expectedRisk:    types.DiskHealthRiskHigh,
+			expectedReasons: 1,
+		},
+		"growth outside of trend window": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples:  []diskHealthSample{reallocated(10*day, 0), reallocated(0, 20)},
+			},
+			expectedRisk:    types.DiskHealthRiskElevated,
+			expectedReasons: 1,
+		},
+		"many reallocated sectors": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples:  []diskHealthSample{reallocated(0, 150)},
+			},
+			expectedRisk:    types.DiskHealthRiskHigh,
+			expectedReasons: 1,
+		},
+		"pending and uncorrectable": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples: []diskHealthSample{sample(0, map[int]int{
+					types.SmartAttrIDCurrentPendingSectorCt: 1,
+					types.SmartAttrIDReportedUncorrect:      2,
+				})},
+			},
+			expectedRisk:    types.DiskHealthRiskElevated,
+			expectedReasons: 2,
+		},
+		"nvme low wear": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples:  []diskHealthSample{wear(20*day, 10), wear(0, 11)},
+			},
+			expectedRisk: types.DiskHealthRiskLow,
+		},
+		"nvme worn": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples:  []diskHealthSample{wear(0, 85)},
+			},
+			expectedRisk:    types.DiskHealthRiskElevated,
+			expectedReasons: 1,
+		},
+		"nvme worn out": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples:  []diskHealthSample{wear(0, 98)},
+			},
+			expectedRisk:    types.DiskHealthRiskHigh,
+			expectedReasons: 1,
+		},
+		"nvme wearing out fast": {
+			// 30% used in 20 days, the remaining 40% would be used in about 27 days
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples:  []diskHealthSample{wear(20*day, 30), wear(0, 60)},
+			},
+			expectedRisk:    types.DiskHealthRiskHigh,
+			expectedReasons: 1,
+		},
+		"nvme wear not projected from a short history": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples:  []diskHealthSample{wear(time.Hour, 30), wear(0, 31)},
+			},
+			expectedRisk: types.DiskHealthRiskLow,
+		},
+		"temperature spike": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples: []diskHealthSample{
+					temperature(2*time.Hour, 40), temperature(time.Hour, 70), temperature(0, 70)},
+			},
+			expectedRisk: types.DiskHealthRiskLow,
+		},
+		"sustained high temperature": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeSata,
+				Samples: []diskHealthSample{
+					temperature(2*time.Hour, 66), temperature(time.Hour, 70), temperature(0, 68)},
+			},
+			expectedRisk:    types.DiskHealthRiskHigh,
+			expectedReasons: 1,
+		},
+		"nvme runs hotter": {
+			history: diskHealthHistory{
+				DiskType: types.SmartDiskTypeNvme,
+				Samples: []diskHealthSample{
+					temperature(2*time.Hour, 66), temperature(time.Hour, 70), temperature(0, 68)},
+			},
+			expectedRisk: types.DiskHealthRiskLow,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			risk, reasons := evaluateDiskHealth(test.history)
+			if risk != test.expectedRisk {
+				t.Errorf("expected risk %s, got %s (%v)", test.expectedRisk, risk, reasons)
+			}
+			if len(reasons) != test.expectedReasons {
+				t.Errorf("expected %d reasons, got %v", test.expectedReasons, reasons)
+			}
+		})
+	}
+}
@@ -103,7 +103,7 @@ func processDisks(ctx *zfsContext) error {
 	if err != nil {
 		return fmt.Errorf("cannot open pool: %s", err)
 	}
-	disksStateProcessing(disksConfig, persistPool)
+	disksStateProcessing(ctx, disksConfig, persistPool)
 	persistPool.Close()
 	// re-read pool to grab changes after disksStateProcessing
 	persistPool, err = libzfs.PoolOpen(types.PersistPool)
@@ -117,7 +117,7 @@ func processDisks(ctx *zfsContext) error {
 
 // disksStateProcessing iterate over disks and adjust its state accordingly to the config
 // we expect that zfs will handle conflicts between order of calls
-func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
+func disksStateProcessing(ctx *zfsContext, disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	vdevTree, err := pool.VDevTree()
 	if err != nil {
 		log.Errorf("cannot get vdev tree: %s", err)
@@ -143,6 +143,10 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 			case types.EdgeNodeDiskConfigTypeZfsOnline:
 				switch zfs.GetZfsDeviceStatusFromStr(devState.String()) {
 				case types.StorageStatusOffline:
+					if _, offlined := ctx.diskHealthOfflined.Load(diskCfg.Disk.Name); offlined {
+						log.Warnf("keep %s offline because of its health", diskCfg.Disk.Name)
+						continue
+					}
 					if err := pool.Online(true, diskCfg.Disk.Name); err != nil {
 						log.Errorf("cannot bring %s online: %s", diskCfg.Disk.Name, err)
 					}
@@ -174,7 +178,7 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	for _, el := range disks.Children {
 		// process children states
 		// we process only states of devices as part of vdevs, so we assume that we can handle them in any order
-		disksStateProcessing(el, pool)
+		disksStateProcessing(ctx, el, pool)
 	}
 }
 
@@ -188,6 +192,9 @@ func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	switch disks.ArrayType {
 	case types.EdgeNodeDiskArrayTypeRAID0:
 		disksLayoutRaid0Process(vdevTree, disks.Children)
+	case types.EdgeNodeDiskArrayTypeRAID5, types.EdgeNodeDiskArrayTypeRAID6:
+		// pool of the single raidz vdev
+		disksLayoutRaidzProcess(vdevTree, disks)
 	case types.EdgeNodeDiskArrayTypeUnspecified:
 	default:
 		// TBD: other array types
@@ -196,7 +203,8 @@ func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 }
 
 // disksLayoutRaid0Process ensure layout for batch of top-level vdevs in pool
-// we support raid0 of unspecified (single disk) and raid1 (mirror of disks) layout here
+// we support raid0 of unspecified (single disk), raid1 (mirror of disks)
+// and raid5/raid6 (raidz1/raidz2 of disks) layout here
 func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDisks) {
 	for _, el := range disks {
 		switch el.ArrayType {
@@ -236,8 +244,48 @@ func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDis
 					}
 				}
 			}
+		case types.EdgeNodeDiskArrayTypeRAID5, types.EdgeNodeDiskArrayTypeRAID6:
+			disksLayoutRaidzProcess(vdevTree, el)
 		default:
 			log.Warnf("No supported child processing for array type: %d", el.ArrayType)
 		}
 	}
 }
+
+// raidzParity returns parity of raidz vdev for the array type
+func raidzParity(arrayType types.EdgeNodeDiskArrayType) int {
+	if arrayType == types.EdgeNodeDiskArrayTypeRAID6 {
+		return 2
+	}
+	return 1
+}
+
+// disksLayoutRaidzProcess ensure that disks form raidz top-level vdev in pool
+// if none of the disks is in pool we add them as a new raidz vdev, this way
+// pool with raidz layout is expanded by adding another child into the stripe
+// zfs cannot attach disks to existing raidz vdev, so we only complain about them
+func disksLayoutRaidzProcess(vdevTree libzfs.VDevTree, disks types.EdgeNodeDisks) {
+	parity := raidzParity(disks.ArrayType)
+	var inPool, notInPool []string
+	for _, dsk := range disks.Disks {
+		if dsk.Disk.Name == "" {
+			continue
+		}
+		if devState := getVDevState(vdevTree, dsk.Disk.Name); devState != nil {
+			inPool = append(inPool, dsk.Disk.Name)
+		} else {
+			notInPool = append(notInPool, dsk.Disk.Name)
+		}
+	}
+	if len(notInPool) == 0 {
+		return
+	}
+	if len(inPool) > 0 {
+		log.Warnf("cannot attach %v to raidz%d vdev of %v: not supported by zfs",
+			notInPool, parity, inPool)
+		return
+	}
+	if stdout, err := zfs.AddRaidzVDev(log, types.PersistPool, parity, notInPool); err != nil {
+		log.Errorf("cannot add raidz%d of %v: %s %s", parity, notInPool, stdout, err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/lf-edge/eve/pkg/pillar/agentbase"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -47,11 +48,17 @@ type zfsContext struct {
 	zVolStatusPub          pubsub.Publication
 	storageStatusPub       pubsub.Publication
 	storageMetricsPub      pubsub.Publication
+	diskHealthStatusPub    pubsub.Publication
 	subDisksConfig         pubsub.Subscription
 	subVolumeStatus        pubsub.Subscription
+	subGlobalConfig        pubsub.Subscription
+	globalConfig           *types.ConfigItemValueMap
 	disksProcessingTrigger chan interface{}
 	zVolDeviceEvents       *base.LockedStringMap // stores device->zVolDeviceEvent mapping to check and publish
+	diskHealthOfflined     *base.LockedStringMap // stores names of disks we took offline because of their health
 	zfsIterLock            sync.Mutex
+	lastZvolIOs            uint64    // I/O operations of zvols at lastZvolIOsTime to get IOPS of apps
+	lastZvolIOsTime        time.Time // zero until the first sample
 }
 
 // Run - an zfs run
@@ -61,8 +68,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 
 	ctxPtr := zfsContext{
 		ps:                     ps,
+		globalConfig:           types.DefaultConfigItemValueMap(),
 		disksProcessingTrigger: make(chan interface{}, 1),
 		zVolDeviceEvents:       base.NewLockedStringMap(),
+		diskHealthOfflined:     base.NewLockedStringMap(),
 	}
 	agentbase.Init(&ctxPtr, logger, log, agentName,
 		agentbase.WithPidFile(),
@@ -84,6 +93,26 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	}
 	log.Functionf("processed Vault Status")
 
+	// Look for global config such as log levels and scrub settings
+	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ConfigItemValueMap{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           &ctxPtr,
+		CreateHandler: handleGlobalConfigCreate,
+		ModifyHandler: handleGlobalConfigModify,
+		DeleteHandler: handleGlobalConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
+
 	// Publish ZVolStatus for zvol devices
 	zVolStatusPub, err := ps.NewPublication(
 		pubsub.PublicationOptions{
@@ -137,6 +166,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	}
 	ctxPtr.storageMetricsPub = storageMetricsPub
 
+	// Publish failure risk of disks
+	diskHealthStatusPub, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  agentName,
+			TopicType:  types.DiskHealthStatus{},
+			Persistent: false,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.diskHealthStatusPub = diskHealthStatusPub
+
 	// Look VolumeStatus from volumemgr to getting zVol metrics
 	subVolumeStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "volumemgr",
@@ -165,15 +206,23 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 
 	go storageMetricsPublisher(&ctxPtr)
 
+	go diskHealthTask(&ctxPtr)
+
 	max := float64(zvolsProcessingInterval)
 	min := max * 0.3
 	devicesProcessTicker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
 
+	scrubTicker := time.NewTicker(scrubCheckInterval)
+
 	for {
 		select {
 		case <-devicesProcessTicker.C:
 			processZVolDeviceEvents(&ctxPtr)
+		case <-scrubTicker.C:
+			processScrub(&ctxPtr)
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
 		case change := <-subDisksConfig.MsgChan():
 			subDisksConfig.ProcessChange(change)
 		case change := <-subVolumeStatus.MsgChan():
@@ -184,6 +233,48 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	}
 }
 
+func handleGlobalConfigCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctxPtr := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigImpl for %s", key)
+	gcp := agentlog.HandleGlobalConfig(log, ctxPtr.subGlobalConfig, agentName,
+		ctxPtr.CLIParams().DebugOverride, logger)
+	if gcp != nil {
+		ctxPtr.globalConfig = gcp
+	}
+	log.Functionf("handleGlobalConfigImpl done for %s", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctxPtr := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigDelete for %s", key)
+	agentlog.HandleGlobalConfig(log, ctxPtr.subGlobalConfig, agentName,
+		ctxPtr.CLIParams().DebugOverride, logger)
+	*ctxPtr.globalConfig = *types.DefaultConfigItemValueMap()
+	log.Functionf("handleGlobalConfigDelete done for %s", key)
+}
+
 // processZVolDeviceEvents iterates over saved zVolDeviceEvent, check for device existence and publish ZVolStatus
 func processZVolDeviceEvents(ctxPtr *zfsContext) {
 	var processedKeys []string
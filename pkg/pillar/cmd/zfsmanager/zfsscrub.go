@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+const (
+	scrubCheckInterval = 30 * time.Second // interval between checks of scrub schedule and app I/O
+)
+
+type scrubAction int
+
+const (
+	scrubActionNone scrubAction = iota
+	scrubActionStart
+	scrubActionPause
+	scrubActionResume
+)
+
+// String returns scrubAction in string format
+func (a scrubAction) String() string {
+	switch a {
+	case scrubActionStart:
+		return "start"
+	case scrubActionPause:
+		return "pause"
+	case scrubActionResume:
+		return "resume"
+	default:
+		return "none"
+	}
+}
+
+// nextScrubAction decides what to do with scrub of the pool given its scan status
+// interval is the time between the end of the last scan and the next scrub, zero disables scheduling
+// scrub is paused while appIOPS are above maxAppIOPS and resumed once they drop, zero disables pausing
+// resilver cannot be paused, and we never start scrub while apps are busy
+func nextScrubAction(scan types.ZFSScanStatus, now time.Time, interval time.Duration,
+	appIOPS, maxAppIOPS uint64) scrubAction {
+	appsBusy := maxAppIOPS != 0 && appIOPS > maxAppIOPS
+	if scan.State == types.ZFSScanStateScanning {
+		if scan.Function != types.ZFSScanFunctionScrub {
+			return scrubActionNone
+		}
+		if scan.Paused && !appsBusy {
+			return scrubActionResume
+		}
+		if !scan.Paused && appsBusy {
+			return scrubActionPause
+		}
+		return scrubActionNone
+	}
+	if interval == 0 || appsBusy {
+		return scrubActionNone
+	}
+	// resilver reads all the data as scrub does, so we count from any finished or canceled scan
+	if scan.State != types.ZFSScanStateNone && now.Sub(scan.EndTime) < interval {
+		return scrubActionNone
+	}
+	return scrubActionStart
+}
+
+// sampleAppIOPS returns I/O operations per second issued by apps to zvols since the previous call
+// it returns false for the first call as we have nothing to compare with
+func sampleAppIOPS(ctx *zfsContext, now time.Time) (uint64, bool) {
+	ios, err := zfs.GetZvolsIOs()
+	if err != nil {
+		log.Errorf("sampleAppIOPS: %s", err)
+		return 0, false
+	}
+	lastIOs, lastTime := ctx.lastZvolIOs, ctx.lastZvolIOsTime
+	ctx.lastZvolIOs, ctx.lastZvolIOsTime = ios, now
+	elapsed := now.Sub(lastTime).Seconds()
+	// counters drop when zvols are removed
+	if lastTime.IsZero() || elapsed <= 0 || ios < lastIOs {
+		return 0, false
+	}
+	return uint64(float64(ios-lastIOs) / elapsed), true
+}
+
+// processScrub starts scheduled scrub of the persist pool and pauses or resumes it
+// according to the I/O load of apps
+func processScrub(ctx *zfsContext) {
+	if vault.ReadPersistType() != types.PersistZFS {
+		return
+	}
+	now := time.Now()
+	appIOPS, ok := sampleAppIOPS(ctx, now)
+	if !ok {
+		return
+	}
+	persistPool, err := libzfs.PoolOpen(types.PersistPool)
+	if err != nil {
+		log.Errorf("processScrub: cannot open pool: %s", err)
+		return
+	}
+	vdevTree, err := persistPool.VDevTree()
+	persistPool.Close()
+	if err != nil {
+		log.Errorf("processScrub: cannot get vdev tree: %s", err)
+		return
+	}
+	scan := zfs.GetZpoolScanStatus(vdevTree.ScanStat, now)
+	if scan.Function == types.ZFSScanFunctionScrub && scan.State == types.ZFSScanStateScanning {
+		scan.Paused, err = zfs.IsScrubPaused(log, types.PersistPool)
+		if err != nil {
+			log.Errorf("processScrub: %s", err)
+			return
+		}
+	}
+	interval := time.Duration(ctx.globalConfig.GlobalValueInt(types.ZFSScrubIntervalDays)) * 24 * time.Hour
+	maxAppIOPS := uint64(ctx.globalConfig.GlobalValueInt(types.ZFSScrubPauseAppIOPS))
+	action := nextScrubAction(scan, now, interval, appIOPS, maxAppIOPS)
+	if action == scrubActionNone {
+		return
+	}
+	log.Noticef("processScrub: %s scrub of %s (apps at %d IOPS, limit %d)",
+		action, types.PersistPool, appIOPS, maxAppIOPS)
+	var stdout string
+	switch action {
+	case scrubActionStart, scrubActionResume:
+		stdout, err = zfs.StartScrub(log, types.PersistPool)
+	case scrubActionPause:
+		stdout, err = zfs.PauseScrub(log, types.PersistPool)
+	}
+	if err != nil {
+		log.Errorf("processScrub: cannot %s scrub: %s %s", action, stdout, err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestNextScrubAction(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := 24 * time.Hour
+	scrubbing := types.ZFSScanStatus{
+		Function: types.ZFSScanFunctionScrub,
+		State:    types.ZFSScanStateScanning,
+	}
+	paused := scrubbing
+	paused.Paused = true
+	resilvering := types.ZFSScanStatus{
+		Function: types.ZFSScanFunctionResilver,
+		State:    types.ZFSScanStateScanning,
+	}
+	finished := types.ZFSScanStatus{
+		Function: types.ZFSScanFunctionScrub,
+		State:    types.ZFSScanStateFinished,
+		EndTime:  now.Add(-10 * day),
+	}
+	resilvered := types.ZFSScanStatus{
+		Function: types.ZFSScanFunctionResilver,
+		State:    types.ZFSScanStateFinished,
+		EndTime:  now.Add(-2 * day),
+	}
+
+	testMatrix := map[string]struct {
+		scan       types.ZFSScanStatus
+		interval   time.Duration
+		appIOPS    uint64
+		maxAppIOPS uint64
+		expected   scrubAction
+	}{
+		"never scanned": {
+			scan:     types.ZFSScanStatus{},
+			interval: 30 * day,
+			expected: scrubActionStart,
+		},
+		"never scanned, scheduling disabled": {
+			scan:     types.ZFSScanStatus{},
+			expected: scrubActionNone,
+		},
+		"never scanned, apps busy": {
+			scan:       types.ZFSScanStatus{},
+			interval:   30 * day,
+			appIOPS:    1000,
+			maxAppIOPS: 500,
+			expected:   scrubActionNone,
+		},
+		"scrubbed recently": {
+			scan:     finished,
+			interval: 30 * day,
+			expected: scrubActionNone,
+		},
+		"scrub due": {
+			scan:     finished,
+			interval: 7 * day,
+			expected: scrubActionStart,
+		},
+		"resilvered recently": {
+			scan:     resilvered,
+			interval: day,
+			expected: scrubActionStart,
+		},
+		"resilver counts as scan": {
+			scan:     resilvered,
+			interval: 7 * day,
+			expected: scrubActionNone,
+		},
+		"scrubbing, apps idle": {
+			scan:       scrubbing,
+			interval:   30 * day,
+			appIOPS:    10,
+			maxAppIOPS: 500,
+			expected:   scrubActionNone,
+		},
+		"scrubbing, apps busy": {
+			scan:       scrubbing,
+			interval:   30 * day,
+			appIOPS:    1000,
+			maxAppIOPS: 500,
+			expected:   scrubActionPause,
+		},
+		"scrubbing, pausing disabled": {
+			scan:     scrubbing,
+			interval: 30 * day,
+			appIOPS:  1000,
+			expected: scrubActionNone,
+		},
+		"paused, apps busy": {
+			scan:       paused,
+			interval:   30 * day,
+			appIOPS:    1000,
+			maxAppIOPS: 500,
+			expected:   scrubActionNone,
+		},
+		"paused, apps idle": {
+			scan:       paused,
+			interval:   30 * day,
+			appIOPS:    10,
+			maxAppIOPS: 500,
+			expected:   scrubActionResume,
+		},
+		"paused, scheduling disabled": {
+			scan:       paused,
+			appIOPS:    10,
+			maxAppIOPS: 500,
+			expected:   scrubActionResume,
+		},
+		"resilvering, apps busy": {
+			scan:       resilvering,
+			interval:   30 * day,
+			appIOPS:    1000,
+			maxAppIOPS: 500,
+			expected:   scrubActionNone,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			action := nextScrubAction(test.scan, now, test.interval,
+				test.appIOPS, test.maxAppIOPS)
+			if action != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, action)
+			}
+		})
+	}
+}
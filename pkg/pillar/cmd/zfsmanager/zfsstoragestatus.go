@@ -94,6 +94,17 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 			if err != nil {
 				log.Errorf("error with get about RAID info from devTree: %v", err)
 			} else {
+				status.ScanStatus = zfs.GetZpoolScanStatus(vdevs.ScanStat, time.Now())
+				if status.ScanStatus.Function == types.ZFSScanFunctionScrub &&
+					status.ScanStatus.State == types.ZFSScanStateScanning {
+					paused, err := zfs.IsScrubPaused(log, zpoolName)
+					if err != nil {
+						log.Errorf("error with get scrub state: %v", err)
+					} else if paused {
+						status.ScanStatus.Paused = true
+						status.ScanStatus.ETA = 0
+					}
+				}
 				// it returns top-level raid type
 				currentRaid = zfs.GetZpoolRaidType(vdevs)
 				// handle the case when we have only one top-level vdev
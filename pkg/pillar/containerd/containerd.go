@@ -20,6 +20,7 @@ import (
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/archive"
 	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/images"
@@ -367,6 +368,56 @@ func (client *Client) CtrRemoveSnapshot(ctx context.Context, snapshotID string)
 	return nil
 }
 
+// CtrExportSnapshot writes the changes of the snapshot with snapshotID to
+// its parent, which is the unpacked image it was prepared from, as a tar
+// stream into w
+func (client *Client) CtrExportSnapshot(ctx context.Context, snapshotID string, w io.Writer) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrExportSnapshot: exception while verifying ctrd client: %s", err.Error())
+	}
+	snapshotter := client.ctrdClient.SnapshotService(defaultSnapshotter)
+	info, err := snapshotter.Stat(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("CtrExportSnapshot: Exception while fetching info of snapshot: %s. %s", snapshotID, err)
+	}
+	upper, err := snapshotter.Mounts(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("CtrExportSnapshot: Exception while fetching mounts of snapshot: %s. %s", snapshotID, err)
+	}
+	viewID := snapshotID + "-export"
+	lower, err := snapshotter.View(ctx, viewID, info.Parent)
+	if err != nil {
+		return fmt.Errorf("CtrExportSnapshot: Exception while creating view of %s: %s", info.Parent, err)
+	}
+	defer func() {
+		if err := snapshotter.Remove(ctx, viewID); err != nil {
+			logrus.Errorf("CtrExportSnapshot: unable to remove view %s: %v", viewID, err)
+		}
+	}()
+	return mount.WithTempMount(ctx, lower, func(lowerRoot string) error {
+		return mount.WithTempMount(ctx, upper, func(upperRoot string) error {
+			return archive.WriteDiff(ctx, w, lowerRoot, upperRoot)
+		})
+	})
+}
+
+// CtrImportSnapshot applies the changes written by CtrExportSnapshot to
+// the snapshot with snapshotID
+func (client *Client) CtrImportSnapshot(ctx context.Context, snapshotID string, r io.Reader) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrImportSnapshot: exception while verifying ctrd client: %s", err.Error())
+	}
+	snapshotter := client.ctrdClient.SnapshotService(defaultSnapshotter)
+	mounts, err := snapshotter.Mounts(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("CtrImportSnapshot: Exception while fetching mounts of snapshot: %s. %s", snapshotID, err)
+	}
+	return mount.WithTempMount(ctx, mounts, func(root string) error {
+		_, err := archive.Apply(ctx, root, r)
+		return err
+	})
+}
+
 // CtrLoadContainer returns container with the given `containerID`. Error is returned if there no container is found.
 func (client *Client) CtrLoadContainer(ctx context.Context, containerID string) (containerd.Container, error) {
 	if err := client.verifyCtr(ctx, true); err != nil {
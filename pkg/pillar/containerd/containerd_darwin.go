@@ -9,3 +9,8 @@ import "fmt"
 func bindNS(ns string, path string, pid int) error {
 	return fmt.Errorf("bindNS is not implemented on Mac OS X")
 }
+
+// blockDeviceOf returns the major and minor numbers of the disk path is on
+func blockDeviceOf(path string) (int64, int64, error) {
+	return 0, 0, fmt.Errorf("blockDeviceOf is not implemented on Mac OS X")
+}
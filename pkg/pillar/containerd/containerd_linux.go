@@ -6,6 +6,7 @@ package containerd
 import (
 	"fmt"
 	"golang.org/x/sys/unix"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -32,3 +33,44 @@ func bindNS(ns string, path string, pid int) error {
 	}
 	return nil
 }
+
+// sysDevBlock is where the kernel lists the block devices by major:minor
+var sysDevBlock = "/sys/dev/block"
+
+// blockDeviceOf returns the major and minor numbers of the disk the file in
+// path is on, or of the disk of the block device in path. The I/O to the
+// partitions of a disk is throttled on the disk itself.
+func blockDeviceOf(path string) (int64, int64, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(path, &st); err != nil {
+		return 0, 0, fmt.Errorf("cannot stat %s: %v", path, err)
+	}
+	dev := uint64(st.Dev)
+	if st.Mode&unix.S_IFMT == unix.S_IFBLK {
+		dev = uint64(st.Rdev)
+	}
+	major, minor := int64(unix.Major(dev)), int64(unix.Minor(dev))
+	if major == 0 {
+		// e.g. a ZFS dataset, whose disks are accessed by ZFS itself
+		return 0, 0, fmt.Errorf("%s is not on a block device", path)
+	}
+	sysDir, err := filepath.EvalSymlinks(filepath.Join(sysDevBlock,
+		fmt.Sprintf("%d:%d", major, minor)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("no block device %d:%d for %s: %v",
+			major, minor, path, err)
+	}
+	if _, err := os.Stat(filepath.Join(sysDir, "partition")); err != nil {
+		return major, minor, nil
+	}
+	content, err := ioutil.ReadFile(filepath.Join(filepath.Dir(sysDir), "dev"))
+	if err != nil {
+		return 0, 0, fmt.Errorf("no disk of partition %d:%d for %s: %v",
+			major, minor, path, err)
+	}
+	if _, err := fmt.Sscanf(string(content), "%d:%d", &major, &minor); err != nil {
+		return 0, 0, fmt.Errorf("cannot parse the disk of partition %s: %v",
+			sysDir, err)
+	}
+	return major, minor, nil
+}
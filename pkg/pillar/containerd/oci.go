@@ -255,7 +255,12 @@ func (s *ociSpec) CreateContainer(removeExisting bool) error {
 func (s *ociSpec) AdjustMemLimit(dom types.DomainConfig, addMemory int64) {
 	// update cgroup resource constraints for CPU and memory
 	if s.Linux != nil {
-		m := int64(dom.Memory*1024) + addMemory
+		memory := dom.Memory
+		if dom.MaxMem > memory {
+			// the balloon of the domain may grow up to MaxMem
+			memory = dom.MaxMem
+		}
+		m := int64(memory*1024) + addMemory
 		s.Linux.Resources.Memory.Limit = &m
 	}
 }
@@ -321,6 +326,16 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig, status *types.Domain
 		if status.VmConfig.CPUs != "" {
 			s.Linux.Resources.CPU.Cpus = status.VmConfig.CPUs
 		}
+		if dom.CPUWeight != 0 {
+			shares := cpuSharesFromWeight(dom.CPUWeight)
+			s.Linux.Resources.CPU.Shares = &shares
+		}
+		if dom.PidsMax != 0 {
+			s.Linux.Resources.Pids = &specs.LinuxPids{Limit: int64(dom.PidsMax)}
+		}
+		// the disks whose limits can not be applied are reported by
+		// domainmgr, see UnthrottledDisks
+		s.Linux.Resources.BlockIO, _ = blockIOFromDisks(status.DiskStatusList, blockDeviceOf)
 
 		s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s", ctrdServicesNamespace, dom.GetTaskName())
 	}
@@ -331,6 +346,94 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig, status *types.Domain
 	s.Annotations[EVEOCIVNCPasswordLabel] = dom.VncPasswd
 }
 
+// cpuSharesFromWeight converts the cgroup v2 cpu.weight, from 1 to 10000,
+// to the cgroup v1 cpu.shares, from 2 to 262144. It is the inverse of the
+// conversion runc does on cgroup v2 hosts, rounded up so that the weight
+// survives the round trip.
+func cpuSharesFromWeight(weight uint32) uint64 {
+	if weight > 10000 {
+		weight = 10000
+	}
+	return 2 + (uint64(weight-1)*262142+9998)/9999
+}
+
+// UnthrottledDisks returns the disks whose I/O limits can not be applied,
+// each with the reason, e.g. files on a ZFS dataset. ZFS issues the I/O to
+// the disks of the pool from its own threads, so that throttling those
+// disks would not limit the I/O of the domain.
+func UnthrottledDisks(disks []types.DiskStatus) []string {
+	_, unthrottled := blockIOFromDisks(disks, blockDeviceOf)
+	return unthrottled
+}
+
+// blockIOFromDisks returns the throttling of the block devices the disks
+// are on, or nil if none of the disks has limits, and the disks with limits
+// whose block device is not known. The kernel throttles the I/O of a cgroup
+// per block device, hence the limits of the disks on the same device are
+// summed, and a limit is not set for the device if one of the disks with
+// limits on it has no such limit. The I/O of the disks without limits is
+// throttled with the other disks on the same device.
+// On cgroup v1 hosts the blkio throttling applies to the direct and the
+// synchronous I/O only: the dirty pages written by the domain are written
+// back by the kernel threads of the host, outside of the cgroup, so that
+// buffered writes are not limited.
+func blockIOFromDisks(disks []types.DiskStatus,
+	deviceOf func(string) (int64, int64, error)) (*specs.LinuxBlockIO, []string) {
+
+	type blockDevice struct {
+		major, minor int64
+	}
+	var devices []blockDevice
+	var unthrottled []string
+	limits := make(map[blockDevice][]uint64)
+	for _, disk := range disks {
+		if disk.IoLimits == (types.VolumeIoLimits{}) || disk.FileLocation == "" {
+			continue
+		}
+		major, minor, err := deviceOf(disk.FileLocation)
+		if err != nil {
+			unthrottled = append(unthrottled,
+				fmt.Sprintf("%s: %v", disk.DisplayName, err))
+			continue
+		}
+		dev := blockDevice{major: major, minor: minor}
+		diskLimits := []uint64{disk.IoLimits.ReadBps, disk.IoLimits.WriteBps,
+			disk.IoLimits.ReadIops, disk.IoLimits.WriteIops}
+		devLimits, ok := limits[dev]
+		if !ok {
+			devices = append(devices, dev)
+			limits[dev] = diskLimits
+			continue
+		}
+		for i, limit := range diskLimits {
+			if limit == 0 || devLimits[i] == 0 {
+				devLimits[i] = 0
+			} else {
+				devLimits[i] += limit
+			}
+		}
+	}
+	if len(devices) == 0 {
+		return nil, unthrottled
+	}
+	blockIO := &specs.LinuxBlockIO{}
+	throttles := []*[]specs.LinuxThrottleDevice{&blockIO.ThrottleReadBpsDevice,
+		&blockIO.ThrottleWriteBpsDevice, &blockIO.ThrottleReadIOPSDevice,
+		&blockIO.ThrottleWriteIOPSDevice}
+	for _, dev := range devices {
+		for i, limit := range limits[dev] {
+			if limit == 0 {
+				continue
+			}
+			throttle := specs.LinuxThrottleDevice{Rate: limit}
+			throttle.Major = dev.major
+			throttle.Minor = dev.minor
+			*throttles[i] = append(*throttles[i], throttle)
+		}
+	}
+	return blockIO, unthrottled
+}
+
 // UpdateFromVolume updates values in the OCI spec based on the location
 // of an EVE volume. EVE volume's are expected to be structured as directories
 // in the filesystem with either config.json containing the full OCI runtime
@@ -791,6 +791,72 @@ func TestEnvs(t *testing.T) {
 	g.Expect(spec.Process.Args).To(Equal([]string{"echo", "hello"}))
 }
 
+func TestCPUSharesFromWeight(t *testing.T) {
+	g := NewGomegaWithT(t)
+	// the conversion runc does on cgroup v2 hosts
+	toWeight := func(shares uint64) uint64 {
+		return 1 + ((shares-2)*9999)/262142
+	}
+	g.Expect(cpuSharesFromWeight(1)).To(Equal(uint64(2)))
+	g.Expect(cpuSharesFromWeight(10000)).To(Equal(uint64(262144)))
+	g.Expect(cpuSharesFromWeight(20000)).To(Equal(uint64(262144)))
+	for _, weight := range []uint32{1, 2, 50, 100, 1000, 9999, 10000} {
+		g.Expect(toWeight(cpuSharesFromWeight(weight))).To(Equal(uint64(weight)))
+	}
+}
+
+func TestBlockIOFromDisks(t *testing.T) {
+	g := NewGomegaWithT(t)
+	devices := map[string][2]int64{
+		"/persist/vol1": {8, 0},
+		"/persist/vol2": {8, 0},
+		"/persist/vol3": {8, 0},
+		"/dev/zvol/vol": {230, 16},
+	}
+	deviceOf := func(path string) (int64, int64, error) {
+		dev, ok := devices[path]
+		if !ok {
+			return 0, 0, fmt.Errorf("no device for %s", path)
+		}
+		return dev[0], dev[1], nil
+	}
+	throttle := func(major, minor int64, rate uint64) specs.LinuxThrottleDevice {
+		t := specs.LinuxThrottleDevice{Rate: rate}
+		t.Major = major
+		t.Minor = minor
+		return t
+	}
+
+	blockIO, unthrottled := blockIOFromDisks(nil, deviceOf)
+	g.Expect(blockIO).To(BeNil())
+	g.Expect(unthrottled).To(BeEmpty())
+	blockIO, unthrottled = blockIOFromDisks([]types.DiskStatus{
+		{FileLocation: "/persist/vol1"},
+		{FileLocation: "/unknown", DisplayName: "dataset",
+			IoLimits: types.VolumeIoLimits{ReadBps: 1}},
+	}, deviceOf)
+	g.Expect(blockIO).To(BeNil())
+	g.Expect(unthrottled).To(Equal([]string{"dataset: no device for /unknown"}))
+
+	blockIO, unthrottled = blockIOFromDisks([]types.DiskStatus{
+		{FileLocation: "/persist/vol1",
+			IoLimits: types.VolumeIoLimits{ReadBps: 1000, WriteBps: 2000, ReadIops: 10}},
+		{FileLocation: "/persist/vol2",
+			IoLimits: types.VolumeIoLimits{ReadBps: 500, WriteBps: 1000, WriteIops: 20}},
+		{FileLocation: "/persist/vol3"},
+		{FileLocation: "/dev/zvol/vol", IoLimits: types.VolumeIoLimits{WriteIops: 100}},
+	}, deviceOf)
+	g.Expect(unthrottled).To(BeEmpty())
+	g.Expect(blockIO).ToNot(BeNil())
+	g.Expect(blockIO.ThrottleReadBpsDevice).To(Equal(
+		[]specs.LinuxThrottleDevice{throttle(8, 0, 1500)}))
+	g.Expect(blockIO.ThrottleWriteBpsDevice).To(Equal(
+		[]specs.LinuxThrottleDevice{throttle(8, 0, 3000)}))
+	g.Expect(blockIO.ThrottleReadIOPSDevice).To(BeEmpty())
+	g.Expect(blockIO.ThrottleWriteIOPSDevice).To(Equal(
+		[]specs.LinuxThrottleDevice{throttle(230, 16, 100)}))
+}
+
 func TestAddLoader(t *testing.T) {
 	g := NewGomegaWithT(t)
 	specTemplate := ociSpec{
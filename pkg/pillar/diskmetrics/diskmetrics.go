@@ -86,12 +86,26 @@ func CreateImg(ctx context.Context, log *base.LogObject, diskfile string, format
 
 // RolloutImgToBlock do conversion of diskfile to outputFile with defined format
 func RolloutImgToBlock(ctx context.Context, log *base.LogObject, diskfile, outputFile, outputFormat string) error {
+	return rolloutImg(ctx, log, diskfile, outputFile, outputFormat, true)
+}
+
+// ConvertImgToBlock is RolloutImgToBlock for the block devices which do not
+// read as zeroes when created, e.g. dm-crypt devices
+func ConvertImgToBlock(ctx context.Context, log *base.LogObject, diskfile, outputFile, outputFormat string) error {
+	return rolloutImg(ctx, log, diskfile, outputFile, outputFormat, false)
+}
+
+func rolloutImg(ctx context.Context, log *base.LogObject, diskfile, outputFile, outputFormat string,
+	targetIsZero bool) error {
 	if _, err := os.Stat(diskfile); err != nil {
 		return err
 	}
 	// writeback cache instead of default unsafe, out of order enabled, skip file creation
 	// Timeout 2 hours
-	args := []string{"convert", "--target-is-zero", "-t", "writeback", "-W", "-n", "-O", outputFormat, diskfile, outputFile}
+	args := []string{"convert", "-t", "writeback", "-W", "-n", "-O", outputFormat, diskfile, outputFile}
+	if targetIsZero {
+		args = append([]string{"convert", "--target-is-zero"}, args[1:]...)
+	}
 	output, err := base.Exec(log, "/usr/bin/qemu-img", args...).WithContext(ctx).CombinedOutputWithCustomTimeout(432000)
 	if err != nil {
 		errStr := fmt.Sprintf("qemu-img failed: %s, %s\n",
@@ -100,3 +114,32 @@ func RolloutImgToBlock(ctx context.Context, log *base.LogObject, diskfile, outpu
 	}
 	return nil
 }
+
+// CreateSnapshot creates an internal snapshot of the qcow2 diskfile
+func CreateSnapshot(log *base.LogObject, diskfile, name string) error {
+	return imgSnapshot(log, "-c", name, diskfile)
+}
+
+// ApplySnapshot reverts the qcow2 diskfile to its internal snapshot
+func ApplySnapshot(log *base.LogObject, diskfile, name string) error {
+	return imgSnapshot(log, "-a", name, diskfile)
+}
+
+// DeleteSnapshot deletes the internal snapshot of the qcow2 diskfile
+func DeleteSnapshot(log *base.LogObject, diskfile, name string) error {
+	return imgSnapshot(log, "-d", name, diskfile)
+}
+
+func imgSnapshot(log *base.LogObject, op, name, diskfile string) error {
+	if _, err := os.Stat(diskfile); err != nil {
+		return err
+	}
+	output, err := base.Exec(log, "/usr/bin/qemu-img", "snapshot", op, name,
+		diskfile).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("qemu-img failed: %s, %s\n",
+			err, output)
+		return errors.New(errStr)
+	}
+	return nil
+}
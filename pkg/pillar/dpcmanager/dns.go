@@ -245,6 +245,7 @@ func (m *DpcManager) getDHCPInfo(port *types.NetworkPortStatus) error {
 		port.Subnet = *dhcpInfo.Subnet
 	}
 	port.NtpServers = dhcpInfo.NtpServers
+	port.DelegatedPrefixes = dhcpInfo.DelegatedPrefixes
 	return nil
 }
 
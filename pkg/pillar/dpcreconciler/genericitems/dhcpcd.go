@@ -280,6 +280,13 @@ func (c *DhcpcdConfigurator) dhcpcdArgs(config types.DhcpConfig) (op string, arg
 		case types.NtDualStack:
 		default:
 		}
+		if config.Type != types.NtIpv4Only {
+			// Also ask for a delegated prefix (IA_PD), used by local IPv6
+			// network instances. "-" means that dhcpcd should not assign
+			// the prefix to any interface, this is done by zedrouter.
+			// IA_NA must be requested explicitly once IA_PD is set.
+			args = append(args, "--ia_na", "--ia_pd=1 -")
+		}
 		if config.Gateway != nil && config.Gateway.String() == zeroIPv4Addr {
 			args = append(args, "--nogateway")
 		}
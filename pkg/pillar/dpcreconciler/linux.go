@@ -588,6 +588,11 @@ func (r *LinuxDpcReconciler) gcpChanged(newGCP types.ConfigItemValueMap) bool {
 	if prevAllowVNC != newAllowVNC {
 		return true
 	}
+	prevAllowP2P := r.prevArgs.GCP.GlobalValueString(types.DownloadP2PKey) != ""
+	newAllowP2P := newGCP.GlobalValueString(types.DownloadP2PKey) != ""
+	if prevAllowP2P != newAllowP2P {
+		return true
+	}
 	return false
 }
 
@@ -1252,6 +1257,13 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 			},
 			LocationTracking: cellCfg.LocationTracking,
 		}
+		for _, simProfile := range cellCfg.SimProfiles {
+			network.SimProfiles = append(network.SimProfiles, types.WwanSimProfile{
+				SlotNumber:     simProfile.SlotNumber,
+				APN:            simProfile.APN,
+				MonthlyDataCap: simProfile.MonthlyDataCap,
+			})
+		}
 		config.Networks = append(config.Networks, network)
 	}
 	return generic.Wwan{Config: config}
@@ -1387,6 +1399,32 @@ func (r *LinuxDpcReconciler) getIntendedACLs(
 		markSSHAndGuacamole, markVnc, markIcmpV6,
 	}
 
+	// Allow other edge nodes to discover and download content from this node,
+	// on the management ports only.
+	if gcp.GlobalValueString(types.DownloadP2PKey) != "" {
+		for _, port := range dpc.Ports {
+			if !port.IsMgmt || port.IfName == "" {
+				continue
+			}
+			markP2PContent := linux.IptablesRule{
+				Args: []string{"-i", port.IfName, "-p", "tcp",
+					"--dport", strconv.Itoa(types.P2PPort), "-j", "CONNMARK",
+					"--set-mark", iptables.ControlProtocolMarkingIDMap["in_p2p_content"]},
+				Description: fmt.Sprintf("Mark content download requests "+
+					"from other edge nodes received on port %s", port.IfName),
+			}
+			markMDNS := linux.IptablesRule{
+				Args: []string{"-i", port.IfName, "-p", "udp", "--dport", "5353",
+					"-j", "CONNMARK",
+					"--set-mark", iptables.ControlProtocolMarkingIDMap["in_p2p_content"]},
+				Description: fmt.Sprintf("Mark mDNS traffic used to discover "+
+					"content on other edge nodes received on port %s", port.IfName),
+			}
+			mangleV4Rules = append(mangleV4Rules, markP2PContent, markMDNS)
+			mangleV6Rules = append(mangleV6Rules, markP2PContent)
+		}
+	}
+
 	// Mark incoming traffic not matched by the rules above with the DROP action.
 	const dropIncomingChain = "drop-incoming"
 	incomingDefDrop := iptables.GetConnmark(0, iptables.DefaultDropAceID, true)
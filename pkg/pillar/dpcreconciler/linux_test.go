@@ -822,3 +822,43 @@ func TestVlansAndBonds(test *testing.T) {
 	t.Expect(vlan200.ParentLL).To(BeEquivalentTo("bond-shopfloor"))
 	t.Expect(vlan200.ParentIfName).To(BeEquivalentTo("bond0"))
 }
+
+func TestP2PContentRules(test *testing.T) {
+	t := initTest(test)
+	gcp := types.DefaultConfigItemValueMap()
+	gcp.SetGlobalValueString(types.DownloadP2PKey, "mock-p2p-key")
+	dpc := types.DevicePortConfig{
+		Version:      types.DPCIsMgmt,
+		Key:          "zedagent",
+		TimePriority: time.Now(),
+		Ports: []types.NetworkPortConfig{
+			{
+				IfName:       "eth0",
+				Phylabel:     "eth0",
+				Logicallabel: "mock-eth0",
+				IsMgmt:       true,
+				IsL3Port:     true,
+			},
+			{
+				IfName:       "eth1",
+				Phylabel:     "eth1",
+				Logicallabel: "mock-eth1",
+				IsMgmt:       false,
+				IsL3Port:     true,
+			},
+		},
+	}
+	ctx := reconciler.MockRun(context.Background())
+	dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc})
+	mangleChain := dg.Reference(linux.IptablesChain{
+		Table: "mangle", ChainName: "PREROUTING-device"})
+	t.Expect(itemDescription(mangleChain)).To(ContainSubstring("-i eth0 -p tcp --dport 8444"))
+	t.Expect(itemDescription(mangleChain)).To(ContainSubstring("-i eth0 -p udp --dport 5353"))
+	t.Expect(itemDescription(mangleChain)).ToNot(ContainSubstring("-i eth1 -p"))
+
+	// Content exchange disabled.
+	gcp = types.DefaultConfigItemValueMap()
+	ctx = reconciler.MockRun(context.Background())
+	dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc})
+	t.Expect(itemDescription(mangleChain)).ToNot(ContainSubstring("--dport 8444"))
+}
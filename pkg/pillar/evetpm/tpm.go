@@ -776,6 +776,83 @@ func PolicyPCRSession(rw io.ReadWriteCloser, pcrSel tpm2.PCRSelection) (tpmutil.
 	return session, policy, nil
 }
 
+// pcrDigestPolicy computes the TPM2 Auth Policy requiring the given digest
+// of the selected PCRs, without the PCRs having these values now
+func pcrDigestPolicy(rw io.ReadWriteCloser, pcrSel tpm2.PCRSelection, pcrDigest []byte) ([]byte, error) {
+	session, _, err := tpm2.StartAuthSession(
+		rw,
+		/*tpmkey=*/ tpm2.HandleNull,
+		/*bindkey=*/ tpm2.HandleNull,
+		/*nonceCaller=*/ make([]byte, 16),
+		/*encryptedSalt=*/ nil,
+		/*sessionType=*/ tpm2.SessionTrial,
+		/*symmetric=*/ tpm2.AlgNull,
+		/*authHash=*/ tpm2.AlgSHA256)
+	if err != nil {
+		return nil, fmt.Errorf("StartAuthSession failed: %v", err)
+	}
+	defer tpm2.FlushContext(rw, session)
+
+	if err = tpm2.PolicyPCR(rw, session, pcrDigest, pcrSel); err != nil {
+		return nil, fmt.Errorf("PolicyPCR failed: %v", err)
+	}
+	policy, err := tpm2.PolicyGetDigest(rw, session)
+	if err != nil {
+		return nil, fmt.Errorf("PolicyGetDigest failed: %w", err)
+	}
+	return policy, nil
+}
+
+// SealToPCRDigest seals data into TPM2.0, to be unsealed only when
+// the selected PCRs have the values with the given SHA256 digest
+// (of the values concatenated in the order of PCR indexes).
+// Returns the private and public parts of the sealed object.
+func SealToPCRDigest(data []byte, pcrSel tpm2.PCRSelection, pcrDigest []byte) ([]byte, []byte, error) {
+	rw, err := tpm2.OpenTPM(TpmDevicePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rw.Close()
+
+	policy, err := pcrDigestPolicy(rw, pcrSel, pcrDigest)
+	if err != nil {
+		return nil, nil, err
+	}
+	priv, public, err := tpm2.Seal(rw, TpmSRKHdl, EmptyPassword, EmptyPassword, policy, data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sealing into TPM failed: %w", err)
+	}
+	return priv, public, nil
+}
+
+// UnsealWithPCRs unseals data sealed by SealToPCRDigest,
+// which succeeds only with the current PCR values matching the digest
+func UnsealWithPCRs(priv, public []byte, pcrSel tpm2.PCRSelection) ([]byte, error) {
+	rw, err := tpm2.OpenTPM(TpmDevicePath)
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+
+	sealedObjHandle, _, err := tpm2.Load(rw, TpmSRKHdl, "", public, priv)
+	if err != nil {
+		return nil, fmt.Errorf("loading the sealed object into TPM failed: %w", err)
+	}
+	defer tpm2.FlushContext(rw, sealedObjHandle)
+
+	session, _, err := PolicyPCRSession(rw, pcrSel)
+	if err != nil {
+		return nil, fmt.Errorf("PolicyPCRSession failed: %v", err)
+	}
+	defer tpm2.FlushContext(rw, session)
+
+	data, err := tpm2.UnsealWithSession(rw, session, sealedObjHandle, EmptyPassword)
+	if err != nil {
+		return nil, fmt.Errorf("UnsealWithSession failed: %w", err)
+	}
+	return data, nil
+}
+
 // CompareLegacyandSealedKey compares legacy and sealed keys
 // to record if we are using a new key for sealed vault
 func CompareLegacyandSealedKey() SealedKeyType {
@@ -14,6 +14,8 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
+const nvmeKelvinOffset = 273
+
 // ReadSMARTinfoForDisks - сollects disks information via API,
 // returns types.DisksInformation
 func ReadSMARTinfoForDisks() (*types.DisksInformation, error) {
@@ -118,7 +120,7 @@ func GetInfoFromSATAdisk(diskName string) (*types.DiskSmartInfo, error) {
 		smartAttr := new(types.DAttrTable)
 		smartAttr.ID = int(smart.Id)
 		smartAttr.Flags = int(smart.Flags)
-		smartAttr.RawValue = int(smart.VendorBytes[0])
+		smartAttr.RawValue = ataRawValue(smart)
 		smartAttr.Value = int(smart.Value)
 		smartAttr.Worst = int(smart.Worst)
 		diskInfo.SmartAttrs = append(diskInfo.SmartAttrs, smartAttr)
@@ -132,6 +134,20 @@ func GetInfoFromSATAdisk(diskName string) (*types.DiskSmartInfo, error) {
 	return diskInfo, nil
 }
 
+// ataRawValue returns raw value of the ATA SMART attribute.
+// Temperature keeps the current value in the lowest byte and min/max in others,
+// counters use all 48 bits.
+func ataRawValue(attr smart.AtaSmartAttr) int {
+	if int(attr.Id) == types.SmartAttrIDTemperatureCelsius {
+		return int(attr.VendorBytes[0])
+	}
+	var raw uint64
+	for i := len(attr.VendorBytes) - 1; i >= 0; i-- {
+		raw = raw<<8 | uint64(attr.VendorBytes[i])
+	}
+	return int(raw)
+}
+
 // GetInfoFromNVMeDisk - takes a disk name (/dev/sda or /dev/nvme0n1)
 // as input and returns information on it
 func GetInfoFromNVMeDisk(diskName string) (*types.DiskSmartInfo, error) {
@@ -171,9 +187,22 @@ func GetInfoFromNVMeDisk(diskName string) (*types.DiskSmartInfo, error) {
 
 	smartTemperature := new(types.DAttrTable)
 	smartTemperature.ID = types.SmartAttrIDTemperatureCelsius
-	smartTemperature.RawValue = int(smartAttr.Temperature)
+	// NVMe reports temperature in Kelvin
+	if smartAttr.Temperature > nvmeKelvinOffset {
+		smartTemperature.RawValue = int(smartAttr.Temperature) - nvmeKelvinOffset
+	}
 	diskInfo.SmartAttrs = append(diskInfo.SmartAttrs, smartTemperature)
 
+	smartPercentageUsed := new(types.DAttrTable)
+	smartPercentageUsed.ID = types.SmartAttrIDNVMePercentageUsed
+	smartPercentageUsed.RawValue = int(smartAttr.PercentUsed)
+	diskInfo.SmartAttrs = append(diskInfo.SmartAttrs, smartPercentageUsed)
+
+	smartMediaErrors := new(types.DAttrTable)
+	smartMediaErrors.ID = types.SmartAttrIDNVMeMediaErrors
+	smartMediaErrors.RawValue = int(smartAttr.MediaErrors.Val[0])
+	diskInfo.SmartAttrs = append(diskInfo.SmartAttrs, smartMediaErrors)
+
 	smartPowerOnHours := new(types.DAttrTable)
 	smartPowerOnHours.ID = types.SmartAttrIDPowerOnHours
 	smartPowerOnHours.RawValue = int(smartAttr.PowerOnHours.Val[0])
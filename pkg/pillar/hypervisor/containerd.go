@@ -5,11 +5,13 @@ package hypervisor
 
 import (
 	"fmt"
+	v1stat "github.com/containerd/cgroups/stats/v1"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -171,6 +173,16 @@ func (ctx ctrdContext) Delete(domainName string) error {
 	return nil
 }
 
+// SetMemory is not supported for containers, which have no balloon
+func (ctx ctrdContext) SetMemory(domainName string, memory int) error {
+	return fmt.Errorf("cannot resize the memory of task %s", domainName)
+}
+
+// SetVCpus is not supported for containers, which have no vCPUs
+func (ctx ctrdContext) SetVCpus(domainName string, vcpus int) error {
+	return fmt.Errorf("cannot change the vCPUs of task %s", domainName)
+}
+
 // Cleanup deletes stale containers if exists
 func (ctx ctrdContext) Cleanup(domainName string) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
@@ -259,6 +271,7 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 		var usedMem, maxUsedMem, availMem, totalMem uint32
 		var usedMemPerc float64
 		var cpuTotal uint64
+		var cgroup types.DomainMetric
 
 		if metric, err := ctx.ctrdClient.CtrGetContainerMetrics(ctrdCtx, id); err == nil {
 			if metric.Memory == nil || metric.Memory.Usage == nil {
@@ -282,6 +295,18 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 			} else {
 				cpuTotal = metric.CPU.Usage.Total
 			}
+			if metric.CPU != nil && metric.CPU.Throttling != nil {
+				cgroup.CPUThrottledPeriods = metric.CPU.Throttling.ThrottledPeriods
+				cgroup.CPUThrottledNs = metric.CPU.Throttling.ThrottledTime
+			}
+			if metric.Pids != nil {
+				cgroup.PidsCurrent = metric.Pids.Current
+				cgroup.PidsLimit = metric.Pids.Limit
+			}
+			if metric.Blkio != nil {
+				cgroup.IoReadBytes, cgroup.IoWriteBytes = sumBlkIOEntries(metric.Blkio.IoServiceBytesRecursive)
+				cgroup.IoReadOps, cgroup.IoWriteOps = sumBlkIOEntries(metric.Blkio.IoServicedRecursive)
+			}
 		} else {
 			logrus.Errorf("GetDomsCPUMem failed with error %v", err)
 		}
@@ -295,7 +320,34 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 			MaxUsedMemory:     maxUsedMem,
 			AvailableMemory:   availMem,
 			UsedMemoryPercent: usedMemPerc,
+
+			CPUThrottledPeriods: cgroup.CPUThrottledPeriods,
+			CPUThrottledNs:      cgroup.CPUThrottledNs,
+			PidsCurrent:         cgroup.PidsCurrent,
+			PidsLimit:           cgroup.PidsLimit,
+			IoReadBytes:         cgroup.IoReadBytes,
+			IoWriteBytes:        cgroup.IoWriteBytes,
+			IoReadOps:           cgroup.IoReadOps,
+			IoWriteOps:          cgroup.IoWriteOps,
 		}
 	}
 	return res, nil
 }
+
+// sumBlkIOEntries returns the sums of the read and of the write values of
+// the blkio entries of all the block devices
+func sumBlkIOEntries(entries []*v1stat.BlkIOEntry) (uint64, uint64) {
+	var read, write uint64
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	return read, write
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/shirou/gopsutil/mem"
 	"github.com/sirupsen/logrus"
 	"os"
+	"time"
 )
 
 // Hypervisor provides methods for manipulating domains on the host
@@ -27,6 +28,17 @@ type Hypervisor interface {
 	GetCapabilities() (*types.Capabilities, error)
 }
 
+// GuestAgent is implemented by the hypervisors which can talk to an agent
+// running inside their domains, if it is enabled in the DomainConfig.
+// The methods return ErrNoGuestAgent if it is not.
+type GuestAgent interface {
+	GuestPing(domainName string, timeout time.Duration) error
+	GuestFsFreeze(domainName string) error
+	GuestFsThaw(domainName string) error
+	GuestSetTime(domainName string, t time.Time) error
+	GuestInfo(domainName string) (types.GuestInfo, error)
+}
+
 type hypervisorDesc struct {
 	constructor func() Hypervisor
 	dom0handle  string
@@ -141,8 +141,14 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 
 [smp-opts]
   cpus = "{{.DomainConfig.VCpus}}"
+{{- if gt .DomainConfig.MaxCpus .DomainConfig.VCpus }}
+  maxcpus = "{{.DomainConfig.MaxCpus}}"
+  sockets = "1"
+  cores = "{{.DomainConfig.MaxCpus}}"
+{{- else }}
   sockets = "1"
   cores = "{{.DomainConfig.VCpus}}"
+{{- end }}
   threads = "1"
 
 [device]
@@ -162,7 +168,18 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   driver = "virtconsole"
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
+{{if .DomainConfig.EnableGuestAgent}}
+[chardev "charqga"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DomainConfig.DisplayName}}/qga"
+  server = "on"
+  wait = "off"
 
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+{{end}}
 {{if .DomainConfig.EnableVnc}}
 [vnc "default"]
   vnc = "0.0.0.0:{{if .DomainConfig.VncDisplay}}{{.DomainConfig.VncDisplay}}{{else}}0{{end}}"
@@ -355,6 +372,14 @@ const qemuUsbHostTemplate = `
   hostaddr = "{{.UsbDevAddr}}"
 `
 
+// the balloon is rendered last, so that it gets a PCI slot which is not
+// taken by any of the devices with an explicit address
+const qemuBalloonTemplate = `
+[device "balloon0"]
+  driver = "virtio-balloon-pci"
+  deflate-on-oom = "on"
+`
+
 const kvmStateDir = "/run/hypervisor/kvm/"
 const sysfsPciDevices = "/sys/bus/pci/devices/"
 const sysfsVfioPciBind = "/sys/bus/pci/drivers/vfio-pci/bind"
@@ -367,6 +392,7 @@ const vfioDriverPath = "/sys/bus/pci/drivers/vfio-pci"
 //
 //	 pid - contains PID of the anchor process
 //	 qmp - UNIX domain socket that allows us to talk to anchor process
+//	 qga - UNIX domain socket of the guest agent channel, if enabled
 //	cons - symlink to /dev/pts/X that allows us to talk to the serial console of the domain
 //
 // In addition to that, we also maintain DOMAIN_NAME -> PID mapping in kvmContext, so we don't
@@ -472,6 +498,10 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 	}
 
 	dmArgs := ctx.dmArgs
+	if config.MaxMem != 0 {
+		// locked memory could not be given back by the balloon
+		dmArgs = withoutMemLock(dmArgs)
+	}
 	if config.VirtualizationMode == types.FML {
 		dmArgs = append(dmArgs, ctx.dmFmlCPUArgs...)
 	} else {
@@ -536,7 +566,12 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 		types.DomainConfig
 		types.DomainStatus
 	}{ctx.devicemodel, config, status}
+	// a domain which balloons boots with MaxMem, and domainmgr lowers its
+	// balloon target to Memory once it runs
 	tmplCtx.DomainConfig.Memory = (config.Memory + 1023) / 1024
+	if config.MaxMem > config.Memory {
+		tmplCtx.DomainConfig.Memory = (config.MaxMem + 1023) / 1024
+	}
 	tmplCtx.DomainConfig.DisplayName = domainName
 
 	// render global device model settings
@@ -708,6 +743,12 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 			}
 		}
 	}
+	if config.MaxMem != 0 {
+		t, _ = template.New("qemuBalloon").Parse(qemuBalloonTemplate)
+		if err := t.Execute(file, nil); err != nil {
+			return logError("can't write balloon to config file %s (%v)", file.Name(), err)
+		}
+	}
 
 	return nil
 }
@@ -784,6 +825,15 @@ func (ctx kvmContext) Start(domainName string) error {
 }
 
 func (ctx kvmContext) Stop(domainName string, force bool) error {
+	// the guest agent shuts the guest down even if it ignores ACPI
+	err := execGuestShutdown(getQgaSocket(domainName))
+	if err == nil {
+		return nil
+	}
+	if err != ErrNoGuestAgent {
+		logrus.Warnf("Stop: guest agent shutdown of %s failed, using ACPI: %v",
+			domainName, err)
+	}
 	if err := execShutdown(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("Stop: failed to execute shutdown command %v", err)
 	}
@@ -941,6 +991,21 @@ func usbBusPort(USBAddr string) (string, string) {
 	return "", ""
 }
 
+// withoutMemLock returns the device model arguments without
+// "-overcommit mem-lock=on"
+func withoutMemLock(dmArgs []string) []string {
+	var args []string
+	for i := 0; i < len(dmArgs); i++ {
+		if dmArgs[i] == "-overcommit" && i+1 < len(dmArgs) &&
+			dmArgs[i+1] == "mem-lock=on" {
+			i++
+			continue
+		}
+		args = append(args, dmArgs[i])
+	}
+	return args
+}
+
 func getQmpExecutorSocket(domainName string) string {
 	return kvmStateDir + domainName + "/qmp"
 }
@@ -948,3 +1013,70 @@ func getQmpExecutorSocket(domainName string) string {
 func getQmpListenerSocket(domainName string) string {
 	return kvmStateDir + domainName + "/listener.qmp"
 }
+
+func getQgaSocket(domainName string) string {
+	return kvmStateDir + domainName + "/qga"
+}
+
+// SetMemory sets the balloon target of the domain in kbytes
+func (ctx kvmContext) SetMemory(domainName string, memory int) error {
+	if err := execBalloon(getQmpExecutorSocket(domainName), int64(memory)*1024); err != nil {
+		return logError("failed to set the balloon of domain %s to %d kB: %v",
+			domainName, memory, err)
+	}
+	return nil
+}
+
+// SetVCpus hot-adds or removes vCPUs of the domain. The guest has to bring
+// the added vCPUs online, and to agree to release the removed ones.
+func (ctx kvmContext) SetVCpus(domainName string, vcpus int) error {
+	if ctx.devicemodel == "virt" {
+		return logError("vCPU hotplug is not supported by %s", ctx.devicemodel)
+	}
+	qmpFile := getQmpExecutorSocket(domainName)
+	slots, err := getHotpluggableCPUs(qmpFile)
+	if err != nil {
+		return logError("failed to query the vCPUs of domain %s: %v", domainName, err)
+	}
+	add, del, err := planVCpus(slots, vcpus)
+	if err != nil {
+		return logError("domain %s: %v", domainName, err)
+	}
+	for _, slot := range add {
+		if err := execCPUAdd(qmpFile, slot); err != nil {
+			return logError("failed to add a vCPU to domain %s: %v", domainName, err)
+		}
+	}
+	for _, slot := range del {
+		if err := execCPUDel(qmpFile, slot); err != nil {
+			return logError("failed to remove vCPU %s of domain %s: %v",
+				slot.QomPath, domainName, err)
+		}
+	}
+	return nil
+}
+
+// GuestPing checks that the guest agent of the domain responds
+func (ctx kvmContext) GuestPing(domainName string, timeout time.Duration) error {
+	return execGuestPing(getQgaSocket(domainName), timeout)
+}
+
+// GuestFsFreeze freezes the filesystems of the guest
+func (ctx kvmContext) GuestFsFreeze(domainName string) error {
+	return execGuestFsFreeze(getQgaSocket(domainName))
+}
+
+// GuestFsThaw thaws the filesystems of the guest
+func (ctx kvmContext) GuestFsThaw(domainName string) error {
+	return execGuestFsThaw(getQgaSocket(domainName))
+}
+
+// GuestSetTime sets the clock of the guest
+func (ctx kvmContext) GuestSetTime(domainName string, t time.Time) error {
+	return execGuestSetTime(getQgaSocket(domainName), t)
+}
+
+// GuestInfo returns the OS, hostname and IP addresses of the guest
+func (ctx kvmContext) GuestInfo(domainName string) (types.GuestInfo, error) {
+	return getGuestInfo(getQgaSocket(domainName))
+}
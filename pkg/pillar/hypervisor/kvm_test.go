@@ -1,9 +1,12 @@
 package hypervisor
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"reflect"
+	"strings"
 	"testing"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -2128,6 +2131,127 @@ func TestCreateDomConfig(t *testing.T) {
 	})
 }
 
+func TestCreateDomConfigGuestAgent(t *testing.T) {
+	initTest(t)
+	config := types.DomainConfig{
+		VmConfig: types.VmConfig{
+			Memory:           1024 * 1024,
+			VCpus:            1,
+			EnableGuestAgent: true,
+		},
+	}
+	conf, err := ioutil.TempFile("/tmp", "config")
+	if err != nil {
+		t.Fatalf("Can't create config file for a domain %v", err)
+	}
+	defer os.Remove(conf.Name())
+	if err := kvmIntel.CreateDomConfig("test", config, types.DomainStatus{}, nil, nil, conf); err != nil {
+		t.Fatalf("CreateDomConfig failed %v", err)
+	}
+	result, err := ioutil.ReadFile(conf.Name())
+	if err != nil {
+		t.Fatalf("reading conf file failed %v", err)
+	}
+	expected := `
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+`
+	if !strings.Contains(string(result), expected) {
+		t.Errorf("got an unexpected guest agent channel:\n%s", string(result))
+	}
+}
+
+func TestCreateDomConfigResizable(t *testing.T) {
+	initTest(t)
+	config := types.DomainConfig{
+		VmConfig: types.VmConfig{
+			Memory:  1024 * 1024,
+			MaxMem:  4 * 1024 * 1024,
+			VCpus:   2,
+			MaxCpus: 4,
+		},
+	}
+	conf, err := ioutil.TempFile("/tmp", "config")
+	if err != nil {
+		t.Fatalf("Can't create config file for a domain %v", err)
+	}
+	defer os.Remove(conf.Name())
+	if err := kvmIntel.CreateDomConfig("test", config, types.DomainStatus{}, nil, nil, conf); err != nil {
+		t.Fatalf("CreateDomConfig failed %v", err)
+	}
+	result, err := ioutil.ReadFile(conf.Name())
+	if err != nil {
+		t.Fatalf("reading conf file failed %v", err)
+	}
+	for _, expected := range []string{`
+[memory]
+  size = "4096"
+`, `
+[smp-opts]
+  cpus = "2"
+  maxcpus = "4"
+  sockets = "1"
+  cores = "4"
+  threads = "1"
+`, `
+[device "balloon0"]
+  driver = "virtio-balloon-pci"
+  deflate-on-oom = "on"
+`} {
+		if !strings.Contains(string(result), expected) {
+			t.Errorf("expected %s in the config:\n%s", expected, string(result))
+		}
+	}
+}
+
+func TestPlanVCpus(t *testing.T) {
+	slot := func(core int64, present bool) hotpluggableCPU {
+		s := hotpluggableCPU{
+			Type:  "host-x86_64-cpu",
+			Props: map[string]int64{"socket-id": 0, "core-id": core, "thread-id": 0},
+		}
+		if present {
+			s.QomPath = fmt.Sprintf("/machine/unattached/device[%d]", core)
+		}
+		return s
+	}
+	// QEMU lists the slots in reverse order
+	slots := []hotpluggableCPU{slot(3, false), slot(2, false), slot(1, true), slot(0, true)}
+	cores := func(list []hotpluggableCPU) []int64 {
+		var ids []int64
+		for _, s := range list {
+			ids = append(ids, s.Props["core-id"])
+		}
+		return ids
+	}
+
+	add, del, err := planVCpus(slots, 3)
+	if err != nil || !reflect.DeepEqual(cores(add), []int64{2}) || len(del) != 0 {
+		t.Errorf("planVCpus(3) returned %v %v %v", cores(add), cores(del), err)
+	}
+	add, del, err = planVCpus(slots, 1)
+	if err != nil || len(add) != 0 || !reflect.DeepEqual(cores(del), []int64{1}) {
+		t.Errorf("planVCpus(1) returned %v %v %v", cores(add), cores(del), err)
+	}
+	slots[1] = slot(2, true)
+	slots[2] = slot(1, false)
+	add, del, err = planVCpus(slots, 2)
+	if err != nil || len(add) != 0 || len(del) != 0 {
+		t.Errorf("planVCpus(2) returned %v %v %v", cores(add), cores(del), err)
+	}
+	if _, _, err := planVCpus(slots, 5); err == nil {
+		t.Errorf("planVCpus(5) should have failed")
+	}
+}
+
 func TestCreateDom(t *testing.T) {
 	initTest(t)
 	if exec.Command("qemu-system-x86_64", "--version").Run() != nil {
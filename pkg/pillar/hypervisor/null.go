@@ -118,6 +118,16 @@ func (ctx nullContext) Delete(domainName string) error {
 	return nil
 }
 
+// SetMemory is noop for null hypervisor
+func (ctx nullContext) SetMemory(_ string, _ int) error {
+	return nil
+}
+
+// SetVCpus is noop for null hypervisor
+func (ctx nullContext) SetVCpus(_ string, _ int) error {
+	return nil
+}
+
 // Cleanup is noop for null hypervisor
 func (ctx nullContext) Cleanup(_ string) error {
 	return nil
@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements a subset of
+//     https://qemu.readthedocs.io/en/latest/interop/qemu-ga-ref.html
+// talking to the qemu-guest-agent inside the domain over its virtio-serial
+// channel, which QEMU exposes as a UNIX domain socket on the host
+
+const qgaTimeout = 5 * time.Second
+
+// qgaFsFreezeTimeout is how long the guest may take to freeze or thaw its
+// filesystems, which involves flushing them
+const qgaFsFreezeTimeout = time.Minute
+
+// ErrNoGuestAgent is returned when the domain has no guest agent channel
+var ErrNoGuestAgent = errors.New("no guest agent channel")
+
+type qgaError struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+type qgaResponse struct {
+	Return json.RawMessage `json:"return"`
+	Error  *qgaError       `json:"error"`
+}
+
+type qgaConn struct {
+	conn    net.Conn
+	decoder *json.Decoder
+}
+
+// qgaConnect connects to the guest agent channel of the domain and syncs
+// with the agent, which drops anything left in the channel by an earlier
+// command which timed out
+func qgaConnect(socket string, timeout time.Duration) (*qgaConn, error) {
+	if _, err := os.Stat(socket); err != nil {
+		return nil, ErrNoGuestAgent
+	}
+	conn, err := net.DialTimeout("unix", socket, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	id := rand.Int31()
+	if err := qgaSend(conn, "guest-sync-delimited", map[string]int32{"id": id}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// the agent prefixes the response with a 0xff sentinel
+	reader := bufio.NewReader(conn)
+	if _, err := reader.ReadBytes(0xff); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("guest agent did not sync: %v", err)
+	}
+	qc := &qgaConn{conn: conn, decoder: json.NewDecoder(reader)}
+	var synced int32
+	if err := qc.receive(&synced); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if synced != id {
+		conn.Close()
+		return nil, fmt.Errorf("guest agent synced with id %d instead of %d",
+			synced, id)
+	}
+	return qc, nil
+}
+
+func qgaSend(conn net.Conn, cmd string, args interface{}) error {
+	req := struct {
+		Execute   string      `json:"execute"`
+		Arguments interface{} `json:"arguments,omitempty"`
+	}{cmd, args}
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("executing QGA command: %s", raw)
+	_, err = conn.Write(raw)
+	return err
+}
+
+func (qc *qgaConn) receive(result interface{}) error {
+	var resp qgaResponse
+	if err := qc.decoder.Decode(&resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %s", resp.Error.Class, resp.Error.Desc)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Return, result)
+}
+
+func (qc *qgaConn) close() {
+	qc.conn.Close()
+}
+
+// execGuestAgentCmd runs a command of the guest agent and stores its return
+// value into result, unless it is nil
+func execGuestAgentCmd(socket string, timeout time.Duration, cmd string,
+	args interface{}, result interface{}) error {
+
+	qc, err := qgaConnect(socket, timeout)
+	if err != nil {
+		return err
+	}
+	defer qc.close()
+	if err := qgaSend(qc.conn, cmd, args); err != nil {
+		return err
+	}
+	if err := qc.receive(result); err != nil {
+		return fmt.Errorf("%s failed: %v", cmd, err)
+	}
+	return nil
+}
+
+func execGuestPing(socket string, timeout time.Duration) error {
+	return execGuestAgentCmd(socket, timeout, "guest-ping", nil, nil)
+}
+
+// execGuestShutdown asks the guest to power down. The agent does not
+// respond when the shutdown succeeds.
+func execGuestShutdown(socket string) error {
+	qc, err := qgaConnect(socket, qgaTimeout)
+	if err != nil {
+		return err
+	}
+	defer qc.close()
+	return qgaSend(qc.conn, "guest-shutdown", map[string]string{"mode": "powerdown"})
+}
+
+func execGuestFsFreeze(socket string) error {
+	var frozen int
+	if err := execGuestAgentCmd(socket, qgaFsFreezeTimeout,
+		"guest-fsfreeze-freeze", nil, &frozen); err != nil {
+		return err
+	}
+	logrus.Infof("guest agent froze %d filesystems", frozen)
+	return nil
+}
+
+func execGuestFsThaw(socket string) error {
+	var thawed int
+	if err := execGuestAgentCmd(socket, qgaFsFreezeTimeout,
+		"guest-fsfreeze-thaw", nil, &thawed); err != nil {
+		return err
+	}
+	logrus.Infof("guest agent thawed %d filesystems", thawed)
+	return nil
+}
+
+func execGuestSetTime(socket string, t time.Time) error {
+	return execGuestAgentCmd(socket, qgaTimeout, "guest-set-time",
+		map[string]int64{"time": t.UnixNano()}, nil)
+}
+
+func getGuestInfo(socket string) (types.GuestInfo, error) {
+	var info types.GuestInfo
+	var osInfo struct {
+		Name          string `json:"name"`
+		PrettyName    string `json:"pretty-name"`
+		Version       string `json:"version"`
+		KernelRelease string `json:"kernel-release"`
+	}
+	if err := execGuestAgentCmd(socket, qgaTimeout, "guest-get-osinfo",
+		nil, &osInfo); err != nil {
+		return info, err
+	}
+	info.OSName = osInfo.Name
+	if info.OSName == "" {
+		info.OSName = osInfo.PrettyName
+	}
+	info.OSVersion = osInfo.Version
+	info.KernelRelease = osInfo.KernelRelease
+	var hostName struct {
+		HostName string `json:"host-name"`
+	}
+	if err := execGuestAgentCmd(socket, qgaTimeout, "guest-get-host-name",
+		nil, &hostName); err != nil {
+		return info, err
+	}
+	info.Hostname = hostName.HostName
+	var ifaces []struct {
+		Name        string `json:"name"`
+		IPAddresses []struct {
+			IPAddress string `json:"ip-address"`
+		} `json:"ip-addresses"`
+	}
+	if err := execGuestAgentCmd(socket, qgaTimeout,
+		"guest-network-get-interfaces", nil, &ifaces); err != nil {
+		return info, err
+	}
+	for _, iface := range ifaces {
+		for _, addr := range iface.IPAddresses {
+			// drop the zone of IPv6 link-local addresses
+			ip := net.ParseIP(strings.SplitN(addr.IPAddress, "%", 2)[0])
+			if ip == nil || ip.IsLoopback() {
+				continue
+			}
+			info.IPAddrs = append(info.IPAddrs, ip)
+		}
+	}
+	info.Connected = true
+	return info, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeGuestAgent answers the commands of the guest agent protocol with the
+// given return values, after leaving some garbage in the channel
+func fakeGuestAgent(t *testing.T, returns map[string]string) string {
+	dir, err := ioutil.TempDir("", "qga")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "qga")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				decoder := json.NewDecoder(bufio.NewReader(conn))
+				for {
+					var req struct {
+						Execute   string                 `json:"execute"`
+						Arguments map[string]interface{} `json:"arguments"`
+					}
+					if err := decoder.Decode(&req); err != nil {
+						return
+					}
+					var resp string
+					switch req.Execute {
+					case "guest-sync-delimited":
+						id, _ := json.Marshal(req.Arguments["id"])
+						resp = `{"return": 4}` + "\n\xff" + `{"return": ` + string(id) + "}\n"
+					case "guest-shutdown":
+						continue
+					default:
+						ret, ok := returns[req.Execute]
+						if !ok {
+							resp = `{"error": {"class": "CommandNotFound", "desc": "unknown"}}` + "\n"
+						} else {
+							resp = `{"return": ` + ret + "}\n"
+						}
+					}
+					if _, err := conn.Write([]byte(resp)); err != nil {
+						return
+					}
+				}
+			}(conn)
+		}
+	}()
+	return socket
+}
+
+func TestGuestAgent(t *testing.T) {
+	socket := fakeGuestAgent(t, map[string]string{
+		"guest-ping":            "{}",
+		"guest-fsfreeze-freeze": "2",
+		"guest-get-osinfo":      `{"name": "Ubuntu", "version": "22.04.2 LTS", "kernel-release": "5.15.0-76-generic"}`,
+		"guest-get-host-name":   `{"host-name": "app1"}`,
+		"guest-network-get-interfaces": `[
+			{"name": "lo", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "127.0.0.1", "prefix": 8}]},
+			{"name": "eth0", "ip-addresses": [
+				{"ip-address-type": "ipv4", "ip-address": "10.1.0.2", "prefix": 24},
+				{"ip-address-type": "ipv6", "ip-address": "fe80::1%eth0", "prefix": 64}]}]`,
+	})
+
+	if err := execGuestPing(socket, time.Second); err != nil {
+		t.Errorf("execGuestPing failed: %v", err)
+	}
+	if err := execGuestFsFreeze(socket); err != nil {
+		t.Errorf("execGuestFsFreeze failed: %v", err)
+	}
+	if err := execGuestFsThaw(socket); err == nil {
+		t.Errorf("execGuestFsThaw should have failed for an unknown command")
+	}
+	if err := execGuestShutdown(socket); err != nil {
+		t.Errorf("execGuestShutdown failed: %v", err)
+	}
+	info, err := getGuestInfo(socket)
+	if err != nil {
+		t.Fatalf("getGuestInfo failed: %v", err)
+	}
+	if !info.Connected || info.OSName != "Ubuntu" || info.OSVersion != "22.04.2 LTS" ||
+		info.KernelRelease != "5.15.0-76-generic" || info.Hostname != "app1" {
+		t.Errorf("getGuestInfo returned %+v", info)
+	}
+	if len(info.IPAddrs) != 2 || info.IPAddrs[0].String() != "10.1.0.2" ||
+		info.IPAddrs[1].String() != "fe80::1" {
+		t.Errorf("getGuestInfo returned IP addresses %v", info.IPAddrs)
+	}
+
+	if err := execGuestPing(socket+"-missing", time.Second); err != ErrNoGuestAgent {
+		t.Errorf("execGuestPing returned %v instead of ErrNoGuestAgent", err)
+	}
+}
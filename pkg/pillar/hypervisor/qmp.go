@@ -7,6 +7,7 @@ import (
 	"github.com/digitalocean/go-qemu/qmp"
 	"github.com/sirupsen/logrus"
 	"os"
+	"sort"
 	"time"
 )
 
@@ -84,6 +85,103 @@ func getQemuStatus(socket string) (string, error) {
 	}
 }
 
+func execBalloon(socket string, bytes int64) error {
+	balloon := fmt.Sprintf(`{ "execute": "balloon", "arguments": { "value": %d } }`, bytes)
+	_, err := execRawCmd(socket, balloon)
+	return err
+}
+
+// hotpluggableCPU is a vCPU slot of the domain. The vCPU is present if
+// it has a QOM path.
+type hotpluggableCPU struct {
+	Type    string           `json:"type"`
+	Props   map[string]int64 `json:"props"`
+	QomPath string           `json:"qom-path"`
+}
+
+func getHotpluggableCPUs(socket string) ([]hotpluggableCPU, error) {
+	raw, err := execRawCmd(socket, `{ "execute": "query-hotpluggable-cpus" }`)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Return []hotpluggableCPU `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	return result.Return, nil
+}
+
+// planVCpus returns the vCPU slots to plug and to unplug to get vcpus
+// vCPUs. The vCPUs are added in topology order and removed in reverse,
+// and the first vCPU is never removed.
+func planVCpus(slots []hotpluggableCPU, vcpus int) (add, del []hotpluggableCPU, err error) {
+	if vcpus < 1 || vcpus > len(slots) {
+		return nil, nil, fmt.Errorf("cannot have %d vCPUs with %d vCPU slots",
+			vcpus, len(slots))
+	}
+	sorted := make([]hotpluggableCPU, len(slots))
+	copy(sorted, slots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, prop := range []string{"node-id", "socket-id", "die-id", "core-id", "thread-id"} {
+			if sorted[i].Props[prop] != sorted[j].Props[prop] {
+				return sorted[i].Props[prop] < sorted[j].Props[prop]
+			}
+		}
+		return false
+	})
+	present := 0
+	for _, slot := range sorted {
+		if slot.QomPath != "" {
+			present++
+		}
+	}
+	for i := 0; i < len(sorted) && present < vcpus; i++ {
+		if sorted[i].QomPath == "" {
+			add = append(add, sorted[i])
+			present++
+		}
+	}
+	for i := len(sorted) - 1; i > 0 && present > vcpus; i-- {
+		if sorted[i].QomPath != "" {
+			del = append(del, sorted[i])
+			present--
+		}
+	}
+	return add, del, nil
+}
+
+func execCPUAdd(socket string, slot hotpluggableCPU) error {
+	args := map[string]interface{}{"driver": slot.Type}
+	id := "cpu"
+	for prop, value := range slot.Props {
+		args[prop] = value
+	}
+	for _, prop := range []string{"socket-id", "core-id", "thread-id"} {
+		id += fmt.Sprintf("-%d", slot.Props[prop])
+	}
+	args["id"] = id
+	cmd, err := json.Marshal(map[string]interface{}{"execute": "device_add", "arguments": args})
+	if err != nil {
+		return err
+	}
+	_, err = execRawCmd(socket, string(cmd))
+	return err
+}
+
+// execCPUDel asks the guest to release the vCPU, which it does
+// asynchronously
+func execCPUDel(socket string, slot hotpluggableCPU) error {
+	cmd, err := json.Marshal(map[string]interface{}{"execute": "device_del",
+		"arguments": map[string]string{"id": slot.QomPath}})
+	if err != nil {
+		return err
+	}
+	_, err = execRawCmd(socket, string(cmd))
+	return err
+}
+
 func qmpEventHandler(listenerSocket, executorSocket string) {
 	monitor, err := qmp.NewSocketMonitor("unix", listenerSocket, sockTimeout)
 	if err != nil {
@@ -495,6 +495,31 @@ func (ctx xenContext) Stop(domainName string, force bool) error {
 	return nil
 }
 
+// SetMemory sets the balloon target of the domain in kbytes
+func (ctx xenContext) SetMemory(domainName string, memory int) error {
+	return ctx.xlResize(domainName, "mem-set", fmt.Sprintf("%dk", memory))
+}
+
+// SetVCpus sets the number of vCPUs of the domain which are online
+func (ctx xenContext) SetVCpus(domainName string, vcpus int) error {
+	return ctx.xlResize(domainName, "vcpu-set", strconv.Itoa(vcpus))
+}
+
+func (ctx xenContext) xlResize(domainName string, cmd string, value string) error {
+	logrus.Infof("xl %s %s %s\n", cmd, domainName, value)
+	ctrdSystemCtx, done := ctx.ctrdClient.CtrNewSystemServicesCtx()
+	defer done()
+	stdOut, stdErr, err := ctx.ctrdClient.CtrSystemExec(ctrdSystemCtx, "xen-tools",
+		[]string{"xl", cmd, domainName, value})
+	if err != nil {
+		errStr := fmt.Sprintf("xl %s failed: %s %s", cmd, stdOut, stdErr)
+		logrus.Errorln(errStr)
+		return errors.New(errStr)
+	}
+	logrus.Infof("xl %s done: stdout: %s, stderr: %s", cmd, stdOut, stdErr)
+	return nil
+}
+
 func (ctx xenContext) Delete(domainName string) (result error) {
 	// regardless of happens to everything else, we have to try and delete the task
 	defer func() {
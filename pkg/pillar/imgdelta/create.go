@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imgdelta
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// blockSize is the granularity of matching the target against the source.
+// Blocks of the source are indexed and looked up at every offset
+// of the target using a rolling checksum.
+const blockSize = 512
+
+// rollingSum is the rsync weak checksum of a block
+type rollingSum struct {
+	a, b uint32
+}
+
+func newRollingSum(block []byte) rollingSum {
+	var s rollingSum
+	for i, c := range block {
+		s.a += uint32(c)
+		s.b += uint32(len(block)-i) * uint32(c)
+	}
+	return s
+}
+
+// roll moves the block by one byte
+func (s *rollingSum) roll(out, in byte) {
+	s.a += uint32(in) - uint32(out)
+	s.b += s.a - blockSize*uint32(out)
+}
+
+func (s rollingSum) value() uint32 {
+	return s.a&0xffff | s.b<<16
+}
+
+// encoder writes the instructions
+type encoder struct {
+	w   io.Writer
+	buf [2*binary.MaxVarintLen64 + 1]byte
+}
+
+func (e *encoder) copy(offset, n int) error {
+	if n == 0 {
+		return nil
+	}
+	e.buf[0] = opCopy
+	l := 1 + binary.PutUvarint(e.buf[1:], uint64(offset))
+	l += binary.PutUvarint(e.buf[l:], uint64(n))
+	_, err := e.w.Write(e.buf[:l])
+	return err
+}
+
+func (e *encoder) data(data []byte) error {
+	for len(data) > 0 {
+		n := len(data)
+		if n > maxDataLen {
+			n = maxDataLen
+		}
+		e.buf[0] = opData
+		l := 1 + binary.PutUvarint(e.buf[1:], uint64(n))
+		if _, err := e.w.Write(e.buf[:l]); err != nil {
+			return err
+		}
+		if _, err := e.w.Write(data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// Create writes the delta producing target from source into w.
+// The images are expected to fit into memory; it is meant for build tools
+// and tests rather than for the edge node.
+func Create(source, target []byte, w io.Writer) error {
+	h := Header{
+		SourceSize:   uint64(len(source)),
+		SourceSha256: sha256.Sum256(source),
+		TargetSize:   uint64(len(target)),
+		TargetSha256: sha256.Sum256(target),
+	}
+	if err := writeHeader(w, h); err != nil {
+		return err
+	}
+	zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return err
+	}
+	enc := &encoder{w: zw}
+
+	// index the source blocks, the first one wins
+	index := make(map[uint32]int, len(source)/blockSize)
+	for off := 0; off+blockSize <= len(source); off += blockSize {
+		sum := newRollingSum(source[off : off+blockSize]).value()
+		if _, ok := index[sum]; !ok {
+			index[sum] = off
+		}
+	}
+
+	pending := 0 // start of the data not yet written
+	pos := 0
+	var sum rollingSum
+	if len(target) >= blockSize {
+		sum = newRollingSum(target[:blockSize])
+	}
+	for pos+blockSize <= len(target) {
+		off, ok := index[sum.value()]
+		if !ok || !bytes.Equal(source[off:off+blockSize], target[pos:pos+blockSize]) {
+			if pos+blockSize < len(target) {
+				sum.roll(target[pos], target[pos+blockSize])
+			}
+			pos++
+			continue
+		}
+		// extend the match in both directions
+		start, end := pos, pos+blockSize
+		srcStart := off
+		for start > pending && srcStart > 0 && source[srcStart-1] == target[start-1] {
+			start--
+			srcStart--
+		}
+		for end < len(target) && srcStart+end-start < len(source) &&
+			source[srcStart+end-start] == target[end] {
+			end++
+		}
+		if err := enc.data(target[pending:start]); err != nil {
+			return err
+		}
+		if err := enc.copy(srcStart, end-start); err != nil {
+			return err
+		}
+		pending, pos = end, end
+		if pos+blockSize <= len(target) {
+			sum = newRollingSum(target[pos : pos+blockSize])
+		}
+	}
+	if err := enc.data(target[pending:]); err != nil {
+		return err
+	}
+	if _, err := zw.Write([]byte{opEnd}); err != nil {
+		return err
+	}
+	return zw.Close()
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package imgdelta implements binary deltas between images, used to update
+// the rootfs of EVE without downloading the whole image.
+//
+// A delta starts with a header identifying the source and the target image
+// by their size and sha256. It is followed by a zstd compressed stream of
+// instructions, each either copying a range of the source image or inserting
+// new data, which produce the target image when executed in order.
+package imgdelta
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	magic   = "EVEDELTA"
+	version = 1
+
+	opEnd  = 0
+	opCopy = 1
+	opData = 2
+
+	// limit of a single data instruction, both when creating and applying
+	maxDataLen = 4 * 1024 * 1024
+)
+
+// ErrSourceMismatch is returned if the source image is not the one
+// the delta was created for
+var ErrSourceMismatch = errors.New("source image does not match the delta")
+
+// Header identifies the images the delta is created for
+type Header struct {
+	SourceSize   uint64
+	SourceSha256 [sha256.Size]byte
+	TargetSize   uint64
+	TargetSha256 [sha256.Size]byte
+}
+
+// rawHeader is the on-disk layout of Header
+type rawHeader struct {
+	Magic   [8]byte
+	Version uint32
+	Header
+}
+
+// SourceSha returns the hex encoded sha256 of the source image
+func (h Header) SourceSha() string {
+	return hex.EncodeToString(h.SourceSha256[:])
+}
+
+// TargetSha returns the hex encoded sha256 of the target image
+func (h Header) TargetSha() string {
+	return hex.EncodeToString(h.TargetSha256[:])
+}
+
+// ReadHeader reads the header from the beginning of the delta
+func ReadHeader(r io.Reader) (Header, error) {
+	var raw rawHeader
+	if err := binary.Read(r, binary.BigEndian, &raw); err != nil {
+		return Header{}, fmt.Errorf("failed to read delta header: %w", err)
+	}
+	if string(raw.Magic[:]) != magic {
+		return Header{}, fmt.Errorf("not a delta")
+	}
+	if raw.Version != version {
+		return Header{}, fmt.Errorf("unsupported delta version %d", raw.Version)
+	}
+	return raw.Header, nil
+}
+
+func writeHeader(w io.Writer, h Header) error {
+	raw := rawHeader{Version: version, Header: h}
+	copy(raw.Magic[:], magic)
+	return binary.Write(w, binary.BigEndian, &raw)
+}
+
+// CheckSource verifies that the source image, which may be followed
+// by other data, is the one the delta was created for
+func CheckSource(h Header, src io.ReaderAt) error {
+	hash := sha256.New()
+	n, err := io.Copy(hash, io.NewSectionReader(src, 0, int64(h.SourceSize)))
+	if err != nil {
+		return fmt.Errorf("failed to read source image: %w", err)
+	}
+	if uint64(n) != h.SourceSize ||
+		!bytes.Equal(hash.Sum(nil), h.SourceSha256[:]) {
+		return ErrSourceMismatch
+	}
+	return nil
+}
+
+// Apply writes the target image into dst, executing the instructions which
+// follow the header h in r. The source image is expected to be verified
+// by CheckSource. Returns an error if the result does not match the header.
+func Apply(h Header, src io.ReaderAt, r io.Reader, dst io.Writer) error {
+	dec, err := zstd.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer dec.Close()
+	br := bufio.NewReader(dec)
+	hash := sha256.New()
+	w := io.MultiWriter(dst, hash)
+	var written uint64
+	for {
+		op, err := br.ReadByte()
+		if err != nil {
+			return fmt.Errorf("failed to read delta: %w", err)
+		}
+		var n uint64
+		switch op {
+		case opEnd:
+			if written != h.TargetSize {
+				return fmt.Errorf("delta produced %d bytes, expected %d",
+					written, h.TargetSize)
+			}
+			if !bytes.Equal(hash.Sum(nil), h.TargetSha256[:]) {
+				return fmt.Errorf("delta produced image not matching sha256 %s",
+					h.TargetSha())
+			}
+			return nil
+		case opCopy:
+			var offset uint64
+			if offset, err = binary.ReadUvarint(br); err == nil {
+				n, err = binary.ReadUvarint(br)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read delta: %w", err)
+			}
+			if offset > h.SourceSize || n > h.SourceSize-offset {
+				return fmt.Errorf("delta copies %d bytes at %d outside of source image",
+					n, offset)
+			}
+			_, err = io.Copy(w, io.NewSectionReader(src, int64(offset), int64(n)))
+		case opData:
+			if n, err = binary.ReadUvarint(br); err != nil {
+				return fmt.Errorf("failed to read delta: %w", err)
+			}
+			if n > maxDataLen {
+				return fmt.Errorf("delta data of %d bytes too long", n)
+			}
+			var copied int64
+			copied, err = io.CopyN(w, br, int64(n))
+			if err == io.EOF {
+				err = fmt.Errorf("delta truncated after %d out of %d bytes",
+					copied, n)
+			}
+		default:
+			return fmt.Errorf("invalid delta instruction %d", op)
+		}
+		if err != nil {
+			return err
+		}
+		written += n
+		if written > h.TargetSize {
+			return fmt.Errorf("delta produced more than %d bytes", h.TargetSize)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imgdelta
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func testImage(seed int64, size int) []byte {
+	img := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(img)
+	return img
+}
+
+// modify returns a new image made of moved, changed and new parts of img
+func modify(img []byte) []byte {
+	var target []byte
+	target = append(target, img[100000:300000]...)
+	target = append(target, testImage(2, 5000)...)
+	target = append(target, img[:100000]...)
+	changed := append([]byte{}, img[300000:]...)
+	for i := 0; i < len(changed); i += 10000 {
+		changed[i] ^= 0xff
+	}
+	return append(target, changed...)
+}
+
+func applyDelta(t *testing.T, source, delta []byte) ([]byte, error) {
+	r := bytes.NewReader(delta)
+	h, err := ReadHeader(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = CheckSource(h, bytes.NewReader(source)); err != nil {
+		return nil, err
+	}
+	var result bytes.Buffer
+	err = Apply(h, bytes.NewReader(source), r, &result)
+	return result.Bytes(), err
+}
+
+func TestDelta(t *testing.T) {
+	source := testImage(1, 512*1024)
+	target := modify(source)
+	var delta bytes.Buffer
+	if err := Create(source, target, &delta); err != nil {
+		t.Fatal(err)
+	}
+	// the new and changed parts only should be in the delta
+	if delta.Len() > 50000 {
+		t.Errorf("delta of %d bytes is too large", delta.Len())
+	}
+	// the source image is followed by other data in the partition
+	partition := append(append([]byte{}, source...), testImage(3, 1000)...)
+	result, err := applyDelta(t, partition, delta.Bytes())
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if !bytes.Equal(result, target) {
+		t.Errorf("Apply produced a different image")
+	}
+}
+
+func TestDeltaSmallImages(t *testing.T) {
+	for _, images := range [][2][]byte{
+		{nil, nil},
+		{testImage(1, 100), testImage(2, 100)},
+		{testImage(1, 10000), nil},
+		{nil, testImage(2, 10000)},
+	} {
+		var delta bytes.Buffer
+		if err := Create(images[0], images[1], &delta); err != nil {
+			t.Fatal(err)
+		}
+		result, err := applyDelta(t, images[0], delta.Bytes())
+		if err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if !bytes.Equal(result, images[1]) {
+			t.Errorf("Apply produced a different image")
+		}
+	}
+}
+
+func TestDeltaSourceMismatch(t *testing.T) {
+	source := testImage(1, 100000)
+	var delta bytes.Buffer
+	if err := Create(source, modify(testImage(1, 400000)), &delta); err != nil {
+		t.Fatal(err)
+	}
+	other := append([]byte{}, source...)
+	other[5000] ^= 1
+	if _, err := applyDelta(t, other, delta.Bytes()); err != ErrSourceMismatch {
+		t.Errorf("expected ErrSourceMismatch, got %v", err)
+	}
+	if _, err := applyDelta(t, source[:1000], delta.Bytes()); err != ErrSourceMismatch {
+		t.Errorf("expected ErrSourceMismatch for short source, got %v", err)
+	}
+}
+
+func TestDeltaCorrupted(t *testing.T) {
+	source := testImage(1, 512*1024)
+	var delta bytes.Buffer
+	if err := Create(source, modify(source), &delta); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ReadHeader(bytes.NewReader(delta.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the header claims a different target
+	h.TargetSha256[0] ^= 1
+	var wrongTarget bytes.Buffer
+	if err := writeHeader(&wrongTarget, h); err != nil {
+		t.Fatal(err)
+	}
+	wrongTarget.Write(delta.Bytes()[wrongTarget.Len():])
+	if _, err := applyDelta(t, source, wrongTarget.Bytes()); err == nil {
+		t.Errorf("Apply of delta with a wrong target sha256 succeeded")
+	}
+
+	// truncated delta
+	truncated := delta.Bytes()[:delta.Len()-100]
+	if _, err := applyDelta(t, source, truncated); err == nil {
+		t.Errorf("Apply of truncated delta succeeded")
+	}
+
+	if _, err := ReadHeader(bytes.NewReader([]byte("not a delta at all, just some text"))); err == nil {
+		t.Errorf("ReadHeader of invalid delta succeeded")
+	}
+}
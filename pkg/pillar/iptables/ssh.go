@@ -29,4 +29,6 @@ var ControlProtocolMarkingIDMap = map[string]string{
 	// DHCP packets originating from outside
 	// (e.g. DHCP multicast requests from other devices on the same network)
 	"in_dhcp": "10",
+	// mDNS and HTTPS flows of content exchanged with other edge nodes
+	"in_p2p_content": "11",
 }
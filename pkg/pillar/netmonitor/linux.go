@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -242,8 +243,8 @@ func (m *LinuxNetworkMonitor) GetInterfaceDHCPInfo(ifIndex int) (info DHCPInfo,
 		return info, err
 	}
 	ifName := attrs.IfName
+	info.DelegatedPrefixes = m.getDelegatedPrefixes(ifName)
 	// XXX Getting error -1 unless we add argument -4.
-	// XXX Add IPv6 support.
 	m.Log.Functionf("Calling dhcpcd -U -4 %s\n", ifName)
 	stdoutStderr, err := base.Exec(m.Log, "dhcpcd", "-U", "-4", ifName).CombinedOutput()
 	if err != nil {
@@ -303,6 +304,64 @@ func (m *LinuxNetworkMonitor) GetInterfaceDHCPInfo(ifIndex int) (info DHCPInfo,
 	return info, nil
 }
 
+// Matches dhcp6_ia_pd<iaid>_prefix<n> and dhcp6_ia_pd<iaid>_prefix<n>_length
+// as dumped by "dhcpcd -U -6".
+var delegatedPrefixRegexp = regexp.MustCompile(`^dhcp6_ia_pd(\d+)_prefix(\d+)(_length)?$`)
+
+// getDelegatedPrefixes returns IPv6 prefixes delegated to the interface
+// by the DHCPv6 server. Errors are only logged - interface may not run
+// DHCPv6 at all.
+func (m *LinuxNetworkMonitor) getDelegatedPrefixes(ifName string) (prefixes []net.IPNet) {
+	m.Log.Functionf("Calling dhcpcd -U -6 %s\n", ifName)
+	stdoutStderr, err := base.Exec(m.Log, "dhcpcd", "-U", "-6", ifName).CombinedOutput()
+	if err != nil {
+		m.Log.Functionf("dhcpcd -U -6 %s failed: %s: %v", ifName,
+			string(stdoutStderr), err)
+		return nil
+	}
+	type prefixAttrs struct {
+		ip     net.IP
+		length int
+	}
+	var keys []string
+	attrs := make(map[string]*prefixAttrs)
+	for _, line := range strings.Split(string(stdoutStderr), "\n") {
+		items := strings.SplitN(line, "=", 2)
+		if len(items) != 2 {
+			continue
+		}
+		match := delegatedPrefixRegexp.FindStringSubmatch(items[0])
+		if match == nil {
+			continue
+		}
+		key := match[1] + "/" + match[2]
+		if _, exists := attrs[key]; !exists {
+			attrs[key] = &prefixAttrs{}
+			keys = append(keys, key)
+		}
+		value := trimQuotes(items[1])
+		if match[3] != "" {
+			attrs[key].length, err = strconv.Atoi(value)
+			if err != nil {
+				m.Log.Errorf("Failed to parse prefix length %s\n", value)
+			}
+		} else {
+			attrs[key].ip = net.ParseIP(value)
+		}
+	}
+	for _, key := range keys {
+		prefix := attrs[key]
+		if prefix.ip == nil || prefix.ip.To4() != nil ||
+			prefix.length <= 0 || prefix.length > 128 {
+			continue
+		}
+		mask := net.CIDRMask(prefix.length, 128)
+		prefixes = append(prefixes, net.IPNet{IP: prefix.ip.Mask(mask), Mask: mask})
+	}
+	m.Log.Functionf("GetDhcpInfo(%s) delegated prefixes %v\n", ifName, prefixes)
+	return prefixes
+}
+
 // GetInterfaceDefaultGWs return a list of IP addresses of default gateways
 // used by the given interface. This is based on routes from the main routing table.
 func (m *LinuxNetworkMonitor) GetInterfaceDefaultGWs(ifIndex int) (gws []net.IP, err error) {
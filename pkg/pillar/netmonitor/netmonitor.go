@@ -171,4 +171,6 @@ type DNSInfo struct {
 type DHCPInfo struct {
 	Subnet     *net.IPNet
 	NtpServers []net.IP
+	// IPv6 prefixes delegated by the DHCPv6 server (IA_PD).
+	DelegatedPrefixes []net.IPNet
 }
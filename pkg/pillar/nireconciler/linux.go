@@ -0,0 +1,1082 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	dpclinux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	uuid "github.com/satori/go.uuid"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Configuration of network instances is modeled using dependency graph (see libs/depgraph).
+// Config graph with all sub-graphs and config item types used for Linux network stack:
+//
+//	+--------------------------------------------------------------------------------+
+//	|                              NetworkInstances                                  |
+//	|                                                                                |
+//	|   +-----------------------------+        +----------------------------------+  |
+//	|   |          Uplinks            |        |               ACLs               |  |
+//	|   |                             |        |                                  |  |
+//	|   | +------------+              |        | +---------------+                |  |
+//	|   | |   Uplink   |              |        | | IptablesChain | ...            |  |
+//	|   | | (external) | ...          |        | |   (*-apps)    |                |  |
+//	|   | +------------+              |        | +---------------+                |  |
+//	|   +-----------------------------+        +----------------------------------+  |
+//	|                                                                                |
+//	|   +-----------------------------+                                              |
+//	|   |            IPsec            |                                              |
+//	|   |                             |                                              |
+//	|   | +----------------+          |                                              |
+//	|   | | IPsecTransport | ...      |                                              |
+//	|   | +----------------+          |                                              |
+//	|   +-----------------------------+                                              |
+//	|                                                                                |
+//	|   +----------------------------------------------------------------------+    |
+//	|   |                          NI-<UUID>                                   |    |
+//	|   |                                                                      |    |
+//	|   |  +--------+   +------------+   +---------+   +-------+   +-------+   |    |
+//	|   |  | Bridge |   | BridgePort |   | Dnsmasq |   | Radvd |   | Route | ..|    |
+//	|   |  +--------+   +------------+   +---------+   +-------+   +-------+   |    |
+//	|   |                                                                      |    |
+//	|   |  +-------+   +-----------+                                           |    |
+//	|   |  | Vxlan |   | WireGuard |                                           |    |
+//	|   |  +-------+   +-----------+                                           |    |
+//	|   +----------------------------------------------------------------------+    |
+//	|                                 ...                                            |
+//	|   +----------------------------------------------------------------------+    |
+//	|   |                          App-<UUID>                                  |    |
+//	|   |                                                                      |    |
+//	|   |  +------------+                   +-----------------------------+    |    |
+//	|   |  |    VIF     | ...               | IptablesChain (mark chains) | .. |    |
+//	|   |  | (external) |                   +-----------------------------+    |    |
+//	|   |  +------------+                                                      |    |
+//	|   +----------------------------------------------------------------------+    |
+//	|                                 ...                                            |
+//	+--------------------------------------------------------------------------------+
+const (
+	// GraphName : name of the graph with the managed state as a whole.
+	GraphName = "NetworkInstances"
+	// UplinksSG : name of the sub-graph with (external) uplink interfaces.
+	UplinksSG = "Uplinks"
+	// ACLsSG : name of the sub-graph with iptables chains shared by all
+	// network instances and applications.
+	ACLsSG = "ACLs"
+	// NISGPrefix : prefix used for the name of a sub-graph with configuration
+	// of a single network instance.
+	NISGPrefix = "NI-"
+	// AppSGPrefix : prefix used for the name of a sub-graph with configuration
+	// of application connections.
+	AppSGPrefix = "App-"
+	// IPsecSG : name of the sub-graph with IPsec SA pairs encrypting traffic
+	// of VXLAN network instances. SA pairs are not stored inside NI sub-graphs
+	// because multiple NIs may share the same pair.
+	IPsecSG = "IPsec"
+)
+
+const (
+	// File where the current state graph is exported (as DOT) after each reconcile.
+	// Can be used for troubleshooting purposes.
+	currentStateFile = "/run/zedrouter-current-state.dot"
+	// File where the intended state graph is exported (as DOT) after each reconcile.
+	// Can be used for troubleshooting purposes.
+	intendedStateFile = "/run/zedrouter-intended-state.dot"
+)
+
+// Chains pre-created by iptables.Init() to hold rules configured by zedrouter.
+var appChains = map[string][]string{ // table -> chains
+	"filter": {"INPUT", "FORWARD", "OUTPUT"},
+	"mangle": {"INPUT", "FORWARD", "OUTPUT", "PREROUTING", "POSTROUTING"},
+	"raw":    {"PREROUTING"},
+	"nat":    {"PREROUTING", "POSTROUTING"},
+}
+
+// LinuxNIReconciler is a network instance reconciler for Linux network stack,
+// i.e. it configures and uses Linux networking to provide connectivity
+// for applications.
+type LinuxNIReconciler struct {
+	sync.Mutex
+
+	// Enable to have the current state exported to /run/zedrouter-current-state.dot
+	// on every change.
+	ExportCurrentState bool
+	// Enable to have the intended state exported to /run/zedrouter-intended-state.dot
+	// on every change.
+	ExportIntendedState bool
+
+	// Note: the exported attributes below should be injected.
+	Log            *base.LogObject           // mandatory
+	NetworkMonitor netmonitor.NetworkMonitor // mandatory
+
+	currentState  dg.Graph
+	intendedState dg.Graph
+
+	initialized bool
+	registry    reconciler.ConfiguratorRegistry
+
+	// To manage asynchronous operations.
+	watcherControl   chan watcherCtrl
+	pendingReconcile pendingReconcile
+	resumeReconcile  chan struct{}
+	resumeAsync      <-chan string // nil if no async ops
+
+	prevArgs   Args
+	prevStatus ReconcileStatus
+}
+
+type pendingReconcile struct {
+	isPending bool
+	reasons   []string
+}
+
+type watcherCtrl uint8
+
+const (
+	watcherCtrlUndefined watcherCtrl = iota
+	watcherCtrlStart
+	watcherCtrlPause
+	watcherCtrlCont
+)
+
+// GetCurrentState : get the current state (read-only).
+// Exported only for unit-testing purposes.
+func (r *LinuxNIReconciler) GetCurrentState() dg.GraphR {
+	return r.currentState
+}
+
+func (r *LinuxNIReconciler) init() (startWatcher func()) {
+	r.Lock()
+	if r.initialized {
+		r.Log.Fatal("Already initialized")
+	}
+	registry := &reconciler.DefaultRegistry{}
+	err := linux.RegisterItems(r.Log, registry, r.NetworkMonitor)
+	if err != nil {
+		r.Log.Fatal(err)
+	}
+	r.registry = registry
+	r.watcherControl = make(chan watcherCtrl, 10)
+	netEvents := r.NetworkMonitor.WatchEvents(
+		context.Background(), "linux-ni-reconciler")
+	go r.watcher(netEvents)
+	r.initialized = true
+	return func() {
+		r.watcherControl <- watcherCtrlStart
+		r.Unlock()
+	}
+}
+
+func (r *LinuxNIReconciler) pauseWatcher() (cont func()) {
+	r.watcherControl <- watcherCtrlPause
+	r.Lock()
+	return func() {
+		r.watcherControl <- watcherCtrlCont
+		r.Unlock()
+	}
+}
+
+func (r *LinuxNIReconciler) watcher(netEvents <-chan netmonitor.Event) {
+	var ctrl watcherCtrl
+	for ctrl != watcherCtrlStart {
+		ctrl = <-r.watcherControl
+	}
+	r.Lock()
+	defer r.Unlock()
+	for {
+		select {
+		case <-r.resumeAsync:
+			r.addPendingReconcile("async op finalized", true)
+
+		case event := <-netEvents:
+			switch ev := event.(type) {
+			case netmonitor.RouteChange:
+				if ev.Table == syscall.RT_TABLE_MAIN && r.isRouteSource(ev.IfIndex) {
+					r.addPendingReconcile("route change", true)
+				}
+			case netmonitor.IfChange:
+				if r.updateCurrentExternalItems() {
+					r.addPendingReconcile("interface change", true)
+				}
+				if r.repairBridgeDrift(ev.Attrs, ev.Deleted) {
+					r.addPendingReconcile("bridge config drift", true)
+				}
+			case netmonitor.AddrChange:
+				if r.updateCurrentExternalItems() {
+					r.addPendingReconcile("address change", true)
+				}
+				if r.repairBridgeAddrsDrift(ev.IfIndex) {
+					r.addPendingReconcile("bridge address drift", true)
+				}
+			}
+
+		case ctrl = <-r.watcherControl:
+			if ctrl == watcherCtrlPause {
+				r.Unlock()
+				for ctrl != watcherCtrlCont {
+					ctrl = <-r.watcherControl
+				}
+				r.Lock()
+			}
+		}
+	}
+}
+
+func (r *LinuxNIReconciler) addPendingReconcile(reason string, sendSignal bool) {
+	var dulicateReason bool
+	for _, prevReason := range r.pendingReconcile.reasons {
+		if prevReason == reason {
+			dulicateReason = true
+			break
+		}
+	}
+	if !dulicateReason {
+		r.pendingReconcile.reasons = append(r.pendingReconcile.reasons, reason)
+	}
+	if r.pendingReconcile.isPending {
+		return
+	}
+	r.pendingReconcile.isPending = true
+	if !sendSignal {
+		return
+	}
+	select {
+	case r.resumeReconcile <- struct{}{}:
+	default:
+		r.Log.Warn("Failed to send signal to resume reconciliation")
+	}
+}
+
+// Reconcile : call to apply the current network instance config into
+// the Linux network stack.
+func (r *LinuxNIReconciler) Reconcile(ctx context.Context, args Args) ReconcileStatus {
+	if !r.initialized {
+		// This is the first state reconciliation.
+		startWatcher := r.init()
+		defer startWatcher()
+		r.addPendingReconcile("initial reconcile", false)
+	} else {
+		// Already run the first state reconciliation.
+		contWatcher := r.pauseWatcher()
+		defer contWatcher()
+	}
+
+	// Reconcile with clear network monitor cache to avoid working with stale data.
+	r.NetworkMonitor.ClearCache()
+	intendedState, missingTables := r.getIntendedState(args)
+	if r.intendedState != nil && len(r.intendedState.DiffItems(intendedState)) > 0 {
+		r.addPendingReconcile("config change", false)
+	}
+	if !r.pendingReconcile.isPending {
+		// Nothing to reconcile.
+		newStatus := r.prevStatus
+		newStatus.Error = nil
+		newStatus.FailingItems = nil
+		return newStatus
+	}
+	r.intendedState = intendedState
+	r.updateCurrentState()
+
+	reconcileStartTime := time.Now()
+	r.Log.Noticef("Running a full state reconciliation, reasons: %s",
+		strings.Join(r.pendingReconcile.reasons, ", "))
+	rs := reconciler.New(r.registry).Reconcile(ctx, r.currentState, r.intendedState)
+	r.currentState = rs.NewCurrentState
+	opLog := rs.OperationLog
+	if missingTables {
+		// Some bridges did not exist when the intended state was built
+		// and therefore routing tables of their network instances could not have been
+		// determined. If the bridges were just created, run one more reconciliation
+		// to install the routes.
+		r.NetworkMonitor.ClearCache()
+		intendedState, _ = r.getIntendedState(args)
+		if len(r.intendedState.DiffItems(intendedState)) > 0 {
+			r.intendedState = intendedState
+			r.updateCurrentState()
+			r.Log.Noticef("Running state reconciliation to install routes " +
+				"of newly created bridges")
+			rs = reconciler.New(r.registry).Reconcile(ctx, r.currentState, r.intendedState)
+			r.currentState = rs.NewCurrentState
+			opLog = append(opLog, rs.OperationLog...)
+		}
+	}
+
+	// Log every executed operation.
+	for _, log := range opLog {
+		var withErr string
+		if log.Err != nil {
+			withErr = fmt.Sprintf(" with error: %v", log.Err)
+		}
+		var verb string
+		if log.InProgress {
+			verb = "started async execution of"
+		} else {
+			if log.StartTime.Before(reconcileStartTime) {
+				verb = "finalized async execution of"
+			} else {
+				// synchronous operation
+				verb = "executed"
+			}
+		}
+		r.Log.Noticef("NI Reconciler %s %v for %v%s, content: %s",
+			verb, log.Operation, dg.Reference(log.Item), withErr, log.Item.String())
+	}
+
+	// Log transitions from no-error to error and vice-versa.
+	var failed, fixed []string
+	var failingItems reconciler.OperationLog
+	for _, log := range opLog {
+		if log.PrevErr == nil && log.Err != nil {
+			failed = append(failed,
+				fmt.Sprintf("%v (err: %v)", dg.Reference(log.Item), log.Err))
+		}
+		if log.PrevErr != nil && log.Err == nil {
+			fixed = append(fixed, dg.Reference(log.Item).String())
+		}
+		if log.Err != nil {
+			failingItems = append(failingItems, log)
+		}
+	}
+	if len(failed) > 0 {
+		r.Log.Errorf("Newly failed config items: %s",
+			strings.Join(failed, ", "))
+	}
+	if len(fixed) > 0 {
+		r.Log.Noticef("Fixed config items: %s",
+			strings.Join(fixed, ", "))
+	}
+
+	r.resumeReconcile = make(chan struct{}, 10)
+	newStatus := ReconcileStatus{
+		Error:           rs.Err,
+		AsyncInProgress: rs.AsyncOpsInProgress,
+		ResumeReconcile: r.resumeReconcile,
+		CancelAsyncOps:  rs.CancelAsyncOps,
+		WaitForAsyncOps: rs.WaitForAsyncOps,
+		FailingItems:    failingItems,
+		NIs:             r.getNIStatuses(args),
+	}
+
+	// Update the internal state.
+	r.prevArgs = args
+	r.prevStatus = newStatus
+	r.resumeAsync = rs.ReadyToResume
+	r.pendingReconcile.isPending = false
+	r.pendingReconcile.reasons = []string{}
+
+	// Output the current state into a file for troubleshooting purposes.
+	if r.ExportCurrentState {
+		dotExporter := &dg.DotExporter{CheckDeps: true}
+		dot, err := dotExporter.Export(r.currentState)
+		if err != nil {
+			r.Log.Warnf("Failed to export the current state to DOT: %v", err)
+		} else {
+			err := fileutils.WriteRename(currentStateFile, []byte(dot))
+			if err != nil {
+				r.Log.Warnf("WriteRename failed for %s: %v",
+					currentStateFile, err)
+			}
+		}
+	}
+	// Output the intended state into a file for troubleshooting purposes.
+	if r.ExportIntendedState {
+		dotExporter := &dg.DotExporter{CheckDeps: true}
+		dot, err := dotExporter.Export(r.intendedState)
+		if err != nil {
+			r.Log.Warnf("Failed to export the intended state to DOT: %v", err)
+		} else {
+			err := fileutils.WriteRename(intendedStateFile, []byte(dot))
+			if err != nil {
+				r.Log.Warnf("WriteRename failed for %s: %v",
+					intendedStateFile, err)
+			}
+		}
+	}
+
+	return newStatus
+}
+
+// getNIStatuses collects state information for every network instance.
+func (r *LinuxNIReconciler) getNIStatuses(args Args) map[uuid.UUID]NIStatus {
+	statuses := make(map[uuid.UUID]NIStatus)
+	for _, ni := range args.NIs {
+		var niStatus NIStatus
+		_, state, _, found := r.currentState.Item(dg.Reference(ni.Bridge))
+		if found && state.IsCreated() {
+			ifIndex, exists, err := r.NetworkMonitor.GetInterfaceIndex(ni.Bridge.IfName)
+			if err == nil && exists {
+				niStatus.BridgeIfIndex = ifIndex
+				if ni.CopyUplinkRoutes || ni.WireGuardRoutes {
+					niStatus.RouteTable = devicenetwork.BaseRTIndex + ifIndex
+				}
+			}
+		}
+		sg := r.currentState.SubGraph(NISGPrefix + ni.UUID.String())
+		if sg != nil {
+			var errs []string
+			iter := sg.Items(true)
+			for iter.Next() {
+				item, state := iter.Item()
+				if state.WithError() != nil {
+					errs = append(errs, fmt.Sprintf("%s: %v",
+						item.Label(), state.WithError()))
+				}
+			}
+			if len(errs) > 0 {
+				niStatus.Error = fmt.Errorf("failed to configure network instance: %s",
+					strings.Join(errs, "; "))
+			}
+		}
+		statuses[ni.UUID] = niStatus
+	}
+	return statuses
+}
+
+// isRouteSource returns true if routes of the given interface are copied
+// into routing tables of network instances.
+func (r *LinuxNIReconciler) isRouteSource(ifIndex int) bool {
+	attrs, err := r.NetworkMonitor.GetInterfaceAttrs(ifIndex)
+	if err != nil {
+		// Interface is most likely gone - in that case the change will be
+		// processed through IfChange event.
+		return false
+	}
+	for _, ni := range r.prevArgs.NIs {
+		if !ni.CopyUplinkRoutes && !ni.WireGuardRoutes {
+			continue
+		}
+		if ni.UplinkIfName == attrs.IfName || ni.Bridge.IfName == attrs.IfName {
+			return true
+		}
+	}
+	return false
+}
+
+// repairBridgeDrift checks if a bridge or a bridge port created by the reconciler
+// was removed or modified from outside. Affected items are removed from the current
+// state so that the next reconciliation re-creates them.
+func (r *LinuxNIReconciler) repairBridgeDrift(attrs netmonitor.IfAttrs, deleted bool) bool {
+	if r.currentState == nil {
+		return false
+	}
+	var drift bool
+	for _, ni := range r.prevArgs.NIs {
+		if ni.Bridge.CreatedByNIM {
+			continue
+		}
+		if deleted && attrs.IfName == ni.Bridge.IfName {
+			bridgeRef := dg.Reference(ni.Bridge)
+			_, state, _, found := r.currentState.Item(bridgeRef)
+			if found && state.IsCreated() {
+				r.Log.Warnf("Bridge %s was removed from outside", ni.Bridge.IfName)
+				r.delItemWithDependents(bridgeRef)
+				drift = true
+			}
+		}
+		if ni.BridgeUplink && attrs.IfName == ni.UplinkIfName && !deleted {
+			port := linux.BridgePort{
+				BridgeIfName: ni.Bridge.IfName,
+				PortIfName:   ni.UplinkIfName,
+			}
+			portRef := dg.Reference(port)
+			_, state, _, found := r.currentState.Item(portRef)
+			if !found || !state.IsCreated() {
+				continue
+			}
+			bridgeIndex, exists, err := r.NetworkMonitor.GetInterfaceIndex(
+				ni.Bridge.IfName)
+			if err != nil || !exists {
+				continue
+			}
+			if !attrs.Enslaved || attrs.MasterIfIndex != bridgeIndex {
+				r.Log.Warnf("Port %s was removed from bridge %s from outside",
+					ni.UplinkIfName, ni.Bridge.IfName)
+				r.delItemWithDependents(portRef)
+				drift = true
+			}
+		}
+	}
+	return drift
+}
+
+// repairBridgeAddrsDrift checks if IP addresses assigned to a bridge created
+// by the reconciler were changed from outside. The current state is updated
+// to reflect the actual state and the next reconciliation will fix the addresses.
+func (r *LinuxNIReconciler) repairBridgeAddrsDrift(ifIndex int) bool {
+	if r.currentState == nil {
+		return false
+	}
+	attrs, err := r.NetworkMonitor.GetInterfaceAttrs(ifIndex)
+	if err != nil {
+		return false
+	}
+	for _, ni := range r.prevArgs.NIs {
+		if ni.Bridge.CreatedByNIM || ni.Bridge.IfName != attrs.IfName {
+			continue
+		}
+		item, state, path, found := r.currentState.Item(dg.Reference(ni.Bridge))
+		if !found || !state.IsCreated() {
+			return false
+		}
+		bridge := item.(linux.Bridge)
+		ipAddrs, _, err := r.NetworkMonitor.GetInterfaceAddrs(ifIndex)
+		if err != nil {
+			return false
+		}
+		actualIPs := filterOutLinkLocal(ipAddrs)
+		if r.equalIPNetSets(bridge.IPAddresses, actualIPs) {
+			return false
+		}
+		r.Log.Warnf("IP addresses of bridge %s were changed from outside: %v -> %v",
+			bridge.IfName, bridge.IPAddresses, actualIPs)
+		bridge.IPAddresses = actualIPs
+		dg.PutItemInto(r.currentState, bridge, state, path)
+		return true
+	}
+	return false
+}
+
+func (r *LinuxNIReconciler) equalIPNetSets(list1, list2 []*net.IPNet) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for _, ip1 := range list1 {
+		var found bool
+		for _, ip2 := range list2 {
+			if ip1.IP.Equal(ip2.IP) && ip1.Mask.String() == ip2.Mask.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func filterOutLinkLocal(ipAddrs []*net.IPNet) (filtered []*net.IPNet) {
+	for _, ipAddr := range ipAddrs {
+		if ipAddr.IP.IsLinkLocalUnicast() {
+			continue
+		}
+		filtered = append(filtered, ipAddr)
+	}
+	return filtered
+}
+
+// delItemWithDependents removes item from the current state together with all
+// items which (directly or transitively) depend on it.
+func (r *LinuxNIReconciler) delItemWithDependents(itemRef dg.ItemRef) {
+	_, _, path, found := r.currentState.Item(itemRef)
+	if !found {
+		return
+	}
+	var dependents []dg.ItemRef
+	iter := r.currentState.IncomingEdges(itemRef)
+	for iter.Next() {
+		dependents = append(dependents, iter.Edge().FromItem)
+	}
+	dg.DelItemFrom(r.currentState, itemRef, path)
+	for _, dependent := range dependents {
+		r.delItemWithDependents(dependent)
+	}
+}
+
+// updateCurrentState prepares the current state for reconciliation.
+func (r *LinuxNIReconciler) updateCurrentState() {
+	if r.currentState == nil {
+		graphArgs := dg.InitArgs{Name: GraphName}
+		r.currentState = dg.New(graphArgs)
+	}
+	r.updateCurrentExternalItems()
+}
+
+// updateCurrentExternalItems updates the current state with the actual state
+// of external items (interfaces not created by the reconciler).
+// Returns true if anything has changed.
+func (r *LinuxNIReconciler) updateCurrentExternalItems() (changed bool) {
+	if r.currentState == nil || r.intendedState == nil {
+		return false
+	}
+	// Remove external items which are no longer intended or which do not exist.
+	type curItem struct {
+		ref  dg.ItemRef
+		path dg.SubGraphPath
+	}
+	var toDel []curItem
+	iter := r.currentState.Items(true)
+	for iter.Next() {
+		item, _ := iter.Item()
+		if !item.External() {
+			continue
+		}
+		ref := dg.Reference(item)
+		_, _, path, _ := r.currentState.Item(ref)
+		intItem, _, _, found := r.intendedState.Item(ref)
+		if found {
+			if _, exists := r.getActualExternalItem(intItem); exists {
+				continue
+			}
+		}
+		toDel = append(toDel, curItem{ref: ref, path: path})
+	}
+	for _, item := range toDel {
+		dg.DelItemFrom(r.currentState, item.ref, item.path)
+		changed = true
+	}
+	// Add or update external items which exist.
+	iter = r.intendedState.Items(true)
+	for iter.Next() {
+		intItem, _ := iter.Item()
+		if !intItem.External() {
+			continue
+		}
+		actualItem, exists := r.getActualExternalItem(intItem)
+		if !exists {
+			continue
+		}
+		ref := dg.Reference(intItem)
+		_, _, path, _ := r.intendedState.Item(ref)
+		prevItem, _, prevPath, found := r.currentState.Item(ref)
+		if found && prevItem.Equal(actualItem) && prevPath.Compare(path) == 0 {
+			continue
+		}
+		r.putCurrentItem(actualItem, path)
+		changed = true
+	}
+	return changed
+}
+
+// putCurrentItem puts external item into the current state, creating the (top-level)
+// subgraph if needed.
+func (r *LinuxNIReconciler) putCurrentItem(item dg.Item, path dg.SubGraphPath) {
+	if dg.GetSubGraph(r.currentState, path) == nil {
+		intSG := dg.GetSubGraphR(r.intendedState, path)
+		r.currentState.PutSubGraph(dg.New(dg.InitArgs{
+			Name:        intSG.Name(),
+			Description: intSG.Description(),
+		}))
+	}
+	state := &reconciler.ItemStateData{
+		State:         reconciler.ItemStateCreated,
+		LastOperation: reconciler.OperationCreate,
+	}
+	dg.PutItemInto(r.currentState, item, state, path)
+}
+
+// getActualExternalItem returns the actual state of an external item.
+func (r *LinuxNIReconciler) getActualExternalItem(item dg.Item) (dg.Item, bool) {
+	ifIndex, exists, err := r.NetworkMonitor.GetInterfaceIndex(item.Name())
+	if err != nil || !exists {
+		return nil, false
+	}
+	attrs, err := r.NetworkMonitor.GetInterfaceAttrs(ifIndex)
+	if err != nil {
+		return nil, false
+	}
+	switch extItem := item.(type) {
+	case linux.Uplink:
+		extItem.IsBridge = attrs.IfType == "bridge"
+		return extItem, true
+	case linux.Bridge:
+		if attrs.IfType != "bridge" {
+			return nil, false
+		}
+		ipAddrs, _, err := r.NetworkMonitor.GetInterfaceAddrs(ifIndex)
+		if err != nil {
+			return nil, false
+		}
+		extItem.IPAddresses = filterOutLinkLocal(ipAddrs)
+		return extItem, true
+	case linux.VIF:
+		return extItem, true
+	}
+	return nil, false
+}
+
+// getIntendedState builds the intended state for the given arguments.
+// Returns missingTables=true if for some NI the routing table could not be determined
+// because the bridge does not exist yet.
+func (r *LinuxNIReconciler) getIntendedState(args Args) (
+	intendedState dg.Graph, missingTables bool) {
+	graphArgs := dg.InitArgs{
+		Name:        GraphName,
+		Description: "Network instances and application connectivity",
+	}
+	intendedState = dg.New(graphArgs)
+	intendedState.PutSubGraph(r.getIntendedUplinks(args))
+	intendedState.PutSubGraph(r.getIntendedIPsec(args))
+	for _, ni := range args.NIs {
+		niSG, missingTable := r.getIntendedNICfg(ni)
+		intendedState.PutSubGraph(niSG)
+		missingTables = missingTables || missingTable
+	}
+	for _, app := range args.Apps {
+		intendedState.PutSubGraph(r.getIntendedAppConnCfg(app))
+	}
+	intendedState.PutSubGraph(r.getIntendedACLs(args))
+	return intendedState, missingTables
+}
+
+func (r *LinuxNIReconciler) getIntendedUplinks(args Args) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        UplinksSG,
+		Description: "Uplink interfaces used by network instances",
+	}
+	intendedUplinks := dg.New(graphArgs)
+	for _, ni := range args.NIs {
+		if ni.UplinkIfName == "" {
+			continue
+		}
+		uplink := linux.Uplink{
+			IfName:       ni.UplinkIfName,
+			LogicalLabel: ni.UplinkLogicalLabel,
+		}
+		if actual, exists := r.getActualExternalItem(uplink); exists {
+			uplink = actual.(linux.Uplink)
+		}
+		intendedUplinks.PutItem(uplink, nil)
+	}
+	return intendedUplinks
+}
+
+func (r *LinuxNIReconciler) getIntendedIPsec(args Args) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        IPsecSG,
+		Description: "IPsec SA pairs encrypting VXLAN traffic",
+	}
+	intendedIPsec := dg.New(graphArgs)
+	for _, ni := range args.NIs {
+		for _, ipsec := range ni.IPsec {
+			item, _, _, exists := intendedIPsec.Item(dg.Reference(ipsec))
+			if exists {
+				if !item.Equal(ipsec) {
+					r.Log.Errorf("Network instance %s uses different IPsec "+
+						"config for %s than other network instance(s), ignoring",
+						ni.DisplayName, ipsec.Name())
+				}
+				continue
+			}
+			intendedIPsec.PutItem(ipsec, nil)
+		}
+	}
+	return intendedIPsec
+}
+
+func (r *LinuxNIReconciler) getIntendedNICfg(ni NIConfig) (
+	intendedNI dg.Graph, missingTable bool) {
+	graphArgs := dg.InitArgs{
+		Name:        NISGPrefix + ni.UUID.String(),
+		Description: "Network instance " + ni.DisplayName,
+	}
+	intendedNI = dg.New(graphArgs)
+	bridge := ni.Bridge
+	if bridge.CreatedByNIM {
+		// External item - use the actual state.
+		if actual, exists := r.getActualExternalItem(bridge); exists {
+			bridge = actual.(linux.Bridge)
+		}
+	}
+	intendedNI.PutItem(bridge, nil)
+	if ni.BridgeUplink && ni.UplinkIfName != "" && !bridge.CreatedByNIM {
+		intendedNI.PutItem(linux.BridgePort{
+			BridgeIfName: bridge.IfName,
+			PortIfName:   ni.UplinkIfName,
+		}, nil)
+	}
+	if ni.Dnsmasq != nil {
+		intendedNI.PutItem(*ni.Dnsmasq, nil)
+	}
+	if ni.Radvd != nil {
+		intendedNI.PutItem(*ni.Radvd, nil)
+	}
+	if ni.WireGuard != nil {
+		intendedNI.PutItem(*ni.WireGuard, nil)
+	}
+	if ni.Vxlan != nil {
+		intendedNI.PutItem(*ni.Vxlan, nil)
+	}
+	if (ni.CopyUplinkRoutes && ni.UplinkIfName != "") ||
+		(ni.WireGuardRoutes && ni.WireGuard != nil) {
+		routes, found := r.getIntendedNIRoutes(ni)
+		if !found {
+			missingTable = true
+		}
+		for _, route := range routes {
+			intendedNI.PutItem(route, nil)
+		}
+	}
+	return intendedNI, missingTable
+}
+
+// getIntendedNIRoutes returns routes to install into the routing table of the given
+// network instance. Returns found=false if the routing table cannot be determined.
+func (r *LinuxNIReconciler) getIntendedNIRoutes(ni NIConfig) (
+	routes []linux.Route, found bool) {
+	bridgeIndex, found, err := r.NetworkMonitor.GetInterfaceIndex(ni.Bridge.IfName)
+	if err != nil {
+		r.Log.Errorf("getIntendedNIRoutes: failed to get ifIndex for %s: %v",
+			ni.Bridge.IfName, err)
+		return nil, false
+	}
+	if !found {
+		return nil, false
+	}
+	table := devicenetwork.BaseRTIndex + bridgeIndex
+	// Add the lowest-prio default-drop route.
+	// The route is used to drop all packets otherwise not matched by any route
+	// and prevent them from escaping the NI-specific routing table.
+	_, defaultDst, _ := net.ParseCIDR("0.0.0.0/0")
+	routes = append(routes, linux.Route{
+		Route: netlink.Route{
+			Dst:      defaultDst,
+			Priority: int(^uint32(0)),
+			Table:    table,
+			Type:     unix.RTN_UNREACHABLE,
+			Family:   netlink.FAMILY_V4,
+		},
+	})
+	// Copy IPv4 routes of the bridge and of the uplink from the main table.
+	routes = append(routes,
+		r.getMainTableRoutes(ni.Bridge.IfName, linux.BridgeTypename, table)...)
+	if ni.CopyUplinkRoutes && ni.UplinkIfName != "" {
+		routes = append(routes,
+			r.getMainTableRoutes(ni.UplinkIfName, linux.UplinkTypename, table)...)
+	}
+	if ni.WireGuardRoutes && ni.WireGuard != nil {
+		routes = append(routes, getWireGuardRoutes(*ni.WireGuard, table)...)
+	}
+	return routes, true
+}
+
+// getWireGuardRoutes returns routes for IPv4 AllowedIPs of all WireGuard peers.
+// Output interface index is resolved by RouteConfigurator.
+func getWireGuardRoutes(wg linux.WireGuard, table int) (routes []linux.Route) {
+	dsts := make(map[string]struct{})
+	for _, peer := range wg.Peers {
+		for _, allowedIP := range peer.AllowedIPs {
+			if allowedIP.IP.To4() == nil {
+				continue
+			}
+			if _, duplicate := dsts[allowedIP.String()]; duplicate {
+				continue
+			}
+			dsts[allowedIP.String()] = struct{}{}
+			routes = append(routes, linux.Route{
+				Route: netlink.Route{
+					Dst:    allowedIP,
+					Table:  table,
+					Scope:  netlink.SCOPE_LINK,
+					Family: netlink.FAMILY_V4,
+				},
+				OutputIf:     wg.IfName,
+				OutputIfType: linux.WireGuardTypename,
+			})
+		}
+	}
+	return routes
+}
+
+func (r *LinuxNIReconciler) getMainTableRoutes(ifName, ifType string,
+	dstTable int) (routes []linux.Route) {
+	ifIndex, found, err := r.NetworkMonitor.GetInterfaceIndex(ifName)
+	if err != nil {
+		r.Log.Errorf("getMainTableRoutes: failed to get ifIndex for %s: %v",
+			ifName, err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	mainRoutes, err := r.NetworkMonitor.ListRoutes(netmonitor.RouteFilters{
+		FilterByTable: true,
+		Table:         syscall.RT_TABLE_MAIN,
+		FilterByIf:    true,
+		IfIndex:       ifIndex,
+	})
+	if err != nil {
+		r.Log.Errorf("getMainTableRoutes: ListRoutes failed for ifIndex %d: %v",
+			ifIndex, err)
+		return nil
+	}
+	for _, rt := range mainRoutes {
+		rtCopy, ok := rt.Data.(netlink.Route)
+		if !ok || !isIPv4Route(rtCopy) {
+			continue
+		}
+		rtCopy.Table = dstTable
+		// Clear any RTNH_F_LINKDOWN etc flags since add doesn't like them.
+		rtCopy.Flags = 0
+		routes = append(routes, linux.Route{
+			Route:        rtCopy,
+			OutputIf:     ifName,
+			OutputIfType: ifType,
+		})
+	}
+	return routes
+}
+
+func isIPv4Route(rt netlink.Route) bool {
+	if rt.Dst != nil {
+		return rt.Dst.IP.To4() != nil
+	}
+	if rt.Gw != nil {
+		return rt.Gw.To4() != nil
+	}
+	return rt.Family == netlink.FAMILY_V4
+}
+
+func (r *LinuxNIReconciler) getIntendedAppConnCfg(app AppConnConfig) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        AppSGPrefix + app.UUID.String(),
+		Description: "Network connections of application " + app.DisplayName,
+	}
+	intendedApp := dg.New(graphArgs)
+	for _, vif := range app.VIFs {
+		intendedApp.PutItem(vif, nil)
+	}
+	for _, shaper := range app.Shapers {
+		intendedApp.PutItem(shaper, nil)
+	}
+	for _, rule := range app.Rules {
+		if rule.ActionChainName == "" {
+			continue
+		}
+		intendedApp.PutItem(getMarkChain(rule), nil)
+	}
+	return intendedApp
+}
+
+// getMarkChain returns chain which marks connection matched by the given rule
+// with the rule-specific mark (used for flow logging and ACL counters)
+// and accepts the traffic.
+func getMarkChain(rule types.IPTablesRule) dpclinux.IptablesChain {
+	mark := strconv.FormatUint(uint64(rule.ActionChainMark), 10)
+	return dpclinux.IptablesChain{
+		ChainName: rule.ActionChainName,
+		Table:     "mangle",
+		ForIPv6:   rule.IPVer == 6,
+		Rules: []dpclinux.IptablesRule{
+			{Args: []string{"-j", "CONNMARK", "--restore-mark"}},
+			{Args: []string{"-m", "mark", "!", "--mark", "0", "-j", "ACCEPT"},
+				Description: "Connection already marked"},
+			{Args: []string{"-j", "CONNMARK", "--set-mark", mark}},
+			{Args: []string{"-j", "CONNMARK", "--restore-mark"}},
+			{Args: []string{"-j", "ACCEPT"}},
+		},
+	}
+}
+
+// getIntendedACLs builds content of the pre-created "-apps" chains.
+// Rules not specific to any NI or app are put at the top, followed by
+// NI-scoped rules and then by app ACLs.
+func (r *LinuxNIReconciler) getIntendedACLs(args Args) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        ACLsSG,
+		Description: "iptables rules installed by zedrouter",
+	}
+	intendedACLs := dg.New(graphArgs)
+	type chainKey struct {
+		table   string
+		chain   string
+		forIPv6 bool
+	}
+	chains := make(map[chainKey]*dpclinux.IptablesChain)
+	for table, tableChains := range appChains {
+		for _, chain := range tableChains {
+			for _, forIPv6 := range []bool{false, true} {
+				chains[chainKey{table: table, chain: chain, forIPv6: forIPv6}] =
+					&dpclinux.IptablesChain{
+						ChainName:  chain + iptables.AppChainSuffix,
+						Table:      table,
+						ForIPv6:    forIPv6,
+						PreCreated: true,
+					}
+			}
+		}
+	}
+	addRule := func(rule types.IPTablesRule) {
+		table := rule.Table
+		if table == "" {
+			table = "filter"
+		}
+		key := chainKey{table: table, chain: rule.Chain, forIPv6: rule.IPVer == 6}
+		chain := chains[key]
+		if chain == nil {
+			r.Log.Errorf("getIntendedACLs: unexpected chain %s for table %s (rule: %v)",
+				rule.Chain, table, rule)
+			return
+		}
+		var ruleArgs []string
+		ruleArgs = append(ruleArgs, rule.Prefix...)
+		ruleArgs = append(ruleArgs, rule.Rule...)
+		ruleArgs = append(ruleArgs, rule.Action...)
+		chain.Rules = append(chain.Rules, dpclinux.IptablesRule{
+			Args:        ruleArgs,
+			Description: rule.RuleName,
+		})
+		if rule.ActionChainName != "" &&
+			!containsString(chain.RefersChains, rule.ActionChainName) {
+			chain.RefersChains = append(chain.RefersChains, rule.ActionChainName)
+		}
+	}
+	for _, rule := range args.Rules {
+		addRule(rule)
+	}
+	for _, ni := range args.NIs {
+		for _, rule := range ni.Rules {
+			addRule(rule)
+		}
+	}
+	for _, app := range args.Apps {
+		for _, rule := range app.Rules {
+			addRule(rule)
+		}
+	}
+	var keys []chainKey
+	for key := range chains {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].table != keys[j].table {
+			return keys[i].table < keys[j].table
+		}
+		if keys[i].chain != keys[j].chain {
+			return keys[i].chain < keys[j].chain
+		}
+		return !keys[i].forIPv6 && keys[j].forIPv6
+	})
+	for _, key := range keys {
+		intendedACLs.PutItem(*chains[key], nil)
+	}
+	return intendedACLs
+}
+
+func containsString(list []string, str string) bool {
+	for _, item := range list {
+		if item == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,605 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	dpclinux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	nirec "github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var (
+	niReconciler   *nirec.LinuxNIReconciler
+	networkMonitor *netmonitor.MockNetworkMonitor
+)
+
+func initTest(test *testing.T) *GomegaWithT {
+	t := NewGomegaWithT(test)
+	t.SetDefaultEventuallyTimeout(5 * time.Second)
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	networkMonitor = &netmonitor.MockNetworkMonitor{
+		Log:    log,
+		MainRT: syscall.RT_TABLE_MAIN,
+	}
+	niReconciler = &nirec.LinuxNIReconciler{
+		Log:            log,
+		NetworkMonitor: networkMonitor,
+	}
+	return t
+}
+
+func itemIsCreated(itemRef dg.ItemRef) bool {
+	_, state, _, found := niReconciler.GetCurrentState().Item(itemRef)
+	return found && state.IsCreated()
+}
+
+func itemIsCreatedWithLabel(label string) bool {
+	currentState := niReconciler.GetCurrentState()
+	iter := currentState.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		if item.Label() == label {
+			return state.IsCreated()
+		}
+	}
+	return false
+}
+
+func itemDescription(itemRef dg.ItemRef) string {
+	item, _, _, found := niReconciler.GetCurrentState().Item(itemRef)
+	if !found {
+		return ""
+	}
+	return item.String()
+}
+
+func itemCountWithType(itemType string) (count int) {
+	currentState := niReconciler.GetCurrentState()
+	iter := currentState.Items(true)
+	for iter.Next() {
+		item, _ := iter.Item()
+		if item.Type() == itemType {
+			count++
+		}
+	}
+	return count
+}
+
+func macAddress(macAddr string) net.HardwareAddr {
+	mac, err := net.ParseMAC(macAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return mac
+}
+
+func ipAddress(ipAddr string) *net.IPNet {
+	ip, subnet, err := net.ParseCIDR(ipAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subnet.IP = ip
+	return subnet
+}
+
+func ipSubnet(ipAddr string) *net.IPNet {
+	_, subnet, err := net.ParseCIDR(ipAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return subnet
+}
+
+var (
+	eth0 = netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       1,
+			IfName:        "eth0",
+			IfType:        "device",
+			WithBroadcast: true,
+			AdminUp:       true,
+			LowerUp:       true,
+		},
+		IPAddrs: []*net.IPNet{ipAddress("192.168.10.5/24")},
+		HwAddr:  macAddress("02:00:00:00:00:01"),
+	}
+	eth0Routes = []netmonitor.Route{
+		{
+			IfIndex: 1,
+			Dst:     nil,
+			Gw:      net.ParseIP("192.168.10.1"),
+			Table:   syscall.RT_TABLE_MAIN,
+			Data: netlink.Route{
+				LinkIndex: 1,
+				Dst:       nil,
+				Gw:        net.ParseIP("192.168.10.1"),
+				Table:     syscall.RT_TABLE_MAIN,
+				Family:    netlink.FAMILY_V4,
+			},
+		},
+		{
+			IfIndex: 1,
+			Dst:     ipSubnet("192.168.10.0/24"),
+			Table:   syscall.RT_TABLE_MAIN,
+			Data: netlink.Route{
+				LinkIndex: 1,
+				Dst:       ipSubnet("192.168.10.0/24"),
+				Table:     syscall.RT_TABLE_MAIN,
+				Family:    netlink.FAMILY_V4,
+			},
+		},
+	}
+	bn1 = netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       10,
+			IfName:        "bn1",
+			IfType:        "bridge",
+			WithBroadcast: true,
+			AdminUp:       true,
+			LowerUp:       true,
+		},
+		IPAddrs: []*net.IPNet{ipAddress("10.10.10.1/24")},
+		HwAddr:  macAddress("00:16:3e:06:00:01"),
+	}
+	ni1UUID  = uuid.FromStringOrNil("0d6a128b-b36f-4bd0-a71c-087ba2d71ebc")
+	app1UUID = uuid.FromStringOrNil("f9a3acd0-85ae-4c1f-8fb2-0ac22b5dd312")
+)
+
+func localNIConfig() nirec.NIConfig {
+	return nirec.NIConfig{
+		UUID:        ni1UUID,
+		DisplayName: "ni1",
+		Bridge: linux.Bridge{
+			IfName:      "bn1",
+			MACAddress:  macAddress("00:16:3e:06:00:01"),
+			IPAddresses: []*net.IPNet{ipAddress("10.10.10.1/24")},
+		},
+		UplinkIfName:       "eth0",
+		UplinkLogicalLabel: "mock-eth0",
+		CopyUplinkRoutes:   true,
+		Dnsmasq: &linux.Dnsmasq{
+			ListenIf: "bn1",
+			ListenIP: net.ParseIP("10.10.10.1"),
+			Subnet:   ipSubnet("10.10.10.0/24"),
+			Router:   net.ParseIP("10.10.10.1"),
+		},
+		Rules: []types.IPTablesRule{
+			{
+				Table:    "nat",
+				Chain:    "POSTROUTING",
+				Rule:     []string{"-o", "eth0", "-s", "10.10.10.0/24"},
+				Action:   []string{"-j", "MASQUERADE"},
+				RuleName: "NAT for ni1",
+			},
+		},
+	}
+}
+
+func app1ConnConfig() nirec.AppConnConfig {
+	return nirec.AppConnConfig{
+		UUID:        app1UUID,
+		DisplayName: "app1",
+		VIFs: []linux.VIF{
+			{
+				IfName:         "nbu1x1",
+				NetAdapterName: "adapter1",
+				AppDisplayName: "app1",
+				BridgeIfName:   "bn1",
+				GuestMAC:       macAddress("02:16:3e:00:00:01"),
+			},
+		},
+		Rules: []types.IPTablesRule{
+			{
+				IPVer:           4,
+				Table:           "mangle",
+				Chain:           "PREROUTING",
+				Prefix:          []string{"-i", "bn1", "-m", "physdev", "--physdev-in", "nbu1x1"},
+				Rule:            []string{"-d", "1.1.1.1"},
+				Action:          []string{"-j", "proto-bn1-1"},
+				ActionChainName: "proto-bn1-1",
+				ActionChainMark: 0x1000001,
+			},
+		},
+	}
+}
+
+func TestReconcileWithEmptyArgs(test *testing.T) {
+	t := initTest(test)
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, nirec.Args{})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.AsyncInProgress).To(BeFalse())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs).To(BeEmpty())
+	t.Expect(itemCountWithType(dpclinux.IPtablesChainTypename)).To(Equal(11))
+	t.Expect(itemCountWithType(dpclinux.IP6tablesChainTypename)).To(Equal(11))
+	t.Expect(itemCountWithType(linux.BridgeTypename)).To(Equal(0))
+
+	// Nothing to do when the arguments have not changed.
+	status = niReconciler.Reconcile(ctx, nirec.Args{})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+}
+
+func TestLocalNI(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+
+	ni1 := localNIConfig()
+	args := nirec.Args{NIs: []nirec.NIConfig{ni1}}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs).To(HaveKey(ni1UUID))
+	// Bridge is not actually created by mock reconciliation.
+	t.Expect(status.NIs[ni1UUID].BridgeIfIndex).To(BeZero())
+	t.Expect(status.NIs[ni1UUID].RouteTable).To(BeZero())
+	t.Expect(itemIsCreated(dg.Reference(ni1.Bridge))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Dnsmasq))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(linux.Uplink{IfName: "eth0"}))).To(BeTrue())
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+	natChain := dg.Reference(dpclinux.IptablesChain{
+		Table: "nat", ChainName: "POSTROUTING-apps"})
+	t.Expect(itemDescription(natChain)).To(ContainSubstring("NAT for ni1"))
+
+	// Simulate the bridge being created.
+	networkMonitor.AddOrUpdateInterface(bn1)
+	networkMonitor.UpdateRoutes(append(eth0Routes, netmonitor.Route{
+		IfIndex: 10,
+		Dst:     ipSubnet("10.10.10.0/24"),
+		Table:   syscall.RT_TABLE_MAIN,
+		Data: netlink.Route{
+			LinkIndex: 10,
+			Dst:       ipSubnet("10.10.10.0/24"),
+			Table:     syscall.RT_TABLE_MAIN,
+			Family:    netlink.FAMILY_V4,
+		},
+	}))
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs[ni1UUID].BridgeIfIndex).To(Equal(10))
+	t.Expect(status.NIs[ni1UUID].RouteTable).To(Equal(510))
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(4))
+	t.Expect(itemIsCreatedWithLabel("IP route table 510 unreachable 0.0.0.0/0")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst <default> dev eth0 via 192.168.10.1")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst 10.10.10.0/24 dev bn1 via <nil>")).To(BeTrue())
+
+	// Connect application.
+	args.Apps = []nirec.AppConnConfig{app1ConnConfig()}
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	vif := dg.Reference(args.Apps[0].VIFs[0])
+	t.Expect(itemIsCreated(vif)).To(BeFalse()) // VIF does not exist yet
+	markChain := dg.Reference(dpclinux.IptablesChain{
+		Table: "mangle", ChainName: "proto-bn1-1"})
+	t.Expect(itemIsCreated(markChain)).To(BeTrue())
+	t.Expect(itemDescription(markChain)).To(ContainSubstring("16777217"))
+	preroutingChain := dg.Reference(dpclinux.IptablesChain{
+		Table: "mangle", ChainName: "PREROUTING-apps"})
+	t.Expect(itemDescription(preroutingChain)).To(ContainSubstring("nbu1x1"))
+
+	// Simulate VIF being created by the hypervisor.
+	networkMonitor.AddOrUpdateInterface(netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       11,
+			IfName:        "nbu1x1",
+			IfType:        "device",
+			AdminUp:       true,
+			LowerUp:       true,
+			Enslaved:      true,
+			MasterIfIndex: 10,
+		},
+	})
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(vif)).To(BeTrue())
+
+	// Disconnect the application and remove the network instance.
+	networkMonitor.DelInterface("nbu1x1")
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, nirec.Args{})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs).To(BeEmpty())
+	t.Expect(itemIsCreated(vif)).To(BeFalse())
+	t.Expect(itemIsCreated(markChain)).To(BeFalse())
+	t.Expect(itemIsCreated(dg.Reference(ni1.Bridge))).To(BeFalse())
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.DnsmasqTypename)).To(Equal(0))
+	t.Expect(itemDescription(natChain)).ToNot(ContainSubstring("NAT for ni1"))
+}
+
+func TestBridgeDrift(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+	networkMonitor.AddOrUpdateInterface(bn1)
+
+	ni1 := localNIConfig()
+	args := nirec.Args{NIs: []nirec.NIConfig{ni1}}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.NIs[ni1UUID].BridgeIfIndex).To(Equal(10))
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Dnsmasq))).To(BeTrue())
+
+	// Simulate bridge being removed from outside.
+	networkMonitor.DelInterface("bn1")
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	t.Expect(itemIsCreated(dg.Reference(ni1.Bridge))).To(BeFalse())
+	// Dnsmasq depends on the bridge and therefore it should be re-created as well.
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Dnsmasq))).To(BeFalse())
+
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(dg.Reference(ni1.Bridge))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Dnsmasq))).To(BeTrue())
+}
+
+func TestSwitchNIWithNIMBridge(test *testing.T) {
+	t := initTest(test)
+	eth0Bridge := netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex: 2,
+			IfName:  "eth0",
+			IfType:  "bridge",
+			AdminUp: true,
+			LowerUp: true,
+		},
+		IPAddrs: []*net.IPNet{ipAddress("192.168.10.5/24")},
+		HwAddr:  macAddress("02:00:00:00:00:02"),
+	}
+	networkMonitor.AddOrUpdateInterface(eth0Bridge)
+
+	ni := nirec.NIConfig{
+		UUID:        ni1UUID,
+		DisplayName: "switch-ni",
+		Bridge: linux.Bridge{
+			IfName:       "eth0",
+			CreatedByNIM: true,
+		},
+		UplinkIfName:       "eth0",
+		UplinkLogicalLabel: "mock-eth0",
+	}
+	args := nirec.Args{NIs: []nirec.NIConfig{ni}}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs[ni1UUID].BridgeIfIndex).To(Equal(2))
+	t.Expect(status.NIs[ni1UUID].RouteTable).To(BeZero())
+	bridge := dg.Reference(ni.Bridge)
+	t.Expect(itemIsCreated(bridge)).To(BeTrue())
+	t.Expect(itemDescription(bridge)).To(ContainSubstring("192.168.10.5/24"))
+	t.Expect(itemCountWithType(linux.BridgePortTypename)).To(Equal(0))
+}
+
+func TestAppTrafficShaping(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+	networkMonitor.AddOrUpdateInterface(bn1)
+
+	app1 := app1ConnConfig()
+	shaper := linux.TrafficShaper{
+		VIFIfName:      "nbu1x1",
+		IFBIfName:      "nbi1x1",
+		NetAdapterName: "adapter1",
+		AppDisplayName: "app1",
+		Egress: types.TrafficShaper{
+			RateBps: 10000000,
+			Classes: []types.TrafficClass{
+				{Name: "ssh", RateBps: 1000000, RemotePorts: []uint16{22}},
+			},
+		},
+	}
+	app1.Shapers = []linux.TrafficShaper{shaper}
+	args := nirec.Args{
+		NIs:  []nirec.NIConfig{localNIConfig()},
+		Apps: []nirec.AppConnConfig{app1},
+	}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	// Shaper waits for the VIF to be created by the hypervisor.
+	t.Expect(itemIsCreated(dg.Reference(shaper))).To(BeFalse())
+
+	networkMonitor.AddOrUpdateInterface(netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       11,
+			IfName:        "nbu1x1",
+			IfType:        "device",
+			AdminUp:       true,
+			LowerUp:       true,
+			Enslaved:      true,
+			MasterIfIndex: 10,
+		},
+	})
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(shaper))).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel("app1/adapter1 (traffic shaper)")).To(BeTrue())
+
+	// Disable shaping.
+	args.Apps[0].Shapers = nil
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.TrafficShaperTypename)).To(Equal(0))
+}
+
+func TestVxlanNIWithIPsec(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+
+	localIP := net.ParseIP("192.168.10.5")
+	peers := []net.IP{net.ParseIP("192.168.20.5"), net.ParseIP("192.168.30.5")}
+	vxlanNI := func(uuidStr, name string, bridgeNum int) nirec.NIConfig {
+		ni := localNIConfig()
+		ni.UUID = uuid.FromStringOrNil(uuidStr)
+		ni.DisplayName = name
+		ni.Bridge.IfName = fmt.Sprintf("bn%d", bridgeNum)
+		ni.Vxlan = &linux.Vxlan{
+			IfName:         fmt.Sprintf("vx%d", bridgeNum),
+			VNI:            uint32(100 + bridgeNum),
+			DstPort:        types.DefaultVxlanDstPort,
+			MTU:            1410,
+			UnderlayIfName: "eth0",
+			UnderlayIfType: linux.UplinkTypename,
+			LocalIP:        localIP,
+			Remotes:        peers,
+			BridgeIfName:   ni.Bridge.IfName,
+		}
+		for _, peer := range peers {
+			ni.IPsec = append(ni.IPsec, linux.IPsecTransport{
+				LocalIP:      localIP,
+				PeerIP:       peer,
+				DstPort:      types.DefaultVxlanDstPort,
+				PresharedKey: "secret",
+				UplinkIfName: "eth0",
+			})
+		}
+		return ni
+	}
+	ni1 := vxlanNI(ni1UUID.String(), "ni1", 1)
+	ni2 := vxlanNI("9d26f1b4-4a29-4a54-9b71-3e5a3a5cb7a6", "ni2", 2)
+	args := nirec.Args{NIs: []nirec.NIConfig{ni1, ni2}}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Vxlan))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(*ni2.Vxlan))).To(BeTrue())
+	// Both NIs use the same SA pairs.
+	t.Expect(itemCountWithType(linux.IPsecTransportTypename)).To(Equal(2))
+	t.Expect(itemIsCreated(dg.Reference(ni1.IPsec[0]))).To(BeTrue())
+	t.Expect(itemDescription(dg.Reference(ni1.IPsec[0]))).ToNot(ContainSubstring("secret"))
+
+	// SA pairs remain as long as they are used by some NI.
+	args.NIs = []nirec.NIConfig{ni2}
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(dg.Reference(*ni1.Vxlan))).To(BeFalse())
+	t.Expect(itemCountWithType(linux.IPsecTransportTypename)).To(Equal(2))
+
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, nirec.Args{})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.VxlanTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.IPsecTransportTypename)).To(Equal(0))
+}
+
+func TestWireGuardNI(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+
+	ni1 := localNIConfig()
+	ni1.CopyUplinkRoutes = false
+	ni1.WireGuardRoutes = true
+	ni1.WireGuard = &linux.WireGuard{
+		IfName:       "wg1",
+		PrivateKey:   "secret",
+		IPAddress:    ipAddress("10.100.0.2/24"),
+		MTU:          1420,
+		UplinkIfName: "eth0",
+		Peers: []linux.WireGuardPeer{
+			{
+				PublicKey: "peer1",
+				Endpoint: &net.UDPAddr{
+					IP: net.ParseIP("192.168.20.5"), Port: 51820},
+				AllowedIPs: []*net.IPNet{
+					ipSubnet("10.100.0.0/24"), ipSubnet("172.30.0.0/16")},
+			},
+			{
+				PublicKey:  "peer2",
+				AllowedIPs: []*net.IPNet{ipSubnet("172.30.0.0/16")},
+			},
+		},
+	}
+	ni1.Rules = []types.IPTablesRule{
+		{
+			Table:    "nat",
+			Chain:    "POSTROUTING",
+			Rule:     []string{"-o", "wg1", "-s", "10.10.10.0/24"},
+			Action:   []string{"-j", "MASQUERADE"},
+			RuleName: "NAT for ni1",
+		},
+	}
+	networkMonitor.AddOrUpdateInterface(bn1)
+	networkMonitor.UpdateRoutes(append(eth0Routes, netmonitor.Route{
+		IfIndex: 10,
+		Dst:     ipSubnet("10.10.10.0/24"),
+		Table:   syscall.RT_TABLE_MAIN,
+		Data: netlink.Route{
+			LinkIndex: 10,
+			Dst:       ipSubnet("10.10.10.0/24"),
+			Table:     syscall.RT_TABLE_MAIN,
+			Family:    netlink.FAMILY_V4,
+		},
+	}))
+
+	args := nirec.Args{NIs: []nirec.NIConfig{ni1}}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.Reconcile(ctx, args)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(status.NIs[ni1UUID].RouteTable).To(Equal(510))
+	t.Expect(itemIsCreated(dg.Reference(*ni1.WireGuard))).To(BeTrue())
+	t.Expect(itemDescription(dg.Reference(*ni1.WireGuard))).ToNot(ContainSubstring("secret"))
+	// Uplink routes are not copied, only AllowedIPs are routed (without duplicates).
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(4))
+	t.Expect(itemIsCreatedWithLabel("IP route table 510 unreachable 0.0.0.0/0")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst 10.10.10.0/24 dev bn1 via <nil>")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst 10.100.0.0/24 dev wg1 via <nil>")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst 172.30.0.0/16 dev wg1 via <nil>")).To(BeTrue())
+	t.Expect(itemIsCreatedWithLabel(
+		"IP route table 510 dst <default> dev eth0 via 192.168.10.1")).To(BeFalse())
+
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.Reconcile(ctx, nirec.Args{})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.WireGuardTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+}
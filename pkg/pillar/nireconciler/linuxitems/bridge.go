@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/vishvananda/netlink"
+)
+
+// Bridge : Linux bridge used by a network instance to connect applications
+// between themselves and with the (optional) uplink.
+type Bridge struct {
+	// IfName : name of the bridge interface.
+	IfName string
+	// MACAddress : MAC address assigned to the bridge.
+	// Only used if the bridge is created by zedrouter.
+	MACAddress net.HardwareAddr
+	// IPAddresses : IP addresses assigned to the bridge.
+	// Only used if the bridge is created by zedrouter.
+	IPAddresses []*net.IPNet
+	// CreatedByNIM : true if this is a bridge created by NIM for the uplink
+	// port of a switch network instance.
+	// Such bridge is an external item for the NI reconciler.
+	CreatedByNIM bool
+}
+
+// Name returns the bridge interface name.
+func (b Bridge) Name() string {
+	return b.IfName
+}
+
+// Label is not defined.
+func (b Bridge) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (b Bridge) Type() string {
+	return BridgeTypename
+}
+
+// Equal is a comparison method for two equally-named bridge instances.
+func (b Bridge) Equal(other depgraph.Item) bool {
+	b2 := other.(Bridge)
+	return b.CreatedByNIM == b2.CreatedByNIM &&
+		b.MACAddress.String() == b2.MACAddress.String() &&
+		equalIPNetLists(b.IPAddresses, b2.IPAddresses)
+}
+
+// External returns true for bridges created by NIM.
+func (b Bridge) External() bool {
+	return b.CreatedByNIM
+}
+
+// String describes the bridge.
+func (b Bridge) String() string {
+	return fmt.Sprintf("Bridge: {ifName: %s, macAddress: %s, ipAddresses: %v, "+
+		"createdByNIM: %t}", b.IfName, b.MACAddress, b.IPAddresses, b.CreatedByNIM)
+}
+
+// Dependencies returns nothing.
+func (b Bridge) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
+
+// HasIP returns true if the given IP address is assigned to the bridge.
+func (b Bridge) HasIP(ip net.IP) bool {
+	for _, addr := range b.IPAddresses {
+		if addr.IP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// BridgeConfigurator implements Configurator interface (libs/reconciler)
+// for Linux bridges.
+type BridgeConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create creates the bridge, sets it UP and assigns IP addresses.
+func (c *BridgeConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	bridge := item.(Bridge)
+	if bridge.CreatedByNIM {
+		return errors.New("not managed by NI reconciler")
+	}
+	// Start clean, remove any leftover from the previous run.
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = bridge.IfName
+	_ = netlink.LinkDel(&netlink.Bridge{LinkAttrs: attrs})
+	// ip link add ${bridgeName} type bridge
+	attrs = netlink.NewLinkAttrs()
+	attrs.Name = bridge.IfName
+	attrs.HardwareAddr = bridge.MACAddress
+	link := &netlink.Bridge{LinkAttrs: attrs}
+	if err := netlink.LinkAdd(link); err != nil {
+		err = fmt.Errorf("netlink.LinkAdd(%s) failed: %v", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	// ip link set ${bridgeName} up
+	if err := netlink.LinkSetUp(link); err != nil {
+		err = fmt.Errorf("netlink.LinkSetUp(%s) failed: %v", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	disableICMPRedirects(c.Log, bridge.IfName)
+	for _, ipAddr := range bridge.IPAddresses {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			err = fmt.Errorf("netlink.AddrAdd(%s, %s) failed: %v",
+				bridge.IfName, ipAddr, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Modify updates the set of IP addresses assigned to the bridge.
+func (c *BridgeConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	oldBridge := oldItem.(Bridge)
+	newBridge := newItem.(Bridge)
+	link, err := netlink.LinkByName(newBridge.IfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", newBridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	obsolete, added := diffIPNetLists(oldBridge.IPAddresses, newBridge.IPAddresses)
+	for _, ipAddr := range obsolete {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrDel(link, addr); err != nil {
+			err = fmt.Errorf("netlink.AddrDel(%s, %s) failed: %v",
+				newBridge.IfName, ipAddr, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	for _, ipAddr := range added {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			err = fmt.Errorf("netlink.AddrAdd(%s, %s) failed: %v",
+				newBridge.IfName, ipAddr, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the bridge (together with assigned IP addresses).
+func (c *BridgeConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	bridge := item.(Bridge)
+	if bridge.CreatedByNIM {
+		return errors.New("not managed by NI reconciler")
+	}
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = bridge.IfName
+	link := &netlink.Bridge{LinkAttrs: attrs}
+	if err := netlink.LinkDel(link); err != nil {
+		err = fmt.Errorf("netlink.LinkDel(%s) failed: %v", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if MAC address has changed.
+// IP addresses can be changed without re-creating the bridge.
+func (c *BridgeConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldBridge := oldItem.(Bridge)
+	newBridge := newItem.(Bridge)
+	return oldBridge.MACAddress.String() != newBridge.MACAddress.String()
+}
+
+func disableICMPRedirects(log *base.LogObject, ifName string) {
+	sysctlSetting := fmt.Sprintf("net.ipv4.conf.%s.send_redirects=0", ifName)
+	out, err := base.Exec(log, "sysctl", "-w", sysctlSetting).CombinedOutput()
+	if err != nil {
+		log.Errorf("sysctl command %s failed %s output %s",
+			sysctlSetting, err, out)
+	}
+}
+
+func equalIPNetLists(list1, list2 []*net.IPNet) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i].String() != list2[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+func diffIPNetLists(oldList, newList []*net.IPNet) (obsolete, added []*net.IPNet) {
+	for _, oldAddr := range oldList {
+		var found bool
+		for _, newAddr := range newList {
+			if oldAddr.String() == newAddr.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			obsolete = append(obsolete, oldAddr)
+		}
+	}
+	for _, newAddr := range newList {
+		var found bool
+		for _, oldAddr := range oldList {
+			if oldAddr.String() == newAddr.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, newAddr)
+		}
+	}
+	return obsolete, added
+}
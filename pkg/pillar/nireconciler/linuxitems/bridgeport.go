@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/vishvananda/netlink"
+)
+
+// BridgePort : network interface attached to a bridge created for network instance.
+// Used to attach uplink interface to the bridge of a switch NI.
+type BridgePort struct {
+	// BridgeIfName : name of the bridge.
+	BridgeIfName string
+	// PortIfName : name of the interface which is put under the bridge.
+	PortIfName string
+}
+
+// Name uses the port interface name to identify the item.
+// An interface can be attached to at most one bridge.
+func (p BridgePort) Name() string {
+	return p.PortIfName
+}
+
+// Label is more human-readable than name.
+func (p BridgePort) Label() string {
+	return fmt.Sprintf("%s -> %s", p.PortIfName, p.BridgeIfName)
+}
+
+// Type of the item.
+func (p BridgePort) Type() string {
+	return BridgePortTypename
+}
+
+// Equal is a comparison method for two equally-named BridgePort instances.
+func (p BridgePort) Equal(other depgraph.Item) bool {
+	p2 := other.(BridgePort)
+	return p.BridgeIfName == p2.BridgeIfName
+}
+
+// External returns false.
+func (p BridgePort) External() bool {
+	return false
+}
+
+// String describes the bridge port.
+func (p BridgePort) String() string {
+	return fmt.Sprintf("Bridge Port: {bridge: %s, port: %s}",
+		p.BridgeIfName, p.PortIfName)
+}
+
+// Dependencies returns the bridge and the port interface as dependencies.
+func (p BridgePort) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: p.BridgeIfName,
+			},
+			Description: "Bridge must exist",
+		},
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: UplinkTypename,
+				ItemName: p.PortIfName,
+			},
+			Description: "Port interface must exist",
+		},
+	}
+}
+
+// BridgePortConfigurator implements Configurator interface (libs/reconciler)
+// for bridge ports.
+type BridgePortConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create sets the port UP and attaches it to the bridge.
+func (c *BridgePortConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	port := item.(BridgePort)
+	bridgeLink, err := netlink.LinkByName(port.BridgeIfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", port.BridgeIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	portLink, err := netlink.LinkByName(port.PortIfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", port.PortIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkSetUp(portLink); err != nil {
+		err = fmt.Errorf("netlink.LinkSetUp(%s) failed: %v", port.PortIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkSetMaster(portLink, bridgeLink); err != nil {
+		err = fmt.Errorf("netlink.LinkSetMaster(%s, %s) failed: %v",
+			port.PortIfName, port.BridgeIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *BridgePortConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete detaches the port from the bridge.
+func (c *BridgePortConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	port := item.(BridgePort)
+	portLink, err := netlink.LinkByName(port.PortIfName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+			// Nothing to detach.
+			return nil
+		}
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", port.PortIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkSetNoMaster(portLink); err != nil {
+		err = fmt.Errorf("netlink.LinkSetNoMaster(%s) failed: %v",
+			port.PortIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *BridgePortConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
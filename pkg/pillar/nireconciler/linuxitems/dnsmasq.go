@@ -0,0 +1,560 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+const (
+	runDir        = "/run/zedrouter"
+	dnsmasqBinary = "/opt/zededa/bin/dnsmasq"
+	// DnsmasqLeaseDir : directory where dnsmasq instances store DHCP leases
+	// (one file per bridge).
+	DnsmasqLeaseDir = runDir + "/dnsmasq.leases/"
+	// How long to wait for dnsmasq to exit after it was signaled to stop.
+	dnsmasqStopTimeout = 60 * time.Second
+)
+
+const dnsmasqStaticConfig = `
+# Automatically generated by zedrouter
+except-interface=lo
+bind-interfaces
+quiet-dhcp
+quiet-dhcp6
+no-hosts
+no-ping
+bogus-priv
+neg-ttl=10
+dhcp-ttl=600
+`
+
+// Dnsmasq : DHCP and DNS server running for a network instance.
+type Dnsmasq struct {
+	// ListenIf : bridge interface on which dnsmasq listens.
+	ListenIf string
+	// ListenIP : IP address assigned to the bridge.
+	ListenIP net.IP
+	// UplinkIf : uplink interface used to forward DNS queries.
+	// Empty for air-gapped network instances.
+	UplinkIf string
+	// UpstreamServers : DNS servers to forward queries to.
+	// If empty (while UplinkIf is defined), dnsmasq uses /etc/resolv.conf.
+	UpstreamServers []net.IP
+	// IPSets : for every listed domain, dnsmasq adds resolved IPs into ipsets
+	// ipv4.<ipset-basename> and ipv6.<ipset-basename>.
+	IPSets []DnsmasqIPSet
+	// HostsDir : directory with hosts files read by dnsmasq.
+	HostsDir string
+	// DomainName : domain name advertised to DHCP clients.
+	DomainName string
+	// DNSServers : DNS servers advertised to DHCP clients.
+	DNSServers []net.IP
+	// NTPServers : NTP servers advertised to DHCP clients.
+	NTPServers []net.IP
+	// Subnet : network instance subnet.
+	Subnet *net.IPNet
+	// Router : router IP advertised to DHCP clients. Nil if dnsmasq should not
+	// advertise any router (e.g. air-gapped network instance).
+	Router net.IP
+	// DHCPRangeStart : first IP address of the DHCP range.
+	DHCPRangeStart net.IP
+	// WithAllOnesNetmask : advertise 255.255.255.255 netmask to force all traffic
+	// to go through the router (and get subjected to ACLs and flow logging).
+	WithAllOnesNetmask bool
+	// MTU : interface MTU advertised to DHCP clients. Zero to not advertise.
+	MTU uint16
+	// DHCPHosts : statically assigned IP addresses.
+	DHCPHosts []DnsmasqDHCPHost
+	// LogDHCP : enable logging of DHCP transactions.
+	LogDHCP bool
+	// LogQueries : enable logging of DNS queries.
+	LogQueries bool
+}
+
+// DnsmasqIPSet : ipset populated by dnsmasq with IPs resolved for the given domain.
+type DnsmasqIPSet struct {
+	Domain        string
+	IPSetBasename string
+}
+
+// DnsmasqDHCPHost : static IP address assignment.
+type DnsmasqDHCPHost struct {
+	MAC      net.HardwareAddr
+	IP       net.IP
+	Hostname string
+}
+
+// fileName returns name of the dhcp-hosts file for this host.
+func (h DnsmasqDHCPHost) fileName() string {
+	if h.IP.To4() == nil {
+		return h.MAC.String() + ".inet6"
+	}
+	return h.MAC.String() + ".inet"
+}
+
+func (h DnsmasqDHCPHost) content() string {
+	if h.IP.To4() == nil {
+		return fmt.Sprintf("%s,[%s],%s\n", h.MAC, h.IP, h.Hostname)
+	}
+	return fmt.Sprintf("%s,id:*,%s,%s\n", h.MAC, h.IP, h.Hostname)
+}
+
+// Name returns the bridge interface name - there is at most one dnsmasq
+// instance per bridge.
+func (d Dnsmasq) Name() string {
+	return d.ListenIf
+}
+
+// Label is more human-readable than name.
+func (d Dnsmasq) Label() string {
+	return "dnsmasq for " + d.ListenIf
+}
+
+// Type of the item.
+func (d Dnsmasq) Type() string {
+	return DnsmasqTypename
+}
+
+// Equal is a comparison method for two equally-named dnsmasq instances.
+func (d Dnsmasq) Equal(other depgraph.Item) bool {
+	d2 := other.(Dnsmasq)
+	return d.equalConfig(d2) && d.equalDHCPHosts(d2)
+}
+
+func (d Dnsmasq) equalConfig(d2 Dnsmasq) bool {
+	var cfg1, cfg2 bytes.Buffer
+	d.writeConfig(&cfg1)
+	d2.writeConfig(&cfg2)
+	return bytes.Equal(cfg1.Bytes(), cfg2.Bytes())
+}
+
+func (d Dnsmasq) equalDHCPHosts(d2 Dnsmasq) bool {
+	if len(d.DHCPHosts) != len(d2.DHCPHosts) {
+		return false
+	}
+	for i := range d.DHCPHosts {
+		if d.DHCPHosts[i].content() != d2.DHCPHosts[i].content() {
+			return false
+		}
+	}
+	return true
+}
+
+// External returns false.
+func (d Dnsmasq) External() bool {
+	return false
+}
+
+// String describes the dnsmasq instance.
+func (d Dnsmasq) String() string {
+	var cfg bytes.Buffer
+	d.writeConfig(&cfg)
+	var hosts []string
+	for _, host := range d.DHCPHosts {
+		hosts = append(hosts, strings.TrimSpace(host.content()))
+	}
+	return fmt.Sprintf("Dnsmasq for bridge %s with config:\n%s\nand DHCP hosts: %v",
+		d.ListenIf, cfg.String(), hosts)
+}
+
+// Dependencies returns the bridge as the only dependency.
+// Dnsmasq binds to the bridge IP address, therefore the bridge must have
+// the address assigned.
+func (d Dnsmasq) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: d.ListenIf,
+			},
+			MustSatisfy: func(item depgraph.Item) bool {
+				bridge := item.(Bridge)
+				return bridge.CreatedByNIM || bridge.HasIP(d.ListenIP)
+			},
+			Description: "Bridge must exist and have the listen IP assigned",
+		},
+	}
+}
+
+func (d Dnsmasq) isIPv6() bool {
+	return d.ListenIP != nil && d.ListenIP.To4() == nil
+}
+
+// writeConfig renders the dnsmasq configuration file.
+func (d Dnsmasq) writeConfig(w io.Writer) {
+	fmt.Fprint(w, dnsmasqStaticConfig)
+	if d.LogQueries {
+		fmt.Fprintln(w, "log-queries")
+	}
+	if d.LogDHCP {
+		fmt.Fprintln(w, "log-dhcp")
+	}
+	fmt.Fprintf(w, "dhcp-leasefile=%s\n", dnsmasqLeasePath(d.ListenIf))
+
+	// Pick where dnsmasq should send DNS requests upstream.
+	// If there is no uplink for this network instance, then nowhere.
+	// If there is an uplink but no DNS servers for it, then we let
+	// dnsmasq use the host's /etc/resolv.conf
+	if d.UplinkIf == "" {
+		fmt.Fprintln(w, "no-resolv")
+	} else if len(d.UpstreamServers) != 0 {
+		for _, server := range d.UpstreamServers {
+			fmt.Fprintf(w, "server=%s@%s\n", server, d.UplinkIf)
+		}
+		fmt.Fprintln(w, "no-resolv")
+	}
+
+	for _, ipset := range d.IPSets {
+		fmt.Fprintf(w, "ipset=/%s/ipv4.%s,ipv6.%s\n",
+			ipset.Domain, ipset.IPSetBasename, ipset.IPSetBasename)
+	}
+	fmt.Fprintf(w, "pid-file=%s\n", dnsmasqPidPath(d.ListenIf))
+	fmt.Fprintf(w, "interface=%s\n", d.ListenIf)
+	if d.ListenIP != nil {
+		fmt.Fprintf(w, "listen-address=%s\n", d.ListenIP)
+	}
+	fmt.Fprintf(w, "hostsdir=%s\n", d.HostsDir)
+	fmt.Fprintf(w, "dhcp-hostsdir=%s\n", dnsmasqDHCPHostsDir(d.ListenIf))
+
+	if d.isIPv6() {
+		d.writeDHCPv6Config(w)
+		return
+	}
+	if d.DomainName != "" {
+		fmt.Fprintf(w, "dhcp-option=option:domain-name,%s\n", d.DomainName)
+	}
+	if len(d.DNSServers) > 0 {
+		var addrList []string
+		for _, srvIP := range d.DNSServers {
+			addrList = append(addrList, srvIP.String())
+		}
+		fmt.Fprintf(w, "dhcp-option=option:dns-server,%s\n",
+			strings.Join(addrList, ","))
+	}
+	if len(d.NTPServers) > 0 {
+		var addrList []string
+		for _, srvIP := range d.NTPServers {
+			addrList = append(addrList, srvIP.String())
+		}
+		fmt.Fprintf(w, "dhcp-option=option:ntp-server,%s\n",
+			strings.Join(addrList, ","))
+	}
+	if d.MTU != 0 {
+		fmt.Fprintf(w, "dhcp-option=option:mtu,%d\n", d.MTU)
+	}
+	ipv4Netmask := "255.255.255.0" // Default unless there is a Subnet
+	if d.Subnet != nil && d.Subnet.IP != nil {
+		ipv4Netmask = net.IP(d.Subnet.Mask).String()
+		if d.Router != nil && d.WithAllOnesNetmask {
+			// Network prefix "255.255.255.255" will force packets to go through
+			// dom0 virtual router that makes the packets pass through ACLs and flow log.
+			fmt.Fprintf(w, "dhcp-option=option:netmask,%s\n", "255.255.255.255")
+		} else {
+			fmt.Fprintf(w, "dhcp-option=option:netmask,%s\n", ipv4Netmask)
+		}
+	}
+	if d.Router != nil {
+		fmt.Fprintf(w, "dhcp-option=option:router,%s\n", d.Router)
+		if d.WithAllOnesNetmask {
+			fmt.Fprintf(w, "dhcp-option=option:classless-static-route,"+
+				"%s/32,%s,%s,%s,%s,%s\n", d.Router, "0.0.0.0",
+				"0.0.0.0/0", d.Router, d.Subnet, d.Router)
+		}
+	} else {
+		fmt.Fprintln(w, "dhcp-option=option:router")
+		if len(d.DNSServers) == 0 {
+			// Handle isolated network by making sure we are not a DNS server.
+			// Can be overridden with the DNSServers above.
+			fmt.Fprintln(w, "dhcp-option=option:dns-server")
+		}
+	}
+	dhcpRange := d.ListenIP
+	if d.DHCPRangeStart != nil {
+		dhcpRange = d.DHCPRangeStart
+	}
+	fmt.Fprintf(w, "dhcp-range=%s,static,%s,60m\n", dhcpRange, ipv4Netmask)
+}
+
+// writeDHCPv6Config renders DHCPv6 part of the dnsmasq configuration.
+// Router (and the on-link prefix) is advertised by radvd, DHCPv6 has
+// no options for that. Only IPv6 addresses of DNS and NTP servers
+// can be advertised.
+func (d Dnsmasq) writeDHCPv6Config(w io.Writer) {
+	if d.DomainName != "" {
+		fmt.Fprintf(w, "dhcp-option=option6:domain-search,%s\n", d.DomainName)
+	}
+	if dnsServers := ipv6AddrList(d.DNSServers); dnsServers != "" {
+		fmt.Fprintf(w, "dhcp-option=option6:dns-server,%s\n", dnsServers)
+	} else if d.Router == nil && len(d.DNSServers) == 0 {
+		// Handle isolated network by making sure we are not a DNS server.
+		fmt.Fprintln(w, "dhcp-option=option6:dns-server")
+	}
+	if ntpServers := ipv6AddrList(d.NTPServers); ntpServers != "" {
+		fmt.Fprintf(w, "dhcp-option=option6:sntp-server,%s\n", ntpServers)
+	}
+	dhcpRange := d.ListenIP
+	if d.DHCPRangeStart != nil {
+		dhcpRange = d.DHCPRangeStart
+	}
+	prefixLen := 64
+	if d.Subnet != nil && d.Subnet.IP != nil {
+		prefixLen, _ = d.Subnet.Mask.Size()
+	}
+	fmt.Fprintf(w, "dhcp-range=%s,static,%d,60m\n", dhcpRange, prefixLen)
+}
+
+// ipv6AddrList returns comma-separated list of IPv6 addresses (in the format
+// expected by option6) from the given list, IPv4 addresses are skipped.
+func ipv6AddrList(ips []net.IP) string {
+	var addrList []string
+	for _, ip := range ips {
+		if ip.To4() == nil {
+			addrList = append(addrList, "["+ip.String()+"]")
+		}
+	}
+	return strings.Join(addrList, ",")
+}
+
+func dnsmasqConfigFile(bridgeIfName string) string {
+	return "dnsmasq." + bridgeIfName + ".conf"
+}
+
+func dnsmasqConfigPath(bridgeIfName string) string {
+	return runDir + "/" + dnsmasqConfigFile(bridgeIfName)
+}
+
+func dnsmasqDHCPHostsDir(bridgeIfName string) string {
+	return runDir + "/dhcp-hosts." + bridgeIfName
+}
+
+func dnsmasqLeasePath(bridgeIfName string) string {
+	return DnsmasqLeaseDir + "/" + bridgeIfName
+}
+
+func dnsmasqPidPath(bridgeIfName string) string {
+	return "/run/dnsmasq." + bridgeIfName + ".pid"
+}
+
+// DnsmasqConfigurator implements Configurator interface (libs/reconciler)
+// for dnsmasq.
+type DnsmasqConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates dnsmasq config file and dhcp-hosts directory and starts the process.
+func (c *DnsmasqConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	dnsmasq := item.(Dnsmasq)
+	// Start clean - stop dnsmasq instance possibly left behind by the previous run.
+	if err := c.stopDnsmasq(dnsmasq.ListenIf, false); err != nil {
+		return err
+	}
+	if err := c.writeConfigFile(dnsmasq); err != nil {
+		return err
+	}
+	hostsDir := dnsmasqDHCPHostsDir(dnsmasq.ListenIf)
+	if err := os.RemoveAll(hostsDir); err != nil {
+		err = fmt.Errorf("failed to remove directory %s: %w", hostsDir, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err := os.MkdirAll(hostsDir, 0755); err != nil {
+		err = fmt.Errorf("failed to create directory %s: %w", hostsDir, err)
+		c.Log.Error(err)
+		return err
+	}
+	for _, host := range dnsmasq.DHCPHosts {
+		if err := c.addDHCPHost(dnsmasq.ListenIf, host); err != nil {
+			return err
+		}
+	}
+	return c.startDnsmasq(dnsmasq.ListenIf)
+}
+
+// Modify updates the set of DHCP hosts if that is the only change, otherwise
+// dnsmasq is restarted with the new config.
+// New DHCP hosts are picked up by dnsmasq automatically (dhcp-hostsdir is watched
+// using inotify), but removal of a host requires restart.
+func (c *DnsmasqConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	oldDnsmasq := oldItem.(Dnsmasq)
+	newDnsmasq := newItem.(Dnsmasq)
+	bridgeIfName := newDnsmasq.ListenIf
+	restart := !oldDnsmasq.equalConfig(newDnsmasq)
+	var obsoleteHosts, newHosts []DnsmasqDHCPHost
+	for _, oldHost := range oldDnsmasq.DHCPHosts {
+		var found bool
+		for _, newHost := range newDnsmasq.DHCPHosts {
+			if oldHost.fileName() == newHost.fileName() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			obsoleteHosts = append(obsoleteHosts, oldHost)
+		}
+	}
+	for _, newHost := range newDnsmasq.DHCPHosts {
+		var found bool
+		for _, oldHost := range oldDnsmasq.DHCPHosts {
+			if oldHost.content() == newHost.content() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			newHosts = append(newHosts, newHost)
+		}
+	}
+	if len(obsoleteHosts) > 0 {
+		restart = true
+	}
+	if restart {
+		if err := c.stopDnsmasq(bridgeIfName, true); err != nil {
+			return err
+		}
+	}
+	for _, host := range obsoleteHosts {
+		hostFile := filepath.Join(dnsmasqDHCPHostsDir(bridgeIfName), host.fileName())
+		if err := os.Remove(hostFile); err != nil && !os.IsNotExist(err) {
+			err = fmt.Errorf("failed to remove DHCP host file %s: %w", hostFile, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	for _, host := range newHosts {
+		if err := c.addDHCPHost(bridgeIfName, host); err != nil {
+			return err
+		}
+	}
+	if restart {
+		if err := c.writeConfigFile(newDnsmasq); err != nil {
+			return err
+		}
+		return c.startDnsmasq(bridgeIfName)
+	}
+	return nil
+}
+
+// Delete stops dnsmasq and removes the config file and DHCP hosts.
+// DHCP leases are preserved.
+func (c *DnsmasqConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	dnsmasq := item.(Dnsmasq)
+	if err := c.stopDnsmasq(dnsmasq.ListenIf, true); err != nil {
+		return err
+	}
+	cfgPath := dnsmasqConfigPath(dnsmasq.ListenIf)
+	if err := os.Remove(cfgPath); err != nil && !os.IsNotExist(err) {
+		err = fmt.Errorf("failed to remove dnsmasq config %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	hostsDir := dnsmasqDHCPHostsDir(dnsmasq.ListenIf)
+	if err := os.RemoveAll(hostsDir); err != nil {
+		err = fmt.Errorf("failed to remove directory %s: %w", hostsDir, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns false - Modify is able to apply any change.
+func (c *DnsmasqConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return false
+}
+
+func (c *DnsmasqConfigurator) writeConfigFile(dnsmasq Dnsmasq) error {
+	var cfg bytes.Buffer
+	dnsmasq.writeConfig(&cfg)
+	cfgPath := dnsmasqConfigPath(dnsmasq.ListenIf)
+	if err := os.WriteFile(cfgPath, cfg.Bytes(), 0644); err != nil {
+		err = fmt.Errorf("failed to write dnsmasq config %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *DnsmasqConfigurator) addDHCPHost(bridgeIfName string, host DnsmasqDHCPHost) error {
+	hostFile := filepath.Join(dnsmasqDHCPHostsDir(bridgeIfName), host.fileName())
+	if err := os.WriteFile(hostFile, []byte(host.content()), 0644); err != nil {
+		err = fmt.Errorf("failed to write DHCP host file %s: %w", hostFile, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Run this:
+//
+//	${DMDIR}/dnsmasq -C /run/zedrouter/dnsmasq.${BRIDGENAME}.conf
+func (c *DnsmasqConfigurator) startDnsmasq(bridgeIfName string) error {
+	if err := os.MkdirAll(DnsmasqLeaseDir, 0755); err != nil {
+		err = fmt.Errorf("failed to create directory %s: %w", DnsmasqLeaseDir, err)
+		c.Log.Error(err)
+		return err
+	}
+	cfgPath := dnsmasqConfigPath(bridgeIfName)
+	out, err := base.Exec(c.Log, "nohup", dnsmasqBinary, "-C", cfgPath).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("failed to start dnsmasq for bridge %s: %w (output: %s)",
+			bridgeIfName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *DnsmasqConfigurator) stopDnsmasq(bridgeIfName string, printOnError bool) error {
+	pidPath := dnsmasqPidPath(bridgeIfName)
+	pidBytes, err := os.ReadFile(pidPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Not running.
+			return nil
+		}
+		err = fmt.Errorf("failed to read dnsmasq pid file %s: %w", pidPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil {
+		c.Log.Warnf("stopDnsmasq: invalid content of pid file %s: %v", pidPath, err)
+		_ = os.Remove(pidPath)
+		return nil
+	}
+	utils.PkillArgs(c.Log, dnsmasqConfigFile(bridgeIfName), printOnError, false)
+	// Wait until the process is gone.
+	startTime := time.Now()
+	for {
+		process, err := os.FindProcess(pid)
+		if err != nil || process.Signal(syscall.Signal(0)) != nil {
+			break
+		}
+		if time.Since(startTime) > dnsmasqStopTimeout {
+			err = fmt.Errorf("dnsmasq for bridge %s (pid %d) did not exit in %v",
+				bridgeIfName, pid, dnsmasqStopTimeout)
+			c.Log.Error(err)
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+	if err = os.Remove(pidPath); err != nil && !os.IsNotExist(err) && printOnError {
+		c.Log.Errorf("stopDnsmasq: failed to remove pid file %s: %v", pidPath, err)
+	}
+	return nil
+}
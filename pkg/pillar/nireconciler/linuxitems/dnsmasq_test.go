@@ -0,0 +1,51 @@
+package linuxitems_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+)
+
+func TestDnsmasqIPv6Config(t *testing.T) {
+	_, subnet, _ := net.ParseCIDR("2001:db8:0:1::/64")
+	dnsmasq := linuxitems.Dnsmasq{
+		ListenIf:       "bn1",
+		ListenIP:       net.ParseIP("2001:db8:0:1::1"),
+		UplinkIf:       "eth0",
+		HostsDir:       "/run/zedrouter/hosts.bn1",
+		Subnet:         subnet,
+		DHCPRangeStart: net.ParseIP("2001:db8:0:1::100"),
+		Router:         net.ParseIP("2001:db8:0:1::1"),
+		DomainName:     "example.com",
+		DNSServers: []net.IP{net.ParseIP("10.0.0.1"),
+			net.ParseIP("2001:4860:4860::8888")},
+		NTPServers: []net.IP{net.ParseIP("2001:db8::123")},
+	}
+	config := dnsmasq.String()
+	for _, expLine := range []string{
+		"dhcp-option=option6:domain-search,example.com",
+		"dhcp-option=option6:dns-server,[2001:4860:4860::8888]",
+		"dhcp-option=option6:sntp-server,[2001:db8::123]",
+		"dhcp-range=2001:db8:0:1::100,static,64,60m",
+	} {
+		if !strings.Contains(config, expLine+"\n") {
+			t.Errorf("missing line %q in config:\n%s", expLine, config)
+		}
+	}
+	for _, unexpected := range []string{"option:netmask", "option:router",
+		"10.0.0.1", "dhcp-range=::"} {
+		if strings.Contains(config, unexpected) {
+			t.Errorf("unexpected %q in config:\n%s", unexpected, config)
+		}
+	}
+
+	// Isolated network without any IPv6 DNS server
+	dnsmasq.Router = nil
+	dnsmasq.DNSServers = nil
+	config = dnsmasq.String()
+	if !strings.Contains(config, "dhcp-option=option6:dns-server\n") {
+		t.Errorf("isolated network should not advertise DNS server:\n%s", config)
+	}
+}
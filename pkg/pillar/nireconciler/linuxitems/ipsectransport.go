@@ -0,0 +1,232 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ipsecAeadAlgo   = "rfc4106(gcm(aes))"
+	ipsecAeadICVLen = 128
+	// AES-256 key followed by 4 bytes of salt.
+	ipsecAeadKeyLen = 36
+)
+
+// IPsecTransport : pair of manually keyed ESP security associations
+// (and the policies selecting traffic for them) protecting UDP traffic
+// sent to the given destination port and exchanged with a single peer.
+// Used to encrypt VXLAN-encapsulated traffic.
+// Keys and SPIs are derived from the pre-shared key and the addresses
+// of both ends, so that both peers arrive at the same SAs without any key
+// exchange. There is no re-keying, the keys change only with the PSK.
+type IPsecTransport struct {
+	// LocalIP : IP address of this node.
+	LocalIP net.IP
+	// PeerIP : IP address of the peer.
+	PeerIP net.IP
+	// DstPort : UDP destination port of the protected traffic.
+	DstPort uint16
+	// PresharedKey : secret shared by all nodes, never printed.
+	PresharedKey string
+	// UplinkIfName : uplink through which the peer is reached.
+	UplinkIfName string
+}
+
+// Name uniquely identifies the SA pair.
+// It is not possible to have two SA pairs with the same traffic selector.
+func (t IPsecTransport) Name() string {
+	return fmt.Sprintf("%s-%s/udp/%d", t.LocalIP, t.PeerIP, t.DstPort)
+}
+
+// Label is more human-readable than name.
+func (t IPsecTransport) Label() string {
+	return fmt.Sprintf("ESP %s <-> %s (udp/%d)", t.LocalIP, t.PeerIP, t.DstPort)
+}
+
+// Type of the item.
+func (t IPsecTransport) Type() string {
+	return IPsecTransportTypename
+}
+
+// Equal is a comparison method for two equally-named IPsecTransport instances.
+func (t IPsecTransport) Equal(other depgraph.Item) bool {
+	t2 := other.(IPsecTransport)
+	return t.PresharedKey == t2.PresharedKey &&
+		t.UplinkIfName == t2.UplinkIfName
+}
+
+// External returns false.
+func (t IPsecTransport) External() bool {
+	return false
+}
+
+// String describes the SA pair. Keys are omitted.
+func (t IPsecTransport) String() string {
+	return fmt.Sprintf("IPsec transport: {localIP: %s, peerIP: %s, dstPort: %d, "+
+		"outSPI: 0x%x, inSPI: 0x%x, uplink: %s}", t.LocalIP, t.PeerIP, t.DstPort,
+		t.OutboundSA().Spi, t.InboundSA().Spi, t.UplinkIfName)
+}
+
+// Dependencies returns the uplink interface as the only dependency.
+func (t IPsecTransport) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: UplinkTypename,
+				ItemName: t.UplinkIfName,
+			},
+			Description: "Uplink must exist",
+		},
+	}
+}
+
+// deriveKey returns HMAC-SHA256 of the PSK over the direction-specific input.
+// Both peers compute the same keys for the given direction.
+func (t IPsecTransport) deriveKey(src, dst net.IP, usage string) []byte {
+	mac := hmac.New(sha256.New, []byte(t.PresharedKey))
+	fmt.Fprintf(mac, "eve-vxlan-esp|%s|%s|%d|%s", src, dst, t.DstPort, usage)
+	return mac.Sum(nil)
+}
+
+// sa returns SA for traffic sent from src to dst.
+func (t IPsecTransport) sa(src, dst net.IP) netlink.XfrmState {
+	key := t.deriveKey(src, dst, "key")
+	extra := t.deriveKey(src, dst, "spi+salt")
+	aeadKey := make([]byte, 0, ipsecAeadKeyLen)
+	aeadKey = append(aeadKey, key...)
+	aeadKey = append(aeadKey, extra[:4]...)
+	// SPIs below 256 are reserved.
+	spi := int(binary.BigEndian.Uint32(extra[4:8])&0x7fffffff | 0x100)
+	return netlink.XfrmState{
+		Src:   src,
+		Dst:   dst,
+		Proto: netlink.XFRM_PROTO_ESP,
+		Mode:  netlink.XFRM_MODE_TRANSPORT,
+		Spi:   spi,
+		Reqid: spi,
+		Aead: &netlink.XfrmStateAlgo{
+			Name:   ipsecAeadAlgo,
+			Key:    aeadKey,
+			ICVLen: ipsecAeadICVLen,
+		},
+		ReplayWindow: 32,
+	}
+}
+
+// OutboundSA returns SA used to encrypt traffic sent to the peer.
+func (t IPsecTransport) OutboundSA() netlink.XfrmState {
+	return t.sa(t.LocalIP, t.PeerIP)
+}
+
+// InboundSA returns SA used to decrypt traffic received from the peer.
+func (t IPsecTransport) InboundSA() netlink.XfrmState {
+	return t.sa(t.PeerIP, t.LocalIP)
+}
+
+func hostIPNet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+// policy returns XFRM policy requiring the given SA for the protected traffic.
+func (t IPsecTransport) policy(sa netlink.XfrmState, dir netlink.Dir) *netlink.XfrmPolicy {
+	return &netlink.XfrmPolicy{
+		Src:     hostIPNet(sa.Src),
+		Dst:     hostIPNet(sa.Dst),
+		Proto:   unix.IPPROTO_UDP,
+		DstPort: int(t.DstPort),
+		Dir:     dir,
+		Tmpls: []netlink.XfrmPolicyTmpl{
+			{
+				Src:   sa.Src,
+				Dst:   sa.Dst,
+				Proto: sa.Proto,
+				Mode:  sa.Mode,
+				Reqid: sa.Reqid,
+			},
+		},
+	}
+}
+
+// IPsecTransportConfigurator implements Configurator interface (libs/reconciler)
+// for IPsec transport-mode SA pairs.
+type IPsecTransportConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create installs both SAs and the policies.
+func (c *IPsecTransportConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	t := item.(IPsecTransport)
+	outSA, inSA := t.OutboundSA(), t.InboundSA()
+	for _, sa := range []netlink.XfrmState{outSA, inSA} {
+		if err := netlink.XfrmStateAdd(&sa); err != nil {
+			err = fmt.Errorf("failed to add SA %s->%s (spi 0x%x): %v",
+				sa.Src, sa.Dst, sa.Spi, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	if err := netlink.XfrmPolicyAdd(t.policy(outSA, netlink.XFRM_DIR_OUT)); err != nil {
+		err = fmt.Errorf("failed to add outbound policy for %s: %v", t.Name(), err)
+		c.Log.Error(err)
+		return err
+	}
+	// Inbound policy makes the kernel drop unencrypted traffic from the peer.
+	if err := netlink.XfrmPolicyAdd(t.policy(inSA, netlink.XFRM_DIR_IN)); err != nil {
+		err = fmt.Errorf("failed to add inbound policy for %s: %v", t.Name(), err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *IPsecTransportConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete removes the policies and both SAs.
+func (c *IPsecTransportConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	t := item.(IPsecTransport)
+	outSA, inSA := t.OutboundSA(), t.InboundSA()
+	var errs []error
+	if err := netlink.XfrmPolicyDel(t.policy(outSA, netlink.XFRM_DIR_OUT)); err != nil &&
+		!errors.Is(err, unix.ENOENT) {
+		errs = append(errs, err)
+	}
+	if err := netlink.XfrmPolicyDel(t.policy(inSA, netlink.XFRM_DIR_IN)); err != nil &&
+		!errors.Is(err, unix.ENOENT) {
+		errs = append(errs, err)
+	}
+	for _, sa := range []netlink.XfrmState{outSA, inSA} {
+		if err := netlink.XfrmStateDel(&sa); err != nil && !errors.Is(err, unix.ESRCH) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		err := fmt.Errorf("failed to remove IPsec transport %s: %v", t.Name(), errs)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *IPsecTransportConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
@@ -0,0 +1,46 @@
+package linuxitems_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+)
+
+func TestIPsecTransportKeys(t *testing.T) {
+	nodeA := net.ParseIP("192.168.10.5")
+	nodeB := net.ParseIP("192.168.20.5")
+	saPairA := linuxitems.IPsecTransport{
+		LocalIP:      nodeA,
+		PeerIP:       nodeB,
+		DstPort:      4789,
+		PresharedKey: "secret",
+	}
+	saPairB := linuxitems.IPsecTransport{
+		LocalIP:      nodeB,
+		PeerIP:       nodeA,
+		DstPort:      4789,
+		PresharedKey: "secret",
+	}
+	outA, inA := saPairA.OutboundSA(), saPairA.InboundSA()
+	outB, inB := saPairB.OutboundSA(), saPairB.InboundSA()
+	// Both nodes must derive the same SA for each direction.
+	if outA.Spi != inB.Spi || string(outA.Aead.Key) != string(inB.Aead.Key) {
+		t.Errorf("outbound SA of node A does not match inbound SA of node B")
+	}
+	if inA.Spi != outB.Spi || string(inA.Aead.Key) != string(outB.Aead.Key) {
+		t.Errorf("inbound SA of node A does not match outbound SA of node B")
+	}
+	// ... but each direction is keyed separately.
+	if outA.Spi == inA.Spi || string(outA.Aead.Key) == string(inA.Aead.Key) {
+		t.Errorf("both directions use the same SPI or key")
+	}
+	if outA.Spi < 0x100 {
+		t.Errorf("reserved SPI 0x%x", outA.Spi)
+	}
+	// Different PSK results in different keys.
+	saPairA.PresharedKey = "other-secret"
+	if string(saPairA.OutboundSA().Aead.Key) == string(outA.Aead.Key) {
+		t.Errorf("key does not depend on the PSK")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	dpclinux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+)
+
+// IptablesChainConfigurator implements Configurator interface (libs/reconciler)
+// for iptables chains used by network instances.
+// Chains are represented by the same item as used by DPC reconciler
+// (dpcreconciler/linuxitems.IptablesChain), but the configurator differs in how
+// it modifies chain content: instead of flushing the chain and re-adding all rules,
+// only obsolete rules are removed and new rules are inserted at their positions.
+// This avoids a (short) period with no rules applied and it preserves counters
+// of unchanged rules, which are used to report ACL statistics.
+// Rules not managed by the reconciler (e.g. inserted by the strongSwan VPN code
+// at the top of the chain) are left untouched; managed rules are expected to be
+// at the bottom of the chain.
+type IptablesChainConfigurator struct {
+	Log *base.LogObject
+	// DPCConfigurator is used to create and delete chains.
+	DPCConfigurator *dpclinux.IptablesChainConfigurator
+}
+
+// Create creates and populates ip(6)tables chain.
+func (c *IptablesChainConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	return c.DPCConfigurator.Create(ctx, item)
+}
+
+// Modify updates chain content incrementally.
+func (c *IptablesChainConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	oldChain := oldItem.(dpclinux.IptablesChain)
+	newChain := newItem.(dpclinux.IptablesChain)
+	keepOld, keepNew := commonRules(oldChain.Rules, newChain.Rules)
+	offset, err := c.foreignRules(oldChain)
+	if err != nil {
+		return err
+	}
+	// Remove obsolete rules first.
+	// Go backwards to keep rule positions valid.
+	for i := len(oldChain.Rules) - 1; i >= 0; i-- {
+		if keepOld[i] {
+			continue
+		}
+		args := []string{"-D", oldChain.ChainName, strconv.Itoa(offset + i + 1), "-t", table(oldChain)}
+		if err := c.execCmd(oldChain, args); err != nil {
+			return err
+		}
+	}
+	// Chain now contains only rules common to both the old and the new content,
+	// in the same relative order as in the new content. Insert new rules at their
+	// positions.
+	for i, rule := range newChain.Rules {
+		if keepNew[i] {
+			continue
+		}
+		args := []string{"-I", newChain.ChainName, strconv.Itoa(offset + i + 1), "-t", table(newChain)}
+		args = append(args, rule.Args...)
+		if err := c.execCmd(newChain, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete flushes the chain content and removes it unless it is a pre-created chain.
+func (c *IptablesChainConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	return c.DPCConfigurator.Delete(ctx, item)
+}
+
+// NeedsRecreate returns false - configurator is able to modify the chain content.
+func (c *IptablesChainConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return false
+}
+
+// foreignRules returns the number of rules at the top of the chain
+// which are not managed by the reconciler.
+func (c *IptablesChainConfigurator) foreignRules(chain dpclinux.IptablesChain) (int, error) {
+	out, err := c.execCmdOut(chain, []string{"-S", chain.ChainName, "-t", table(chain)})
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "-A ") {
+			count++
+		}
+	}
+	if count < len(chain.Rules) {
+		return 0, nil
+	}
+	return count - len(chain.Rules), nil
+}
+
+func (c *IptablesChainConfigurator) execCmd(chain dpclinux.IptablesChain, args []string) error {
+	_, err := c.execCmdOut(chain, args)
+	return err
+}
+
+func (c *IptablesChainConfigurator) execCmdOut(chain dpclinux.IptablesChain,
+	args []string) (string, error) {
+	cmd := "iptables"
+	if chain.ForIPv6 {
+		cmd = "ip6tables"
+	}
+	out, err := base.Exec(c.Log, cmd, args...).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("%s %v failed: %v, output: %s", cmd, args, err, out)
+		c.Log.Error(err)
+		return "", err
+	}
+	return string(out), nil
+}
+
+// commonRules finds the longest common subsequence of the old and the new
+// list of rules. Returned slices mark rules which are part of the subsequence.
+func commonRules(oldRules, newRules []dpclinux.IptablesRule) (keepOld, keepNew []bool) {
+	n, m := len(oldRules), len(newRules)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if reflect.DeepEqual(oldRules[i].Args, newRules[j].Args) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	keepOld = make([]bool, n)
+	keepNew = make([]bool, m)
+	for i, j := 0, 0; i < n && j < m; {
+		if reflect.DeepEqual(oldRules[i].Args, newRules[j].Args) {
+			keepOld[i] = true
+			keepNew[j] = true
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return keepOld, keepNew
+}
+
+func table(chain dpclinux.IptablesChain) string {
+	if chain.Table == "" {
+		return "filter"
+	}
+	return chain.Table
+}
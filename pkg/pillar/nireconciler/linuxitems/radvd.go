@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+// Radvd : router advertisement daemon running for an IPv6 network instance.
+type Radvd struct {
+	// ListenIf : bridge interface on which radvd sends advertisements.
+	ListenIf string
+	// Prefix : on-link /64 prefix advertised for SLAAC.
+	// Nil if only the default router (and DHCPv6) is advertised.
+	Prefix *net.IPNet
+	// DNSServers : recursive DNS servers advertised with RDNSS.
+	DNSServers []net.IP
+	// DomainName : DNS search domain advertised with DNSSL.
+	DomainName string
+}
+
+// Name returns the bridge interface name - there is at most one radvd
+// instance per bridge.
+func (r Radvd) Name() string {
+	return r.ListenIf
+}
+
+// Label is more human-readable than name.
+func (r Radvd) Label() string {
+	return "radvd for " + r.ListenIf
+}
+
+// Type of the item.
+func (r Radvd) Type() string {
+	return RadvdTypename
+}
+
+// Equal is a comparison method for two equally-named radvd instances.
+func (r Radvd) Equal(other depgraph.Item) bool {
+	var cfg1, cfg2 bytes.Buffer
+	r.writeConfig(&cfg1)
+	other.(Radvd).writeConfig(&cfg2)
+	return bytes.Equal(cfg1.Bytes(), cfg2.Bytes())
+}
+
+// writeConfig renders the radvd configuration file.
+// Managed flag tells apps to ask DHCPv6 for an address (in addition
+// to SLAAC when the prefix is advertised), other-config flag for the DNS
+// and NTP servers. Route fd00::/8 makes the meta-data server reachable
+// even if the app prefers another default router.
+func (r Radvd) writeConfig(w io.Writer) {
+	fmt.Fprintln(w, "# Automatically generated by zedrouter")
+	fmt.Fprintln(w, "# Low preference to allow underlay to have high preference default")
+	fmt.Fprintf(w, "interface %s {\n", r.ListenIf)
+	fmt.Fprintln(w, "\tIgnoreIfMissing on;")
+	fmt.Fprintln(w, "\tAdvSendAdvert on;")
+	fmt.Fprintln(w, "\tMaxRtrAdvInterval 1800;")
+	fmt.Fprintln(w, "\tAdvManagedFlag on;")
+	fmt.Fprintln(w, "\tAdvOtherConfigFlag on;")
+	fmt.Fprintln(w, "\tAdvLinkMTU 1280;")
+	fmt.Fprintln(w, "\tAdvDefaultPreference low;")
+	if r.Prefix != nil && r.Prefix.IP != nil {
+		fmt.Fprintf(w, "\tprefix %s\n\t{\n", r.Prefix.String())
+		fmt.Fprintln(w, "\t\tAdvOnLink on;")
+		fmt.Fprintln(w, "\t\tAdvAutonomous on;")
+		fmt.Fprintln(w, "\t};")
+	}
+	fmt.Fprintln(w, "\troute fd00::/8\n\t{")
+	fmt.Fprintln(w, "\t\tAdvRoutePreference high;")
+	fmt.Fprintln(w, "\t\tAdvRouteLifetime 1800;")
+	fmt.Fprintln(w, "\t};")
+	if len(r.DNSServers) > 0 {
+		var addrList []string
+		for _, srvIP := range r.DNSServers {
+			addrList = append(addrList, srvIP.String())
+		}
+		fmt.Fprintf(w, "\tRDNSS %s\n\t{\n\t};\n", strings.Join(addrList, " "))
+	}
+	if r.DomainName != "" {
+		fmt.Fprintf(w, "\tDNSSL %s\n\t{\n\t};\n", r.DomainName)
+	}
+	fmt.Fprintln(w, "};")
+}
+
+// External returns false.
+func (r Radvd) External() bool {
+	return false
+}
+
+// String describes the radvd instance.
+func (r Radvd) String() string {
+	var cfg bytes.Buffer
+	r.writeConfig(&cfg)
+	return fmt.Sprintf("Radvd for bridge %s with config:\n%s", r.ListenIf, cfg.String())
+}
+
+// Dependencies returns the bridge as the only dependency.
+func (r Radvd) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: r.ListenIf,
+			},
+			Description: "Bridge must exist",
+		},
+	}
+}
+
+func radvdConfigFile(bridgeIfName string) string {
+	return "radvd." + bridgeIfName + ".conf"
+}
+
+func radvdConfigPath(bridgeIfName string) string {
+	return runDir + "/" + radvdConfigFile(bridgeIfName)
+}
+
+func radvdPidPath(bridgeIfName string) string {
+	return "/run/radvd." + bridgeIfName + ".pid"
+}
+
+// RadvdConfigurator implements Configurator interface (libs/reconciler)
+// for radvd.
+type RadvdConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates radvd config file and starts the daemon.
+func (c *RadvdConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	radvd := item.(Radvd)
+	// Kill any instance left behind by the previous run.
+	utils.PkillArgs(c.Log, radvdConfigFile(radvd.ListenIf), false, false)
+	cfgPath := radvdConfigPath(radvd.ListenIf)
+	var cfg bytes.Buffer
+	radvd.writeConfig(&cfg)
+	if err := os.WriteFile(cfgPath, cfg.Bytes(), 0644); err != nil {
+		err = fmt.Errorf("failed to write radvd config %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	// radvd -u radvd -C /run/zedrouter/radvd.${BRIDGE}.conf -p /run/radvd.${BRIDGE}.pid
+	cmd := exec.Command("nohup", "radvd", "-u", "radvd", "-C", cfgPath,
+		"-p", radvdPidPath(radvd.ListenIf))
+	if err := cmd.Start(); err != nil {
+		err = fmt.Errorf("failed to start radvd for bridge %s: %w",
+			radvd.ListenIf, err)
+		c.Log.Error(err)
+		return err
+	}
+	go func() {
+		_ = cmd.Wait()
+	}()
+	return nil
+}
+
+// Modify is not implemented.
+func (c *RadvdConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete stops radvd and removes the config file.
+func (c *RadvdConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	radvd := item.(Radvd)
+	utils.PkillArgs(c.Log, radvdConfigFile(radvd.ListenIf), true, false)
+	cfgPath := radvdConfigPath(radvd.ListenIf)
+	if err := os.Remove(cfgPath); err != nil && !os.IsNotExist(err) {
+		err = fmt.Errorf("failed to remove radvd config %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *RadvdConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
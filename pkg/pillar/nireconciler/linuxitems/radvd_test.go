@@ -0,0 +1,51 @@
+package linuxitems_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+)
+
+func TestRadvdConfig(t *testing.T) {
+	_, prefix, _ := net.ParseCIDR("2001:db8:0:1::/64")
+	radvd := linuxitems.Radvd{
+		ListenIf:   "bn1",
+		Prefix:     prefix,
+		DNSServers: []net.IP{net.ParseIP("2001:db8:0:1::1")},
+		DomainName: "example.com",
+	}
+	config := radvd.String()
+	for _, expLine := range []string{
+		"interface bn1 {",
+		"\tAdvManagedFlag on;",
+		"\tAdvOtherConfigFlag on;",
+		"\tprefix 2001:db8:0:1::/64",
+		"\t\tAdvAutonomous on;",
+		"\troute fd00::/8",
+		"\tRDNSS 2001:db8:0:1::1",
+		"\tDNSSL example.com",
+	} {
+		if !strings.Contains(config, expLine+"\n") {
+			t.Errorf("missing line %q in config:\n%s", expLine, config)
+		}
+	}
+
+	// Changed prefix must be detected.
+	radvd2 := radvd
+	_, radvd2.Prefix, _ = net.ParseCIDR("2001:db8:0:2::/64")
+	if radvd.Equal(radvd2) {
+		t.Errorf("radvd with different prefixes reported as equal")
+	}
+	radvd2.Prefix = prefix
+	if !radvd.Equal(radvd2) {
+		t.Errorf("radvd with equal config reported as different")
+	}
+
+	// Without prefix only the router is advertised.
+	radvd.Prefix = nil
+	if config = radvd.String(); strings.Contains(config, "prefix") {
+		t.Errorf("unexpected prefix in config:\n%s", config)
+	}
+}
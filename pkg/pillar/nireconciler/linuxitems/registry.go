@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	dpclinux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+)
+
+// RegisterItems : register all configurators implemented by this package.
+func RegisterItems(log *base.LogObject, registry *reconciler.DefaultRegistry,
+	monitor netmonitor.NetworkMonitor) error {
+	type configurator struct {
+		c reconciler.Configurator
+		t string
+	}
+	dpcIptablesConfigurator := &dpclinux.IptablesChainConfigurator{Log: log}
+	iptablesConfigurator := &IptablesChainConfigurator{
+		Log:             log,
+		DPCConfigurator: dpcIptablesConfigurator,
+	}
+	configurators := []configurator{
+		{c: &BridgeConfigurator{Log: log, NetworkMonitor: monitor}, t: BridgeTypename},
+		{c: &BridgePortConfigurator{Log: log, NetworkMonitor: monitor}, t: BridgePortTypename},
+		{c: &DnsmasqConfigurator{Log: log}, t: DnsmasqTypename},
+		{c: &IPsecTransportConfigurator{Log: log}, t: IPsecTransportTypename},
+		{c: &RadvdConfigurator{Log: log}, t: RadvdTypename},
+		{c: &RouteConfigurator{Log: log, NetworkMonitor: monitor}, t: RouteTypename},
+		{c: &TrafficShaperConfigurator{Log: log, NetworkMonitor: monitor}, t: TrafficShaperTypename},
+		{c: &VxlanConfigurator{Log: log, NetworkMonitor: monitor}, t: VxlanTypename},
+		{c: &WireGuardConfigurator{Log: log, NetworkMonitor: monitor}, t: WireGuardTypename},
+		{c: iptablesConfigurator, t: dpclinux.IPtablesChainTypename},
+		{c: iptablesConfigurator, t: dpclinux.IP6tablesChainTypename},
+	}
+	for _, configurator := range configurators {
+		err := registry.Register(configurator.c, configurator.t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Route : IP route installed into the routing table of a network instance.
+type Route struct {
+	netlink.Route
+	// OutputIf : name of the output interface.
+	// Should match with Route.LinkIndex. Empty for unreachable routes.
+	OutputIf string
+	// OutputIfType : type of the item representing the output interface,
+	// either UplinkTypename, BridgeTypename or WireGuardTypename.
+	OutputIfType string
+}
+
+// Name combines the route table ID, the output interface name and the destination
+// address to construct a unique route identifier.
+func (r Route) Name() string {
+	var dst string
+	if r.Route.Dst == nil {
+		dst = "default"
+	} else {
+		dst = r.Route.Dst.String()
+	}
+	outputIf := r.OutputIf
+	if r.Route.Type == unix.RTN_UNREACHABLE {
+		outputIf = "unreachable"
+	}
+	return fmt.Sprintf("%d/%s/%s", r.Table, outputIf, dst)
+}
+
+// Label is more human-readable than name.
+func (r Route) Label() string {
+	var dst string
+	if r.Route.Dst == nil {
+		dst = "<default>"
+	} else {
+		dst = r.Route.Dst.String()
+	}
+	if r.Route.Type == unix.RTN_UNREACHABLE {
+		return fmt.Sprintf("IP route table %d unreachable %s", r.Table, dst)
+	}
+	return fmt.Sprintf("IP route table %d dst %s dev %v via %v",
+		r.Table, dst, r.OutputIf, r.Gw)
+}
+
+// Type of the item.
+func (r Route) Type() string {
+	return RouteTypename
+}
+
+// Equal is a comparison method for two equally-named route instances.
+func (r Route) Equal(other depgraph.Item) bool {
+	r2 := other.(Route)
+	return r.Route.Equal(r2.Route)
+}
+
+// External returns false.
+func (r Route) External() bool {
+	return false
+}
+
+// String describes the network route.
+func (r Route) String() string {
+	return fmt.Sprintf("Network instance route for output interface %s: %+v",
+		r.OutputIf, r.Route)
+}
+
+// Dependencies of a route: the output interface must exist.
+func (r Route) Dependencies() (deps []depgraph.Dependency) {
+	if r.OutputIf == "" {
+		return nil
+	}
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: r.OutputIfType,
+				ItemName: r.OutputIf,
+			},
+			Attributes: depgraph.DependencyAttributes{
+				// Linux automatically removes route when the interface disappears.
+				AutoDeletedByExternal: r.OutputIfType == UplinkTypename,
+			},
+			Description: "The output interface must exist",
+		},
+	}
+}
+
+// RouteConfigurator implements Configurator interface (libs/reconciler)
+// for routes of network instances.
+type RouteConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create adds the route.
+func (c *RouteConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	route := item.(Route)
+	if err := c.resolveLinkIndex(&route); err != nil {
+		return err
+	}
+	err := netlink.RouteAdd(&route.Route)
+	if err != nil && errors.Is(err, syscall.EEXIST) {
+		// Ignore duplicate route.
+		return nil
+	}
+	return err
+}
+
+// Modify is not implemented.
+func (c *RouteConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes the route.
+func (c *RouteConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	route := item.(Route)
+	if err := c.resolveLinkIndex(&route); err != nil {
+		return err
+	}
+	err := netlink.RouteDel(&route.Route)
+	if err != nil && errors.Is(err, syscall.ESRCH) {
+		// Route is already gone.
+		return nil
+	}
+	return err
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *RouteConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
+
+// Interface index may have changed since the intended state was built
+// (e.g. the bridge was re-created).
+func (c *RouteConfigurator) resolveLinkIndex(route *Route) error {
+	if route.OutputIf == "" {
+		return nil
+	}
+	ifIndex, found, err := c.NetworkMonitor.GetInterfaceIndex(route.OutputIf)
+	if err != nil {
+		err = fmt.Errorf("failed to get index of interface %s: %w",
+			route.OutputIf, err)
+		c.Log.Error(err)
+		return err
+	}
+	if !found {
+		err = fmt.Errorf("output interface %s does not exist", route.OutputIf)
+		c.Log.Error(err)
+		return err
+	}
+	route.LinkIndex = ifIndex
+	return nil
+}
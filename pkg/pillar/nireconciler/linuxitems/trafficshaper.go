@@ -0,0 +1,469 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// TrafficShaper : bit-rate shaping of the traffic of an application VIF.
+// Traffic sent to the application leaves the host through the VIF and it is shaped
+// by the HTB qdisc installed on the VIF. Traffic sent by the application enters
+// the host through the VIF, where tc cannot queue it, therefore it is redirected
+// to an IFB device and shaped by the HTB qdisc of the IFB.
+// Every traffic class is a HTB class with fq_codel qdisc as the leaf, packets are
+// classified with u32 filters.
+type TrafficShaper struct {
+	// VIFIfName : name of the shaped VIF on the host side.
+	VIFIfName string
+	// IFBIfName : name of the IFB device created to shape the egress traffic.
+	IFBIfName string
+	// NetAdapterName : logical name of the application network adapter.
+	NetAdapterName string
+	// AppDisplayName : name of the application (for logging).
+	AppDisplayName string
+	// Egress : shaping of the traffic sent by the application.
+	Egress types.TrafficShaper
+	// Ingress : shaping of the traffic received by the application.
+	Ingress types.TrafficShaper
+}
+
+// Name uses the VIF name to identify the item.
+func (s TrafficShaper) Name() string {
+	return s.VIFIfName
+}
+
+// Label is more human-readable than name.
+func (s TrafficShaper) Label() string {
+	return fmt.Sprintf("%s/%s (traffic shaper)", s.AppDisplayName, s.NetAdapterName)
+}
+
+// Type of the item.
+func (s TrafficShaper) Type() string {
+	return TrafficShaperTypename
+}
+
+// Equal is a comparison method for two equally-named TrafficShaper instances.
+func (s TrafficShaper) Equal(other depgraph.Item) bool {
+	s2 := other.(TrafficShaper)
+	return s.IFBIfName == s2.IFBIfName &&
+		reflect.DeepEqual(s.Egress, s2.Egress) &&
+		reflect.DeepEqual(s.Ingress, s2.Ingress)
+}
+
+// External returns false.
+func (s TrafficShaper) External() bool {
+	return false
+}
+
+// String describes the traffic shaper.
+func (s TrafficShaper) String() string {
+	return fmt.Sprintf("Traffic Shaper: {vif: %s, ifb: %s, netAdapter: %s, app: %s, "+
+		"egress: %+v, ingress: %+v}", s.VIFIfName, s.IFBIfName, s.NetAdapterName,
+		s.AppDisplayName, s.Egress, s.Ingress)
+}
+
+// Dependencies returns the VIF as the only dependency.
+func (s TrafficShaper) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: VIFTypename,
+				ItemName: s.VIFIfName,
+			},
+			Description: "VIF must exist",
+		},
+	}
+}
+
+// HTB classes created for every shaped direction.
+// The root class has the rate of the shaper and it is the parent of the default
+// class and of the configured traffic classes, which borrow from it.
+const (
+	htbHandleMajor       = 1
+	htbRootClassMinor    = 1
+	htbDefaultClassMinor = 2
+	// Minor of the class of the first configured traffic class.
+	htbFirstClassMinor = 0x10
+	// Priority of the default class.
+	htbDefaultClassPrio = 7
+	// u32 filters matching IPv4 and IPv6 are installed with different
+	// preferences, kernel requires the same protocol for the same preference.
+	filterPrefIPv4 = 1
+	filterPrefIPv6 = 2
+)
+
+// TrafficClassNames returns names of the traffic classes of the shaper,
+// including the default class, indexed by the handle of the HTB class.
+func TrafficClassNames(shaper types.TrafficShaper) map[uint32]string {
+	names := make(map[uint32]string)
+	if !shaper.IsSet() {
+		return names
+	}
+	names[netlink.MakeHandle(htbHandleMajor, htbDefaultClassMinor)] =
+		types.DefaultTrafficClassName
+	for i, class := range shaper.Classes {
+		names[netlink.MakeHandle(htbHandleMajor, uint16(htbFirstClassMinor+i))] = class.Name
+	}
+	return names
+}
+
+// TrafficShaperConfigurator implements Configurator interface (libs/reconciler)
+// for traffic shapers.
+type TrafficShaperConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create installs qdiscs, classes and filters shaping the traffic of the VIF.
+// If any step fails, everything installed so far is removed, otherwise
+// the next attempt would fail on the already existing objects.
+func (c *TrafficShaperConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	shaper := item.(TrafficShaper)
+	err := c.installShaper(shaper)
+	if err != nil {
+		c.Log.Error(err)
+		if cleanupErr := c.removeShaper(shaper); cleanupErr != nil {
+			c.Log.Errorf("failed to clean up after failed creation of %s: %v",
+				shaper.Label(), cleanupErr)
+		}
+	}
+	return err
+}
+
+func (c *TrafficShaperConfigurator) installShaper(shaper TrafficShaper) error {
+	vifLink, err := netlink.LinkByName(shaper.VIFIfName)
+	if err != nil {
+		return fmt.Errorf("netlink.LinkByName(%s) failed: %v", shaper.VIFIfName, err)
+	}
+	if shaper.Ingress.IsSet() {
+		// Traffic to the application: the remote endpoint is the source.
+		if err = c.installHTB(vifLink, shaper.Ingress, true); err != nil {
+			return err
+		}
+	}
+	if shaper.Egress.IsSet() {
+		ifbLink, err := c.createIFB(shaper.IFBIfName)
+		if err != nil {
+			return err
+		}
+		// Traffic from the application: the remote endpoint is the destination.
+		if err = c.installHTB(ifbLink, shaper.Egress, false); err != nil {
+			return err
+		}
+		if err = c.redirectToIFB(vifLink, ifbLink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *TrafficShaperConfigurator) createIFB(ifName string) (netlink.Link, error) {
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = ifName
+	attrs.TxQLen = 1000
+	ifb := &netlink.Ifb{LinkAttrs: attrs}
+	if err := netlink.LinkAdd(ifb); err != nil {
+		return nil, fmt.Errorf("netlink.LinkAdd(%s) failed: %v", ifName, err)
+	}
+	ifbLink, err := netlink.LinkByName(ifName)
+	if err != nil {
+		return nil, fmt.Errorf("netlink.LinkByName(%s) failed: %v", ifName, err)
+	}
+	if err = netlink.LinkSetUp(ifbLink); err != nil {
+		return nil, fmt.Errorf("netlink.LinkSetUp(%s) failed: %v", ifName, err)
+	}
+	return ifbLink, nil
+}
+
+// redirectToIFB redirects all traffic entering the host through the VIF
+// to the IFB device.
+func (c *TrafficShaperConfigurator) redirectToIFB(vifLink, ifbLink netlink.Link) error {
+	vifName := vifLink.Attrs().Name
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: vifLink.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscAdd(ingress); err != nil {
+		return fmt.Errorf("netlink.QdiscAdd(ingress, %s) failed: %v", vifName, err)
+	}
+	// u32 filter without selector matches all packets.
+	redirect := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: vifLink.Attrs().Index,
+			Parent:    ingress.Handle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{netlink.NewMirredAction(ifbLink.Attrs().Index)},
+	}
+	if err := netlink.FilterAdd(redirect); err != nil {
+		return fmt.Errorf("netlink.FilterAdd(redirect %s -> %s) failed: %v",
+			vifName, ifbLink.Attrs().Name, err)
+	}
+	return nil
+}
+
+// installHTB installs HTB qdisc with classes of the shaper as the root qdisc
+// of the link.
+func (c *TrafficShaperConfigurator) installHTB(link netlink.Link,
+	shaper types.TrafficShaper, remoteIsSrc bool) error {
+	ifName := link.Attrs().Name
+	ifIndex := link.Attrs().Index
+	rootHandle := netlink.MakeHandle(htbHandleMajor, 0)
+	htb := netlink.NewHtb(netlink.QdiscAttrs{
+		LinkIndex: ifIndex,
+		Handle:    rootHandle,
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	htb.Defcls = htbDefaultClassMinor
+	if err := netlink.QdiscReplace(htb); err != nil {
+		return fmt.Errorf("netlink.QdiscReplace(htb, %s) failed: %v", ifName, err)
+	}
+	burst := shaper.Burst()
+	rootClass := netlink.MakeHandle(htbHandleMajor, htbRootClassMinor)
+	err := c.addHTBClass(ifIndex, rootHandle, rootClass, netlink.HtbClassAttrs{
+		Rate:    shaper.RateBps,
+		Ceil:    shaper.RateBps,
+		Buffer:  burst,
+		Cbuffer: burst,
+	}, false)
+	if err != nil {
+		return fmt.Errorf("failed to add root HTB class to %s: %v", ifName, err)
+	}
+	// The default class gets the rate not guaranteed to the configured
+	// classes, but at least 1% of the rate.
+	var guaranteed uint64
+	for _, class := range shaper.Classes {
+		guaranteed += class.RateBps
+	}
+	defaultRate := shaper.RateBps / 100
+	if guaranteed < shaper.RateBps && shaper.RateBps-guaranteed > defaultRate {
+		defaultRate = shaper.RateBps - guaranteed
+	}
+	err = c.addHTBClass(ifIndex, rootClass,
+		netlink.MakeHandle(htbHandleMajor, htbDefaultClassMinor), netlink.HtbClassAttrs{
+			Rate: defaultRate,
+			Ceil: shaper.RateBps,
+			Prio: htbDefaultClassPrio,
+		}, true)
+	if err != nil {
+		return fmt.Errorf("failed to add default HTB class to %s: %v", ifName, err)
+	}
+	for i, class := range shaper.Classes {
+		classHandle := netlink.MakeHandle(htbHandleMajor, uint16(htbFirstClassMinor+i))
+		ceil := class.CeilBps
+		if ceil == 0 || ceil > shaper.RateBps {
+			ceil = shaper.RateBps
+		}
+		err = c.addHTBClass(ifIndex, rootClass, classHandle, netlink.HtbClassAttrs{
+			Rate: class.RateBps,
+			Ceil: ceil,
+			Prio: class.Priority,
+		}, true)
+		if err != nil {
+			return fmt.Errorf("failed to add HTB class %s to %s: %v",
+				class.Name, ifName, err)
+		}
+		for _, filter := range classFilters(ifIndex, rootHandle, classHandle,
+			class, remoteIsSrc) {
+			if err = netlink.FilterAdd(filter); err != nil {
+				return fmt.Errorf("netlink.FilterAdd(%s, class %s) failed: %v",
+					ifName, class.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// addHTBClass adds HTB class, optionally with fq_codel as the leaf qdisc.
+func (c *TrafficShaperConfigurator) addHTBClass(ifIndex int, parent, handle uint32,
+	attrs netlink.HtbClassAttrs, withLeaf bool) error {
+	class := netlink.NewHtbClass(netlink.ClassAttrs{
+		LinkIndex: ifIndex,
+		Parent:    parent,
+		Handle:    handle,
+	}, attrs)
+	if err := netlink.ClassAdd(class); err != nil {
+		return fmt.Errorf("netlink.ClassAdd failed: %v", err)
+	}
+	if !withLeaf {
+		return nil
+	}
+	_, minor := netlink.MajorMinor(handle)
+	leaf := netlink.NewFqCodel(netlink.QdiscAttrs{
+		LinkIndex: ifIndex,
+		Parent:    handle,
+		Handle:    netlink.MakeHandle(minor, 0),
+	})
+	if err := netlink.QdiscAdd(leaf); err != nil {
+		return fmt.Errorf("netlink.QdiscAdd(fq_codel) failed: %v", err)
+	}
+	return nil
+}
+
+// Offsets and masks of the header fields matched by u32 filters.
+// Values are in the host order of the 32-bit words of the headers.
+const (
+	ipv4IHLMask      = 0x0f000000
+	ipv4IHL5         = 0x05000000 // Ports are matched only without IPv4 options.
+	ipv4DSCPMask     = 0x00fc0000
+	ipv4DSCPShift    = 18
+	ipv4ProtoOff     = 8
+	ipv4ProtoMask    = 0x00ff0000
+	ipv4ProtoShift   = 16
+	ipv4PortsOff     = 20
+	ipv6DSCPMask     = 0x0fc00000
+	ipv6DSCPShift    = 22
+	ipv6NextHdrOff   = 4
+	ipv6NextHdrMask  = 0x0000ff00
+	ipv6NextHdrShift = 8
+	ipv6PortsOff     = 40 // Ports are matched only without extension headers.
+	srcPortMask      = 0xffff0000
+	srcPortShift     = 16
+	dstPortMask      = 0x0000ffff
+)
+
+// classFilters returns u32 filters classifying packets into the traffic class.
+func classFilters(ifIndex int, qdisc, classHandle uint32, class types.TrafficClass,
+	remoteIsSrc bool) (filters []*netlink.U32) {
+	newFilter := func(ipv6 bool, keys ...netlink.TcU32Key) *netlink.U32 {
+		attrs := netlink.FilterAttrs{
+			LinkIndex: ifIndex,
+			Parent:    qdisc,
+			Priority:  filterPrefIPv4,
+			Protocol:  unix.ETH_P_IP,
+		}
+		if ipv6 {
+			attrs.Priority = filterPrefIPv6
+			attrs.Protocol = unix.ETH_P_IPV6
+		}
+		return &netlink.U32{
+			FilterAttrs: attrs,
+			ClassId:     classHandle,
+			Sel: &netlink.TcU32Sel{
+				Flags: nl.TC_U32_TERMINAL,
+				Keys:  keys,
+			},
+		}
+	}
+	for _, dscp := range class.DSCP {
+		filters = append(filters,
+			newFilter(false, netlink.TcU32Key{
+				Mask: ipv4DSCPMask,
+				Val:  uint32(dscp) << ipv4DSCPShift,
+			}),
+			newFilter(true, netlink.TcU32Key{
+				Mask: ipv6DSCPMask,
+				Val:  uint32(dscp) << ipv6DSCPShift,
+			}))
+	}
+	for _, port := range class.RemotePorts {
+		portKey := netlink.TcU32Key{Mask: dstPortMask, Val: uint32(port)}
+		if remoteIsSrc {
+			portKey = netlink.TcU32Key{Mask: srcPortMask, Val: uint32(port) << srcPortShift}
+		}
+		for _, proto := range []uint32{unix.IPPROTO_TCP, unix.IPPROTO_UDP} {
+			ipv4PortKey := portKey
+			ipv4PortKey.Off = ipv4PortsOff
+			ipv6PortKey := portKey
+			ipv6PortKey.Off = ipv6PortsOff
+			filters = append(filters,
+				newFilter(false,
+					netlink.TcU32Key{Mask: ipv4IHLMask, Val: ipv4IHL5},
+					netlink.TcU32Key{Mask: ipv4ProtoMask, Val: proto << ipv4ProtoShift,
+						Off: ipv4ProtoOff},
+					ipv4PortKey),
+				newFilter(true,
+					netlink.TcU32Key{Mask: ipv6NextHdrMask, Val: proto << ipv6NextHdrShift,
+						Off: ipv6NextHdrOff},
+					ipv6PortKey))
+		}
+	}
+	return filters
+}
+
+// Modify is not implemented.
+func (c *TrafficShaperConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete removes qdiscs from the VIF and the IFB device.
+// Classes and filters are removed together with the qdiscs.
+func (c *TrafficShaperConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	err := c.removeShaper(item.(TrafficShaper))
+	if err != nil {
+		c.Log.Error(err)
+	}
+	return err
+}
+
+// removeShaper removes the IFB device and qdiscs installed for the shaper.
+// Objects which do not exist are skipped.
+func (c *TrafficShaperConfigurator) removeShaper(shaper TrafficShaper) error {
+	if shaper.Egress.IsSet() {
+		ifbLink, err := netlink.LinkByName(shaper.IFBIfName)
+		if err == nil {
+			if err = netlink.LinkDel(ifbLink); err != nil {
+				return fmt.Errorf("netlink.LinkDel(%s) failed: %v", shaper.IFBIfName, err)
+			}
+		} else if _, notFound := err.(netlink.LinkNotFoundError); !notFound {
+			return fmt.Errorf("netlink.LinkByName(%s) failed: %v", shaper.IFBIfName, err)
+		}
+	}
+	vifLink, err := netlink.LinkByName(shaper.VIFIfName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+			// Qdiscs were removed together with the VIF.
+			return nil
+		}
+		return fmt.Errorf("netlink.LinkByName(%s) failed: %v", shaper.VIFIfName, err)
+	}
+	var qdiscs []netlink.Qdisc
+	if shaper.Ingress.IsSet() {
+		qdiscs = append(qdiscs, &netlink.Htb{
+			QdiscAttrs: netlink.QdiscAttrs{
+				LinkIndex: vifLink.Attrs().Index,
+				Handle:    netlink.MakeHandle(htbHandleMajor, 0),
+				Parent:    netlink.HANDLE_ROOT,
+			},
+		})
+	}
+	if shaper.Egress.IsSet() {
+		qdiscs = append(qdiscs, &netlink.Ingress{
+			QdiscAttrs: netlink.QdiscAttrs{
+				LinkIndex: vifLink.Attrs().Index,
+				Handle:    netlink.MakeHandle(0xffff, 0),
+				Parent:    netlink.HANDLE_INGRESS,
+			},
+		})
+	}
+	for _, qdisc := range qdiscs {
+		err = netlink.QdiscDel(qdisc)
+		if err != nil && !errors.Is(err, unix.ENOENT) && !errors.Is(err, unix.EINVAL) {
+			return fmt.Errorf("netlink.QdiscDel(%s, %s) failed: %v",
+				qdisc.Type(), shaper.VIFIfName, err)
+		}
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *TrafficShaperConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+const (
+	// BridgeTypename : typename for Linux bridges used by network instances.
+	BridgeTypename = "Bridge"
+	// BridgePortTypename : typename for network interface attached to a bridge.
+	BridgePortTypename = "Bridge-Port"
+	// DnsmasqTypename : typename for dnsmasq program (DHCP and DNS server).
+	DnsmasqTypename = "Dnsmasq"
+	// IPsecTransportTypename : typename for ESP SAs protecting VXLAN traffic
+	// exchanged with a peer.
+	IPsecTransportTypename = "IPsec-Transport"
+	// RadvdTypename : typename for radvd program (router advertisement daemon).
+	RadvdTypename = "Radvd"
+	// RouteTypename : typename for IP route installed into NI-specific routing table.
+	RouteTypename = "NI-Route"
+	// TrafficShaperTypename : typename for tc configuration shaping traffic of a VIF.
+	TrafficShaperTypename = "Traffic-Shaper"
+	// UplinkTypename : typename for uplink interface used by network instance.
+	UplinkTypename = "Uplink"
+	// VIFTypename : typename for virtual interface connecting application with NI.
+	VIFTypename = "VIF"
+	// VxlanTypename : typename for VXLAN interface attached to NI bridge.
+	VxlanTypename = "VXLAN"
+	// WireGuardTypename : typename for WireGuard interface.
+	WireGuardTypename = "WireGuard"
+)
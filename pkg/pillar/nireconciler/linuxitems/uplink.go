@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+)
+
+// Uplink : uplink interface used by network instance to provide external connectivity.
+// External item used to represent a presence (or lack of it) of the uplink
+// interface (configured by NIM).
+type Uplink struct {
+	// IfName : name of the uplink interface (as assigned by the OS).
+	IfName string
+	// LogicalLabel : interface name used by the controller.
+	LogicalLabel string
+	// IsBridge : true if NIM has put the uplink under a bridge
+	// (the bridge then takes over the interface name).
+	IsBridge bool
+}
+
+// Name returns the interface name.
+func (u Uplink) Name() string {
+	return u.IfName
+}
+
+// Label returns the logical label.
+func (u Uplink) Label() string {
+	return u.LogicalLabel + " (uplink)"
+}
+
+// Type of the item.
+func (u Uplink) Type() string {
+	return UplinkTypename
+}
+
+// Equal is a comparison method for two equally-named Uplink instances.
+func (u Uplink) Equal(other depgraph.Item) bool {
+	u2 := other.(Uplink)
+	return u.IsBridge == u2.IsBridge
+}
+
+// External returns true because we learn about a presence of an uplink interface
+// through the NetworkMonitor.
+func (u Uplink) External() bool {
+	return true
+}
+
+// String describes the uplink interface.
+func (u Uplink) String() string {
+	return fmt.Sprintf("Uplink Interface: %#+v", u)
+}
+
+// Dependencies returns nothing (external item).
+func (u Uplink) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
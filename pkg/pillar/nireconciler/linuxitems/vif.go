@@ -0,0 +1,66 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+)
+
+// VIF : virtual interface connecting an application with a network instance.
+// External item - VIFs are created by the hypervisor (domainmgr) and we learn
+// about their presence through the NetworkMonitor.
+type VIF struct {
+	// IfName : name of the VIF on the host side.
+	IfName string
+	// NetAdapterName : logical name of the application network adapter.
+	NetAdapterName string
+	// AppDisplayName : name of the application (for logging).
+	AppDisplayName string
+	// BridgeIfName : bridge to which the VIF is attached.
+	BridgeIfName string
+	// GuestMAC : MAC address of the interface on the guest side.
+	GuestMAC net.HardwareAddr
+}
+
+// Name returns the interface name.
+func (v VIF) Name() string {
+	return v.IfName
+}
+
+// Label is more human-readable than name.
+func (v VIF) Label() string {
+	return fmt.Sprintf("%s/%s (VIF)", v.AppDisplayName, v.NetAdapterName)
+}
+
+// Type of the item.
+func (v VIF) Type() string {
+	return VIFTypename
+}
+
+// Equal is a comparison method for two equally-named VIF instances.
+func (v VIF) Equal(other depgraph.Item) bool {
+	v2 := other.(VIF)
+	return v.BridgeIfName == v2.BridgeIfName &&
+		v.GuestMAC.String() == v2.GuestMAC.String()
+}
+
+// External returns true.
+func (v VIF) External() bool {
+	return true
+}
+
+// String describes the VIF.
+func (v VIF) String() string {
+	return fmt.Sprintf("VIF: {ifName: %s, netAdapter: %s, app: %s, bridge: %s, "+
+		"guestMAC: %s}", v.IfName, v.NetAdapterName, v.AppDisplayName,
+		v.BridgeIfName, v.GuestMAC)
+}
+
+// Dependencies returns nothing (external item).
+func (v VIF) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
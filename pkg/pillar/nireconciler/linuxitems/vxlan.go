@@ -0,0 +1,277 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Vxlan : VXLAN interface attached to the bridge of a network instance,
+// stretching the L2 segment of the NI to other EVE nodes.
+// BUM traffic is replicated to every remote VTEP (head-end replication),
+// MAC addresses of remote endpoints are learned from the data plane.
+type Vxlan struct {
+	// IfName : name of the VXLAN interface.
+	IfName string
+	// VNI : VXLAN network identifier.
+	VNI uint32
+	// DstPort : UDP destination port of the encapsulated traffic.
+	DstPort uint16
+	// MTU of the VXLAN interface.
+	MTU uint16
+	// UnderlayIfName : interface through which the encapsulated traffic is sent.
+	UnderlayIfName string
+	// UnderlayIfType : UplinkTypename or WireGuardTypename.
+	UnderlayIfType string
+	// LocalIP : source IP address of the encapsulated traffic.
+	LocalIP net.IP
+	// Remotes : IP addresses of remote VTEPs.
+	Remotes []net.IP
+	// BridgeIfName : bridge of the network instance.
+	BridgeIfName string
+}
+
+// Name returns the interface name.
+func (v Vxlan) Name() string {
+	return v.IfName
+}
+
+// Label is more human-readable than name.
+func (v Vxlan) Label() string {
+	return fmt.Sprintf("VXLAN %d (%s)", v.VNI, v.IfName)
+}
+
+// Type of the item.
+func (v Vxlan) Type() string {
+	return VxlanTypename
+}
+
+// Equal is a comparison method for two equally-named Vxlan instances.
+func (v Vxlan) Equal(other depgraph.Item) bool {
+	v2 := other.(Vxlan)
+	return v.equalLinkAttrs(v2) && equalIPSets(v.Remotes, v2.Remotes)
+}
+
+// equalLinkAttrs compares everything except for the list of remote VTEPs,
+// which can be changed without re-creating the interface.
+func (v Vxlan) equalLinkAttrs(v2 Vxlan) bool {
+	return v.VNI == v2.VNI &&
+		v.DstPort == v2.DstPort &&
+		v.MTU == v2.MTU &&
+		v.UnderlayIfName == v2.UnderlayIfName &&
+		v.UnderlayIfType == v2.UnderlayIfType &&
+		v.LocalIP.Equal(v2.LocalIP) &&
+		v.BridgeIfName == v2.BridgeIfName
+}
+
+// External returns false.
+func (v Vxlan) External() bool {
+	return false
+}
+
+// String describes the VXLAN interface.
+func (v Vxlan) String() string {
+	return fmt.Sprintf("VXLAN interface: {ifName: %s, vni: %d, dstPort: %d, "+
+		"mtu: %d, underlay: %s, localIP: %s, remotes: %v, bridge: %s}",
+		v.IfName, v.VNI, v.DstPort, v.MTU, v.UnderlayIfName, v.LocalIP,
+		v.Remotes, v.BridgeIfName)
+}
+
+// Dependencies returns the bridge and the underlay interface as dependencies.
+func (v Vxlan) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: v.BridgeIfName,
+			},
+			Description: "Bridge must exist",
+		},
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: v.UnderlayIfType,
+				ItemName: v.UnderlayIfName,
+			},
+			Description: "Underlay interface must exist",
+		},
+	}
+}
+
+func equalIPSets(list1, list2 []net.IP) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for _, ip1 := range list1 {
+		if !containsIP(list2, ip1) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsIP(list []net.IP, ip net.IP) bool {
+	for _, item := range list {
+		if item.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// VxlanConfigurator implements Configurator interface (libs/reconciler)
+// for VXLAN interfaces.
+type VxlanConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create adds VXLAN interface, installs FDB entries for remote VTEPs
+// and puts the interface under the bridge.
+func (c *VxlanConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	vxlan := item.(Vxlan)
+	underlayLink, err := netlink.LinkByName(vxlan.UnderlayIfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v",
+			vxlan.UnderlayIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	bridgeLink, err := netlink.LinkByName(vxlan.BridgeIfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v",
+			vxlan.BridgeIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = vxlan.IfName
+	attrs.MTU = int(vxlan.MTU)
+	link := &netlink.Vxlan{
+		LinkAttrs:    attrs,
+		VxlanId:      int(vxlan.VNI),
+		VtepDevIndex: underlayLink.Attrs().Index,
+		SrcAddr:      vxlan.LocalIP,
+		Port:         int(vxlan.DstPort),
+		Learning:     true,
+	}
+	if err = netlink.LinkAdd(link); err != nil {
+		err = fmt.Errorf("netlink.LinkAdd(%s) failed: %v", vxlan.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	for _, remote := range vxlan.Remotes {
+		if err = c.addRemote(link, remote); err != nil {
+			return err
+		}
+	}
+	if err = netlink.LinkSetMaster(link, bridgeLink); err != nil {
+		err = fmt.Errorf("netlink.LinkSetMaster(%s, %s) failed: %v",
+			vxlan.IfName, vxlan.BridgeIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkSetUp(link); err != nil {
+		err = fmt.Errorf("netlink.LinkSetUp(%s) failed: %v", vxlan.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// remoteFDBEntry returns all-zeros FDB entry which makes the kernel send
+// a copy of every BUM frame to the given remote VTEP.
+func remoteFDBEntry(link netlink.Link, remote net.IP) *netlink.Neigh {
+	return &netlink.Neigh{
+		LinkIndex:    link.Attrs().Index,
+		Family:       unix.AF_BRIDGE,
+		State:        netlink.NUD_PERMANENT,
+		Flags:        netlink.NTF_SELF,
+		IP:           remote,
+		HardwareAddr: make(net.HardwareAddr, 6),
+	}
+}
+
+func (c *VxlanConfigurator) addRemote(link netlink.Link, remote net.IP) error {
+	err := netlink.NeighAppend(remoteFDBEntry(link, remote))
+	if err != nil {
+		err = fmt.Errorf("failed to add FDB entry for remote VTEP %s to %s: %v",
+			remote, link.Attrs().Name, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *VxlanConfigurator) delRemote(link netlink.Link, remote net.IP) error {
+	err := netlink.NeighDel(remoteFDBEntry(link, remote))
+	if err != nil && !errors.Is(err, unix.ENOENT) {
+		err = fmt.Errorf("failed to remove FDB entry for remote VTEP %s from %s: %v",
+			remote, link.Attrs().Name, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify updates FDB entries of remote VTEPs.
+func (c *VxlanConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	oldVxlan := oldItem.(Vxlan)
+	newVxlan := newItem.(Vxlan)
+	link, err := netlink.LinkByName(newVxlan.IfName)
+	if err != nil {
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", newVxlan.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	for _, remote := range oldVxlan.Remotes {
+		if !containsIP(newVxlan.Remotes, remote) {
+			if err = c.delRemote(link, remote); err != nil {
+				return err
+			}
+		}
+	}
+	for _, remote := range newVxlan.Remotes {
+		if !containsIP(oldVxlan.Remotes, remote) {
+			if err = c.addRemote(link, remote); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Delete removes VXLAN interface.
+func (c *VxlanConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	vxlan := item.(Vxlan)
+	link, err := netlink.LinkByName(vxlan.IfName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+			return nil
+		}
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", vxlan.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkDel(link); err != nil {
+		err = fmt.Errorf("netlink.LinkDel(%s) failed: %v", vxlan.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if anything other than the list of remote VTEPs
+// has changed.
+func (c *VxlanConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return !oldItem.(Vxlan).equalLinkAttrs(newItem.(Vxlan))
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/vishvananda/netlink"
+)
+
+const wgBinary = "/usr/bin/wg"
+
+// WireGuard : WireGuard interface with a static set of peers.
+// Used to encrypt traffic of a VXLAN network instance and as the tunnel
+// of WireGuard VPN network instance.
+type WireGuard struct {
+	// IfName : name of the WireGuard interface.
+	IfName string
+	// PrivateKey : base64-encoded private key (never printed).
+	PrivateKey string
+	// ListenPort : UDP port to listen on.
+	ListenPort uint16
+	// IPAddress : IP address (with the tunnel subnet) assigned to the interface.
+	IPAddress *net.IPNet
+	// MTU of the WireGuard interface.
+	MTU uint16
+	// Peers : remote ends of the tunnel.
+	Peers []WireGuardPeer
+	// UplinkIfName : uplink through which the peers are reached.
+	UplinkIfName string
+}
+
+// WireGuardPeer : remote end of a WireGuard tunnel.
+type WireGuardPeer struct {
+	// PublicKey : base64-encoded public key of the peer.
+	PublicKey string
+	// Endpoint : UDP address of the peer.
+	Endpoint *net.UDPAddr
+	// AllowedIPs : IP addresses routed to (and accepted from) the peer.
+	AllowedIPs []*net.IPNet
+	// PersistentKeepalive : interval in seconds, zero to disable.
+	PersistentKeepalive uint16
+}
+
+// Equal compares two WireGuard peers.
+func (p WireGuardPeer) Equal(p2 WireGuardPeer) bool {
+	if p.PublicKey != p2.PublicKey ||
+		p.PersistentKeepalive != p2.PersistentKeepalive {
+		return false
+	}
+	if (p.Endpoint == nil) != (p2.Endpoint == nil) {
+		return false
+	}
+	if p.Endpoint != nil && p.Endpoint.String() != p2.Endpoint.String() {
+		return false
+	}
+	if len(p.AllowedIPs) != len(p2.AllowedIPs) {
+		return false
+	}
+	for i := range p.AllowedIPs {
+		if p.AllowedIPs[i].String() != p2.AllowedIPs[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the interface name.
+func (w WireGuard) Name() string {
+	return w.IfName
+}
+
+// Label is more human-readable than name.
+func (w WireGuard) Label() string {
+	return w.IfName + " (WireGuard)"
+}
+
+// Type of the item.
+func (w WireGuard) Type() string {
+	return WireGuardTypename
+}
+
+// Equal is a comparison method for two equally-named WireGuard instances.
+func (w WireGuard) Equal(other depgraph.Item) bool {
+	w2 := other.(WireGuard)
+	if !w.equalIfAttrs(w2) || len(w.Peers) != len(w2.Peers) {
+		return false
+	}
+	for i := range w.Peers {
+		if !w.Peers[i].Equal(w2.Peers[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalIfAttrs compares everything except for the peers, which can be
+// changed without re-creating the interface.
+func (w WireGuard) equalIfAttrs(w2 WireGuard) bool {
+	return w.PrivateKey == w2.PrivateKey &&
+		w.ListenPort == w2.ListenPort &&
+		w.IPAddress.String() == w2.IPAddress.String() &&
+		w.MTU == w2.MTU &&
+		w.UplinkIfName == w2.UplinkIfName
+}
+
+// External returns false.
+func (w WireGuard) External() bool {
+	return false
+}
+
+// String describes the WireGuard interface. Private key is omitted.
+func (w WireGuard) String() string {
+	var peers []string
+	for _, peer := range w.Peers {
+		peers = append(peers, fmt.Sprintf("{publicKey: %s, endpoint: %v, "+
+			"allowedIPs: %v, keepalive: %d}", peer.PublicKey, peer.Endpoint,
+			peer.AllowedIPs, peer.PersistentKeepalive))
+	}
+	return fmt.Sprintf("WireGuard interface: {ifName: %s, listenPort: %d, "+
+		"ipAddress: %s, mtu: %d, uplink: %s, peers: [%s]}",
+		w.IfName, w.ListenPort, w.IPAddress, w.MTU, w.UplinkIfName,
+		strings.Join(peers, ", "))
+}
+
+// Dependencies returns the uplink interface as the only dependency.
+func (w WireGuard) Dependencies() (deps []depgraph.Dependency) {
+	if w.UplinkIfName == "" {
+		return nil
+	}
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: UplinkTypename,
+				ItemName: w.UplinkIfName,
+			},
+			Description: "Uplink must exist",
+		},
+	}
+}
+
+// WireGuardConfigurator implements Configurator interface (libs/reconciler)
+// for WireGuard interfaces.
+type WireGuardConfigurator struct {
+	Log            *base.LogObject
+	NetworkMonitor netmonitor.NetworkMonitor
+}
+
+// Create adds WireGuard interface, assigns the IP address and configures
+// the private key and peers using the wg tool.
+func (c *WireGuardConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	wg := item.(WireGuard)
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = wg.IfName
+	attrs.MTU = int(wg.MTU)
+	link := &netlink.Wireguard{LinkAttrs: attrs}
+	if err := netlink.LinkAdd(link); err != nil {
+		err = fmt.Errorf("netlink.LinkAdd(%s) failed: %v", wg.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if wg.IPAddress != nil {
+		addr := &netlink.Addr{IPNet: wg.IPAddress}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			err = fmt.Errorf("netlink.AddrAdd(%s, %s) failed: %v",
+				wg.IfName, wg.IPAddress, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	args := []string{"set", wg.IfName,
+		"listen-port", strconv.Itoa(int(wg.ListenPort)),
+		"private-key", "/dev/stdin"}
+	args = append(args, wgPeerArgs(wg.Peers)...)
+	if err := c.runWg(ctx, wg.PrivateKey, args); err != nil {
+		return err
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		err = fmt.Errorf("netlink.LinkSetUp(%s) failed: %v", wg.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func wgPeerArgs(peers []WireGuardPeer) (args []string) {
+	for _, peer := range peers {
+		args = append(args, "peer", peer.PublicKey)
+		if peer.Endpoint != nil {
+			args = append(args, "endpoint", peer.Endpoint.String())
+		}
+		var allowedIPs []string
+		for _, allowedIP := range peer.AllowedIPs {
+			allowedIPs = append(allowedIPs, allowedIP.String())
+		}
+		args = append(args, "allowed-ips", strings.Join(allowedIPs, ","))
+		if peer.PersistentKeepalive != 0 {
+			args = append(args, "persistent-keepalive",
+				strconv.Itoa(int(peer.PersistentKeepalive)))
+		}
+	}
+	return args
+}
+
+// runWg executes the wg tool. Private key (if any) is passed through stdin
+// so that it does not appear in the process list.
+func (c *WireGuardConfigurator) runWg(ctx context.Context,
+	privateKey string, args []string) error {
+	cmd := exec.CommandContext(ctx, wgBinary, args...)
+	if privateKey != "" {
+		cmd.Stdin = strings.NewReader(privateKey)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("wg %s failed: %s (%v)", args[0],
+			strings.TrimSpace(string(out)), err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify updates the set of peers.
+func (c *WireGuardConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
+	oldWg := oldItem.(WireGuard)
+	newWg := newItem.(WireGuard)
+	args := []string{"set", newWg.IfName}
+	for _, oldPeer := range oldWg.Peers {
+		var found bool
+		for _, newPeer := range newWg.Peers {
+			if oldPeer.PublicKey == newPeer.PublicKey {
+				found = true
+				break
+			}
+		}
+		if !found {
+			args = append(args, "peer", oldPeer.PublicKey, "remove")
+		}
+	}
+	args = append(args, wgPeerArgs(newWg.Peers)...)
+	return c.runWg(ctx, "", args)
+}
+
+// Delete removes WireGuard interface.
+func (c *WireGuardConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	wg := item.(WireGuard)
+	link, err := netlink.LinkByName(wg.IfName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+			return nil
+		}
+		err = fmt.Errorf("netlink.LinkByName(%s) failed: %v", wg.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err = netlink.LinkDel(link); err != nil {
+		err = fmt.Errorf("netlink.LinkDel(%s) failed: %v", wg.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if anything other than the peers has changed.
+func (c *WireGuardConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return !oldItem.(WireGuard).equalIfAttrs(newItem.(WireGuard))
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"context"
+
+	"github.com/lf-edge/eve/libs/reconciler"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// NIReconciler should translate the configuration of network instances and of
+// application connections to network instances (prepared by zedrouter) into
+// the corresponding low-level network configuration of the target network stack
+// and apply it using the Reconciler (see libs/reconciler).
+// It is not required for NIReconciler to be thread-safe.
+type NIReconciler interface {
+	// Reconcile : call to apply the current network instance config into
+	// the target network stack.
+	// Synchronous configuration operations are run from within the caller's Go routine.
+	Reconcile(ctx context.Context, args Args) ReconcileStatus
+}
+
+// Args : network instances and application connections to be applied
+// into the target network stack.
+type Args struct {
+	// NIs : network instances to configure.
+	NIs []NIConfig
+	// Apps : applications connected to network instances.
+	Apps []AppConnConfig
+	// Rules : iptables rules which are not specific to any network instance
+	// or application (but still installed into the "-apps" chains).
+	Rules []types.IPTablesRule
+}
+
+// NIConfig : configuration of a single network instance.
+type NIConfig struct {
+	UUID        uuid.UUID
+	DisplayName string
+	// Bridge : bridge used by the network instance.
+	// With Bridge.CreatedByNIM enabled, the bridge is only looked up, otherwise
+	// it is created (and removed when the NI is deleted).
+	Bridge linux.Bridge
+	// UplinkIfName : name of the interface used by the network instance to reach
+	// external endpoints. Empty for air-gapped network instance.
+	UplinkIfName string
+	// UplinkLogicalLabel : logical label of the uplink interface.
+	UplinkLogicalLabel string
+	// BridgeUplink : put the uplink interface under the NI bridge (used with switch
+	// network instance if the bridge is not created by NIM).
+	BridgeUplink bool
+	// CopyUplinkRoutes : install IPv4 routes of the uplink into the routing table
+	// of the network instance (used with local network instances).
+	CopyUplinkRoutes bool
+	// Dnsmasq : DHCP+DNS server to run for the network instance.
+	// Nil if dnsmasq should not run.
+	Dnsmasq *linux.Dnsmasq
+	// Radvd : router advertisement daemon to run for (IPv6) network instance.
+	// Nil if radvd should not run.
+	Radvd *linux.Radvd
+	// Vxlan : VXLAN interface stretching the NI bridge to other EVE nodes.
+	// Nil unless this is a VXLAN network instance.
+	Vxlan *linux.Vxlan
+	// WireGuard : interface carrying encrypted VXLAN traffic (VXLAN network
+	// instance encrypted with WireGuard) or the tunnel of WireGuard VPN
+	// network instance. Nil otherwise.
+	WireGuard *linux.WireGuard
+	// WireGuardRoutes : route AllowedIPs of WireGuard peers from the routing table
+	// of the network instance (used with WireGuard VPN network instance).
+	WireGuardRoutes bool
+	// IPsec : SA pairs encrypting VXLAN traffic exchanged with every peer.
+	// Empty unless VXLAN network instance is encrypted with IPsec.
+	// Network instances may share SA pairs with the same traffic selector.
+	IPsec []linux.IPsecTransport
+	// Rules : iptables rules installed for the network instance.
+	// Rules should be listed in the order in which they are applied.
+	Rules []types.IPTablesRule
+}
+
+// AppConnConfig : configuration of application connections to network instances.
+type AppConnConfig struct {
+	UUID        uuid.UUID
+	DisplayName string
+	// VIFs : virtual interfaces connecting the application with network instances.
+	// VIFs are created by the hypervisor, NIReconciler only checks their presence.
+	VIFs []linux.VIF
+	// Shapers : bit-rate shaping of the traffic of application VIFs.
+	// Only VIFs with shaping enabled are listed.
+	Shapers []linux.TrafficShaper
+	// Rules : iptables rules implementing ACLs of the application.
+	// Rules should be listed in the order in which they are applied
+	// and with prefix already included.
+	Rules []types.IPTablesRule
+}
+
+// ReconcileStatus : state data related to config reconciliation.
+type ReconcileStatus struct {
+	// Error summarizing the outcome of the reconciliation.
+	Error error
+	// True if any async operations are in progress.
+	AsyncInProgress bool
+	// ResumeReconcile channel is used by NIReconciler to signal that reconciliation
+	// should be triggered (even if Args has not necessarily changed). This is either
+	// because some config operation was running asynchronously and has just finalized
+	// (and should be followed up on), or because something changed in the current state
+	// that NIReconciler needs to reflect in the applied config.
+	ResumeReconcile <-chan struct{}
+	// CancelAsyncOps : send cancel signal to all asynchronously running operations.
+	CancelAsyncOps func()
+	// WaitForAsyncOps : wait for all asynchronously running operations to complete.
+	WaitForAsyncOps func()
+	// The set of configuration items currently in a failed state.
+	// Includes information about the last (failed) operation.
+	FailingItems reconciler.OperationLog
+	// NIs : status of every network instance.
+	NIs map[uuid.UUID]NIStatus
+}
+
+// NIStatus : state information related to a single network instance.
+type NIStatus struct {
+	// BridgeIfIndex : index of the bridge interface.
+	// Zero if the bridge does not exist (yet).
+	BridgeIfIndex int
+	// RouteTable : routing table used by the network instance.
+	// Zero if the NI does not have its own routing table (yet).
+	RouteTable int
+	// Error : non-nil if some config item of the network instance failed to apply.
+	Error error
+}
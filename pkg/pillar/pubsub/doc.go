@@ -26,6 +26,8 @@
 // Included is the `SocketDriver`, which uses a Unix-domain socket to
 // communicate between publishers and subscribers, and local directories to
 // store persistent messages.
+// `KVDriver` builds on top of `SocketDriver`, but keeps persistent
+// publications in a single transactional key-value store file each.
 //
 // see the documentation for each element to understand its usage.
 //
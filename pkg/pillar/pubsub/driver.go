@@ -77,6 +77,10 @@ type DriverPublisher interface {
 
 	// LargeDirName returns the directory to be used for large fields
 	LargeDirName() string
+
+	// HistoryDirName returns the directory where to dump the change history
+	// of the publication (if enabled). Empty string disables the dump.
+	HistoryDirName() string
 }
 
 // Restarted interface that lets you determine if a Publication has been restarted
@@ -66,6 +66,11 @@ func (e *EmptyDriverPublisher) LargeDirName() string {
 	return "/tmp"
 }
 
+// HistoryDirName where to dump change history
+func (e *EmptyDriverPublisher) HistoryDirName() string {
+	return ""
+}
+
 // EmptyDriverSubscriber struct
 type EmptyDriverSubscriber struct{}
 
@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// Name of the file (inside HistoryDirName()/<publication-name>/)
+// with the dumped change history of a publication.
+const historyDumpFile = "history.json"
+
+// historyDumpInterval is the minimum time between two dumps of the history.
+// Changes made within the interval are dumped together at its end.
+const historyDumpInterval = 30 * time.Second
+
+// HistoryOp is the type of a change recorded in the history.
+type HistoryOp uint8
+
+const (
+	// HistoryCreate : a new key was added.
+	HistoryCreate HistoryOp = iota + 1
+	// HistoryModify : an existing key was changed.
+	HistoryModify
+	// HistoryDelete : a key was removed.
+	HistoryDelete
+)
+
+// String returns the name of the operation.
+func (op HistoryOp) String() string {
+	switch op {
+	case HistoryCreate:
+		return "create"
+	case HistoryModify:
+		return "modify"
+	case HistoryDelete:
+		return "delete"
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(op))
+}
+
+// MarshalText is used to print the operation name in the JSON dump.
+func (op HistoryOp) MarshalText() ([]byte, error) {
+	return []byte(op.String()), nil
+}
+
+// HistoryEvent is a single change of a publication/subscription recorded
+// in the history.
+type HistoryEvent struct {
+	Time time.Time
+	Op   HistoryOp
+	Key  string
+	// Diff between the previous and the new value of the item (see cmp.Diff).
+	// Empty for create and delete.
+	Diff string `json:",omitempty"`
+	// Item is the value after the change. For delete this is the last value
+	// the item had before it was removed.
+	Item interface{}
+	// oldItem is kept for modify until the diff is computed.
+	oldItem interface{}
+}
+
+// ReplayHistory reconstructs the state of the items which were created,
+// modified or deleted within the recorded history, as it was at the given
+// point in time. Items deleted by then are not included. Keys which
+// did not change since the oldest recorded event are missing as well,
+// so the result should be combined with the current content (GetAll)
+// if a complete view is needed.
+func ReplayHistory(events []HistoryEvent, until time.Time) map[string]interface{} {
+	items := make(map[string]interface{})
+	for _, event := range events {
+		if event.Time.After(until) {
+			break
+		}
+		switch event.Op {
+		case HistoryCreate, HistoryModify:
+			items[event.Key] = event.Item
+		case HistoryDelete:
+			delete(items, event.Key)
+		}
+	}
+	return items
+}
+
+// changeHistory is a ring buffer with the most recent changes.
+// Recording a change only stores references to the items, the diff
+// is computed and the file is written later, outside of Publish.
+type changeHistory struct {
+	sync.Mutex
+	events []HistoryEvent
+	next   int  // index where the next event is stored
+	full   bool // all slots of events are used
+	// File where to dump the history, at most once per historyDumpInterval.
+	// Empty if not dumped.
+	dumpFile  string
+	dumpTimer *time.Timer // pending dump, nil if none
+	lastDump  time.Time
+	log       *base.LogObject
+}
+
+func newChangeHistory(log *base.LogObject, size int,
+	dumpFile string) *changeHistory {
+	return &changeHistory{
+		events:   make([]HistoryEvent, size),
+		dumpFile: dumpFile,
+		log:      log,
+	}
+}
+
+// record adds new event into the history, possibly overwriting the oldest one.
+// oldItem is nil for create, newItem is nil for delete.
+func (h *changeHistory) record(key string, oldItem, newItem interface{}) {
+	if h == nil {
+		return
+	}
+	event := HistoryEvent{
+		Time: time.Now(),
+		Key:  key,
+		Item: newItem,
+	}
+	switch {
+	case oldItem == nil:
+		event.Op = HistoryCreate
+	case newItem == nil:
+		event.Op = HistoryDelete
+		event.Item = oldItem
+	default:
+		event.Op = HistoryModify
+		event.oldItem = oldItem
+	}
+	h.Lock()
+	defer h.Unlock()
+	h.events[h.next] = event
+	h.next = (h.next + 1) % len(h.events)
+	if h.next == 0 {
+		h.full = true
+	}
+	if h.dumpFile != "" && h.dumpTimer == nil {
+		delay := historyDumpInterval - time.Since(h.lastDump)
+		if delay < 0 {
+			delay = 0
+		}
+		h.dumpTimer = time.AfterFunc(delay, h.dump)
+	}
+}
+
+// get returns recorded events ordered from the oldest to the most recent.
+func (h *changeHistory) get() []HistoryEvent {
+	if h == nil {
+		return nil
+	}
+	h.Lock()
+	defer h.Unlock()
+	return h.eventsLocked()
+}
+
+func (h *changeHistory) eventsLocked() []HistoryEvent {
+	for i := range h.events {
+		event := &h.events[i]
+		if event.oldItem != nil {
+			event.Diff = cmp.Diff(event.oldItem, event.Item)
+			event.oldItem = nil
+		}
+	}
+	var events []HistoryEvent
+	if h.full {
+		events = append(events, h.events[h.next:]...)
+	}
+	return append(events, h.events[:h.next]...)
+}
+
+// flush writes a pending dump immediately.
+func (h *changeHistory) flush() {
+	if h == nil {
+		return
+	}
+	h.Lock()
+	timer := h.dumpTimer
+	h.Unlock()
+	if timer != nil && timer.Stop() {
+		h.dump()
+	}
+}
+
+// dump writes the history into a file as JSON.
+func (h *changeHistory) dump() {
+	h.Lock()
+	defer h.Unlock()
+	h.dumpTimer = nil
+	h.lastDump = time.Now()
+	b, err := json.MarshalIndent(h.eventsLocked(), "", "  ")
+	if err != nil {
+		h.log.Errorf("changeHistory.dump(%s): json.Marshal failed: %v",
+			h.dumpFile, err)
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(h.dumpFile), 0700); err != nil {
+		h.log.Errorf("changeHistory.dump(%s): %v", h.dumpFile, err)
+		return
+	}
+	if err = fileutils.WriteRename(h.dumpFile, b); err != nil {
+		h.log.Errorf("changeHistory.dump(%s): %v", h.dumpFile, err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicationHistory(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "history_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	driver := socketdriver.SocketDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	ps := pubsub.New(&driver, logger, log)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:   "testagent",
+		TopicType:   item{},
+		HistorySize: 4,
+	})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	assert.Empty(t, pub.History())
+
+	// The first change is dumped right away.
+	dumpFile := filepath.Join(rootPath, "run/pubsub-history/testagent/item/history.json")
+	assert.NoError(t, pub.Publish("key1", item{FieldA: "item1"}))
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(dumpFile)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.NoError(t, pub.Publish("key2", item{FieldA: "item2"}))
+	// Unchanged item is not recorded.
+	assert.NoError(t, pub.Publish("key2", item{FieldA: "item2"}))
+	history := pub.History()
+	assert.Len(t, history, 2)
+	beforeModify := time.Now()
+	assert.NoError(t, pub.Publish("key1", item{FieldA: "item1modified"}))
+	assert.NoError(t, pub.Unpublish("key2"))
+	assert.NoError(t, pub.Publish("key3", item{FieldA: "item3"}))
+
+	// The oldest event (create of key1) was overwritten.
+	history = pub.History()
+	assert.Len(t, history, 4)
+	var ops []pubsub.HistoryOp
+	var keys []string
+	for _, event := range history {
+		ops = append(ops, event.Op)
+		keys = append(keys, event.Key)
+	}
+	assert.Equal(t, []pubsub.HistoryOp{pubsub.HistoryCreate,
+		pubsub.HistoryModify, pubsub.HistoryDelete, pubsub.HistoryCreate}, ops)
+	assert.Equal(t, []string{"key2", "key1", "key2", "key3"}, keys)
+	assert.Contains(t, history[1].Diff, "item1modified")
+	assert.Equal(t, item{FieldA: "item2"}, history[2].Item)
+
+	assert.Equal(t, map[string]interface{}{
+		"key2": item{FieldA: "item2"},
+	}, pubsub.ReplayHistory(history, beforeModify))
+	assert.Equal(t, map[string]interface{}{
+		"key1": item{FieldA: "item1modified"},
+		"key3": item{FieldA: "item3"},
+	}, pubsub.ReplayHistory(history, time.Now()))
+
+	// Further changes are dumped only after the rate limit interval.
+	var dump []struct {
+		Op   string
+		Key  string
+		Diff string
+	}
+	b, err := ioutil.ReadFile(dumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, json.Unmarshal(b, &dump))
+	assert.Len(t, dump, 1)
+
+	// Close writes the pending dump, including deletes of the unloaded items.
+	assert.NoError(t, pub.Close())
+	b, err = ioutil.ReadFile(dumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump = nil
+	assert.NoError(t, json.Unmarshal(b, &dump))
+	assert.Len(t, dump, 4)
+	if len(dump) == 4 {
+		assert.Equal(t, "create", dump[1].Op)
+		assert.Equal(t, "key3", dump[1].Key)
+		assert.Equal(t, "delete", dump[2].Op)
+		assert.Equal(t, "delete", dump[3].Op)
+		assert.ElementsMatch(t, []string{"key1", "key3"},
+			[]string{dump[2].Key, dump[3].Key})
+	}
+}
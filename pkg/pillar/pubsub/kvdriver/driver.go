@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kvdriver implements pubsub.Driver which keeps persistent
+// publications in an embedded key-value store instead of one JSON file
+// per key. All items of a publication are stored in a single file under
+// /persist/status (see Store), where every change is committed atomically.
+// IPC with subscribers and everything that is not persisted is delegated
+// to socketdriver.
+package kvdriver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Copied from types package to avoid cycle in package dependencies
+	persistDir = "/persist"
+	// Suffix of the store file.
+	storeSuffix = ".kv"
+	// Key under which the restart counter is stored.
+	// Published keys can't contain slashes, hence no collision is possible.
+	restartCounterKey = "/restarted"
+)
+
+// KVDriver driver for pubsub using socketdriver for IPC and an embedded
+// key-value store for persistence.
+type KVDriver struct {
+	Logger  *logrus.Logger
+	Log     *base.LogObject
+	RootDir string // Default is "/"; tests can override
+	// KeepLegacyDirs returns true while the directories of socketdriver
+	// must be kept in sync with the stores, e.g. while EVE image is being
+	// tested after an update and the previous image can still be booted.
+	// Called only if there is such a directory. Nil means false.
+	KeepLegacyDirs func() bool
+}
+
+// Publisher return an implementation of `pubsub.DriverPublisher` for `KVDriver`.
+func (d *KVDriver) Publisher(global bool, name, topic string, persistent bool,
+	updaterList *pubsub.Updaters, restarted pubsub.Restarted,
+	differ pubsub.Differ) (pubsub.DriverPublisher, error) {
+	if !usesStore(global, name, persistent) {
+		return d.socketDriver().Publisher(global, name, topic, persistent,
+			updaterList, restarted, differ)
+	}
+	store, err := OpenStore(d.storePath(name))
+	if err != nil {
+		return nil, fmt.Errorf("Publish(%s): %w", name, err)
+	}
+	keepLegacyDir := d.keepLegacyDir(name)
+	if err = migrateDir(d.Log, d.legacyDirName(name), store, keepLegacyDir); err != nil {
+		store.Close()
+		return nil, fmt.Errorf("Publish(%s): %w", name, err)
+	}
+	// Socket publisher is created as non-persistent; it only serves
+	// subscribers and keeps a checkpoint in /run. If the legacy directory
+	// is kept, the socket publisher is persistent and keeps it updated.
+	socketPub, err := d.socketDriver().Publisher(global, name, topic, keepLegacyDir,
+		updaterList, restarted, differ)
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return &Publisher{
+		DriverPublisher: socketPub,
+		name:            name,
+		store:           store,
+		log:             d.Log,
+	}, nil
+}
+
+// Subscriber return an implementation of `pubsub.DriverSubscriber` for `KVDriver`.
+func (d *KVDriver) Subscriber(global bool, name, topic string, persistent bool,
+	C chan pubsub.Change) (pubsub.DriverSubscriber, error) {
+	socketSub, err := d.socketDriver().Subscriber(global, name, topic,
+		persistent, C)
+	if err != nil || !usesStore(global, name, persistent) {
+		return socketSub, err
+	}
+	return &Subscriber{
+		DriverSubscriber: socketSub,
+		name:             name,
+		storePath:        d.storePath(name),
+		log:              d.Log,
+	}, nil
+}
+
+// DefaultName default name for an agent when none is provided
+func (d *KVDriver) DefaultName() string {
+	return d.socketDriver().DefaultName()
+}
+
+func (d *KVDriver) socketDriver() *socketdriver.SocketDriver {
+	return &socketdriver.SocketDriver{
+		Logger:  d.Logger,
+		Log:     d.Log,
+		RootDir: d.RootDir,
+	}
+}
+
+// storePath returns path to the store file of the publication.
+func (d *KVDriver) storePath(name string) string {
+	return d.legacyDirName(name) + storeSuffix
+}
+
+// legacyDirName returns the directory where socketdriver keeps persistent
+// publication (as one JSON file per key).
+func (d *KVDriver) legacyDirName(name string) string {
+	return fmt.Sprintf("%s/%s/status/%s", d.RootDir, persistDir, name)
+}
+
+// keepLegacyDir returns true if the directory of socketdriver exists
+// and must be kept in sync with the store.
+func (d *KVDriver) keepLegacyDir(name string) bool {
+	if d.KeepLegacyDirs == nil {
+		return false
+	}
+	if _, err := os.Stat(d.legacyDirName(name)); err != nil {
+		return false
+	}
+	return d.KeepLegacyDirs()
+}
+
+// Persistent publications which are accessed directly in the directory
+// layout of socketdriver by something else than pubsub.
+var legacyPublications = map[string]struct{}{
+	// Created and updated by upgradeconverter before zedagent starts.
+	"zedagent/ConfigItemValueMap": {},
+}
+
+// usesStore returns true if the publication is persisted using the key-value
+// store. Global publications (/persist/config), publications of zedclient
+// (read from the directory by subscribers) and legacyPublications
+// are left to socketdriver.
+func usesStore(global bool, name string, persistent bool) bool {
+	if !persistent || global {
+		return false
+	}
+	if _, legacy := legacyPublications[name]; legacy {
+		return false
+	}
+	agentName := strings.Split(name, "/")[0]
+	return agentName != "zedclient"
+}
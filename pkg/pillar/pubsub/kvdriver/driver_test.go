@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/kvdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	FieldA string
+}
+
+func newPublication(t *testing.T, ps *pubsub.PubSub) pubsub.Publication {
+	pub, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  "testagent",
+			Persistent: true,
+			TopicType:  item{},
+		})
+	if err != nil {
+		t.Fatalf("unable to publish: %v", err)
+	}
+	return pub
+}
+
+func TestMigrationAndReload(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "kvdriver_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	driver := kvdriver.KVDriver{
+		Logger:  logger,
+		Log:     log,
+		RootDir: rootPath,
+	}
+	ps := pubsub.New(&driver, logger, log)
+
+	// Publication persisted by socketdriver.
+	legacyDir := filepath.Join(rootPath, "persist/status/testagent/item")
+	if err := os.MkdirAll(legacyDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"key1.json":     `{"FieldA":"item1"}`,
+		"key1.json.bak": `{"FieldA":"item1-old"}`,
+		// Crashed before the original was written.
+		"key2.json.bak": `{"FieldA":"item2"}`,
+		"restarted":     "",
+	}
+	for name, content := range files {
+		err = ioutil.WriteFile(filepath.Join(legacyDir, name), []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pub := newPublication(t, ps)
+	_, err = os.Stat(legacyDir)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(legacyDir + ".kv")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"key1": item{FieldA: "item1"},
+		"key2": item{FieldA: "item2"},
+	}, pub.GetAll())
+
+	assert.NoError(t, pub.Publish("key3", item{FieldA: "item3"}))
+	assert.NoError(t, pub.Unpublish("key1"))
+	assert.NoError(t, pub.SignalRestarted())
+	assert.NoError(t, pub.Close())
+
+	// Reload the publication from the store.
+	pub = newPublication(t, ps)
+	assert.Equal(t, map[string]interface{}{
+		"key2": item{FieldA: "item2"},
+		"key3": item{FieldA: "item3"},
+	}, pub.GetAll())
+	assert.NoError(t, pub.Close())
+
+	// Subscriber loads the persisted content before the publisher starts.
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:  "testagent",
+		Persistent: true,
+		TopicImpl:  item{},
+	})
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	assert.NoError(t, sub.Activate())
+	assert.Equal(t, map[string]interface{}{
+		"key2": item{FieldA: "item2"},
+		"key3": item{FieldA: "item3"},
+	}, sub.GetAll())
+	// Publication.Close clears the restarted flag.
+	assert.False(t, sub.Restarted())
+	sub.Close()
+}
+
+func TestMigrationKeepsLegacyDir(t *testing.T) {
+	rootPath, err := ioutil.TempDir("", "kvdriver_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(rootPath)
+
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	keepLegacyDirs := true
+	driver := kvdriver.KVDriver{
+		Logger:         logger,
+		Log:            log,
+		RootDir:        rootPath,
+		KeepLegacyDirs: func() bool { return keepLegacyDirs },
+	}
+	ps := pubsub.New(&driver, logger, log)
+
+	legacyDir := filepath.Join(rootPath, "persist/status/testagent/item")
+	if err := os.MkdirAll(legacyDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(legacyDir, "key1.json"),
+		[]byte(`{"FieldA":"item1"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// New image is not active yet, the directory is kept up to date
+	// for the previous image.
+	pub := newPublication(t, ps)
+	assert.Equal(t, map[string]interface{}{
+		"key1": item{FieldA: "item1"},
+	}, pub.GetAll())
+	assert.NoError(t, pub.Publish("key2", item{FieldA: "item2"}))
+	assert.NoError(t, pub.Unpublish("key1"))
+	// Invalid key is neither persisted nor published.
+	assert.Error(t, pub.Publish("a/b", item{FieldA: "item3"}))
+	assert.NoError(t, pub.Close())
+	_, err = os.Stat(filepath.Join(legacyDir, "key1.json"))
+	assert.True(t, os.IsNotExist(err))
+	content, err := ioutil.ReadFile(filepath.Join(legacyDir, "key2.json"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"FieldA":"item2"}`, string(content))
+
+	// Image marked active, the directory is removed.
+	keepLegacyDirs = false
+	pub = newPublication(t, ps)
+	assert.Equal(t, map[string]interface{}{
+		"key2": item{FieldA: "item2"},
+	}, pub.GetAll())
+	assert.NoError(t, pub.Close())
+	_, err = os.Stat(legacyDir)
+	assert.True(t, os.IsNotExist(err))
+}
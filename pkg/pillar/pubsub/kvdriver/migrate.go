@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const (
+	jsonSuffix   = ".json"
+	backupSuffix = ".json.bak"
+	restartFile  = "restarted"
+	// Copied from socketdriver
+	maxFileName = 255
+)
+
+// migrateDir imports a publication persisted by socketdriver (one JSON file
+// per key) into the store and removes the directory afterwards, unless keepDir
+// is set. The directory is kept (and updated along with the store, see
+// KVDriver.KeepLegacyDirs) until the EVE image with kvdriver is marked active,
+// because the previous image, which reads only the directory, could be
+// booted after a failed update.
+// The directory content replaces the content of the store, which only matters
+// if the directory was re-created by an older EVE version after a downgrade.
+// The import is committed as a single batch; if interrupted, the directory
+// is left untouched and the migration is repeated on the next start.
+func migrateDir(log *base.LogObject, dirName string, store *Store, keepDir bool) error {
+	files, err := ioutil.ReadDir(dirName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	items := make(map[string][]byte)
+	backups := make(map[string][]byte)
+	for _, file := range files {
+		fileName := file.Name()
+		filePath := filepath.Join(dirName, fileName)
+		switch {
+		case fileName == restartFile:
+			val, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				log.Errorf("migrateDir: %v", err)
+				continue
+			}
+			if len(val) == 0 {
+				// Treat present but empty file as "1"
+				val = []byte("1")
+			}
+			items[restartCounterKey] = val
+		case strings.HasSuffix(fileName, backupSuffix):
+			val, err := ioutil.ReadFile(filePath)
+			if err != nil || len(val) == 0 {
+				continue
+			}
+			backups[strings.TrimSuffix(fileName, backupSuffix)] = val
+		case strings.HasSuffix(fileName, jsonSuffix):
+			val, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				log.Errorf("migrateDir: %v", err)
+				continue
+			}
+			if len(val) == 0 {
+				log.Errorf("migrateDir: %s is empty", filePath)
+				continue
+			}
+			items[strings.TrimSuffix(fileName, jsonSuffix)] = val
+		}
+	}
+	// Recover lost items (have backup but missing the original).
+	for key, val := range backups {
+		if _, loaded := items[key]; !loaded {
+			log.Warnf("migrateDir: using backup of %s/%s", dirName, key)
+			items[key] = val
+		}
+	}
+	var batch Batch
+	for key := range store.Items() {
+		if _, exists := items[key]; !exists {
+			batch.Delete(key)
+		}
+	}
+	for key, val := range items {
+		batch.Put(key, val)
+	}
+	if err = store.Commit(&batch); err != nil {
+		return fmt.Errorf("failed to import %s: %w", dirName, err)
+	}
+	if keepDir {
+		log.Noticef("migrateDir: imported %d items from %s, keeping the directory",
+			len(items), dirName)
+		return nil
+	}
+	if err = os.RemoveAll(dirName); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", dirName, err)
+	}
+	if err = fileutils.DirSync(filepath.Dir(dirName)); err != nil {
+		return err
+	}
+	log.Noticef("migrateDir: imported %d items from %s", len(items), dirName)
+	return nil
+}
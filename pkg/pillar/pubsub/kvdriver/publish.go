@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// Publisher implementation of `pubsub.DriverPublisher` for `KVDriver`.
+// Persists items in the Store and uses the embedded (non-persistent)
+// socketdriver publisher to serve subscribers.
+type Publisher struct {
+	pubsub.DriverPublisher
+	name  string
+	store *Store
+	log   *base.LogObject
+}
+
+// Start the publisher.
+// Before serving subscribers, the checkpoint kept by the socket publisher
+// in /run is brought in sync with the store, so that tools reading
+// the directory directly (e.g. edgeview) see the persisted items after reboot.
+func (p *Publisher) Start() error {
+	items, restartCounter := splitRestartCounter(p.log, p.name, p.store.Items())
+	for key, item := range items {
+		if err := p.DriverPublisher.Publish(key, item); err != nil {
+			p.log.Errorf("Start(%s): failed to checkpoint %s: %v",
+				p.name, key, err)
+		}
+	}
+	if restartCounter != 0 {
+		if err := p.DriverPublisher.Restart(restartCounter); err != nil {
+			p.log.Errorf("Start(%s): %v", p.name, err)
+		}
+	}
+	return p.DriverPublisher.Start()
+}
+
+// Publish a key-value pair
+// The item is persisted in the store before it is handed over
+// to the socket publisher, so subscribers never see an item which
+// is lost with a power failure.
+func (p *Publisher) Publish(key string, item []byte) error {
+	if err := p.validate(key, item); err != nil {
+		return err
+	}
+	if err := p.store.Put(key, item); err != nil {
+		return fmt.Errorf("Publish(%s/%s): %w", p.name, key, err)
+	}
+	return p.DriverPublisher.Publish(key, item)
+}
+
+// validate applies the same restrictions as socketdriver, which keeps
+// items in files named by their keys.
+func (p *Publisher) validate(key string, item []byte) error {
+	if len(item) == 0 {
+		return fmt.Errorf("empty content published for %s/%s", p.name, key)
+	}
+	if strings.Contains(key, "/") {
+		return fmt.Errorf("key(%s) must not contain slashes", key)
+	}
+	if len(key+jsonSuffix) > maxFileName {
+		return fmt.Errorf("key(%s) exceed maximum filename limit of %d bytes: %d",
+			key, maxFileName, len(key+jsonSuffix))
+	}
+	return nil
+}
+
+// Unpublish delete a key and publish its deletion
+func (p *Publisher) Unpublish(key string) error {
+	if err := p.store.Delete(key); err != nil {
+		return fmt.Errorf("Unpublish(%s/%s): %w", p.name, key, err)
+	}
+	return p.DriverPublisher.Unpublish(key)
+}
+
+// Load load entire persisted data set into a map
+func (p *Publisher) Load() (map[string][]byte, int, error) {
+	p.log.Tracef("Load(%s)\n", p.name)
+	items, restartCounter := splitRestartCounter(p.log, p.name, p.store.Items())
+	return items, restartCounter, nil
+}
+
+// Restart indicate that the topic is restarted if counter is non-zero
+func (p *Publisher) Restart(restartCounter int) error {
+	var err error
+	if restartCounter != 0 {
+		err = p.store.Put(restartCounterKey, []byte(strconv.Itoa(restartCounter)))
+	} else {
+		err = p.store.Delete(restartCounterKey)
+	}
+	if err != nil {
+		return fmt.Errorf("pub.restartImpl(%s): %w", p.name, err)
+	}
+	return p.DriverPublisher.Restart(restartCounter)
+}
+
+// Stop the publisher
+func (p *Publisher) Stop() error {
+	err := p.DriverPublisher.Stop()
+	if closeErr := p.store.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// splitRestartCounter separates the restart counter from the published items.
+func splitRestartCounter(log *base.LogObject, name string,
+	items map[string][]byte) (map[string][]byte, int) {
+	var restartCounter int
+	if val, ok := items[restartCounterKey]; ok {
+		delete(items, restartCounterKey)
+		var err error
+		restartCounter, err = strconv.Atoi(string(val))
+		if err != nil {
+			log.Warnf("Load(%s): invalid restart counter %q; treat as 1",
+				name, val)
+			restartCounter = 1
+		}
+	}
+	return items, restartCounter
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// Store file layout:
+//
+//	header: magic (8 bytes)
+//	record: crc32c(payload) (4 bytes) | len(payload) (4 bytes) | payload
+//	payload: sequence of operations, each encoded as
+//	  opPut:    op (1 byte) | uvarint(len(key)) | key | uvarint(len(value)) | value
+//	  opDelete: op (1 byte) | uvarint(len(key)) | key
+//
+// Every committed Batch is written as a single record followed by fsync.
+// A record which is truncated or fails the checksum (torn write after power
+// loss) is ignored together with everything that follows, hence a Batch is
+// either applied as a whole or not at all.
+// Records are only ever appended. Once the file grows well beyond the size
+// of the live data, it is compacted into a new file with a single record,
+// which then atomically replaces the old file (write, fsync, rename).
+const (
+	storeMagic     = "EVEKV001"
+	recordHdrLen   = 8
+	maxRecordLen   = 1 << 30
+	opPut          = byte(1)
+	opDelete       = byte(2)
+	minCompactSize = 1 << 20 // Do not bother compacting files below 1MiB
+	compactRatio   = 2       // Compact once the file is twice the size of live data
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errCorrupted is returned by readRecord for a torn or otherwise corrupted record.
+var errCorrupted = errors.New("corrupted record")
+
+// Batch : set of changes to be committed into the Store atomically.
+type Batch struct {
+	ops []batchOp
+}
+
+type batchOp struct {
+	op    byte
+	key   string
+	value []byte
+}
+
+// Put adds (or overwrites) the key with the given value.
+func (b *Batch) Put(key string, value []byte) {
+	b.ops = append(b.ops, batchOp{op: opPut, key: key, value: value})
+}
+
+// Delete removes the key.
+func (b *Batch) Delete(key string) {
+	b.ops = append(b.ops, batchOp{op: opDelete, key: key})
+}
+
+// Len returns the number of operations in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+func (b *Batch) encode() []byte {
+	var buf bytes.Buffer
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, op := range b.ops {
+		buf.WriteByte(op.op)
+		n := binary.PutUvarint(lenBuf[:], uint64(len(op.key)))
+		buf.Write(lenBuf[:n])
+		buf.WriteString(op.key)
+		if op.op == opPut {
+			n = binary.PutUvarint(lenBuf[:], uint64(len(op.value)))
+			buf.Write(lenBuf[:n])
+			buf.Write(op.value)
+		}
+	}
+	payload := buf.Bytes()
+	record := make([]byte, recordHdrLen+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], crc32.Checksum(payload, crcTable))
+	binary.LittleEndian.PutUint32(record[4:8], uint32(len(payload)))
+	copy(record[recordHdrLen:], payload)
+	return record
+}
+
+// Store : embedded key-value store persisting all items of a publication
+// in a single append-only file.
+// All items are also kept in memory, the file is only read when opened.
+type Store struct {
+	sync.Mutex
+	path     string
+	file     *os.File
+	fileSize int64
+	liveSize int64
+	items    map[string][]byte
+}
+
+// OpenStore opens (or creates) the store file for reading and writing.
+// A torn record left at the end of the file by an interrupted commit
+// is truncated.
+func OpenStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := writeStoreFile(path, nil); err != nil {
+			return nil, err
+		}
+	}
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	items, validSize, err := readStore(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read store %s: %w", path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.Size() > validSize {
+		// Drop what is left of an interrupted commit.
+		if err := file.Truncate(validSize); err != nil {
+			file.Close()
+			return nil, err
+		}
+		if err := file.Sync(); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	if _, err := file.Seek(validSize, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	s := &Store{
+		path:     path,
+		file:     file,
+		fileSize: validSize,
+		items:    items,
+	}
+	s.liveSize = s.computeLiveSize()
+	return s, nil
+}
+
+// LoadStore reads all items from the store file without opening
+// it for writing. Used by subscribers to load the persisted state of
+// a publication owned by another process.
+func LoadStore(path string) (map[string][]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	items, _, err := readStore(file)
+	return items, err
+}
+
+// Get returns the value stored for the key.
+func (s *Store) Get(key string) ([]byte, bool) {
+	s.Lock()
+	defer s.Unlock()
+	val, ok := s.items[key]
+	return val, ok
+}
+
+// Items returns a copy of all items.
+func (s *Store) Items() map[string][]byte {
+	s.Lock()
+	defer s.Unlock()
+	items := make(map[string][]byte, len(s.items))
+	for key, val := range s.items {
+		items[key] = val
+	}
+	return items
+}
+
+// Put commits a single key-value pair.
+func (s *Store) Put(key string, value []byte) error {
+	var b Batch
+	b.Put(key, value)
+	return s.Commit(&b)
+}
+
+// Delete commits removal of a single key.
+func (s *Store) Delete(key string) error {
+	var b Batch
+	b.Delete(key)
+	return s.Commit(&b)
+}
+
+// Commit atomically applies all changes of the batch.
+// Once Commit returns without error, the changes are durable.
+func (s *Store) Commit(b *Batch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.file == nil {
+		return fmt.Errorf("store %s is closed", s.path)
+	}
+	record := b.encode()
+	if len(record)-recordHdrLen > maxRecordLen {
+		return fmt.Errorf("batch of size %d exceeds max %d",
+			len(record)-recordHdrLen, maxRecordLen)
+	}
+	if _, err := s.file.Write(record); err != nil {
+		// Do not leave a partial record behind (it would shadow
+		// all subsequent commits).
+		s.rollback()
+		return fmt.Errorf("failed to write into store %s: %w", s.path, err)
+	}
+	if err := s.file.Sync(); err != nil {
+		s.rollback()
+		return fmt.Errorf("failed to sync store %s: %w", s.path, err)
+	}
+	s.fileSize += int64(len(record))
+	for _, op := range b.ops {
+		s.apply(op)
+	}
+	if s.fileSize > minCompactSize && s.fileSize > compactRatio*s.liveSize {
+		// Not fatal, the file is just larger than it needs to be.
+		_ = s.compact()
+	}
+	return nil
+}
+
+// Close the store file.
+func (s *Store) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *Store) rollback() {
+	_ = s.file.Truncate(s.fileSize)
+	_, _ = s.file.Seek(s.fileSize, io.SeekStart)
+}
+
+func (s *Store) apply(op batchOp) {
+	if oldVal, exists := s.items[op.key]; exists {
+		s.liveSize -= itemSize(op.key, oldVal)
+	}
+	switch op.op {
+	case opPut:
+		s.items[op.key] = op.value
+		s.liveSize += itemSize(op.key, op.value)
+	case opDelete:
+		delete(s.items, op.key)
+	}
+}
+
+func (s *Store) computeLiveSize() int64 {
+	var size int64
+	for key, val := range s.items {
+		size += itemSize(key, val)
+	}
+	return size
+}
+
+// compact rewrites the store file to contain only the live items.
+func (s *Store) compact() error {
+	if err := writeStoreFile(s.path, s.items); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.path, os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	s.file.Close()
+	s.file = file
+	s.fileSize = size
+	return nil
+}
+
+// itemSize approximates the number of bytes an item takes in the store file.
+func itemSize(key string, value []byte) int64 {
+	return int64(1 + 2*binary.MaxVarintLen32 + len(key) + len(value))
+}
+
+// writeStoreFile atomically (re)creates the store file with the given items
+// stored in a single record.
+func writeStoreFile(path string, items map[string][]byte) error {
+	var b Batch
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		b.Put(key, items[key])
+	}
+	content := []byte(storeMagic)
+	if b.Len() > 0 {
+		content = append(content, b.encode()...)
+	}
+	// WriteRename does fsync of both the file and the directory.
+	return fileutils.WriteRename(path, content)
+}
+
+// readStore reads all valid records and returns the resulting set of items
+// together with the offset where the valid content ends.
+func readStore(file *os.File) (map[string][]byte, int64, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+	reader := bufio.NewReader(file)
+	magic := make([]byte, len(storeMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil {
+		return nil, 0, fmt.Errorf("failed to read header: %w", err)
+	}
+	if string(magic) != storeMagic {
+		return nil, 0, fmt.Errorf("unexpected header %q", magic)
+	}
+	items := make(map[string][]byte)
+	offset := int64(len(storeMagic))
+	for {
+		payload, err := readRecord(reader)
+		if err == io.EOF || err == errCorrupted {
+			return items, offset, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		ops, err := decodePayload(payload)
+		if err != nil {
+			// Checksum matched, yet the content is invalid.
+			// Treat as corrupted and stop here.
+			return items, offset, nil
+		}
+		for _, op := range ops {
+			switch op.op {
+			case opPut:
+				items[op.key] = op.value
+			case opDelete:
+				delete(items, op.key)
+			}
+		}
+		offset += int64(recordHdrLen + len(payload))
+	}
+}
+
+func readRecord(reader *bufio.Reader) ([]byte, error) {
+	var hdr [recordHdrLen]byte
+	n, err := io.ReadFull(reader, hdr[:])
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err == io.ErrUnexpectedEOF || n < recordHdrLen {
+		return nil, errCorrupted
+	}
+	if err != nil {
+		return nil, err
+	}
+	crc := binary.LittleEndian.Uint32(hdr[0:4])
+	length := binary.LittleEndian.Uint32(hdr[4:8])
+	if length > maxRecordLen {
+		return nil, errCorrupted
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errCorrupted
+		}
+		return nil, err
+	}
+	if crc32.Checksum(payload, crcTable) != crc {
+		return nil, errCorrupted
+	}
+	return payload, nil
+}
+
+func decodePayload(payload []byte) ([]batchOp, error) {
+	var ops []batchOp
+	reader := bytes.NewReader(payload)
+	readBytes := func() ([]byte, error) {
+		length, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(reader.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		buf := make([]byte, length)
+		_, err = io.ReadFull(reader, buf)
+		return buf, err
+	}
+	for reader.Len() > 0 {
+		op, _ := reader.ReadByte()
+		key, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opPut:
+			value, err := readBytes()
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, batchOp{op: op, key: string(key), value: value})
+		case opDelete:
+			ops = append(ops, batchOp{op: op, key: string(key)})
+		default:
+			return nil, fmt.Errorf("unknown operation %d", op)
+		}
+	}
+	return ops, nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kvstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sub", "test.kv")
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, store.Items())
+	assert.NoError(t, store.Put("key1", []byte("value1")))
+	assert.NoError(t, store.Put("key2", []byte("value2")))
+	assert.NoError(t, store.Put("key1", []byte("value1-modified")))
+	assert.NoError(t, store.Delete("key2"))
+	var batch Batch
+	batch.Put("key3", []byte("value3"))
+	batch.Put("key4", []byte("value4"))
+	batch.Delete("key3")
+	assert.NoError(t, store.Commit(&batch))
+	assert.NoError(t, store.Close())
+
+	expected := map[string][]byte{
+		"key1": []byte("value1-modified"),
+		"key4": []byte("value4"),
+	}
+	items, err := LoadStore(path)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, items)
+
+	store, err = OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, store.Items())
+	val, found := store.Get("key4")
+	assert.True(t, found)
+	assert.Equal(t, []byte("value4"), val)
+	assert.NoError(t, store.Close())
+}
+
+func TestStoreTornWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kvstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.kv")
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, store.Put("key1", []byte("value1")))
+	assert.NoError(t, store.Close())
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validSize := info.Size()
+
+	// Simulate power loss in the middle of committing a batch.
+	var batch Batch
+	batch.Put("key1", []byte("value1-modified"))
+	batch.Put("key2", []byte("value2"))
+	record := batch.encode()
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = file.Write(record[:len(record)-3])
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	// Batch should be ignored as a whole.
+	items, err := LoadStore(path)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"key1": []byte("value1")}, items)
+
+	// Torn record should be truncated when opened for writing.
+	store, err = OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err = os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, validSize, info.Size())
+	assert.NoError(t, store.Put("key2", []byte("value2")))
+	assert.NoError(t, store.Close())
+
+	// Corrupted (not just truncated) record should be ignored as well.
+	file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record[len(record)-1] ^= 0xff
+	_, err = file.Write(record)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+	items, err = LoadStore(path)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	}, items)
+}
+
+func TestStoreCompaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kvstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.kv")
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := make([]byte, 4096)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("key%d", i%10)
+		value[0] = byte(i)
+		assert.NoError(t, store.Put(key, value))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without compaction the file would have over 4MB.
+	assert.Less(t, info.Size(), int64(minCompactSize+len(value)))
+	assert.Len(t, store.Items(), 10)
+	assert.NoError(t, store.Close())
+
+	items, err := LoadStore(path)
+	assert.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, byte(999%256), items["key9"][0])
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package kvdriver
+
+import (
+	"os"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// Subscriber implementation of `pubsub.DriverSubscriber` for `KVDriver`.
+// Updates are received from the embedded socketdriver subscriber,
+// only the initial Load is done from the Store.
+type Subscriber struct {
+	pubsub.DriverSubscriber
+	name      string
+	storePath string
+	log       *base.LogObject
+}
+
+// Load load entire persisted data set into a map
+func (s *Subscriber) Load() (map[string][]byte, int, error) {
+	s.log.Tracef("Load(%s)\n", s.name)
+	if _, err := os.Stat(s.storePath); os.IsNotExist(err) {
+		// Publisher has not migrated the publication yet.
+		return s.DriverSubscriber.Load()
+	}
+	items, err := LoadStore(s.storePath)
+	if err != nil {
+		s.log.Error(err)
+		return make(map[string][]byte), 0, err
+	}
+	items, restartCounter := splitRestartCounter(s.log, s.name, items)
+	return items, restartCounter, nil
+}
@@ -6,7 +6,7 @@ import (
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
-	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/kvdriver"
 	"github.com/sirupsen/logrus"
 )
 
@@ -63,6 +63,6 @@ func PublishPersistent(agentName string, topicType interface{}) (pubsub.Publicat
 func initialize(agentName string) {
 	logger = logrus.New()
 	log = base.NewSourceLogObject(logger, agentName, os.Getpid())
-	defaultPubsub = pubsub.New(&socketdriver.SocketDriver{Log: log},
+	defaultPubsub = pubsub.New(&kvdriver.KVDriver{Log: log},
 		logger, log)
 }
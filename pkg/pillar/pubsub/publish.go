@@ -53,6 +53,7 @@ type PublicationImpl struct {
 	defaultName string
 	updaterList *Updaters
 	persistent  bool
+	history     *changeHistory // nil if not enabled
 	logger      *logrus.Logger
 	log         *base.LogObject
 
@@ -111,7 +112,8 @@ func (pub *PublicationImpl) Publish(key string, item interface{}) error {
 	}
 	// Perform a deepCopy in case the caller might change a map etc
 	newItem := deepCopy(pub.log, item)
-	if m, ok := pub.km.key.Load(key); ok {
+	m, exists := pub.km.key.Load(key)
+	if exists {
 		if cmp.Equal(m, newItem) {
 			pub.log.Tracef("Publish(%s/%s) unchanged\n", name, key)
 			return nil
@@ -133,6 +135,7 @@ func (pub *PublicationImpl) Publish(key string, item interface{}) error {
 		}
 	}
 	pub.km.key.Store(key, newItem)
+	pub.history.record(key, m, newItem)
 
 	if pub.logger.GetLevel() == logrus.TraceLevel {
 		pub.dump("after Publish")
@@ -152,7 +155,8 @@ func (pub *PublicationImpl) Publish(key string, item interface{}) error {
 // Unpublish delete a key from the key-value map
 func (pub *PublicationImpl) Unpublish(key string) error {
 	name := pub.nameString()
-	if m, ok := pub.km.key.Load(key); ok {
+	m, exists := pub.km.key.Load(key)
+	if exists {
 		// DO NOT log Values. They may contain sensitive information.
 		pub.log.Tracef("Unpublish(%s/%s) removing Item", name, key)
 		loggable, ok := m.(base.LoggableObject)
@@ -166,6 +170,7 @@ func (pub *PublicationImpl) Unpublish(key string) error {
 		return errors.New(errStr)
 	}
 	pub.km.key.Delete(key)
+	pub.history.record(key, m, nil)
 	if pub.logger.GetLevel() == logrus.TraceLevel {
 		pub.dump("after Unpublish")
 	}
@@ -216,6 +221,11 @@ func (pub *PublicationImpl) Iterate(function base.StrMapFunc) {
 	pub.km.key.Range(function)
 }
 
+// History returns recorded changes, ordered from the oldest to the most recent.
+func (pub *PublicationImpl) History() []HistoryEvent {
+	return pub.history.get()
+}
+
 // Close the publisher
 func (pub *PublicationImpl) Close() error {
 	items := pub.GetAll()
@@ -227,6 +237,7 @@ func (pub *PublicationImpl) Close() error {
 		}
 	}
 	pub.ClearRestarted()
+	pub.history.flush()
 	pub.driver.Stop()
 	return nil
 }
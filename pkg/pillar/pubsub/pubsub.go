@@ -8,6 +8,7 @@ package pubsub
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"time"
 
@@ -31,6 +32,9 @@ type SubscriptionOptions struct {
 	Ctx            interface{}
 	Persistent     bool
 	MyAgentName    string // For logging
+	// HistorySize is the number of most recent changes to keep in memory
+	// (see Subscription.History). Zero disables the change history.
+	HistorySize int
 }
 
 // SubCreateHandler is a handler to handle creates
@@ -129,6 +133,9 @@ func (p *PubSub) NewSubscription(options SubscriptionOptions) (Subscription, err
 		return sub, err
 	}
 	sub.driver = driver
+	if options.HistorySize > 0 {
+		sub.history = newChangeHistory(p.log, options.HistorySize, "")
+	}
 
 	sub.log.Functionf("Subscribe(%s)\n", name)
 	if options.Activate {
@@ -149,6 +156,11 @@ type PublicationOptions struct {
 	AgentScope string
 	TopicType  interface{}
 	Persistent bool
+	// HistorySize is the number of most recent changes to keep in memory
+	// (see Publication.History). For debugging purposes the history is also
+	// dumped into a file, at most once per 30 seconds and when the publication
+	// is closed. Zero disables the change history.
+	HistorySize int
 }
 
 // NewPublication creates a new Publication with given options
@@ -186,6 +198,13 @@ func (p *PubSub) NewPublication(options PublicationOptions) (Publication, error)
 		return pub, err
 	}
 	pub.driver = driver
+	if options.HistorySize > 0 {
+		var dumpFile string
+		if dir := driver.HistoryDirName(); dir != "" {
+			dumpFile = filepath.Join(dir, name, historyDumpFile)
+		}
+		pub.history = newChangeHistory(p.log, options.HistorySize, dumpFile)
+	}
 
 	pub.populate()
 	if pub.logger.GetLevel() == logrus.TraceLevel {
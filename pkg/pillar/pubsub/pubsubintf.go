@@ -27,6 +27,9 @@ type Publication interface {
 	GetAll() map[string]interface{}
 	// Iterate - Perform some action on all items
 	Iterate(function base.StrMapFunc)
+	// History returns recorded changes, ordered from the oldest to the most
+	// recent. Empty unless enabled by PublicationOptions.HistorySize.
+	History() []HistoryEvent
 	// Close - delete the publisher
 	Close() error
 }
@@ -39,6 +42,9 @@ type Subscription interface {
 	GetAll() map[string]interface{}
 	// Iterate - Perform some action on all items
 	Iterate(function base.StrMapFunc)
+	// History returns recorded changes, ordered from the oldest to the most
+	// recent. Empty unless enabled by SubscriptionOptions.HistorySize.
+	History() []HistoryEvent
 	// Restarted report if this subscription has been marked as restarted
 	Restarted() bool
 	// RestartCounter reports how many times this subscription has been restarted
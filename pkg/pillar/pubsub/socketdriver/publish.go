@@ -273,6 +273,11 @@ func (s *Publisher) LargeDirName() string {
 	return fmt.Sprintf("%s/persist/pubsub-large", s.rootDir)
 }
 
+// HistoryDirName where to dump change history
+func (s *Publisher) HistoryDirName() string {
+	return fmt.Sprintf("%s/run/pubsub-history", s.rootDir)
+}
+
 func (s *Publisher) serveConnection(conn net.Conn, instance int) {
 	s.log.Functionf("serveConnection(%s/%d)\n", s.name, instance)
 	defer conn.Close()
@@ -41,6 +41,7 @@ type SubscriptionImpl struct {
 	log          *base.LogObject
 	myAgentName  string // For logging
 	ps           *PubSub
+	history      *changeHistory // nil if not enabled
 }
 
 // MsgChan return the Message Channel for the Subscription.
@@ -163,6 +164,11 @@ func (sub *SubscriptionImpl) Iterate(function base.StrMapFunc) {
 	sub.km.key.Range(function)
 }
 
+// History - Get recorded changes, ordered from the oldest to the most recent
+func (sub *SubscriptionImpl) History() []HistoryEvent {
+	return sub.history.get()
+}
+
 // Restarted - Check if the Publisher has Restarted
 func (sub *SubscriptionImpl) Restarted() bool {
 	return sub.km.restartCounter != 0
@@ -259,6 +265,7 @@ func handleModify(ctxArg interface{}, key string, itemcb []byte) {
 		}
 	}
 	sub.km.key.Store(key, item)
+	sub.history.record(key, m, item)
 	if sub.logger.GetLevel() == logrus.TraceLevel {
 		sub.dump("after handleModify")
 	}
@@ -294,6 +301,7 @@ func handleDelete(ctxArg interface{}, key string) {
 	// DO NOT log Values. They may contain sensitive information.
 	sub.log.Tracef("pubsub.handleDelete(%s) key %s", name, key)
 	sub.km.key.Delete(key)
+	sub.history.record(key, m, nil)
 	if sub.logger.GetLevel() == logrus.TraceLevel {
 		sub.dump("after handleDelete")
 	}
@@ -178,6 +178,38 @@ access_usb() {
             fi
             sync
         fi
+        if [ -d /mnt/export ] && [ -d "$PERSISTDIR/appexport" ]; then
+            echo "$(date -Ins -u) Copying app archives to USB stick"
+            for dir in "$PERSISTDIR"/appexport/*; do
+                # skip archives which are still written
+                [ -f "$dir/manifest.json" ] || continue
+                app=$(basename "$dir")
+                if tar cf "/mnt/export/$app.tar.tmp" -C "$PERSISTDIR/appexport" "$app"; then
+                    mv "/mnt/export/$app.tar.tmp" "/mnt/export/$app.tar"
+                else
+                    rm -f "/mnt/export/$app.tar.tmp"
+                fi
+            done
+            sync
+        fi
+        if [ -d /mnt/import ]; then
+            mkdir -p "$PERSISTDIR/appimport"
+            for file in /mnt/import/*.tar; do
+                [ -f "$file" ] || continue
+                echo "$(date -Ins -u) Importing app archive $file from USB stick"
+                # volumemgr verifies the signature of the archive
+                if tar xf "$file" -C "$PERSISTDIR/appimport"; then
+                    mv "$file" "$file.imported"
+                fi
+            done
+            sync
+        fi
+        if [ -f /mnt/attest-policy.json ]; then
+            echo "$(date -Ins -u) Found attestation policy on USB stick"
+            # zedagent verifies the signature and the serial number of the policy
+            mkdir -p "$PERSISTDIR/attest"
+            cp -p /mnt/attest-policy.json "$PERSISTDIR/attest/policy.incoming.json"
+        fi
         umount -f /mnt
         blockdev --flushbufs "$SPECIAL"
     fi
@@ -0,0 +1,293 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default values of the AppHealthConfig
+const (
+	DefaultAppProbePeriod           = 10 * time.Second
+	DefaultAppProbeTimeout          = time.Second
+	DefaultAppProbeFailureThreshold = 3
+	DefaultAppRestartBackoffMax     = 5 * time.Minute
+	// MaxAppProbeHistory is the number of results of a probe kept in
+	// its AppProbeStatus
+	MaxAppProbeHistory = 10
+)
+
+// AppProbeType is the kind of a health probe of an app instance
+type AppProbeType uint8
+
+// AppProbeNone and other values for AppProbeType
+const (
+	// AppProbeNone : no probe
+	AppProbeNone AppProbeType = iota
+	// AppProbeHTTP : HTTP GET of a path
+	AppProbeHTTP
+	// AppProbeTCP : TCP connection to a port
+	AppProbeTCP
+	// AppProbeExec : command run inside the container task
+	AppProbeExec
+	// AppProbeAgent : ping of the guest agent of a VM
+	AppProbeAgent
+)
+
+// String returns the name of the probe type
+func (probeType AppProbeType) String() string {
+	switch probeType {
+	case AppProbeNone:
+		return "none"
+	case AppProbeHTTP:
+		return "http"
+	case AppProbeTCP:
+		return "tcp"
+	case AppProbeExec:
+		return "exec"
+	case AppProbeAgent:
+		return "agent"
+	default:
+		return fmt.Sprintf("Unknown AppProbeType %d", probeType)
+	}
+}
+
+// AppProbe is a health probe which EVE runs from the host against an app
+// instance
+type AppProbe struct {
+	Type AppProbeType
+	// Host to connect to for the HTTP and TCP probes. When empty in the
+	// AppInstanceConfig, zedmanager fills in the IP address of the app
+	// instance in the DomainConfig.
+	Host string
+	Port uint16
+	// Path of the HTTP probe
+	Path string
+	// Command of the exec probe and its arguments
+	Command []string
+}
+
+// ParseAppProbe parses a probe in the format of the API, e.g.
+// "http://:8080/healthz", "tcp://:5432", "exec:pg_isready -q" or "agent:".
+// An empty string is no probe.
+func ParseAppProbe(probe string) (AppProbe, error) {
+	switch {
+	case probe == "":
+		return AppProbe{}, nil
+	case probe == "agent:":
+		return AppProbe{Type: AppProbeAgent}, nil
+	case strings.HasPrefix(probe, "exec:"):
+		command := strings.Fields(strings.TrimPrefix(probe, "exec:"))
+		if len(command) == 0 {
+			return AppProbe{}, fmt.Errorf("probe %s has no command", probe)
+		}
+		return AppProbe{Type: AppProbeExec, Command: command}, nil
+	}
+	u, err := url.Parse(probe)
+	if err != nil {
+		return AppProbe{}, fmt.Errorf("malformed probe %s: %v", probe, err)
+	}
+	var parsed AppProbe
+	switch u.Scheme {
+	case "http":
+		parsed.Type = AppProbeHTTP
+		parsed.Path = u.EscapedPath()
+		if parsed.Path == "" {
+			parsed.Path = "/"
+		}
+		if u.RawQuery != "" {
+			parsed.Path += "?" + u.RawQuery
+		}
+	case "tcp":
+		parsed.Type = AppProbeTCP
+		if u.Path != "" && u.Path != "/" {
+			return AppProbe{}, fmt.Errorf("TCP probe %s has a path", probe)
+		}
+	default:
+		return AppProbe{}, fmt.Errorf("unsupported probe %s", probe)
+	}
+	port, err := strconv.ParseUint(u.Port(), 10, 16)
+	if err != nil || port == 0 {
+		return AppProbe{}, fmt.Errorf("probe %s has no valid port", probe)
+	}
+	parsed.Port = uint16(port)
+	parsed.Host = u.Hostname()
+	return parsed, nil
+}
+
+// String returns the probe in the format of the API
+func (probe AppProbe) String() string {
+	hostPort := net.JoinHostPort(probe.Host, strconv.Itoa(int(probe.Port)))
+	switch probe.Type {
+	case AppProbeHTTP:
+		return "http://" + hostPort + probe.Path
+	case AppProbeTCP:
+		return "tcp://" + hostPort
+	case AppProbeExec:
+		return "exec:" + strings.Join(probe.Command, " ")
+	case AppProbeAgent:
+		return "agent:"
+	default:
+		return ""
+	}
+}
+
+// AppRestartPolicy is what EVE does when an app instance fails
+type AppRestartPolicy uint8
+
+// AppRestartNever and other values for AppRestartPolicy
+const (
+	// AppRestartNever : the app instance is not restarted automatically
+	AppRestartNever AppRestartPolicy = iota
+	// AppRestartOnFailure : the app instance is restarted when its liveness
+	// probe fails or its domain reports an error
+	AppRestartOnFailure
+	// AppRestartAlways : the app instance is also restarted when it halts
+	// by itself
+	AppRestartAlways
+)
+
+// ParseAppRestartPolicy parses a restart policy in the format of the API
+func ParseAppRestartPolicy(policy string) (AppRestartPolicy, error) {
+	switch policy {
+	case "", "never":
+		return AppRestartNever, nil
+	case "on-failure":
+		return AppRestartOnFailure, nil
+	case "always":
+		return AppRestartAlways, nil
+	default:
+		return AppRestartNever, fmt.Errorf("unsupported restart policy %s", policy)
+	}
+}
+
+// String returns the restart policy in the format of the API
+func (policy AppRestartPolicy) String() string {
+	switch policy {
+	case AppRestartNever:
+		return "never"
+	case AppRestartOnFailure:
+		return "on-failure"
+	case AppRestartAlways:
+		return "always"
+	default:
+		return fmt.Sprintf("Unknown AppRestartPolicy %d", policy)
+	}
+}
+
+// AppHealthConfig holds the health probes of an app instance and what to do
+// when it fails. Zero values stand for the defaults.
+type AppHealthConfig struct {
+	Liveness          AppProbe
+	Readiness         AppProbe
+	InitialDelay      time.Duration
+	Period            time.Duration
+	Timeout           time.Duration
+	FailureThreshold  int
+	RestartPolicy     AppRestartPolicy
+	RestartBackoffMax time.Duration
+}
+
+// HasProbes returns true if the app instance has any probe
+func (config AppHealthConfig) HasProbes() bool {
+	return config.Liveness.Type != AppProbeNone ||
+		config.Readiness.Type != AppProbeNone
+}
+
+// ProbePeriod returns the interval between two runs of a probe
+func (config AppHealthConfig) ProbePeriod() time.Duration {
+	if config.Period == 0 {
+		return DefaultAppProbePeriod
+	}
+	return config.Period
+}
+
+// ProbeTimeout returns how long a run of a probe may take
+func (config AppHealthConfig) ProbeTimeout() time.Duration {
+	if config.Timeout == 0 {
+		return DefaultAppProbeTimeout
+	}
+	return config.Timeout
+}
+
+// ProbeFailureThreshold returns the number of consecutive failures after
+// which a probe is failed
+func (config AppHealthConfig) ProbeFailureThreshold() int {
+	if config.FailureThreshold == 0 {
+		return DefaultAppProbeFailureThreshold
+	}
+	return config.FailureThreshold
+}
+
+// MaxRestartBackoff returns the maximum delay between two automatic
+// restarts
+func (config AppHealthConfig) MaxRestartBackoff() time.Duration {
+	if config.RestartBackoffMax == 0 {
+		return DefaultAppRestartBackoffMax
+	}
+	return config.RestartBackoffMax
+}
+
+// AppProbeResult is the result of a run of a probe
+type AppProbeResult struct {
+	Time    time.Time
+	Success bool
+	Error   string
+}
+
+// AppProbeStatus is the state of a probe of an app instance, reported by
+// domainmgr in the DomainStatus and copied by zedmanager into the
+// AppInstanceStatus
+type AppProbeStatus struct {
+	// Probe in the format of the API, empty if there is no probe
+	Probe string
+	// Passing is set when the probe succeeded, and cleared when it failed
+	// FailureThreshold times in a row
+	Passing bool
+	// Failed is set when the probe failed FailureThreshold times in a row
+	Failed              bool
+	ConsecutiveFailures int
+	// History holds the latest MaxAppProbeHistory results, oldest first
+	History []AppProbeResult
+}
+
+// Record adds the result of a run of the probe
+func (status *AppProbeStatus) Record(result AppProbeResult, failureThreshold int) {
+	status.History = append(status.History, result)
+	if len(status.History) > MaxAppProbeHistory {
+		status.History = status.History[len(status.History)-MaxAppProbeHistory:]
+	}
+	if result.Success {
+		status.ConsecutiveFailures = 0
+		status.Passing = true
+		status.Failed = false
+		return
+	}
+	status.ConsecutiveFailures++
+	if status.ConsecutiveFailures >= failureThreshold {
+		status.Passing = false
+		status.Failed = true
+	}
+}
+
+// Reset forgets the state of the probe, but not its history, when the app
+// instance boots
+func (status *AppProbeStatus) Reset() {
+	status.Passing = false
+	status.Failed = false
+	status.ConsecutiveFailures = 0
+}
+
+// LastError returns the error of the latest run of the probe
+func (status AppProbeStatus) LastError() string {
+	if len(status.History) == 0 {
+		return ""
+	}
+	return status.History[len(status.History)-1].Error
+}
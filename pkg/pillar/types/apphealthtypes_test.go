@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAppProbe(t *testing.T) {
+	testMatrix := map[string]struct {
+		probe    string
+		expected AppProbe
+		err      bool
+	}{
+		"Empty": {
+			probe:    "",
+			expected: AppProbe{},
+		},
+		"HTTP without host": {
+			probe:    "http://:8080/healthz",
+			expected: AppProbe{Type: AppProbeHTTP, Port: 8080, Path: "/healthz"},
+		},
+		"HTTP with host and query": {
+			probe: "http://10.1.0.2:80/status?full=1",
+			expected: AppProbe{Type: AppProbeHTTP, Host: "10.1.0.2", Port: 80,
+				Path: "/status?full=1"},
+		},
+		"HTTP without path": {
+			probe:    "http://:80",
+			expected: AppProbe{Type: AppProbeHTTP, Port: 80, Path: "/"},
+		},
+		"TCP": {
+			probe:    "tcp://:5432",
+			expected: AppProbe{Type: AppProbeTCP, Port: 5432},
+		},
+		"Exec": {
+			probe: "exec:pg_isready -q",
+			expected: AppProbe{Type: AppProbeExec,
+				Command: []string{"pg_isready", "-q"}},
+		},
+		"Agent": {
+			probe:    "agent:",
+			expected: AppProbe{Type: AppProbeAgent},
+		},
+		"TCP without port": {
+			probe: "tcp://10.1.0.2",
+			err:   true,
+		},
+		"Exec without command": {
+			probe: "exec: ",
+			err:   true,
+		},
+		"Unsupported scheme": {
+			probe: "udp://:53",
+			err:   true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		probe, err := ParseAppProbe(test.probe)
+		if test.err {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, probe)
+		if test.probe != "" && probe.Host != "" {
+			assert.Equal(t, test.probe, probe.String())
+		}
+	}
+}
+
+func TestAppProbeStatusRecord(t *testing.T) {
+	var status AppProbeStatus
+	now := time.Now()
+	status.Record(AppProbeResult{Time: now, Error: "refused"}, 2)
+	assert.False(t, status.Passing)
+	assert.False(t, status.Failed)
+	status.Record(AppProbeResult{Time: now, Success: true}, 2)
+	assert.True(t, status.Passing)
+	status.Record(AppProbeResult{Time: now, Error: "refused"}, 2)
+	assert.True(t, status.Passing)
+	assert.False(t, status.Failed)
+	status.Record(AppProbeResult{Time: now, Error: "timeout"}, 2)
+	assert.False(t, status.Passing)
+	assert.True(t, status.Failed)
+	assert.Equal(t, "timeout", status.LastError())
+	for i := 0; i < MaxAppProbeHistory; i++ {
+		status.Record(AppProbeResult{Time: now, Success: true}, 2)
+	}
+	assert.Len(t, status.History, MaxAppProbeHistory)
+	assert.Equal(t, 0, status.ConsecutiveFailures)
+	assert.False(t, status.Failed)
+}
@@ -42,6 +42,7 @@ type BlobStatus struct {
 	LastRefCountChangeTime time.Time
 	TotalSize              int64 // expected size as reported by the downloader, if any
 	CurrentSize            int64 // current total downloaded size as reported by the downloader
+	ReusedSize             int64 // size taken from chunks of local blobs, not included in TotalSize
 	// Progress percentage downloaded 0-100, defined by CurrentSize/TotalSize
 	Progress uint
 	// ErrorAndTimeWithSource provide common error handling capabilities
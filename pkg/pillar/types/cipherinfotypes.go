@@ -144,4 +144,7 @@ type EncryptionBlock struct {
 	WifiUserName      string // If the authentication type is EAP
 	WifiPassword      string
 	ProtectedUserData string
+	// Secrets of VXLAN network instance.
+	IPsecPresharedKey   string
+	WireGuardPrivateKey string
 }
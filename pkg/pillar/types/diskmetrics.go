@@ -190,6 +190,15 @@ type ImgInfo struct {
 	Format      string `json:"format"`
 	ActualSize  uint64 `json:"actual-size"`
 	DirtyFlag   bool   `json:"dirty-flag"`
+	// Snapshots are the internal snapshots of a qcow2 image
+	Snapshots []ImgSnapshotInfo `json:"snapshots"`
+}
+
+// ImgSnapshotInfo matches the json output of qemu-img info for a snapshot
+type ImgSnapshotInfo struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	DateSec int64  `json:"date-sec"`
 }
 
 // UsageStat stores usage information about directory
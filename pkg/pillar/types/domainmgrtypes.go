@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -52,6 +53,14 @@ type DomainConfig struct {
 	// once the version is changed cloud-init tool restarts in a guest.
 	// See getCloudInitVersion() and createCloudInitISO() for details.
 	CloudInitVersion uint32
+
+	// HealthConfig holds the health probes run by domainmgr, with the
+	// host of the HTTP and TCP probes filled in by zedmanager
+	HealthConfig AppHealthConfig
+
+	// GuestFsFreeze is set by zedmanager while the volumes of the running
+	// domain are snapshotted, for its guest agent to freeze its filesystems
+	GuestFsFreeze bool
 }
 
 // MetaDataType of metadata service for app
@@ -225,6 +234,40 @@ type VmConfig struct {
 	VncPasswd          string
 	DisableLogs        bool
 	CPUsPinned         bool
+	// EnableGuestAgent attaches a channel to the QEMU guest agent
+	EnableGuestAgent bool
+	// CPUWeight is the relative weight of the CPU time from 1 to 10000,
+	// 0 for the default weight
+	CPUWeight uint32
+	// PidsMax limits the number of processes and threads, 0 for no limit
+	PidsMax uint32
+}
+
+// ResizableTo returns true if a running domain can go from this VmConfig
+// to the new one without a restart, i.e. if at most Memory and VCpus
+// changed and they stay within MaxMem and MaxCpus. The memory of a domain
+// can only change if MaxMem is set, and its vCPUs if MaxCpus is set and
+// they are not pinned. CPUs is ignored since domainmgr assigns it.
+func (config VmConfig) ResizableTo(newConfig VmConfig) bool {
+	if config.VirtualizationMode == NOHYPER &&
+		(newConfig.Memory != config.Memory || newConfig.VCpus != config.VCpus) {
+		// containers have neither a balloon nor vCPUs
+		return false
+	}
+	if newConfig.Memory != config.Memory &&
+		(newConfig.MaxMem == 0 || newConfig.Memory <= 0 ||
+			newConfig.Memory > newConfig.MaxMem) {
+		return false
+	}
+	if newConfig.VCpus != config.VCpus &&
+		(newConfig.MaxCpus == 0 || newConfig.CPUsPinned ||
+			newConfig.VCpus <= 0 || newConfig.VCpus > newConfig.MaxCpus) {
+		return false
+	}
+	config.Memory = newConfig.Memory
+	config.VCpus = newConfig.VCpus
+	config.CPUs = newConfig.CPUs
+	return cmp.Equal(config, newConfig)
 }
 
 type VmMode uint8
@@ -248,6 +291,12 @@ type Task interface {
 	Delete(string) error
 	Info(string) (int, SwState, error)
 	Cleanup(string) error
+	// SetMemory sets the balloon target of a running domain in kbytes,
+	// which cannot exceed the MaxMem it was started with
+	SetMemory(string, int) error
+	// SetVCpus hot-adds or removes vCPUs of a running domain, up to the
+	// MaxCpus it was started with
+	SetVCpus(string, int) error
 }
 
 type DomainStatus struct {
@@ -280,6 +329,41 @@ type DomainStatus struct {
 	EnvVariables   map[string]string // List of environment variables to be set in container
 	VmConfig                         // From DomainConfig
 	Service        bool
+	// Liveness and Readiness are the states of the health probes of the
+	// domain since it booted
+	Liveness  AppProbeStatus
+	Readiness AppProbeStatus
+	// GuestInfo is reported by the guest agent of the domain
+	GuestInfo GuestInfo
+	// GuestFsFreeze is the latest GuestFsFreeze of the DomainConfig
+	// handled by domainmgr, and GuestFsFrozen is set if the guest agent
+	// froze the filesystems. GuestFsFrozenUntil is when domainmgr thaws
+	// them at the latest, should GuestFsFreeze not be cleared before.
+	GuestFsFreeze      bool
+	GuestFsFrozen      bool
+	GuestFsFrozenUntil time.Time
+	// BalloonTarget is the memory in kbytes the running domain is asked
+	// to use. It is below Memory while domainmgr reclaims memory from the
+	// domain because the host is low on memory.
+	BalloonTarget int
+	// ResizeError is set if the memory or vCPUs of the running domain
+	// could not be changed to those of the DomainConfig
+	ResizeError string
+	// IoLimitsError lists the disks whose I/O limits could not be applied
+	// when the domain was started
+	IoLimitsError string
+}
+
+// GuestInfo is what the guest agent of a domain reports about the guest
+type GuestInfo struct {
+	// Connected is set if the guest agent answered the latest query
+	Connected     bool
+	OSName        string
+	OSVersion     string
+	KernelRelease string
+	Hostname      string
+	// IPAddrs are the addresses of all interfaces but the loopback
+	IPAddrs []net.IP
 }
 
 func (status DomainStatus) Key() string {
@@ -401,6 +485,7 @@ type DiskConfig struct {
 	WWN          string
 	Target       zconfig.Target
 	CustomMeta   string
+	IoLimits     VolumeIoLimits
 }
 
 type DiskStatus struct {
@@ -414,6 +499,7 @@ type DiskStatus struct {
 	Vdev         string // Allocated
 	WWN          string
 	CustomMeta   string
+	IoLimits     VolumeIoLimits // From DiskConfig
 }
 
 // DomainMetric carries CPU and memory usage. UUID=devUUID for the dom0/host metrics overhead
@@ -428,6 +514,15 @@ type DomainMetric struct {
 	UsedMemoryPercent float64
 	LastHeard         time.Time
 	Activated         bool
+	// Counters of the cgroup of the domain, 0 if not known
+	CPUThrottledPeriods uint64
+	CPUThrottledNs      uint64
+	PidsCurrent         uint64
+	PidsLimit           uint64
+	IoReadBytes         uint64
+	IoWriteBytes        uint64
+	IoReadOps           uint64
+	IoWriteOps          uint64
 }
 
 // Key returns the key for pubsub
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVmConfigResizableTo(t *testing.T) {
+	running := VmConfig{
+		Memory:             1024 * 1024,
+		MaxMem:             4 * 1024 * 1024,
+		VCpus:              2,
+		MaxCpus:            4,
+		CPUs:               "1,2",
+		VirtualizationMode: HVM,
+	}
+	testMatrix := map[string]struct {
+		change    func(*VmConfig)
+		resizable bool
+	}{
+		"Unchanged": {
+			change:    func(c *VmConfig) { c.CPUs = "" },
+			resizable: true,
+		},
+		"Grow memory": {
+			change:    func(c *VmConfig) { c.Memory = 2 * 1024 * 1024 },
+			resizable: true,
+		},
+		"Memory above MaxMem": {
+			change:    func(c *VmConfig) { c.Memory = 8 * 1024 * 1024 },
+			resizable: false,
+		},
+		"Memory without MaxMem": {
+			change: func(c *VmConfig) {
+				c.Memory = 2 * 1024 * 1024
+				c.MaxMem = 0
+			},
+			resizable: false,
+		},
+		"Add vCPUs": {
+			change:    func(c *VmConfig) { c.VCpus = 4 },
+			resizable: true,
+		},
+		"Remove vCPUs": {
+			change:    func(c *VmConfig) { c.VCpus = 1 },
+			resizable: true,
+		},
+		"vCPUs above MaxCpus": {
+			change:    func(c *VmConfig) { c.VCpus = 5 },
+			resizable: false,
+		},
+		"Pinned vCPUs": {
+			change: func(c *VmConfig) {
+				c.VCpus = 3
+				c.CPUsPinned = true
+			},
+			resizable: false,
+		},
+		"Other change": {
+			change: func(c *VmConfig) {
+				c.VCpus = 3
+				c.ExtraArgs = "console=ttyS0"
+			},
+			resizable: false,
+		},
+		"Container": {
+			change: func(c *VmConfig) {
+				c.VirtualizationMode = NOHYPER
+				c.Memory = 2 * 1024 * 1024
+			},
+			resizable: false,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := running
+		test.change(&config)
+		old := running
+		old.VirtualizationMode = config.VirtualizationMode
+		assert.Equal(t, test.resizable, old.ResizableTo(config), testname)
+	}
+}
@@ -108,6 +108,8 @@ type DownloaderStatus struct {
 	Size          uint64    // Once DOWNLOADED; in bytes
 	TotalSize     int64     // expected size as reported by the downloader, if any
 	CurrentSize   int64     // current total downloaded size as reported by the downloader
+	ResumedOffset int64     // size downloaded before the last (re)start, which was not downloaded again
+	ReusedSize    int64     // size taken from chunks of blobs present locally, not included in TotalSize
 	Progress      uint      // In percent i.e., 0-100, given by CurrentSize/ExpectedSize
 	ModTime       time.Time
 	ContentType   string // content-type header, if provided
@@ -224,3 +226,11 @@ type DatastoreContext struct {
 	Password        string
 	Region          string
 }
+
+const (
+	// P2PServiceType is the mDNS service type used by downloader to advertise
+	// content which can be downloaded by other edge nodes on the same network.
+	P2PServiceType = "_eve-content._tcp"
+	// P2PPort is the TCP port of the HTTPS endpoint serving the content.
+	P2PPort = 8444
+)
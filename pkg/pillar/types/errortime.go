@@ -74,6 +74,8 @@ const (
 	ErrorEntityContentBlob ErrorEntityType = 10
 	// ErrorEntityVolume Entity
 	ErrorEntityVolume ErrorEntityType = 11
+	// ErrorEntityDisk Entity
+	ErrorEntityDisk ErrorEntityType = 12
 )
 
 // ErrorEntity contains the device entity details
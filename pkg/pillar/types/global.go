@@ -201,6 +201,12 @@ const (
 	// Dom0DiskUsageMaxBytes - Max disk usage for Dom0. Dom0 can use
 	//  Dom0MinDiskUsagePercent up to a max of  Dom0DiskUsageMaxBytes
 	Dom0DiskUsageMaxBytes GlobalSettingKey = "storage.dom0.disk.maxusagebytes"
+	// ZFSScrubIntervalDays global setting key: number of days between
+	// scheduled scrubs of the persist zpool, zero disables them
+	ZFSScrubIntervalDays GlobalSettingKey = "storage.zfs.scrub.interval.days"
+	// ZFSScrubPauseAppIOPS global setting key: pause a running scrub while
+	// applications issue more I/O operations per second to their zvols
+	ZFSScrubPauseAppIOPS GlobalSettingKey = "storage.zfs.scrub.pause.app.iops"
 	// AppContainerStatsInterval - App Container Stats Collection
 	AppContainerStatsInterval GlobalSettingKey = "timer.appcontainer.stats.interval"
 	// VaultReadyCutOffTime global setting key
@@ -229,10 +235,22 @@ const (
 	VmmMemoryLimitInMiB GlobalSettingKey = "memory.vmm.limit.MiB"
 	// IgnoreMemoryCheckForApps global setting key
 	IgnoreMemoryCheckForApps GlobalSettingKey = "memory.apps.ignore.check"
+	// MemoryReclaimPercent global setting key: the free host memory in
+	// percent below which balloon memory is reclaimed from idle apps
+	MemoryReclaimPercent GlobalSettingKey = "memory.apps.reclaim.percent"
 	// IgnoreDiskCheckForApps global setting key
 	IgnoreDiskCheckForApps GlobalSettingKey = "storage.apps.ignore.disk.check"
+	// ZFSOfflineFailingDisks global setting key: take disks with high risk of
+	// failure offline in zpool as long as the rest of their vdev is healthy
+	ZFSOfflineFailingDisks GlobalSettingKey = "storage.zfs.offline.failing.disks"
 	// AllowLogFastupload global setting key
 	AllowLogFastupload GlobalSettingKey = "newlog.allow.fastupload"
+	// AttestLocalVerifier global setting key: verify TPM quotes on the device
+	// against the signed local policy instead of by the Controller
+	AttestLocalVerifier GlobalSettingKey = "attest.local.verifier"
+	// ExportNetworkInstanceState global setting key: export the current and
+	// the intended state of the network instances of zedrouter as dot files
+	ExportNetworkInstanceState GlobalSettingKey = "debug.export.network.instance.state"
 
 	// TriState Items
 	// NetworkFallbackAnyEth global setting key
@@ -259,6 +277,11 @@ const (
 
 	// XXX temp for testing edge-view
 	EdgeViewToken GlobalSettingKey = "edgeview.authen.jwt"
+
+	// DownloadP2PKey global setting key is a key shared by the edge nodes
+	// of a site which are allowed to exchange downloaded content.
+	// Empty disables the exchange.
+	DownloadP2PKey GlobalSettingKey = "network.download.p2p.key"
 )
 
 // AgentSettingKey - keys for per-agent settings
@@ -803,6 +826,9 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddIntItem(LocationCloudInterval, HourInSec, 5*MinuteInSec, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(LocationAppInterval, 20, 5, HourInSec)
 	configItemSpecMap.AddIntItem(Dom0MinDiskUsagePercent, 20, 20, 80)
+	// Scrub the persist zpool monthly and back off above 500 IOPS of apps
+	configItemSpecMap.AddIntItem(ZFSScrubIntervalDays, 30, 0, 365)
+	configItemSpecMap.AddIntItem(ZFSScrubPauseAppIOPS, 500, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(AppContainerStatsInterval, 300, 1, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(VaultReadyCutOffTime, 300, 60, 0xFFFFFFFF)
 	// Dom0DiskUsageMaxBytes - Default is 2GB, min is 100MB
@@ -813,6 +839,8 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 		uint32(eveMemoryLimitInBytes), 0xFFFFFFFF)
 	// Limit manual vmm overhead override to 1 PiB
 	configItemSpecMap.AddIntItem(VmmMemoryLimitInMiB, 0, 0, uint32(1024*1024*1024))
+	// Reclaiming memory from idle apps is disabled by default
+	configItemSpecMap.AddIntItem(MemoryReclaimPercent, 0, 0, 50)
 	// LogRemainToSendMBytes - Default is 2 Gbytes, minimum is 10 Mbytes
 	configItemSpecMap.AddIntItem(LogRemainToSendMBytes, 2048, 10, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadMaxPortCost, 0, 0, 255)
@@ -823,7 +851,10 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddBoolItem(AllowAppVnc, false)
 	configItemSpecMap.AddBoolItem(IgnoreMemoryCheckForApps, false)
 	configItemSpecMap.AddBoolItem(IgnoreDiskCheckForApps, false)
+	configItemSpecMap.AddBoolItem(ZFSOfflineFailingDisks, false)
 	configItemSpecMap.AddBoolItem(AllowLogFastupload, false)
+	configItemSpecMap.AddBoolItem(AttestLocalVerifier, false)
+	configItemSpecMap.AddBoolItem(ExportNetworkInstanceState, false)
 	configItemSpecMap.AddBoolItem(DisableDHCPAllOnesNetMask, false)
 	configItemSpecMap.AddBoolItem(ProcessCloudInitMultiPart, false)
 	configItemSpecMap.AddBoolItem(ConsoleAccess, true) // Controller likely default to false
@@ -843,6 +874,7 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 
 	// XXX temp edgeview setting
 	configItemSpecMap.AddStringItem(EdgeViewToken, "", blankValidator)
+	configItemSpecMap.AddStringItem(DownloadP2PKey, "", blankValidator)
 
 	return configItemSpecMap
 }
@@ -169,12 +169,15 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		NetworkSendTimeout,
 		NetworkDialTimeout,
 		Dom0MinDiskUsagePercent,
+		ZFSScrubIntervalDays,
+		ZFSScrubPauseAppIOPS,
 		AppContainerStatsInterval,
 		VaultReadyCutOffTime,
 		Dom0DiskUsageMaxBytes,
 		ForceFallbackCounter,
 		LogRemainToSendMBytes,
 		DownloadMaxPortCost,
+		MemoryReclaimPercent,
 		// Bool Items
 		UsbAccess,
 		VgaAccess,
@@ -184,7 +187,10 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		VmmMemoryLimitInMiB,
 		IgnoreMemoryCheckForApps,
 		IgnoreDiskCheckForApps,
+		ZFSOfflineFailingDisks,
 		AllowLogFastupload,
+		AttestLocalVerifier,
+		ExportNetworkInstanceState,
 		// TriState Items
 		NetworkFallbackAnyEth,
 		MaintenanceMode,
@@ -195,6 +201,7 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		DisableDHCPAllOnesNetMask,
 		ProcessCloudInitMultiPart,
 		EdgeViewToken,
+		DownloadP2PKey,
 	}
 	if len(specMap.GlobalSettings) != len(gsKeys) {
 		t.Errorf("GlobalSettings has more (%d) than expected keys (%d)",
@@ -21,6 +21,12 @@ const (
 	SealedDirName = PersistDir + "/vault"
 	// VolumeEncryptedDirName - sealed directory used to store volumes
 	VolumeEncryptedDirName = SealedDirName + "/volumes"
+	// VolumeKeysDirName - sealed directory used to store the wrapped keys
+	// of the volumes encrypted with their own keys
+	VolumeKeysDirName = SealedDirName + "/volume-keys"
+	// WireGuardKeysDirName - sealed directory used to store private keys
+	// generated for WireGuard VPN network instances
+	WireGuardKeysDirName = SealedDirName + "/wireguard-keys"
 	// ClearDirName - directory which is not encrypted
 	ClearDirName = PersistDir + "/clear"
 	// VolumeClearDirName - Not encrypted directory used to store volumes
@@ -31,6 +37,24 @@ const (
 	PersistInstallerDir = PersistDir + "/installer"
 	// IngestedDirname - location for shas of files we pulled from /config
 	IngestedDirname = PersistDir + "/ingested"
+	// AppExportDirname - location of the app archives exported by the
+	// local export command
+	AppExportDirname = PersistDir + "/appexport"
+	// AppImportDirname - location of the app archives to import the
+	// volumes of app instances from
+	AppImportDirname = PersistDir + "/appimport"
+	// DiskHealthDirname - history of SMART attributes of every disk
+	DiskHealthDirname = PersistDir + "/disk-health"
+	// LocalAttestDirname - state of the local attestation verifier
+	LocalAttestDirname = PersistDir + "/attest"
+	// LocalAttestPolicyFile - signed attestation policy in use
+	LocalAttestPolicyFile = LocalAttestDirname + "/policy.json"
+	// LocalAttestIncomingPolicyFile - signed attestation policy copied
+	// from a USB stick, not yet verified
+	LocalAttestIncomingPolicyFile = LocalAttestDirname + "/policy.incoming.json"
+	// LocalAttestEscrowFile - vault key wrapped by the TPM, released
+	// to vaultmgr only after a successful local attestation
+	LocalAttestEscrowFile = LocalAttestDirname + "/vault-key.escrow"
 
 	// IdentityDirname - Config dir
 	IdentityDirname = "/config"
@@ -56,11 +80,19 @@ const (
 	BootstrapConfFileName = IdentityDirname + "/bootstrap-config.pb"
 	// RemoteAccessFlagFileName -- file to check for remote access configuration
 	RemoteAccessFlagFileName = IdentityDirname + "/remote_access_disabled"
+	// AttestPolicySignerCertName - what we trust for signatures of
+	// the local attestation policy
+	AttestPolicySignerCertName = IdentityDirname + "/attest-policy-signer.cert.pem"
+	// AppImportTrustedCertsName - certificates of devices (or of CAs
+	// which issued them) trusted to export app archives for import
+	AppImportTrustedCertsName = IdentityDirname + "/app-import-trusted.cert.pem"
 	// BootstrapShaFileName - file to store SHA hash of an already ingested bootstrap config
 	BootstrapShaFileName = IngestedDirname + "/bootstrap-config.sha"
 
 	// ServerSigningCertFileName - filename for server signing leaf certificate
 	ServerSigningCertFileName = CertificateDirname + "/server-signing-cert.pem"
+	// AttestCertName - certificate of the TPM key used to sign quotes
+	AttestCertName = CertificateDirname + "/attest.cert.pem"
 
 	// ShareCertDirname - directory to place private proxy server certificates
 	ShareCertDirname = "/usr/local/share/ca-certificates"
@@ -73,6 +105,12 @@ const (
 	ITokenFile = "/run/eve.integrity_token"
 	//EveVersionFile contains the running version of EVE
 	EveVersionFile = "/run/eve-release"
+	//AttestMeasurementReportFile describes the measured boot components,
+	//with changes since the last successfully attested boot
+	AttestMeasurementReportFile = "/run/eve.attest-measurements.json"
+	//AttestedMeasurementsFile contains measurements of the last boot
+	//attested successfully
+	AttestedMeasurementsFile = LocalAttestDirname + "/attested-measurements.json"
 	//DefaultVaultName is the name of the default vault
 	DefaultVaultName = "Application Data Store"
 
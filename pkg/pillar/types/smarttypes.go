@@ -63,6 +63,18 @@ const (
 	SmartAttrIDTemperatureCelsius int = 194
 	// SmartAttrIDRealLocatedEventCount - count of remap operations. Shows the total count of attempts.
 	SmartAttrIDRealLocatedEventCount int = 196
+	// SmartAttrIDReportedUncorrect - count of errors that could not be recovered using hardware ECC
+	SmartAttrIDReportedUncorrect int = 187
+)
+
+// NVMe reports its health in the SMART log page without ATA attribute IDs.
+// Values which have no ATA counterpart get IDs above the ATA range (1-255).
+const (
+	// SmartAttrIDNVMePercentageUsed - estimate of the used life of the NVMe disk in percent,
+	// may exceed 100 if the disk is used beyond its rated endurance
+	SmartAttrIDNVMePercentageUsed int = 256
+	// SmartAttrIDNVMeMediaErrors - count of unrecovered data integrity errors of the NVMe disk
+	SmartAttrIDNVMeMediaErrors int = 257
 )
 
 // DAttrTable have smart attr received via API
@@ -132,3 +144,53 @@ func (dsi DiskSmartInfo) GetSmartAttrViaID(id int) *DAttrTable {
 	}
 	return nil
 }
+
+// DiskHealthRisk indicates how likely the disk is to fail,
+// must be in sync with info.DiskHealthRisk enum of api
+type DiskHealthRisk int32
+
+const (
+	// DiskHealthRiskUnspecified - risk is not evaluated, e.g. SMART is not available
+	DiskHealthRiskUnspecified DiskHealthRisk = 0
+	// DiskHealthRiskLow - no signs of degradation
+	DiskHealthRiskLow DiskHealthRisk = 1
+	// DiskHealthRiskElevated - the disk shows signs of degradation
+	DiskHealthRiskElevated DiskHealthRisk = 2
+	// DiskHealthRiskHigh - the disk is likely to fail soon
+	DiskHealthRiskHigh DiskHealthRisk = 3
+)
+
+// String returns DiskHealthRisk in string format
+func (r DiskHealthRisk) String() string {
+	switch r {
+	case DiskHealthRiskLow:
+		return "low"
+	case DiskHealthRiskElevated:
+		return "elevated"
+	case DiskHealthRiskHigh:
+		return "high"
+	default:
+		return "unspecified"
+	}
+}
+
+// DiskHealthStatus is published by zfsmanager with the failure risk of the disk
+// evaluated from the trend of its SMART attributes.
+// The error is set when the risk is elevated (warning) or high (error).
+type DiskHealthStatus struct {
+	DiskName     string // /dev/sda
+	SerialNumber string
+	Risk         DiskHealthRisk
+	// OfflinedByEVE is set when zfsmanager took the disk offline in zpool
+	// because of the high risk while the rest of its vdev was healthy
+	OfflinedByEVE bool
+	ErrorAndTime
+}
+
+// Key for pubsub is the serial number, as disk names may change across reboots
+func (status DiskHealthStatus) Key() string {
+	if status.SerialNumber != "" {
+		return PathToKey(status.SerialNumber)
+	}
+	return PathToKey(status.DiskName)
+}
@@ -17,7 +17,8 @@ import (
 
 // SwState started with enum names from OMA-TS-LWM2M_SwMgmt-V1_0-20151201-C
 // but now has many additions.
-// They are in order of progression (except for the RESTARTING and PURGING ones)
+// They are in order of progression (except for the RESTARTING and PURGING ones,
+// and START_BLOCKED which comes before BOOTING)
 // We map this to info.ZSwState
 type SwState uint8
 
@@ -47,6 +48,8 @@ const (
 	PURGING    // Purging due to config change
 	BROKEN     // Domain is still alive, but its device model has failed
 	UNKNOWN    // State of the domain can't be determined
+	// START_BLOCKED is last to not renumber the states above
+	START_BLOCKED // Domain start waits for the app instances it depends on
 	MAXSTATE
 )
 
@@ -99,6 +102,8 @@ func (state SwState) String() string {
 		return "BROKEN"
 	case START_DELAYED:
 		return "START_DELAYED"
+	case START_BLOCKED:
+		return "START_BLOCKED"
 	case UNKNOWN:
 		return "UNKNOWN"
 	default:
@@ -168,6 +173,8 @@ func (state SwState) ZSwState() info.ZSwState {
 		return info.ZSwState_HALTING
 	case START_DELAYED:
 		return info.ZSwState_START_DELAYED
+	case START_BLOCKED:
+		return info.ZSwState_START_BLOCKED
 	// If we ever see UNKNOWN we return RUNNING assuming the state will change to something
 	// known soon.
 	case UNKNOWN:
@@ -29,6 +29,37 @@ type VolumeConfig struct {
 	HasNoAppReferences      bool
 	Target                  zconfig.Target
 	CustomMeta              string
+	SnapshotPolicy          VolumeSnapshotPolicy
+}
+
+// VolumeSnapshotPolicy : when snapshots of a volume are taken and used
+// by zedmanager. It is set for the volumes of an app instance which
+// has the policy in its configuration.
+type VolumeSnapshotPolicy struct {
+	// SnapshotBeforeUpdate : take a snapshot of the volume while the app
+	// instance is stopped to be updated
+	SnapshotBeforeUpdate bool
+	// RollbackOnBootFailure : roll the volume back to the snapshot taken
+	// before the update if the updated app instance fails to boot
+	RollbackOnBootFailure bool
+}
+
+// VolumeSnapshot : snapshot of a volume
+type VolumeSnapshot struct {
+	Name       string
+	CreateTime time.Time
+}
+
+// VolumeExport : content of a volume exported into an app archive
+type VolumeExport struct {
+	// File is the compressed content, relative to the archive directory
+	File string
+	// Format is the format of the disk image, or "container" for the
+	// changes of a container volume to its image
+	Format string
+	// Size and Sha256 are those of the compressed content
+	Size   int64
+	Sha256 string
 }
 
 // Key is volume UUID which will be unique
@@ -120,6 +151,7 @@ type VolumeStatus struct {
 	GenerationCounter       int64
 	LocalGenerationCounter  int64
 	Encrypted               bool
+	KeyEncrypted            bool // Encrypted with its own key, see vault.CreateVolumeKey
 	DisplayName             string
 	State                   SwState
 	SubState                volumeSubState
@@ -137,6 +169,8 @@ type VolumeStatus struct {
 	WWN                     string
 	Target                  zconfig.Target
 	CustomMeta              string
+	SnapshotPolicy          VolumeSnapshotPolicy
+	Snapshots               []VolumeSnapshot // Oldest first
 
 	ErrorAndTimeWithSource
 }
@@ -158,13 +192,25 @@ func (status VolumeStatus) IsContainer() bool {
 
 // PathName returns the path of the volume
 func (status VolumeStatus) PathName() string {
+	return volumePathName(status.VolumeID, status.GenerationCounter+status.LocalGenerationCounter,
+		status.ContentFormat, status.Encrypted, status.KeyEncrypted)
+}
+
+// volumePathName returns the path of the file of the volume. The content of
+// the volumes encrypted with their own key is stored raw inside the LUKS
+// container, whatever the format of the content tree.
+func volumePathName(volumeID uuid.UUID, generation int64, format zconfig.Format,
+	encrypted, keyEncrypted bool) string {
+
 	baseDir := VolumeClearDirName
-	if status.Encrypted {
+	if encrypted {
 		baseDir = VolumeEncryptedDirName
 	}
-	return fmt.Sprintf("%s/%s#%d.%s", baseDir, status.VolumeID.String(),
-		status.GenerationCounter+status.LocalGenerationCounter,
-		strings.ToLower(status.ContentFormat.String()))
+	if keyEncrypted {
+		format = zconfig.Format_RAW
+	}
+	return fmt.Sprintf("%s/%s#%d.%s", baseDir, volumeID.String(), generation,
+		strings.ToLower(format.String()))
 }
 
 // LogCreate :
@@ -249,6 +295,25 @@ type VolumeRefConfig struct {
 	RefCount               uint
 	MountDir               string
 	VerifyOnly             bool
+	// Incremented by zedmanager to request a snapshot of the volume
+	// or a rollback to its latest snapshot
+	SnapshotCounter uint32
+	RollbackCounter uint32
+	// Incremented by zedmanager to request an export of the content of
+	// the volume into the app archive in ExportDir
+	ExportCounter uint32
+	ExportDir     string
+	// Limits of the block I/O of the app instance to the volume
+	IoLimits VolumeIoLimits
+}
+
+// VolumeIoLimits : limits of the block I/O to a volume in bytes and in
+// operations per second, 0 for no limit
+type VolumeIoLimits struct {
+	ReadBps   uint64
+	WriteBps  uint64
+	ReadIops  uint64
+	WriteIops uint64
 }
 
 // Key : VolumeRefConfig unique key
@@ -331,6 +396,19 @@ type VolumeRefStatus struct {
 	VerifyOnly             bool
 	Target                 zconfig.Target
 	CustomMeta             string
+	SnapshotPolicy         VolumeSnapshotPolicy
+	Snapshots              []VolumeSnapshot
+	// Counters of the VolumeRefConfig once the snapshot or rollback
+	// is done, also if it failed
+	SnapshotCounter uint32
+	RollbackCounter uint32
+	// Error of the last snapshot or rollback, empty if it succeeded
+	SnapshotError string
+	// ExportCounter of the VolumeRefConfig once the export is done, also
+	// if it failed, and the exported content or the error of the export
+	ExportCounter uint32
+	Export        VolumeExport
+	ExportError   string
 
 	ErrorAndTimeWithSource
 }
@@ -446,6 +524,7 @@ type VolumeCreatePending struct {
 	LocalGenerationCounter int64
 	ContentFormat          zconfig.Format
 	Encrypted              bool
+	KeyEncrypted           bool
 }
 
 // Key : VolumeCreatePending unique key
@@ -461,13 +540,8 @@ func (status VolumeCreatePending) LogKey() string {
 
 // PathName returns the path of the volume
 func (status VolumeCreatePending) PathName() string {
-	baseDir := VolumeClearDirName
-	if status.Encrypted {
-		baseDir = VolumeEncryptedDirName
-	}
-	return fmt.Sprintf("%s/%s#%d.%s", baseDir, status.VolumeID.String(),
-		status.GenerationCounter+status.LocalGenerationCounter,
-		strings.ToLower(status.ContentFormat.String()))
+	return volumePathName(status.VolumeID, status.GenerationCounter+status.LocalGenerationCounter,
+		status.ContentFormat, status.Encrypted, status.KeyEncrypted)
 }
 
 // IsContainer will return true if content tree attached
@@ -484,6 +558,7 @@ func VolumeCreatePendingFromVolumeStatus(status VolumeStatus) VolumeCreatePendin
 		LocalGenerationCounter: status.LocalGenerationCounter,
 		ContentFormat:          status.ContentFormat,
 		Encrypted:              status.Encrypted,
+		KeyEncrypted:           status.KeyEncrypted,
 	}
 }
 
@@ -26,12 +26,22 @@ type BaseOsConfig struct {
 	ContentTreeConfigList []ContentTreeConfig
 	RetryCount            int32
 	Activate              bool
+	// Optional binary delta against the rootfs in the current partition,
+	// used instead of ContentTreeConfigList if it applies
+	DeltaContentTreeConfig *ContentTreeConfig
+	// RootfsSha256 is the expected sha256 of the rootfs written to the partition
+	RootfsSha256 string
 }
 
 func (config BaseOsConfig) Key() string {
 	return config.UUIDandVersion.UUID.String()
 }
 
+// HasDelta returns true if the rootfs can be produced by a delta
+func (config BaseOsConfig) HasDelta() bool {
+	return config.DeltaContentTreeConfig != nil && config.RootfsSha256 != ""
+}
+
 // LogCreate :
 func (config BaseOsConfig) LogCreate(logBase *base.LogObject) {
 	logObject := base.NewLogObject(logBase, base.BaseOsConfigLogType, config.BaseOsVersion,
@@ -87,9 +97,14 @@ type BaseOsStatus struct {
 	Activated             bool
 	TooEarly              bool // Failed since previous was inprogress/test
 	ContentTreeStatusList []ContentTreeStatus
-	PartitionLabel        string
-	PartitionDevice       string // From zboot
-	PartitionState        string // From zboot
+	// DeltaContentTreeStatus is set when the config has a delta
+	DeltaContentTreeStatus *ContentTreeStatus
+	// DeltaFailed is set if the delta could not be downloaded or applied,
+	// and ContentTreeStatusList is used instead
+	DeltaFailed     bool
+	PartitionLabel  string
+	PartitionDevice string // From zboot
+	PartitionState  string // From zboot
 	// Minimum state across all steps/StorageStatus.
 	// Error* set implies error.
 	State SwState
@@ -647,13 +662,15 @@ type LocalCommands struct {
 	AppCounters map[string]*LocalAppCounters // key: app UUID
 	// Local volume generation counters.
 	VolumeGenCounters map[string]int64 // key: volume UUID
+	// Locally requested key rotations of WireGuard network instances.
+	WireGuardKeyRotations map[string]*LocalWireGuardKeyRotation // key: NI UUID
 }
 
 // Empty : returns true if there were no commands triggered locally
 // (for currently deployed apps and volumes).
 func (lc *LocalCommands) Empty() bool {
 	return len(lc.AppCommands) == 0 && len(lc.AppCounters) == 0 &&
-		len(lc.VolumeGenCounters) == 0
+		len(lc.VolumeGenCounters) == 0 && len(lc.WireGuardKeyRotations) == 0
 }
 
 // AppCommand : application command requested to run by a local server.
@@ -667,6 +684,14 @@ const (
 	AppCommandRestart
 	// AppCommandPurge : purge application with ALL of its volumes.
 	AppCommandPurge
+	// AppCommandSnapshot : restart application with a snapshot taken of its volumes.
+	AppCommandSnapshot
+	// AppCommandRollback : restart application with its volumes reverted
+	// to their latest snapshots.
+	AppCommandRollback
+	// AppCommandExport : restart application with its configuration and
+	// volumes exported into an archive while it is stopped.
+	AppCommandExport
 	// TODO : purge for a single or a subset of volumes.
 )
 
@@ -685,6 +710,21 @@ type LocalAppCommand struct {
 	LastCompletedTimestamp uint64
 }
 
+// LocalWireGuardKeyRotation : key rotation of WireGuard network instance
+// requested from a local server.
+type LocalWireGuardKeyRotation struct {
+	// Counter : how many rotations have been requested in total
+	// (including the uncompleted one).
+	Counter uint32
+	// LocalServerTimestamp : timestamp made by the local server when the last
+	// rotation was requested.
+	LocalServerTimestamp uint64
+	// Completed is set to true by zedagent once zedrouter applies the rotation.
+	Completed bool
+	// LastCompletedTimestamp : (server) timestamp of the last completed rotation.
+	LastCompletedTimestamp uint64
+}
+
 // LocalAppCounters : counters for locally issued application commands.
 type LocalAppCounters struct {
 	// RestartCmd : contains counter counting how many restart requests have been submitted
@@ -693,6 +733,15 @@ type LocalAppCounters struct {
 	// PurgeCounter : contains counter counting how many purge requests have been submitted
 	// via local server for this application in total (including uncompleted requests).
 	PurgeCmd AppInstanceOpsCmd
+	// SnapshotCmd : contains counter counting how many snapshot requests have been submitted
+	// via local server for this application in total (including uncompleted requests).
+	SnapshotCmd AppInstanceOpsCmd
+	// RollbackCmd : contains counter counting how many rollback requests have been submitted
+	// via local server for this application in total (including uncompleted requests).
+	RollbackCmd AppInstanceOpsCmd
+	// ExportCmd : contains counter counting how many export requests have been submitted
+	// via local server for this application in total (including uncompleted requests).
+	ExportCmd AppInstanceOpsCmd
 }
 
 // DevCommand : application command requested to run by a local server.
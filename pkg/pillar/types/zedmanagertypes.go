@@ -63,6 +63,9 @@ type AppInstanceConfig struct {
 	PurgeCmd            AppInstanceOpsCmd
 	LocalRestartCmd     AppInstanceOpsCmd
 	LocalPurgeCmd       AppInstanceOpsCmd
+	LocalSnapshotCmd    AppInstanceOpsCmd
+	LocalRollbackCmd    AppInstanceOpsCmd
+	LocalExportCmd      AppInstanceOpsCmd
 	HasLocalServer      bool // Set if localServerAddr matches
 	// XXX: to be deprecated, use CipherBlockStatus instead
 	CloudInitUserData *string `json:"pubsub-large-CloudInitUserData"`
@@ -86,6 +89,48 @@ type AppInstanceConfig struct {
 	// All changes to the cloud-init config are tracked using this version field -
 	// once the version is changed cloud-init tool restarts in a guest.
 	CloudInitVersion uint32
+
+	// Dependencies are the app instances which must be online or healthy
+	// before the app instance is started
+	Dependencies []AppDependency
+	// RestartWithDependencies requests the app instance to be restarted
+	// when one of its Dependencies restarts
+	RestartWithDependencies bool
+
+	// HealthConfig holds the health probes and the restart policy
+	HealthConfig AppHealthConfig
+}
+
+// AppDependencyCondition is the condition of an app instance which the app
+// instances depending on it wait for
+type AppDependencyCondition uint8
+
+// AppDependencyOnline and other values for AppDependencyCondition
+const (
+	// AppDependencyOnline : the app instance is running
+	AppDependencyOnline AppDependencyCondition = iota
+	// AppDependencyHealthy : the app instance is running without errors and
+	// its readiness probe, if any, is passing
+	AppDependencyHealthy
+)
+
+// String returns the name of the condition
+func (cond AppDependencyCondition) String() string {
+	switch cond {
+	case AppDependencyOnline:
+		return "online"
+	case AppDependencyHealthy:
+		return "healthy"
+	default:
+		return fmt.Sprintf("Unknown AppDependencyCondition %d", cond)
+	}
+}
+
+// AppDependency is an app instance which must meet the Condition before
+// the app instance depending on it is started
+type AppDependency struct {
+	AppUUID   uuid.UUID
+	Condition AppDependencyCondition
 }
 
 type AppInstanceOpsCmd struct {
@@ -172,6 +217,25 @@ type AppInstanceStatus struct {
 	RestartStartedAt    time.Time
 	PurgeInprogress     Inprogress
 	PurgeStartedAt      time.Time
+	// VolumeSnapshotOp is run on the volumes while the app instance
+	// is brought down by a restart or purge
+	VolumeSnapshotOp VolumeSnapshotOp
+	// VolumeSnapshotOpStarted is set once the VolumeRefConfigs were
+	// updated to request VolumeSnapshotOp
+	VolumeSnapshotOpStarted bool
+	// RollbackOnBootFailure is set after the volumes were snapshotted
+	// before an update, until the updated app instance is up and running
+	RollbackOnBootFailure bool
+	// VolumeSnapshotLive is set when VolumeSnapshotOp is run while the
+	// app instance is running, with its filesystems frozen by the guest
+	// agent, instead of restarting it
+	VolumeSnapshotLive bool
+	// GuestFsFreeze requests domainmgr to freeze the filesystems
+	GuestFsFreeze bool
+	// ExportedAt is when the last export of the app instance into an app
+	// archive completed, and ExportError its error, empty if it succeeded
+	ExportedAt  time.Time
+	ExportError string
 
 	// Minimum state across all steps and all StorageStatus.
 	// Error* set implies error.
@@ -184,6 +248,19 @@ type AppInstanceStatus struct {
 	ErrorAndTimeWithSource
 	// Effective time, when the application should start
 	StartTime time.Time
+	// BlockedOnApps are the dependencies of the app instance which do
+	// not meet their condition yet, while it is in START_BLOCKED state
+	BlockedOnApps []uuid.UUID
+	// DependencyBootTimes are the boot times of the dependencies when the
+	// app instance was started, indexed by the UUID of the dependency.
+	// A change means the dependency restarted.
+	DependencyBootTimes map[string]time.Time
+	// Liveness and Readiness are the states of the health probes of the
+	// app instance, copied from the DomainStatus
+	Liveness  AppProbeStatus
+	Readiness AppProbeStatus
+	// GuestInfo is reported by the guest agent, copied from the DomainStatus
+	GuestInfo GuestInfo
 }
 
 // AppCount is uint8 and it should be sufficient for the number of apps we can support
@@ -276,6 +353,53 @@ const (
 	BringUp
 )
 
+// VolumeSnapshotOp is an operation on the snapshots of the volumes of
+// an app instance
+type VolumeSnapshotOp uint8
+
+// NoVolumeSnapshotOp and other values for VolumeSnapshotOp
+const (
+	NoVolumeSnapshotOp VolumeSnapshotOp = iota
+	// SnapshotBeforeUpdate : snapshot the volumes with SnapshotBeforeUpdate
+	// set in their policy
+	SnapshotBeforeUpdate
+	// RollbackAfterBootFailure : roll back the volumes with
+	// RollbackOnBootFailure set in their policy
+	RollbackAfterBootFailure
+	// SnapshotVolumes : snapshot all volumes, requested by the local server
+	SnapshotVolumes
+	// RollbackVolumes : roll back all volumes, requested by the local server
+	RollbackVolumes
+	// ExportVolumes : export all volumes into an app archive, requested
+	// by the local server
+	ExportVolumes
+)
+
+// String returns the name of the operation
+func (op VolumeSnapshotOp) String() string {
+	switch op {
+	case NoVolumeSnapshotOp:
+		return "none"
+	case SnapshotBeforeUpdate:
+		return "snapshot-before-update"
+	case RollbackAfterBootFailure:
+		return "rollback-after-boot-failure"
+	case SnapshotVolumes:
+		return "snapshot"
+	case RollbackVolumes:
+		return "rollback"
+	case ExportVolumes:
+		return "export"
+	default:
+		return fmt.Sprintf("Unknown VolumeSnapshotOp %d", op)
+	}
+}
+
+// IsRollback returns true if the operation reverts the volumes
+func (op VolumeSnapshotOp) IsRollback() bool {
+	return op == RollbackAfterBootFailure || op == RollbackVolumes
+}
+
 func (status AppInstanceStatus) Key() string {
 	return status.UUIDandVersion.UUID.String()
 }
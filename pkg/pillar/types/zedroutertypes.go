@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"net"
 	"os"
 	"reflect"
@@ -1002,6 +1004,16 @@ type CellConfig struct {
 	DisableProbe bool
 	// Enable to get location info from the GNSS receiver of the LTE modem.
 	LocationTracking bool
+	// Connection profiles of SIM cards ordered by preference.
+	SimProfiles []CellSimProfile
+}
+
+// CellSimProfile - how to use SIM card inserted into the given slot
+type CellSimProfile struct {
+	SlotNumber uint8 // starting with 1
+	APN        string
+	// Maximum RX+TX bytes within a calendar month (UTC), zero means no limit.
+	MonthlyDataCap uint64
 }
 
 // WirelessConfig - wireless structure
@@ -1164,8 +1176,10 @@ type NetworkPortStatus struct {
 	Up             bool
 	MacAddr        string
 	DefaultRouters []net.IP
-	WirelessCfg    WirelessConfig
-	WirelessStatus WirelessStatus
+	// IPv6 prefixes delegated to this port by the DHCPv6 server (IA_PD)
+	DelegatedPrefixes []net.IPNet
+	WirelessCfg       WirelessConfig
+	WirelessStatus    WirelessStatus
 	ProxyConfig
 	L2LinkConfig
 	// TestResults provides recording of failure and success
@@ -1373,6 +1387,14 @@ func (status DeviceNetworkStatus) MostlyEqual(status2 DeviceNetworkStatus) bool
 				return false
 			}
 		}
+		if len(p1.DelegatedPrefixes) != len(p2.DelegatedPrefixes) {
+			return false
+		}
+		for i := range p1.DelegatedPrefixes {
+			if !EqualSubnet(p1.DelegatedPrefixes[i], p2.DelegatedPrefixes[i]) {
+				return false
+			}
+		}
 
 		if !reflect.DeepEqual(p1.ProxyConfig, p2.ProxyConfig) ||
 			!reflect.DeepEqual(p1.WirelessStatus, p2.WirelessStatus) {
@@ -2081,6 +2103,7 @@ type UnderlayNetworkConfig struct {
 	ACLs         []ACE
 	AccessVlanID uint32
 	IfIdx        uint32 // If we have multiple interfaces on that network, we will increase the index
+	Shaping      TrafficShaping
 }
 
 type UnderlayNetworkStatus struct {
@@ -2167,13 +2190,24 @@ func (ipRange IpRange) Contains(ipAddr net.IP) bool {
 	return false
 }
 
-// Size returns addresses count inside IpRange
+// Size returns addresses count inside IpRange.
+// For IPv6 ranges the count is capped at math.MaxUint32.
 func (ipRange IpRange) Size() uint32 {
-	//TBD:XXX, IPv6 handling
 	ip1v4 := ipRange.Start.To4()
 	ip2v4 := ipRange.End.To4()
 	if ip1v4 == nil || ip2v4 == nil {
-		return 0
+		ip1v6 := ipRange.Start.To16()
+		ip2v6 := ipRange.End.To16()
+		if ip1v4 != nil || ip2v4 != nil || ip1v6 == nil || ip2v6 == nil {
+			return 0
+		}
+		diff := new(big.Int).Sub(new(big.Int).SetBytes(ip2v6),
+			new(big.Int).SetBytes(ip1v6))
+		diff.Abs(diff)
+		if !diff.IsUint64() || diff.Uint64() > math.MaxUint32 {
+			return math.MaxUint32
+		}
+		return uint32(diff.Uint64())
 	}
 	ip1Int := binary.BigEndian.Uint32(ip1v4)
 	ip2Int := binary.BigEndian.Uint32(ip2v4)
@@ -2339,6 +2373,7 @@ type NetworkInstanceMetrics struct {
 	NetworkMetrics NetworkMetrics
 	ProbeMetrics   ProbeMetrics
 	VpnMetrics     *VpnMetrics
+	VxlanMetrics   *VxlanMetrics
 	VlanMetrics    VlanMetrics
 }
 
@@ -2486,6 +2521,18 @@ type NetworkMetric struct {
 	RxAclDrops          uint64 // For implicit deny/drop at end
 	TxAclRateLimitDrops uint64 // For all rate limited rules
 	RxAclRateLimitDrops uint64 // For all rate limited rules
+	// Counters of the traffic classes of a shaped VIF
+	TxClasses []TrafficClassMetric
+	RxClasses []TrafficClassMetric
+}
+
+// TrafficClassMetric has the counters of a traffic class of TrafficShaper
+type TrafficClassMetric struct {
+	Name       string
+	Bytes      uint64
+	Pkts       uint64
+	Drops      uint64
+	Overlimits uint64
 }
 
 type NetworkInstanceType int32
@@ -2498,6 +2545,8 @@ const (
 	NetworkInstanceTypeCloud       NetworkInstanceType = 3
 	NetworkInstanceTypeHoneyPot    NetworkInstanceType = 5
 	NetworkInstanceTypeTransparent NetworkInstanceType = 6
+	NetworkInstanceTypeVXLAN       NetworkInstanceType = 7
+	NetworkInstanceTypeWireGuard   NetworkInstanceType = 8
 	NetworkInstanceTypeLast        NetworkInstanceType = 255
 )
 
@@ -2531,18 +2580,28 @@ type NetworkInstanceConfig struct {
 	Logicallabel string
 
 	// IP configuration for the Application
-	IpType          AddressType
-	Subnet          net.IPNet
-	Gateway         net.IP
-	DomainName      string
-	NtpServer       net.IP
-	DnsServers      []net.IP // If not set we use Gateway as DNS server
-	DhcpRange       IpRange
-	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
+	IpType AddressType
+	// IPv6PrefixDelegation : Subnet, Gateway and DhcpRange are not taken
+	// from the config but derived from the IPv6 prefix delegated (DHCPv6-PD)
+	// to the uplink port. Only valid for a local NI with IpType IPV6.
+	IPv6PrefixDelegation bool
+	Subnet               net.IPNet
+	Gateway              net.IP
+	DomainName           string
+	NtpServer            net.IP
+	DnsServers           []net.IP // If not set we use Gateway as DNS server
+	DhcpRange            IpRange
+	DnsNameToIPList      []DnsNameToIP // Used for DNS and ACL ipset
 
 	// For other network services - Proxy / StrongSwan etc..
 	OpaqueConfig string
 
+	// Vxlan : only used with NetworkInstanceTypeVXLAN.
+	Vxlan VxlanConfig
+
+	// WireGuard : only used with NetworkInstanceTypeWireGuard.
+	WireGuard WireGuardConfig
+
 	// Any errors from the parser
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
@@ -2600,6 +2659,218 @@ func (config *NetworkInstanceConfig) IsIPv6() bool {
 	return false
 }
 
+// VxlanEncryption : encryption of the traffic exchanged between VTEPs.
+// The values here should be same as the ones defined in zconfig.VxlanEncryption
+type VxlanEncryption int32
+
+const (
+	// VxlanEncryptionNone : VXLAN traffic is not encrypted.
+	VxlanEncryptionNone VxlanEncryption = 0
+	// VxlanEncryptionIPsec : ESP in the transport mode with static keys
+	// derived from a pre-shared key.
+	VxlanEncryptionIPsec VxlanEncryption = 1
+	// VxlanEncryptionWireGuard : VXLAN runs inside a WireGuard tunnel.
+	VxlanEncryptionWireGuard VxlanEncryption = 2
+)
+
+// String returns human-readable name of the encryption.
+func (e VxlanEncryption) String() string {
+	switch e {
+	case VxlanEncryptionNone:
+		return "none"
+	case VxlanEncryptionIPsec:
+		return "ipsec"
+	case VxlanEncryptionWireGuard:
+		return "wireguard"
+	}
+	return fmt.Sprintf("unknown(%d)", int32(e))
+}
+
+const (
+	// DefaultVxlanDstPort : IANA-assigned UDP port for VXLAN.
+	DefaultVxlanDstPort = 4789
+	// MaxVxlanVNI : VNI is a 24-bit number.
+	MaxVxlanVNI = 1<<24 - 1
+	// Encapsulation overhead (outer IP + UDP + VXLAN + inner Ethernet header).
+	vxlanOverheadIPv4 = 50
+	vxlanOverheadIPv6 = 70
+	// Outer IP + UDP + WireGuard header + authentication tag.
+	wireGuardOverheadIPv4 = 60
+	wireGuardOverheadIPv6 = 80
+	// ESP header + IV + ICV of AES-GCM, plus the worst-case padding.
+	espTransportOverhead = 40
+)
+
+// VxlanPeer : remote VTEP (EVE node) of a VXLAN network instance.
+type VxlanPeer struct {
+	// Address : underlay IP address of the peer.
+	Address net.IP
+	// WireGuardPublicKey : base64-encoded public key of the peer.
+	WireGuardPublicKey string
+	// TunnelAddress : IP address of the peer inside the WireGuard tunnel.
+	TunnelAddress net.IP
+}
+
+// VxlanConfig : configuration specific to VXLAN network instance.
+type VxlanConfig struct {
+	VNI     uint32
+	DstPort uint16
+	// MTU : zero if MTU should be derived from the MTU of the uplink.
+	MTU        uint16
+	Peers      []VxlanPeer
+	Encryption VxlanEncryption
+	// TunnelAddress : address of this node inside the WireGuard tunnel.
+	TunnelAddress net.IPNet
+	// WireGuardListenPort : used by this node and expected on the peers.
+	WireGuardListenPort uint16
+	// CipherBlockStatus carries the IPsec pre-shared key or the WireGuard
+	// private key.
+	CipherBlockStatus CipherBlockStatus
+}
+
+// UnderlayIsIPv6 returns true if VXLAN-encapsulated traffic is carried
+// over IPv6. With WireGuard this is decided by the tunnel address,
+// otherwise by the address of the first peer.
+func (config VxlanConfig) UnderlayIsIPv6() bool {
+	if config.Encryption == VxlanEncryptionWireGuard {
+		return config.TunnelAddress.IP != nil && config.TunnelAddress.IP.To4() == nil
+	}
+	return len(config.Peers) > 0 && config.Peers[0].Address.To4() == nil
+}
+
+// GetMTU returns the MTU of the VXLAN segment for the given MTU of the uplink.
+// Returns error if the configured MTU does not fit into the uplink MTU
+// after the encapsulation (and encryption) overhead is subtracted.
+func (config VxlanConfig) GetMTU(uplinkMTU uint16) (uint16, error) {
+	overhead := vxlanOverheadIPv4
+	if config.UnderlayIsIPv6() {
+		overhead = vxlanOverheadIPv6
+	}
+	switch config.Encryption {
+	case VxlanEncryptionIPsec:
+		overhead += espTransportOverhead
+	case VxlanEncryptionWireGuard:
+		overhead += config.wireGuardOverhead()
+	}
+	if int(uplinkMTU) <= overhead {
+		return 0, fmt.Errorf("uplink MTU %d is too small for VXLAN (overhead %d)",
+			uplinkMTU, overhead)
+	}
+	maxMTU := uplinkMTU - uint16(overhead)
+	if config.MTU == 0 {
+		return maxMTU, nil
+	}
+	if config.MTU > maxMTU {
+		return 0, fmt.Errorf("VXLAN MTU %d exceeds maximum %d allowed by uplink MTU %d",
+			config.MTU, maxMTU, uplinkMTU)
+	}
+	return config.MTU, nil
+}
+
+// GetWireGuardMTU returns the MTU of the WireGuard interface carrying
+// VXLAN traffic for the given MTU of the uplink.
+func (config VxlanConfig) GetWireGuardMTU(uplinkMTU uint16) (uint16, error) {
+	overhead := config.wireGuardOverhead()
+	if int(uplinkMTU) <= overhead {
+		return 0, fmt.Errorf("uplink MTU %d is too small for WireGuard (overhead %d)",
+			uplinkMTU, overhead)
+	}
+	return uplinkMTU - uint16(overhead), nil
+}
+
+// wireGuardOverhead depends on the address family of the peers,
+// not of the tunnel.
+func (config VxlanConfig) wireGuardOverhead() int {
+	if len(config.Peers) > 0 && config.Peers[0].Address.To4() == nil {
+		return wireGuardOverheadIPv6
+	}
+	return wireGuardOverheadIPv4
+}
+
+// WireGuardMode : role of the node in WireGuard VPN network instance.
+type WireGuardMode int32
+
+// The values here should be same as the ones defined in zconfig.WireGuardMode
+const (
+	// WireGuardModeClient : traffic sent into the tunnel is NATed
+	// to the tunnel address.
+	WireGuardModeClient WireGuardMode = 0
+	// WireGuardModeHub : applications are reachable from the peers.
+	WireGuardModeHub WireGuardMode = 1
+)
+
+// String returns human-readable name of the mode.
+func (m WireGuardMode) String() string {
+	switch m {
+	case WireGuardModeClient:
+		return "client"
+	case WireGuardModeHub:
+		return "hub"
+	}
+	return fmt.Sprintf("unknown(%d)", int32(m))
+}
+
+// WireGuardPeer : remote end of WireGuard VPN network instance.
+type WireGuardPeer struct {
+	// PublicKey : base64-encoded public key of the peer.
+	PublicKey string
+	// Endpoint : nil if not known (learned from the handshake).
+	Endpoint *net.UDPAddr
+	// AllowedIPs : IPv4 subnets routed to (and accepted from) the peer.
+	AllowedIPs []net.IPNet
+	// PersistentKeepalive : interval in seconds, zero to disable.
+	PersistentKeepalive uint16
+}
+
+// WireGuardConfig : configuration specific to WireGuard VPN network instance.
+type WireGuardConfig struct {
+	Mode WireGuardMode
+	// TunnelAddress : address of this node inside the tunnel.
+	TunnelAddress net.IPNet
+	// ListenPort : zero to let the kernel pick a random port (client only).
+	ListenPort uint16
+	// MTU : zero if MTU should be derived from the MTU of the uplink.
+	MTU   uint16
+	Peers []WireGuardPeer
+	// CipherBlockStatus carries the private key.
+	// Without cipher data the key pair is generated by EVE.
+	CipherBlockStatus CipherBlockStatus
+	// KeyGeneration : change makes EVE generate a new key pair.
+	KeyGeneration uint32
+	// LocalKeyRotation : counts key rotations requested via local profile
+	// server, same effect as KeyGeneration.
+	LocalKeyRotation uint32
+}
+
+// HasLocalKey returns true if the key pair is generated by EVE.
+func (config WireGuardConfig) HasLocalKey() bool {
+	return !config.CipherBlockStatus.IsCipher
+}
+
+// GetMTU returns the MTU of the tunnel for the given MTU of the uplink.
+// Overhead of IPv6 is assumed if any peer is reached over IPv6.
+func (config WireGuardConfig) GetMTU(uplinkMTU uint16) (uint16, error) {
+	overhead := wireGuardOverheadIPv4
+	for _, peer := range config.Peers {
+		if peer.Endpoint != nil && peer.Endpoint.IP.To4() == nil {
+			overhead = wireGuardOverheadIPv6
+		}
+	}
+	if int(uplinkMTU) <= overhead {
+		return 0, fmt.Errorf("uplink MTU %d is too small for WireGuard (overhead %d)",
+			uplinkMTU, overhead)
+	}
+	maxMTU := uplinkMTU - uint16(overhead)
+	if config.MTU == 0 {
+		return maxMTU, nil
+	}
+	if config.MTU > maxMTU {
+		return 0, fmt.Errorf("WireGuard MTU %d exceeds maximum %d allowed by uplink MTU %d",
+			config.MTU, maxMTU, uplinkMTU)
+	}
+	return config.MTU, nil
+}
+
 type ChangeInProgressType int32
 
 const (
@@ -2627,11 +2898,28 @@ type NetworkInstanceStatus struct {
 
 	Server4Running bool // Did we start the server?
 
+	// DelegatedPrefix : prefix delegated to the uplink port out of which
+	// the /64 Subnet was taken (only with IPv6PrefixDelegation).
+	DelegatedPrefix net.IPNet
+
+	// VxlanMTU : MTU of the VXLAN segment as applied
+	// (only with NetworkInstanceTypeVXLAN).
+	VxlanMTU uint16
+
 	NetworkInstanceInfo
 
 	OpaqueStatus string
 	VpnStatus    *VpnStatus
 
+	// WireGuardStatus : only with NetworkInstanceTypeWireGuard.
+	WireGuardStatus *WireGuardStatus
+
+	// ReconcileErr : set when the network configuration of the NI could not be
+	// built or applied (e.g. WireGuard interface without kernel support).
+	// Maintained separately from the error of the NI config processing,
+	// it is cleared once the configuration is applied successfully.
+	ReconcileErr ErrorAndTime
+
 	NetworkInstanceProbeStatus
 }
 
@@ -2704,12 +2992,13 @@ type IPTablesRule struct {
 	RuleID           int32    // Unique rule ID
 	RuleName         string
 	ActionChainName  string
-	IsUserConfigured bool // Does this rule come from user configuration/manifest?
-	IsMarkingRule    bool // Rule does marking of packet for flow tracking.
-	IsPortMapRule    bool // Is this a port map rule?
-	IsLimitDropRule  bool // Is this a policer limit drop rule?
-	IsDefaultDrop    bool // Is this a default drop rule that forwards to dummy?
-	AnyPhysdev       bool // Apply rule irrespective of the input/output physical device.
+	ActionChainMark  uint32 // Connection mark set by ActionChainName
+	IsUserConfigured bool   // Does this rule come from user configuration/manifest?
+	IsMarkingRule    bool   // Rule does marking of packet for flow tracking.
+	IsPortMapRule    bool   // Is this a port map rule?
+	IsLimitDropRule  bool   // Is this a policer limit drop rule?
+	IsDefaultDrop    bool   // Is this a default drop rule that forwards to dummy?
+	AnyPhysdev       bool   // Apply rule irrespective of the input/output physical device.
 }
 
 // IPTablesRuleList : list of iptables rules
@@ -2852,6 +3141,62 @@ type ACEAction struct {
 	TargetPort int  // Internal port
 }
 
+// TrafficShaping limits the bit-rate of the traffic of an app VIF.
+// Egress is the traffic sent by the app, Ingress the traffic it receives.
+type TrafficShaping struct {
+	Egress  TrafficShaper
+	Ingress TrafficShaper
+}
+
+// IsSet returns true if the traffic is shaped in any direction
+func (ts TrafficShaping) IsSet() bool {
+	return ts.Egress.IsSet() || ts.Ingress.IsSet()
+}
+
+// TrafficShaper is a bit-rate limit of one direction, shared by priority
+// classes. Traffic matching no class goes to the default class.
+type TrafficShaper struct {
+	RateBps    uint64 // Zero if not shaped
+	BurstBytes uint32 // Zero to use the default burst
+	Classes    []TrafficClass
+}
+
+// DefaultTrafficClassName is the name of the class of the traffic
+// not matching any TrafficClass
+const DefaultTrafficClassName = "default"
+
+// IsSet returns true if the direction is shaped
+func (ts TrafficShaper) IsSet() bool {
+	return ts.RateBps != 0
+}
+
+// Burst returns the burst in bytes, which defaults to 10ms of traffic
+// at the rate
+func (ts TrafficShaper) Burst() uint32 {
+	if ts.BurstBytes != 0 {
+		return ts.BurstBytes
+	}
+	burst := ts.RateBps / 8 / 100
+	if burst < minTrafficBurst {
+		burst = minTrafficBurst
+	}
+	return uint32(burst)
+}
+
+// Less than an MTU of burst would stall the shaper
+const minTrafficBurst = 1600
+
+// TrafficClass is a share of the rate of a TrafficShaper for packets
+// matching any of the DSCP values or remote ports
+type TrafficClass struct {
+	Name        string
+	Priority    uint32 // 0 is the highest, 7 the lowest
+	RateBps     uint64 // Guaranteed rate
+	CeilBps     uint64 // Zero to borrow up to the rate of the shaper
+	DSCP        []uint8
+	RemotePorts []uint16
+}
+
 // Retrieved from geolocation service for device underlay connectivity
 type AdditionalInfoDevice struct {
 	UnderlayIP string
@@ -3017,6 +3362,53 @@ type VpnConnMetrics struct {
 	REndPoint VpnEndPointMetrics
 }
 
+// VxlanPeerMetrics : traffic exchanged with a single peer of VXLAN NI.
+type VxlanPeerMetrics struct {
+	Address string
+	// Stats : only available with encryption (from SAs or WireGuard peer).
+	Stats LinkPktStats
+	// LastHandshake : WireGuard only.
+	LastHandshake time.Time
+}
+
+// VxlanMetrics : metrics of VXLAN network instance.
+type VxlanMetrics struct {
+	VNI        uint32
+	MTU        uint16
+	Encryption VxlanEncryption
+	// TunnelStats : counters of the VXLAN interface.
+	TunnelStats LinkPktStats
+	Peers       []VxlanPeerMetrics
+}
+
+// WireGuardPeerStatus : state of a single peer of WireGuard VPN NI.
+type WireGuardPeerStatus struct {
+	PublicKey string
+	// Endpoint : as configured or learned from the last handshake.
+	Endpoint      string
+	LastHandshake time.Time
+	// Connected : the last handshake is recent enough.
+	Connected bool
+	RxBytes   uint64
+	TxBytes   uint64
+}
+
+// WireGuardStatus : state of WireGuard VPN network instance.
+type WireGuardStatus struct {
+	// PublicKey : public key of this node.
+	PublicKey  string
+	ListenPort uint16
+	// MTU : MTU of the tunnel as applied (also advertised by DHCP).
+	MTU uint16
+	// KeyGeneration of the key pair in use (only with the key pair
+	// generated by EVE).
+	KeyGeneration uint32
+	// LocalKeyRotation : the last applied key rotation requested via local
+	// profile server. Acknowledged even if the key is provided by the controller.
+	LocalKeyRotation uint32
+	Peers            []WireGuardPeerStatus
+}
+
 type VpnMetrics struct {
 	UpTime     time.Time // service start time stamp
 	DataStat   LinkPktStats
@@ -3284,11 +3676,19 @@ func AddToIP(ip net.IP, addition int) net.IP {
 		byte3 := byte(val & 0xFF)
 		return net.IPv4(byte0, byte1, byte2, byte3)
 	}
-	//TBD:XXX, IPv6 handling
+	if addr := ip.To16(); addr != nil {
+		val := new(big.Int).SetBytes(addr)
+		val.Add(val, big.NewInt(int64(addition)))
+		if val.Sign() < 0 || val.BitLen() > 8*net.IPv6len {
+			return net.IP{}
+		}
+		return val.FillBytes(make(net.IP, net.IPv6len))
+	}
 	return net.IP{}
 }
 
-// GetIPAddrCountOnSubnet IP address count on subnet
+// GetIPAddrCountOnSubnet IP address count on subnet.
+// For large IPv6 subnets (e.g. /64) the count is capped at math.MaxInt32.
 func GetIPAddrCountOnSubnet(subnet net.IPNet) int {
 	prefixLen, _ := subnet.Mask.Size()
 	if prefixLen != 0 {
@@ -3296,12 +3696,38 @@ func GetIPAddrCountOnSubnet(subnet net.IPNet) int {
 			return 0x01 << (32 - prefixLen)
 		}
 		if subnet.IP.To16() != nil {
+			if 128-prefixLen >= 31 {
+				return math.MaxInt32
+			}
 			return 0x01 << (128 - prefixLen)
 		}
 	}
 	return 0
 }
 
+// GetIPv6SubnetFromPrefix returns the index-th /64 subnet carved out
+// of a (shorter) delegated IPv6 prefix.
+func GetIPv6SubnetFromPrefix(prefix net.IPNet, index int) (net.IPNet, error) {
+	prefixLen, bits := prefix.Mask.Size()
+	if bits != 8*net.IPv6len || prefix.IP.To4() != nil {
+		return net.IPNet{}, fmt.Errorf("%s is not an IPv6 prefix",
+			prefix.String())
+	}
+	if prefixLen > 64 {
+		return net.IPNet{}, fmt.Errorf("prefix %s is longer than /64",
+			prefix.String())
+	}
+	if index < 0 || (64-prefixLen < 31 && index >= 1<<(64-prefixLen)) {
+		return net.IPNet{}, fmt.Errorf("prefix %s has no room for subnet %d",
+			prefix.String(), index)
+	}
+	subnet := make(net.IP, net.IPv6len)
+	copy(subnet, prefix.IP.Mask(prefix.Mask))
+	val := binary.BigEndian.Uint64(subnet[:8]) + uint64(index)
+	binary.BigEndian.PutUint64(subnet[:8], val)
+	return net.IPNet{IP: subnet, Mask: net.CIDRMask(64, 128)}, nil
+}
+
 // GetIPNetwork  :
 // returns the first IP Address of the subnet(Network Address)
 func GetIPNetwork(subnet net.IPNet) net.IP {
@@ -3310,7 +3736,11 @@ func GetIPNetwork(subnet net.IPNet) net.IP {
 
 // GetIPBroadcast :
 // returns the last IP Address of the subnet(Broadcast Address)
+// IPv6 has no broadcast address, an empty IP is returned.
 func GetIPBroadcast(subnet net.IPNet) net.IP {
+	if subnet.IP.To4() == nil {
+		return net.IP{}
+	}
 	if network := GetIPNetwork(subnet); network != nil {
 		if addrCount := GetIPAddrCountOnSubnet(subnet); addrCount != 0 {
 			return AddToIP(network, addrCount-1)
@@ -3327,8 +3757,16 @@ const (
 	BitMapMax       = 255 // with 0 base, its 256
 	MinSubnetSize   = 4   // minimum Subnet Size
 	LargeSubnetSize = 16  // for determining default Dhcp Range
+	// IPv6DhcpRangeOffset : default offset of the DHCPv6 range start
+	// from the subnet address. With SLAAC the app picks the interface ID
+	// itself, the range is used for DHCPv6 assignments.
+	IPv6DhcpRangeOffset = 0x100
 )
 
+// MetadataServerIPv6 is the IPv6 address of the metadata server,
+// the IPv6 counterpart of 169.254.169.254 (as used by cloud-init).
+var MetadataServerIPv6 = net.ParseIP("fd00:ec2::254")
+
 // WwanConfig is published by nim and consumed by the wwan service.
 type WwanConfig struct {
 	RadioSilence bool                `json:"radio-silence"`
@@ -3376,6 +3814,21 @@ type WwanNetworkConfig struct {
 	// microservice. This is further distributed to the controller and
 	// to applications by zedagent.
 	LocationTracking bool `json:"location-tracking"`
+	// Connection profiles of SIM cards ordered by preference.
+	// With more than one profile the wwan microservice fails over between SIM slots.
+	// If empty, the currently activated SIM slot is used with the first APN.
+	SimProfiles []WwanSimProfile `json:"sim-profiles"`
+}
+
+// WwanSimProfile : connection profile for SIM card inserted into the given slot.
+type WwanSimProfile struct {
+	// SIM slot number, starting with 1.
+	SlotNumber uint8 `json:"slot-number"`
+	// APN used with this SIM card. If empty, the first APN of the network is used.
+	APN string `json:"apn"`
+	// Maximum number of bytes (RX+TX) within a calendar month (UTC) to be used
+	// with this SIM card. Zero means no limit.
+	MonthlyDataCap uint64 `json:"monthly-data-cap"`
 }
 
 // WwanProbe : cellular connectivity verification probe.
@@ -3408,6 +3861,15 @@ func (wnc WwanNetworkConfig) Equal(wnc2 WwanNetworkConfig) bool {
 	if wnc.LocationTracking != wnc2.LocationTracking {
 		return false
 	}
+	// Order of SIM profiles matters - it defines preference.
+	if len(wnc.SimProfiles) != len(wnc2.SimProfiles) {
+		return false
+	}
+	for i := range wnc.SimProfiles {
+		if wnc.SimProfiles[i] != wnc2.SimProfiles[i] {
+			return false
+		}
+	}
 	if len(wnc.Apns) != len(wnc2.Apns) {
 		return false
 	}
@@ -3503,8 +3965,10 @@ type WwanNetworkStatus struct {
 	Module       WwanCellModule `json:"cellular-module"`
 	SimCards     []WwanSimCard  `json:"sim-cards"`
 	ConfigError  string         `json:"config-error"`
-	ProbeError   string         `json:"probe-error"`
-	Providers    []WwanProvider `json:"providers"`
+	// ConfigErrorCode classifies ConfigError.
+	ConfigErrorCode WwanErrorCode  `json:"config-error-code"`
+	ProbeError      string         `json:"probe-error"`
+	Providers       []WwanProvider `json:"providers"`
 }
 
 // WwanCellModule contains cellular module specs.
@@ -3522,6 +3986,13 @@ type WwanSimCard struct {
 	Name  string `json:"name,omitempty"`
 	ICCID string `json:"iccid"`
 	IMSI  string `json:"imsi"`
+	// SIM slot number, starting with 1. Zero if not known.
+	SlotNumber uint8 `json:"slot-number"`
+	// True if the slot is activated, i.e. used for the cellular connection.
+	SlotActivated bool `json:"slot-activated"`
+	// Bytes received and transmitted using this SIM card in the current
+	// calendar month (UTC).
+	MonthlyDataUsage uint64 `json:"monthly-data-usage"`
 }
 
 // WwanProvider contains information about a cellular connectivity provider.
@@ -3550,6 +4021,30 @@ const (
 	WwanOpModeUnrecognized WwanOpMode = "unrecognized"
 )
 
+// WwanErrorCode : classification of an error reported by the wwan microservice
+type WwanErrorCode string
+
+const (
+	// WwanErrorUnspecified : no error or not classified
+	WwanErrorUnspecified WwanErrorCode = ""
+	// WwanErrorModemNotFound : modem with the configured physical address was not found
+	WwanErrorModemNotFound WwanErrorCode = "modem-not-found"
+	// WwanErrorModemCommandFailed : command sent to the modem failed
+	WwanErrorModemCommandFailed WwanErrorCode = "modem-command-failed"
+	// WwanErrorSimNotReady : SIM card is missing, not initialized or the SIM slot
+	// cannot be activated
+	WwanErrorSimNotReady WwanErrorCode = "sim-not-ready"
+	// WwanErrorRegistrationFailed : modem failed to register on the network
+	WwanErrorRegistrationFailed WwanErrorCode = "registration-failed"
+	// WwanErrorConnectionFailed : modem failed to establish data connection
+	WwanErrorConnectionFailed WwanErrorCode = "connection-failed"
+	// WwanErrorIPConfigFailed : IP configuration was not provided by the modem
+	// or could not be applied
+	WwanErrorIPConfigFailed WwanErrorCode = "ip-config-failed"
+	// WwanErrorDataCapExceeded : monthly data cap of every usable SIM card was exceeded
+	WwanErrorDataCapExceeded WwanErrorCode = "data-cap-exceeded"
+)
+
 // WwanCtrlProt : wwan control protocol
 type WwanCtrlProt string
 
@@ -4,6 +4,7 @@
 package types
 
 import (
+	"math"
 	"net"
 
 	"github.com/satori/go.uuid"
@@ -1412,3 +1413,245 @@ func TestGetLocalAddrList(t *testing.T) {
 		}
 	}
 }
+
+func TestAddToIPv6(t *testing.T) {
+	testMatrix := map[string]struct {
+		ip            string
+		addition      int
+		expectedValue net.IP
+	}{
+		"Test simple addition": {
+			ip:            "2001:db8::1",
+			addition:      0x100,
+			expectedValue: net.ParseIP("2001:db8::101"),
+		},
+		"Test addition with carry": {
+			ip:            "2001:db8::ffff:ffff",
+			addition:      1,
+			expectedValue: net.ParseIP("2001:db8::1:0:0"),
+		},
+		"Test subtraction": {
+			ip:            "2001:db8::1:0",
+			addition:      -1,
+			expectedValue: net.ParseIP("2001:db8::ffff"),
+		},
+		"Test underflow": {
+			ip:            "::",
+			addition:      -1,
+			expectedValue: net.IP{},
+		},
+		"Test overflow": {
+			ip:            "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+			addition:      1,
+			expectedValue: net.IP{},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		value := AddToIP(net.ParseIP(test.ip), test.addition)
+		assert.True(t, test.expectedValue.Equal(value),
+			"expected %v, got %v", test.expectedValue, value)
+	}
+}
+
+func TestIpRangeSizeIPv6(t *testing.T) {
+	ipRange := IpRange{
+		Start: net.ParseIP("2001:db8::100"),
+		End:   net.ParseIP("2001:db8::1ff"),
+	}
+	assert.Equal(t, uint32(0xff), ipRange.Size())
+	ipRange.End = net.ParseIP("2001:db8::1:0:0:0")
+	assert.Equal(t, uint32(math.MaxUint32), ipRange.Size())
+	ipRange.End = net.ParseIP("10.1.0.1")
+	assert.Equal(t, uint32(0), ipRange.Size())
+}
+
+func TestGetIPAddrCountOnSubnetIPv6(t *testing.T) {
+	_, subnet, _ := net.ParseCIDR("2001:db8::/120")
+	assert.Equal(t, 256, GetIPAddrCountOnSubnet(*subnet))
+	_, subnet, _ = net.ParseCIDR("2001:db8::/64")
+	assert.Equal(t, math.MaxInt32, GetIPAddrCountOnSubnet(*subnet))
+}
+
+func TestGetIPv6SubnetFromPrefix(t *testing.T) {
+	testMatrix := map[string]struct {
+		prefix        string
+		index         int
+		expectFail    bool
+		expectedValue string
+	}{
+		"Test first /64 of /56": {
+			prefix:        "2001:db8:0:ab00::/56",
+			index:         0,
+			expectedValue: "2001:db8:0:ab00::/64",
+		},
+		"Test second /64 of /56": {
+			prefix:        "2001:db8:0:ab00::/56",
+			index:         1,
+			expectedValue: "2001:db8:0:ab01::/64",
+		},
+		"Test last /64 of /56": {
+			prefix:        "2001:db8:0:ab00::/56",
+			index:         255,
+			expectedValue: "2001:db8:0:abff::/64",
+		},
+		"Test beyond /56": {
+			prefix:     "2001:db8:0:ab00::/56",
+			index:      256,
+			expectFail: true,
+		},
+		"Test /64 prefix": {
+			prefix:        "2001:db8:0:1::/64",
+			index:         0,
+			expectedValue: "2001:db8:0:1::/64",
+		},
+		"Test second /64 of /64": {
+			prefix:     "2001:db8:0:1::/64",
+			index:      1,
+			expectFail: true,
+		},
+		"Test /80 prefix": {
+			prefix:     "2001:db8:0:1::/80",
+			index:      0,
+			expectFail: true,
+		},
+		"Test IPv4 prefix": {
+			prefix:     "10.1.0.0/16",
+			index:      0,
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		_, prefix, err := net.ParseCIDR(test.prefix)
+		assert.Nil(t, err)
+		value, err := GetIPv6SubnetFromPrefix(*prefix, test.index)
+		if test.expectFail {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedValue, value.String())
+		}
+	}
+}
+
+func TestVxlanGetMTU(t *testing.T) {
+	ipv4Peers := []VxlanPeer{{Address: net.ParseIP("192.168.1.2"),
+		TunnelAddress: net.ParseIP("10.99.0.2")}}
+	ipv6Peers := []VxlanPeer{{Address: net.ParseIP("2001:db8::2")}}
+	testMatrix := map[string]struct {
+		config        VxlanConfig
+		uplinkMTU     uint16
+		expectFail    bool
+		expectedValue uint16
+	}{
+		"Test derived MTU over IPv4": {
+			config:        VxlanConfig{Peers: ipv4Peers},
+			uplinkMTU:     1500,
+			expectedValue: 1450,
+		},
+		"Test derived MTU over IPv6": {
+			config:        VxlanConfig{Peers: ipv6Peers},
+			uplinkMTU:     1500,
+			expectedValue: 1430,
+		},
+		"Test derived MTU with IPsec": {
+			config: VxlanConfig{Peers: ipv4Peers,
+				Encryption: VxlanEncryptionIPsec},
+			uplinkMTU:     1500,
+			expectedValue: 1410,
+		},
+		"Test derived MTU with WireGuard": {
+			config: VxlanConfig{Peers: ipv4Peers,
+				Encryption: VxlanEncryptionWireGuard,
+				TunnelAddress: net.IPNet{IP: net.ParseIP("10.99.0.1"),
+					Mask: net.CIDRMask(24, 32)}},
+			uplinkMTU:     1500,
+			expectedValue: 1390,
+		},
+		"Test configured MTU": {
+			config:        VxlanConfig{Peers: ipv4Peers, MTU: 1400},
+			uplinkMTU:     1500,
+			expectedValue: 1400,
+		},
+		"Test configured MTU with jumbo uplink": {
+			config:        VxlanConfig{Peers: ipv4Peers, MTU: 8950},
+			uplinkMTU:     9000,
+			expectedValue: 8950,
+		},
+		"Test configured MTU too large": {
+			config:     VxlanConfig{Peers: ipv4Peers, MTU: 1500},
+			uplinkMTU:  1500,
+			expectFail: true,
+		},
+		"Test uplink MTU too small": {
+			config:     VxlanConfig{Peers: ipv6Peers},
+			uplinkMTU:  64,
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		value, err := test.config.GetMTU(test.uplinkMTU)
+		if test.expectFail {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedValue, value)
+		}
+	}
+}
+
+func TestWireGuardGetMTU(t *testing.T) {
+	ipv4Peers := []WireGuardPeer{{Endpoint: &net.UDPAddr{
+		IP: net.ParseIP("192.168.1.2"), Port: 51820}}}
+	ipv6Peers := append([]WireGuardPeer{{Endpoint: &net.UDPAddr{
+		IP: net.ParseIP("2001:db8::2"), Port: 51820}}}, ipv4Peers...)
+	testMatrix := map[string]struct {
+		config        WireGuardConfig
+		uplinkMTU     uint16
+		expectFail    bool
+		expectedValue uint16
+	}{
+		"Test derived MTU over IPv4": {
+			config:        WireGuardConfig{Peers: ipv4Peers},
+			uplinkMTU:     1500,
+			expectedValue: 1440,
+		},
+		"Test derived MTU with an IPv6 peer": {
+			config:        WireGuardConfig{Peers: ipv6Peers},
+			uplinkMTU:     1500,
+			expectedValue: 1420,
+		},
+		"Test derived MTU without known endpoints": {
+			config:        WireGuardConfig{Peers: []WireGuardPeer{{}}},
+			uplinkMTU:     1500,
+			expectedValue: 1440,
+		},
+		"Test configured MTU": {
+			config:        WireGuardConfig{Peers: ipv4Peers, MTU: 1280},
+			uplinkMTU:     1500,
+			expectedValue: 1280,
+		},
+		"Test configured MTU too large": {
+			config:     WireGuardConfig{Peers: ipv4Peers, MTU: 1450},
+			uplinkMTU:  1500,
+			expectFail: true,
+		},
+		"Test uplink MTU too small": {
+			config:     WireGuardConfig{Peers: ipv4Peers},
+			uplinkMTU:  60,
+			expectFail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		value, err := test.config.GetMTU(test.uplinkMTU)
+		if test.expectFail {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedValue, value)
+		}
+	}
+}
@@ -177,6 +177,57 @@ const (
 	StorageStatusSuspended   StorageStatus = 7 // A pool that is waiting for device connectivity to be restored.
 )
 
+// ZFSScanFunction indicates the kind of the pool scan
+type ZFSScanFunction int32
+
+// ZFSScanFunction enum should be in sync with info api
+const (
+	ZFSScanFunctionNone     ZFSScanFunction = 0 // pool was never scanned
+	ZFSScanFunctionScrub    ZFSScanFunction = 1 // all data is read and verified against checksums
+	ZFSScanFunctionResilver ZFSScanFunction = 2 // data is reconstructed onto a new or returned device
+)
+
+// ZFSScanState indicates the state of the pool scan
+type ZFSScanState int32
+
+// ZFSScanState enum should be in sync with info api
+const (
+	ZFSScanStateNone     ZFSScanState = 0 // no scan was started
+	ZFSScanStateScanning ZFSScanState = 1 // scan is in progress (or paused)
+	ZFSScanStateFinished ZFSScanState = 2 // scan has completed
+	ZFSScanStateCanceled ZFSScanState = 3 // scan was stopped before completion
+)
+
+// ZFSScanStatus stores progress of the current or the last scrub or resilver of zpool
+type ZFSScanStatus struct {
+	Function  ZFSScanFunction
+	State     ZFSScanState
+	Paused    bool      // scrub is paused, only possible with ZFSScanStateScanning
+	StartTime time.Time // time when the scan was started
+	EndTime   time.Time // time when the scan has finished or was canceled
+	ToExamine uint64    // total bytes to scan
+	Examined  uint64    // bytes scanned so far
+	Processed uint64    // bytes repaired by scrub or resilvered
+	Errors    uint64    // number of errors encountered by the scan
+	// ETA - estimated time remaining until the scan completes, computed from the
+	// rate of the current scan pass. Zero if the scan is not running or is paused.
+	ETA time.Duration
+}
+
+// Progress returns scanned part of the pool in percent
+func (s ZFSScanStatus) Progress() uint32 {
+	if s.State == ZFSScanStateFinished {
+		return 100
+	}
+	if s.ToExamine == 0 {
+		return 0
+	}
+	if s.Examined >= s.ToExamine {
+		return 100
+	}
+	return uint32(s.Examined * 100 / s.ToExamine)
+}
+
 // ZFSPoolStatus stores collected information about zpool
 type ZFSPoolStatus struct {
 	PoolName         string
@@ -189,8 +240,9 @@ type ZFSPoolStatus struct {
 	Disks            []*StorageDiskState
 	CollectorErrors  string
 	Children         []*StorageChildren
-	PoolStatusMsg    PoolStatus // pool status value from ZFS
-	PoolStatusMsgStr string     // pool status value from ZFS in string format
+	PoolStatusMsg    PoolStatus    // pool status value from ZFS
+	PoolStatusMsgStr string        // pool status value from ZFS in string format
+	ScanStatus       ZFSScanStatus // progress of the current or the last scrub or resilver
 }
 
 // Key for pubsub
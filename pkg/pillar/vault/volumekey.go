@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	utils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// Every volume encrypted with its own key has a random data key, which is
+// stored in types.VolumeKeysDirName wrapped with a key derived from the
+// vault key sealed into the TPM. Destroying the wrapped key is a best effort
+// to erase the content of the volume, see DestroyVolumeKey.
+
+const (
+	volumeKeyLen      = 32 //bytes
+	volumeKeyVersion  = 1
+	volumeKeyStageDir = "/run/TmpVolumeKeys"
+	// label of the key wrapping the volume keys, derived from the vault key
+	volumeKEKLabel = "eve volume key encryption key"
+)
+
+var (
+	errInvalidWrappedKey = errors.New("invalid wrapped volume key")
+)
+
+// VolumeKeysSupported returns true if volumes can be encrypted with their
+// own keys, which requires a TPM to seal the vault key
+func VolumeKeysSupported() bool {
+	return etpm.IsTpmEnabled()
+}
+
+// VolumeKeyExists returns true if the volume has its own key
+func VolumeKeyExists(volumeKey string) bool {
+	_, err := os.Stat(volumeKeyPath(volumeKey))
+	return err == nil
+}
+
+// CreateVolumeKey generates a new key for the volume and stores it
+// wrapped
+func CreateVolumeKey(log *base.LogObject, volumeKey string) error {
+	kek, err := volumeKEK(log)
+	if err != nil {
+		return err
+	}
+	key, err := etpm.GetRandom(volumeKeyLen)
+	if err != nil {
+		return fmt.Errorf("GetRandom failed: %w", err)
+	}
+	wrapped, err := wrapVolumeKey(kek, key, volumeKey)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(types.VolumeKeysDirName, 0700); err != nil {
+		return err
+	}
+	if err := utils.WriteRename(volumeKeyPath(volumeKey), wrapped); err != nil {
+		return fmt.Errorf("error saving key of volume %s: %w", volumeKey, err)
+	}
+	log.Noticef("CreateVolumeKey(%s) done", volumeKey)
+	return nil
+}
+
+// StageVolumeKey writes the unwrapped key of the volume into a tmpfs file
+// for zfs and cryptsetup. Returns the file and the function to remove it.
+func StageVolumeKey(log *base.LogObject, volumeKey string) (string, func(), error) {
+	wrapped, err := ioutil.ReadFile(volumeKeyPath(volumeKey))
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading key of volume %s: %w", volumeKey, err)
+	}
+	kek, err := volumeKEK(log)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := unwrapVolumeKey(kek, wrapped, volumeKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("error unwrapping key of volume %s: %w", volumeKey, err)
+	}
+	if err := os.MkdirAll(volumeKeyStageDir, 0700); err != nil {
+		return "", nil, err
+	}
+	keyFile := filepath.Join(volumeKeyStageDir, volumeKeyFileName(volumeKey))
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		return "", nil, fmt.Errorf("error creating keyFile: %w", err)
+	}
+	return keyFile, func() { unstageVolumeKey(log, keyFile) }, nil
+}
+
+func unstageVolumeKey(log *base.LogObject, keyFile string) {
+	if _, _, err := execCmd("shred", "--remove", keyFile); err != nil {
+		log.Errorf("Error shredding keyFile %s: %v", keyFile, err)
+	}
+}
+
+// DestroyVolumeKey deletes the key of the volume. The wrapped key is
+// overwritten before it is removed, but that does not guarantee its erasure:
+// zfs writes the new data to other blocks, and the flash translation layer
+// of SSDs and eMMCs remaps them too, so the previous content of the file can
+// remain on the disk. Such a remnant, like a copy of the disk, can only be
+// unwrapped with the vault key sealed into the TPM of the device, hence the
+// content of the volume stays readable for whoever controls the device with
+// the TPM state unchanged, until the vault key itself is destroyed.
+func DestroyVolumeKey(log *base.LogObject, volumeKey string) error {
+	keyPath := volumeKeyPath(volumeKey)
+	if _, err := os.Stat(keyPath); os.IsNotExist(err) {
+		return nil
+	}
+	if stdOut, stdErr, err := execCmd("shred", "--remove", keyPath); err != nil {
+		return fmt.Errorf("error shredding key of volume %s: %v, %s, %s",
+			volumeKey, err, stdOut, stdErr)
+	}
+	log.Noticef("DestroyVolumeKey(%s) done", volumeKey)
+	return utils.DirSync(types.VolumeKeysDirName)
+}
+
+func volumeKeyFileName(volumeKey string) string {
+	return strings.ReplaceAll(volumeKey, "/", "_")
+}
+
+func volumeKeyPath(volumeKey string) string {
+	return filepath.Join(types.VolumeKeysDirName, volumeKeyFileName(volumeKey))
+}
+
+// volumeKEK returns the key wrapping the volume keys
+func volumeKEK(log *base.LogObject) ([]byte, error) {
+	if !VolumeKeysSupported() {
+		return nil, errors.New("volume keys require a TPM")
+	}
+	vaultKey, err := etpm.FetchSealedVaultKey(log)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching vault key: %w", err)
+	}
+	return deriveVolumeKEK(vaultKey), nil
+}
+
+func deriveVolumeKEK(vaultKey []byte) []byte {
+	mac := hmac.New(sha256.New, vaultKey)
+	mac.Write([]byte(volumeKEKLabel))
+	return mac.Sum(nil)
+}
+
+// wrapVolumeKey encrypts the key with AES-GCM, authenticating the volume it
+// belongs to. The result is the version, the nonce and the sealed key.
+func wrapVolumeKey(kek, key []byte, volumeKey string) ([]byte, error) {
+	gcm, err := newVolumeKeyCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	wrapped := append([]byte{volumeKeyVersion}, nonce...)
+	return gcm.Seal(wrapped, nonce, key, []byte(volumeKey)), nil
+}
+
+func unwrapVolumeKey(kek, wrapped []byte, volumeKey string) ([]byte, error) {
+	gcm, err := newVolumeKeyCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < 1+gcm.NonceSize() || wrapped[0] != volumeKeyVersion {
+		return nil, errInvalidWrappedKey
+	}
+	nonce := wrapped[1 : 1+gcm.NonceSize()]
+	key, err := gcm.Open(nil, nonce, wrapped[1+gcm.NonceSize():], []byte(volumeKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != volumeKeyLen {
+		return nil, errInvalidKeyLen
+	}
+	return key, nil
+}
+
+func newVolumeKeyCipher(kek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrapVolumeKey(t *testing.T) {
+	kek := deriveVolumeKEK(bytes.Repeat([]byte{1}, vaultKeyLen))
+	key := bytes.Repeat([]byte{2}, volumeKeyLen)
+	volume := "6ba7b810-9dad-11d1-80b4-00c04fd430c8#1"
+
+	wrapped, err := wrapVolumeKey(kek, key, volume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(wrapped, key) {
+		t.Fatalf("wrapped key contains the key")
+	}
+	unwrapped, err := unwrapVolumeKey(kek, wrapped, volume)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unwrapped, key) {
+		t.Errorf("unwrapped key %x, expected %x", unwrapped, key)
+	}
+
+	// the wrapped key is bound to the volume
+	if _, err := unwrapVolumeKey(kek, wrapped, "6ba7b810-9dad-11d1-80b4-00c04fd430c8#2"); err == nil {
+		t.Errorf("unwrapped the key of another volume")
+	}
+	otherKEK := deriveVolumeKEK(bytes.Repeat([]byte{3}, vaultKeyLen))
+	if _, err := unwrapVolumeKey(otherKEK, wrapped, volume); err == nil {
+		t.Errorf("unwrapped with another vault key")
+	}
+	if _, err := unwrapVolumeKey(kek, wrapped[:8], volume); err != errInvalidWrappedKey {
+		t.Errorf("unexpected error for a truncated key: %v", err)
+	}
+}
@@ -4,10 +4,26 @@
 package volumehandlers
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/edge-containers/pkg/registry"
+	"github.com/lf-edge/eve/pkg/pillar/appexport"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
+// importBlockSize is the size of the blocks written when importing the
+// content of a volume; the blocks of zeroes are skipped on targets which
+// read as zeroes
+const importBlockSize = 1 << 20
+
 // commonVolumeHandler stores common data and implements common functions
 // that may be re-implemented in explicit handlers
 type commonVolumeHandler struct {
@@ -20,6 +36,18 @@ func (handler *commonVolumeHandler) PrepareVolume() error { return nil }
 
 func (handler *commonVolumeHandler) HandlePrepared() (bool, error) { return true, nil }
 
+func (handler *commonVolumeHandler) CreateSnapshot(string) error { return ErrSnapshotNotSupported }
+
+func (handler *commonVolumeHandler) ListSnapshots() ([]types.VolumeSnapshot, error) {
+	return nil, ErrSnapshotNotSupported
+}
+
+func (handler *commonVolumeHandler) RollbackToSnapshot(string) error {
+	return ErrSnapshotNotSupported
+}
+
+func (handler *commonVolumeHandler) DeleteSnapshot(string) error { return ErrSnapshotNotSupported }
+
 func (handler *commonVolumeHandler) UsageFromStatus() uint64 {
 	sizeToUseInCalculation := uint64(handler.status.CurrentSize)
 	hasNoAppReferences := false
@@ -48,3 +76,180 @@ func (handler *commonVolumeHandler) UsageFromStatus() uint64 {
 	}
 	return sizeToUseInCalculation
 }
+
+// getVolumeFilePath returns the path of the blob of the content tree of the
+// volume in the content store of containerd
+func (handler *commonVolumeHandler) getVolumeFilePath() (string, error) {
+	puller := registry.Puller{
+		Image: handler.status.ReferenceName,
+	}
+	ctrdCtx, done := handler.volumeManager.GetCasClient().CtrNewUserServicesCtx()
+	defer done()
+
+	resolver, err := handler.volumeManager.GetCasClient().Resolver(ctrdCtx)
+	if err != nil {
+		errStr := fmt.Sprintf("error getting CAS resolver: %v", err)
+		handler.log.Error(errStr)
+		return "", errors.New(errStr)
+	}
+	pathToFile := ""
+	_, i, err := puller.Config(true, os.Stderr, resolver)
+	if err != nil {
+		errStr := fmt.Sprintf("error Config for ref %s: %v", handler.status.ReferenceName, err)
+		handler.log.Error(errStr)
+		return "", errors.New(errStr)
+	}
+	if len(i.RootFS.DiffIDs) > 0 {
+		// the image of a volume has its content in the first layer
+		b := i.RootFS.DiffIDs[0]
+		pathToFile = filepath.Join(types.ContainerdContentDir, "blobs", b.Algorithm().String(), b.Encoded())
+	}
+
+	if pathToFile == "" {
+		errStr := fmt.Sprintf("no blobs to convert found for ref %s", handler.status.ReferenceName)
+		handler.log.Error(errStr)
+		return "", errors.New(errStr)
+	}
+	return pathToFile, nil
+}
+
+// exportFile writes the content of the file or device at path into the app
+// archive in dir
+func (handler *commonVolumeHandler) exportFile(dir, path, format string) (types.VolumeExport, error) {
+	ve, err := appexport.ExportVolume(dir, handler.status.VolumeID, format,
+		func(w io.Writer) error {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = io.Copy(w, f)
+			return err
+		})
+	if err != nil {
+		errStr := fmt.Sprintf("Error exporting %s to %s: %v", path, dir, err)
+		handler.log.Error(errStr)
+		return ve, errors.New(errStr)
+	}
+	handler.log.Functionf("ExportVolume(%s) DONE", handler.status.Key())
+	return ve, nil
+}
+
+// importedVolume returns the app archive in the import directory which has
+// the content of the volume, and the volume in its manifest, or nil if no
+// archive has it. Only archives exported by this device or by the devices
+// in the allow-list in /config are trusted.
+func (handler *commonVolumeHandler) importedVolume() (string, *appexport.Volume) {
+	if _, err := os.Stat(types.AppImportDirname); err != nil {
+		return "", nil
+	}
+	trusted, err := appexport.LoadTrustedCerts(types.DeviceCertName,
+		types.AppImportTrustedCertsName)
+	if err != nil {
+		handler.log.Errorf("importedVolume: cannot load trusted certificates: %v", err)
+		return "", nil
+	}
+	return appexport.FindVolume(handler.log, types.AppImportDirname, trusted,
+		handler.status.VolumeID)
+}
+
+// importVolume writes the content of the volume from the app archive in
+// dir to target, which is a file or a device of the format. Content of
+// another format is converted by qemu-img. The content is removed from the
+// archive once imported.
+func (handler *commonVolumeHandler) importVolume(ctx context.Context, dir string,
+	volume appexport.Volume, target, format string, targetIsZero bool) error {
+
+	r, err := appexport.OpenVolume(dir, volume)
+	if err != nil {
+		errStr := fmt.Sprintf("Error opening volume %s in app archive %s: %v",
+			volume.VolumeID, dir, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	defer r.Close()
+	if volume.Format == format {
+		err = writeImportedContent(r, target, targetIsZero)
+	} else {
+		// qemu-img converts from a file, which is written into the archive
+		tmpFile := filepath.Join(dir, volume.VolumeID.String()+"."+volume.Format)
+		defer os.Remove(tmpFile)
+		err = writeImportedContent(r, tmpFile, true)
+		if err == nil {
+			err = handler.convertImportedContent(ctx, tmpFile, target, format, targetIsZero)
+		}
+	}
+	if err != nil {
+		errStr := fmt.Sprintf("Error importing volume %s from app archive %s to %s: %v",
+			volume.VolumeID, dir, target, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	if err := appexport.ReleaseVolume(dir, volume); err != nil {
+		handler.log.Warnf("importVolume(%s): cannot remove the imported content: %v",
+			handler.status.Key(), err)
+	}
+	handler.log.Noticef("importVolume(%s) imported %s content from app archive %s",
+		handler.status.Key(), volume.Format, dir)
+	return nil
+}
+
+// convertImportedContent converts the imported file to target. A file
+// target is created with the virtual size of the imported file.
+func (handler *commonVolumeHandler) convertImportedContent(ctx context.Context,
+	file, target, format string, targetIsZero bool) error {
+
+	if _, err := os.Stat(target); errors.Is(err, os.ErrNotExist) {
+		size, err := diskmetrics.GetDiskVirtualSize(handler.log, file)
+		if err != nil {
+			return err
+		}
+		if err := diskmetrics.CreateImg(ctx, handler.log, target, format, size); err != nil {
+			return err
+		}
+	}
+	if targetIsZero {
+		return diskmetrics.RolloutImgToBlock(ctx, handler.log, file, target, format)
+	}
+	return diskmetrics.ConvertImgToBlock(ctx, handler.log, file, target, format)
+}
+
+// writeImportedContent writes the content read from r to the file or
+// device at target. The blocks of zeroes are skipped if the target reads
+// as zeroes, which leaves files sparse.
+func writeImportedContent(r io.Reader, target string, targetIsZero bool) error {
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zeroes := make([]byte, importBlockSize)
+	buf := make([]byte, importBlockSize)
+	var offset int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if targetIsZero && bytes.Equal(buf[:n], zeroes[:n]) {
+				if _, err := f.Seek(int64(n), io.SeekCurrent); err != nil {
+					return err
+				}
+			} else if _, err := f.Write(buf[:n]); err != nil {
+				return err
+			}
+			offset += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+		// the size of the file if the last blocks were skipped
+		if err := f.Truncate(offset); err != nil {
+			return err
+		}
+	}
+	return f.Sync()
+}
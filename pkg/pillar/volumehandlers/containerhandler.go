@@ -5,11 +5,14 @@ package volumehandlers
 
 import (
 	"fmt"
+	"io"
 	"os"
 
+	"github.com/lf-edge/eve/pkg/pillar/appexport"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	utils "github.com/lf-edge/eve/pkg/pillar/utils/file"
 )
 
@@ -64,6 +67,12 @@ func (handler *volumeHandlerContainer) CreateVolume() (string, error) {
 		handler.log.Errorf("Failed to create ctr bundle. Error %s", err)
 		return fileLocation, err
 	}
+	if dir, imported := handler.importedVolume(); imported != nil {
+		if err := handler.importSnapshot(dir, *imported, fileLocation); err != nil {
+			handler.log.Error(err)
+			return fileLocation, err
+		}
+	}
 	if err := utils.DirSync(fileLocation); err != nil {
 		handler.log.Errorf("Failed to sync directory. Error %s", err)
 		return fileLocation, err
@@ -82,6 +91,52 @@ func (handler *volumeHandlerContainer) DestroyVolume() (string, error) {
 	return "", nil
 }
 
+// ExportVolume exports the changes of the snapshot of the container to the
+// image it was created from
+func (handler *volumeHandlerContainer) ExportVolume(dir string) (types.VolumeExport, error) {
+	snapshotID := containerd.GetSnapshotID(handler.status.FileLocation)
+	ve, err := appexport.ExportVolume(dir, handler.status.VolumeID,
+		appexport.ContainerFormat, func(w io.Writer) error {
+			return handler.volumeManager.GetCasClient().ExportSnapshot(snapshotID, w)
+		})
+	if err != nil {
+		err = fmt.Errorf("error exporting snapshot %s to %s: %v", snapshotID, dir, err)
+		handler.log.Error(err)
+		return ve, err
+	}
+	handler.log.Functionf("ExportVolume(%s) DONE", handler.status.Key())
+	return ve, nil
+}
+
+// importSnapshot applies the changes of the container exported by another
+// device to the snapshot prepared in fileLocation
+func (handler *volumeHandlerContainer) importSnapshot(dir string,
+	volume appexport.Volume, fileLocation string) error {
+
+	if volume.Format != appexport.ContainerFormat {
+		return fmt.Errorf("cannot import %s content of volume %s into a container",
+			volume.Format, volume.VolumeID)
+	}
+	r, err := appexport.OpenVolume(dir, volume)
+	if err != nil {
+		return fmt.Errorf("error opening volume %s in app archive %s: %v",
+			volume.VolumeID, dir, err)
+	}
+	defer r.Close()
+	snapshotID := containerd.GetSnapshotID(fileLocation)
+	if err := handler.volumeManager.GetCasClient().ImportSnapshot(snapshotID, r); err != nil {
+		return fmt.Errorf("error importing volume %s from app archive %s into snapshot %s: %v",
+			volume.VolumeID, dir, snapshotID, err)
+	}
+	if err := appexport.ReleaseVolume(dir, volume); err != nil {
+		handler.log.Warnf("importSnapshot(%s): cannot remove the imported content: %v",
+			handler.status.Key(), err)
+	}
+	handler.log.Noticef("importSnapshot(%s) imported snapshot %s from app archive %s",
+		handler.status.Key(), snapshotID, dir)
+	return nil
+}
+
 func (handler *volumeHandlerContainer) Populate() (bool, error) {
 	if _, err := os.Stat(handler.status.PathName()); err == nil {
 		handler.status.FileLocation = handler.status.PathName()
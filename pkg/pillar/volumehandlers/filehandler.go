@@ -8,12 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/lf-edge/edge-containers/pkg/registry"
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
 type volumeHandlerFile struct {
@@ -85,7 +87,20 @@ func (handler *volumeHandlerFile) CreateVolume() (string, error) {
 		handler.log.Error(errStr)
 		return "", errors.New(errStr)
 	}
-	if handler.status.ReferenceName != "" {
+	if dir, imported := handler.importedVolume(); imported != nil {
+		// the content of the volume exported by another device replaces
+		// the content tree
+		if err := handler.importVolume(createContext, dir, *imported, fileLocation,
+			strings.ToLower(handler.status.ContentFormat.String()), true); err != nil {
+			return fileLocation, err
+		}
+		if handler.expandableDisk() {
+			if err := handler.maybeResizeDisk(createContext, fileLocation, handler.status.MaxVolSize); err != nil {
+				handler.log.Error(err)
+				return fileLocation, err
+			}
+		}
+	} else if handler.status.ReferenceName != "" {
 		// use the edge-containers library to extract the data we need
 		puller := registry.Puller{
 			Image: handler.status.ReferenceName,
@@ -172,6 +187,11 @@ func (handler *volumeHandlerFile) Populate() (bool, error) {
 	return false, nil
 }
 
+func (handler *volumeHandlerFile) ExportVolume(dir string) (types.VolumeExport, error) {
+	return handler.exportFile(dir, handler.status.FileLocation,
+		strings.ToLower(handler.status.ContentFormat.String()))
+}
+
 // expandableDisk returns true if we should try to expand disk to the provided max volume size
 func (handler *volumeHandlerFile) expandableDisk() bool {
 	if handler.status.ContentFormat == zconfig.Format_ISO {
@@ -198,3 +218,76 @@ func (handler *volumeHandlerFile) maybeResizeDisk(ctx context.Context, diskfile
 	}
 	return nil
 }
+
+// snapshots are internal snapshots of qcow2 images, which keep the
+// snapshots in the image file itself. External snapshots are not supported:
+// they would make a new overlay file the active image of the volume, which
+// changes the FileLocation the domain and the volume metrics refer to, and
+// raw images have no internal snapshots, hence ErrSnapshotNotSupported.
+func (handler *volumeHandlerFile) snapshotsSupported() bool {
+	return handler.status.ContentFormat == zconfig.Format_QCOW2
+}
+
+func (handler *volumeHandlerFile) CreateSnapshot(name string) error {
+	if !handler.snapshotsSupported() {
+		return ErrSnapshotNotSupported
+	}
+	if err := diskmetrics.CreateSnapshot(handler.log, handler.status.FileLocation, name); err != nil {
+		errStr := fmt.Sprintf("Error creating snapshot %s of %s: %v",
+			name, handler.status.FileLocation, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	handler.log.Functionf("CreateSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
+}
+
+func (handler *volumeHandlerFile) ListSnapshots() ([]types.VolumeSnapshot, error) {
+	if !handler.snapshotsSupported() {
+		return nil, ErrSnapshotNotSupported
+	}
+	imgInfo, err := diskmetrics.GetImgInfo(handler.log, handler.status.FileLocation)
+	if err != nil {
+		return nil, fmt.Errorf("ListSnapshots/GetImgInfo failed for %s: %v",
+			handler.status.FileLocation, err)
+	}
+	var snapshots []types.VolumeSnapshot
+	for _, snapshot := range imgInfo.Snapshots {
+		snapshots = append(snapshots, types.VolumeSnapshot{
+			Name:       snapshot.Name,
+			CreateTime: time.Unix(snapshot.DateSec, 0),
+		})
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreateTime.Before(snapshots[j].CreateTime)
+	})
+	return snapshots, nil
+}
+
+func (handler *volumeHandlerFile) RollbackToSnapshot(name string) error {
+	if !handler.snapshotsSupported() {
+		return ErrSnapshotNotSupported
+	}
+	if err := diskmetrics.ApplySnapshot(handler.log, handler.status.FileLocation, name); err != nil {
+		errStr := fmt.Sprintf("Error reverting %s to snapshot %s: %v",
+			handler.status.FileLocation, name, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	handler.log.Functionf("RollbackToSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
+}
+
+func (handler *volumeHandlerFile) DeleteSnapshot(name string) error {
+	if !handler.snapshotsSupported() {
+		return ErrSnapshotNotSupported
+	}
+	if err := diskmetrics.DeleteSnapshot(handler.log, handler.status.FileLocation, name); err != nil {
+		errStr := fmt.Sprintf("Error deleting snapshot %s of %s: %v",
+			name, handler.status.FileLocation, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	handler.log.Functionf("DeleteSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
+}
@@ -4,6 +4,8 @@
 package volumehandlers
 
 import (
+	"errors"
+
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -30,8 +32,25 @@ type VolumeHandler interface {
 	// with current options of VolumeStatus
 	// to be used in storage usage calculation
 	UsageFromStatus() uint64
+	// CreateSnapshot creates a snapshot of the volume with the name.
+	// The volume must not be in use by a running app instance.
+	CreateSnapshot(name string) error
+	// ListSnapshots returns the snapshots of the volume, oldest first
+	ListSnapshots() ([]types.VolumeSnapshot, error)
+	// RollbackToSnapshot reverts the content of the volume to the snapshot
+	// with the name. The volume must not be in use by a running app instance.
+	RollbackToSnapshot(name string) error
+	// DeleteSnapshot deletes the snapshot with the name
+	DeleteSnapshot(name string) error
+	// ExportVolume writes the content of the volume into the app archive
+	// in dir. The volume must not be in use by a running app instance.
+	ExportVolume(dir string) (types.VolumeExport, error)
 }
 
+// ErrSnapshotNotSupported is returned by the snapshot functions of the
+// handlers of volumes which do not support snapshots
+var ErrSnapshotNotSupported = errors.New("snapshots are not supported for the volume")
+
 // VolumeMgr is an interface to obtain information required for volume processing
 type VolumeMgr interface {
 	LookupVolumeConfig(key string) *types.VolumeConfig
@@ -61,6 +80,9 @@ func GetVolumeHandler(log *base.LogObject, volumeManager VolumeMgr, status *type
 	if status.UseZVolDisk(vault.ReadPersistType()) {
 		return &volumeHandlerZVol{commonVolumeHandler: common, useVHost: useVhost(log, volumeManager)}
 	}
+	if status.KeyEncrypted {
+		return &volumeHandlerLUKS{common}
+	}
 	return &volumeHandlerFile{common}
 }
 
@@ -0,0 +1,295 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumehandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+)
+
+const (
+	cryptsetupBinary = "/sbin/cryptsetup"
+	devMapperDir     = "/dev/mapper"
+	// luksHeaderSize is the default size of the LUKS2 header, allocated in
+	// front of the content of the volume
+	luksHeaderSize = 16 << 20
+)
+
+// Operations on the keys of the volumes encrypted with their own key,
+// replaced in the tests
+var (
+	volumeKeyExists  = vault.VolumeKeyExists
+	createVolumeKey  = vault.CreateVolumeKey
+	stageVolumeKey   = vault.StageVolumeKey
+	destroyVolumeKey = vault.DestroyVolumeKey
+)
+
+// volumeHandlerLUKS handles the file volumes encrypted with their own key.
+// The file is a LUKS container opened with cryptsetup, and the content of
+// the volume is stored raw in the device mapped on top of it.
+type volumeHandlerLUKS struct {
+	commonVolumeHandler
+}
+
+// mapperName returns the name of the device mapped on top of the file
+func (handler *volumeHandlerLUKS) mapperName() string {
+	return fmt.Sprintf("vol-%s-%d", handler.status.VolumeID,
+		handler.status.GenerationCounter+handler.status.LocalGenerationCounter)
+}
+
+func (handler *volumeHandlerLUKS) mapperDevice() string {
+	return filepath.Join(devMapperDir, handler.mapperName())
+}
+
+func (handler *volumeHandlerLUKS) GetVolumeDetails() (uint64, uint64, string, bool, error) {
+	imgInfo, err := diskmetrics.GetImgInfo(handler.log, handler.status.PathName())
+	if err != nil {
+		return 0, 0, "", false, fmt.Errorf("GetVolumeSize/GetImgInfo failed for %s: %v",
+			handler.status.PathName(), err)
+	}
+	f, err := os.Open(handler.mapperDevice())
+	if err != nil {
+		return 0, 0, "", false, fmt.Errorf("GetVolumeSize failed for %s: %v",
+			handler.mapperDevice(), err)
+	}
+	defer f.Close()
+	virtualSize, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, 0, "", false, fmt.Errorf("GetVolumeSize failed for %s: %v",
+			handler.mapperDevice(), err)
+	}
+	return imgInfo.ActualSize, uint64(virtualSize), "raw", false, nil
+}
+
+func (handler *volumeHandlerLUKS) PrepareVolume() error {
+	size := handler.status.MaxVolSize
+	if handler.status.ReferenceName != "" {
+		pathToFile, err := handler.getVolumeFilePath()
+		if err != nil {
+			errStr := fmt.Sprintf("Error obtaining file for volume %s, error=%v",
+				handler.status.Key(), err)
+			handler.log.Error(errStr)
+			return errors.New(errStr)
+		}
+		size, _, err = diskmetrics.CheckResizeDisk(handler.log, pathToFile, handler.status.MaxVolSize)
+		if err != nil {
+			errStr := fmt.Sprintf("Error creating LUKS volume at checkResizeDisk %s, error=%v",
+				pathToFile, err)
+			handler.log.Error(errStr)
+			return errors.New(errStr)
+		}
+	}
+	return handler.formatContainer(handler.status.PathName(), size)
+}
+
+// formatContainer creates the LUKS container of the given size for the
+// content of the volume. The key of the volume is created only if there is
+// none yet: PrepareVolume is retried after a failure and the container may
+// have been formatted with the existing key already.
+func (handler *volumeHandlerLUKS) formatContainer(fileLocation string, size uint64) error {
+	if !volumeKeyExists(handler.status.Key()) {
+		if err := createVolumeKey(handler.log, handler.status.Key()); err != nil {
+			errStr := fmt.Sprintf("Error creating key for volume %s, error=%v",
+				handler.status.Key(), err)
+			handler.log.Error(errStr)
+			return errors.New(errStr)
+		}
+	}
+	keyFile, unstage, err := stageVolumeKey(handler.log, handler.status.Key())
+	if err != nil {
+		errStr := fmt.Sprintf("Error staging key for volume %s, error=%v",
+			handler.status.Key(), err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	defer unstage()
+	f, err := os.Create(fileLocation)
+	if err != nil {
+		errStr := fmt.Sprintf("Error creating %s: %v", fileLocation, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	// sparse, the blocks are allocated by the writes of the app instance
+	err = f.Truncate(int64(size) + luksHeaderSize)
+	f.Close()
+	if err != nil {
+		errStr := fmt.Sprintf("Error allocating %s: %v", fileLocation, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	if output, err := cryptsetup(handler.log, "luksFormat", "--batch-mode",
+		"--type", "luks2", "--key-file", keyFile, fileLocation); err != nil {
+		errStr := fmt.Sprintf("Error formatting LUKS volume %s: %s %v",
+			fileLocation, output, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+func (handler *volumeHandlerLUKS) HandleCreated() (bool, error) {
+	handler.status.ContentFormat = zconfig.Format_RAW
+	updateVolumeSizes(handler.log, handler, handler.status)
+	return true, nil
+}
+
+func (handler *volumeHandlerLUKS) CreateVolume() (string, error) {
+	createContext := context.Background()
+
+	// we call createCancel to break volume creation process
+	// removing of partially created objects must be done inside caller
+	createContext, createCancel := context.WithCancel(createContext)
+
+	done := make(chan bool, 1)
+
+	defer func() {
+		done <- true
+	}()
+
+	go func() {
+		timer := time.NewTicker(time.Second)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+				st := handler.volumeManager.LookupVolumeStatus(handler.status.Key())
+				// it disappears in case of deleting of volume config
+				if st == nil {
+					handler.log.Warnf("CreateVolume: VolumeStatus(%s) disappear during creation", handler.status.Key())
+					createCancel()
+					return
+				}
+			case <-done:
+				createCancel()
+				return
+			}
+		}
+	}()
+
+	if err := handler.openDevice(); err != nil {
+		handler.log.Error(err)
+		return "", err
+	}
+	device := handler.mapperDevice()
+	if dir, imported := handler.importedVolume(); imported != nil {
+		// the mapped device does not read as zeroes, so every block of
+		// the content is written
+		if err := handler.importVolume(createContext, dir, *imported, device,
+			"raw", false); err != nil {
+			return device, err
+		}
+	} else if handler.status.ReferenceName != "" {
+		pathToFile, err := handler.getVolumeFilePath()
+		if err != nil {
+			errStr := fmt.Sprintf("Error obtaining file for volume %s, error=%v",
+				handler.status.Key(), err)
+			handler.log.Error(errStr)
+			return device, errors.New(errStr)
+		}
+		if err := diskmetrics.ConvertImgToBlock(createContext, handler.log, pathToFile, device, "raw"); err != nil {
+			errStr := fmt.Sprintf("Error converting %s to LUKS volume %s: %v",
+				pathToFile, device, err)
+			handler.log.Error(errStr)
+			return device, errors.New(errStr)
+		}
+		f, err := os.Open(device)
+		if err != nil {
+			errStr := fmt.Sprintf("Error opening LUKS volume %s: %v",
+				device, err)
+			handler.log.Error(errStr)
+			return device, errors.New(errStr)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				handler.log.Errorf("error closing LUKS volume: %s: %v", device, err)
+			}
+		}()
+		if err := f.Sync(); err != nil {
+			errStr := fmt.Sprintf("Error syncing LUKS volume %s: %v", device, err)
+			handler.log.Error(errStr)
+			return device, errors.New(errStr)
+		}
+	}
+
+	handler.log.Functionf("Extract DONE from %s to %s", handler.status.ReferenceName, device)
+
+	handler.log.Functionf("CreateVolume(%s) DONE", handler.status.Key())
+	return device, nil
+}
+
+// DestroyVolume removes the LUKS container and destroys the key of the
+// volume, so that a copy of the container can not be decrypted anymore
+func (handler *volumeHandlerLUKS) DestroyVolume() (string, error) {
+	if _, err := os.Stat(handler.mapperDevice()); err == nil {
+		if output, err := cryptsetup(handler.log, "close", handler.mapperName()); err != nil {
+			errStr := fmt.Sprintf("Error closing LUKS volume %s: %s %v",
+				handler.mapperDevice(), output, err)
+			handler.log.Error(errStr)
+			return "", errors.New(errStr)
+		}
+	}
+	if err := os.RemoveAll(handler.status.PathName()); err != nil {
+		handler.log.Error(err)
+		return "", err
+	}
+	if err := destroyVolumeKey(handler.log, handler.status.Key()); err != nil {
+		handler.log.Error(err)
+		return "", err
+	}
+	handler.log.Functionf("destroyVolume(%s) DONE", handler.status.Key())
+	return "", nil
+}
+
+func (handler *volumeHandlerLUKS) Populate() (bool, error) {
+	if _, err := os.Stat(handler.status.PathName()); err != nil {
+		return false, nil
+	}
+	if err := handler.openDevice(); err != nil {
+		return false, err
+	}
+	handler.status.FileLocation = handler.mapperDevice()
+	handler.status.ContentFormat = zconfig.Format_RAW
+	return true, nil
+}
+
+// ExportVolume exports the decrypted content of the volume, which is
+// encrypted again with the key of the volume created by the importing device
+func (handler *volumeHandlerLUKS) ExportVolume(dir string) (types.VolumeExport, error) {
+	return handler.exportFile(dir, handler.mapperDevice(), "raw")
+}
+
+// openDevice maps the decrypted device on top of the LUKS container
+func (handler *volumeHandlerLUKS) openDevice() error {
+	if _, err := os.Stat(handler.mapperDevice()); err == nil {
+		return nil
+	}
+	keyFile, unstage, err := stageVolumeKey(handler.log, handler.status.Key())
+	if err != nil {
+		return fmt.Errorf("cannot stage key of volume %s: %v", handler.status.Key(), err)
+	}
+	defer unstage()
+	if output, err := cryptsetup(handler.log, "open", "--type", "luks2",
+		"--key-file", keyFile, handler.status.PathName(), handler.mapperName()); err != nil {
+		return fmt.Errorf("cannot open LUKS volume %s: %s %v",
+			handler.status.PathName(), output, err)
+	}
+	return nil
+}
+
+// cryptsetup runs cryptsetup with the arguments, replaced in the tests
+var cryptsetup = func(log *base.LogObject, args ...string) (string, error) {
+	output, err := base.Exec(log, cryptsetupBinary, args...).CombinedOutput()
+	return string(output), err
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumehandlers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// testVolumeKeys keeps the keys of the volumes in memory and stages them
+// into dir
+type testVolumeKeys struct {
+	dir     string
+	keys    map[string][]byte
+	created int
+}
+
+func (k *testVolumeKeys) exists(volumeKey string) bool {
+	_, ok := k.keys[volumeKey]
+	return ok
+}
+
+func (k *testVolumeKeys) create(log *base.LogObject, volumeKey string) error {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	k.keys[volumeKey] = key
+	k.created++
+	return nil
+}
+
+func (k *testVolumeKeys) stage(log *base.LogObject, volumeKey string) (string, func(), error) {
+	key, ok := k.keys[volumeKey]
+	if !ok {
+		return "", nil, fmt.Errorf("no key for volume %s", volumeKey)
+	}
+	keyFile := filepath.Join(k.dir, "key")
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		return "", nil, err
+	}
+	return keyFile, func() { os.Remove(keyFile) }, nil
+}
+
+func TestLUKSPrepareRetry(t *testing.T) {
+	dir := t.TempDir()
+	keys := &testVolumeKeys{dir: dir, keys: make(map[string][]byte)}
+	volumeKeyExists, createVolumeKey, stageVolumeKey = keys.exists, keys.create, keys.stage
+	// luksFormat records the key of the container, the first one fails
+	// after writing the header, as if cryptsetup was interrupted
+	var formatKeys [][]byte
+	cryptsetup = func(log *base.LogObject, args ...string) (string, error) {
+		if args[0] != "luksFormat" {
+			return "", fmt.Errorf("unexpected cryptsetup %v", args)
+		}
+		key, err := ioutil.ReadFile(args[len(args)-2])
+		if err != nil {
+			return "", err
+		}
+		formatKeys = append(formatKeys, key)
+		if len(formatKeys) == 1 {
+			return "", errors.New("interrupted")
+		}
+		return "", nil
+	}
+
+	handler := &volumeHandlerLUKS{commonVolumeHandler{
+		status: &types.VolumeStatus{
+			VolumeID:   uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			MaxVolSize: 1 << 20,
+		},
+		log: base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234),
+	}}
+	fileLocation := filepath.Join(dir, "volume")
+	if err := handler.formatContainer(fileLocation, 1<<20); err == nil {
+		t.Fatalf("first attempt succeeded")
+	}
+	if err := handler.formatContainer(fileLocation, 1<<20); err != nil {
+		t.Fatalf("retry failed: %v", err)
+	}
+	if keys.created != 1 {
+		t.Errorf("key created %d times", keys.created)
+	}
+	if len(formatKeys) != 2 || !bytes.Equal(formatKeys[0], formatKeys[1]) {
+		t.Errorf("retry formatted the container with a different key")
+	}
+	if !bytes.Equal(formatKeys[1], keys.keys[handler.status.Key()]) {
+		t.Errorf("container formatted with a key which is not the key of the volume")
+	}
+}
@@ -8,10 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"time"
 
-	"github.com/lf-edge/edge-containers/pkg/registry"
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/tgt"
@@ -66,6 +64,9 @@ func (handler *volumeHandlerZVol) PrepareVolume() error {
 		}
 	}
 	zVolName := handler.status.ZVolName()
+	if handler.status.KeyEncrypted {
+		return handler.createEncryptedZVol(zVolName, size)
+	}
 	if err := zfs.CreateVolumeDataset(handler.log, zVolName, size, "zstd"); err != nil {
 		errStr := fmt.Sprintf("Error creating zfs zvol at %s, error=%v",
 			zVolName, err)
@@ -75,6 +76,57 @@ func (handler *volumeHandlerZVol) PrepareVolume() error {
 	return nil
 }
 
+// createEncryptedZVol creates the zvol encrypted natively by zfs with a new
+// key of the volume
+func (handler *volumeHandlerZVol) createEncryptedZVol(zVolName string, size uint64) error {
+	// the dataset may have been created with the existing key by a previous
+	// attempt, which failed later
+	if !volumeKeyExists(handler.status.Key()) {
+		if err := createVolumeKey(handler.log, handler.status.Key()); err != nil {
+			errStr := fmt.Sprintf("Error creating key for zfs zvol %s, error=%v",
+				zVolName, err)
+			handler.log.Error(errStr)
+			return errors.New(errStr)
+		}
+	}
+	keyFile, unstage, err := stageVolumeKey(handler.log, handler.status.Key())
+	if err != nil {
+		errStr := fmt.Sprintf("Error staging key for zfs zvol %s, error=%v",
+			zVolName, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	defer unstage()
+	if err := zfs.CreateEncryptedVolumeDataset(handler.log, zVolName, size, "zstd", keyFile); err != nil {
+		errStr := fmt.Sprintf("Error creating encrypted zfs zvol at %s, error=%v",
+			zVolName, err)
+		handler.log.Error(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// loadZVolKey loads the key of the zvol encrypted with its own key, which
+// is required after a reboot for the zvol device to appear
+func (handler *volumeHandlerZVol) loadZVolKey(zVolName string) error {
+	keyStatus, err := zfs.GetDatasetKeyStatus(zVolName)
+	if err != nil {
+		return fmt.Errorf("cannot get key status of zvol %s: %v", zVolName, err)
+	}
+	if keyStatus == "available" {
+		return nil
+	}
+	keyFile, unstage, err := stageVolumeKey(handler.log, handler.status.Key())
+	if err != nil {
+		return fmt.Errorf("cannot stage key of zvol %s: %v", zVolName, err)
+	}
+	defer unstage()
+	if output, err := zfs.LoadDatasetKey(handler.log, zVolName, keyFile); err != nil {
+		return fmt.Errorf("cannot load key of zvol %s: %s %v", zVolName, output, err)
+	}
+	return nil
+}
+
 func (handler *volumeHandlerZVol) HandlePrepared() (bool, error) {
 	zVolStatus := handler.volumeManager.LookupZVolStatusByDataset(handler.status.ZVolName())
 	if zVolStatus == nil {
@@ -141,7 +193,12 @@ func (handler *volumeHandlerZVol) CreateVolume() (string, error) {
 		handler.log.Error(errStr)
 		return "", errors.New(errStr)
 	}
-	if handler.status.ReferenceName != "" {
+	if dir, imported := handler.importedVolume(); imported != nil {
+		if err := handler.importVolume(createContext, dir, *imported, zVolDevice,
+			"raw", true); err != nil {
+			return zVolDevice, err
+		}
+	} else if handler.status.ReferenceName != "" {
 		pathToFile, err := handler.getVolumeFilePath()
 		if err != nil {
 			errStr := fmt.Sprintf("Error obtaining file for zvol at volume %s, error=%v",
@@ -207,6 +264,12 @@ func (handler *volumeHandlerZVol) DestroyVolume() (string, error) {
 		handler.log.Error(errStr)
 		return "", errors.New(errStr)
 	}
+	if handler.status.KeyEncrypted {
+		if err := destroyVolumeKey(handler.log, handler.status.Key()); err != nil {
+			handler.log.Error(err)
+			return "", err
+		}
+	}
 	handler.log.Functionf("destroyVolume(%s) DONE", handler.status.Key())
 	return "", nil
 }
@@ -214,6 +277,11 @@ func (handler *volumeHandlerZVol) DestroyVolume() (string, error) {
 func (handler *volumeHandlerZVol) Populate() (bool, error) {
 	zvolName := handler.status.ZVolName()
 	if zfs.DatasetExist(handler.log, zvolName) {
+		if handler.status.KeyEncrypted {
+			if err := handler.loadZVolKey(zvolName); err != nil {
+				return false, err
+			}
+		}
 		zVolDevice := zfs.GetZVolDeviceByDataset(zvolName)
 		if zVolDevice == "" {
 			return false, fmt.Errorf("cannot find device for zvol %s of %s", zvolName, handler.status.Key())
@@ -234,37 +302,46 @@ func (handler *volumeHandlerZVol) Populate() (bool, error) {
 	return false, nil
 }
 
-func (handler *volumeHandlerZVol) getVolumeFilePath() (string, error) {
-	puller := registry.Puller{
-		Image: handler.status.ReferenceName,
-	}
-	ctrdCtx, done := handler.volumeManager.GetCasClient().CtrNewUserServicesCtx()
-	defer done()
+func (handler *volumeHandlerZVol) ExportVolume(dir string) (types.VolumeExport, error) {
+	return handler.exportFile(dir, handler.status.FileLocation, "raw")
+}
 
-	resolver, err := handler.volumeManager.GetCasClient().Resolver(ctrdCtx)
-	if err != nil {
-		errStr := fmt.Sprintf("error getting CAS resolver: %v", err)
+func (handler *volumeHandlerZVol) CreateSnapshot(name string) error {
+	zVolName := handler.status.ZVolName()
+	if output, err := zfs.CreateSnapshot(handler.log, zVolName, name); err != nil {
+		errStr := fmt.Sprintf("Error creating snapshot %s of zfs zvol %s: %s %v",
+			name, zVolName, output, err)
 		handler.log.Error(errStr)
-		return "", errors.New(errStr)
+		return errors.New(errStr)
 	}
-	pathToFile := ""
-	_, i, err := puller.Config(true, os.Stderr, resolver)
-	if err != nil {
-		errStr := fmt.Sprintf("error Config for ref %s: %v", handler.status.ReferenceName, err)
+	handler.log.Functionf("CreateSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
+}
+
+func (handler *volumeHandlerZVol) ListSnapshots() ([]types.VolumeSnapshot, error) {
+	return zfs.GetSnapshots(handler.log, handler.status.ZVolName())
+}
+
+func (handler *volumeHandlerZVol) RollbackToSnapshot(name string) error {
+	zVolName := handler.status.ZVolName()
+	if output, err := zfs.RollbackToSnapshot(handler.log, zVolName, name); err != nil {
+		errStr := fmt.Sprintf("Error reverting zfs zvol %s to snapshot %s: %s %v",
+			zVolName, name, output, err)
 		handler.log.Error(errStr)
-		return "", errors.New(errStr)
-	}
-	if len(i.RootFS.DiffIDs) > 0 {
-		// FIXME we expects root in the first layer for now
-		b := i.RootFS.DiffIDs[0]
-		// FIXME we need the proper way to extract file from content dir of containerd
-		pathToFile = filepath.Join(types.ContainerdContentDir, "blobs", b.Algorithm().String(), b.Encoded())
+		return errors.New(errStr)
 	}
+	handler.log.Functionf("RollbackToSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
+}
 
-	if pathToFile == "" {
-		errStr := fmt.Sprintf("no blobs to convert found for ref %s", handler.status.ReferenceName)
+func (handler *volumeHandlerZVol) DeleteSnapshot(name string) error {
+	zVolName := handler.status.ZVolName()
+	if output, err := zfs.DestroySnapshot(handler.log, zVolName, name); err != nil {
+		errStr := fmt.Sprintf("Error deleting snapshot %s of zfs zvol %s: %s %v",
+			name, zVolName, output, err)
 		handler.log.Error(errStr)
-		return "", errors.New(errStr)
+		return errors.New(errStr)
 	}
-	return pathToFile, nil
+	handler.log.Functionf("DeleteSnapshot(%s, %s) DONE", handler.status.Key(), name)
+	return nil
 }
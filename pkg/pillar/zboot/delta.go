@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zboot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/imgdelta"
+)
+
+// WriteDeltaToPartition applies the delta in image to the rootfs image
+// in the current partition and writes the result to partition partName.
+// The other partition is not touched unless the delta applies to
+// the current partition and produces rootfsSha256, which is verified
+// again by reading the partition after it is written.
+func WriteDeltaToPartition(log *base.LogObject, image string, partName string,
+	rootfsSha256 string) error {
+
+	if !IsOtherPartition(partName) {
+		errStr := fmt.Sprintf("not other partition %s", partName)
+		log.Errorf("WriteDeltaToPartition failed %s\n", errStr)
+		return errors.New(errStr)
+	}
+	devName := GetPartitionDevname(partName)
+	if devName == "" {
+		errStr := fmt.Sprintf("null devname for partition %s", partName)
+		log.Errorf("WriteDeltaToPartition failed %s\n", errStr)
+		return errors.New(errStr)
+	}
+	srcDevName := GetCurrentPartitionDevName()
+	log.Functionf("WriteDeltaToPartition %s, %s from %s: %v\n",
+		partName, devName, srcDevName, image)
+
+	src, err := os.Open(srcDevName)
+	if err != nil {
+		return fmt.Errorf("error opening current partition device at %s: %v",
+			srcDevName, err)
+	}
+	defer src.Close()
+
+	// the delta is read while it is being pulled from CAS
+	pr, pw := io.Pipe()
+	pulled := make(chan struct{})
+	go func() {
+		pw.CloseWithError(pullRootDisk(log, image, pw))
+		close(pulled)
+	}()
+	defer func() {
+		pr.Close()
+		<-pulled
+	}()
+
+	h, err := imgdelta.ReadHeader(pr)
+	if err != nil {
+		return err
+	}
+	if h.TargetSha() != rootfsSha256 {
+		return fmt.Errorf("delta produces rootfs %s, expected %s",
+			h.TargetSha(), rootfsSha256)
+	}
+	if err = imgdelta.CheckSource(h, src); err != nil {
+		return fmt.Errorf("current partition %s: %v", srcDevName, err)
+	}
+
+	unmountPartition(log, devName)
+	dst, err := os.OpenFile(devName, os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing to partition device at %s: %v",
+			devName, err)
+	}
+	defer dst.Close()
+	w := bufio.NewWriterSize(dst, 1024*1024)
+	if err = imgdelta.Apply(h, src, pr, w); err == nil {
+		if err = w.Flush(); err == nil {
+			err = dst.Sync()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error applying delta to partition device at %s: %v",
+			devName, err)
+	}
+	return verifyPartition(devName, int64(h.TargetSize), rootfsSha256)
+}
+
+// verifyPartition compares sha256 of the first size bytes of the partition
+func verifyPartition(devName string, size int64, sha256Hex string) error {
+	f, err := os.Open(devName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err = io.CopyN(hash, f, size); err != nil {
+		return fmt.Errorf("error reading partition device at %s: %v",
+			devName, err)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != sha256Hex {
+		return fmt.Errorf("partition device at %s has sha256 %s, expected %s",
+			devName, sum, sha256Hex)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -358,11 +359,6 @@ func GetOtherPartitionDevName() string {
 // WriteToPartition write the image to partition partName
 func WriteToPartition(log *base.LogObject, image string, partName string) error {
 
-	var (
-		casClient cas.CAS
-		err       error
-	)
-
 	if !IsOtherPartition(partName) {
 		errStr := fmt.Sprintf("not other partition %s", partName)
 		log.Errorf("WriteToPartition failed %s\n", errStr)
@@ -378,6 +374,29 @@ func WriteToPartition(log *base.LogObject, image string, partName string) error
 
 	log.Functionf("WriteToPartition %s, %s: %v\n", partName, devName, image)
 
+	unmountPartition(log, devName)
+	// create a writer for the file where we want
+	// Avoid holding the lock since this can take a long time.
+	f, err := os.OpenFile(devName,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		errStr := fmt.Sprintf("error writing to partition device at %s: %v", devName, err)
+		log.Error(errStr)
+		return errors.New(errStr)
+	}
+	defer f.Close()
+
+	return pullRootDisk(log, image, f)
+}
+
+// pullRootDisk writes the root disk of the image from CAS into w
+func pullRootDisk(log *base.LogObject, image string, w io.Writer) error {
+
+	var (
+		casClient cas.CAS
+		err       error
+	)
+
 	// use the edge-containers library to extract the data we need
 	puller := registry.Puller{
 		Image: image,
@@ -399,30 +418,22 @@ func WriteToPartition(log *base.LogObject, image string, partName string) error
 		return errors.New(errStr)
 	}
 
-	// Make sure we have nothing mounted on the target
+	if _, _, err := puller.Pull(&registry.FilesTarget{Root: w, AcceptHash: true}, 0, false, os.Stderr, resolver); err != nil {
+		errStr := fmt.Sprintf("error pulling %s from containerd: %v", image, err)
+		log.Error(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// unmountPartition makes sure we have nothing mounted on the partition
+func unmountPartition(log *base.LogObject, devName string) {
 	for {
 		if err := syscall.Unmount(devName, 0); err != nil {
 			break
 		}
 		log.Warnf("Successfully umounted %s", devName)
 	}
-	// create a writer for the file where we want
-	// Avoid holding the lock since this can take a long time.
-	f, err := os.OpenFile(devName,
-		os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		errStr := fmt.Sprintf("error writing to partition device at %s: %v", devName, err)
-		log.Error(errStr)
-		return errors.New(errStr)
-	}
-	defer f.Close()
-
-	if _, _, err := puller.Pull(&registry.FilesTarget{Root: f, AcceptHash: true}, 0, false, os.Stderr, resolver); err != nil {
-		errStr := fmt.Sprintf("error pulling %s from containerd: %v", image, err)
-		log.Error(errStr)
-		return errors.New(errStr)
-	}
-	return nil
 }
 
 // MarkCurrentPartitionStateActive transition current from inprogress to active, and other from active/inprogress
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentbase"
@@ -46,9 +47,10 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cmd/zedrouter"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/zfsmanager"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/kvdriver"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub/reverse"
-	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zboot"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
 	"github.com/sirupsen/logrus"
 )
@@ -149,7 +151,8 @@ func runService(serviceName string, sep entrypoint, inline bool) int {
 		log.Functionf("Running inline command %s args: %+v",
 			serviceName, arguments)
 		ps := pubsub.New(
-			&socketdriver.SocketDriver{Logger: logger, Log: log},
+			&kvdriver.KVDriver{Logger: logger, Log: log,
+				KeepLegacyDirs: keepLegacyPubsubDirs},
 			logger, log)
 		return sep.f(ps, logger, log, arguments)
 	}
@@ -171,6 +174,21 @@ func runService(serviceName string, sep entrypoint, inline bool) int {
 	return 0
 }
 
+var (
+	legacyPubsubDirsOnce sync.Once
+	legacyPubsubDirs     bool
+)
+
+// keepLegacyPubsubDirs returns true while the current EVE image is being
+// tested after an update. The previous image, which can still be booted,
+// keeps persistent publications in directories instead of kvdriver stores.
+func keepLegacyPubsubDirs() bool {
+	legacyPubsubDirsOnce.Do(func() {
+		legacyPubsubDirs = zboot.IsCurrentPartitionStateInProgress()
+	})
+	return legacyPubsubDirs
+}
+
 // runZedbox is the built-in starting of the main process
 func runZedbox(ps *pubsub.PubSub, logger *logrus.Logger, log *base.LogObject, arguments []string) int {
 	//Start zedbox
@@ -211,9 +229,10 @@ func handleService(serviceName string, cmdArgs []string) {
 	log.Functionf("zedbox: Received command = %s args = %v", serviceName, cmdArgs)
 	srvLogger, srvLog := agentlog.Init(serviceName)
 	srvPs := pubsub.New(
-		&socketdriver.SocketDriver{
-			Logger: srvLogger,
-			Log:    srvLog,
+		&kvdriver.KVDriver{
+			Logger:         srvLogger,
+			Log:            srvLog,
+			KeepLegacyDirs: keepLegacyPubsubDirs,
 		},
 		srvLogger, srvLog)
 	sep, ok := entrypoints[serviceName]
@@ -159,12 +159,37 @@ func DatasetExist(log *base.LogObject, datasetPath string) bool {
 
 // CreateVolumeDataset creates dataset of zvol type in zfs
 func CreateVolumeDataset(log *base.LogObject, datasetName string, size uint64, compression string) error {
-	alignedSize := alignUpToBlockSize(size)
+	return createVolumeDataset(log, datasetName, volumeDatasetProps(size, compression))
+}
 
-	// Create fs datasets if they don't exist
-	if err := CreateDatasets(log, filepath.Dir(datasetName)); err != nil {
-		return err
+// CreateEncryptedVolumeDataset creates a zvol encrypted with its own raw key
+// read from zfsKeyFile. The key must be loaded with LoadDatasetKey after
+// a reboot.
+func CreateEncryptedVolumeDataset(log *base.LogObject, datasetName string, size uint64,
+	compression, zfsKeyFile string) error {
+
+	props := volumeDatasetProps(size, compression)
+	props[libzfs.DatasetPropEncryption] = libzfs.Property{
+		Value: "aes-256-gcm"}
+	props[libzfs.DatasetPropKeyLocation] = libzfs.Property{
+		Value: "file://" + zfsKeyFile}
+	props[libzfs.DatasetPropKeyFormat] = libzfs.Property{
+		Value: "raw"}
+	return createVolumeDataset(log, datasetName, props)
+}
+
+// LoadDatasetKey loads the key of an encrypted dataset from zfsKeyFile
+func LoadDatasetKey(log *base.LogObject, datasetName, zfsKeyFile string) (string, error) {
+	args := []string{"load-key", "-L", "file://" + zfsKeyFile, datasetName}
+	stdoutStderr, err := base.Exec(log, types.ZFSBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
 	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+func volumeDatasetProps(size uint64, compression string) map[libzfs.Prop]libzfs.Property {
+	alignedSize := alignUpToBlockSize(size)
 
 	props := make(map[libzfs.Prop]libzfs.Property)
 	props[libzfs.DatasetPropVolsize] = libzfs.Property{
@@ -181,6 +206,16 @@ func CreateVolumeDataset(log *base.LogObject, datasetName string, size uint64, c
 		Value: "most"}
 	props[libzfs.DatasetPropCompression] = libzfs.Property{
 		Value: compression}
+	return props
+}
+
+func createVolumeDataset(log *base.LogObject, datasetName string,
+	props map[libzfs.Prop]libzfs.Property) error {
+
+	// Create fs datasets if they don't exist
+	if err := CreateDatasets(log, filepath.Dir(datasetName)); err != nil {
+		return err
+	}
 
 	dataset, err := libzfs.DatasetCreate(datasetName, libzfs.DatasetTypeVolume, props)
 	if err != nil {
@@ -368,6 +403,121 @@ func ReplaceVDev(log *base.LogObject, pool, oldVdev, newVdev string) (string, er
 	return strings.TrimSpace(string(stdoutStderr)), nil
 }
 
+// AddRaidzVDev adds a new raidz top-level vdev with the given parity
+// built from disks to the pool
+func AddRaidzVDev(log *base.LogObject, pool string, parity int, disks []string) (string, error) {
+	if parity < 1 || parity > 3 {
+		return "", fmt.Errorf("unsupported raidz parity %d", parity)
+	}
+	// same minimum as the installer uses for raid5 and raid6
+	if len(disks) < parity+2 {
+		return "", fmt.Errorf("raidz%d requires at least %d disks, got %d",
+			parity, parity+2, len(disks))
+	}
+	args := append([]string{"add", "-f", pool, fmt.Sprintf("raidz%d", parity)}, disks...)
+	stdoutStderr, err := base.Exec(log, types.ZPoolBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// StartScrub starts scrub of the pool or resumes the paused one
+func StartScrub(log *base.LogObject, pool string) (string, error) {
+	args := []string{"scrub", pool}
+	stdoutStderr, err := base.Exec(log, types.ZPoolBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// PauseScrub pauses running scrub of the pool, StartScrub resumes it
+func PauseScrub(log *base.LogObject, pool string) (string, error) {
+	args := []string{"scrub", "-p", pool}
+	stdoutStderr, err := base.Exec(log, types.ZPoolBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// IsScrubPaused returns true if scrub of the pool is paused.
+// libzfs does not expose the pause time of the scan, so we look into
+// the output of "zpool status" for it
+func IsScrubPaused(log *base.LogObject, pool string) (bool, error) {
+	args := []string{"status", pool}
+	stdoutStderr, err := base.Exec(log, types.ZPoolBinary, args...).CombinedOutput()
+	if err != nil {
+		return false, fmt.Errorf("zpool status failed: %s: %w",
+			strings.TrimSpace(string(stdoutStderr)), err)
+	}
+	return strings.Contains(string(stdoutStderr), "scrub paused since"), nil
+}
+
+// CreateSnapshot creates snapshot with the name of the dataset
+func CreateSnapshot(log *base.LogObject, dataset, name string) (string, error) {
+	args := []string{"snapshot", dataset + "@" + name}
+	stdoutStderr, err := base.Exec(log, types.ZFSBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// RollbackToSnapshot reverts the dataset to its snapshot with the name,
+// destroying the later snapshots of the dataset
+func RollbackToSnapshot(log *base.LogObject, dataset, name string) (string, error) {
+	args := []string{"rollback", "-r", dataset + "@" + name}
+	stdoutStderr, err := base.Exec(log, types.ZFSBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// DestroySnapshot destroys snapshot with the name of the dataset
+func DestroySnapshot(log *base.LogObject, dataset, name string) (string, error) {
+	args := []string{"destroy", dataset + "@" + name}
+	stdoutStderr, err := base.Exec(log, types.ZFSBinary, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+// GetSnapshots returns the snapshots of the dataset, oldest first
+func GetSnapshots(log *base.LogObject, dataset string) ([]types.VolumeSnapshot, error) {
+	args := []string{"list", "-H", "-p", "-t", "snapshot", "-o", "name,creation",
+		"-s", "creation", "-d", "1", dataset}
+	stdoutStderr, err := base.Exec(log, types.ZFSBinary, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots of %s failed: %s %v",
+			dataset, stdoutStderr, err)
+	}
+	var snapshots []types.VolumeSnapshot
+	for _, line := range strings.Split(string(stdoutStderr), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		name := strings.TrimPrefix(fields[0], dataset+"@")
+		if name == fields[0] {
+			continue
+		}
+		creation, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse creation of %s: %v",
+				fields[0], err)
+		}
+		snapshots = append(snapshots, types.VolumeSnapshot{
+			Name:       name,
+			CreateTime: time.Unix(creation, 0),
+		})
+	}
+	return snapshots, nil
+}
+
 // GetZfsVersion return zfs kernel module version
 func GetZfsVersion() (string, error) {
 	dataBytes, err := ioutil.ReadFile("/hostfs/sys/module/zfs/version")
@@ -468,6 +618,57 @@ func GetZpoolRaidType(vdevs libzfs.VDevTree) types.StorageRaidType {
 	return types.StorageRaidTypeNoRAID
 }
 
+// GetZpoolScanStatus takes the scan statistics of the pool (from the root
+// of libzfs.VDevTree) and returns progress of its scrub or resilver.
+// ETA is estimated at the time now from the rate of the current scan pass.
+func GetZpoolScanStatus(scanStat libzfs.PoolScanStat, now time.Time) types.ZFSScanStatus {
+	status := types.ZFSScanStatus{
+		ToExamine: scanStat.ToExamine,
+		Examined:  scanStat.Examined,
+		Processed: scanStat.Processed,
+		Errors:    scanStat.Errors,
+	}
+	switch scanStat.Func {
+	case libzfs.PoolScanScrub:
+		status.Function = types.ZFSScanFunctionScrub
+	case libzfs.PoolScanResilver:
+		status.Function = types.ZFSScanFunctionResilver
+	default:
+		return types.ZFSScanStatus{}
+	}
+	switch scanStat.State {
+	case libzfs.DSSScanning:
+		status.State = types.ZFSScanStateScanning
+	case libzfs.DSSFinished:
+		status.State = types.ZFSScanStateFinished
+	case libzfs.DSSCanceled:
+		status.State = types.ZFSScanStateCanceled
+	default:
+		return types.ZFSScanStatus{}
+	}
+	if scanStat.StartTime != 0 {
+		status.StartTime = time.Unix(int64(scanStat.StartTime), 0)
+	}
+	if status.State != types.ZFSScanStateScanning {
+		if scanStat.EndTime != 0 {
+			status.EndTime = time.Unix(int64(scanStat.EndTime), 0)
+		}
+		return status
+	}
+	elapsed := now.Unix() - int64(scanStat.PassStart)
+	if scanStat.PassStart == 0 || elapsed <= 0 || scanStat.PassExam == 0 ||
+		scanStat.Examined >= scanStat.ToExamine {
+		return status
+	}
+	rate := scanStat.PassExam / uint64(elapsed)
+	if rate == 0 {
+		return status
+	}
+	remaining := (scanStat.ToExamine - scanStat.Examined) / rate
+	status.ETA = time.Duration(remaining) * time.Second
+	return status
+}
+
 // GetZfsDeviceStatusFromStr takes a string with status as input and returns status
 func GetZfsDeviceStatusFromStr(statusStr string) types.StorageStatus {
 	if len(statusStr) == 0 {
@@ -697,6 +898,29 @@ func GetZvolMetrics(status types.VolumeStatus, poolName string) (*types.StorageZ
 	return zvolMetric, nil
 }
 
+// GetZvolsIOs returns the number of read and write operations completed
+// by all zvols (/dev/zd*) since boot, as reported in /proc/diskstats.
+// Partitions of zvols are not counted to not duplicate their I/O.
+func GetZvolsIOs() (uint64, error) {
+	fs, err := blockdevice.NewFS("/proc", "/sys")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block device stats: %w", err)
+	}
+	stats, err := fs.ProcDiskstats()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get diskstats: %w", err)
+	}
+	var ios uint64
+	for _, stat := range stats {
+		name := stat.Info.DeviceName
+		if !strings.HasPrefix(name, "zd") || strings.Contains(name, "p") {
+			continue
+		}
+		ios += stat.IOStats.ReadIOs + stat.IOStats.WriteIOs
+	}
+	return ios, nil
+}
+
 func getZfsDisksMetrics(disk libzfs.VDevTree) *types.StorageDiskMetrics {
 	disksMetrics := new(types.StorageDiskMetrics)
 	rootDevice, err := disks.GetRootDevice()
@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfs
+
+import (
+	"testing"
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestGetZpoolScanStatus(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	gib := uint64(1 << 30)
+
+	testMatrix := map[string]struct {
+		scanStat         libzfs.PoolScanStat
+		expectedFunction types.ZFSScanFunction
+		expectedState    types.ZFSScanState
+		expectedEndTime  time.Time
+		expectedProgress uint32
+		expectedETA      time.Duration
+	}{
+		"never scanned": {
+			scanStat: libzfs.PoolScanStat{},
+		},
+		"scrub in progress": {
+			scanStat: libzfs.PoolScanStat{
+				Func:      libzfs.PoolScanScrub,
+				State:     libzfs.DSSScanning,
+				StartTime: uint64(now.Unix()) - 200,
+				ToExamine: 100 * gib,
+				Examined:  25 * gib,
+				// 10 GiB examined during the last 100 seconds of the pass
+				PassExam:  10 * gib,
+				PassStart: uint64(now.Unix()) - 100,
+			},
+			expectedFunction: types.ZFSScanFunctionScrub,
+			expectedState:    types.ZFSScanStateScanning,
+			expectedProgress: 25,
+			expectedETA:      750 * time.Second,
+		},
+		"resilver just started": {
+			scanStat: libzfs.PoolScanStat{
+				Func:      libzfs.PoolScanResilver,
+				State:     libzfs.DSSScanning,
+				StartTime: uint64(now.Unix()),
+				ToExamine: 100 * gib,
+				PassStart: uint64(now.Unix()),
+			},
+			expectedFunction: types.ZFSScanFunctionResilver,
+			expectedState:    types.ZFSScanStateScanning,
+		},
+		"scrub finished": {
+			scanStat: libzfs.PoolScanStat{
+				Func:      libzfs.PoolScanScrub,
+				State:     libzfs.DSSFinished,
+				StartTime: uint64(now.Unix()) - 3600,
+				EndTime:   uint64(now.Unix()) - 60,
+				ToExamine: 100 * gib,
+				Examined:  100 * gib,
+				PassExam:  100 * gib,
+				PassStart: uint64(now.Unix()) - 3600,
+			},
+			expectedFunction: types.ZFSScanFunctionScrub,
+			expectedState:    types.ZFSScanStateFinished,
+			expectedEndTime:  now.Add(-time.Minute),
+			expectedProgress: 100,
+		},
+		"scrub canceled": {
+			scanStat: libzfs.PoolScanStat{
+				Func:      libzfs.PoolScanScrub,
+				State:     libzfs.DSSCanceled,
+				StartTime: uint64(now.Unix()) - 3600,
+				EndTime:   uint64(now.Unix()) - 60,
+				ToExamine: 100 * gib,
+				Examined:  40 * gib,
+			},
+			expectedFunction: types.ZFSScanFunctionScrub,
+			expectedState:    types.ZFSScanStateCanceled,
+			expectedEndTime:  now.Add(-time.Minute),
+			expectedProgress: 40,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			status := GetZpoolScanStatus(test.scanStat, now)
+			if status.Function != test.expectedFunction {
+				t.Errorf("expected function %d, got %d", test.expectedFunction, status.Function)
+			}
+			if status.State != test.expectedState {
+				t.Errorf("expected state %d, got %d", test.expectedState, status.State)
+			}
+			if !status.EndTime.Equal(test.expectedEndTime) {
+				t.Errorf("expected end time %v, got %v", test.expectedEndTime, status.EndTime)
+			}
+			if status.Progress() != test.expectedProgress {
+				t.Errorf("expected progress %d, got %d", test.expectedProgress, status.Progress())
+			}
+			if status.ETA != test.expectedETA {
+				t.Errorf("expected ETA %v, got %v", test.expectedETA, status.ETA)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// wwan-agent manages cellular connections as configured by nim.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/lf-edge/eve/pkg/wwan/manager"
+)
+
+func main() {
+	runDir := flag.String("run-dir", "/run/wwan",
+		"directory with config.json written by nim and status published by this agent")
+	usagePath := flag.String("data-usage", manager.DataUsagePath,
+		"file where monthly data usage of SIM cards is persisted")
+	flag.Parse()
+
+	log.SetFlags(log.LstdFlags | log.LUTC)
+	log.Println("Starting wwan manager")
+	if err := manager.New(*runDir, *usagePath).Run(); err != nil {
+		log.Fatal(err)
+	}
+}
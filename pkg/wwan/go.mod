@@ -0,0 +1,3 @@
+module github.com/lf-edge/eve/pkg/wwan
+
+go 1.16
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+// Types in this file define the JSON API between the wwan microservice
+// and nim (config.json, status.json and metrics.json in /run/wwan).
+// They must be kept in sync with Wwan* types defined in pkg/pillar/types.
+// The wwan microservice is built as a separate module and therefore
+// cannot import pillar.
+
+// Config : content of config.json (types.WwanConfig).
+type Config struct {
+	RadioSilence bool            `json:"radio-silence"`
+	Networks     []NetworkConfig `json:"networks"`
+}
+
+// NetworkConfig : configuration of a single cellular network
+// (types.WwanNetworkConfig).
+type NetworkConfig struct {
+	LogicalLabel     string       `json:"logical-label"`
+	PhysAddrs        PhysAddrs    `json:"physical-addrs"`
+	Apns             []string     `json:"apns"`
+	Proxies          []ProxyEntry `json:"proxies"`
+	Probe            Probe        `json:"probe"`
+	LocationTracking bool         `json:"location-tracking"`
+	SimProfiles      []SimProfile `json:"sim-profiles"`
+}
+
+// SimProfile : connection profile for SIM card inserted into the given slot
+// (types.WwanSimProfile).
+type SimProfile struct {
+	SlotNumber     uint8  `json:"slot-number"`
+	APN            string `json:"apn"`
+	MonthlyDataCap uint64 `json:"monthly-data-cap"`
+}
+
+// ProxyEntry : proxy configured for the network (types.ProxyEntry).
+type ProxyEntry struct {
+	Type   uint8  `json:"type"`
+	Server string `json:"server"`
+	Port   uint32 `json:"port"`
+}
+
+// Probe : connectivity verification probe (types.WwanProbe).
+type Probe struct {
+	Disable bool   `json:"disable"`
+	Address string `json:"address"`
+}
+
+// PhysAddrs : physical address of a cellular modem (types.WwanPhysAddrs).
+type PhysAddrs struct {
+	Interface string `json:"interface"`
+	USB       string `json:"usb"`
+	PCI       string `json:"pci"`
+}
+
+// Status : content of status.json (types.WwanStatus).
+type Status struct {
+	Networks       []NetworkStatus `json:"networks"`
+	ConfigChecksum string          `json:"config-checksum,omitempty"`
+}
+
+// NetworkStatus : status of a single cellular network (types.WwanNetworkStatus).
+type NetworkStatus struct {
+	LogicalLabel    string     `json:"logical-label"`
+	PhysAddrs       PhysAddrs  `json:"physical-addrs"`
+	Module          CellModule `json:"cellular-module"`
+	SimCards        []SimCard  `json:"sim-cards"`
+	ConfigError     string     `json:"config-error"`
+	ConfigErrorCode ErrorCode  `json:"config-error-code"`
+	ProbeError      string     `json:"probe-error"`
+	Providers       []Provider `json:"providers"`
+}
+
+// CellModule : cellular module specs (types.WwanCellModule).
+type CellModule struct {
+	IMEI            string `json:"imei"`
+	Model           string `json:"model"`
+	Revision        string `json:"revision"`
+	ControlProtocol string `json:"control-protocol"`
+	OpMode          string `json:"operating-mode"`
+}
+
+// SimCard : SIM card information (types.WwanSimCard).
+type SimCard struct {
+	ICCID            string `json:"iccid"`
+	IMSI             string `json:"imsi"`
+	SlotNumber       uint8  `json:"slot-number"`
+	SlotActivated    bool   `json:"slot-activated"`
+	MonthlyDataUsage uint64 `json:"monthly-data-usage"`
+}
+
+// Provider : cellular connectivity provider (types.WwanProvider).
+type Provider struct {
+	PLMN           string `json:"plmn"`
+	Description    string `json:"description"`
+	CurrentServing bool   `json:"current-serving"`
+	Roaming        bool   `json:"roaming"`
+}
+
+// ErrorCode : classification of ConfigError (types.WwanErrorCode).
+type ErrorCode string
+
+const (
+	// ErrorUnspecified : no error or not classified
+	ErrorUnspecified ErrorCode = ""
+	// ErrorModemNotFound : modem with the configured physical address was not found
+	ErrorModemNotFound ErrorCode = "modem-not-found"
+	// ErrorModemCommandFailed : command sent to the modem failed
+	ErrorModemCommandFailed ErrorCode = "modem-command-failed"
+	// ErrorSimNotReady : SIM card is missing, not initialized or the SIM slot
+	// cannot be activated
+	ErrorSimNotReady ErrorCode = "sim-not-ready"
+	// ErrorRegistrationFailed : modem failed to register on the network
+	ErrorRegistrationFailed ErrorCode = "registration-failed"
+	// ErrorConnectionFailed : modem failed to establish data connection
+	ErrorConnectionFailed ErrorCode = "connection-failed"
+	// ErrorIPConfigFailed : IP configuration was not provided by the modem
+	// or could not be applied
+	ErrorIPConfigFailed ErrorCode = "ip-config-failed"
+	// ErrorDataCapExceeded : monthly data cap of every usable SIM card was exceeded
+	ErrorDataCapExceeded ErrorCode = "data-cap-exceeded"
+)
+
+// Metrics : content of metrics.json (types.WwanMetrics).
+type Metrics struct {
+	Networks []NetworkMetrics `json:"networks"`
+}
+
+// NetworkMetrics : metrics of a single cellular network (types.WwanNetworkMetrics).
+type NetworkMetrics struct {
+	LogicalLabel string      `json:"logical-label"`
+	PhysAddrs    PhysAddrs   `json:"physical-addrs"`
+	PacketStats  PacketStats `json:"packet-stats"`
+	SignalInfo   SignalInfo  `json:"signal-info"`
+}
+
+// PacketStats : packet statistics recorded by a cellular modem (types.WwanPacketStats).
+type PacketStats struct {
+	RxBytes   uint64 `json:"rx-bytes"`
+	RxPackets uint64 `json:"rx-packets"`
+	RxDrops   uint64 `json:"rx-drops"`
+	TxBytes   uint64 `json:"tx-bytes"`
+	TxPackets uint64 `json:"tx-packets"`
+	TxDrops   uint64 `json:"tx-drops"`
+}
+
+// SignalInfo : cellular signal strength information (types.WwanSignalInfo).
+type SignalInfo struct {
+	RSSI int32 `json:"rssi"`
+	RSRQ int32 `json:"rsrq"`
+	RSRP int32 `json:"rsrp"`
+	SNR  int32 `json:"snr"`
+}
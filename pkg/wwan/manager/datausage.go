@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DataUsagePath is where the monthly data usage of SIM cards is persisted
+// so that data caps are enforced across reboots.
+const DataUsagePath = "/persist/status/wwan/data-usage.json"
+
+// dataUsage : number of bytes (RX+TX) transferred with each SIM card (by ICCID)
+// within the current calendar month (UTC).
+type dataUsage struct {
+	path string
+	// Month in the format "YYYY-MM".
+	Month string            `json:"month"`
+	Bytes map[string]uint64 `json:"bytes"`
+}
+
+func monthOf(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// loadDataUsage reads persisted data usage. Missing or corrupted file
+// is not an error, usage is then counted from zero.
+func loadDataUsage(path string) *dataUsage {
+	usage := &dataUsage{path: path}
+	if path != "" {
+		if content, err := ioutil.ReadFile(path); err == nil {
+			if err = json.Unmarshal(content, usage); err != nil {
+				log.Printf("Failed to parse %s: %v", path, err)
+			}
+		}
+	}
+	if usage.Bytes == nil {
+		usage.Bytes = make(map[string]uint64)
+	}
+	return usage
+}
+
+// rollover clears the usage when a new month begins.
+func (u *dataUsage) rollover(now time.Time) {
+	if month := monthOf(now); month != u.Month {
+		u.Month = month
+		u.Bytes = make(map[string]uint64)
+	}
+}
+
+// add records bytes transferred with the given SIM card.
+func (u *dataUsage) add(iccid string, bytes uint64, now time.Time) {
+	u.rollover(now)
+	if iccid == "" || bytes == 0 {
+		return
+	}
+	u.Bytes[iccid] += bytes
+}
+
+// get returns bytes transferred with the given SIM card in the current month.
+func (u *dataUsage) get(iccid string, now time.Time) uint64 {
+	u.rollover(now)
+	return u.Bytes[iccid]
+}
+
+// capExceeded returns true if the SIM card has used up its monthly data cap.
+// Zero cap means no limit.
+func (u *dataUsage) capExceeded(iccid string, dataCap uint64, now time.Time) bool {
+	return dataCap != 0 && iccid != "" && u.get(iccid, now) >= dataCap
+}
+
+// save persists the usage atomically.
+func (u *dataUsage) save() error {
+	if u.path == "" {
+		return nil
+	}
+	content, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(u.path), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(u.path, content)
+}
+
+// writeFileAtomic writes file via a temporary file and rename, so that readers
+// never see partially written content.
+func writeFileAtomic(path string, content []byte) error {
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// trafficCounter converts modem packet counters, which reset with every new
+// connection (and modem restart), into increments of the data usage.
+type trafficCounter struct {
+	last  uint64
+	valid bool
+}
+
+// reset is called when a new connection is established. Bytes transferred
+// before the next update are not counted.
+func (c *trafficCounter) reset() {
+	c.valid = false
+}
+
+// update returns the number of bytes transferred since the last update.
+func (c *trafficCounter) update(counter uint64) uint64 {
+	var delta uint64
+	if c.valid {
+		if counter >= c.last {
+			delta = counter - c.last
+		} else {
+			// Counter was reset.
+			delta = counter
+		}
+	}
+	c.last = counter
+	c.valid = true
+	return delta
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrafficCounter(t *testing.T) {
+	var counter trafficCounter
+	counter.reset()
+	updates := []struct {
+		counter       uint64
+		expectedDelta uint64
+	}{
+		{counter: 5000, expectedDelta: 0}, // baseline
+		{counter: 5600, expectedDelta: 600},
+		{counter: 5600, expectedDelta: 0},
+		{counter: 300, expectedDelta: 300}, // counter reset by the modem
+		{counter: 1000, expectedDelta: 700},
+	}
+	for i, update := range updates {
+		if delta := counter.update(update.counter); delta != update.expectedDelta {
+			t.Errorf("update %d: expected delta %d, got %d", i, update.expectedDelta, delta)
+		}
+	}
+}
+
+func TestDataUsageRollover(t *testing.T) {
+	path := t.TempDir() + "/data-usage.json"
+	usage := loadDataUsage(path)
+	may := time.Date(2023, 5, 31, 23, 0, 0, 0, time.UTC)
+	usage.add("iccid1", 800, may)
+	usage.add("iccid1", 300, may)
+	if !usage.capExceeded("iccid1", 1000, may) {
+		t.Error("data cap should be exceeded")
+	}
+	if usage.capExceeded("iccid1", 0, may) {
+		t.Error("zero data cap means no limit")
+	}
+	if err := usage.save(); err != nil {
+		t.Fatal(err)
+	}
+	usage = loadDataUsage(path)
+	if bytes := usage.get("iccid1", may); bytes != 1100 {
+		t.Errorf("expected 1100 bytes after reload, got %d", bytes)
+	}
+	june := may.Add(2 * time.Hour)
+	if bytes := usage.get("iccid1", june); bytes != 0 {
+		t.Errorf("expected usage to be reset in the new month, got %d", bytes)
+	}
+}
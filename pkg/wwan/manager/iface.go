@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+// defaultRouteMetric is high enough for the default route via the cellular
+// network to be used only as the last resort.
+const defaultRouteMetric = 65000
+
+// ifaceConfigurator applies IP settings received from the modem
+// to the network interface. Tests replace it with a no-op implementation.
+type ifaceConfigurator interface {
+	bringUp(iface string, settings modem.IPSettings) error
+	bringDown(iface string) error
+}
+
+// ipCmdConfigurator configures interface using the ip command
+// and publishes DNS servers for nim into <runDir>/resolv.conf.
+type ipCmdConfigurator struct {
+	runDir string
+}
+
+func ipCmd(args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("ip", args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("ip %s: %s", strings.Join(args, " "),
+			strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func (c ipCmdConfigurator) resolvConfPath(iface string) string {
+	return filepath.Join(c.runDir, "resolv.conf", iface+".dhcp")
+}
+
+func (c ipCmdConfigurator) bringUp(iface string, settings modem.IPSettings) error {
+	if iface == "" {
+		return fmt.Errorf("network interface of the modem is not known")
+	}
+	mask := settings.Mask
+	if mask == nil {
+		mask = net.CIDRMask(32, 32)
+	}
+	prefixLen, _ := mask.Size()
+	addr := fmt.Sprintf("%s/%d", settings.Address, prefixLen)
+	args := []string{"addr", "add", addr, "dev", iface}
+	if settings.Gateway != nil {
+		args = append(args, "peer", settings.Gateway.String())
+	}
+	if err := ipCmd(args...); err != nil {
+		return err
+	}
+	if settings.MTU != 0 {
+		if err := ipCmd("link", "set", "mtu", strconv.Itoa(settings.MTU),
+			"dev", iface); err != nil {
+			return err
+		}
+	}
+	if err := ipCmd("link", "set", iface, "up"); err != nil {
+		return err
+	}
+	if settings.Gateway == nil {
+		return fmt.Errorf("modem did not provide gateway address")
+	}
+	gw := settings.Gateway.String()
+	// NOTE we may want to disable /proc/sys/net/ipv4/conf/default/rp_filter instead
+	//      Verify it by cat /proc/net/netstat | awk '{print $80}'
+	if err := ipCmd("route", "add", "default", "via", gw, "dev", iface,
+		"metric", strconv.Itoa(defaultRouteMetric)); err != nil {
+		return err
+	}
+	var resolvConf strings.Builder
+	for _, dns := range settings.DNS {
+		// The route makes sure that DNS probing uses the cellular interface
+		// even before nim installs routing rules for the assigned IP.
+		// The route should not cause any harm since EVE uses separate
+		// per-interface routing tables instead of the main table.
+		if err := ipCmd("route", "add", dns.String(), "via", gw,
+			"dev", iface); err != nil {
+			return err
+		}
+		fmt.Fprintf(&resolvConf, "nameserver %s\n", dns)
+	}
+	resolvConfPath := c.resolvConfPath(iface)
+	if err := os.MkdirAll(filepath.Dir(resolvConfPath), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(resolvConfPath, []byte(resolvConf.String()))
+}
+
+func (c ipCmdConfigurator) bringDown(iface string) error {
+	if iface == "" {
+		return nil
+	}
+	// Truncate resolv.conf if it exists.
+	resolvConfPath := c.resolvConfPath(iface)
+	if _, err := os.Stat(resolvConfPath); err == nil {
+		if err = os.Truncate(resolvConfPath, 0); err != nil {
+			return err
+		}
+	}
+	// Remove IP address and routes from the interface.
+	return ipCmd("addr", "flush", "dev", iface)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+// locTrackingScript keeps publishing location obtained from the GNSS receiver
+// of the modem into the output file given as the last argument.
+const locTrackingScript = "/usr/bin/wwan-loc.sh"
+
+// How long to wait for the location tracker to exit after SIGTERM.
+const locTrackerStopTimeout = 3 * time.Second
+
+// locationTracker runs locTrackingScript in its own process group,
+// so that it can be stopped together with all its child processes.
+// FIXME XXX Limited to a single cellular modem
+type locationTracker struct {
+	cmd    *exec.Cmd
+	done   chan struct{}
+	label  string
+	device modem.Device
+}
+
+// update starts, restarts or stops location tracking to match the given
+// network (nil to stop).
+func (t *locationTracker) update(n *network, outputPath string) {
+	if n == nil {
+		t.stop()
+		return
+	}
+	if t.cmd != nil && t.label == n.config.LogicalLabel && t.device == n.device {
+		select {
+		case <-t.done:
+			// Exited unexpectedly, start again.
+		default:
+			return
+		}
+	}
+	t.stop()
+	cmd := exec.Command(locTrackingScript, n.config.LogicalLabel, n.device.CDCDevice,
+		string(n.device.Protocol), outputPath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		log.Printf("Failed to start location tracking: %v", err)
+		return
+	}
+	log.Printf("Location tracking was started for %s (PID: %d)",
+		n.config.LogicalLabel, cmd.Process.Pid)
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	t.cmd, t.done = cmd, done
+	t.label, t.device = n.config.LogicalLabel, n.device
+}
+
+func (t *locationTracker) stop() {
+	if t.cmd == nil {
+		return
+	}
+	pgid := t.cmd.Process.Pid
+	_ = syscall.Kill(-pgid, syscall.SIGTERM)
+	select {
+	case <-t.done:
+	case <-time.After(locTrackerStopTimeout):
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+		<-t.done
+	}
+	log.Printf("Location tracking was stopped (PID: %d)", pgid)
+	t.cmd, t.done = nil, nil
+}
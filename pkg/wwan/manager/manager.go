@@ -0,0 +1,481 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manager implements the wwan microservice: it manages connections
+// of cellular modems as configured by nim and publishes their status and metrics.
+// The message bus between nim and this microservice are JSON files inside
+// the run directory (/run/wwan):
+//   - config.json is written by nim
+//   - status.json, metrics.json and location.json are written by this microservice
+package manager
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+const (
+	configFilename   = "config.json"
+	statusFilename   = "status.json"
+	metricsFilename  = "metrics.json"
+	locInfoFilename  = "location.json"
+	probeInterval    = 5 * time.Minute // how often to probe the connectivity status
+	metricsInterval  = time.Minute     // how often to obtain and publish metrics
+	waitAttempts     = 10
+	waitInterval     = 6 * time.Second
+	defaultProbeWait = 3 * time.Second
+)
+
+type event int
+
+const (
+	eventConfig event = iota
+	eventProbe
+	eventMetrics
+)
+
+func (ev event) String() string {
+	switch ev {
+	case eventConfig:
+		return "CONFIG"
+	case eventProbe:
+		return "PROBE"
+	case eventMetrics:
+		return "METRICS"
+	}
+	return "UNKNOWN"
+}
+
+// Manager of cellular connections.
+type Manager struct {
+	runDir string
+	usage  *dataUsage
+	// Set when usage changed and should be persisted.
+	usageChanged bool
+	loc          locationTracker
+
+	config      Config
+	configBytes []byte
+	checksum    string
+	// networks configured by the controller, key is the logical label.
+	networks map[string]*network
+	// modems by control device (e.g. cdc-wdm0).
+	modems map[string]modem.Modem
+
+	// Dependencies replaced by tests.
+	listDevices  func() ([]modem.Device, error)
+	newModem     func(modem.Device) modem.Modem
+	iface        ifaceConfigurator
+	probe        connProber
+	now          func() time.Time
+	waitAttempts int
+	waitInterval time.Duration
+	// How long to wait after a connection is established before it is probed.
+	probeDelay time.Duration
+}
+
+// New returns Manager publishing to the given run directory and persisting
+// data usage of SIM cards into usagePath.
+func New(runDir, usagePath string) *Manager {
+	return &Manager{
+		runDir:       runDir,
+		usage:        loadDataUsage(usagePath),
+		networks:     make(map[string]*network),
+		modems:       make(map[string]modem.Modem),
+		listDevices:  modem.ListDevices,
+		newModem:     modem.Device.NewModem,
+		iface:        ipCmdConfigurator{runDir: runDir},
+		probe:        probeConnectivity,
+		now:          time.Now,
+		waitAttempts: waitAttempts,
+		waitInterval: waitInterval,
+		probeDelay:   defaultProbeWait,
+	}
+}
+
+// Run processes config changes and periodically probes connectivity
+// and publishes metrics. It returns only if the run directory cannot be watched.
+func (m *Manager) Run() error {
+	if err := os.MkdirAll(m.runDir, 0755); err != nil {
+		return err
+	}
+	configChanges, err := watchFile(m.runDir, configFilename)
+	if err != nil {
+		return err
+	}
+	m.reloadConfig(true)
+	probeTicker := time.NewTicker(probeInterval)
+	metricsTicker := time.NewTicker(metricsInterval)
+	for {
+		select {
+		case <-configChanges:
+			m.reloadConfig(false)
+		case <-probeTicker.C:
+			m.processEvent(eventProbe)
+		case <-metricsTicker.C:
+			m.processEvent(eventMetrics)
+		}
+	}
+}
+
+// configChecksum returns SHA-256 of the config file content
+// (as computed by nim from the serialized config).
+func configChecksum(content []byte) string {
+	hash := sha256.Sum256(bytes.TrimRight(content, "\n"))
+	return hex.EncodeToString(hash[:])
+}
+
+// reloadConfig reads config.json and applies it if it has changed.
+// Config which cannot be parsed (e.g. because nim is just writing it) is ignored.
+func (m *Manager) reloadConfig(force bool) {
+	content, err := ioutil.ReadFile(filepath.Join(m.runDir, configFilename))
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("Failed to read config: %v", err)
+		return
+	}
+	if !force && m.configBytes != nil && bytes.Equal(content, m.configBytes) {
+		// spurious notification, ignore
+		return
+	}
+	var config Config
+	if len(content) > 0 {
+		if err = json.Unmarshal(content, &config); err != nil {
+			log.Printf("Failed to parse config: %v", err)
+			return
+		}
+	}
+	m.config = config
+	m.configBytes = content
+	if m.configBytes == nil {
+		m.configBytes = []byte{}
+	}
+	m.checksum = configChecksum(content)
+	m.processEvent(eventConfig)
+}
+
+// updateNetwork returns state of the configured network.
+// Returns true if the network configuration has changed.
+func (m *Manager) updateNetwork(config NetworkConfig) (*network, bool) {
+	n, exists := m.networks[config.LogicalLabel]
+	if !exists {
+		n = newNetwork(config)
+		m.networks[config.LogicalLabel] = n
+		return n, true
+	}
+	if reflect.DeepEqual(n.config, config) {
+		return n, false
+	}
+	n.config = config
+	return n, true
+}
+
+// getModem returns (cached) modem for the device.
+func (m *Manager) getModem(dev modem.Device) modem.Modem {
+	mdm, exists := m.modems[dev.CDCDevice]
+	if !exists || mdm.Protocol() != dev.Protocol {
+		mdm = m.newModem(dev)
+		m.modems[dev.CDCDevice] = mdm
+	}
+	return mdm
+}
+
+func findDevice(devices []modem.Device, addrs PhysAddrs) (modem.Device, error) {
+	for _, dev := range devices {
+		if dev.MatchesAddrs(addrs.Interface, addrs.USB, addrs.PCI) {
+			return dev, nil
+		}
+	}
+	orAny := func(addr string) string {
+		if addr == "" {
+			return "<ANY>"
+		}
+		return addr
+	}
+	return modem.Device{}, fmt.Errorf(
+		"failed to find modem for interface=%s, USB=%s, PCI=%s",
+		orAny(addrs.Interface), orAny(addrs.USB), orAny(addrs.PCI))
+}
+
+func devicePhysAddrs(dev modem.Device) PhysAddrs {
+	return PhysAddrs{Interface: dev.Interface, USB: dev.USBAddr, PCI: dev.PCIAddr}
+}
+
+// processEvent reflects updated config or probes the current status
+// or collects metrics, for all modems.
+func (m *Manager) processEvent(ev event) {
+	devices, err := m.listDevices()
+	if err != nil {
+		log.Printf("Failed to list modems: %v", err)
+	}
+	var (
+		status     Status
+		metrics    Metrics
+		locNetwork *network
+	)
+	managed := make(map[string]bool)
+	configured := make(map[string]bool)
+	for _, config := range m.config.Networks {
+		configured[config.LogicalLabel] = true
+		n, changed := m.updateNetwork(config)
+		dev, err := findDevice(devices, config.PhysAddrs)
+		if err != nil {
+			n.connected = false
+			n.setError(ErrorModemNotFound, err)
+			if ev != eventMetrics {
+				status.Networks = append(status.Networks, NetworkStatus{
+					LogicalLabel:    config.LogicalLabel,
+					PhysAddrs:       config.PhysAddrs,
+					ConfigError:     n.configError,
+					ConfigErrorCode: n.errorCode,
+				})
+			}
+			continue
+		}
+		managed[dev.CDCDevice] = true
+		mdm := m.getModem(dev)
+		if n.device != dev || n.modem != mdm {
+			n.device, n.modem = dev, mdm
+			n.connected = false
+		}
+		if ev == eventMetrics {
+			m.accountTraffic(n)
+			metrics.Networks = append(metrics.Networks,
+				m.networkMetrics(config.LogicalLabel, dev, mdm))
+			continue
+		}
+		log.Printf("%s Processing managed modem (event: %s)", n.logPrefix(), ev)
+		if m.switchProtocol(dev, mdm) {
+			// Modem is being restarted, return back to it later.
+			n.connected = false
+			continue
+		}
+		if config.LocationTracking && locNetwork == nil {
+			locNetwork = n
+		}
+		if m.config.RadioSilence {
+			m.disconnectForRadioSilence(n)
+		} else if ev == eventProbe {
+			m.probeNetwork(n)
+		} else if changed || !n.connected {
+			m.connect(n)
+		}
+		status.Networks = append(status.Networks, m.networkStatus(n, ev == eventConfig))
+	}
+	for label := range m.networks {
+		if !configured[label] {
+			delete(m.networks, label)
+		}
+	}
+
+	// Manage RF state also for modems not configured by the controller.
+	for _, dev := range devices {
+		if managed[dev.CDCDevice] {
+			continue
+		}
+		mdm := m.getModem(dev)
+		// Unmanaged modems do not have logical label.
+		if ev == eventMetrics {
+			metrics.Networks = append(metrics.Networks, m.networkMetrics("", dev, mdm))
+			continue
+		}
+		log.Printf("[%s] Processing unmanaged modem (event: %s)", dev.CDCDevice, ev)
+		n := newNetwork(NetworkConfig{})
+		n.device, n.modem = dev, mdm
+		if err := m.disableRadio(mdm, dev.Interface); err != nil {
+			n.setError(ErrorModemCommandFailed, err)
+		}
+		status.Networks = append(status.Networks, m.networkStatus(n, ev == eventConfig))
+	}
+
+	if ev == eventConfig {
+		m.loc.update(locNetwork, filepath.Join(m.runDir, locInfoFilename))
+	}
+	if m.usageChanged {
+		if err := m.usage.save(); err != nil {
+			log.Printf("Failed to save data usage: %v", err)
+		}
+		m.usageChanged = false
+	}
+	if ev == eventMetrics {
+		m.publish(metricsFilename, metrics)
+	} else {
+		status.ConfigChecksum = m.checksum
+		m.publish(statusFilename, status)
+	}
+}
+
+// switchProtocol switches modem to the preferred control protocol if needed.
+func (m *Manager) switchProtocol(dev modem.Device, mdm modem.Modem) bool {
+	info, err := mdm.GetInfo()
+	if err != nil {
+		return false
+	}
+	switched, err := modem.SwitchToPreferredProtocol(dev, info.Model)
+	if err != nil {
+		log.Printf("[%s] %v", dev.CDCDevice, err)
+	}
+	if switched {
+		log.Printf("[%s] Switched modem to the preferred protocol, "+
+			"the modem is restarting", dev.CDCDevice)
+	}
+	return switched
+}
+
+func (m *Manager) disconnectForRadioSilence(n *network) {
+	m.accountTraffic(n)
+	n.connected = false
+	n.probeError = ""
+	if err := m.disableRadio(n.modem, n.device.Interface); err != nil {
+		n.setError(ErrorModemCommandFailed, err)
+		return
+	}
+	n.clearError()
+}
+
+// networkStatus collects status of the modem. Scanning for visible providers,
+// which takes up to a minute, is skipped in the quick mode so that the status
+// after config change (e.g. radio-silence switched ON/OFF) is promptly published.
+func (m *Manager) networkStatus(n *network, quick bool) NetworkStatus {
+	status := NetworkStatus{
+		LogicalLabel:    n.config.LogicalLabel,
+		PhysAddrs:       devicePhysAddrs(n.device),
+		ConfigError:     n.configError,
+		ConfigErrorCode: n.errorCode,
+		ProbeError:      n.probeError,
+	}
+	mdm := n.modem
+	status.Module.ControlProtocol = string(mdm.Protocol())
+	if info, err := mdm.GetInfo(); err == nil {
+		status.Module.IMEI = info.IMEI
+		status.Module.Model = info.Model
+		status.Module.Revision = info.Revision
+	}
+	opMode, err := mdm.GetOpMode()
+	if err == nil {
+		status.Module.OpMode = string(opMode)
+	}
+	now := m.now()
+	if slots, err := mdm.GetSimSlots(); err == nil {
+		for _, slot := range slots {
+			if !slot.Present && !slot.Activated {
+				continue
+			}
+			status.SimCards = append(status.SimCards, SimCard{
+				ICCID:            slot.ICCID,
+				IMSI:             slot.IMSI,
+				SlotNumber:       slot.Number,
+				SlotActivated:    slot.Activated,
+				MonthlyDataUsage: m.usage.get(slot.ICCID, now),
+			})
+		}
+	}
+	if !quick && opMode != modem.OpModeRadioOff {
+		providers, err := mdm.ScanProviders()
+		if err != nil {
+			log.Printf("%s Failed to scan providers: %v", n.logPrefix(), err)
+		}
+		for _, provider := range providers {
+			status.Providers = append(status.Providers, Provider{
+				PLMN:           provider.PLMN,
+				Description:    provider.Description,
+				CurrentServing: provider.CurrentServing,
+				Roaming:        provider.Roaming,
+			})
+		}
+	}
+	return status
+}
+
+func (m *Manager) networkMetrics(label string, dev modem.Device, mdm modem.Modem) NetworkMetrics {
+	metrics := NetworkMetrics{
+		LogicalLabel: label,
+		PhysAddrs:    devicePhysAddrs(dev),
+		SignalInfo: SignalInfo{
+			RSSI: modem.UnavailableSignalMetric,
+			RSRQ: modem.UnavailableSignalMetric,
+			RSRP: modem.UnavailableSignalMetric,
+			SNR:  modem.UnavailableSignalMetric,
+		},
+	}
+	if stats, err := mdm.GetPacketStats(); err == nil {
+		metrics.PacketStats = PacketStats(stats)
+	}
+	if info, err := mdm.GetSignalInfo(); err == nil {
+		metrics.SignalInfo = SignalInfo(info)
+	}
+	return metrics
+}
+
+// publish writes status or metrics atomically.
+func (m *Manager) publish(filename string, content interface{}) {
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		log.Printf("Failed to serialize %s: %v", filename, err)
+		return
+	}
+	if err = writeFileAtomic(filepath.Join(m.runDir, filename), data); err != nil {
+		log.Printf("Failed to publish %s: %v", filename, err)
+	}
+}
+
+// watchFile returns channel signaling when the given file inside the directory
+// was created, modified or removed. Notifications are coalesced, the file should
+// be re-read after receiving from the channel.
+func watchFile(dir, filename string) (<-chan struct{}, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("inotify init failed: %w", err)
+	}
+	_, err = syscall.InotifyAddWatch(fd, dir, syscall.IN_CREATE|syscall.IN_MODIFY|
+		syscall.IN_CLOSE_WRITE|syscall.IN_MOVED_TO|syscall.IN_DELETE)
+	if err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("failed to watch %s: %w", dir, err)
+	}
+	changes := make(chan struct{}, 1)
+	go func() {
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+		for {
+			n, err := syscall.Read(fd, buf)
+			if err == syscall.EINTR {
+				continue
+			}
+			if err != nil {
+				log.Fatalf("Failed to read inotify events: %v", err)
+			}
+			var offset int
+			for offset+syscall.SizeofInotifyEvent <= n {
+				event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+				nameStart := offset + syscall.SizeofInotifyEvent
+				nameEnd := nameStart + int(event.Len)
+				offset = nameEnd
+				if nameEnd > n {
+					break
+				}
+				name := string(bytes.TrimRight(buf[nameStart:nameEnd], "\x00"))
+				if name != filename {
+					continue
+				}
+				select {
+				case changes <- struct{}{}:
+				default:
+					// Already signaled, not yet processed.
+				}
+			}
+		}
+	}()
+	return changes, nil
+}
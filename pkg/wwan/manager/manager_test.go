@@ -0,0 +1,312 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+type noopIfaceConfigurator struct{}
+
+func (noopIfaceConfigurator) bringUp(string, modem.IPSettings) error { return nil }
+func (noopIfaceConfigurator) bringDown(string) error                 { return nil }
+
+var testDevice = modem.Device{
+	CDCDevice: "cdc-wdm0",
+	Protocol:  modem.ProtocolQMI,
+	Interface: "wwan0",
+	USBAddr:   "1:3",
+	PCIAddr:   "0000:00:14.0",
+}
+
+type testEnv struct {
+	t       *testing.T
+	manager *Manager
+	modem   *modem.SimulatedModem
+	now     time.Time
+	// probeFails : set of SIM slots with broken data path.
+	probeFails map[uint8]bool
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	runDir := t.TempDir()
+	env := &testEnv{
+		t:          t,
+		modem:      modem.NewSimulatedModem(2),
+		now:        time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC),
+		probeFails: make(map[uint8]bool),
+	}
+	env.modem.InsertSim(1, modem.SimulatedSim{
+		ICCID:    "89012703578345957137",
+		IMSI:     "231020123456789",
+		Provider: modem.Provider{PLMN: "231-02", Description: "Telekom SK"},
+	})
+	env.modem.InsertSim(2, modem.SimulatedSim{
+		ICCID:    "89430301722113060011",
+		IMSI:     "231010987654321",
+		Provider: modem.Provider{PLMN: "231-01", Description: "Orange SK"},
+	})
+	m := New(runDir, filepath.Join(runDir, "data-usage.json"))
+	m.listDevices = func() ([]modem.Device, error) {
+		return []modem.Device{testDevice}, nil
+	}
+	m.newModem = func(modem.Device) modem.Modem { return env.modem }
+	m.iface = noopIfaceConfigurator{}
+	m.probe = func(NetworkConfig, string, modem.IPSettings) error {
+		if env.probeFails[env.modem.ActiveSlot()] {
+			return errors.New("failed to ping 8.8.8.8 via wwan0: 100% packet loss")
+		}
+		return nil
+	}
+	m.now = func() time.Time { return env.now }
+	m.waitAttempts = 2
+	m.waitInterval = 0
+	m.probeDelay = 0
+	env.manager = m
+	return env
+}
+
+func (env *testEnv) applyConfig(config Config) {
+	content, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		env.t.Fatal(err)
+	}
+	configPath := filepath.Join(env.manager.runDir, configFilename)
+	if err = ioutil.WriteFile(configPath, content, 0644); err != nil {
+		env.t.Fatal(err)
+	}
+	env.manager.reloadConfig(false)
+}
+
+func (env *testEnv) status() NetworkStatus {
+	content, err := ioutil.ReadFile(filepath.Join(env.manager.runDir, statusFilename))
+	if err != nil {
+		env.t.Fatal(err)
+	}
+	var status Status
+	if err = json.Unmarshal(content, &status); err != nil {
+		env.t.Fatal(err)
+	}
+	if status.ConfigChecksum != env.manager.checksum {
+		env.t.Errorf("unexpected config checksum: %s", status.ConfigChecksum)
+	}
+	for _, network := range status.Networks {
+		if network.LogicalLabel == "modem0" {
+			return network
+		}
+	}
+	env.t.Fatalf("missing status of modem0: %+v", status.Networks)
+	return NetworkStatus{}
+}
+
+func (env *testEnv) expectConnected(slot uint8, apn string) {
+	env.t.Helper()
+	if active := env.modem.ActiveSlot(); active != slot {
+		env.t.Errorf("expected SIM slot %d to be active, got %d", slot, active)
+	}
+	if connAPN := env.modem.APN(); connAPN != apn {
+		env.t.Errorf("expected connection with APN %q, got %q", apn, connAPN)
+	}
+}
+
+func dualSimConfig() Config {
+	return Config{
+		Networks: []NetworkConfig{
+			{
+				LogicalLabel: "modem0",
+				PhysAddrs:    PhysAddrs{USB: "1:3"},
+				Apns:         []string{"internet"},
+				SimProfiles: []SimProfile{
+					{SlotNumber: 1, APN: "primary-apn"},
+					{SlotNumber: 2, APN: "backup-apn"},
+				},
+			},
+		},
+	}
+}
+
+func TestConnectPreferredSim(t *testing.T) {
+	env := newTestEnv(t)
+	env.applyConfig(dualSimConfig())
+	env.expectConnected(1, "primary-apn")
+	status := env.status()
+	if status.ConfigError != "" || status.ConfigErrorCode != ErrorUnspecified {
+		t.Errorf("unexpected config error: %s (%s)", status.ConfigError, status.ConfigErrorCode)
+	}
+	if status.Module.OpMode != string(modem.OpModeConnected) {
+		t.Errorf("unexpected operating mode: %s", status.Module.OpMode)
+	}
+	if len(status.SimCards) != 2 || !status.SimCards[0].SlotActivated ||
+		status.SimCards[1].SlotActivated {
+		t.Errorf("unexpected SIM cards: %+v", status.SimCards)
+	}
+}
+
+func TestConnectWithoutSimProfiles(t *testing.T) {
+	env := newTestEnv(t)
+	config := dualSimConfig()
+	config.Networks[0].SimProfiles = nil
+	config.Networks[0].Apns = nil
+	env.applyConfig(config)
+	env.expectConnected(1, defaultAPN)
+}
+
+func TestFailoverOnRegistrationFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.modem.UpdateSim(1, func(sim *modem.SimulatedSim) {
+		sim.DenyRegistration = true
+	})
+	env.applyConfig(dualSimConfig())
+	env.expectConnected(2, "backup-apn")
+	status := env.status()
+	if status.ConfigError != "" {
+		t.Errorf("unexpected config error after failover: %s", status.ConfigError)
+	}
+}
+
+func TestAllSimsFail(t *testing.T) {
+	env := newTestEnv(t)
+	env.modem.UpdateSim(1, func(sim *modem.SimulatedSim) {
+		sim.DenyRegistration = true
+	})
+	env.modem.UpdateSim(2, func(sim *modem.SimulatedSim) {
+		sim.FailConnection = true
+	})
+	env.applyConfig(dualSimConfig())
+	status := env.status()
+	if status.ConfigErrorCode != ErrorRegistrationFailed {
+		t.Errorf("unexpected error code: %s", status.ConfigErrorCode)
+	}
+	if !strings.Contains(status.ConfigError, "SIM slot 1: registration denied") ||
+		!strings.Contains(status.ConfigError, "SIM slot 2: connection with APN backup-apn rejected") {
+		t.Errorf("unexpected config error: %s", status.ConfigError)
+	}
+}
+
+func TestFailoverOnProbeFailureAndFailback(t *testing.T) {
+	env := newTestEnv(t)
+	env.applyConfig(dualSimConfig())
+	env.expectConnected(1, "primary-apn")
+
+	// Data path of the primary SIM breaks.
+	env.probeFails[1] = true
+	env.now = env.now.Add(probeInterval)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(2, "backup-apn")
+	if status := env.status(); status.ProbeError != "" {
+		t.Errorf("unexpected probe error: %s", status.ProbeError)
+	}
+
+	// Primary SIM is fixed, but it is too early to fail back.
+	env.probeFails[1] = false
+	env.now = env.now.Add(probeInterval)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(2, "backup-apn")
+
+	env.now = env.now.Add(slotFailureBackoff)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(1, "primary-apn")
+}
+
+func TestKeepLastSimWithFailingProbe(t *testing.T) {
+	env := newTestEnv(t)
+	env.probeFails[1] = true
+	env.probeFails[2] = true
+	env.applyConfig(dualSimConfig())
+	// Both SIMs fail the probe, the last one tried is kept connected.
+	env.expectConnected(2, "backup-apn")
+	status := env.status()
+	if status.ConfigError != "" || status.ProbeError == "" {
+		t.Errorf("expected only probe error, got config error %q and probe error %q",
+			status.ConfigError, status.ProbeError)
+	}
+}
+
+func TestDataCap(t *testing.T) {
+	env := newTestEnv(t)
+	config := dualSimConfig()
+	config.Networks[0].SimProfiles[0].MonthlyDataCap = 1000
+	env.applyConfig(config)
+	env.expectConnected(1, "primary-apn")
+
+	env.modem.AddTraffic(400, 300)
+	env.manager.processEvent(eventMetrics)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(1, "primary-apn")
+	if usage := env.status().SimCards[0].MonthlyDataUsage; usage != 700 {
+		t.Errorf("expected data usage 700, got %d", usage)
+	}
+
+	env.modem.AddTraffic(200, 200)
+	env.manager.processEvent(eventMetrics)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(2, "backup-apn")
+
+	// Data usage survives restart.
+	usage := loadDataUsage(env.manager.usage.path)
+	if bytes := usage.get("89012703578345957137", env.now); bytes != 1100 {
+		t.Errorf("expected persisted data usage 1100, got %d", bytes)
+	}
+
+	// Data cap is reset with the new month.
+	env.now = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	env.manager.processEvent(eventProbe)
+	env.expectConnected(1, "primary-apn")
+}
+
+func TestAllDataCapsExceeded(t *testing.T) {
+	env := newTestEnv(t)
+	config := dualSimConfig()
+	config.Networks[0].SimProfiles[0].MonthlyDataCap = 1000
+	config.Networks[0].SimProfiles[1].MonthlyDataCap = 1000
+	env.manager.usage.add("89012703578345957137", 1000, env.now)
+	env.manager.usage.add("89430301722113060011", 2000, env.now)
+	env.applyConfig(config)
+	if connected, _ := env.modem.IsConnected(); connected {
+		t.Error("modem should not be connected")
+	}
+	if code := env.status().ConfigErrorCode; code != ErrorDataCapExceeded {
+		t.Errorf("unexpected error code: %s", code)
+	}
+}
+
+func TestModemNotFound(t *testing.T) {
+	env := newTestEnv(t)
+	config := dualSimConfig()
+	config.Networks[0].PhysAddrs.USB = "2:1"
+	env.applyConfig(config)
+	status := env.status()
+	if status.ConfigErrorCode != ErrorModemNotFound {
+		t.Errorf("unexpected error code: %s", status.ConfigErrorCode)
+	}
+	// Not configured modem should have radio turned off.
+	if opMode, _ := env.modem.GetOpMode(); opMode != modem.OpModeRadioOff {
+		t.Errorf("expected radio to be off, got %s", opMode)
+	}
+}
+
+func TestRadioSilence(t *testing.T) {
+	env := newTestEnv(t)
+	config := dualSimConfig()
+	env.applyConfig(config)
+	env.expectConnected(1, "primary-apn")
+
+	config.RadioSilence = true
+	env.applyConfig(config)
+	if opMode := env.status().Module.OpMode; opMode != string(modem.OpModeRadioOff) {
+		t.Errorf("expected radio to be off, got %s", opMode)
+	}
+
+	config.RadioSilence = false
+	env.applyConfig(config)
+	env.expectConnected(1, "primary-apn")
+}
@@ -0,0 +1,427 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+// defaultAPN is used if neither SIM profile nor the network specifies APN.
+const defaultAPN = "internet"
+
+// SIM slot which failed to register, connect or pass the connectivity probe
+// is tried only after other slots until slotFailureBackoff elapses.
+// Once it elapses, connection is moved back (failback) to the more preferred slot.
+const slotFailureBackoff = time.Hour
+
+// simProfile : SIM profile with APN resolved.
+type simProfile struct {
+	// slot is zero if no SIM profiles are configured and the currently
+	// activated slot should be used.
+	slot    uint8
+	apn     string
+	dataCap uint64
+}
+
+// network : cellular network configured by the controller and the state
+// of the connection.
+type network struct {
+	config NetworkConfig
+	device modem.Device
+	modem  modem.Modem
+
+	// connected is true if the connection was established by us and has not
+	// been stopped since. Whether it actually works is verified by probing.
+	connected   bool
+	connectedAt time.Time
+	profile     simProfile
+	activeICCID string
+	ipSettings  modem.IPSettings
+	traffic     trafficCounter
+	// slotFailures : time of the last failure for every SIM slot.
+	slotFailures map[uint8]time.Time
+
+	configError string
+	errorCode   ErrorCode
+	probeError  string
+}
+
+func newNetwork(config NetworkConfig) *network {
+	return &network{
+		config:       config,
+		slotFailures: make(map[uint8]time.Time),
+	}
+}
+
+func (n *network) logPrefix() string {
+	return fmt.Sprintf("[%s]", n.device.CDCDevice)
+}
+
+func (n *network) setError(code ErrorCode, err error) {
+	n.errorCode = code
+	n.configError = err.Error()
+}
+
+func (n *network) clearError() {
+	n.errorCode = ErrorUnspecified
+	n.configError = ""
+}
+
+// profiles returns SIM profiles in the order of preference.
+func (n *network) profiles() []simProfile {
+	apn := defaultAPN
+	if len(n.config.Apns) > 0 && n.config.Apns[0] != "" {
+		// XXX Multiple APNs are not yet supported.
+		apn = n.config.Apns[0]
+	}
+	if len(n.config.SimProfiles) == 0 {
+		return []simProfile{{apn: apn}}
+	}
+	var profiles []simProfile
+	for _, profile := range n.config.SimProfiles {
+		p := simProfile{slot: profile.SlotNumber, apn: profile.APN,
+			dataCap: profile.MonthlyDataCap}
+		if p.apn == "" {
+			p.apn = apn
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles
+}
+
+// findSlot returns the given SIM slot or the activated slot for slot number zero.
+func findSlot(slots []modem.SimSlot, number uint8) *modem.SimSlot {
+	for i := range slots {
+		if (number == 0 && slots[i].Activated) || slots[i].Number == number {
+			return &slots[i]
+		}
+	}
+	return nil
+}
+
+// candidates returns SIM profiles to try in the order of preference.
+// Slots without SIM card and with exceeded data cap are skipped, recently failed
+// slots are moved to the end. The second return value is true if some slots
+// were skipped because of the data cap and no candidate remained.
+func (m *Manager) candidates(n *network, slots []modem.SimSlot) ([]simProfile, bool) {
+	now := m.now()
+	var preferred, failed []simProfile
+	var capped bool
+	for _, p := range n.profiles() {
+		if slot := findSlot(slots, p.slot); slot != nil {
+			if !slot.Present {
+				continue
+			}
+			if m.usage.capExceeded(slot.ICCID, p.dataCap, now) {
+				capped = true
+				continue
+			}
+		}
+		if failedAt, ok := n.slotFailures[p.slot]; ok && now.Sub(failedAt) < slotFailureBackoff {
+			failed = append(failed, p)
+			continue
+		}
+		preferred = append(preferred, p)
+	}
+	candidates := append(preferred, failed...)
+	return candidates, capped && len(candidates) == 0
+}
+
+// waitFor polls the condition until it is satisfied or the number of attempts
+// is exhausted. The last error returned by the condition is included in the
+// timeout error.
+func (m *Manager) waitFor(what string, cond func() (bool, error)) error {
+	var lastErr error
+	for i := 0; i < m.waitAttempts; i++ {
+		if i > 0 {
+			time.Sleep(m.waitInterval)
+		}
+		done, err := cond()
+		if err == nil && done {
+			return nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return fmt.Errorf("timeout waiting for %s: %w", what, lastErr)
+	}
+	return fmt.Errorf("timeout waiting for %s", what)
+}
+
+// connect (re)establishes the connection, trying SIM profiles in the order
+// returned by candidates. Profile for which the connection fails (or does not
+// pass the probe) is marked as failed and the next one is tried.
+func (m *Manager) connect(n *network) {
+	m.accountTraffic(n)
+	n.connected = false
+	n.activeICCID = ""
+	slots, err := n.modem.GetSimSlots()
+	if err != nil {
+		log.Printf("%s Failed to get SIM slots: %v", n.logPrefix(), err)
+	}
+	candidates, allCapped := m.candidates(n, slots)
+	if len(candidates) == 0 {
+		m.disconnect(n)
+		if allCapped {
+			n.setError(ErrorDataCapExceeded,
+				errors.New("monthly data cap is exceeded for all SIM cards"))
+		} else {
+			n.setError(ErrorSimNotReady,
+				errors.New("no SIM card is inserted in any of the configured slots"))
+		}
+		return
+	}
+	var (
+		failures    []string
+		failureCode ErrorCode
+	)
+	for i, p := range candidates {
+		code, err := m.connectWithProfile(n, p)
+		if err == nil {
+			time.Sleep(m.probeDelay)
+			n.probeError = ""
+			if probeErr := m.checkConnectivity(n); probeErr != nil {
+				n.probeError = probeErr.Error()
+				if i < len(candidates)-1 {
+					// Try the next SIM, but keep this one as the last resort.
+					code = ErrorConnectionFailed
+					err = fmt.Errorf("connectivity probe failed: %w", probeErr)
+				}
+			}
+		}
+		if err == nil {
+			log.Printf("%s Connection successfully restarted (SIM slot %d)",
+				n.logPrefix(), n.profile.slot)
+			n.clearError()
+			return
+		}
+		n.connected = false
+		n.slotFailures[p.slot] = m.now()
+		log.Printf("%s Connection using SIM slot %d failed: %v",
+			n.logPrefix(), p.slot, err)
+		if p.slot != 0 {
+			err = fmt.Errorf("SIM slot %d: %w", p.slot, err)
+		}
+		if failureCode == ErrorUnspecified {
+			failureCode = code
+		}
+		failures = append(failures, err.Error())
+	}
+	n.errorCode = failureCode
+	n.configError = strings.Join(failures, "; ")
+}
+
+// connectWithProfile tries to connect using the given SIM profile.
+// Returned error code classifies the failure.
+func (m *Manager) connectWithProfile(n *network, p simProfile) (ErrorCode, error) {
+	log.Printf("%s Restarting connection (SIM slot=%d, APN=%s, interface=%s)",
+		n.logPrefix(), p.slot, p.apn, n.device.Interface)
+	if err := m.iface.bringDown(n.device.Interface); err != nil {
+		return ErrorIPConfigFailed, err
+	}
+	if err := n.modem.StopNetwork(); err != nil {
+		return ErrorModemCommandFailed, err
+	}
+	if err := n.modem.SetRadio(true); err != nil {
+		return ErrorModemCommandFailed, err
+	}
+	var slot modem.SimSlot
+	if p.slot != 0 {
+		slots, err := n.modem.GetSimSlots()
+		if err != nil {
+			return ErrorModemCommandFailed, err
+		}
+		if active := findSlot(slots, 0); active == nil || active.Number != p.slot {
+			log.Printf("%s Activating SIM slot %d", n.logPrefix(), p.slot)
+			if err = n.modem.ActivateSimSlot(p.slot); err != nil {
+				return ErrorSimNotReady, fmt.Errorf("failed to activate SIM slot: %w", err)
+			}
+		}
+	}
+	err := m.waitFor("SIM card to initialize", func() (bool, error) {
+		slots, err := n.modem.GetSimSlots()
+		if err != nil {
+			return false, err
+		}
+		active := findSlot(slots, 0)
+		if active == nil || (p.slot != 0 && active.Number != p.slot) {
+			return false, nil
+		}
+		slot = *active
+		return active.Ready, nil
+	})
+	if err != nil {
+		return ErrorSimNotReady, err
+	}
+	if m.usage.capExceeded(slot.ICCID, p.dataCap, m.now()) {
+		return ErrorDataCapExceeded, fmt.Errorf(
+			"monthly data cap of SIM card %s is exceeded", slot.ICCID)
+	}
+	// Some LTE networks require explicit (and correct) APN for the registration
+	// (for the initial EPS bearer activation).
+	if err = n.modem.SetAttachAPN(p.apn); err != nil {
+		log.Printf("%s Failed to set attach APN: %v", n.logPrefix(), err)
+	}
+	var reg modem.Registration
+	err = m.waitFor("the device to register on the network", func() (bool, error) {
+		var err error
+		reg, err = n.modem.GetRegistration()
+		return reg.Registered, err
+	})
+	if err != nil {
+		if reg.Denied {
+			err = errors.New("registration denied by the network")
+		}
+		return ErrorRegistrationFailed, err
+	}
+	if err = n.modem.StartNetwork(p.apn); err != nil {
+		return ErrorConnectionFailed, err
+	}
+	err = m.waitFor("DATA services to connect", n.modem.IsConnected)
+	if err != nil {
+		return ErrorConnectionFailed, err
+	}
+	var settings modem.IPSettings
+	err = m.waitFor("IP configuration", func() (bool, error) {
+		var err error
+		settings, err = n.modem.GetIPSettings()
+		return err == nil, err
+	})
+	if err != nil {
+		return ErrorIPConfigFailed, err
+	}
+	if err = m.iface.bringUp(n.device.Interface, settings); err != nil {
+		return ErrorIPConfigFailed, err
+	}
+	n.connected = true
+	n.connectedAt = m.now()
+	n.profile = p
+	n.activeICCID = slot.ICCID
+	n.ipSettings = settings
+	n.traffic.reset()
+	m.accountTraffic(n)
+	return ErrorUnspecified, nil
+}
+
+// disconnect stops the connection. Errors are only logged, the modem
+// may be in a state where the connection cannot be stopped gracefully.
+func (m *Manager) disconnect(n *network) {
+	m.accountTraffic(n)
+	n.connected = false
+	n.activeICCID = ""
+	if err := m.iface.bringDown(n.device.Interface); err != nil {
+		log.Printf("%s Failed to bring down interface: %v", n.logPrefix(), err)
+	}
+	if err := n.modem.StopNetwork(); err != nil {
+		log.Printf("%s Failed to stop network: %v", n.logPrefix(), err)
+	}
+}
+
+// checkConnectivity verifies the connection as reported by the modem
+// and then (optionally) by communicating with a remote endpoint.
+func (m *Manager) checkConnectivity(n *network) error {
+	if !n.connected {
+		return errors.New("modem is not connected")
+	}
+	opMode, err := n.modem.GetOpMode()
+	if err != nil {
+		return err
+	}
+	if opMode != modem.OpModeConnected {
+		return fmt.Errorf("modem is not connected (operating mode: %s)", opMode)
+	}
+	return m.probe(n.config, n.device.Interface, n.ipSettings)
+}
+
+// probeNetwork is run periodically. It reconnects (failing over to another
+// SIM slot) if the connection is broken, the data cap of the active SIM card
+// has been exceeded, or if it is time to fail back to a more preferred slot.
+func (m *Manager) probeNetwork(n *network) {
+	m.accountTraffic(n)
+	if err := m.checkConnectivity(n); err != nil {
+		n.probeError = err.Error()
+		if n.connected {
+			log.Printf("%s Connectivity probe failed: %v", n.logPrefix(), err)
+			n.slotFailures[n.profile.slot] = m.now()
+		}
+		m.connect(n)
+		return
+	}
+	n.probeError = ""
+	now := m.now()
+	if m.usage.capExceeded(n.activeICCID, n.profile.dataCap, now) {
+		log.Printf("%s Monthly data cap of SIM card %s is exceeded",
+			n.logPrefix(), n.activeICCID)
+		m.connect(n)
+		return
+	}
+	if len(n.config.SimProfiles) < 2 || now.Sub(n.connectedAt) < slotFailureBackoff {
+		return
+	}
+	slots, err := n.modem.GetSimSlots()
+	if err != nil {
+		return
+	}
+	candidates, _ := m.candidates(n, slots)
+	if len(candidates) > 0 && candidates[0].slot != n.profile.slot &&
+		m.isPreferred(n, candidates[0].slot) {
+		log.Printf("%s Failing back to the preferred SIM slot %d",
+			n.logPrefix(), candidates[0].slot)
+		m.connect(n)
+	}
+}
+
+// isPreferred returns true if the slot has higher preference than the active slot.
+func (m *Manager) isPreferred(n *network, slot uint8) bool {
+	for _, p := range n.config.SimProfiles {
+		switch p.SlotNumber {
+		case slot:
+			return true
+		case n.profile.slot:
+			return false
+		}
+	}
+	return false
+}
+
+// disableRadio turns off radio transmission of the modem.
+func (m *Manager) disableRadio(mdm modem.Modem, iface string) error {
+	opMode, err := mdm.GetOpMode()
+	if err == nil && opMode == modem.OpModeRadioOff {
+		return nil
+	}
+	if err = mdm.SetRadio(false); err != nil {
+		return err
+	}
+	err = m.waitFor("radio to turn off", func() (bool, error) {
+		opMode, err := mdm.GetOpMode()
+		return opMode == modem.OpModeRadioOff, err
+	})
+	if err != nil {
+		return err
+	}
+	return m.iface.bringDown(iface)
+}
+
+// accountTraffic adds traffic of the active connection to the data usage.
+func (m *Manager) accountTraffic(n *network) {
+	if !n.connected || n.activeICCID == "" {
+		return
+	}
+	stats, err := n.modem.GetPacketStats()
+	if err != nil {
+		return
+	}
+	delta := n.traffic.update(stats.RxBytes + stats.TxBytes)
+	if delta > 0 {
+		m.usage.add(n.activeICCID, delta, m.now())
+		m.usageChanged = true
+	}
+}
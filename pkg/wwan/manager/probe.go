@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/wwan/modem"
+)
+
+// defaultProbeAddr is pinged if the user did not configure the probe address
+// and other probing methods failed.
+const defaultProbeAddr = "8.8.8.8"
+
+const (
+	tcpProbeTimeout = 5 * time.Second
+	dnsProbeTimeout = 5 * time.Second
+)
+
+// connProber verifies connectivity over the cellular network.
+// Tests replace it to simulate broken connection.
+type connProber func(config NetworkConfig, iface string, settings modem.IPSettings) error
+
+// probeConnectivity tries user-configured ICMP probe address if set.
+// Otherwise it first tries endpoints from inside the cellular network:
+//   - TCP handshake with an IP-addressed proxy
+//   - DNS request to a DNS server provided by the network
+//
+// As a last resort, it tries to ping Google DNS (can be blocked by firewall).
+func probeConnectivity(config NetworkConfig, iface string, settings modem.IPSettings) error {
+	if config.Probe.Disable {
+		return nil
+	}
+	if config.Probe.Address != "" {
+		return icmpProbe(iface, config.Probe.Address)
+	}
+	var probeErrs []string
+	for _, proxy := range config.Proxies {
+		if net.ParseIP(proxy.Server).To4() == nil {
+			continue
+		}
+		err := tcpProbe(settings.Address, proxy.Server, proxy.Port)
+		if err == nil {
+			return nil
+		}
+		probeErrs = append(probeErrs, err.Error())
+	}
+	for _, dns := range settings.DNS {
+		err := dnsProbe(settings.Address, dns)
+		if err == nil {
+			return nil
+		}
+		probeErrs = append(probeErrs, err.Error())
+	}
+	// In a private LTE network ICMP requests headed towards public DNS servers
+	// may be blocked by the firewall and thus produce probing false negatives.
+	err := icmpProbe(iface, defaultProbeAddr)
+	if err == nil {
+		return nil
+	}
+	probeErrs = append(probeErrs, err.Error())
+	return errors.New(strings.Join(probeErrs, "; "))
+}
+
+func icmpProbe(iface, addr string) error {
+	// ping returns 0 even if just a single packet out of 3 gets through.
+	out, err := exec.Command("ping", "-W", "20", "-w", "20", "-c", "3",
+		"-I", iface, addr).CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	probeErr := strings.TrimSpace(string(out))
+	for _, line := range strings.Split(probeErr, "\n") {
+		if strings.Contains(line, "packet loss") {
+			probeErr = line
+			break
+		}
+	}
+	if probeErr == "" {
+		probeErr = err.Error()
+	}
+	return fmt.Errorf("failed to ping %s via %s: %s", addr, iface, probeErr)
+}
+
+func tcpProbe(localIP net.IP, server string, port uint32) error {
+	dialer := net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: localIP},
+		Timeout:   tcpProbeTimeout,
+	}
+	addr := net.JoinHostPort(server, strconv.Itoa(int(port)))
+	conn, err := dialer.Dial("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("TCP handshake with proxy %s failed", addr)
+	}
+	conn.Close()
+	return nil
+}
+
+func dnsProbe(localIP, dns net.IP) error {
+	resolver := net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer := net.Dialer{LocalAddr: &net.UDPAddr{IP: localIP}}
+			return dialer.DialContext(ctx, "udp", net.JoinHostPort(dns.String(), "53"))
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dnsProbeTimeout)
+	defer cancel()
+	// Query for the root domain to get only small-sized response.
+	_, err := resolver.LookupNS(ctx, ".")
+	var dnsErr *net.DNSError
+	if err == nil || (errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+		// Any response from the server proves the connectivity.
+		return nil
+	}
+	return fmt.Errorf("DNS query sent to %s failed", dns)
+}
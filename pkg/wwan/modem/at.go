@@ -0,0 +1,87 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// sendATCommand sends Hayes command to the given tty device.
+// https://en.wikipedia.org/wiki/Hayes_command_set
+func sendATCommand(run cmdRunner, command, tty string) (string, error) {
+	// picocom does not read the command from arguments, use shell to pipe it in.
+	return run("sh", "-c",
+		fmt.Sprintf(`printf "%%s\r\n" '%s' | picocom -qrx 2000 -b 9600 %s`,
+			strings.ReplaceAll(command, "'", `'\''`), tty))
+}
+
+// findATPort returns tty device of the modem responding to AT commands.
+func findATPort(run cmdRunner, usbAddr string) (string, error) {
+	if usbAddr == "" {
+		return "", errors.New("USB address is not known")
+	}
+	// Convert USB address to <bus>-<port> as used in the /sys filesystem.
+	prefix := strings.ReplaceAll(usbAddr, ":", "-")
+	intfs, err := filepath.Glob(filepath.Join("/sys/bus/usb/devices", prefix+"*"))
+	if err != nil {
+		return "", err
+	}
+	for _, intf := range intfs {
+		entries, err := ioutil.ReadDir(intf)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Name(), "tty") {
+				continue
+			}
+			tty := filepath.Join("/dev", entry.Name())
+			out, err := sendATCommand(run, "AT", tty)
+			if err == nil && strings.Contains(out, "OK") {
+				return tty, nil
+			}
+		}
+	}
+	return "", errors.New("AT port is not available")
+}
+
+// SwitchToPreferredProtocol switches modem to the protocol which works
+// better with the given model. Returns true if the switch was executed
+// and the modem is being restarted.
+func SwitchToPreferredProtocol(dev Device, model string) (bool, error) {
+	switch model {
+	case "EM7565":
+		// With Sierra Wireless EM7565 we are experiencing quite often a firmware
+		// bug causing the transmit queue to get stuck. In dmesg we see:
+		//   NETDEV WATCHDOG: wwan0 (qmi_wwan): transmit queue 0 timed out
+		// Followed by a stacktrace which shows that netif_tx_lock hangs.
+		// This can be only fixed by restarting the modem, but it does not guarantee
+		// that it will not happen again. The bug is easily reproducible with QMI,
+		// but not with MBIM. Therefore we prefer to use MBIM with this modem.
+		if dev.Protocol == ProtocolMBIM {
+			return false, nil
+		}
+		atPort, err := findATPort(execCommand, dev.USBAddr)
+		if err != nil {
+			return false, fmt.Errorf("cannot switch modem to MBIM: %w", err)
+		}
+		for _, cmd := range []string{
+			"+++", `AT!ENTERCND="A710"`, "AT!USBCOMP=1,1,100D", "AT!RESET"} {
+			out, err := sendATCommand(execCommand, cmd, atPort)
+			if err == nil && strings.Contains(out, "ERROR") {
+				err = errors.New(strings.TrimSpace(out))
+			}
+			if err != nil {
+				return false, fmt.Errorf("failed to switch modem to MBIM "+
+					"(using AT port %s): %w", atPort, err)
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
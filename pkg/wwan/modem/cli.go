@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Some commands (e.g. network scan) take up to a minute, but a modem not responding
+// for two minutes is most likely stuck.
+const cmdTimeout = 2 * time.Minute
+
+// cmdRunner executes a command and returns its standard output.
+// Tests replace it to return a recorded output.
+type cmdRunner func(name string, args ...string) (string, error)
+
+func execCommand(name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("%s %s: timeout after %v",
+				name, strings.Join(args, " "), cmdTimeout)
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return stdout.String(), fmt.Errorf("%s %s: %s",
+			name, strings.Join(args, " "), msg)
+	}
+	return stdout.String(), nil
+}
+
+// parseAttr returns value of the attribute printed by qmicli or mbimcli
+// as "<attr>: '<value>'" (quotes are optional), without the unit suffix
+// (e.g. " dBm") if given. Only the first occurrence is returned. Value "unknown" (used by libqmi
+// and libmbim for unknown enum values) is returned as empty string.
+func parseAttr(output, attr, unit string) string {
+	prefix := attr + ":"
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		idx := strings.Index(line, prefix)
+		// attribute may follow the device path, e.g. "[/dev/cdc-wdm0] Connection status: ..."
+		if idx < 0 || (idx > 0 && line[idx-1] != ' ' && line[idx-1] != '\t') {
+			continue
+		}
+		value := strings.TrimSpace(line[idx+len(prefix):])
+		value = strings.TrimSuffix(value, "'")
+		value = strings.TrimSuffix(value, unit)
+		value = strings.Trim(value, "'")
+		if value == "unknown" {
+			return ""
+		}
+		return value
+	}
+	return ""
+}
+
+// splitBlocks splits output listing multiple items (e.g. "Network [0]:")
+// into blocks, one per item. Lines before the first item are dropped.
+func splitBlocks(output string, isHeader func(line string) bool) []string {
+	var blocks []string
+	var block strings.Builder
+	inBlock := false
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if isHeader(strings.TrimSpace(line)) {
+			if inBlock {
+				blocks = append(blocks, block.String())
+				block.Reset()
+			}
+			inBlock = true
+		}
+		if inBlock {
+			block.WriteString(line)
+			block.WriteString("\n")
+		}
+	}
+	if inBlock {
+		blocks = append(blocks, block.String())
+	}
+	return blocks
+}
+
+// formatPLMN returns PLMN code as "MCC-MNC" with zero-padding,
+// MNC can be two or three digits long.
+func formatPLMN(mcc, mnc string) string {
+	if mcc == "" || mnc == "" {
+		return ""
+	}
+	for len(mcc) < 3 {
+		mcc = "0" + mcc
+	}
+	for len(mnc) < 2 {
+		mnc = "0" + mnc
+	}
+	return mcc + "-" + mnc
+}
+
+// parseIPv4 returns nil for anything else than a valid IPv4 address.
+func parseIPv4(addr string) net.IP {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil
+	}
+	return ip.To4()
+}
+
+// swapNibbles decodes BCD-coded digits with swapped nibbles as used by SIM cards
+// for ICCID and IMSI (see ETSI TS 102 221 and 3GPP TS 31.102). Padding (F) is dropped.
+func swapNibbles(hexBytes []string) string {
+	var digits strings.Builder
+	for _, b := range hexBytes {
+		b = strings.TrimSpace(b)
+		if len(b) != 2 {
+			continue
+		}
+		for _, digit := range []byte{b[1], b[0]} {
+			if digit != 'F' && digit != 'f' {
+				digits.WriteByte(digit)
+			}
+		}
+	}
+	return digits.String()
+}
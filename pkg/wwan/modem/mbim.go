@@ -0,0 +1,318 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MBIMModem controls modem using the MBIM protocol.
+// MBIM does not provide commands to switch SIM slots or to modify profiles,
+// for these QMI messages are sent over the MBIM device (supported by most
+// Qualcomm-based modems).
+type MBIMModem struct {
+	device string
+	qmi    *QMIModem
+	run    cmdRunner
+}
+
+// How long to wait after the packet service is attached before requesting
+// the data connection.
+var mbimAttachDelay = 10 * time.Second
+
+// NewMBIMModem returns modem controlled via the given MBIM device.
+func NewMBIMModem(device, iface string) *MBIMModem {
+	return &MBIMModem{
+		device: device,
+		qmi:    newQMIOverMBIM(device, iface, execCommand),
+		run:    execCommand,
+	}
+}
+
+func (m *MBIMModem) mbim(args ...string) (string, error) {
+	return m.run("mbimcli", append([]string{"-p", "-d", m.device}, args...)...)
+}
+
+// Protocol returns ProtocolMBIM.
+func (m *MBIMModem) Protocol() Protocol {
+	return ProtocolMBIM
+}
+
+// GetInfo returns IMEI, model and firmware revision.
+func (m *MBIMModem) GetInfo() (info Info, err error) {
+	out, err := m.mbim("--query-device-caps")
+	if err != nil {
+		return info, err
+	}
+	return Info{
+		IMEI:     parseAttr(out, "Device ID", ""),
+		Model:    parseAttr(out, "Hardware info", ""),
+		Revision: parseAttr(out, "Firmware info", ""),
+	}, nil
+}
+
+// GetOpMode returns OpModeRadioOff if either hardware or software radio
+// is off, otherwise the modem is online and connected once the packet
+// service is attached.
+func (m *MBIMModem) GetOpMode() (OpMode, error) {
+	out, err := m.mbim("--query-radio-state")
+	if err != nil {
+		return OpModeUnspecified, err
+	}
+	if parseAttr(out, "Hardware radio state", "") == "off" ||
+		parseAttr(out, "Software radio state", "") == "off" {
+		return OpModeRadioOff, nil
+	}
+	out, err = m.mbim("--query-packet-service-state")
+	if err == nil && parseAttr(out, "Packet service state", "") == "attached" {
+		return OpModeConnected, nil
+	}
+	// MBIM does not distinguish offline from online-but-detached.
+	return OpModeOnline, nil
+}
+
+// SetRadio sets the software radio state.
+func (m *MBIMModem) SetRadio(on bool) error {
+	state := "off"
+	if on {
+		state = "on"
+	}
+	_, err := m.mbim("--set-radio-state=" + state)
+	return err
+}
+
+// GetSimSlots returns state of SIM slots as reported via QMI-over-MBIM,
+// combined with the subscriber state of the activated slot.
+// Without QMI support the modem is reported to have a single slot.
+func (m *MBIMModem) GetSimSlots() ([]SimSlot, error) {
+	var slots []SimSlot
+	out, err := m.qmi.qmi("--uim-get-slot-status")
+	if err == nil {
+		slots = parseQMISlotStatus(out)
+	}
+	if len(slots) == 0 {
+		slots = []SimSlot{{Number: 1, Activated: true, Present: true}}
+	}
+	out, err = m.mbim("--query-subscriber-ready-status")
+	if err != nil {
+		return slots, err
+	}
+	ready := parseAttr(out, "Ready state", "") == "initialized"
+	// Remove trailing Fs that modem may add as a padding.
+	iccid := strings.TrimRight(parseAttr(out, "SIM ICCID", ""), "Ff")
+	imsi := parseAttr(out, "Subscriber ID", "")
+	for i := range slots {
+		if slots[i].Activated {
+			slots[i].Ready = ready
+			if iccid != "" {
+				slots[i].ICCID = iccid
+			}
+			slots[i].IMSI = imsi
+		}
+	}
+	return slots, nil
+}
+
+// ActivateSimSlot switches SIM slot using QMI-over-MBIM.
+func (m *MBIMModem) ActivateSimSlot(slot uint8) error {
+	return m.qmi.ActivateSimSlot(slot)
+}
+
+// SetAttachAPN modifies the default profile using QMI-over-MBIM,
+// mbimcli does not yet provide commands to manipulate with profiles.
+func (m *MBIMModem) SetAttachAPN(apn string) error {
+	return m.qmi.SetAttachAPN(apn)
+}
+
+// GetRegistration returns registration state with the serving network.
+func (m *MBIMModem) GetRegistration() (reg Registration, err error) {
+	out, err := m.mbim("--query-registration-state")
+	if err != nil {
+		return reg, err
+	}
+	return parseMBIMRegistration(out), nil
+}
+
+func parseMBIMRegistration(out string) (reg Registration) {
+	state := parseAttr(out, "Register state", "")
+	switch state {
+	case "home", "roaming", "partner":
+		reg.Registered = true
+	case "denied":
+		reg.Denied = true
+	}
+	if !reg.Registered {
+		return reg
+	}
+	reg.Provider = Provider{
+		PLMN:           formatMBIMProviderID(parseAttr(out, "Provider ID", "")),
+		Description:    parseAttr(out, "Provider name", ""),
+		CurrentServing: true,
+		Roaming:        state != "home",
+	}
+	return reg
+}
+
+// formatMBIMProviderID converts MBIM provider ID (MCC followed by MNC)
+// to "MCC-MNC".
+func formatMBIMProviderID(id string) string {
+	if len(id) < 5 {
+		return ""
+	}
+	return formatPLMN(id[:3], id[3:])
+}
+
+// ScanProviders returns visible network operators.
+func (m *MBIMModem) ScanProviders() ([]Provider, error) {
+	out, err := m.mbim("--query-visible-providers")
+	if err != nil {
+		return nil, err
+	}
+	return parseMBIMVisibleProviders(out), nil
+}
+
+func parseMBIMVisibleProviders(out string) (providers []Provider) {
+	blocks := splitBlocks(out, func(line string) bool {
+		return strings.HasPrefix(line, "Provider [")
+	})
+	seen := make(map[Provider]bool)
+	for _, block := range blocks {
+		state := parseAttr(block, "State", "")
+		provider := Provider{
+			PLMN:           formatMBIMProviderID(parseAttr(block, "Provider ID", "")),
+			Description:    parseAttr(block, "Provider name", ""),
+			CurrentServing: strings.Contains(state, "registered"),
+			Roaming:        strings.Contains(state, "roaming"),
+		}
+		if provider.PLMN == "" || seen[provider] {
+			continue
+		}
+		seen[provider] = true
+		providers = append(providers, provider)
+	}
+	return providers
+}
+
+// StartNetwork attaches packet service and connects with the given APN.
+func (m *MBIMModem) StartNetwork(apn string) error {
+	// After attaching the packet service the modem may need some time
+	// before it accepts the connect request.
+	if _, err := m.mbim("--attach-packet-service"); err != nil {
+		return err
+	}
+	time.Sleep(mbimAttachDelay)
+	_, err := m.mbim(fmt.Sprintf("--connect=apn='%s'", apn))
+	return err
+}
+
+// StopNetwork disconnects and detaches packet service.
+// Errors are ignored, the connection may not be established in the first place.
+func (m *MBIMModem) StopNetwork() error {
+	_, _ = m.mbim("--disconnect")
+	_, _ = m.mbim("--detach-packet-service")
+	return nil
+}
+
+// IsConnected returns true if the connection is activated.
+func (m *MBIMModem) IsConnected() (bool, error) {
+	out, err := m.mbim("--query-connection-state")
+	if err != nil {
+		return false, err
+	}
+	return parseAttr(out, "Activation state", "") == "activated", nil
+}
+
+// mbimIPConfig : output of mbimcli --query-ip-configuration
+// (JSON output is added to libmbim by our patch).
+type mbimIPConfig struct {
+	MTU  int `json:"mtu"`
+	IPv4 struct {
+		IP      string `json:"ip"`
+		Subnet  string `json:"subnet"`
+		Gateway string `json:"gateway"`
+		DNS0    string `json:"dns0"`
+		DNS1    string `json:"dns1"`
+	} `json:"ipv4"`
+}
+
+// GetIPSettings returns IPv4 settings of the data connection.
+func (m *MBIMModem) GetIPSettings() (settings IPSettings, err error) {
+	out, err := m.mbim("--query-ip-configuration")
+	if err != nil {
+		return settings, err
+	}
+	return parseMBIMIPConfig(out)
+}
+
+func parseMBIMIPConfig(out string) (settings IPSettings, err error) {
+	var config mbimIPConfig
+	if err = json.Unmarshal([]byte(out), &config); err != nil {
+		return settings, fmt.Errorf("failed to parse IP configuration: %w", err)
+	}
+	settings.Address = parseIPv4(config.IPv4.IP)
+	if settings.Address == nil {
+		return settings, errors.New("modem did not provide IPv4 address")
+	}
+	if mask := parseIPv4(config.IPv4.Subnet); mask != nil {
+		settings.Mask = []byte(mask)
+	}
+	settings.Gateway = parseIPv4(config.IPv4.Gateway)
+	for _, dns := range []string{config.IPv4.DNS0, config.IPv4.DNS1} {
+		if ip := parseIPv4(dns); ip != nil {
+			settings.DNS = append(settings.DNS, ip)
+		}
+	}
+	settings.MTU = config.MTU
+	return settings, nil
+}
+
+// GetPacketStats returns counters of the data connection.
+// Discarded packets and packets with errors are both reported as drops.
+func (m *MBIMModem) GetPacketStats() (stats PacketStats, err error) {
+	out, err := m.mbim("--query-packet-statistics")
+	if err != nil {
+		return stats, err
+	}
+	counter := func(attr string) uint64 {
+		value, _ := strconv.ParseUint(parseAttr(out, attr, ""), 10, 64)
+		return value
+	}
+	return PacketStats{
+		RxBytes:   counter("Octets (in)"),
+		RxPackets: counter("Packets (in)"),
+		RxDrops:   counter("Discards (in)") + counter("Errors (in)"),
+		TxBytes:   counter("Octets (out)"),
+		TxPackets: counter("Packets (out)"),
+		TxDrops:   counter("Discards (out)") + counter("Errors (out)"),
+	}, nil
+}
+
+// GetSignalInfo returns RSSI, other metrics are not provided by MBIM.
+func (m *MBIMModem) GetSignalInfo() (info SignalInfo, err error) {
+	out, err := m.mbim("--query-signal-state")
+	if err != nil {
+		return info, err
+	}
+	return parseMBIMSignalState(out), nil
+}
+
+func parseMBIMSignalState(out string) SignalInfo {
+	info := SignalInfo{
+		RSSI: UnavailableSignalMetric,
+		RSRQ: UnavailableSignalMetric,
+		RSRP: UnavailableSignalMetric,
+		SNR:  UnavailableSignalMetric,
+	}
+	rssi, err := strconv.Atoi(parseAttr(out, "RSSI [0-31,99]", ""))
+	if err == nil && rssi != 99 {
+		// See table 10-58 (MBIM_SIGNAL_STATE_INFO) in MBIM_v1_0_USBIF_FINAL.pdf
+		info.RSSI = int32(-113 + 2*rssi)
+	}
+	return info
+}
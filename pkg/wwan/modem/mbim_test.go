@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+const mbimRegistrationState = `[/dev/cdc-wdm0] Registration status:
+	         Network error: 'unknown'
+	        Register state: 'roaming'
+	         Register mode: 'automatic'
+	Available data classes: 'lte'
+	Current cellular class: 'gsm'
+	           Provider ID: '23102'
+	         Provider name: 'Telekom SK'
+	          Roaming text: 'unknown'
+	    Registration flags: 'packet-service-automatic-attach'
+`
+
+const mbimVisibleProviders = `[/dev/cdc-wdm0] Providers:
+	Provider [0]:
+		    Provider ID: '23102'
+		  Provider name: 'Telekom SK'
+		          State: 'home, visible, registered'
+		Cellular class: 'gsm'
+		           RSSI: '18'
+		     Error rate: '99'
+	Provider [1]:
+		    Provider ID: '231015'
+		  Provider name: 'Orange SK'
+		          State: 'visible, roaming'
+		Cellular class: 'gsm'
+		           RSSI: '99'
+		     Error rate: '99'
+`
+
+const mbimIPConfiguration = `{
+	"pdp-type": "ipv4",
+	"ip-family": "ipv4",
+	"mtu": 1430,
+	"ipv4": {
+		"ip": "10.109.23.148",
+		"subnet": "255.255.255.240",
+		"gateway": "10.109.23.149",
+		"dns0": "10.0.0.1",
+		"dummy": 0
+	},
+	"ipv6": {
+		"dummy": 0
+	}
+}
+`
+
+func TestParseMBIMRegistration(t *testing.T) {
+	expected := Registration{
+		Registered: true,
+		Provider: Provider{
+			PLMN:           "231-02",
+			Description:    "Telekom SK",
+			CurrentServing: true,
+			Roaming:        true,
+		},
+	}
+	reg := parseMBIMRegistration(mbimRegistrationState)
+	if !reflect.DeepEqual(reg, expected) {
+		t.Errorf("expected %+v, got %+v", expected, reg)
+	}
+	reg = parseMBIMRegistration("Register state: 'denied'\n")
+	if reg.Registered || !reg.Denied {
+		t.Errorf("expected denied registration, got %+v", reg)
+	}
+}
+
+func TestParseMBIMVisibleProviders(t *testing.T) {
+	expected := []Provider{
+		{PLMN: "231-02", Description: "Telekom SK", CurrentServing: true},
+		{PLMN: "231-015", Description: "Orange SK", Roaming: true},
+	}
+	providers := parseMBIMVisibleProviders(mbimVisibleProviders)
+	if !reflect.DeepEqual(providers, expected) {
+		t.Errorf("expected %+v, got %+v", expected, providers)
+	}
+}
+
+func TestParseMBIMIPConfig(t *testing.T) {
+	settings, err := parseMBIMIPConfig(mbimIPConfiguration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := IPSettings{
+		Address: net.IP{10, 109, 23, 148},
+		Mask:    net.IPMask{255, 255, 255, 240},
+		Gateway: net.IP{10, 109, 23, 149},
+		DNS:     []net.IP{{10, 0, 0, 1}},
+		MTU:     1430,
+	}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Errorf("expected %+v, got %+v", expected, settings)
+	}
+	if _, err = parseMBIMIPConfig("error: couldn't open the MbimDevice"); err == nil {
+		t.Error("expected error for invalid output")
+	}
+}
+
+func TestParseMBIMSignalState(t *testing.T) {
+	info := parseMBIMSignalState("[/dev/cdc-wdm0] Signal state:\n\t          RSSI [0-31,99]: '19'\n")
+	if info.RSSI != -75 || info.RSRP != UnavailableSignalMetric {
+		t.Errorf("unexpected signal info: %+v", info)
+	}
+	info = parseMBIMSignalState("\tRSSI [0-31,99]: '99'\n")
+	if info.RSSI != UnavailableSignalMetric {
+		t.Errorf("expected unavailable RSSI, got %d", info.RSSI)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package modem controls cellular modems using the QMI or MBIM protocol.
+// Commands are sent to the modem through qmicli and mbimcli (via qmi-proxy
+// and mbim-proxy, so that multiple clients can share the device), their output
+// is parsed into the structures defined here.
+package modem
+
+import (
+	"math"
+	"net"
+)
+
+// Protocol used to control the modem.
+type Protocol string
+
+const (
+	// ProtocolQMI : Qualcomm MSM Interface
+	ProtocolQMI Protocol = "qmi"
+	// ProtocolMBIM : Mobile Broadband Interface Model
+	ProtocolMBIM Protocol = "mbim"
+)
+
+// OpMode : operating mode of the modem.
+// Values are the same as used by types.WwanOpMode in pillar.
+type OpMode string
+
+const (
+	// OpModeUnspecified : operating mode is not known
+	OpModeUnspecified OpMode = ""
+	// OpModeOnline : modem is online but not connected
+	OpModeOnline OpMode = "online"
+	// OpModeConnected : modem is online and connected
+	OpModeConnected OpMode = "online-and-connected"
+	// OpModeRadioOff : modem has disabled radio transmission
+	OpModeRadioOff OpMode = "radio-off"
+	// OpModeOffline : modem is offline
+	OpModeOffline OpMode = "offline"
+	// OpModeUnrecognized : operating mode reported by the modem is not recognized
+	OpModeUnrecognized OpMode = "unrecognized"
+)
+
+// UnavailableSignalMetric is used for signal metrics not provided by the modem.
+const UnavailableSignalMetric = math.MaxInt32
+
+// Info : identification of the modem.
+type Info struct {
+	IMEI     string
+	Model    string
+	Revision string
+}
+
+// SimSlot : state of a SIM slot and of the card inserted into it.
+type SimSlot struct {
+	// Number of the (physical) slot, starting with 1.
+	Number uint8
+	// Activated is true for the slot used by the modem.
+	// Only one slot can be activated at a time.
+	Activated bool
+	// Present is true if there is a SIM card inserted into the slot.
+	Present bool
+	// Ready is true if the card in the activated slot is initialized
+	// and not locked.
+	Ready bool
+	ICCID string
+	// IMSI is only known for the card in the activated slot.
+	IMSI string
+}
+
+// Provider : cellular network operator.
+type Provider struct {
+	// PLMN code in the format "MCC-MNC".
+	PLMN           string
+	Description    string
+	CurrentServing bool
+	Roaming        bool
+}
+
+// Registration : registration of the modem on the network.
+type Registration struct {
+	Registered bool
+	// Denied is true if the network rejected the registration.
+	Denied bool
+	// Provider is the serving network if registered.
+	Provider Provider
+}
+
+// IPSettings : IPv4 configuration of the data connection.
+type IPSettings struct {
+	Address net.IP
+	Mask    net.IPMask
+	Gateway net.IP
+	DNS     []net.IP
+	// MTU is zero if not provided by the modem.
+	MTU int
+}
+
+// PacketStats : counters of the data connection.
+type PacketStats struct {
+	RxBytes   uint64
+	RxPackets uint64
+	RxDrops   uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxDrops   uint64
+}
+
+// SignalInfo : signal strength and quality.
+// UnavailableSignalMetric is used for values not provided by the modem.
+type SignalInfo struct {
+	// Received signal strength indicator in dBm.
+	RSSI int32
+	// Reference signal received quality in dB.
+	RSRQ int32
+	// Reference signal received power in dBm.
+	RSRP int32
+	// Signal-to-noise ratio in dB.
+	SNR int32
+}
+
+// Modem is implemented for QMI and MBIM modems. SimulatedModem implements
+// it in memory for tests.
+type Modem interface {
+	// Protocol used to control the modem.
+	Protocol() Protocol
+	// GetInfo returns identification of the modem.
+	GetInfo() (Info, error)
+	// GetOpMode returns the current operating mode.
+	GetOpMode() (OpMode, error)
+	// SetRadio enables or disables radio transmission.
+	SetRadio(on bool) error
+	// GetSimSlots returns state of all SIM slots ordered by slot number.
+	// Modems with a single SIM slot return one activated slot.
+	GetSimSlots() ([]SimSlot, error)
+	// ActivateSimSlot switches the modem to the given SIM slot.
+	// The modem may take some time to initialize the card afterwards.
+	ActivateSimSlot(slot uint8) error
+	// SetAttachAPN sets APN used to register with the network (for the initial
+	// EPS bearer). Some LTE networks reject the attach with an incorrect APN.
+	SetAttachAPN(apn string) error
+	// GetRegistration returns the current registration status.
+	GetRegistration() (Registration, error)
+	// ScanProviders returns visible network operators. This can take up to a minute.
+	ScanProviders() ([]Provider, error)
+	// StartNetwork requests data connection with the given APN.
+	StartNetwork(apn string) error
+	// StopNetwork terminates data connection.
+	StopNetwork() error
+	// IsConnected returns true if the data connection is established.
+	IsConnected() (bool, error)
+	// GetIPSettings returns IP configuration of the data connection.
+	GetIPSettings() (IPSettings, error)
+	// GetPacketStats returns counters of the data connection.
+	GetPacketStats() (PacketStats, error)
+	// GetSignalInfo returns signal strength and quality.
+	GetSignalInfo() (SignalInfo, error)
+}
@@ -0,0 +1,440 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QMIModem controls modem using the QMI protocol.
+type QMIModem struct {
+	// Control device, e.g. /dev/cdc-wdm0.
+	device string
+	// Network interface, e.g. wwan0.
+	iface string
+	// Extra arguments for qmicli, used to send QMI messages over MBIM.
+	openArgs []string
+	run      cmdRunner
+	// Packet data handle and client ID of the data connection,
+	// needed to stop it.
+	packetDataHandle string
+	clientID         string
+}
+
+// NewQMIModem returns modem controlled via the given QMI device.
+func NewQMIModem(device, iface string) *QMIModem {
+	return &QMIModem{device: device, iface: iface, run: execCommand}
+}
+
+// newQMIOverMBIM returns QMIModem used to send QMI messages through the MBIM device.
+// Many MBIM modems support it, which gives us access to SIM slots and profiles
+// not covered by the MBIM protocol.
+func newQMIOverMBIM(device, iface string, run cmdRunner) *QMIModem {
+	return &QMIModem{device: device, iface: iface, run: run,
+		openArgs: []string{"--device-open-mbim"}}
+}
+
+func (m *QMIModem) qmi(args ...string) (string, error) {
+	cmdArgs := append([]string{"-p"}, m.openArgs...)
+	cmdArgs = append(cmdArgs, "-d", m.device)
+	return m.run("qmicli", append(cmdArgs, args...)...)
+}
+
+// Protocol returns ProtocolQMI.
+func (m *QMIModem) Protocol() Protocol {
+	return ProtocolQMI
+}
+
+// GetInfo returns IMEI, model and firmware revision.
+func (m *QMIModem) GetInfo() (info Info, err error) {
+	out, err := m.qmi("--dms-get-ids")
+	if err != nil {
+		return info, err
+	}
+	info.IMEI = parseAttr(out, "IMEI", "")
+	if out, err = m.qmi("--dms-get-model"); err != nil {
+		return info, err
+	}
+	info.Model = parseAttr(out, "Model", "")
+	if out, err = m.qmi("--dms-get-revision"); err != nil {
+		return info, err
+	}
+	info.Revision = parseAttr(out, "Revision", "")
+	return info, nil
+}
+
+// GetOpMode returns the operating mode, online modem with established
+// data connection is reported as OpModeConnected.
+func (m *QMIModem) GetOpMode() (OpMode, error) {
+	out, err := m.qmi("--dms-get-operating-mode")
+	if err != nil {
+		return OpModeUnspecified, err
+	}
+	switch parseAttr(out, "Mode", "") {
+	case "online":
+		connected, err := m.IsConnected()
+		if err == nil && connected {
+			return OpModeConnected, nil
+		}
+		return OpModeOnline, nil
+	case "offline":
+		return OpModeOffline, nil
+	case "low-power", "persistent-low-power", "mode-only-low-power":
+		return OpModeRadioOff, nil
+	}
+	return OpModeUnrecognized, nil
+}
+
+// SetRadio switches between online and (persistent) low-power mode.
+func (m *QMIModem) SetRadio(on bool) error {
+	mode := "persistent-low-power"
+	if on {
+		mode = "online"
+	}
+	_, err := m.qmi("--dms-set-operating-mode=" + mode)
+	return err
+}
+
+// GetSimSlots returns state of physical SIM slots. Modems without support
+// for multiple slots are reported to have one activated slot.
+func (m *QMIModem) GetSimSlots() ([]SimSlot, error) {
+	var slots []SimSlot
+	out, err := m.qmi("--uim-get-slot-status")
+	if err == nil {
+		slots = parseQMISlotStatus(out)
+	}
+	if len(slots) == 0 {
+		// Single-SIM modem or slot status not supported by the firmware.
+		slots = []SimSlot{{Number: 1, Activated: true, Present: true}}
+	}
+	ready, err := m.isCardReady()
+	if err != nil {
+		return slots, err
+	}
+	for i := range slots {
+		slot := &slots[i]
+		if !slot.Activated {
+			continue
+		}
+		slot.Ready = ready
+		if !ready {
+			break
+		}
+		if slot.ICCID == "" {
+			slot.ICCID, _ = m.readICCID()
+		}
+		slot.IMSI, _ = m.readIMSI()
+	}
+	return slots, nil
+}
+
+// parseQMISlotStatus parses output of qmicli --uim-get-slot-status.
+func parseQMISlotStatus(out string) (slots []SimSlot) {
+	blocks := splitBlocks(out, func(line string) bool {
+		return strings.HasPrefix(line, "Physical slot ")
+	})
+	for _, block := range blocks {
+		header := strings.SplitN(strings.TrimSpace(block), "\n", 2)[0]
+		number, err := strconv.Atoi(strings.TrimSuffix(
+			strings.TrimPrefix(header, "Physical slot "), ":"))
+		if err != nil || number <= 0 || number > math.MaxUint8 {
+			continue
+		}
+		slots = append(slots, SimSlot{
+			Number:    uint8(number),
+			Activated: parseAttr(block, "Slot status", "") == "active",
+			Present:   parseAttr(block, "Card status", "") == "present",
+			ICCID:     strings.TrimRight(parseAttr(block, "ICCID", ""), "Ff"),
+		})
+	}
+	return slots
+}
+
+func (m *QMIModem) isCardReady() (bool, error) {
+	out, err := m.qmi("--uim-get-card-status")
+	if err != nil {
+		return false, err
+	}
+	return parseAttr(out, "Application state", "") == "ready", nil
+}
+
+// readICCID reads ICCID from EF ICCID of the card (ETSI TS 102 221, 13.2).
+func (m *QMIModem) readICCID() (string, error) {
+	out, err := m.qmi("--uim-read-transparent=0x3F00,0x2FE2")
+	if err != nil {
+		return "", err
+	}
+	return swapNibbles(parseReadResult(out)), nil
+}
+
+// readIMSI reads IMSI from EF IMSI of the USIM (3GPP TS 31.102, 4.2.2).
+func (m *QMIModem) readIMSI() (string, error) {
+	out, err := m.qmi("--uim-read-transparent=0x3F00,0x7FFF,0x6F07")
+	if err != nil {
+		return "", err
+	}
+	return decodeIMSI(parseReadResult(out)), nil
+}
+
+// parseReadResult returns hex bytes printed by qmicli --uim-read-transparent
+// on the line following "Read result:".
+func parseReadResult(out string) []string {
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "Read result:" && i+1 < len(lines) {
+			return strings.Split(strings.TrimSpace(lines[i+1]), ":")
+		}
+	}
+	return nil
+}
+
+// decodeIMSI decodes content of EF IMSI. The first byte is the length,
+// the lower nibble of the second byte is the parity and not a digit.
+func decodeIMSI(hexBytes []string) string {
+	if len(hexBytes) < 2 || len(hexBytes[1]) != 2 {
+		return ""
+	}
+	first := strings.TrimRight(hexBytes[1][:1], "Ff")
+	return first + swapNibbles(hexBytes[2:])
+}
+
+// ActivateSimSlot activates the physical slot for the (only) logical slot.
+func (m *QMIModem) ActivateSimSlot(slot uint8) error {
+	_, err := m.qmi(fmt.Sprintf("--uim-switch-slot=%d", slot))
+	return err
+}
+
+// SetAttachAPN sets APN of the default 3GPP profile, which is used
+// for the initial EPS bearer.
+func (m *QMIModem) SetAttachAPN(apn string) error {
+	out, err := m.qmi("--wds-get-default-profile-num=3gpp")
+	if err != nil {
+		return err
+	}
+	profile := parseAttr(out, "Default profile number", "")
+	if profile == "" {
+		return errors.New("failed to get default 3GPP profile number")
+	}
+	_, err = m.qmi(fmt.Sprintf("--wds-modify-profile=3gpp,%s,apn=%s", profile, apn))
+	return err
+}
+
+// GetRegistration returns registration state with the serving network.
+func (m *QMIModem) GetRegistration() (reg Registration, err error) {
+	out, err := m.qmi("--nas-get-serving-system")
+	if err != nil {
+		return reg, err
+	}
+	return parseQMIServingSystem(out), nil
+}
+
+func parseQMIServingSystem(out string) (reg Registration) {
+	switch parseAttr(out, "Registration state", "") {
+	case "registered":
+		reg.Registered = true
+	case "registration-denied":
+		reg.Denied = true
+	}
+	if !reg.Registered {
+		return reg
+	}
+	reg.Provider = Provider{
+		PLMN:           formatPLMN(parseAttr(out, "MCC", ""), parseAttr(out, "MNC", "")),
+		Description:    parseAttr(out, "Description", ""),
+		CurrentServing: true,
+		Roaming:        parseAttr(out, "Roaming status", "") == "on",
+	}
+	return reg
+}
+
+// ScanProviders scans for visible networks, falls back to the serving network
+// if the scan fails or is aborted (modems often refuse to scan while connected).
+func (m *QMIModem) ScanProviders() ([]Provider, error) {
+	out, err := m.qmi("--nas-network-scan")
+	if err == nil {
+		if providers := parseQMINetworkScan(out); len(providers) > 0 {
+			return providers, nil
+		}
+	}
+	reg, err := m.GetRegistration()
+	if err != nil || !reg.Registered {
+		return nil, err
+	}
+	return []Provider{reg.Provider}, nil
+}
+
+func parseQMINetworkScan(out string) (providers []Provider) {
+	blocks := splitBlocks(out, func(line string) bool {
+		return strings.HasPrefix(line, "Network [")
+	})
+	seen := make(map[Provider]bool)
+	for _, block := range blocks {
+		status := parseAttr(block, "Status", "")
+		if status == "" {
+			// Blocks listing radio access technologies of the networks.
+			continue
+		}
+		// Status is a list of flags, e.g. "available, roaming, not-forbidden".
+		flags := make(map[string]bool)
+		for _, flag := range strings.Split(status, ",") {
+			flags[strings.TrimSpace(flag)] = true
+		}
+		provider := Provider{
+			PLMN:           formatPLMN(parseAttr(block, "MCC", ""), parseAttr(block, "MNC", "")),
+			Description:    parseAttr(block, "Description", ""),
+			CurrentServing: flags["current-serving"],
+			Roaming:        flags["roaming"],
+		}
+		// The same network may be listed more than once.
+		if !seen[provider] {
+			seen[provider] = true
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+// StartNetwork switches the interface to raw-IP mode and starts data connection.
+func (m *QMIModem) StartNetwork(apn string) error {
+	if err := m.setRawIP(); err != nil {
+		return err
+	}
+	if _, err := m.qmi("--wds-reset"); err != nil {
+		return err
+	}
+	out, err := m.qmi("--wds-start-network=ip-type=4,apn="+apn,
+		"--client-no-release-cid")
+	if err != nil {
+		return err
+	}
+	m.packetDataHandle = parseAttr(out, "Packet data handle", "")
+	m.clientID = parseAttr(out, "CID", "")
+	return nil
+}
+
+// setRawIP : modems controlled by QMI send IP packets without ethernet header.
+func (m *QMIModem) setRawIP() error {
+	if m.iface == "" {
+		return nil
+	}
+	if _, err := m.run("ip", "link", "set", m.iface, "down"); err != nil {
+		return err
+	}
+	rawIPPath := filepath.Join("/sys/class/net", m.iface, "qmi/raw_ip")
+	if err := os.WriteFile(rawIPPath, []byte("Y"), 0644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to enable raw-IP mode: %w", err)
+	}
+	_, err := m.run("ip", "link", "set", m.iface, "up")
+	return err
+}
+
+// StopNetwork stops data connection. Without the handle of the connection
+// (e.g. started before restart of the service) or if it fails, the modem
+// is cycled through the low-power mode, which also stops the connection.
+func (m *QMIModem) StopNetwork() error {
+	if m.packetDataHandle != "" && m.clientID != "" {
+		_, err := m.qmi("--wds-stop-network="+m.packetDataHandle,
+			"--client-cid="+m.clientID)
+		m.packetDataHandle, m.clientID = "", ""
+		if err == nil {
+			return nil
+		}
+	}
+	if _, err := m.qmi("--dms-set-operating-mode=low-power"); err != nil {
+		return err
+	}
+	time.Sleep(time.Second)
+	_, err := m.qmi("--dms-set-operating-mode=online")
+	return err
+}
+
+// IsConnected returns true if the packet service is connected.
+func (m *QMIModem) IsConnected() (bool, error) {
+	out, err := m.qmi("--wds-get-packet-service-status")
+	if err != nil {
+		return false, err
+	}
+	return parseAttr(out, "Connection status", "") == "connected", nil
+}
+
+// GetIPSettings returns IPv4 settings of the data connection.
+func (m *QMIModem) GetIPSettings() (settings IPSettings, err error) {
+	out, err := m.qmi("--wds-get-current-settings")
+	if err != nil {
+		return settings, err
+	}
+	return parseQMICurrentSettings(out)
+}
+
+func parseQMICurrentSettings(out string) (settings IPSettings, err error) {
+	settings.Address = parseIPv4(parseAttr(out, "IPv4 address", ""))
+	if settings.Address == nil {
+		return settings, errors.New("modem did not provide IPv4 address")
+	}
+	if mask := parseIPv4(parseAttr(out, "IPv4 subnet mask", "")); mask != nil {
+		settings.Mask = net.IPMask(mask)
+	}
+	settings.Gateway = parseIPv4(parseAttr(out, "IPv4 gateway address", ""))
+	for _, attr := range []string{"IPv4 primary DNS", "IPv4 secondary DNS"} {
+		if dns := parseIPv4(parseAttr(out, attr, "")); dns != nil {
+			settings.DNS = append(settings.DNS, dns)
+		}
+	}
+	settings.MTU, _ = strconv.Atoi(parseAttr(out, "MTU", ""))
+	return settings, nil
+}
+
+// GetPacketStats returns counters of the data connection.
+func (m *QMIModem) GetPacketStats() (stats PacketStats, err error) {
+	out, err := m.qmi("--wds-get-packet-statistics")
+	if err != nil {
+		return stats, err
+	}
+	counter := func(attr string) uint64 {
+		value, _ := strconv.ParseUint(parseAttr(out, attr, ""), 10, 64)
+		return value
+	}
+	return PacketStats{
+		RxBytes:   counter("RX bytes OK"),
+		RxPackets: counter("RX packets OK"),
+		RxDrops:   counter("RX packets dropped"),
+		TxBytes:   counter("TX bytes OK"),
+		TxPackets: counter("TX packets OK"),
+		TxDrops:   counter("TX packets dropped"),
+	}, nil
+}
+
+// GetSignalInfo returns signal metrics of the current radio access technology.
+func (m *QMIModem) GetSignalInfo() (info SignalInfo, err error) {
+	out, err := m.qmi("--nas-get-signal-info")
+	if err != nil {
+		return info, err
+	}
+	return parseQMISignalInfo(out), nil
+}
+
+func parseQMISignalInfo(out string) SignalInfo {
+	metric := func(attr, unit string) int32 {
+		// SNR is reported with one decimal place.
+		value, err := strconv.ParseFloat(parseAttr(out, attr, unit), 64)
+		if err != nil {
+			return UnavailableSignalMetric
+		}
+		return int32(math.Round(value))
+	}
+	return SignalInfo{
+		RSSI: metric("RSSI", " dBm"),
+		RSRQ: metric("RSRQ", " dB"),
+		RSRP: metric("RSRP", " dBm"),
+		SNR:  metric("SNR", " dB"),
+	}
+}
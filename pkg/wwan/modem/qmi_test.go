@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const qmiSlotStatus = `[/dev/cdc-wdm0] 2 physical slots found:
+  Physical slot 1:
+     Card status: present
+     Slot status: active
+     Logical slot: 1
+     ICCID: 89012703578345957137
+  Physical slot 2:
+     Card status: absent
+     Slot status: inactive
+`
+
+const qmiServingSystem = `[/dev/cdc-wdm0] Successfully got serving system:
+	Registration state: 'registered'
+	CS: 'attached'
+	PS: 'attached'
+	Selected network: '3gpp'
+	Radio interfaces: '1'
+		[0]: 'lte'
+	Roaming status: 'off'
+	Data service capabilities: '1'
+		[0]: 'lte'
+	Current PLMN:
+		MCC: '231'
+		MNC: '2'
+		Description: 'Telekom SK'
+`
+
+const qmiNetworkScan = `[/dev/cdc-wdm0] Successfully scanned networks
+Network [0]:
+	MCC: '231'
+	MNC: '02'
+	Status: 'current-serving, roaming-unknown, forbidden-unknown, preferred-unknown'
+	Description: 'Telekom SK'
+Network [1]:
+	MCC: '231'
+	MNC: '01'
+	Status: 'available, roaming, not-forbidden, preferred-unknown'
+	Description: 'Orange SK'
+Network [2]:
+	MCC: '231'
+	MNC: '02'
+	Status: 'current-serving, roaming-unknown, forbidden-unknown, preferred-unknown'
+	Description: 'Telekom SK'
+Network [0]:
+	MCC: '231'
+	MNC: '02'
+	Radio access technology: 'lte'
+`
+
+const qmiCurrentSettings = `[/dev/cdc-wdm0] Current settings retrieved:
+           IP Family: IPv4
+        IPv4 address: 10.163.4.24
+    IPv4 subnet mask: 255.255.255.248
+IPv4 gateway address: 10.163.4.25
+    IPv4 primary DNS: 37.152.62.116
+  IPv4 secondary DNS: 37.152.61.116
+                 MTU: 1500
+             Domains: none
+`
+
+const qmiSignalInfo = `[/dev/cdc-wdm0] Successfully got signal info
+LTE:
+	RSSI: '-71 dBm'
+	RSRQ: '-11 dB'
+	RSRP: '-98 dBm'
+	SNR: '6.4 dB'
+`
+
+func TestParseAttr(t *testing.T) {
+	out := `[/dev/cdc-wdm0] Connection status: 'connected'
+	RSSI [0-31,99]: '19'
+	Mode: 'unknown'
+`
+	if v := parseAttr(out, "Connection status", ""); v != "connected" {
+		t.Errorf("unexpected connection status: %q", v)
+	}
+	if v := parseAttr(out, "RSSI [0-31,99]", ""); v != "19" {
+		t.Errorf("unexpected RSSI: %q", v)
+	}
+	if v := parseAttr(out, "Mode", ""); v != "" {
+		t.Errorf("unknown value should be returned as empty, got %q", v)
+	}
+	if v := parseAttr(qmiSignalInfo, "RSRP", " dBm"); v != "-98" {
+		t.Errorf("unexpected RSRP: %q", v)
+	}
+}
+
+func TestParseQMISlotStatus(t *testing.T) {
+	expected := []SimSlot{
+		{Number: 1, Activated: true, Present: true, ICCID: "89012703578345957137"},
+		{Number: 2},
+	}
+	slots := parseQMISlotStatus(qmiSlotStatus)
+	if !reflect.DeepEqual(slots, expected) {
+		t.Errorf("expected %+v, got %+v", expected, slots)
+	}
+}
+
+func TestDecodeSimFiles(t *testing.T) {
+	iccid := swapNibbles(strings.Split("98:10:72:30:75:38:54:59:17:73", ":"))
+	if iccid != "89012703578345957137" {
+		t.Errorf("unexpected ICCID: %s", iccid)
+	}
+	imsi := decodeIMSI(strings.Split("08:29:13:20:10:32:54:76:98", ":"))
+	if imsi != "231020123456789" {
+		t.Errorf("unexpected IMSI: %s", imsi)
+	}
+}
+
+func TestParseQMIServingSystem(t *testing.T) {
+	reg := parseQMIServingSystem(qmiServingSystem)
+	expected := Registration{
+		Registered: true,
+		Provider: Provider{
+			PLMN:           "231-02",
+			Description:    "Telekom SK",
+			CurrentServing: true,
+		},
+	}
+	if !reflect.DeepEqual(reg, expected) {
+		t.Errorf("expected %+v, got %+v", expected, reg)
+	}
+	reg = parseQMIServingSystem("Registration state: 'registration-denied'\n")
+	if reg.Registered || !reg.Denied {
+		t.Errorf("expected denied registration, got %+v", reg)
+	}
+}
+
+func TestParseQMINetworkScan(t *testing.T) {
+	expected := []Provider{
+		{PLMN: "231-02", Description: "Telekom SK", CurrentServing: true},
+		{PLMN: "231-01", Description: "Orange SK", Roaming: true},
+	}
+	providers := parseQMINetworkScan(qmiNetworkScan)
+	if !reflect.DeepEqual(providers, expected) {
+		t.Errorf("expected %+v, got %+v", expected, providers)
+	}
+}
+
+func TestParseQMICurrentSettings(t *testing.T) {
+	settings, err := parseQMICurrentSettings(qmiCurrentSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := IPSettings{
+		Address: net.IP{10, 163, 4, 24},
+		Mask:    net.IPMask{255, 255, 255, 248},
+		Gateway: net.IP{10, 163, 4, 25},
+		DNS:     []net.IP{{37, 152, 62, 116}, {37, 152, 61, 116}},
+		MTU:     1500,
+	}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Errorf("expected %+v, got %+v", expected, settings)
+	}
+	if _, err = parseQMICurrentSettings("IP Family: IPv4\n"); err == nil {
+		t.Error("expected error for missing IPv4 address")
+	}
+}
+
+func TestParseQMISignalInfo(t *testing.T) {
+	expected := SignalInfo{RSSI: -71, RSRQ: -11, RSRP: -98, SNR: 6}
+	if info := parseQMISignalInfo(qmiSignalInfo); info != expected {
+		t.Errorf("expected %+v, got %+v", expected, info)
+	}
+	info := parseQMISignalInfo("")
+	if info.RSSI != UnavailableSignalMetric || info.SNR != UnavailableSignalMetric {
+		t.Errorf("expected unavailable metrics, got %+v", info)
+	}
+}
+
+func TestQMIStartStopNetwork(t *testing.T) {
+	var commands []string
+	m := &QMIModem{
+		device: "/dev/cdc-wdm0",
+		run: func(name string, args ...string) (string, error) {
+			commands = append(commands, name+" "+strings.Join(args, " "))
+			if strings.HasPrefix(args[len(args)-1], "--client-no-release-cid") {
+				return "[/dev/cdc-wdm0] Network started\n" +
+					"\tPacket data handle: '2264924160'\n" +
+					"[/dev/cdc-wdm0] Client ID not released:\n" +
+					"\tService: 'wds'\n\t    CID: '17'\n", nil
+			}
+			return "", nil
+		},
+	}
+	if err := m.StartNetwork("internet"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StopNetwork(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"qmicli -p -d /dev/cdc-wdm0 --wds-reset",
+		"qmicli -p -d /dev/cdc-wdm0 --wds-start-network=ip-type=4,apn=internet " +
+			"--client-no-release-cid",
+		"qmicli -p -d /dev/cdc-wdm0 --wds-stop-network=2264924160 --client-cid=17",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands:\n%s\ngot:\n%s",
+			strings.Join(expected, "\n"), strings.Join(commands, "\n"))
+	}
+}
+
+func TestQMIOverMBIM(t *testing.T) {
+	var command string
+	m := &MBIMModem{
+		device: "/dev/cdc-wdm0",
+		qmi: newQMIOverMBIM("/dev/cdc-wdm0", "wwan0",
+			func(name string, args ...string) (string, error) {
+				command = fmt.Sprintf("%s %s", name, strings.Join(args, " "))
+				return "", nil
+			}),
+	}
+	if err := m.ActivateSimSlot(2); err != nil {
+		t.Fatal(err)
+	}
+	expected := "qmicli -p --device-open-mbim -d /dev/cdc-wdm0 --uim-switch-slot=2"
+	if command != expected {
+		t.Errorf("expected %q, got %q", expected, command)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+)
+
+// SimulatedSim : SIM card inserted into a slot of SimulatedModem.
+type SimulatedSim struct {
+	ICCID string
+	IMSI  string
+	// Provider of the network the card registers with.
+	Provider Provider
+	// DenyRegistration simulates network rejecting the registration.
+	DenyRegistration bool
+	// FailConnection simulates network rejecting the data connection.
+	FailConnection bool
+	// NotReady simulates card which does not initialize (e.g. PIN-locked).
+	NotReady bool
+}
+
+// SimulatedModem implements Modem in memory. It is used to test connection
+// management (including SIM failover) without a real modem.
+type SimulatedModem struct {
+	sync.Mutex
+	info       Info
+	protocol   Protocol
+	sims       map[uint8]*SimulatedSim
+	slotCount  uint8
+	activeSlot uint8
+	radioOn    bool
+	connected  bool
+	apn        string
+	attachAPN  string
+	stats      PacketStats
+}
+
+// NewSimulatedModem returns simulated modem with the given number of empty
+// SIM slots. Slot 1 is activated.
+func NewSimulatedModem(slotCount uint8) *SimulatedModem {
+	return &SimulatedModem{
+		info: Info{
+			IMEI:     "353533100000000",
+			Model:    "Simulated",
+			Revision: "1.0",
+		},
+		protocol:   ProtocolQMI,
+		sims:       make(map[uint8]*SimulatedSim),
+		slotCount:  slotCount,
+		activeSlot: 1,
+	}
+}
+
+// InsertSim inserts (or replaces) SIM card in the given slot.
+func (m *SimulatedModem) InsertSim(slot uint8, sim SimulatedSim) {
+	m.Lock()
+	defer m.Unlock()
+	m.sims[slot] = &sim
+}
+
+// UpdateSim changes properties of an inserted SIM card, e.g. to simulate
+// network outage. Connection using the card is dropped if it should fail.
+func (m *SimulatedModem) UpdateSim(slot uint8, update func(sim *SimulatedSim)) {
+	m.Lock()
+	defer m.Unlock()
+	sim := m.sims[slot]
+	if sim == nil {
+		return
+	}
+	update(sim)
+	if slot == m.activeSlot && (sim.DenyRegistration || sim.FailConnection) {
+		m.connected = false
+	}
+}
+
+// AddTraffic simulates data transferred over the connection.
+func (m *SimulatedModem) AddTraffic(rxBytes, txBytes uint64) {
+	m.Lock()
+	defer m.Unlock()
+	m.stats.RxBytes += rxBytes
+	m.stats.TxBytes += txBytes
+	m.stats.RxPackets++
+	m.stats.TxPackets++
+}
+
+// ActiveSlot returns the currently activated SIM slot.
+func (m *SimulatedModem) ActiveSlot() uint8 {
+	m.Lock()
+	defer m.Unlock()
+	return m.activeSlot
+}
+
+// APN returns APN of the current data connection (empty if not connected).
+func (m *SimulatedModem) APN() string {
+	m.Lock()
+	defer m.Unlock()
+	if !m.connected {
+		return ""
+	}
+	return m.apn
+}
+
+// Protocol returns the simulated protocol.
+func (m *SimulatedModem) Protocol() Protocol {
+	return m.protocol
+}
+
+// GetInfo returns the simulated identification.
+func (m *SimulatedModem) GetInfo() (Info, error) {
+	return m.info, nil
+}
+
+// GetOpMode returns the simulated operating mode.
+func (m *SimulatedModem) GetOpMode() (OpMode, error) {
+	m.Lock()
+	defer m.Unlock()
+	switch {
+	case !m.radioOn:
+		return OpModeRadioOff, nil
+	case m.connected:
+		return OpModeConnected, nil
+	default:
+		return OpModeOnline, nil
+	}
+}
+
+// SetRadio turns the radio on or off. Disabling the radio drops the connection.
+func (m *SimulatedModem) SetRadio(on bool) error {
+	m.Lock()
+	defer m.Unlock()
+	m.radioOn = on
+	if !on {
+		m.connected = false
+	}
+	return nil
+}
+
+// GetSimSlots returns state of all simulated slots.
+func (m *SimulatedModem) GetSimSlots() ([]SimSlot, error) {
+	m.Lock()
+	defer m.Unlock()
+	var slots []SimSlot
+	for number := uint8(1); number <= m.slotCount; number++ {
+		slot := SimSlot{Number: number, Activated: number == m.activeSlot}
+		if sim := m.sims[number]; sim != nil {
+			slot.Present = true
+			slot.ICCID = sim.ICCID
+			if slot.Activated && !sim.NotReady {
+				slot.Ready = true
+				slot.IMSI = sim.IMSI
+			}
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+// ActivateSimSlot switches the active slot, dropping the connection.
+func (m *SimulatedModem) ActivateSimSlot(slot uint8) error {
+	m.Lock()
+	defer m.Unlock()
+	if slot == 0 || slot > m.slotCount {
+		return fmt.Errorf("invalid SIM slot %d", slot)
+	}
+	if slot != m.activeSlot {
+		m.activeSlot = slot
+		m.connected = false
+	}
+	return nil
+}
+
+// SetAttachAPN records the attach APN.
+func (m *SimulatedModem) SetAttachAPN(apn string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.attachAPN = apn
+	return nil
+}
+
+func (m *SimulatedModem) activeSim() *SimulatedSim {
+	sim := m.sims[m.activeSlot]
+	if sim == nil || sim.NotReady {
+		return nil
+	}
+	return sim
+}
+
+// GetRegistration returns registration with the provider of the active SIM.
+func (m *SimulatedModem) GetRegistration() (reg Registration, err error) {
+	m.Lock()
+	defer m.Unlock()
+	sim := m.activeSim()
+	if !m.radioOn || sim == nil {
+		return reg, nil
+	}
+	if sim.DenyRegistration {
+		reg.Denied = true
+		return reg, nil
+	}
+	reg.Registered = true
+	reg.Provider = sim.Provider
+	reg.Provider.CurrentServing = true
+	return reg, nil
+}
+
+// ScanProviders returns the serving provider only.
+func (m *SimulatedModem) ScanProviders() ([]Provider, error) {
+	reg, err := m.GetRegistration()
+	if err != nil || !reg.Registered {
+		return nil, err
+	}
+	return []Provider{reg.Provider}, nil
+}
+
+// StartNetwork connects unless the active SIM is set to fail.
+func (m *SimulatedModem) StartNetwork(apn string) error {
+	m.Lock()
+	defer m.Unlock()
+	sim := m.activeSim()
+	if !m.radioOn || sim == nil || sim.DenyRegistration {
+		return errors.New("modem is not registered")
+	}
+	if sim.FailConnection {
+		return fmt.Errorf("connection with APN %s rejected by the network", apn)
+	}
+	m.connected = true
+	m.apn = apn
+	return nil
+}
+
+// StopNetwork drops the connection.
+func (m *SimulatedModem) StopNetwork() error {
+	m.Lock()
+	defer m.Unlock()
+	m.connected = false
+	return nil
+}
+
+// IsConnected returns true if connected.
+func (m *SimulatedModem) IsConnected() (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.connected, nil
+}
+
+// GetIPSettings returns fixed IP settings while connected.
+func (m *SimulatedModem) GetIPSettings() (settings IPSettings, err error) {
+	m.Lock()
+	defer m.Unlock()
+	if !m.connected {
+		return settings, errors.New("modem is not connected")
+	}
+	return IPSettings{
+		Address: net.IPv4(10, 10, 0, m.activeSlot+1).To4(),
+		Mask:    net.CIDRMask(24, 32),
+		Gateway: net.IPv4(10, 10, 0, 1).To4(),
+		DNS:     []net.IP{net.IPv4(10, 10, 0, 1).To4()},
+		MTU:     1500,
+	}, nil
+}
+
+// GetPacketStats returns the simulated traffic counters.
+func (m *SimulatedModem) GetPacketStats() (PacketStats, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.stats, nil
+}
+
+// GetSignalInfo returns fixed signal metrics while the radio is on.
+func (m *SimulatedModem) GetSignalInfo() (SignalInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+	if !m.radioOn {
+		return SignalInfo{
+			RSSI: UnavailableSignalMetric,
+			RSRQ: UnavailableSignalMetric,
+			RSRP: UnavailableSignalMetric,
+			SNR:  UnavailableSignalMetric,
+		}, nil
+	}
+	return SignalInfo{RSSI: -70, RSRQ: -10, RSRP: -95, SNR: 10}, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package modem
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Device : modem found in the /sys filesystem.
+type Device struct {
+	// CDCDevice is the name of the control device, e.g. cdc-wdm0.
+	CDCDevice string
+	Protocol  Protocol
+	// Interface is the name of the network interface, e.g. wwan0.
+	Interface string
+	// USBAddr is the USB address in the format "<BUS>:[<PORT>]+".
+	USBAddr string
+	// PCIAddr is the PCI address of the USB controller (or of the modem
+	// itself if it is connected directly to the PCI bus).
+	PCIAddr string
+}
+
+// DevicePath returns path to the control device.
+func (d Device) DevicePath() string {
+	return filepath.Join("/dev", d.CDCDevice)
+}
+
+// NewModem returns Modem implementation for the device protocol.
+func (d Device) NewModem() Modem {
+	if d.Protocol == ProtocolMBIM {
+		return NewMBIMModem(d.DevicePath(), d.Interface)
+	}
+	return NewQMIModem(d.DevicePath(), d.Interface)
+}
+
+const usbmiscClassPath = "/sys/class/usbmisc"
+
+// ListDevices returns all modems controlled by the QMI or MBIM driver.
+func ListDevices() ([]Device, error) {
+	entries, err := ioutil.ReadDir(usbmiscClassPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var devices []Device
+	for _, entry := range entries {
+		sysPath := filepath.Join(usbmiscClassPath, entry.Name())
+		protocol, ok := sysGetProtocol(sysPath)
+		if !ok {
+			continue
+		}
+		devices = append(devices, Device{
+			CDCDevice: entry.Name(),
+			Protocol:  protocol,
+			Interface: sysGetInterface(sysPath),
+			USBAddr:   sysGetUSBAddr(sysPath),
+			PCIAddr:   sysGetPCIAddr(sysPath),
+		})
+	}
+	return devices, nil
+}
+
+// MatchesAddrs returns true if the device matches all of the non-empty addresses.
+func (d Device) MatchesAddrs(iface, usbAddr, pciAddr string) bool {
+	return (iface == "" || iface == d.Interface) &&
+		(usbAddr == "" || usbAddr == d.USBAddr) &&
+		(pciAddr == "" || pciAddr == d.PCIAddr)
+}
+
+func sysGetProtocol(sysPath string) (Protocol, bool) {
+	module, err := os.Readlink(filepath.Join(sysPath, "device/driver/module"))
+	if err != nil {
+		return "", false
+	}
+	switch filepath.Base(module) {
+	case "cdc_mbim":
+		return ProtocolMBIM, true
+	case "qmi_wwan":
+		return ProtocolQMI, true
+	}
+	return "", false
+}
+
+func sysGetInterface(sysPath string) string {
+	entries, err := ioutil.ReadDir(filepath.Join(sysPath, "device/net"))
+	if err != nil || len(entries) == 0 {
+		return ""
+	}
+	return entries[0].Name()
+}
+
+// sysFindParent walks up the device path until it finds device of the given
+// subsystem.
+func sysFindParent(sysPath, subsystem string) string {
+	devPath, err := filepath.EvalSymlinks(filepath.Join(sysPath, "device"))
+	if err != nil {
+		return ""
+	}
+	for {
+		link, err := os.Readlink(filepath.Join(devPath, "subsystem"))
+		if err != nil {
+			return ""
+		}
+		if filepath.Base(link) == subsystem {
+			return filepath.Base(devPath)
+		}
+		devPath = filepath.Dir(devPath)
+	}
+}
+
+func sysGetUSBAddr(sysPath string) string {
+	// USB interface is named "<bus>-<port>[.<port>]*:<config>.<interface>".
+	name := sysFindParent(sysPath, "usb")
+	if name == "" {
+		return ""
+	}
+	name = strings.SplitN(name, ":", 2)[0]
+	return strings.ReplaceAll(name, "-", ":")
+}
+
+func sysGetPCIAddr(sysPath string) string {
+	return sysFindParent(sysPath, "pci")
+}
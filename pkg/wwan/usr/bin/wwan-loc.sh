@@ -1,6 +1,9 @@
 #!/bin/sh
 # shellcheck disable=SC2039
 # shellcheck disable=SC2155
+#
+# Usage: wwan-loc.sh <logical-label> <cdc-device> <qmi|mbim> <output-file>
+# Started (and killed together with its process group) by wwan-agent.
 
 kill_process_tree() {
   local parent="$1" child
@@ -162,3 +165,5 @@ location_tracking() {
                               --loc-noop "--client-cid=$CID" 2>/dev/null
   done
 }
+
+location_tracking "$@"